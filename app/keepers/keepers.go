@@ -81,6 +81,8 @@ import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v17/x/lockup/types"
 	mintkeeper "github.com/osmosis-labs/osmosis/v17/x/mint/keeper"
 	minttypes "github.com/osmosis-labs/osmosis/v17/x/mint/types"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle"
+	oracletypes "github.com/osmosis-labs/osmosis/v17/x/oracle/types"
 	poolincentives "github.com/osmosis-labs/osmosis/v17/x/pool-incentives"
 	poolincentiveskeeper "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/keeper"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/types"
@@ -133,6 +135,7 @@ type AppKeepers struct {
 	EvidenceKeeper               *evidencekeeper.Keeper
 	GAMMKeeper                   *gammkeeper.Keeper
 	TwapKeeper                   *twap.Keeper
+	OracleKeeper                 *oracle.Keeper
 	LockupKeeper                 *lockupkeeper.Keeper
 	EpochsKeeper                 *epochskeeper.Keeper
 	IncentivesKeeper             *incentiveskeeper.Keeper
@@ -325,6 +328,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		// TODO: Add a mintcoins restriction
 		appKeepers.BankKeeper, appKeepers.DistrKeeper,
 		appKeepers.ConcentratedLiquidityKeeper,
+		appKeepers.LockupKeeper,
 		appKeepers.PoolIncentivesKeeper,
 		appKeepers.IncentivesKeeper)
 	appKeepers.GAMMKeeper = &gammKeeper
@@ -352,20 +356,30 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
 
+	appKeepers.OracleKeeper = oracle.NewKeeper(
+		appKeepers.keys[oracletypes.StoreKey],
+		appKeepers.TwapKeeper,
+		appKeepers.PoolManagerKeeper)
+
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
 		appKeepers.GetSubspace(protorevtypes.ModuleName),
-		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.GAMMKeeper, appKeepers.EpochsKeeper, appKeepers.PoolManagerKeeper)
+		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper, appKeepers.GAMMKeeper, appKeepers.EpochsKeeper, appKeepers.PoolManagerKeeper, appKeepers.ConcentratedLiquidityKeeper, appKeepers.TwapKeeper)
 	appKeepers.ProtoRevKeeper = &protorevKeeper
+	appKeepers.PoolManagerKeeper.SetProtorevKeeper(appKeepers.ProtoRevKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
 	txFeesKeeper := txfeeskeeper.NewKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
+		appKeepers.GetSubspace(txfeestypes.ModuleName),
 		appKeepers.PoolManagerKeeper,
-		appKeepers.GAMMKeeper,
+		appKeepers.TwapKeeper,
+		appKeepers.EpochsKeeper,
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
 
@@ -474,6 +488,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	// set token factory contract keeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
+	appKeepers.PoolManagerKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
@@ -491,11 +506,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(appKeepers.IBCKeeper.ClientKeeper)).
 		AddRoute(poolincentivestypes.RouterKey, poolincentives.NewPoolIncentivesProposalHandler(*appKeepers.PoolIncentivesKeeper)).
 		AddRoute(txfeestypes.RouterKey, txfees.NewUpdateFeeTokenProposalHandler(*appKeepers.TxFeesKeeper)).
+		AddRoute(oracletypes.RouterKey, oracle.NewUpdatePriceRoutesProposalHandler(*appKeepers.OracleKeeper)).
 		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper, *appKeepers.GAMMKeeper)).
 		AddRoute(protorevtypes.RouterKey, protorev.NewProtoRevProposalHandler(*appKeepers.ProtoRevKeeper)).
 		AddRoute(gammtypes.RouterKey, gamm.NewMigrationRecordHandler(*appKeepers.GAMMKeeper)).
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
-		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper))
+		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
+		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(wasmEnabledProposals) != 0 {
@@ -660,6 +677,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
+	paramsKeeper.Subspace(txfeestypes.ModuleName)
 	paramsKeeper.Subspace(ibcratelimittypes.ModuleName)
 	paramsKeeper.Subspace(concentratedliquiditytypes.ModuleName)
 	paramsKeeper.Subspace(icqtypes.ModuleName)
@@ -696,6 +714,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.PoolIncentivesKeeper.Hooks(),
 			appKeepers.TwapKeeper.GammHooks(),
 			appKeepers.ProtoRevKeeper.Hooks(),
+			appKeepers.PoolManagerKeeper.GammHooks(),
 		),
 	)
 
@@ -704,6 +723,13 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.TwapKeeper.ConcentratedLiquidityListener(),
 			appKeepers.PoolIncentivesKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.Hooks(),
+			appKeepers.PoolManagerKeeper.ConcentratedLiquidityListener(),
+		),
+	)
+
+	appKeepers.PoolManagerKeeper.SetHooks(
+		poolmanagertypes.NewMultiPoolHooks(
+		// insert poolmanager hooks receivers here
 		),
 	)
 
@@ -736,6 +762,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
@@ -766,6 +793,7 @@ func KVStoreKeys() []string {
 		capabilitytypes.StoreKey,
 		gammtypes.StoreKey,
 		twaptypes.StoreKey,
+		oracletypes.StoreKey,
 		lockuptypes.StoreKey,
 		incentivestypes.StoreKey,
 		epochstypes.StoreKey,
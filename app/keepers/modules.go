@@ -41,8 +41,11 @@ import (
 	"github.com/osmosis-labs/osmosis/v17/x/incentives"
 	"github.com/osmosis-labs/osmosis/v17/x/lockup"
 	"github.com/osmosis-labs/osmosis/v17/x/mint"
+	oracleclient "github.com/osmosis-labs/osmosis/v17/x/oracle/client"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/oraclemodule"
 	poolincentives "github.com/osmosis-labs/osmosis/v17/x/pool-incentives"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/client"
+	poolmanagerclient "github.com/osmosis-labs/osmosis/v17/x/poolmanager/client"
 	poolmanager "github.com/osmosis-labs/osmosis/v17/x/poolmanager/module"
 	"github.com/osmosis-labs/osmosis/v17/x/protorev"
 	superfluid "github.com/osmosis-labs/osmosis/v17/x/superfluid"
@@ -84,9 +87,13 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.UpdateMigrationRecordsProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetDynamicSpreadFactorRecordsProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
+			poolmanagerclient.DenomPairTakerFeeProposalHandler,
+			poolmanagerclient.DenomPairRoutesProposalHandler,
+			oracleclient.UpdatePriceRoutesProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -101,6 +108,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	gamm.AppModuleBasic{},
 	poolmanager.AppModuleBasic{},
 	twapmodule.AppModuleBasic{},
+	oraclemodule.AppModuleBasic{},
 	concentratedliquidity.AppModuleBasic{},
 	protorev.AppModuleBasic{},
 	txfees.AppModuleBasic{},
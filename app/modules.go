@@ -75,6 +75,8 @@ import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v17/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v17/x/mint"
 	minttypes "github.com/osmosis-labs/osmosis/v17/x/mint/types"
+	oraclemodule "github.com/osmosis-labs/osmosis/v17/x/oracle/oraclemodule"
+	oracletypes "github.com/osmosis-labs/osmosis/v17/x/oracle/types"
 	poolincentives "github.com/osmosis-labs/osmosis/v17/x/pool-incentives"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/types"
 	poolmanager "github.com/osmosis-labs/osmosis/v17/x/poolmanager/module"
@@ -161,6 +163,7 @@ func appModules(
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
 		poolmanager.NewAppModule(*app.PoolManagerKeeper, app.GAMMKeeper),
 		twapmodule.NewAppModule(*app.TwapKeeper),
+		oraclemodule.NewAppModule(*app.OracleKeeper),
 		concentratedliquidity.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
 		protorev.NewAppModule(appCodec, *app.ProtoRevKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper, app.GAMMKeeper),
 		txfees.NewAppModule(*app.TxFeesKeeper),
@@ -248,6 +251,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		poolmanagertypes.ModuleName,
 		protorevtypes.ModuleName,
 		twaptypes.ModuleName,
+		oracletypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -2,6 +2,7 @@ package v17
 
 import (
 	"github.com/osmosis-labs/osmosis/v17/app/upgrades"
+	oracletypes "github.com/osmosis-labs/osmosis/v17/x/oracle/types"
 
 	store "github.com/cosmos/cosmos-sdk/store/types"
 )
@@ -13,7 +14,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{},
+		Added:   []string{oracletypes.StoreKey},
 		Deleted: []string{},
 	},
 }
@@ -7,6 +7,10 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v17/app/keepers"
 	"github.com/osmosis-labs/osmosis/v17/app/upgrades"
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v17/x/protorev/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v17/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -23,6 +27,44 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// Set the taker fee, pool hook contract and volume twap window parameters added to x/poolmanager. The taker fee
+		// defaults to zero and no pool hook contracts are called until they are changed by governance.
+		poolmanagerSubspace := keepers.GetSubspace(poolmanagertypes.ModuleName)
+		poolmanagerSubspace.Set(ctx, poolmanagertypes.KeyTakerFeeParams, poolmanagertypes.DefaultParams().TakerFeeParams)
+		poolmanagerSubspace.Set(ctx, poolmanagertypes.KeyPoolHookContracts, poolmanagertypes.DefaultParams().PoolHookContracts)
+		poolmanagerSubspace.Set(ctx, poolmanagertypes.KeyVolumeTwapWindow, poolmanagertypes.DefaultVolumeTwapWindow)
+
+		// Set the tick liquidity snapshot parameters added to x/concentrated-liquidity.
+		clSubspace := keepers.GetSubspace(cltypes.ModuleName)
+		clSubspace.Set(ctx, cltypes.KeyTickLiquiditySnapshotInterval, cltypes.DefaultTickLiquiditySnapshotInterval)
+		clSubspace.Set(ctx, cltypes.KeyTickLiquiditySnapshotKeepPeriod, cltypes.DefaultTickLiquiditySnapshotKeepPeriod)
+		// Set the parameters bounding the swaps of auto-compounding in x/concentrated-liquidity by their twap value.
+		clSubspace.Set(ctx, cltypes.KeyAutoCompoundSwapTwapWindow, cltypes.DefaultAutoCompoundSwapTwapWindow)
+		clSubspace.Set(ctx, cltypes.KeyMaxAutoCompoundSwapSlippage, cltypes.DefaultMaxAutoCompoundSwapSlippage)
+
+		// Backfill the geometric second moment accumulator added to the twap records.
+		if err := keepers.TwapKeeper.MigrateGeometricSecondMomentAccumulators(ctx); err != nil {
+			return nil, err
+		}
+
+		// Set the max number of routes that x/protorev can automatically promote to hot routes.
+		if err := keepers.ProtoRevKeeper.SetMaxPromotedHotRoutes(ctx, protorevtypes.DefaultMaxPromotedHotRoutes); err != nil {
+			return nil, err
+		}
+
+		// Set the profit distribution policy parameter added to x/protorev. The profits are not distributed
+		// until the policy is changed by governance.
+		// The profits swapped to OSMO by the distribution are bounded by their twap value less the max slippage.
+		protorevSubspace := keepers.GetSubspace(protorevtypes.ModuleName)
+		protorevSubspace.Set(ctx, protorevtypes.ParamStoreKeyProfitDistributionPolicy, protorevtypes.DefaultProfitDistributionPolicy)
+		protorevSubspace.Set(ctx, protorevtypes.ParamStoreKeyProfitSwapTwapWindow, protorevtypes.DefaultProfitSwapTwapWindow)
+		protorevSubspace.Set(ctx, protorevtypes.ParamStoreKeyMaxProfitSwapSlippage, protorevtypes.DefaultMaxProfitSwapSlippage)
+
+		// Set the base fee parameters added to x/txfees. The base fee starts at the consensus min fee and only
+		// increases when blocks consume more than the target gas.
+		keepers.TxFeesKeeper.SetParams(ctx, txfeestypes.DefaultParams())
+		keepers.TxFeesKeeper.SetCurrentBaseFee(ctx, txfeestypes.ConsensusMinFee)
+
 		return migrations, nil
 	}
 }
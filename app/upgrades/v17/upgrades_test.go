@@ -0,0 +1,81 @@
+package v17_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	v17 "github.com/osmosis-labs/osmosis/v17/app/upgrades/v17"
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v17/x/protorev/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func dummyUpgrade(suite *UpgradeTestSuite) {
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v17.UpgradeName, Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+}
+
+func (suite *UpgradeTestSuite) TestUpgrade() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+
+	// Remove the params added in v17 so that the state matches a chain
+	// that has only run the earlier upgrade handlers.
+	paramsStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(paramstypes.StoreKey))
+	poolmanagerParamsStore := prefix.NewStore(paramsStore, append([]byte(poolmanagertypes.ModuleName), '/'))
+	poolmanagerParamsStore.Delete(poolmanagertypes.KeyTakerFeeParams)
+	poolmanagerParamsStore.Delete(poolmanagertypes.KeyPoolHookContracts)
+	protorevParamsStore := prefix.NewStore(paramsStore, append([]byte(protorevtypes.ModuleName), '/'))
+	protorevParamsStore.Delete(protorevtypes.ParamStoreKeyProfitSwapTwapWindow)
+	protorevParamsStore.Delete(protorevtypes.ParamStoreKeyMaxProfitSwapSlippage)
+	clParamsStore := prefix.NewStore(paramsStore, append([]byte(cltypes.ModuleName), '/'))
+	clParamsStore.Delete(cltypes.KeyAutoCompoundSwapTwapWindow)
+	clParamsStore.Delete(cltypes.KeyMaxAutoCompoundSwapSlippage)
+
+	dummyUpgrade(suite)
+	suite.Require().NotPanics(func() {
+		suite.App.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{})
+	})
+
+	// The new params are set to their defaults
+	params := suite.App.PoolManagerKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(poolmanagertypes.DefaultParams().TakerFeeParams, params.TakerFeeParams)
+	suite.Require().Empty(params.PoolHookContracts)
+	protorevParams := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(protorevtypes.DefaultProfitSwapTwapWindow, protorevParams.ProfitSwapTwapWindow)
+	suite.Require().Equal(protorevtypes.DefaultMaxProfitSwapSlippage, protorevParams.MaxProfitSwapSlippage)
+	clParams := suite.App.ConcentratedLiquidityKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(cltypes.DefaultAutoCompoundSwapTwapWindow, clParams.AutoCompoundSwapTwapWindow)
+	suite.Require().Equal(cltypes.DefaultMaxAutoCompoundSwapSlippage, clParams.MaxAutoCompoundSwapSlippage)
+
+	// Swaps, which read the taker fee and pool hook contracts params, succeed after the upgrade
+	suite.Require().NotPanics(func() {
+		suite.RunBasicSwap(poolId)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	incentivestypes "github.com/osmosis-labs/osmosis/v17/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v17/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/v17/x/mint/types"
+	oraclequerytypes "github.com/osmosis-labs/osmosis/v17/x/oracle/client/queryproto"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/types"
 	poolmanagerqueryproto "github.com/osmosis-labs/osmosis/v17/x/poolmanager/client/queryproto"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v17/x/superfluid/types"
@@ -150,8 +151,17 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapOverRoute", &twapquerytypes.GeometricTwapOverRouteResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/LogPriceVariance", &twapquerytypes.LogPriceVarianceResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/LogPriceVarianceToNow", &twapquerytypes.LogPriceVarianceToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/LogPriceStdDev", &twapquerytypes.LogPriceStdDevResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/LogPriceStdDevToNow", &twapquerytypes.LogPriceStdDevToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
+	// oracle
+	setWhitelistedQuery("/osmosis.oracle.v1beta1.Query/Price", &oraclequerytypes.PriceResponse{})
+	setWhitelistedQuery("/osmosis.oracle.v1beta1.Query/PriceRoutes", &oraclequerytypes.PriceRoutesResponse{})
+
 	// downtime-detector
 	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength", &downtimequerytypes.RecoveredSinceDowntimeOfLengthResponse{})
 
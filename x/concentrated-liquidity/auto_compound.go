@@ -0,0 +1,400 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// setPositionAutoCompound opts the given position in or out of auto-compounding.
+// Opting in a position that is already opted in keeps its last compound time.
+// Returns error if the position does not exist or is not owned by the sender.
+func (k Keeper) setPositionAutoCompound(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, enabled bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if sender.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: sender.String()}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPositionAutoCompound(positionId)
+	if !enabled {
+		store.Delete(key)
+	} else if !store.Has(key) {
+		osmoutils.MustSet(store, key, &model.PositionAutoCompound{PositionId: positionId})
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetPositionAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAutoCompoundEnabled, strconv.FormatBool(enabled)),
+		),
+	})
+
+	return nil
+}
+
+// GetPositionAutoCompound returns the auto-compound record of the given position.
+// Returns error if the position is not opted in auto-compounding.
+func (k Keeper) GetPositionAutoCompound(ctx sdk.Context, positionId uint64) (model.PositionAutoCompound, error) {
+	record := model.PositionAutoCompound{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionAutoCompound(positionId), &record)
+	if err != nil {
+		return model.PositionAutoCompound{}, err
+	}
+	if !found {
+		return model.PositionAutoCompound{}, types.PositionAutoCompoundNotFoundError{PositionId: positionId}
+	}
+	return record, nil
+}
+
+// GetAllPositionAutoCompounds returns the auto-compound records of all positions, ordered by position id.
+func (k Keeper) GetAllPositionAutoCompounds(ctx sdk.Context) ([]model.PositionAutoCompound, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionAutoCompoundPrefix, parsePositionAutoCompoundFromBz)
+}
+
+// GetUserAutoCompoundPositions returns the auto-compound records of the positions of the given address.
+func (k Keeper) GetUserAutoCompoundPositions(ctx sdk.Context, addr sdk.AccAddress) ([]model.PositionAutoCompound, error) {
+	positions, err := k.GetUserPositions(ctx, addr, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []model.PositionAutoCompound{}
+	for _, position := range positions {
+		record, err := k.GetPositionAutoCompound(ctx, position.PositionId)
+		if errors.Is(err, types.PositionAutoCompoundNotFoundError{PositionId: position.PositionId}) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (k Keeper) setPositionAutoCompoundRecord(ctx sdk.Context, record model.PositionAutoCompound) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionAutoCompound(record.PositionId), &record)
+}
+
+func (k Keeper) deletePositionAutoCompound(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionAutoCompound(positionId))
+}
+
+// CompoundPositions compounds the rewards of the next batch of positions opted in auto-compounding.
+// At most MaxAutoCompoundPositionsPerEpoch positions are compounded per call, starting from the stored cursor,
+// and the positions that are not reached are compounded at the end of the following epochs.
+// Each position is compounded under its own cache context and gas limit. If compounding a position fails,
+// e.g. because its rewards are too small to be swapped or added to it, the error is logged and its rewards
+// keep accruing until its next turn.
+func (k Keeper) CompoundPositions(ctx sdk.Context) {
+	records, nextPositionId, err := k.getPositionAutoCompoundBatch(ctx, k.getAutoCompoundCursor(ctx), types.MaxAutoCompoundPositionsPerEpoch)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, record := range records {
+		err := k.compoundPositionWithGasLimit(ctx, record)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to compound position (%d): %s", record.PositionId, err))
+		}
+	}
+
+	k.setAutoCompoundCursor(ctx, nextPositionId)
+}
+
+// getPositionAutoCompoundBatch returns at most limit auto-compound records, ordered by position id and starting
+// from the given position id. Also returns the position id of the record that follows the batch, or zero if the
+// batch reaches the last record.
+func (k Keeper) getPositionAutoCompoundBatch(ctx sdk.Context, startPositionId uint64, limit int) ([]model.PositionAutoCompound, uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPositionAutoCompound(startPositionId), sdk.PrefixEndBytes(types.PositionAutoCompoundPrefix))
+	defer iterator.Close()
+
+	records := []model.PositionAutoCompound{}
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := parsePositionAutoCompoundFromBz(iterator.Value())
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(records) == limit {
+			return records, record.PositionId, nil
+		}
+		records = append(records, record)
+	}
+	return records, 0, nil
+}
+
+// getAutoCompoundCursor returns the position id from which the next batch of positions is compounded.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) uint64 {
+	cursor := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyAutoCompoundCursor, &cursor)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return 0
+	}
+	return cursor.Value
+}
+
+// setAutoCompoundCursor sets the position id from which the next batch of positions is compounded.
+// A zero position id restarts from the first position opted in auto-compounding.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, positionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if positionId == 0 {
+		store.Delete(types.KeyAutoCompoundCursor)
+		return
+	}
+	osmoutils.MustSet(store, types.KeyAutoCompoundCursor, &gogotypes.UInt64Value{Value: positionId})
+}
+
+// compoundPositionWithGasLimit compounds the given position under a cache context with a gas meter limited to
+// AutoCompoundGasLimitPerPosition. The state changes are discarded if compounding errors, panics or runs out of gas.
+func (k Keeper) compoundPositionWithGasLimit(ctx sdk.Context, record model.PositionAutoCompound) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			isOutOfGas, descriptor := osmoutils.IsOutOfGasError(r)
+			if !isOutOfGas {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas: %s", descriptor)
+		}
+	}()
+
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.AutoCompoundGasLimitPerPosition))
+	return osmoutils.ApplyFuncIfNoError(limitedCtx, func(ctx sdk.Context) error {
+		return k.compoundPosition(ctx, record)
+	})
+}
+
+// compoundPosition claims the spread rewards and incentives of the given position, swaps the ones in the denoms of
+// its pool to the ratio of the position and adds them to the position. Rewards in other denoms are left with the owner.
+// The liquidity is added to the position in place, so it keeps its id and join time, and therefore its uptime.
+// Positions with an underlying lock are skipped, as well as positions without rewards in the denoms of their pool.
+func (k Keeper) compoundPosition(ctx sdk.Context, record model.PositionAutoCompound) error {
+	position, err := k.GetPosition(ctx, record.PositionId)
+	if err != nil {
+		return err
+	}
+	hasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, position.PositionId)
+	if err != nil {
+		return err
+	}
+	if hasUnderlyingLock {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+	spreadRewards, err := k.collectSpreadRewards(ctx, owner, position.PositionId)
+	if err != nil {
+		return err
+	}
+	incentives, _, err := k.collectIncentives(ctx, owner, position.PositionId)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	rewards := spreadRewards.Add(incentives...)
+	amount0, amount1 := rewards.AmountOf(pool.GetToken0()), rewards.AmountOf(pool.GetToken1())
+	if amount0.IsZero() && amount1.IsZero() {
+		return nil
+	}
+
+	amount0, amount1, err = k.swapToPositionRatio(ctx, owner, pool, position, amount0, amount1)
+	if err != nil {
+		return err
+	}
+
+	actualAmount0, actualAmount1, err := k.addLiquidityToPosition(ctx, owner, position, amount0, amount1)
+	if err != nil {
+		return err
+	}
+	k.setPositionAutoCompoundRecord(ctx, model.PositionAutoCompound{PositionId: position.PositionId, LastCompoundTime: ctx.BlockTime()})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCompoundPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, actualAmount1.String()),
+		),
+	})
+
+	return nil
+}
+
+// addLiquidityToPosition adds the liquidity of the given amounts at the current price to the given position,
+// keeping its id and join time, and transfers the actual amounts added from the owner to the pool.
+// Returns the actual amounts added.
+func (k Keeper) addLiquidityToPosition(ctx sdk.Context, owner sdk.AccAddress, position model.Position, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	// Refetch the pool, since its price may have moved when swapping to the position ratio.
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return sdk.Int{}, sdk.Int{}, types.ErrZeroLiquidity
+	}
+
+	actualAmount0, actualAmount1, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, position.PositionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokensAdded := sdk.Coins{}
+	if actualAmount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), actualAmount0))
+	}
+	if actualAmount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+	k.listeners.AfterLiquidityAdded(ctx, owner, position.PoolId, tokensAdded)
+
+	return actualAmount0, actualAmount1, nil
+}
+
+// swapToPositionRatio swaps part of amount0 or amount1 through the pool of the given position so that the resulting
+// amounts are in the ratio of the position at the current price. Positions below the current price only hold token1,
+// and positions above the current price only hold token0. Returns the amounts after the swap.
+// Due to the price impact and spread factor of the swap, the resulting amounts are only approximately in the ratio
+// of the position, and the part that cannot be added to the position is left with the owner.
+func (k Keeper) swapToPositionRatio(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	amount0ToSwap, amount1ToSwap, err := amountsToSwapToPositionRatio(pool, position, amount0, amount1)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if amount0ToSwap.IsPositive() {
+		amountOut, err := k.swapForCompounding(ctx, owner, pool.GetId(), sdk.NewCoin(pool.GetToken0(), amount0ToSwap), pool.GetToken1())
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		return amount0.Sub(amount0ToSwap), amount1.Add(amountOut), nil
+	}
+	if amount1ToSwap.IsPositive() {
+		amountOut, err := k.swapForCompounding(ctx, owner, pool.GetId(), sdk.NewCoin(pool.GetToken1(), amount1ToSwap), pool.GetToken0())
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		return amount0.Add(amountOut), amount1.Sub(amount1ToSwap), nil
+	}
+	return amount0, amount1, nil
+}
+
+// swapForCompounding swaps tokenIn to tokenOutDenom through the given pool on behalf of the owner of a compounded
+// position. The swap is not charged the taker fee, since it is done by the module rather than by a trader, and it must
+// get at least the twap value of tokenIn less the max auto-compound swap slippage, so that the price of the pool
+// cannot be moved around it to extract value from the position.
+func (k Keeper) swapForCompounding(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Int, error) {
+	minAmountOut, err := k.getAutoCompoundSwapMinAmountOut(ctx, poolId, tokenIn, tokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	return k.poolmanagerKeeper.RouteExactAmountInWithoutTakerFee(ctx, owner, route, tokenIn, minAmountOut)
+}
+
+// getAutoCompoundSwapMinAmountOut returns the min amount out of swapping tokenIn to tokenOutDenom through the given
+// pool when compounding a position, which is the value of tokenIn at the twap of the pool over the auto-compound swap
+// twap window, less the max auto-compound swap slippage.
+// Returns error if the min amount out is not positive.
+func (k Keeper) getAutoCompoundSwapMinAmountOut(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Int, error) {
+	// The twap records of concentrated liquidity pools are built from CalculateSpotPrice, which quotes the quote
+	// asset in units of the base asset, so the price of tokenIn in units of tokenOutDenom is the twap with
+	// tokenOutDenom as the base asset.
+	params := k.GetParams(ctx)
+	twapPrice, err := k.twapKeeper.GetArithmeticTwap(ctx, poolId, tokenOutDenom, tokenIn.Denom, ctx.BlockTime().Add(-params.AutoCompoundSwapTwapWindow), ctx.BlockTime())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	minAmountOut := twapPrice.MulInt(tokenIn.Amount).Mul(sdk.OneDec().Sub(params.MaxAutoCompoundSwapSlippage)).TruncateInt()
+	if !minAmountOut.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("twap value of %s is too small to be swapped to %s", tokenIn, tokenOutDenom)
+	}
+
+	return minAmountOut, nil
+}
+
+// amountsToSwapToPositionRatio returns the amount of token0 or token1 to swap so that, ignoring the price impact
+// and spread factor of the swap, the resulting amounts are in the ratio of the given position at the current price.
+// At most one of the returned amounts is positive.
+func amountsToSwapToPositionRatio(pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	currentTick := pool.GetCurrentTick()
+	if currentTick < position.LowerTick {
+		return sdk.ZeroInt(), amount1, nil
+	}
+	if currentTick >= position.UpperTick {
+		return amount0, sdk.ZeroInt(), nil
+	}
+
+	sqrtPriceLower, err := math.TickToSqrtPriceBigDec(position.LowerTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	sqrtPriceUpper, err := math.TickToSqrtPriceBigDec(position.UpperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// The amounts of token0 and token1 held by a unit of liquidity of the position.
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	amount0PerLiquidity := math.CalcAmount0Delta(osmomath.OneDec(), currentSqrtPrice, sqrtPriceUpper, false)
+	amount1PerLiquidity := math.CalcAmount1Delta(osmomath.OneDec(), sqrtPriceLower, currentSqrtPrice, false)
+
+	// The value of the amounts in units of token1 is split between token0 and token1 in the proportion of their
+	// value held by a unit of liquidity.
+	price := currentSqrtPrice.Mul(currentSqrtPrice)
+	value := osmomath.BigDecFromSDKDec(amount0.ToDec()).Mul(price).Add(osmomath.BigDecFromSDKDec(amount1.ToDec()))
+	targetAmount0 := value.Mul(amount0PerLiquidity).Quo(amount0PerLiquidity.Mul(price).Add(amount1PerLiquidity))
+
+	amount0Excess := osmomath.BigDecFromSDKDec(amount0.ToDec()).Sub(targetAmount0)
+	if amount0Excess.IsPositive() {
+		return amount0Excess.SDKDec().TruncateInt(), sdk.ZeroInt(), nil
+	}
+	return sdk.ZeroInt(), amount0Excess.Neg().Mul(price).SDKDec().TruncateInt(), nil
+}
+
+func parsePositionAutoCompoundFromBz(bz []byte) (model.PositionAutoCompound, error) {
+	record := model.PositionAutoCompound{}
+	err := record.Unmarshal(bz)
+	return record, err
+}
@@ -0,0 +1,367 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	tests := map[string]struct {
+		positionId  uint64
+		sender      int
+		alreadySet  bool
+		enabled     bool
+		expectedErr error
+	}{
+		"opt in": {
+			positionId: 1,
+			enabled:    true,
+		},
+		"opt in a position that is already opted in": {
+			positionId: 1,
+			alreadySet: true,
+			enabled:    true,
+		},
+		"opt out": {
+			positionId: 1,
+			alreadySet: true,
+			enabled:    false,
+		},
+		"error: position does not exist": {
+			positionId:  2,
+			enabled:     true,
+			expectedErr: types.PositionIdNotFoundError{PositionId: 2},
+		},
+		"error: sender does not own the position": {
+			positionId:  1,
+			sender:      1,
+			enabled:     true,
+			expectedErr: types.NotPositionOwnerError{PositionId: 1, Address: s.TestAccs[1].String()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			if tc.alreadySet {
+				err := s.clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], 1, true)
+				s.Require().NoError(err)
+			}
+			s.AddBlockTime(time.Hour)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			err := s.clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[tc.sender], tc.positionId, tc.enabled)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSetPositionAutoCompound, 1)
+
+			record, err := s.clk.GetPositionAutoCompound(s.Ctx, tc.positionId)
+			if !tc.enabled {
+				s.Require().ErrorIs(err, types.PositionAutoCompoundNotFoundError{PositionId: tc.positionId})
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.positionId, record.PositionId)
+			if tc.alreadySet {
+				// Opting in again does not reset the last compound time.
+				s.Require().Equal(time.Time{}, record.LastCompoundTime)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	owner := s.TestAccs[0]
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	compoundedPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	optedOutPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	err := s.clk.SetPositionAutoCompound(s.Ctx, owner, compoundedPositionId, true)
+	s.Require().NoError(err)
+
+	// Earn spread rewards in both denoms of the pool. The swaps are in a later block than the pool creation, whose
+	// spot price error before any liquidity was added would otherwise fail the twap that bounds compounding swaps.
+	s.AddBlockTime(time.Minute)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(100_000)), USDC, types.MinSpotPrice, 1)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(USDC, sdk.NewInt(100_000_000)), ETH, types.MaxSpotPrice, 1)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+
+	compoundedPositionBefore, err := s.clk.GetPosition(s.Ctx, compoundedPositionId)
+	s.Require().NoError(err)
+	optedOutPositionBefore, err := s.clk.GetPosition(s.Ctx, optedOutPositionId)
+	s.Require().NoError(err)
+	s.AddBlockTime(time.Hour)
+
+	// Compounding fails once the spot price is moved away from its twap, as in a sandwich of the compounding swap.
+	sandwichCtx, _ := s.Ctx.CacheContext()
+	sandwichTokenIn := sdk.NewCoin(USDC, sdk.NewInt(6_000_000_000))
+	s.Require().NoError(simapp.FundAccount(s.App.BankKeeper, sandwichCtx, s.TestAccs[1], sdk.NewCoins(sandwichTokenIn)))
+	poolBefore, err := s.clk.GetPoolById(sandwichCtx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = s.clk.SwapExactAmountIn(sandwichCtx, s.TestAccs[1], poolBefore, sandwichTokenIn, ETH, sdk.OneInt(), poolBefore.GetSpreadFactor(sandwichCtx))
+	s.Require().NoError(err)
+	err = s.clk.CompoundPosition(sandwichCtx, model.PositionAutoCompound{PositionId: compoundedPositionId})
+	s.Require().Error(err)
+
+	// System under test.
+	s.clk.CompoundPositions(s.Ctx)
+
+	// The rewards are added to the compounded position in place, so it keeps its id and join time.
+	compoundedPositionAfter, err := s.clk.GetPosition(s.Ctx, compoundedPositionId)
+	s.Require().NoError(err)
+	s.Require().True(compoundedPositionAfter.Liquidity.GT(compoundedPositionBefore.Liquidity))
+	s.Require().Equal(compoundedPositionBefore.JoinTime, compoundedPositionAfter.JoinTime)
+	s.Require().Equal(compoundedPositionId+2, s.App.ConcentratedLiquidityKeeper.GetNextPositionId(s.Ctx))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 1)
+
+	// The auto-compound record keeps track of the last compound time.
+	records, err := s.clk.GetUserAutoCompoundPositions(s.Ctx, owner)
+	s.Require().NoError(err)
+	s.Require().Equal([]model.PositionAutoCompound{{PositionId: compoundedPositionId, LastCompoundTime: s.Ctx.BlockTime()}}, records)
+
+	// The position that is not opted in is left untouched.
+	optedOutPositionAfter, err := s.clk.GetPosition(s.Ctx, optedOutPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(optedOutPositionBefore, optedOutPositionAfter)
+}
+
+// TestCompoundPositions_KeepsUptime tests that a position compounded every day keeps its join time, so that it
+// collects the incentives of an uptime longer than a day once it has been in the pool for that long.
+func (s *KeeperTestSuite) TestCompoundPositions_KeepsUptime() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	owner := s.TestAccs[0]
+	weekUptime := time.Hour * 24 * 7
+	params := s.clk.GetParams(s.Ctx)
+	params.AuthorizedUptimes = []time.Duration{time.Nanosecond, weekUptime}
+	s.clk.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	err := s.clk.SetPositionAutoCompound(s.Ctx, owner, positionId, true)
+	s.Require().NoError(err)
+	positionBefore, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	incentiveCoin := sdk.NewCoin(USDC, sdk.NewInt(1_000_000_000_000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err = s.clk.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, sdk.NewDec(1000), s.Ctx.BlockTime(), weekUptime)
+	s.Require().NoError(err)
+
+	s.AddBlockTime(time.Minute)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(100_000)), USDC, types.MinSpotPrice, 1)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(USDC, sdk.NewInt(100_000_000)), ETH, types.MaxSpotPrice, 1)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+
+	// Compound the position every day for longer than the uptime of the incentive. Until the position meets the
+	// uptime, its incentives are forfeited, so it is only compounded once it is older than the uptime.
+	for day := 0; day < 8; day++ {
+		s.AddBlockTime(time.Hour * 24)
+		s.clk.CompoundPositions(s.Ctx)
+	}
+	record, err := s.clk.GetPositionAutoCompound(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime(), record.LastCompoundTime)
+
+	positionAfter, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.JoinTime, positionAfter.JoinTime)
+
+	// The incentives accrued since the last compound are collected rather than forfeited.
+	s.AddBlockTime(time.Hour * 24)
+	collected, forfeited, err := s.clk.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.AmountOf(USDC).IsPositive())
+	s.Require().True(forfeited.IsZero())
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_Batches() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	owner := s.TestAccs[0]
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	numPositions := types.MaxAutoCompoundPositionsPerEpoch + 1
+	for i := 0; i < numPositions; i++ {
+		positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+		err := s.clk.SetPositionAutoCompound(s.Ctx, owner, positionId, true)
+		s.Require().NoError(err)
+	}
+
+	s.AddBlockTime(time.Minute)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(10_000_000)), USDC, types.MinSpotPrice, 1)
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(USDC, sdk.NewInt(10_000_000_000)), ETH, types.MaxSpotPrice, 1)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.AddBlockTime(time.Hour)
+
+	lastCompoundTimes := func() []time.Time {
+		records, err := s.clk.GetAllPositionAutoCompounds(s.Ctx)
+		s.Require().NoError(err)
+		times := []time.Time{}
+		for _, record := range records {
+			times = append(times, record.LastCompoundTime)
+		}
+		return times
+	}
+
+	// The first epoch compounds a full batch and leaves the last position for the next epoch.
+	s.clk.CompoundPositions(s.Ctx)
+	times := lastCompoundTimes()
+	for _, compoundTime := range times[:numPositions-1] {
+		s.Require().Equal(s.Ctx.BlockTime(), compoundTime)
+	}
+	s.Require().Equal(time.Time{}, times[numPositions-1])
+	s.Require().Equal(uint64(numPositions), s.clk.GetAutoCompoundCursor(s.Ctx))
+
+	// The next epoch resumes from the cursor and wraps around once all the positions are compounded.
+	s.AddBlockTime(time.Hour)
+	s.clk.CompoundPositions(s.Ctx)
+	times = lastCompoundTimes()
+	s.Require().Equal(s.Ctx.BlockTime(), times[numPositions-1])
+	s.Require().Equal(s.Ctx.BlockTime().Add(-time.Hour), times[0])
+	s.Require().Equal(uint64(0), s.clk.GetAutoCompoundCursor(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_SkipsLockedPositions() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	owner := s.TestAccs[0]
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	s.FundAcc(owner, DefaultCoins)
+	positionId, _, _, _, _, err := s.clk.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, time.Hour*24*14)
+	s.Require().NoError(err)
+	err = s.clk.SetPositionAutoCompound(s.Ctx, owner, positionId, true)
+	s.Require().NoError(err)
+
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(1_000_000)), USDC, types.MinSpotPrice, 1)
+	positionBefore, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	// System under test.
+	s.clk.CompoundPositions(s.Ctx)
+
+	positionAfter, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore, positionAfter)
+	record, err := s.clk.GetPositionAutoCompound(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(time.Time{}, record.LastCompoundTime)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 0)
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundRecordLifecycle() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	err := s.clk.SetPositionAutoCompound(s.Ctx, owner, positionId, true)
+	s.Require().NoError(err)
+
+	// Adding to the position moves the record to the new position.
+	s.FundAcc(owner, DefaultCoins)
+	newPositionId, _, _, err := s.clk.AddToPosition(s.Ctx, owner, positionId, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt())
+	s.Require().NoError(err)
+	_, err = s.clk.GetPositionAutoCompound(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionAutoCompoundNotFoundError{PositionId: positionId})
+	_, err = s.clk.GetPositionAutoCompound(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+
+	// Transferring the position clears the record, since the new owner did not opt in.
+	err = s.clk.TransferPositions(s.Ctx, []uint64{newPositionId}, owner, s.TestAccs[1])
+	s.Require().NoError(err)
+	_, err = s.clk.GetPositionAutoCompound(s.Ctx, newPositionId)
+	s.Require().ErrorIs(err, types.PositionAutoCompoundNotFoundError{PositionId: newPositionId})
+
+	// Withdrawing the full position deletes the record along with the position.
+	err = s.clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], newPositionId, true)
+	s.Require().NoError(err)
+	liquidity, err := s.clk.GetPositionLiquidity(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, s.TestAccs[1], newPositionId, liquidity)
+	s.Require().NoError(err)
+	records, err := s.clk.GetAllPositionAutoCompounds(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+}
+
+func (s *KeeperTestSuite) TestAmountsToSwapToPositionRatio() {
+	tests := map[string]struct {
+		currentTick int64
+		amount0     sdk.Int
+		amount1     sdk.Int
+
+		expectedAmount0ToSwap sdk.Int
+		expectedAmount1ToSwap sdk.Int
+	}{
+		"position above the current price: all token1 is swapped": {
+			currentTick:           DefaultLowerTick - 100,
+			amount0:               sdk.NewInt(1000),
+			amount1:               sdk.NewInt(5000),
+			expectedAmount0ToSwap: sdk.ZeroInt(),
+			expectedAmount1ToSwap: sdk.NewInt(5000),
+		},
+		"position below the current price: all token0 is swapped": {
+			currentTick:           DefaultUpperTick,
+			amount0:               sdk.NewInt(1000),
+			amount1:               sdk.NewInt(5000),
+			expectedAmount0ToSwap: sdk.NewInt(1000),
+			expectedAmount1ToSwap: sdk.ZeroInt(),
+		},
+		"amounts of the position: only the rounding error is swapped": {
+			currentTick:           31000000,
+			amount0:               DefaultAmt0Expected,
+			amount1:               DefaultAmt1Expected,
+			expectedAmount0ToSwap: sdk.ZeroInt(),
+			expectedAmount1ToSwap: sdk.NewInt(1546),
+		},
+		"only token0: part of it is swapped": {
+			currentTick:           31000000,
+			amount0:               DefaultAmt0,
+			amount1:               sdk.ZeroInt(),
+			expectedAmount0ToSwap: sdk.NewInt(500255),
+			expectedAmount1ToSwap: sdk.ZeroInt(),
+		},
+		"only token1: part of it is swapped": {
+			currentTick:           31000000,
+			amount0:               sdk.ZeroInt(),
+			amount1:               DefaultAmt1,
+			expectedAmount0ToSwap: sdk.ZeroInt(),
+			expectedAmount1ToSwap: sdk.NewInt(2498720118),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			pool, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			position, err := s.clk.GetPosition(s.Ctx, 1)
+			s.Require().NoError(err)
+
+			pool.SetCurrentTick(tc.currentTick)
+
+			// System under test.
+			amount0ToSwap, amount1ToSwap, err := cl.AmountsToSwapToPositionRatio(pool, position, tc.amount0, tc.amount1)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedAmount0ToSwap.String(), amount0ToSwap.String())
+			s.Require().Equal(tc.expectedAmount1ToSwap.String(), amount1ToSwap.String())
+		})
+	}
+}
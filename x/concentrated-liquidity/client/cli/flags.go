@@ -8,6 +8,8 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagDynamicSpreadFactorRecords = "dynamic-spread-factor-records"
+	FlagPoolIdsToRemove            = "pool-ids-to-remove"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -22,6 +22,11 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickLiquidityNetInDirection)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLimitOrderBookDepth)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetEffectiveSpreadFactor)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserAutoCompoundPositions)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRangeAtTime)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -131,3 +136,51 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetUserLimitOrders() (*osmocli.QueryDescriptor, *queryproto.UserLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "user-limit-orders [address]",
+			Short: "Query user's limit orders",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-limit-orders osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+			CustomFlagOverrides: poolIdFlagOverride,
+		},
+		&queryproto.UserLimitOrdersRequest{}
+}
+
+func GetLimitOrderBookDepth() (*osmocli.QueryDescriptor, *queryproto.LimitOrderBookDepthRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "limit-order-book-depth [pool-id]",
+		Short: "Query the amount of limit orders that is not filled yet at every tick of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} limit-order-book-depth 1`,
+	}, &queryproto.LimitOrderBookDepthRequest{}
+}
+
+func GetEffectiveSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.EffectiveSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "effective-spread-factor [pool-id]",
+		Short: "Query the spread factor that swaps of a pool are currently charged",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} effective-spread-factor 1`,
+	}, &queryproto.EffectiveSpreadFactorRequest{}
+}
+
+func GetUserAutoCompoundPositions() (*osmocli.QueryDescriptor, *queryproto.UserAutoCompoundPositionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "user-auto-compound-positions [address]",
+		Short: "Query user's positions opted in auto-compounding and when they were last compounded",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-auto-compound-positions osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &queryproto.UserAutoCompoundPositionsRequest{}
+}
+
+func GetLiquidityPerTickRangeAtTime() (*osmocli.QueryDescriptor, *queryproto.LiquidityPerTickRangeAtTimeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-per-tick-range-at-time [pool-id] [time] [lower-tick] [upper-tick]",
+		Short: "Query the liquidity depth of a pool within a tick window, as of the latest snapshot at or before a given time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-per-tick-range-at-time 1 1689000000 "[-18000000]" 9000000`,
+	}, &queryproto.LiquidityPerTickRangeAtTimeRequest{}
+}
@@ -4,17 +4,20 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
@@ -29,6 +32,13 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectSpreadRewardsCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
+	osmocli.AddTxCmd(txCmd, NewMergePositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitPositionCmd)
 	return txCmd
 }
 
@@ -69,6 +79,31 @@ func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	}, &types.MsgWithdrawPosition{}
 }
 
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order [pool-id] [tick-index] [token-in]",
+		Short:   "place a limit order selling token-in at the price of the given tick",
+		Long:    "orders selling token0 of the pool must be placed above the current tick, orders selling token1 at or below it",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 \"[-69000]\" 10000uosmo --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-limit-order [order-id]",
+		Short:   "cancel a limit order, refunding the part that is not filled yet and claiming its proceeds",
+		Example: "osmosisd tx concentratedliquidity cancel-limit-order 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order [order-id]",
+		Short:   "claim the proceeds of the filled part of a limit order",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
+
 func NewCollectSpreadRewardsCmd() (*osmocli.TxCliDesc, *types.MsgCollectSpreadRewards) {
 	return &osmocli.TxCliDesc{
 		Use:     "collect-spread-rewards [position-ids]",
@@ -93,6 +128,53 @@ func NewFungifyChargedPositionsCmd() (*osmocli.TxCliDesc, *types.MsgFungifyCharg
 	}, &types.MsgFungifyChargedPositions{}
 }
 
+func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-positions [position-ids] [new-owner]",
+		Short:   "transfer position(s) to a new owner, along with their unclaimed spread rewards and incentives",
+		Example: "osmosisd tx concentratedliquidity transfer-positions 1,2 osmo1... --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgTransferPositions{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound [position-id] [enabled]",
+		Short:   "opt a position in or out of auto-compounding of its spread rewards and incentives",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 1 true --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func NewMergePositionsCmd() (*osmocli.TxCliDesc, *types.MsgMergePositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "merge-positions [position-ids]",
+		Short:   "merge positions of the same pool and tick range into a new position, along with their unclaimed spread rewards and incentives",
+		Example: "osmosisd tx concentratedliquidity merge-positions 1,2 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+	}, &types.MsgMergePositions{}
+}
+
+func NewSplitPositionCmd() (*osmocli.TxCliDesc, *types.MsgSplitPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "split-position [position-id] [liquidity-amounts]",
+		Short:   "split a position into new positions with the given liquidity amounts, plus a new position with the remaining liquidity",
+		Example: "osmosisd tx concentratedliquidity split-position 1 100.5,200 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"LiquidityAmounts": parseLiquidityAmounts,
+		},
+	}, &types.MsgSplitPosition{}
+}
+
+func parseLiquidityAmounts(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	liquidityAmounts := []sdk.Dec{}
+	for _, liquidityStr := range strings.Split(arg, ",") {
+		liquidity, err := sdk.NewDecFromStr(liquidityStr)
+		if err != nil {
+			return nil, osmocli.UsedArg, err
+		}
+		liquidityAmounts = append(liquidityAmounts, liquidity)
+	}
+	return liquidityAmounts, osmocli.UsedArg, nil
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -207,6 +289,65 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSetDynamicSpreadFactorRecordsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dynamic-spread-factor-records-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to set and remove dynamic spread factor records of pools",
+		Long: strings.TrimSpace(`Submit a proposal to set and remove dynamic spread factor records of pools.
+
+Passing in FlagDynamicSpreadFactorRecords separated by commas would be parsed automatically to dynamic spread factor records of
+poolId, minSpreadFactor, maxSpreadFactor, volatilityMultiplier and volatilityWindow.
+Ex) --dynamic-spread-factor-records=1,0.001,0.01,0.5,1h -> [(poolId 1, minSpreadFactor 0.001, maxSpreadFactor 0.01, volatilityMultiplier 0.5, volatilityWindow 1h)]
+Passing in FlagPoolIdsToRemove separated by commas removes the dynamic spread factor records of the pools.
+Ex) --pool-ids-to-remove=2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseSetDynamicSpreadFactorRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagDynamicSpreadFactorRecords, "", "The dynamic spread factor records array")
+	cmd.Flags().String(FlagPoolIdsToRemove, "", "The pool ids whose dynamic spread factor records are removed")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -256,6 +397,97 @@ func parsePoolIdToTickSpacingRecordsArgsToContent(cmd *cobra.Command) (govtypes.
 	return content, nil
 }
 
+func parseSetDynamicSpreadFactorRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := parseDynamicSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdsToRemoveStr, err := cmd.Flags().GetString(FlagPoolIdsToRemove)
+	if err != nil {
+		return nil, err
+	}
+	poolIdsToRemove := []uint64{}
+	if poolIdsToRemoveStr != "" {
+		poolIdsToRemove, err = osmoutils.ParseUint64SliceFromString(poolIdsToRemoveStr, ",")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := &types.SetDynamicSpreadFactorRecordsProposal{
+		Title:           title,
+		Description:     description,
+		Records:         records,
+		PoolIdsToRemove: poolIdsToRemove,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorRecords(cmd *cobra.Command) ([]types.DynamicSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []types.DynamicSpreadFactorRecord{}
+	if recordsStr == "" {
+		return records, nil
+	}
+
+	recordFields := strings.Split(recordsStr, ",")
+	if len(recordFields)%5 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorRecords must be a list of poolId, minSpreadFactor, maxSpreadFactor, volatilityMultiplier, and volatilityWindow")
+	}
+
+	i := 0
+	for i < len(recordFields) {
+		poolId, err := strconv.ParseUint(recordFields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		minSpreadFactor, err := sdk.NewDecFromStr(recordFields[i+1])
+		if err != nil {
+			return nil, err
+		}
+		maxSpreadFactor, err := sdk.NewDecFromStr(recordFields[i+2])
+		if err != nil {
+			return nil, err
+		}
+		volatilityMultiplier, err := sdk.NewDecFromStr(recordFields[i+3])
+		if err != nil {
+			return nil, err
+		}
+		volatilityWindow, err := time.ParseDuration(recordFields[i+4])
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, types.DynamicSpreadFactorRecord{
+			PoolId:               poolId,
+			MinSpreadFactor:      minSpreadFactor,
+			MaxSpreadFactor:      maxSpreadFactor,
+			VolatilityMultiplier: volatilityMultiplier,
+			VolatilityWindow:     volatilityWindow,
+		})
+
+		// increase counter by the next 5
+		i = i + 5
+	}
+
+	return records, nil
+}
+
 func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSpacingRecord, error) {
 	assetsStr, err := cmd.Flags().GetString(FlagPoolIdToTickSpacingRecords)
 	if err != nil {
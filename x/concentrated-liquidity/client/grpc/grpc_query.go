@@ -40,6 +40,16 @@ func (q Querier) UserPositions(grpcCtx context.Context,
 	return q.Q.UserPositions(ctx, *req)
 }
 
+func (q Querier) UserLimitOrders(grpcCtx context.Context,
+	req *queryproto.UserLimitOrdersRequest,
+) (*queryproto.UserLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserLimitOrders(ctx, *req)
+}
+
 func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	req *queryproto.TickAccumulatorTrackersRequest,
 ) (*queryproto.TickAccumulatorTrackersResponse, error) {
@@ -90,6 +100,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) LiquidityPerTickRangeAtTime(grpcCtx context.Context,
+	req *queryproto.LiquidityPerTickRangeAtTimeRequest,
+) (*queryproto.LiquidityPerTickRangeAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LiquidityPerTickRangeAtTime(ctx, *req)
+}
+
 func (q Querier) LiquidityPerTickRange(grpcCtx context.Context,
 	req *queryproto.LiquidityPerTickRangeRequest,
 ) (*queryproto.LiquidityPerTickRangeResponse, error) {
@@ -110,6 +130,36 @@ func (q Querier) LiquidityNetInDirection(grpcCtx context.Context,
 	return q.Q.LiquidityNetInDirection(ctx, *req)
 }
 
+func (q Querier) LimitOrderBookDepth(grpcCtx context.Context,
+	req *queryproto.LimitOrderBookDepthRequest,
+) (*queryproto.LimitOrderBookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LimitOrderBookDepth(ctx, *req)
+}
+
+func (q Querier) EffectiveSpreadFactor(grpcCtx context.Context,
+	req *queryproto.EffectiveSpreadFactorRequest,
+) (*queryproto.EffectiveSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EffectiveSpreadFactor(ctx, *req)
+}
+
+func (q Querier) UserAutoCompoundPositions(grpcCtx context.Context,
+	req *queryproto.UserAutoCompoundPositionsRequest,
+) (*queryproto.UserAutoCompoundPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserAutoCompoundPositions(ctx, *req)
+}
+
 func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	req *queryproto.IncentiveRecordsRequest,
 ) (*queryproto.IncentiveRecordsResponse, error) {
@@ -10,4 +10,5 @@ import (
 var (
 	TickSpacingDecreaseProposalHandler             = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal, rest.ProposalTickSpacingDecreaseRESTHandler)
 	CreateConcentratedLiquidityPoolProposalHandler = govclient.NewProposalHandler(cli.NewCmdCreateConcentratedLiquidityPoolsProposal, rest.ProposalCreateConcentratedLiquidityPoolHandler)
+	SetDynamicSpreadFactorRecordsProposalHandler   = govclient.NewProposalHandler(cli.NewSetDynamicSpreadFactorRecordsProposal, rest.ProposalSetDynamicSpreadFactorRecordsHandler)
 )
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -9,6 +11,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity"
 	clquery "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
 )
 
 // Querier defines a wrapper around the x/concentrated-liquidity keeper providing gRPC method
@@ -123,6 +126,20 @@ func (q Querier) LiquidityPerTickRange(ctx sdk.Context, req clquery.LiquidityPer
 	return &clquery.LiquidityPerTickRangeResponse{Liquidity: liquidity}, nil
 }
 
+// LiquidityPerTickRangeAtTime returns the amount of liquidity per every tick range within the given tick window
+// of the given pool, as of the newest tick liquidity snapshot taken at or before the given time.
+func (q Querier) LiquidityPerTickRangeAtTime(ctx sdk.Context, req clquery.LiquidityPerTickRangeAtTimeRequest) (*clquery.LiquidityPerTickRangeAtTimeResponse, error) {
+	liquidity, snapshotTime, err := q.Keeper.GetLiquidityPerTickRangeAtTime(ctx, req.PoolId, req.Time, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clquery.LiquidityPerTickRangeAtTimeResponse{
+		Liquidity:    liquidity,
+		SnapshotTime: snapshotTime,
+	}, nil
+}
+
 // LiquidityNetInDirection returns an array of LiquidityDepthWithRange, which contains the range(lower tick and upper tick) and the liquidity amount in the range.
 func (q Querier) LiquidityNetInDirection(ctx sdk.Context, req clquery.LiquidityNetInDirectionRequest) (*clquery.LiquidityNetInDirectionResponse, error) {
 	if req.TokenIn == "" {
@@ -288,3 +305,77 @@ func (q Querier) GetTotalLiquidity(ctx sdk.Context, req clquery.GetTotalLiquidit
 		TotalLiquidity: totalLiquidity,
 	}, nil
 }
+
+// UserLimitOrders returns the limit orders of a specified address. Each order is broken down by:
+// - the limit order itself
+// - the amount of the order that is not filled yet
+// - the proceeds that can be claimed by the order
+func (q Querier) UserLimitOrders(ctx sdk.Context, req clquery.UserLimitOrdersRequest) (*clquery.UserLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fullLimitOrders, pageRes, err := q.Keeper.GetUserLimitOrdersSerialized(ctx, sdkAddr, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserLimitOrdersResponse{
+		LimitOrders: fullLimitOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// LimitOrderBookDepth returns the amount of limit orders that is not filled yet at every tick of the given pool.
+func (q Querier) LimitOrderBookDepth(ctx sdk.Context, req clquery.LimitOrderBookDepthRequest) (*clquery.LimitOrderBookDepthResponse, error) {
+	asks, bids, err := q.Keeper.GetLimitOrderBookDepth(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.LimitOrderBookDepthResponse{
+		Asks: asks,
+		Bids: bids,
+	}, nil
+}
+
+// EffectiveSpreadFactor returns the spread factor that swaps of the given pool are currently charged,
+// along with the dynamic spread factor record of the pool if it is in the dynamic spread factor mode.
+func (q Querier) EffectiveSpreadFactor(ctx sdk.Context, req clquery.EffectiveSpreadFactorRequest) (*clquery.EffectiveSpreadFactorResponse, error) {
+	spreadFactor, err := q.Keeper.GetEffectiveSpreadFactor(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var dynamicSpreadFactorRecord *types.DynamicSpreadFactorRecord
+	record, err := q.Keeper.GetDynamicSpreadFactorRecord(ctx, req.PoolId)
+	if err == nil {
+		dynamicSpreadFactorRecord = &record
+	} else if !errors.Is(err, types.DynamicSpreadFactorRecordNotFoundError{PoolId: req.PoolId}) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.EffectiveSpreadFactorResponse{
+		SpreadFactor:              spreadFactor,
+		DynamicSpreadFactorRecord: dynamicSpreadFactorRecord,
+	}, nil
+}
+
+// UserAutoCompoundPositions returns the positions of the given address that are opted in auto-compounding,
+// along with the last time that they were compounded.
+func (q Querier) UserAutoCompoundPositions(ctx sdk.Context, req clquery.UserAutoCompoundPositionsRequest) (*clquery.UserAutoCompoundPositionsResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	positions, err := q.Keeper.GetUserAutoCompoundPositions(ctx, sdkAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserAutoCompoundPositionsResponse{
+		Positions: positions,
+	}, nil
+}
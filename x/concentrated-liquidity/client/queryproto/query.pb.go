@@ -15,6 +15,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	model "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -24,12 +25,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1505,1271 +1508,1536 @@ func (m *GetTotalLiquidityResponse) GetTotalLiquidity() github_com_cosmos_cosmos
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
-	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
-	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
-	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
-	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
-	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
-	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
-	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
-	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
-	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
-	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
-	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
-	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
-	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
-	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
-	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
-	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
-	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
-	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
-	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
-	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
-	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
-	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+// =============================== UserLimitOrders
+type UserLimitOrdersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId     uint64             `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentrated-liquidity/query.proto", fileDescriptor_5c83e18b11fd607d)
+func (m *UserLimitOrdersRequest) Reset()         { *m = UserLimitOrdersRequest{} }
+func (m *UserLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersRequest) ProtoMessage()    {}
+func (*UserLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{30}
 }
-
-var fileDescriptor_5c83e18b11fd607d = []byte{
-	// 2131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x4d, 0x7e, 0xe7, 0xc5, 0xf9, 0x2b, 0x3b, 0xf6, 0x64, 0x92, 0xcc, 0x84, 0x82, 0xdd,
-	0xb5, 0x36, 0xf1, 0x0c, 0xf9, 0x31, 0x21, 0x7f, 0xbb, 0xeb, 0xb1, 0xd7, 0xd6, 0xb0, 0x4e, 0xd6,
-	0xe9, 0x8d, 0x01, 0x71, 0xa0, 0xe9, 0xe9, 0x2e, 0x8f, 0x5b, 0xd3, 0xd3, 0x35, 0xee, 0xae, 0xb6,
-	0xd7, 0x5a, 0x22, 0xad, 0xd8, 0x23, 0x12, 0x2c, 0xe2, 0xce, 0x85, 0x03, 0x08, 0x71, 0x84, 0x03,
-	0xdc, 0xe0, 0x80, 0x22, 0x0e, 0xab, 0x95, 0x10, 0x12, 0xda, 0x83, 0x17, 0x12, 0x0e, 0x48, 0x8b,
-	0x38, 0x98, 0xcb, 0x4a, 0x5c, 0x50, 0x57, 0x57, 0xff, 0xcc, 0xb8, 0xc7, 0xee, 0x19, 0x7b, 0x4f,
-	0x99, 0xea, 0xf7, 0xfb, 0xbd, 0xf7, 0xea, 0x55, 0xbd, 0x72, 0xe0, 0x55, 0xe6, 0xb6, 0x99, 0x6b,
-	0xba, 0x55, 0x9d, 0xd9, 0x3a, 0xb5, 0xb9, 0xa3, 0x71, 0x6a, 0x4c, 0x59, 0xe6, 0x9a, 0x67, 0x1a,
-	0x26, 0xdf, 0xac, 0xae, 0x79, 0xd4, 0xd9, 0xac, 0x74, 0x1c, 0xc6, 0x19, 0x7e, 0x49, 0xf2, 0x56,
-	0x92, 0xbc, 0x11, 0x6b, 0x65, 0xfd, 0x7a, 0x83, 0x72, 0xed, 0x7a, 0x71, 0xac, 0xc9, 0x9a, 0x4c,
-	0x48, 0x54, 0xfd, 0x5f, 0x81, 0x70, 0xf1, 0xea, 0x1e, 0x86, 0x3a, 0x9a, 0xa3, 0xb5, 0x5d, 0xc9,
-	0x3c, 0xb5, 0x07, 0x33, 0x37, 0xf5, 0x56, 0xdd, 0x5e, 0x09, 0x75, 0x97, 0x74, 0xc1, 0x5f, 0x6d,
-	0x68, 0x2e, 0xad, 0x4a, 0x37, 0xaa, 0x3a, 0x33, 0x6d, 0x49, 0x7f, 0x35, 0x49, 0x17, 0x88, 0x22,
-	0xae, 0x8e, 0xd6, 0x34, 0x6d, 0x8d, 0x9b, 0x2c, 0xe4, 0xbd, 0xd4, 0x64, 0xac, 0x69, 0xd1, 0xaa,
-	0xd6, 0x31, 0xab, 0x9a, 0x6d, 0x33, 0x2e, 0x88, 0xa1, 0x63, 0x17, 0x24, 0x55, 0xac, 0x1a, 0xde,
-	0x4a, 0x55, 0xb3, 0x37, 0x43, 0x52, 0x60, 0x44, 0x0d, 0x90, 0x07, 0x0b, 0x49, 0x2a, 0xf7, 0x4a,
-	0x71, 0xb3, 0x4d, 0x5d, 0xae, 0xb5, 0x3b, 0x21, 0x80, 0x5e, 0x06, 0xc3, 0x73, 0x92, 0x4e, 0xed,
-	0x15, 0x8f, 0x0e, 0x73, 0xcd, 0x04, 0xfb, 0xf4, 0x1e, 0xec, 0xa6, 0xf8, 0x6a, 0xae, 0x53, 0xd5,
-	0xa1, 0x3a, 0x73, 0x8c, 0x40, 0x8c, 0xfc, 0x0e, 0xc1, 0xd8, 0xb2, 0x4b, 0x9d, 0x25, 0xa9, 0xcd,
-	0x55, 0xe8, 0x9a, 0x47, 0x5d, 0x8e, 0xaf, 0xc1, 0x71, 0xcd, 0x30, 0x1c, 0xea, 0xba, 0x05, 0x74,
-	0x05, 0x4d, 0xe6, 0x6b, 0x78, 0x7b, 0xab, 0x7c, 0x7a, 0x53, 0x6b, 0x5b, 0x77, 0x89, 0x24, 0x10,
-	0x25, 0x64, 0xc1, 0x57, 0xe1, 0x78, 0x87, 0x31, 0x4b, 0x35, 0x8d, 0x42, 0xee, 0x0a, 0x9a, 0x3c,
-	0x92, 0xe4, 0x96, 0x04, 0xa2, 0x1c, 0xf3, 0x7f, 0xd5, 0x0d, 0x3c, 0x0f, 0x10, 0xa7, 0xa0, 0x70,
-	0xf8, 0x0a, 0x9a, 0x3c, 0x79, 0xe3, 0xe5, 0x8a, 0x8c, 0x9e, 0x9f, 0xaf, 0x4a, 0x50, 0x81, 0x32,
-	0x5f, 0x95, 0x25, 0xad, 0x49, 0xa5, 0x5b, 0x4a, 0x42, 0x92, 0xfc, 0x11, 0xc1, 0xf9, 0x1e, 0xdf,
-	0xdd, 0x0e, 0xb3, 0x5d, 0x8a, 0xbf, 0x07, 0xf9, 0x30, 0x3c, 0xbe, 0xfb, 0x87, 0x27, 0x4f, 0xde,
-	0xb8, 0x5f, 0xc9, 0x54, 0xc9, 0x95, 0x79, 0xcf, 0xb2, 0x42, 0x85, 0x35, 0x87, 0x6a, 0x2d, 0x83,
-	0x6d, 0xd8, 0xb5, 0x23, 0xcf, 0xb6, 0xca, 0x87, 0x94, 0x58, 0x29, 0x5e, 0xe8, 0xc2, 0x90, 0x13,
-	0x18, 0x5e, 0xd9, 0x13, 0x43, 0xe0, 0x5e, 0x17, 0x88, 0x47, 0x30, 0x1a, 0x99, 0xdb, 0xac, 0x1b,
-	0x61, 0xf8, 0x6f, 0xc3, 0xc9, 0xd0, 0x98, 0x1f, 0x54, 0x24, 0x82, 0x3a, 0xbe, 0xbd, 0x55, 0xc6,
-	0x61, 0x50, 0x23, 0x22, 0x51, 0x20, 0x5c, 0xd5, 0x0d, 0xb2, 0x0e, 0x63, 0xdd, 0xfa, 0x64, 0x48,
-	0xbe, 0x0b, 0x27, 0x42, 0x2e, 0xa1, 0xed, 0x60, 0x22, 0x12, 0xe9, 0x24, 0xdf, 0x84, 0x91, 0x25,
-	0xc6, 0xac, 0xa8, 0x7e, 0xe6, 0x53, 0x02, 0x34, 0x4c, 0x92, 0x7f, 0x8c, 0xe0, 0x94, 0x54, 0x2c,
-	0x91, 0x4c, 0xc3, 0x51, 0xbf, 0x90, 0xc2, 0xc4, 0x8e, 0x55, 0x82, 0x8d, 0x54, 0x09, 0x37, 0x52,
-	0x65, 0xc6, 0xde, 0xac, 0xe5, 0xff, 0xfc, 0x9b, 0xa9, 0xa3, 0xbe, 0x5c, 0x5d, 0x09, 0xb8, 0x0f,
-	0x2e, 0x63, 0x67, 0xe0, 0xd4, 0x92, 0x68, 0x5c, 0xd2, 0x5d, 0xb2, 0x0c, 0xa7, 0xc3, 0x0f, 0xd2,
-	0xc5, 0x59, 0x38, 0x16, 0xf4, 0x36, 0x19, 0xea, 0x97, 0xf6, 0x08, 0x75, 0x20, 0x2e, 0x63, 0x2a,
-	0x45, 0xc9, 0x6f, 0x11, 0x9c, 0x7d, 0x62, 0xea, 0xad, 0xc5, 0x90, 0xed, 0x11, 0xe5, 0xb8, 0x05,
-	0xa7, 0x22, 0x31, 0xd5, 0xa6, 0x5c, 0x6e, 0xce, 0x79, 0x5f, 0xf2, 0x93, 0xad, 0xf2, 0xcb, 0x4d,
-	0x93, 0xaf, 0x7a, 0x8d, 0x8a, 0xce, 0xda, 0xb2, 0x1d, 0xc9, 0x7f, 0xa6, 0x5c, 0xa3, 0x55, 0xe5,
-	0x9b, 0x1d, 0xea, 0x56, 0xe6, 0xa8, 0xbe, 0xbd, 0x55, 0x1e, 0x0b, 0xea, 0xa8, 0x4b, 0x19, 0x51,
-	0x46, 0xac, 0xa4, 0xb1, 0x5b, 0x00, 0x7e, 0xd7, 0x55, 0x4d, 0xdb, 0xa0, 0xef, 0x8a, 0x90, 0x1d,
-	0xae, 0x9d, 0xdf, 0xde, 0x2a, 0x9f, 0x0b, 0x64, 0x63, 0x1a, 0x51, 0xf2, 0x41, 0x7b, 0xf6, 0x7f,
-	0x7f, 0x8e, 0x60, 0x22, 0xf2, 0x79, 0x8e, 0x76, 0xf8, 0xea, 0xb7, 0x4c, 0xbe, 0xaa, 0x68, 0x76,
-	0x93, 0xe2, 0x35, 0x38, 0x1b, 0x5b, 0xd4, 0xda, 0xcc, 0xb3, 0x0f, 0x1a, 0xc1, 0x99, 0x68, 0x3d,
-	0x23, 0xd4, 0xfb, 0x20, 0x2c, 0xb6, 0x41, 0x1d, 0xd5, 0xf7, 0x70, 0x27, 0x88, 0x98, 0x46, 0x94,
-	0xbc, 0x58, 0xf8, 0x31, 0xf7, 0xa5, 0xbc, 0x4e, 0x27, 0x94, 0x3a, 0xdc, 0x2b, 0x15, 0xd3, 0x88,
-	0x92, 0x17, 0x0b, 0x5f, 0x8a, 0x7c, 0x9a, 0x83, 0x52, 0x32, 0x5d, 0x75, 0x7b, 0xce, 0x74, 0xa8,
-	0xee, 0x97, 0x4d, 0xb8, 0x2f, 0x12, 0x9d, 0x12, 0xed, 0xd9, 0x29, 0x2b, 0x70, 0x82, 0xb3, 0x16,
-	0xb5, 0x55, 0x33, 0xa8, 0xd8, 0x7c, 0x6d, 0x74, 0x7b, 0xab, 0x7c, 0x46, 0x86, 0x5f, 0x52, 0x88,
-	0x72, 0x5c, 0xfc, 0xac, 0xdb, 0xbe, 0xd7, 0x2e, 0xd7, 0x1c, 0xde, 0xc7, 0xeb, 0x98, 0x46, 0x94,
-	0xbc, 0x58, 0x08, 0xac, 0x77, 0x60, 0xc4, 0x73, 0xa9, 0xaa, 0x7b, 0x12, 0xed, 0x91, 0x2b, 0x68,
-	0xf2, 0x44, 0x6d, 0x62, 0x7b, 0xab, 0x3c, 0x2a, 0xd1, 0x26, 0xa8, 0x44, 0x01, 0xcf, 0xa5, 0xb3,
-	0x5e, 0x14, 0xa6, 0x06, 0xf3, 0x6c, 0x23, 0x10, 0x3c, 0xda, 0x6b, 0x30, 0xa6, 0x11, 0x25, 0x2f,
-	0x16, 0x49, 0x83, 0x36, 0x53, 0xc5, 0xb7, 0xc2, 0xb1, 0x34, 0x83, 0x21, 0x35, 0x30, 0xf8, 0x88,
-	0xd5, 0xc4, 0xe2, 0x17, 0x39, 0x28, 0xf7, 0x8d, 0xb0, 0xdc, 0x7d, 0xab, 0xc9, 0x22, 0x33, 0xfc,
-	0x02, 0x0c, 0x7b, 0xc5, 0xed, 0x8c, 0x2d, 0xaf, 0x77, 0xdb, 0xc9, 0x9d, 0x19, 0xd7, 0x96, 0x28,
-	0x6b, 0x17, 0x7f, 0x09, 0x46, 0x74, 0xcf, 0x71, 0xa8, 0xcd, 0x13, 0xd5, 0xa5, 0x9c, 0x94, 0xdf,
-	0x04, 0xd6, 0x0d, 0x38, 0x17, 0xb2, 0x44, 0xd2, 0x22, 0x33, 0xf9, 0xda, 0x37, 0x06, 0x2e, 0xf9,
-	0x42, 0x10, 0x9e, 0x1d, 0x0a, 0x89, 0x72, 0x56, 0x7e, 0x8b, 0xbc, 0x26, 0x6f, 0xc1, 0xa5, 0x68,
-	0xb1, 0x14, 0xd4, 0xa7, 0xd8, 0x83, 0xc3, 0x14, 0x22, 0xf9, 0x00, 0xc1, 0xe5, 0x3e, 0xda, 0x64,
-	0xd0, 0x1b, 0x90, 0x8f, 0xf1, 0x05, 0xd1, 0x7e, 0x2d, 0x63, 0xb4, 0xfb, 0x34, 0x8b, 0xf0, 0xd0,
-	0x8d, 0x51, 0x7e, 0x1b, 0x2e, 0xcf, 0x5a, 0x9a, 0xd9, 0xd6, 0x1a, 0x16, 0x7d, 0xa7, 0xe3, 0x50,
-	0xcd, 0x50, 0xe8, 0x86, 0xe6, 0x18, 0xee, 0xbe, 0x4f, 0xcd, 0x9f, 0x21, 0x28, 0xf5, 0x53, 0x2d,
-	0x01, 0x7e, 0x1f, 0x0a, 0x7a, 0xc8, 0xa1, 0xba, 0x82, 0x45, 0x75, 0x02, 0x1e, 0x89, 0xf7, 0x42,
-	0xd7, 0x69, 0x12, 0xa2, 0x9b, 0x65, 0xa6, 0x5d, 0x7b, 0xc5, 0x87, 0xb2, 0xbd, 0x55, 0x2e, 0xcb,
-	0x04, 0xf6, 0x51, 0x44, 0x94, 0x71, 0x3d, 0xd5, 0x0b, 0xb2, 0x0c, 0xc5, 0xc8, 0xbf, 0x7a, 0x78,
-	0x95, 0xdb, 0x3f, 0xee, 0x0f, 0x72, 0x70, 0x31, 0x55, 0xaf, 0x04, 0xbd, 0x06, 0x63, 0xb1, 0xaf,
-	0xd1, 0x15, 0x32, 0x03, 0xe0, 0x2f, 0x4b, 0xc0, 0x17, 0x7b, 0x01, 0xc7, 0x4a, 0x88, 0x32, 0xaa,
-	0xef, 0x34, 0xed, 0x9b, 0x5c, 0x61, 0xce, 0x0a, 0x35, 0x39, 0x35, 0x92, 0x26, 0x73, 0x03, 0x9a,
-	0x4c, 0x53, 0x42, 0x94, 0xd1, 0xe8, 0x73, 0x6c, 0x92, 0x2c, 0xc2, 0x65, 0xff, 0xaa, 0x30, 0xa3,
-	0xeb, 0x5e, 0xdb, 0xb3, 0x34, 0xce, 0x9c, 0x9e, 0xba, 0x1a, 0x68, 0xaf, 0xfc, 0x21, 0x07, 0xa5,
-	0x7e, 0xea, 0x64, 0x58, 0x3f, 0x44, 0x70, 0xb1, 0x2b, 0xf3, 0x6a, 0xd3, 0x61, 0x1b, 0x7c, 0x55,
-	0x6d, 0x5a, 0xac, 0xa1, 0x59, 0x32, 0xbc, 0x97, 0x52, 0xb1, 0xce, 0x51, 0x5d, 0xc0, 0xbd, 0xe9,
-	0xc3, 0xfd, 0xd5, 0xa7, 0xe5, 0xab, 0xd9, 0xba, 0x87, 0x2f, 0xe3, 0x2a, 0x05, 0x37, 0x51, 0x55,
-	0x0b, 0xc2, 0xe6, 0x82, 0x30, 0x89, 0x7f, 0x88, 0x60, 0xcc, 0xeb, 0xf8, 0x43, 0x4a, 0x8f, 0x2f,
-	0x41, 0xdc, 0x6f, 0x65, 0xdc, 0xcb, 0xcb, 0x42, 0xc5, 0x13, 0x47, 0xd3, 0x5b, 0xd4, 0xe9, 0x4d,
-	0x49, 0x9a, 0x7e, 0xa2, 0xe0, 0xe0, 0x73, 0xd2, 0x1b, 0xbf, 0xdf, 0x94, 0xfc, 0x1e, 0x93, 0x88,
-	0xa1, 0xd4, 0x39, 0x54, 0x4e, 0x86, 0xbc, 0xc9, 0x7c, 0x96, 0x83, 0x72, 0x5f, 0x2f, 0x64, 0x2a,
-	0x9f, 0x21, 0xb8, 0x93, 0x9a, 0x4a, 0xd6, 0x11, 0xfb, 0x8c, 0xaa, 0x46, 0x78, 0x40, 0xa9, 0x6c,
-	0x45, 0xb5, 0x34, 0x97, 0xab, 0xdc, 0xd1, 0xd6, 0xa9, 0xe3, 0x7e, 0x91, 0x89, 0xbe, 0xb1, 0x33,
-	0xd1, 0x6f, 0x4b, 0x87, 0xa2, 0x03, 0xf3, 0xed, 0x95, 0x45, 0xcd, 0xe5, 0x4f, 0x42, 0x67, 0xf0,
-	0x53, 0x38, 0x23, 0x33, 0xc4, 0x25, 0xca, 0x7d, 0x25, 0xbf, 0x24, 0x93, 0x3f, 0xde, 0x95, 0xfc,
-	0x50, 0x35, 0x51, 0x4e, 0x7b, 0x49, 0x76, 0x97, 0xfc, 0x08, 0xc1, 0x44, 0xb4, 0x29, 0x15, 0x31,
-	0xa4, 0x0e, 0x97, 0xec, 0x83, 0x1a, 0x3d, 0x3e, 0x42, 0x50, 0xd8, 0xe9, 0x90, 0xcc, 0xbb, 0x09,
-	0xe7, 0x7a, 0x47, 0xea, 0xb0, 0x2d, 0x7e, 0x2d, 0x63, 0xb8, 0x7a, 0x74, 0xcb, 0xf3, 0xee, 0xac,
-	0xd9, 0x63, 0xf2, 0xe0, 0x26, 0x97, 0xf7, 0x11, 0x5c, 0x9d, 0x9d, 0x7f, 0xf8, 0x50, 0xcc, 0x45,
-	0xc6, 0xa2, 0x69, 0xb7, 0xe6, 0x1d, 0xd6, 0x9e, 0x4d, 0x38, 0x19, 0x50, 0xc2, 0xa8, 0x3f, 0x86,
-	0xb1, 0x24, 0x02, 0xb5, 0x3b, 0x05, 0xe5, 0x44, 0x7b, 0x4f, 0xe1, 0x22, 0x0a, 0xd6, 0x77, 0x68,
-	0x26, 0x26, 0x5c, 0xcb, 0xe6, 0x81, 0x0c, 0xf3, 0x1d, 0x18, 0xd1, 0x57, 0xda, 0xed, 0x1e, 0xd3,
-	0x89, 0xab, 0x62, 0x92, 0x4a, 0x14, 0xf0, 0x97, 0xd2, 0xd4, 0x43, 0xb8, 0xbc, 0xec, 0x52, 0x67,
-	0xd9, 0x6e, 0x30, 0xdb, 0x30, 0xed, 0xe6, 0xfe, 0x9e, 0x38, 0xc8, 0xcf, 0x11, 0x94, 0xfa, 0xe9,
-	0x93, 0xce, 0xbe, 0x8f, 0xa0, 0x18, 0x3d, 0x11, 0xa8, 0x1b, 0x26, 0x5f, 0x55, 0x3b, 0xd4, 0x31,
-	0x99, 0xa1, 0x5a, 0x4c, 0x6f, 0xc9, 0xea, 0x78, 0x90, 0xb1, 0x3a, 0x42, 0xf5, 0xfe, 0x7d, 0x68,
-	0x49, 0x68, 0x59, 0x64, 0x7a, 0x4b, 0x16, 0xc9, 0x44, 0x64, 0xa6, 0x9b, 0x4c, 0x8a, 0x50, 0x58,
-	0xa0, 0xfc, 0x09, 0xe3, 0x9a, 0x15, 0x5d, 0xab, 0xc2, 0x39, 0xf5, 0x27, 0x08, 0x2e, 0xa4, 0x10,
-	0xa5, 0xf3, 0x1c, 0xce, 0x70, 0x9f, 0xa2, 0xf6, 0x5e, 0xe3, 0x76, 0x39, 0x72, 0xbf, 0x2a, 0x5b,
-	0xd3, 0x64, 0x86, 0xd6, 0x14, 0xf4, 0xa5, 0xd3, 0xbc, 0xcb, 0xfa, 0x8d, 0xff, 0x15, 0xe0, 0xe8,
-	0x63, 0xbf, 0x7a, 0xf1, 0x2f, 0x11, 0x88, 0x81, 0xdd, 0xc5, 0x37, 0x33, 0x47, 0x28, 0x7e, 0x6f,
-	0x28, 0xde, 0x1a, 0x4c, 0x28, 0x00, 0x4d, 0x6e, 0xfd, 0xe0, 0x2f, 0xff, 0xfc, 0x69, 0xae, 0x82,
-	0xaf, 0x55, 0xd3, 0x9e, 0xcf, 0xe2, 0xd7, 0xb3, 0xe8, 0xe9, 0x50, 0x38, 0xf8, 0x6b, 0x04, 0xc7,
-	0x82, 0x91, 0x1d, 0x67, 0x36, 0x9b, 0x7c, 0x31, 0x28, 0x4e, 0x0f, 0x28, 0x25, 0xbd, 0x9d, 0x16,
-	0xde, 0x56, 0xf1, 0x54, 0x56, 0x6f, 0x03, 0x1f, 0x3f, 0x42, 0x70, 0xaa, 0xeb, 0x9d, 0x0c, 0xdf,
-	0xcb, 0xda, 0xd0, 0x53, 0x5e, 0x06, 0x8b, 0xf7, 0x87, 0x13, 0x96, 0x18, 0x6a, 0x02, 0xc3, 0x7d,
-	0x7c, 0x37, 0x73, 0xc4, 0xa5, 0x86, 0xea, 0x7b, 0x72, 0x27, 0x3e, 0xc5, 0x9f, 0x21, 0x38, 0x9f,
-	0x3a, 0x8d, 0xe0, 0xd9, 0x41, 0x47, 0x8e, 0x94, 0xc9, 0xa8, 0x38, 0xb7, 0x3f, 0x25, 0x12, 0xe8,
-	0x82, 0x00, 0x3a, 0x83, 0x5f, 0xcf, 0x08, 0x34, 0x1e, 0x59, 0xc3, 0xa7, 0x05, 0xd5, 0x11, 0x98,
-	0xfe, 0x9b, 0x7c, 0x4f, 0xe9, 0x1e, 0x79, 0xf1, 0x9b, 0x83, 0xba, 0x9a, 0xfa, 0x28, 0x51, 0x9c,
-	0xdf, 0xaf, 0x1a, 0x89, 0xb9, 0x2e, 0x30, 0xcf, 0xe2, 0x99, 0x81, 0x31, 0xdb, 0x94, 0xab, 0xa6,
-	0x1d, 0xdf, 0x95, 0xf0, 0x7f, 0x10, 0x8c, 0xa7, 0x4f, 0x64, 0x38, 0x6b, 0x7e, 0x76, 0x9d, 0x15,
-	0x8b, 0x6f, 0xee, 0x53, 0xcb, 0x90, 0x69, 0xee, 0x37, 0xfa, 0xe1, 0x7f, 0x20, 0x18, 0x4d, 0x19,
-	0xc5, 0xf0, 0xcc, 0xa0, 0x7e, 0xee, 0x18, 0x0f, 0x8b, 0xb5, 0xfd, 0xa8, 0x90, 0x38, 0x67, 0x05,
-	0xce, 0x07, 0xf8, 0xde, 0xc0, 0x38, 0xe3, 0xf1, 0x0b, 0xff, 0x09, 0xc1, 0x48, 0xf2, 0x75, 0x1a,
-	0xdf, 0x1d, 0xf0, 0x30, 0x4c, 0x3c, 0x91, 0x17, 0xef, 0x0d, 0x25, 0x2b, 0xe1, 0x3c, 0x10, 0x70,
-	0x6e, 0xe3, 0xe9, 0x01, 0xdb, 0x90, 0xda, 0xd8, 0x54, 0x4d, 0x03, 0xff, 0x0b, 0xc1, 0x78, 0xfa,
-	0x8c, 0x97, 0xb9, 0x3a, 0x77, 0x9d, 0x38, 0x33, 0x57, 0xe7, 0xee, 0x83, 0x26, 0x99, 0x11, 0x30,
-	0xef, 0xe1, 0x3b, 0x03, 0x9c, 0x6f, 0xaa, 0xe6, 0xeb, 0x8b, 0xea, 0xf2, 0xaf, 0x08, 0xce, 0xf6,
-	0xde, 0x82, 0xf1, 0x6b, 0xc3, 0x5d, 0x71, 0x23, 0x78, 0xaf, 0x0f, 0x2d, 0x2f, 0x81, 0xbd, 0x21,
-	0x80, 0xdd, 0xc5, 0x5f, 0xcf, 0x08, 0x6c, 0xc7, 0x5d, 0x1d, 0xff, 0x1b, 0xc1, 0x44, 0x9f, 0xe1,
-	0x2e, 0x73, 0x5b, 0xdd, 0x7d, 0x44, 0xcd, 0xdc, 0x56, 0xf7, 0x98, 0x31, 0x07, 0x3e, 0x33, 0xc5,
-	0xe1, 0x11, 0x64, 0x31, 0x1c, 0xb7, 0xf0, 0xef, 0x73, 0xf0, 0x95, 0x2c, 0x37, 0x6f, 0xac, 0x64,
-	0x6d, 0x16, 0xd9, 0x07, 0x89, 0xe2, 0x3b, 0x07, 0xaa, 0x53, 0x46, 0xc5, 0x14, 0x51, 0xd1, 0xb1,
-	0x96, 0xb5, 0x23, 0x25, 0x26, 0x05, 0xd5, 0x32, 0xed, 0x96, 0xba, 0xe2, 0xb0, 0xb6, 0x9a, 0x14,
-	0xaa, 0xbe, 0x97, 0x36, 0xc9, 0x3c, 0xc5, 0x9f, 0x23, 0x18, 0x4f, 0xbf, 0xfb, 0x67, 0xde, 0xee,
-	0xbb, 0x8e, 0x22, 0x99, 0xb7, 0xfb, 0xee, 0x03, 0x08, 0x79, 0x2c, 0x42, 0xf2, 0x16, 0xae, 0x67,
-	0x0c, 0x89, 0xe7, 0x52, 0x47, 0xf5, 0x42, 0x7d, 0x6a, 0xda, 0x5d, 0xeb, 0x13, 0x04, 0xe7, 0x76,
-	0x0c, 0x0d, 0x38, 0xeb, 0xfe, 0xed, 0x37, 0x8b, 0x14, 0xdf, 0x18, 0x5e, 0xc1, 0x90, 0x9b, 0xa2,
-	0x49, 0xb9, 0xda, 0x33, 0xe0, 0xd4, 0x56, 0x9f, 0x3d, 0x2f, 0xa1, 0x8f, 0x9f, 0x97, 0xd0, 0xdf,
-	0x9f, 0x97, 0xd0, 0x87, 0x2f, 0x4a, 0x87, 0x3e, 0x7e, 0x51, 0x3a, 0xf4, 0xb7, 0x17, 0xa5, 0x43,
-	0xdf, 0x79, 0x94, 0x98, 0x68, 0xa4, 0xfe, 0x29, 0x4b, 0x6b, 0xb8, 0x91, 0xb1, 0xf5, 0xeb, 0xb7,
-	0xab, 0xef, 0xf6, 0xfb, 0x63, 0xbb, 0x6e, 0x99, 0xd4, 0xe6, 0xc1, 0x7f, 0x3b, 0x08, 0xfe, 0x2c,
-	0x79, 0x4c, 0xfc, 0x73, 0xf3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xac, 0x51, 0x60, 0x46, 0x7c,
-	0x21, 0x00, 0x00,
+func (m *UserLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
+func (m *UserLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *UserLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *UserLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
-	out := new(PoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
-	out := new(UserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
-	out := new(LiquidityPerTickRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UserLimitOrdersResponse struct {
+	LimitOrders []model.FullLimitOrderBreakdown `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	Pagination  *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
-	out := new(LiquidityNetInDirectionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersResponse) Reset()         { *m = UserLimitOrdersResponse{} }
+func (m *UserLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersResponse) ProtoMessage()    {}
+func (*UserLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{31}
+}
+func (m *UserLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UserLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
-	out := new(ClaimableSpreadRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserLimitOrdersResponse) GetLimitOrders() []model.FullLimitOrderBreakdown {
+	if m != nil {
+		return m.LimitOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
-	out := new(ClaimableIncentivesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UserLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
-	out := new(PositionByIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== LimitOrderBookDepth
+type LimitOrderBookDepthRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
-	out := new(PoolAccumulatorRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrderBookDepthRequest) Reset()         { *m = LimitOrderBookDepthRequest{} }
+func (m *LimitOrderBookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderBookDepthRequest) ProtoMessage()    {}
+func (*LimitOrderBookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{32}
+}
+func (m *LimitOrderBookDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderBookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderBookDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrderBookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderBookDepthRequest.Merge(m, src)
+}
+func (m *LimitOrderBookDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderBookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderBookDepthRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
-	out := new(IncentiveRecordsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrderBookDepthRequest proto.InternalMessageInfo
+
+func (m *LimitOrderBookDepthRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
-	out := new(TickAccumulatorTrackersResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LimitOrderBookDepthResponse struct {
+	// asks sell token0 of the pool, ordered by tick.
+	Asks []model.LimitOrderTickDepth `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks"`
+	// bids sell token1 of the pool, ordered by tick.
+	Bids []model.LimitOrderTickDepth `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
 }
 
-func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrderBookDepthResponse) Reset()         { *m = LimitOrderBookDepthResponse{} }
+func (m *LimitOrderBookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderBookDepthResponse) ProtoMessage()    {}
+func (*LimitOrderBookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{33}
+}
+func (m *LimitOrderBookDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderBookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderBookDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrderBookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderBookDepthResponse.Merge(m, src)
+}
+func (m *LimitOrderBookDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderBookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderBookDepthResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
-	out := new(UserUnbondingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrderBookDepthResponse proto.InternalMessageInfo
+
+func (m *LimitOrderBookDepthResponse) GetAsks() []model.LimitOrderTickDepth {
+	if m != nil {
+		return m.Asks
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
-	out := new(GetTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrderBookDepthResponse) GetBids() []model.LimitOrderTickDepth {
+	if m != nil {
+		return m.Bids
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
+// =============================== EffectiveSpreadFactor
+type EffectiveSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *EffectiveSpreadFactorRequest) Reset()         { *m = EffectiveSpreadFactorRequest{} }
+func (m *EffectiveSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorRequest) ProtoMessage()    {}
+func (*EffectiveSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{34}
 }
-
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+func (m *EffectiveSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+
+var xxx_messageInfo_EffectiveSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+
+type EffectiveSpreadFactorResponse struct {
+	SpreadFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=spread_factor,json=spreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread_factor" yaml:"spread_factor"`
+	// dynamic_spread_factor_record is the record of the pool if it is in the
+	// dynamic spread factor mode, and null otherwise.
+	DynamicSpreadFactorRecord *types1.DynamicSpreadFactorRecord `protobuf:"bytes,2,opt,name=dynamic_spread_factor_record,json=dynamicSpreadFactorRecord,proto3" json:"dynamic_spread_factor_record,omitempty" yaml:"dynamic_spread_factor_record"`
 }
-func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+
+func (m *EffectiveSpreadFactorResponse) Reset()         { *m = EffectiveSpreadFactorResponse{} }
+func (m *EffectiveSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorResponse) ProtoMessage()    {}
+func (*EffectiveSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{35}
 }
-func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+func (m *EffectiveSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+func (m *EffectiveSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+func (m *EffectiveSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+func (m *EffectiveSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+func (m *EffectiveSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+
+var xxx_messageInfo_EffectiveSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorResponse) GetDynamicSpreadFactorRecord() *types1.DynamicSpreadFactorRecord {
+	if m != nil {
+		return m.DynamicSpreadFactorRecord
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// =============================== UserAutoCompoundPositions
+type UserAutoCompoundPositionsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+func (m *UserAutoCompoundPositionsRequest) Reset()         { *m = UserAutoCompoundPositionsRequest{} }
+func (m *UserAutoCompoundPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UserAutoCompoundPositionsRequest) ProtoMessage()    {}
+func (*UserAutoCompoundPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{36}
+}
+func (m *UserAutoCompoundPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserAutoCompoundPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserAutoCompoundPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UserAutoCompoundPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserAutoCompoundPositionsRequest.Merge(m, src)
+}
+func (m *UserAutoCompoundPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserAutoCompoundPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserAutoCompoundPositionsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+var xxx_messageInfo_UserAutoCompoundPositionsRequest proto.InternalMessageInfo
+
+func (m *UserAutoCompoundPositionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type UserAutoCompoundPositionsResponse struct {
+	Positions []model.PositionAutoCompound `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
 }
 
-func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityPerTickRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+func (m *UserAutoCompoundPositionsResponse) Reset()         { *m = UserAutoCompoundPositionsResponse{} }
+func (m *UserAutoCompoundPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UserAutoCompoundPositionsResponse) ProtoMessage()    {}
+func (*UserAutoCompoundPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{37}
+}
+func (m *UserAutoCompoundPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserAutoCompoundPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserAutoCompoundPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UserAutoCompoundPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserAutoCompoundPositionsResponse.Merge(m, src)
+}
+func (m *UserAutoCompoundPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserAutoCompoundPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserAutoCompoundPositionsResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityNetInDirectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_UserAutoCompoundPositionsResponse proto.InternalMessageInfo
+
+func (m *UserAutoCompoundPositionsResponse) GetPositions() []model.PositionAutoCompound {
+	if m != nil {
+		return m.Positions
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
+	return nil
+}
+
+// =============================== LiquidityPerTickRangeAtTime
+type LiquidityPerTickRangeAtTimeRequest struct {
+	PoolId    uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Time      time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	LowerTick int64     `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64     `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) Reset()         { *m = LiquidityPerTickRangeAtTimeRequest{} }
+func (m *LiquidityPerTickRangeAtTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPerTickRangeAtTimeRequest) ProtoMessage()    {}
+func (*LiquidityPerTickRangeAtTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{38}
+}
+func (m *LiquidityPerTickRangeAtTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityPerTickRangeAtTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPerTickRangeAtTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+}
+func (m *LiquidityPerTickRangeAtTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPerTickRangeAtTimeRequest.Merge(m, src)
+}
+func (m *LiquidityPerTickRangeAtTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPerTickRangeAtTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPerTickRangeAtTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityPerTickRangeAtTimeRequest proto.InternalMessageInfo
+
+func (m *LiquidityPerTickRangeAtTimeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+	return 0
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableSpreadRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+func (m *LiquidityPerTickRangeAtTimeRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableIncentivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LiquidityPerTickRangeAtTimeRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableIncentives(ctx, in)
+	return 0
+}
+
+type LiquidityPerTickRangeAtTimeResponse struct {
+	Liquidity []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
+	// snapshot_time is the time of the snapshot the liquidity is read from.
+	SnapshotTime time.Time `protobuf:"bytes,2,opt,name=snapshot_time,json=snapshotTime,proto3,stdtime" json:"snapshot_time" yaml:"snapshot_time"`
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) Reset()         { *m = LiquidityPerTickRangeAtTimeResponse{} }
+func (m *LiquidityPerTickRangeAtTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPerTickRangeAtTimeResponse) ProtoMessage()    {}
+func (*LiquidityPerTickRangeAtTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c83e18b11fd607d, []int{39}
+}
+func (m *LiquidityPerTickRangeAtTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityPerTickRangeAtTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPerTickRangeAtTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
+}
+func (m *LiquidityPerTickRangeAtTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPerTickRangeAtTimeResponse.Merge(m, src)
+}
+func (m *LiquidityPerTickRangeAtTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPerTickRangeAtTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPerTickRangeAtTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityPerTickRangeAtTimeResponse proto.InternalMessageInfo
+
+func (m *LiquidityPerTickRangeAtTimeResponse) GetLiquidity() []LiquidityDepthWithRange {
+	if m != nil {
+		return m.Liquidity
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+	return nil
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) GetSnapshotTime() time.Time {
+	if m != nil {
+		return m.SnapshotTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionByIdRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
+	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
+	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
+	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
+	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
+	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
+	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
+	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
+	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
+	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
+	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
+	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
+	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
+	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
+	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
+	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
+	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
+	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
+	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
+	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
+	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+	proto.RegisterType((*UserLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersRequest")
+	proto.RegisterType((*UserLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersResponse")
+	proto.RegisterType((*LimitOrderBookDepthRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderBookDepthRequest")
+	proto.RegisterType((*LimitOrderBookDepthResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderBookDepthResponse")
+	proto.RegisterType((*EffectiveSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorRequest")
+	proto.RegisterType((*EffectiveSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorResponse")
+	proto.RegisterType((*UserAutoCompoundPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserAutoCompoundPositionsRequest")
+	proto.RegisterType((*UserAutoCompoundPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserAutoCompoundPositionsResponse")
+	proto.RegisterType((*LiquidityPerTickRangeAtTimeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeAtTimeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeAtTimeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeAtTimeResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/query.proto", fileDescriptor_5c83e18b11fd607d)
+}
+
+var fileDescriptor_5c83e18b11fd607d = []byte{
+	// 2637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x4e, 0x4d, 0x9c, 0x1f, 0x3f, 0xdb, 0x71, 0x52, 0x76, 0x6c, 0xa7, 0x93, 0xcc, 0x78, 0x3b,
+	0xec, 0xc6, 0xda, 0xc4, 0x33, 0xe4, 0x8f, 0x90, 0xff, 0x78, 0xfc, 0xc7, 0xec, 0x3a, 0x89, 0xd3,
+	0x6b, 0x03, 0xe2, 0x40, 0xd3, 0xd3, 0x5d, 0x33, 0x6e, 0x4d, 0x4f, 0xd7, 0xa4, 0x7f, 0xec, 0xb5,
+	0x96, 0x48, 0x2b, 0x96, 0xe5, 0x82, 0x04, 0x41, 0xdc, 0xb9, 0x70, 0x00, 0xad, 0x38, 0x82, 0xc4,
+	0xc2, 0x09, 0x0e, 0x28, 0x80, 0xb4, 0x5a, 0x09, 0x21, 0xd0, 0x1e, 0x1c, 0x48, 0x38, 0x20, 0x76,
+	0xc5, 0xc1, 0x5c, 0xf6, 0x88, 0xba, 0xba, 0xba, 0xa7, 0x67, 0xa6, 0xc7, 0xee, 0x99, 0x31, 0x48,
+	0x7b, 0xf2, 0x74, 0x57, 0xbd, 0xef, 0xbd, 0xef, 0xbd, 0xaa, 0xd7, 0xd5, 0x5f, 0x1b, 0x5e, 0xa5,
+	0x76, 0x95, 0xda, 0xba, 0x9d, 0x53, 0xa9, 0xa9, 0x12, 0xd3, 0xb1, 0x14, 0x87, 0x68, 0xd3, 0x86,
+	0xfe, 0xc8, 0xd5, 0x35, 0xdd, 0xd9, 0xcc, 0x3d, 0x72, 0x89, 0xb5, 0x99, 0xad, 0x59, 0xd4, 0xa1,
+	0xf8, 0x65, 0x3e, 0x37, 0x1b, 0x9d, 0x1b, 0x4e, 0xcd, 0xae, 0x5f, 0x28, 0x12, 0x47, 0xb9, 0x20,
+	0x8c, 0x96, 0x69, 0x99, 0x32, 0x8b, 0x9c, 0xf7, 0xcb, 0x37, 0x16, 0xce, 0xed, 0xe2, 0xa8, 0xa6,
+	0x58, 0x4a, 0xd5, 0xe6, 0x93, 0xa7, 0x77, 0x99, 0xec, 0xe8, 0x6a, 0xa5, 0x60, 0x96, 0x02, 0xec,
+	0xb4, 0xca, 0xe6, 0xe7, 0x8a, 0x8a, 0x4d, 0x72, 0x3c, 0x8c, 0x9c, 0x4a, 0x75, 0x93, 0x8f, 0xbf,
+	0x1a, 0x1d, 0x67, 0x8c, 0xc2, 0x59, 0x35, 0xa5, 0xac, 0x9b, 0x8a, 0xa3, 0xd3, 0x60, 0xee, 0xa9,
+	0x32, 0xa5, 0x65, 0x83, 0xe4, 0x94, 0x9a, 0x9e, 0x53, 0x4c, 0x93, 0x3a, 0x6c, 0x30, 0x08, 0xec,
+	0x04, 0x1f, 0x65, 0x57, 0x45, 0xb7, 0x94, 0x53, 0xcc, 0xcd, 0x60, 0xc8, 0x77, 0x22, 0xfb, 0xcc,
+	0xfd, 0x0b, 0x3e, 0x94, 0x69, 0xb6, 0x72, 0xf4, 0x2a, 0xb1, 0x1d, 0xa5, 0x5a, 0x0b, 0x08, 0x34,
+	0x4f, 0xd0, 0x5c, 0x2b, 0x1a, 0xd4, 0x6e, 0xf9, 0xa8, 0x51, 0x5b, 0x8f, 0x4c, 0xbf, 0xb2, 0xcb,
+	0x74, 0x9d, 0xdd, 0xd5, 0xd7, 0x89, 0x6c, 0x11, 0x95, 0x5a, 0x1a, 0x37, 0xcb, 0xed, 0x62, 0x66,
+	0xe8, 0x55, 0xdd, 0x79, 0x60, 0x69, 0xc4, 0xe2, 0x06, 0x53, 0xbb, 0x18, 0x94, 0xe9, 0xba, 0x3f,
+	0x53, 0x7c, 0x1f, 0xc1, 0xe8, 0xaa, 0x4d, 0xac, 0x65, 0x1e, 0xa8, 0x2d, 0x91, 0x47, 0x2e, 0xb1,
+	0x1d, 0x7c, 0x1e, 0x0e, 0x29, 0x9a, 0x66, 0x11, 0xdb, 0x9e, 0x40, 0x93, 0x68, 0xaa, 0x3f, 0x8f,
+	0xb7, 0xb7, 0x32, 0x47, 0x36, 0x95, 0xaa, 0x71, 0x5d, 0xe4, 0x03, 0xa2, 0x14, 0x4c, 0xc1, 0xe7,
+	0xe0, 0x50, 0x8d, 0x52, 0x43, 0xd6, 0xb5, 0x89, 0xd4, 0x24, 0x9a, 0xea, 0x8b, 0xce, 0xe6, 0x03,
+	0xa2, 0x74, 0xd0, 0xfb, 0x55, 0xd0, 0xf0, 0x02, 0x40, 0xbd, 0xba, 0x13, 0xfb, 0x27, 0xd1, 0xd4,
+	0xc0, 0xc5, 0x57, 0xb2, 0xbc, 0x30, 0xde, 0x52, 0xc8, 0xfa, 0x8b, 0x9b, 0x2f, 0x85, 0xec, 0xb2,
+	0x52, 0x26, 0x3c, 0x2c, 0x29, 0x62, 0x29, 0xfe, 0x16, 0xc1, 0xf1, 0xa6, 0xd8, 0xed, 0x1a, 0x35,
+	0x6d, 0x82, 0xbf, 0x01, 0xfd, 0x41, 0xe6, 0xbd, 0xf0, 0xf7, 0x4f, 0x0d, 0x5c, 0xbc, 0x99, 0x4d,
+	0xb4, 0x49, 0xb2, 0x0b, 0xae, 0x61, 0x04, 0x80, 0x79, 0x8b, 0x28, 0x15, 0x8d, 0x6e, 0x98, 0xf9,
+	0xbe, 0xa7, 0x5b, 0x99, 0x7d, 0x52, 0x1d, 0x14, 0x2f, 0x36, 0x70, 0x48, 0x31, 0x0e, 0x67, 0x77,
+	0xe5, 0xe0, 0x87, 0xd7, 0x40, 0xe2, 0x3e, 0x8c, 0x84, 0xee, 0x36, 0x0b, 0x5a, 0x90, 0xfe, 0xab,
+	0x30, 0x10, 0x38, 0xf3, 0x92, 0x8a, 0x58, 0x52, 0xc7, 0xb6, 0xb7, 0x32, 0x38, 0x48, 0x6a, 0x38,
+	0x28, 0x4a, 0x10, 0x5c, 0x15, 0x34, 0x71, 0x1d, 0x46, 0x1b, 0xf1, 0x78, 0x4a, 0xbe, 0x0e, 0x87,
+	0x83, 0x59, 0x0c, 0x6d, 0x6f, 0x32, 0x12, 0x62, 0x8a, 0x5f, 0x86, 0xc1, 0x65, 0x4a, 0x8d, 0x70,
+	0xfd, 0x2c, 0xc4, 0x24, 0xa8, 0x9b, 0x22, 0x7f, 0x1f, 0xc1, 0x10, 0x07, 0xe6, 0x4c, 0xae, 0xc0,
+	0x01, 0x6f, 0x21, 0x05, 0x85, 0x1d, 0xcd, 0xfa, 0x7b, 0x34, 0x1b, 0xec, 0xd1, 0xec, 0x8c, 0xb9,
+	0x99, 0xef, 0xff, 0xc3, 0xcf, 0xa7, 0x0f, 0x78, 0x76, 0x05, 0xc9, 0x9f, 0xbd, 0x77, 0x15, 0x1b,
+	0x86, 0xa1, 0x65, 0xd6, 0x13, 0x79, 0xb8, 0xe2, 0x2a, 0x1c, 0x09, 0x6e, 0xf0, 0x10, 0x67, 0xe1,
+	0xa0, 0xdf, 0x36, 0x79, 0xaa, 0x5f, 0xde, 0x25, 0xd5, 0xbe, 0x39, 0xcf, 0x29, 0x37, 0x15, 0x7f,
+	0x81, 0xe0, 0xe8, 0x8a, 0xae, 0x56, 0x96, 0x82, 0x69, 0xf7, 0x89, 0x83, 0x2b, 0x30, 0x14, 0x9a,
+	0xc9, 0x26, 0x71, 0xf8, 0xe6, 0x5c, 0xf0, 0x2c, 0x3f, 0xda, 0xca, 0xbc, 0x52, 0xd6, 0x9d, 0x35,
+	0xb7, 0x98, 0x55, 0x69, 0x95, 0x77, 0x3a, 0xfe, 0x67, 0xda, 0xd6, 0x2a, 0x39, 0x67, 0xb3, 0x46,
+	0xec, 0xec, 0x1c, 0x51, 0xb7, 0xb7, 0x32, 0xa3, 0xfe, 0x3a, 0x6a, 0x00, 0x13, 0xa5, 0x41, 0x23,
+	0xea, 0xec, 0x32, 0x80, 0xd7, 0xd0, 0x65, 0xdd, 0xd4, 0xc8, 0x9b, 0x2c, 0x65, 0xfb, 0xf3, 0xc7,
+	0xb7, 0xb7, 0x32, 0xc7, 0x7c, 0xdb, 0xfa, 0x98, 0x28, 0xf5, 0xfb, 0x9d, 0xdf, 0xfb, 0xfd, 0x29,
+	0x82, 0xf1, 0x30, 0xe6, 0x39, 0x52, 0x73, 0xd6, 0xbe, 0xa2, 0x3b, 0x6b, 0x92, 0x62, 0x96, 0x09,
+	0x7e, 0x04, 0x47, 0xeb, 0x1e, 0x95, 0x2a, 0x75, 0xcd, 0xbd, 0x66, 0x30, 0x1c, 0x5e, 0xcf, 0x30,
+	0x78, 0x8f, 0x84, 0x41, 0x37, 0x88, 0x25, 0x7b, 0x11, 0xb6, 0x92, 0xa8, 0x8f, 0x89, 0x52, 0x3f,
+	0xbb, 0xf0, 0x72, 0xee, 0x59, 0xb9, 0xb5, 0x5a, 0x60, 0xb5, 0xbf, 0xd9, 0xaa, 0x3e, 0x26, 0x4a,
+	0xfd, 0xec, 0xc2, 0xb3, 0x12, 0x9f, 0xa5, 0x20, 0x1d, 0x2d, 0x57, 0xc1, 0x9c, 0xd3, 0x2d, 0xa2,
+	0x7a, 0xcb, 0x26, 0xd8, 0x17, 0x91, 0x4e, 0x89, 0x76, 0xed, 0x94, 0x59, 0x38, 0xec, 0xd0, 0x0a,
+	0x31, 0x65, 0xdd, 0x5f, 0xb1, 0xfd, 0xf9, 0x91, 0xed, 0xad, 0xcc, 0x30, 0x4f, 0x3f, 0x1f, 0x11,
+	0xa5, 0x43, 0xec, 0x67, 0xc1, 0xf4, 0xa2, 0xb6, 0x1d, 0xc5, 0x72, 0xda, 0x44, 0x5d, 0x1f, 0x13,
+	0xa5, 0x7e, 0x76, 0xc1, 0xb8, 0x5e, 0x83, 0x41, 0xd7, 0x26, 0xb2, 0xea, 0x72, 0xb6, 0x7d, 0x93,
+	0x68, 0xea, 0x70, 0x7e, 0x7c, 0x7b, 0x2b, 0x33, 0xc2, 0xd9, 0x46, 0x46, 0x45, 0x09, 0x5c, 0x9b,
+	0xcc, 0xba, 0x61, 0x9a, 0x8a, 0xd4, 0x35, 0x35, 0xdf, 0xf0, 0x40, 0xb3, 0xc3, 0xfa, 0x98, 0x28,
+	0xf5, 0xb3, 0x8b, 0xa8, 0x43, 0x93, 0xca, 0xec, 0xde, 0xc4, 0xc1, 0x38, 0x87, 0xc1, 0xa8, 0xef,
+	0xf0, 0x3e, 0xcd, 0xb3, 0x8b, 0x9f, 0xa4, 0x20, 0xd3, 0x36, 0xc3, 0x7c, 0xf7, 0xad, 0x45, 0x17,
+	0x99, 0xe6, 0x2d, 0xc0, 0xa0, 0x57, 0x5c, 0x4d, 0xd8, 0xf2, 0x9a, 0xb7, 0x1d, 0xdf, 0x99, 0xc3,
+	0x46, 0xc3, 0xb2, 0xb6, 0xf1, 0x4b, 0x30, 0xa8, 0xba, 0x96, 0x45, 0x4c, 0x27, 0xb2, 0xba, 0xa4,
+	0x01, 0x7e, 0x8f, 0x71, 0xdd, 0x80, 0x63, 0xc1, 0x94, 0xd0, 0x9a, 0x55, 0xa6, 0x3f, 0xff, 0x5a,
+	0xc7, 0x4b, 0x7e, 0xc2, 0x4f, 0x4f, 0x0b, 0xa0, 0x28, 0x1d, 0xe5, 0xf7, 0xc2, 0xa8, 0xc5, 0xd7,
+	0xe1, 0x54, 0x78, 0xb1, 0xec, 0xaf, 0x4f, 0xb6, 0x07, 0xbb, 0x59, 0x88, 0xe2, 0x3b, 0x08, 0x4e,
+	0xb7, 0x41, 0xe3, 0x49, 0x2f, 0x42, 0x7f, 0x9d, 0x9f, 0x9f, 0xed, 0xdb, 0x09, 0xb3, 0xdd, 0xa6,
+	0x59, 0x04, 0x0f, 0xdd, 0x3a, 0xcb, 0xaf, 0xc2, 0xe9, 0x59, 0x43, 0xd1, 0xab, 0x4a, 0xd1, 0x20,
+	0x6f, 0xd4, 0x2c, 0xa2, 0x68, 0x12, 0xd9, 0x50, 0x2c, 0xcd, 0xee, 0xf9, 0xa9, 0xf9, 0x23, 0x04,
+	0xe9, 0x76, 0xd0, 0x9c, 0xe0, 0x37, 0x61, 0x42, 0x0d, 0x66, 0xc8, 0x36, 0x9b, 0x22, 0x5b, 0xfe,
+	0x1c, 0xce, 0xf7, 0x44, 0xc3, 0xd3, 0x24, 0x60, 0x37, 0x4b, 0x75, 0x33, 0x7f, 0xd6, 0xa3, 0xb2,
+	0xbd, 0x95, 0xc9, 0xf0, 0x02, 0xb6, 0x01, 0x12, 0xa5, 0x31, 0x35, 0x36, 0x0a, 0x71, 0x15, 0x84,
+	0x30, 0xbe, 0x42, 0x70, 0x4a, 0xec, 0x9d, 0xf7, 0x3b, 0x29, 0x38, 0x19, 0x8b, 0xcb, 0x49, 0x3f,
+	0x82, 0xd1, 0x7a, 0xac, 0xe1, 0xe9, 0x34, 0x01, 0xe1, 0x33, 0x9c, 0xf0, 0xc9, 0x66, 0xc2, 0x75,
+	0x10, 0x51, 0x1a, 0x51, 0x5b, 0x5d, 0x7b, 0x2e, 0x4b, 0xd4, 0x2a, 0x11, 0xdd, 0x21, 0x5a, 0xd4,
+	0x65, 0xaa, 0x43, 0x97, 0x71, 0x20, 0xa2, 0x34, 0x12, 0xde, 0xae, 0xbb, 0x14, 0x97, 0xe0, 0xb4,
+	0x77, 0x54, 0x98, 0x51, 0x55, 0xb7, 0xea, 0x1a, 0x8a, 0x43, 0xad, 0xa6, 0x75, 0xd5, 0xd1, 0x5e,
+	0xf9, 0x4d, 0x0a, 0xd2, 0xed, 0xe0, 0x78, 0x5a, 0x9f, 0x20, 0x38, 0xd9, 0x50, 0x79, 0xb9, 0x6c,
+	0xd1, 0x0d, 0x67, 0x4d, 0x2e, 0x1b, 0xb4, 0xa8, 0x18, 0x3c, 0xbd, 0xa7, 0x62, 0xb9, 0xce, 0x11,
+	0x95, 0xd1, 0xbd, 0xe4, 0xd1, 0x7d, 0xef, 0x59, 0xe6, 0x5c, 0xb2, 0xee, 0xe1, 0xd9, 0xd8, 0xd2,
+	0x84, 0x1d, 0x59, 0x55, 0x8b, 0xcc, 0xe7, 0x22, 0x73, 0x89, 0xbf, 0x8b, 0x60, 0xd4, 0xad, 0x39,
+	0x7a, 0x95, 0x34, 0xc5, 0xe2, 0xe7, 0xfd, 0x72, 0xc2, 0xbd, 0xbc, 0xca, 0x20, 0x56, 0x2c, 0x45,
+	0xad, 0x10, 0xab, 0xb9, 0x24, 0x71, 0xf8, 0xa2, 0x84, 0xfd, 0xdb, 0xd1, 0x68, 0xbc, 0x7e, 0x93,
+	0xf6, 0x7a, 0x4c, 0x24, 0x87, 0x1c, 0xb3, 0xab, 0x9a, 0x74, 0x79, 0x92, 0xf9, 0x38, 0x05, 0x99,
+	0xb6, 0x51, 0xf0, 0x52, 0x3e, 0x45, 0x70, 0x2d, 0xb6, 0x94, 0xb4, 0xc6, 0xf6, 0x19, 0x91, 0xb5,
+	0xe0, 0x01, 0x25, 0xd3, 0x92, 0x6c, 0x28, 0xb6, 0x23, 0x3b, 0x96, 0xb2, 0x4e, 0x2c, 0xfb, 0x7f,
+	0x59, 0xe8, 0x8b, 0xad, 0x85, 0x7e, 0xc0, 0x03, 0x0a, 0x1f, 0x98, 0x0f, 0x4a, 0x4b, 0x8a, 0xed,
+	0xac, 0x04, 0xc1, 0xe0, 0xc7, 0x30, 0xcc, 0x2b, 0xe4, 0x70, 0x96, 0x3d, 0x15, 0x3f, 0xcd, 0x8b,
+	0x3f, 0xd6, 0x50, 0xfc, 0x00, 0x5a, 0x94, 0x8e, 0xb8, 0xd1, 0xe9, 0xb6, 0xf8, 0x3d, 0x04, 0xe3,
+	0xe1, 0xa6, 0x94, 0xd8, 0xfb, 0x6f, 0x77, 0xc5, 0xde, 0xab, 0x57, 0x8f, 0x0f, 0x10, 0x4c, 0xb4,
+	0x06, 0xc4, 0xeb, 0xae, 0xc3, 0xb1, 0xe6, 0xb7, 0xf5, 0xa0, 0x2d, 0x7e, 0x21, 0x61, 0xba, 0x9a,
+	0xb0, 0xf9, 0xf3, 0xee, 0xa8, 0xde, 0xe4, 0x72, 0xef, 0xde, 0x5c, 0xde, 0x46, 0x70, 0x6e, 0x76,
+	0xe1, 0xde, 0x3d, 0xf6, 0x5e, 0xa4, 0x2d, 0xe9, 0x66, 0x65, 0xc1, 0xa2, 0xd5, 0xd9, 0x48, 0x90,
+	0xfe, 0x48, 0x90, 0xf5, 0x87, 0x30, 0x1a, 0x65, 0x20, 0x37, 0x96, 0x20, 0x13, 0x69, 0xef, 0x31,
+	0xb3, 0x44, 0x09, 0xab, 0x2d, 0xc8, 0xa2, 0x0e, 0xe7, 0x93, 0x45, 0xc0, 0xd3, 0x7c, 0x0d, 0x06,
+	0xd5, 0x52, 0xb5, 0xda, 0xe4, 0x3a, 0x72, 0x54, 0x8c, 0x8e, 0x8a, 0x12, 0x78, 0x97, 0xdc, 0xd5,
+	0x3d, 0x38, 0xed, 0xa9, 0x03, 0xab, 0x66, 0x91, 0x9a, 0x9a, 0x6e, 0x96, 0x7b, 0x93, 0x38, 0xc4,
+	0x1f, 0x23, 0x48, 0xb7, 0xc3, 0xe3, 0xc1, 0xbe, 0x8d, 0x40, 0x08, 0x25, 0x02, 0x79, 0x43, 0x77,
+	0xd6, 0xe4, 0x1a, 0xb1, 0x74, 0xaa, 0xc9, 0x06, 0x55, 0x2b, 0x7c, 0x75, 0xdc, 0x4a, 0xb8, 0x3a,
+	0x02, 0x78, 0xef, 0x3c, 0xb4, 0xcc, 0x50, 0x96, 0xa8, 0x5a, 0xe1, 0x8b, 0x64, 0x3c, 0x74, 0xd3,
+	0x38, 0x2c, 0x0a, 0x30, 0xb1, 0x48, 0x9c, 0x15, 0xea, 0x28, 0x46, 0x78, 0xac, 0x0a, 0xde, 0x53,
+	0x7f, 0x80, 0xe0, 0x44, 0xcc, 0x20, 0x0f, 0xde, 0x81, 0x61, 0xc7, 0x1b, 0x91, 0x9b, 0x8f, 0x71,
+	0x3b, 0x3c, 0x72, 0x3f, 0xcf, 0x5b, 0xd3, 0x54, 0x82, 0xd6, 0xe4, 0xf7, 0xa5, 0x23, 0x4e, 0x83,
+	0x77, 0xf1, 0xd7, 0x08, 0xc6, 0xbc, 0xac, 0x2e, 0x85, 0x12, 0xd6, 0x67, 0x49, 0x81, 0xfa, 0x23,
+	0x82, 0xf1, 0x96, 0xe8, 0x79, 0x3e, 0xcb, 0x30, 0xc8, 0x74, 0x39, 0x99, 0xb2, 0xfb, 0x1d, 0x9e,
+	0x89, 0x3d, 0xd1, 0xa5, 0x8e, 0xda, 0x2c, 0xbb, 0x0c, 0xd4, 0x15, 0xbf, 0x3d, 0x6c, 0x0f, 0x05,
+	0x10, 0x22, 0x2e, 0x29, 0xad, 0xb0, 0xf3, 0x78, 0x57, 0x67, 0xa0, 0xdf, 0x23, 0x38, 0x19, 0x8b,
+	0xc5, 0x93, 0xb3, 0x02, 0x7d, 0x8a, 0x5d, 0x09, 0x92, 0x72, 0x3d, 0xf1, 0x8b, 0x42, 0x80, 0xe8,
+	0x3d, 0x95, 0x19, 0x22, 0x4f, 0x08, 0x43, 0xf3, 0x50, 0x8b, 0xba, 0x16, 0x3c, 0xb5, 0xf6, 0x00,
+	0xd5, 0x43, 0xf3, 0x5e, 0xa4, 0xe6, 0x4b, 0x25, 0xef, 0x91, 0xb9, 0xce, 0x0f, 0xe5, 0x0b, 0x8a,
+	0xca, 0x0e, 0x75, 0x5d, 0x24, 0xe6, 0xfd, 0x14, 0x9c, 0x6e, 0x83, 0xc6, 0x53, 0x53, 0x81, 0x21,
+	0x7e, 0x9e, 0x28, 0xb1, 0x81, 0x5e, 0xf5, 0x91, 0x06, 0x30, 0x51, 0x1a, 0xb4, 0x23, 0x4e, 0xf1,
+	0x7b, 0x08, 0x4e, 0x69, 0x9b, 0xa6, 0x52, 0xd5, 0x55, 0xb9, 0x61, 0x22, 0x7f, 0xa4, 0xf1, 0xe5,
+	0x74, 0x37, 0x61, 0x2a, 0xe7, 0x7c, 0xa8, 0x46, 0x5e, 0xec, 0xd9, 0x76, 0x76, 0x7b, 0x2b, 0x73,
+	0xc6, 0x0f, 0x68, 0x27, 0x7f, 0xa2, 0x74, 0x42, 0x6b, 0x87, 0x21, 0x2e, 0xc3, 0xa4, 0xb7, 0xd9,
+	0x66, 0x5c, 0x87, 0xce, 0xd2, 0x6a, 0xcd, 0xd3, 0x03, 0x7a, 0xec, 0xe9, 0xdf, 0x46, 0xf0, 0xd2,
+	0x0e, 0x90, 0xbc, 0x22, 0x72, 0xab, 0x9a, 0x7c, 0xa3, 0xc3, 0x26, 0x1e, 0x75, 0xd0, 0x22, 0x26,
+	0x8b, 0xdf, 0x49, 0x81, 0x18, 0xfb, 0x76, 0x3d, 0xe3, 0xac, 0xe8, 0xd5, 0xae, 0xde, 0xd8, 0xf1,
+	0x22, 0xf4, 0x39, 0x7a, 0x95, 0xf0, 0x02, 0x0a, 0x2d, 0x22, 0xe9, 0x4a, 0xf0, 0xa5, 0x23, 0x3f,
+	0xce, 0xcf, 0x69, 0x03, 0xc1, 0x59, 0xb8, 0x4a, 0xc4, 0x27, 0xcf, 0x32, 0x48, 0x62, 0x00, 0x4d,
+	0xfa, 0xd9, 0xfe, 0xae, 0xf4, 0xb3, 0xbe, 0x84, 0xfa, 0xd9, 0x27, 0x08, 0xce, 0xec, 0x98, 0x88,
+	0xff, 0x9f, 0xd8, 0x80, 0x15, 0x18, 0xb2, 0x4d, 0xa5, 0x66, 0xaf, 0x51, 0x47, 0x4e, 0x98, 0xc9,
+	0x49, 0x9e, 0xc9, 0x60, 0xe7, 0x45, 0xcd, 0xfd, 0x94, 0x0e, 0x06, 0xf7, 0x3c, 0xa3, 0x8b, 0xbf,
+	0x14, 0xe1, 0xc0, 0x43, 0xaf, 0x37, 0xe3, 0x9f, 0x22, 0x60, 0x6a, 0xb5, 0x8d, 0x2f, 0x25, 0x5e,
+	0x59, 0x75, 0xb1, 0x5d, 0xb8, 0xdc, 0x99, 0x91, 0x9f, 0x45, 0xf1, 0xf2, 0xb7, 0xfe, 0xf4, 0x8f,
+	0x1f, 0xa6, 0xb2, 0xf8, 0x7c, 0xec, 0xf7, 0xa5, 0xd0, 0xba, 0xfe, 0x49, 0x8e, 0x05, 0xf8, 0x33,
+	0x04, 0x07, 0x7d, 0xbd, 0x1a, 0x27, 0x76, 0x1b, 0x95, 0xcb, 0x85, 0x2b, 0x1d, 0x5a, 0xf1, 0x68,
+	0xaf, 0xb0, 0x68, 0x73, 0x78, 0x3a, 0x69, 0xb4, 0x7e, 0x8c, 0x1f, 0x20, 0x18, 0x6a, 0xf8, 0x48,
+	0x84, 0x93, 0xee, 0xdd, 0xb8, 0xcf, 0x62, 0xc2, 0xcd, 0xee, 0x8c, 0x39, 0x87, 0x3c, 0xe3, 0x70,
+	0x13, 0x5f, 0x4f, 0x9c, 0x71, 0x8e, 0x90, 0x7b, 0x8b, 0xb7, 0xac, 0xc7, 0xf8, 0x63, 0x04, 0xc7,
+	0x63, 0xf7, 0x08, 0x9e, 0xed, 0x74, 0x0b, 0xc4, 0xc8, 0x82, 0xc2, 0x5c, 0x6f, 0x20, 0x9c, 0xe8,
+	0x22, 0x23, 0x3a, 0x83, 0xef, 0x24, 0x24, 0x1a, 0xde, 0x91, 0x83, 0xbe, 0x20, 0x5b, 0x8c, 0xd3,
+	0x7f, 0xa2, 0x1f, 0x13, 0x1a, 0xf5, 0x5e, 0x3c, 0xdf, 0x69, 0xa8, 0xb1, 0x8a, 0xbc, 0xb0, 0xd0,
+	0x2b, 0x0c, 0xe7, 0x5c, 0x60, 0x9c, 0x67, 0xf1, 0x4c, 0xc7, 0x9c, 0x4d, 0xe2, 0xc8, 0xba, 0x59,
+	0x17, 0x0a, 0xf0, 0xbf, 0x11, 0x8c, 0xc5, 0xcb, 0x91, 0x38, 0x69, 0x7d, 0x76, 0x14, 0x4a, 0x85,
+	0xf9, 0x1e, 0x51, 0xba, 0x2c, 0x73, 0x3b, 0xdd, 0x13, 0xff, 0x1d, 0xc1, 0x48, 0x8c, 0x0e, 0x89,
+	0x67, 0x3a, 0x8d, 0xb3, 0x45, 0x1b, 0x15, 0xf2, 0xbd, 0x40, 0x70, 0x9e, 0xb3, 0x8c, 0xe7, 0x2d,
+	0x7c, 0xa3, 0x63, 0x9e, 0x75, 0xed, 0x11, 0xff, 0x0e, 0x79, 0x9f, 0x48, 0xeb, 0x9f, 0x66, 0xf1,
+	0xf5, 0x0e, 0x0f, 0x11, 0x91, 0xef, 0xc3, 0xc2, 0x8d, 0xae, 0x6c, 0x39, 0x9d, 0x5b, 0x8c, 0xce,
+	0x55, 0x7c, 0xa5, 0xc3, 0x36, 0x24, 0x17, 0x37, 0x65, 0x5d, 0xc3, 0xff, 0x44, 0x30, 0x16, 0x2f,
+	0x70, 0x26, 0x5e, 0x9d, 0x3b, 0xca, 0xad, 0xc2, 0x7c, 0x8f, 0x28, 0x9c, 0xe6, 0x0c, 0xa3, 0x79,
+	0x03, 0x5f, 0xeb, 0xe0, 0xf9, 0x26, 0x2b, 0x1e, 0x5e, 0xb8, 0x2e, 0xff, 0x8c, 0xe0, 0x68, 0xb3,
+	0x04, 0x84, 0x6f, 0x77, 0xa7, 0xef, 0x84, 0xf4, 0xee, 0x74, 0x6d, 0xcf, 0x89, 0xdd, 0x65, 0xc4,
+	0xae, 0xe3, 0x2f, 0x26, 0x24, 0xd6, 0x22, 0x54, 0xe1, 0x4f, 0x10, 0x8c, 0xb7, 0x51, 0x36, 0x13,
+	0xb7, 0xd5, 0x9d, 0xf5, 0x59, 0x61, 0xa1, 0x57, 0x98, 0x2e, 0x9f, 0x99, 0xec, 0xe1, 0xe1, 0x57,
+	0x31, 0xd0, 0x1a, 0xf1, 0xaf, 0x52, 0xf0, 0xb9, 0x24, 0xb2, 0x13, 0x96, 0x92, 0x36, 0x8b, 0xe4,
+	0x2a, 0x9a, 0xf0, 0xc6, 0x9e, 0x62, 0xf2, 0xac, 0xe8, 0x2c, 0x2b, 0x2a, 0x56, 0x92, 0x76, 0xa4,
+	0x88, 0x4c, 0x26, 0x1b, 0xba, 0x59, 0x91, 0x4b, 0x16, 0xad, 0xca, 0x51, 0xa3, 0xdc, 0x5b, 0x71,
+	0x32, 0xde, 0x63, 0xfc, 0x29, 0x97, 0x68, 0x5a, 0x85, 0xaf, 0xc4, 0xdb, 0x7d, 0x47, 0x1d, 0x4e,
+	0x98, 0xef, 0x11, 0x85, 0xa7, 0xe4, 0x21, 0x4b, 0xc9, 0xeb, 0xb8, 0x90, 0x30, 0x25, 0xae, 0x4d,
+	0x2c, 0xd9, 0x0d, 0xf0, 0xe4, 0xb8, 0xb3, 0xd6, 0x47, 0x08, 0x8e, 0xb5, 0x28, 0x66, 0x38, 0xe9,
+	0xfe, 0x6d, 0x27, 0xc4, 0x09, 0x77, 0xbb, 0x07, 0xe8, 0x72, 0x53, 0x94, 0x89, 0x23, 0x37, 0xa9,
+	0x7b, 0xf8, 0x2f, 0x08, 0x86, 0x9b, 0xc4, 0x2b, 0x7c, 0xab, 0x83, 0x52, 0xb4, 0x4a, 0x76, 0xc2,
+	0xed, 0x6e, 0xcd, 0x39, 0xad, 0x79, 0x46, 0xeb, 0x0e, 0xbe, 0x95, 0xf8, 0x08, 0x55, 0x17, 0xd8,
+	0x22, 0x65, 0x7b, 0x81, 0x60, 0x24, 0x46, 0x7d, 0x4a, 0x7c, 0x9a, 0x68, 0xaf, 0x82, 0x09, 0xf9,
+	0x5e, 0x20, 0x7a, 0x67, 0x29, 0x17, 0x29, 0xad, 0xf8, 0xff, 0xd3, 0x80, 0xff, 0x85, 0xe0, 0x78,
+	0xac, 0x94, 0x94, 0xf8, 0x45, 0x60, 0x27, 0x59, 0x4b, 0x98, 0xeb, 0x0d, 0x84, 0x73, 0x5d, 0x60,
+	0x5c, 0xef, 0xe2, 0xdb, 0x09, 0xb9, 0x92, 0x00, 0xad, 0x51, 0x1e, 0xc2, 0xef, 0xa6, 0xe0, 0x44,
+	0x5b, 0xa5, 0x06, 0x2f, 0x76, 0xb0, 0xee, 0x76, 0x92, 0x8f, 0x84, 0x2f, 0xf5, 0x0e, 0xc4, 0x89,
+	0xaf, 0x32, 0xe2, 0x0f, 0xf0, 0xbd, 0x4e, 0xba, 0x91, 0xe2, 0x3a, 0x54, 0x56, 0x39, 0x66, 0x6c,
+	0x47, 0x7a, 0x37, 0xe5, 0x09, 0xab, 0x6d, 0x15, 0x12, 0x5c, 0xe8, 0xe5, 0xf5, 0xad, 0x41, 0x6e,
+	0x12, 0x5e, 0xdb, 0x0b, 0x28, 0x9e, 0x8d, 0x07, 0x2c, 0x1b, 0x05, 0xbc, 0xd8, 0xe3, 0xfb, 0xa0,
+	0xac, 0xf8, 0x6a, 0x4a, 0x7e, 0xed, 0xe9, 0xf3, 0x34, 0xfa, 0xf0, 0x79, 0x1a, 0xfd, 0xed, 0x79,
+	0x1a, 0x3d, 0x79, 0x91, 0xde, 0xf7, 0xe1, 0x8b, 0xf4, 0xbe, 0xbf, 0xbe, 0x48, 0xef, 0xfb, 0xda,
+	0xfd, 0x88, 0x40, 0xca, 0x9d, 0x4d, 0x1b, 0x4a, 0xd1, 0x0e, 0x3d, 0xaf, 0x5f, 0xb8, 0x9a, 0x7b,
+	0xb3, 0xdd, 0x7f, 0xc6, 0xaa, 0x86, 0x4e, 0x4c, 0xc7, 0xff, 0x5f, 0x64, 0x5f, 0xe1, 0x39, 0xc8,
+	0xfe, 0x5c, 0xfa, 0xef, 0x00, 0xc5, 0x23, 0xdd, 0x6d, 0x91, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
+	// UserLimitOrders returns all the limit orders of some address.
+	UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error)
+	// LimitOrderBookDepth returns the amount of limit orders that is not filled
+	// yet at every tick of the given pool, for each of the pool tokens.
+	LimitOrderBookDepth(ctx context.Context, in *LimitOrderBookDepthRequest, opts ...grpc.CallOption) (*LimitOrderBookDepthResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// given pool, which is recomputed each block for pools in the dynamic spread
+	// factor mode.
+	EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error)
+	// UserAutoCompoundPositions returns the positions of the given address that
+	// are opted in auto-compounding, along with the last time that they were
+	// compounded.
+	UserAutoCompoundPositions(ctx context.Context, in *UserAutoCompoundPositionsRequest, opts ...grpc.CallOption) (*UserAutoCompoundPositionsResponse, error)
+	// LiquidityPerTickRangeAtTime returns the amount of liquidity per tick range
+	// within the given tick window of a pool, as of its latest tick liquidity
+	// snapshot taken at or before the given time.
+	LiquidityPerTickRangeAtTime(ctx context.Context, in *LiquidityPerTickRangeAtTimeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeAtTimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
+	out := new(PoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolAccumulatorRewardsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncentiveRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
+	out := new(UserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentiveRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TickAccumulatorTrackersRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
+	out := new(LiquidityPerTickRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
+	out := new(LiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserUnbondingPositionsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
+	out := new(ClaimableSpreadRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
+	out := new(ClaimableIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "LiquidityPerTickRange",
-			Handler:    _Query_LiquidityPerTickRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableSpreadRewards",
-			Handler:    _Query_ClaimableSpreadRewards_Handler,
-		},
-		{
-			MethodName: "ClaimableIncentives",
-			Handler:    _Query_ClaimableIncentives_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
-		{
-			MethodName: "PoolAccumulatorRewards",
-			Handler:    _Query_PoolAccumulatorRewards_Handler,
-		},
-		{
-			MethodName: "IncentiveRecords",
-			Handler:    _Query_IncentiveRecords_Handler,
-		},
-		{
-			MethodName: "TickAccumulatorTrackers",
-			Handler:    _Query_TickAccumulatorTrackers_Handler,
-		},
-		{
-			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
-			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
-		},
-		{
-			MethodName: "UserUnbondingPositions",
-			Handler:    _Query_UserUnbondingPositions_Handler,
-		},
-		{
-			MethodName: "GetTotalLiquidity",
-			Handler:    _Query_GetTotalLiquidity_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentrated-liquidity/query.proto",
+func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
+	out := new(PositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
+	out := new(PoolAccumulatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
+	out := new(IncentiveRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
+	out := new(TickAccumulatorTrackersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
+	out := new(UserUnbondingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
+	out := new(GetTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error) {
+	out := new(UserLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrderBookDepth(ctx context.Context, in *LimitOrderBookDepthRequest, opts ...grpc.CallOption) (*LimitOrderBookDepthResponse, error) {
+	out := new(LimitOrderBookDepthResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderBookDepth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error) {
+	out := new(EffectiveSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) UserAutoCompoundPositions(ctx context.Context, in *UserAutoCompoundPositionsRequest, opts ...grpc.CallOption) (*UserAutoCompoundPositionsResponse, error) {
+	out := new(UserAutoCompoundPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserAutoCompoundPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPerTickRangeAtTime(ctx context.Context, in *LiquidityPerTickRangeAtTimeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeAtTimeResponse, error) {
+	out := new(LiquidityPerTickRangeAtTimeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRangeAtTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
+	// UserLimitOrders returns all the limit orders of some address.
+	UserLimitOrders(context.Context, *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error)
+	// LimitOrderBookDepth returns the amount of limit orders that is not filled
+	// yet at every tick of the given pool, for each of the pool tokens.
+	LimitOrderBookDepth(context.Context, *LimitOrderBookDepthRequest) (*LimitOrderBookDepthResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// given pool, which is recomputed each block for pools in the dynamic spread
+	// factor mode.
+	EffectiveSpreadFactor(context.Context, *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error)
+	// UserAutoCompoundPositions returns the positions of the given address that
+	// are opted in auto-compounding, along with the last time that they were
+	// compounded.
+	UserAutoCompoundPositions(context.Context, *UserAutoCompoundPositionsRequest) (*UserAutoCompoundPositionsResponse, error)
+	// LiquidityPerTickRangeAtTime returns the amount of liquidity per tick range
+	// within the given tick window of a pool, as of its latest tick liquidity
+	// snapshot taken at or before the given time.
+	LiquidityPerTickRangeAtTime(context.Context, *LiquidityPerTickRangeAtTimeRequest) (*LiquidityPerTickRangeAtTimeResponse, error)
 }
 
-func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+}
+func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+}
+func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) UserLimitOrders(ctx context.Context, req *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderBookDepth(ctx context.Context, req *LimitOrderBookDepthRequest) (*LimitOrderBookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderBookDepth not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveSpreadFactor(ctx context.Context, req *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSpreadFactor not implemented")
+}
+func (*UnimplementedQueryServer) UserAutoCompoundPositions(ctx context.Context, req *UserAutoCompoundPositionsRequest) (*UserAutoCompoundPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAutoCompoundPositions not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPerTickRangeAtTime(ctx context.Context, req *LiquidityPerTickRangeAtTimeRequest) (*LiquidityPerTickRangeAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRangeAtTime not implemented")
 }
 
-func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityPerTickRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableSpreadRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccumulatorRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
+func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickAccumulatorTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUnbondingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpperTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x18
+func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LowerTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
 	}
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLimitOrdersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserLimitOrders(ctx, req.(*UserLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LimitOrderBookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderBookDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderBookDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderBookDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderBookDepth(ctx, req.(*LimitOrderBookDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseNoBound {
-		i--
-		if m.UseNoBound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.BoundTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.UseCurTick {
-		i--
-		if m.UseCurTick {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Query_EffectiveSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StartTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, req.(*EffectiveSpreadFactorRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserAutoCompoundPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAutoCompoundPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).UserAutoCompoundPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserAutoCompoundPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserAutoCompoundPositions(ctx, req.(*UserAutoCompoundPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LiquidityPerTickRangeAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityPerTickRangeAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPerTickRangeAtTime(ctx, in)
 	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRangeAtTime",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPerTickRangeAtTime(ctx, req.(*LiquidityPerTickRangeAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRange",
+			Handler:    _Query_LiquidityPerTickRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableSpreadRewards",
+			Handler:    _Query_ClaimableSpreadRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "PoolAccumulatorRewards",
+			Handler:    _Query_PoolAccumulatorRewards_Handler,
+		},
+		{
+			MethodName: "IncentiveRecords",
+			Handler:    _Query_IncentiveRecords_Handler,
+		},
+		{
+			MethodName: "TickAccumulatorTrackers",
+			Handler:    _Query_TickAccumulatorTrackers_Handler,
+		},
+		{
+			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
+			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
+		},
+		{
+			MethodName: "UserUnbondingPositions",
+			Handler:    _Query_UserUnbondingPositions_Handler,
+		},
+		{
+			MethodName: "GetTotalLiquidity",
+			Handler:    _Query_GetTotalLiquidity_Handler,
+		},
+		{
+			MethodName: "UserLimitOrders",
+			Handler:    _Query_UserLimitOrders_Handler,
+		},
+		{
+			MethodName: "LimitOrderBookDepth",
+			Handler:    _Query_LimitOrderBookDepth_Handler,
+		},
+		{
+			MethodName: "EffectiveSpreadFactor",
+			Handler:    _Query_EffectiveSpreadFactor_Handler,
+		},
+		{
+			MethodName: "UserAutoCompoundPositions",
+			Handler:    _Query_UserAutoCompoundPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRangeAtTime",
+			Handler:    _Query_LiquidityPerTickRangeAtTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentrated-liquidity/query.proto",
+}
+
+func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2779,25 +3047,44 @@ func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2807,20 +3094,32 @@ func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2834,7 +3133,7 @@ func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2844,12 +3143,12 @@ func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2862,7 +3161,7 @@ func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2872,34 +3171,30 @@ func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2909,25 +3204,32 @@ func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2937,34 +3239,32 @@ func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ForfeitedIncentives) > 0 {
-		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ClaimableIncentives) > 0 {
-		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2978,7 +3278,7 @@ func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2988,25 +3288,20 @@ func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3016,48 +3311,30 @@ func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3067,12 +3344,12 @@ func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3082,15 +3359,20 @@ func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3100,48 +3382,40 @@ func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeTrackers) > 0 {
-		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3151,26 +3425,51 @@ func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.UseNoBound {
+		i--
+		if m.UseNoBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BoundTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UseCurTick {
+		i--
+		if m.UseCurTick {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
@@ -3181,7 +3480,7 @@ func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3191,32 +3490,35 @@ func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.IncentiveRecords) > 0 {
-		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3230,7 +3532,7 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3240,25 +3542,25 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3268,25 +3570,34 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3296,27 +3607,25 @@ func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3326,20 +3635,20 @@ func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3353,7 +3662,7 @@ func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3363,20 +3672,25 @@ func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3386,20 +3700,34 @@ func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3413,484 +3741,2340 @@ func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
-	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
-	}
-	if m.UseNoBound {
-		n += 2
-	}
-	return n
+func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for _, e := range m.ClaimableSpreadRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClaimableIncentives) > 0 {
-		for _, e := range m.ClaimableIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ForfeitedIncentives) > 0 {
-		for _, e := range m.ForfeitedIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for _, e := range m.SpreadRewardGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for _, e := range m.UptimeGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.UptimeTrackers) > 0 {
-		for _, e := range m.UptimeTrackers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IncentiveRecords) > 0 {
-		for _, e := range m.IncentiveRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
-	}
-	return n
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for _, e := range m.PositionsWithPeriodLock {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.TotalLiquidity) > 0 {
-		for _, e := range m.TotalLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderBookDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderBookDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderBookDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderBookDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderBookDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderBookDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSpreadFactorRecord != nil {
+		{
+			size, err := m.DynamicSpreadFactorRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserAutoCompoundPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserAutoCompoundPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserAutoCompoundPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserAutoCompoundPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserAutoCompoundPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserAutoCompoundPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SnapshotTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SnapshotTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityPerTickRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for _, e := range m.ClaimableSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for _, e := range m.PositionsWithPeriodLock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for _, e := range m.TotalLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrderBookDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *LimitOrderBookDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicSpreadFactorRecord != nil {
+		l = m.DynamicSpreadFactorRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserAutoCompoundPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserAutoCompoundPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeAtTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeAtTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SnapshotTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3913,15 +6097,15 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3949,9 +6133,99 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -3970,11 +6244,11 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3984,28 +6258,102 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4027,7 +6375,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4050,15 +6398,15 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4085,16 +6433,35 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4104,25 +6471,23 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4147,7 +6512,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4170,17 +6535,17 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4190,7 +6555,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4216,7 +6581,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4239,15 +6604,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4274,7 +6639,8 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4299,7 +6665,7 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,17 +6688,17 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4342,28 +6708,11 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4385,7 +6734,7 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4408,49 +6757,15 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4477,10 +6792,8 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4505,7 +6818,7 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4528,12 +6841,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4555,7 +6887,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4578,15 +6910,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4613,7 +6945,42 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4638,7 +7005,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4661,51 +7028,17 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.TickIndex = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4715,7 +7048,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4741,7 +7074,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4764,17 +7097,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4784,31 +7117,31 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
 			}
-			m.LowerTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4818,30 +7151,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
-			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4863,7 +7192,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4886,10 +7215,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4912,100 +7241,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
-			}
-			m.StartTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
-			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
 			}
-			var v int
+			m.TickIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5015,12 +7254,11 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TickIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseNoBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5042,7 +7280,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5065,15 +7303,15 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5100,35 +7338,16 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5138,23 +7357,23 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5179,7 +7398,7 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5202,10 +7421,10 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5227,6 +7446,42 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5248,7 +7503,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5271,15 +7526,15 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5306,8 +7561,44 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5332,7 +7623,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5355,17 +7646,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
 			}
-			m.PositionId = 0
+			m.ConcentratedPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5375,7 +7666,7 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5401,7 +7692,7 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5424,17 +7715,17 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
 			}
-			var msglen int
+			m.CfmmPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5444,26 +7735,11 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5485,7 +7761,7 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5508,17 +7784,17 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.PositionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5528,11 +7804,24 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5554,7 +7843,7 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5577,49 +7866,15 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5646,8 +7901,8 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5672,7 +7927,7 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5695,31 +7950,12 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5741,7 +7977,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5764,49 +8000,15 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5833,8 +8035,8 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5859,7 +8061,7 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *UserLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5882,13 +8084,45 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -5907,11 +8141,11 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.TickIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5921,11 +8155,28 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5947,7 +8198,7 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *UserLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5970,15 +8221,15 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6005,14 +8256,14 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrders = append(m.LimitOrders, model.FullLimitOrderBreakdown{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6039,8 +8290,10 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6065,7 +8318,7 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *LimitOrderBookDepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,10 +8341,10 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrderBookDepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrderBookDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6113,42 +8366,6 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6170,7 +8387,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *LimitOrderBookDepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6193,15 +8410,15 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrderBookDepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrderBookDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6228,14 +8445,14 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Asks = append(m.Asks, model.LimitOrderTickDepth{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6262,10 +8479,8 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, model.LimitOrderTickDepth{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6290,7 +8505,7 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6313,17 +8528,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6333,7 +8548,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6359,7 +8574,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *EffectiveSpreadFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6382,17 +8597,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
 			}
-			m.CfmmPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6402,11 +8617,62 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSpreadFactorRecord == nil {
+				m.DynamicSpreadFactorRecord = &types1.DynamicSpreadFactorRecord{}
+			}
+			if err := m.DynamicSpreadFactorRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6428,7 +8694,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserAutoCompoundPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6451,10 +8717,10 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserAutoCompoundPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserAutoCompoundPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6510,7 +8776,7 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserAutoCompoundPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6533,15 +8799,15 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserAutoCompoundPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserAutoCompoundPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6568,8 +8834,8 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, model.PositionAutoCompound{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6594,7 +8860,7 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeAtTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6617,12 +8883,102 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeAtTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeAtTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6644,7 +9000,7 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPerTickRangeAtTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6667,15 +9023,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPerTickRangeAtTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeAtTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6702,8 +9058,41 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SnapshotTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
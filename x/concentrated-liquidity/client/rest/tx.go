@@ -21,6 +21,13 @@ func ProposalCreateConcentratedLiquidityPoolHandler(clientCtx client.Context) go
 	}
 }
 
+func ProposalSetDynamicSpreadFactorRecordsHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-dynamic-spread-factor-records",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
 func emptyHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 	}
@@ -11,6 +11,8 @@ type ConcentratedLiquidityListenerMock struct {
 	AfterInitialPoolPositionCreatedCallCount int
 	AfterLastPoolPositionRemovedCallCount    int
 	AfterConcentratedPoolSwapCallCount       int
+	AfterLiquidityAddedCallCount             int
+	AfterLiquidityRemovedCallCount           int
 }
 
 var _ types.ConcentratedLiquidityListener = &ConcentratedLiquidityListenerMock{}
@@ -30,3 +32,11 @@ func (l *ConcentratedLiquidityListenerMock) AfterLastPoolPositionRemoved(ctx sdk
 func (l *ConcentratedLiquidityListenerMock) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.AfterConcentratedPoolSwapCallCount += 1
 }
+
+func (l *ConcentratedLiquidityListenerMock) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins) {
+	l.AfterLiquidityAddedCallCount += 1
+}
+
+func (l *ConcentratedLiquidityListenerMock) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins) {
+	l.AfterLiquidityRemovedCallCount += 1
+}
@@ -0,0 +1,190 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+// volatilityNumSubWindows is the number of sub-windows the volatility window of a dynamic spread factor record is split into.
+// The realized volatility is computed from the returns between the TWAPs of consecutive sub-windows.
+const volatilityNumSubWindows = 10
+
+// SetDynamicSpreadFactorRecord opts the pool of the given record in the dynamic spread factor mode, replacing any
+// existing record of the pool. Until it is recomputed at the beginning of the next block, the effective spread factor
+// of the pool is its spread factor bounded by the record.
+// Returns error if the record is invalid or if the pool does not exist.
+func (k Keeper) SetDynamicSpreadFactorRecord(ctx sdk.Context, record types.DynamicSpreadFactorRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	pool, err := k.getPoolById(ctx, record.PoolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyDynamicSpreadFactorRecord(record.PoolId), &record)
+	osmoutils.MustSetDec(store, types.KeyEffectiveSpreadFactor(record.PoolId), boundSpreadFactor(pool.GetSpreadFactor(ctx), record))
+	return nil
+}
+
+// RemoveDynamicSpreadFactorRecord opts the given pool out of the dynamic spread factor mode, so that swaps are charged
+// its spread factor again.
+// Returns error if the pool has no dynamic spread factor record.
+func (k Keeper) RemoveDynamicSpreadFactorRecord(ctx sdk.Context, poolId uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyDynamicSpreadFactorRecord(poolId)
+	if !store.Has(key) {
+		return types.DynamicSpreadFactorRecordNotFoundError{PoolId: poolId}
+	}
+	store.Delete(key)
+	store.Delete(types.KeyEffectiveSpreadFactor(poolId))
+	return nil
+}
+
+// GetDynamicSpreadFactorRecord returns the dynamic spread factor record of the given pool.
+// Returns error if the pool has no dynamic spread factor record.
+func (k Keeper) GetDynamicSpreadFactorRecord(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	record := types.DynamicSpreadFactorRecord{}
+	found, err := osmoutils.Get(store, types.KeyDynamicSpreadFactorRecord(poolId), &record)
+	if err != nil {
+		return types.DynamicSpreadFactorRecord{}, err
+	}
+	if !found {
+		return types.DynamicSpreadFactorRecord{}, types.DynamicSpreadFactorRecordNotFoundError{PoolId: poolId}
+	}
+	return record, nil
+}
+
+// GetAllDynamicSpreadFactorRecords returns the dynamic spread factor records of all pools, ordered by pool id.
+func (k Keeper) GetAllDynamicSpreadFactorRecords(ctx sdk.Context) ([]types.DynamicSpreadFactorRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorRecordPrefix, parseDynamicSpreadFactorRecordFromBz)
+}
+
+// GetEffectiveSpreadFactor returns the spread factor that swaps of the given pool are charged.
+// This is the last computed dynamic spread factor if the pool is in the dynamic spread factor mode,
+// and the spread factor of the pool otherwise.
+// Returns error if the pool does not exist.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (sdk.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.getEffectiveSpreadFactor(ctx, pool), nil
+}
+
+func (k Keeper) getEffectiveSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension) sdk.Dec {
+	effectiveSpreadFactor, err := osmoutils.GetDec(ctx.KVStore(k.storeKey), types.KeyEffectiveSpreadFactor(pool.GetId()))
+	if err != nil {
+		return pool.GetSpreadFactor(ctx)
+	}
+	return effectiveSpreadFactor
+}
+
+// getSwapSpreadFactor returns the spread factor to charge a swap for which the given spread factor was requested.
+// Callers request the spread factor of the pool, possibly discounted (e.g. for multi-hop swaps), so the effective
+// spread factor of pools in the dynamic spread factor mode is discounted in the same proportion.
+func (k Keeper) getSwapSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor sdk.Dec) sdk.Dec {
+	effectiveSpreadFactor, err := osmoutils.GetDec(ctx.KVStore(k.storeKey), types.KeyEffectiveSpreadFactor(pool.GetId()))
+	if err != nil {
+		return spreadFactor
+	}
+	poolSpreadFactor := pool.GetSpreadFactor(ctx)
+	if poolSpreadFactor.IsZero() {
+		return effectiveSpreadFactor
+	}
+	return effectiveSpreadFactor.Mul(spreadFactor).Quo(poolSpreadFactor)
+}
+
+// UpdateDynamicSpreadFactors recomputes the effective spread factor of every pool in the dynamic spread factor mode.
+// The effective spread factor is the spread factor of the pool plus the volatility multiplier of its record times the
+// realized volatility of its TWAP over the volatility window, bounded by the min and max spread factors of the record.
+// If the volatility of a pool cannot be computed, e.g. because its TWAP records do not cover the volatility window yet,
+// the error is logged and its effective spread factor is left unchanged.
+func (k Keeper) UpdateDynamicSpreadFactors(ctx sdk.Context) {
+	records, err := k.GetAllDynamicSpreadFactorRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, record := range records {
+		record := record
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.updateDynamicSpreadFactor(ctx, record)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to update the dynamic spread factor of pool (%d): %s", record.PoolId, err))
+		}
+	}
+}
+
+func (k Keeper) updateDynamicSpreadFactor(ctx sdk.Context, record types.DynamicSpreadFactorRecord) error {
+	pool, err := k.getPoolById(ctx, record.PoolId)
+	if err != nil {
+		return err
+	}
+	volatility, err := k.computeVolatility(ctx, pool, record.VolatilityWindow)
+	if err != nil {
+		return err
+	}
+
+	effectiveSpreadFactor := boundSpreadFactor(pool.GetSpreadFactor(ctx).Add(record.VolatilityMultiplier.Mul(volatility)), record)
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.KeyEffectiveSpreadFactor(record.PoolId), effectiveSpreadFactor)
+	return nil
+}
+
+// computeVolatility returns the realized volatility of the price of the given pool over the window ending at the
+// current block time. The window is split into volatilityNumSubWindows sub-windows, and the volatility is the root
+// mean square of the returns between the arithmetic TWAPs of consecutive sub-windows.
+func (k Keeper) computeVolatility(ctx sdk.Context, pool types.ConcentratedPoolExtension, window time.Duration) (sdk.Dec, error) {
+	subWindow := window / volatilityNumSubWindows
+	if subWindow <= 0 {
+		return sdk.Dec{}, fmt.Errorf("volatility window (%s) is too short", window)
+	}
+
+	startTime := ctx.BlockTime().Add(-subWindow * volatilityNumSubWindows)
+	sumSquaredReturns := sdk.ZeroDec()
+	previousTwap := sdk.Dec{}
+	for i := 0; i < volatilityNumSubWindows; i++ {
+		subWindowStart := startTime.Add(subWindow * time.Duration(i))
+		twap, err := k.twapKeeper.GetArithmeticTwap(ctx, pool.GetId(), pool.GetToken0(), pool.GetToken1(), subWindowStart, subWindowStart.Add(subWindow))
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !twap.IsPositive() {
+			return sdk.Dec{}, types.NonPositiveTwapError{PoolId: pool.GetId(), Twap: twap}
+		}
+
+		if i > 0 {
+			twapReturn := twap.Sub(previousTwap).Quo(previousTwap)
+			sumSquaredReturns = sumSquaredReturns.Add(twapReturn.Mul(twapReturn))
+		}
+		previousTwap = twap
+	}
+
+	return osmomath.MonotonicSqrt(sumSquaredReturns.QuoInt64(volatilityNumSubWindows - 1))
+}
+
+// boundSpreadFactor returns the given spread factor bounded by the min and max spread factors of the given record.
+func boundSpreadFactor(spreadFactor sdk.Dec, record types.DynamicSpreadFactorRecord) sdk.Dec {
+	if spreadFactor.LT(record.MinSpreadFactor) {
+		return record.MinSpreadFactor
+	}
+	if spreadFactor.GT(record.MaxSpreadFactor) {
+		return record.MaxSpreadFactor
+	}
+	return spreadFactor
+}
+
+func parseDynamicSpreadFactorRecordFromBz(bz []byte) (types.DynamicSpreadFactorRecord, error) {
+	record := types.DynamicSpreadFactorRecord{}
+	err := record.Unmarshal(bz)
+	return record, err
+}
@@ -0,0 +1,250 @@
+package concentrated_liquidity_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+var defaultVolatilityWindow = time.Hour
+
+// mockTwapKeeper returns the twap of the sub-window of the default volatility window starting at the given start time.
+type mockTwapKeeper struct {
+	endTime time.Time
+	twaps   []sdk.Dec
+}
+
+func (m mockTwapKeeper) GetArithmeticTwap(_ sdk.Context, _ uint64, _, _ string, startTime, _ time.Time) (sdk.Dec, error) {
+	subWindow := defaultVolatilityWindow / time.Duration(len(m.twaps))
+	index := startTime.Sub(m.endTime.Add(-defaultVolatilityWindow)) / subWindow
+	return m.twaps[index], nil
+}
+
+func constantTwaps(twap sdk.Dec) []sdk.Dec {
+	twaps := make([]sdk.Dec, 10)
+	for i := range twaps {
+		twaps[i] = twap
+	}
+	return twaps
+}
+
+func (s *KeeperTestSuite) defaultDynamicSpreadFactorRecord(poolId uint64) types.DynamicSpreadFactorRecord {
+	return types.DynamicSpreadFactorRecord{
+		PoolId:               poolId,
+		MinSpreadFactor:      sdk.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: sdk.MustNewDecFromStr("0.1"),
+		VolatilityWindow:     defaultVolatilityWindow,
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactorRecord() {
+	tests := map[string]struct {
+		poolSpreadFactor sdk.Dec
+		modifyRecord     func(*types.DynamicSpreadFactorRecord)
+
+		expectedEffectiveSpreadFactor sdk.Dec
+		expectedError                 error
+	}{
+		"spread factor within the bounds": {
+			poolSpreadFactor:              sdk.MustNewDecFromStr("0.003"),
+			expectedEffectiveSpreadFactor: sdk.MustNewDecFromStr("0.003"),
+		},
+		"spread factor below the min spread factor": {
+			poolSpreadFactor:              sdk.ZeroDec(),
+			expectedEffectiveSpreadFactor: sdk.MustNewDecFromStr("0.001"),
+		},
+		"spread factor above the max spread factor": {
+			poolSpreadFactor:              sdk.MustNewDecFromStr("0.005"),
+			modifyRecord:                  func(r *types.DynamicSpreadFactorRecord) { r.MaxSpreadFactor = sdk.MustNewDecFromStr("0.004") },
+			expectedEffectiveSpreadFactor: sdk.MustNewDecFromStr("0.004"),
+		},
+		"error: pool does not exist": {
+			poolSpreadFactor: sdk.ZeroDec(),
+			modifyRecord:     func(r *types.DynamicSpreadFactorRecord) { r.PoolId = 2 },
+			expectedError:    types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: min spread factor above the max spread factor": {
+			poolSpreadFactor: sdk.ZeroDec(),
+			modifyRecord:     func(r *types.DynamicSpreadFactorRecord) { r.MinSpreadFactor = sdk.MustNewDecFromStr("0.02") },
+			expectedError:    fmt.Errorf("min spread factor (0.020000000000000000) must not be greater than max spread factor (0.010000000000000000)"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, tc.poolSpreadFactor)
+			record := s.defaultDynamicSpreadFactorRecord(pool.GetId())
+			if tc.modifyRecord != nil {
+				tc.modifyRecord(&record)
+			}
+
+			err := s.clk.SetDynamicSpreadFactorRecord(s.Ctx, record)
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			storedRecord, err := s.clk.GetDynamicSpreadFactorRecord(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(record, storedRecord)
+
+			effectiveSpreadFactor, err := s.clk.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedEffectiveSpreadFactor, effectiveSpreadFactor)
+
+			// Removing the record restores the spread factor of the pool.
+			s.Require().NoError(s.clk.RemoveDynamicSpreadFactorRecord(s.Ctx, pool.GetId()))
+			effectiveSpreadFactor, err = s.clk.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.poolSpreadFactor, effectiveSpreadFactor)
+
+			_, err = s.clk.GetDynamicSpreadFactorRecord(s.Ctx, pool.GetId())
+			s.Require().ErrorIs(err, types.DynamicSpreadFactorRecordNotFoundError{PoolId: pool.GetId()})
+			err = s.clk.RemoveDynamicSpreadFactorRecord(s.Ctx, pool.GetId())
+			s.Require().ErrorIs(err, types.DynamicSpreadFactorRecordNotFoundError{PoolId: pool.GetId()})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactors() {
+	poolSpreadFactor := sdk.MustNewDecFromStr("0.003")
+
+	tests := map[string]struct {
+		twaps []sdk.Dec
+
+		expectedEffectiveSpreadFactor sdk.Dec
+	}{
+		"no volatility": {
+			twaps:                         constantTwaps(sdk.NewDec(5000)),
+			expectedEffectiveSpreadFactor: poolSpreadFactor,
+		},
+		"volatility within the bounds": {
+			// Every other sub-window moves the price up by 1%, so the realized volatility is sqrt(4 * 0.01^2 / 9) = 0.02 / 3.
+			twaps: []sdk.Dec{
+				sdk.NewDec(100), sdk.NewDec(100), sdk.NewDec(101), sdk.NewDec(101), sdk.MustNewDecFromStr("102.01"),
+				sdk.MustNewDecFromStr("102.01"), sdk.MustNewDecFromStr("103.0301"), sdk.MustNewDecFromStr("103.0301"),
+				sdk.MustNewDecFromStr("104.060401"), sdk.MustNewDecFromStr("104.060401"),
+			},
+			expectedEffectiveSpreadFactor: sdk.MustNewDecFromStr("0.003666666666666663"),
+		},
+		"volatility above the max spread factor": {
+			twaps: []sdk.Dec{
+				sdk.NewDec(100), sdk.NewDec(200), sdk.NewDec(100), sdk.NewDec(200), sdk.NewDec(100),
+				sdk.NewDec(200), sdk.NewDec(100), sdk.NewDec(200), sdk.NewDec(100), sdk.NewDec(200),
+			},
+			expectedEffectiveSpreadFactor: sdk.MustNewDecFromStr("0.01"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, poolSpreadFactor)
+			s.Require().NoError(s.clk.SetDynamicSpreadFactorRecord(s.Ctx, s.defaultDynamicSpreadFactorRecord(pool.GetId())))
+
+			s.clk.SetTwapKeeper(mockTwapKeeper{endTime: s.Ctx.BlockTime(), twaps: tc.twaps})
+			s.clk.UpdateDynamicSpreadFactors(s.Ctx)
+
+			effectiveSpreadFactor, err := s.clk.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedEffectiveSpreadFactor, effectiveSpreadFactor)
+		})
+	}
+}
+
+// TestUpdateDynamicSpreadFactors_TwapError tests that the effective spread factor is left unchanged
+// when the twap records of the pool do not cover the volatility window.
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactors_TwapError() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+	s.Require().NoError(s.clk.SetDynamicSpreadFactorRecord(s.Ctx, s.defaultDynamicSpreadFactorRecord(pool.GetId())))
+
+	// The pool was just created, so it has no twap records for the volatility window.
+	s.clk.UpdateDynamicSpreadFactors(s.Ctx)
+
+	effectiveSpreadFactor, err := s.clk.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.003"), effectiveSpreadFactor)
+}
+
+// TestSwap_DynamicSpreadFactor tests that swaps of pools in the dynamic spread factor mode are charged their
+// effective spread factor, discounted in the same proportion as the requested spread factor.
+func (s *KeeperTestSuite) TestSwap_DynamicSpreadFactor() {
+	poolSpreadFactor := sdk.MustNewDecFromStr("0.002")
+	record := s.defaultDynamicSpreadFactorRecord(1)
+	record.MinSpreadFactor = sdk.MustNewDecFromStr("0.004")
+
+	tests := map[string]struct {
+		requestedSpreadFactor sdk.Dec
+		expectedSpreadFactor  sdk.Dec
+	}{
+		"pool spread factor": {
+			requestedSpreadFactor: poolSpreadFactor,
+			expectedSpreadFactor:  record.MinSpreadFactor,
+		},
+		"discounted spread factor": {
+			requestedSpreadFactor: poolSpreadFactor.QuoInt64(2),
+			expectedSpreadFactor:  record.MinSpreadFactor.QuoInt64(2),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, poolSpreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			tokenIn := sdk.NewCoin(USDC, sdk.NewInt(1_000_000))
+
+			// The expected amount out is the one of a swap charged the expected spread factor without a record.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			expectedTokenOut, err := s.clk.CalcOutAmtGivenIn(cacheCtx, pool, tokenIn, ETH, tc.expectedSpreadFactor)
+			s.Require().NoError(err)
+
+			s.Require().NoError(s.clk.SetDynamicSpreadFactorRecord(s.Ctx, record))
+			tokenOut, err := s.clk.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, tc.requestedSpreadFactor)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut, tokenOut)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleSetDynamicSpreadFactorRecordsProposal() {
+	s.SetupTest()
+	pools := []uint64{s.PrepareConcentratedPool().GetId(), s.PrepareConcentratedPool().GetId()}
+	records := []types.DynamicSpreadFactorRecord{s.defaultDynamicSpreadFactorRecord(pools[0]), s.defaultDynamicSpreadFactorRecord(pools[1])}
+
+	err := s.clk.HandleSetDynamicSpreadFactorRecordsProposal(s.Ctx, &types.SetDynamicSpreadFactorRecordsProposal{
+		Title:       "Title",
+		Description: "Description",
+		Records:     records,
+	})
+	s.Require().NoError(err)
+	storedRecords, err := s.clk.GetAllDynamicSpreadFactorRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(records, storedRecords)
+
+	err = s.clk.HandleSetDynamicSpreadFactorRecordsProposal(s.Ctx, &types.SetDynamicSpreadFactorRecordsProposal{
+		Title:           "Title",
+		Description:     "Description",
+		PoolIdsToRemove: []uint64{pools[0]},
+	})
+	s.Require().NoError(err)
+	storedRecords, err = s.clk.GetAllDynamicSpreadFactorRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(records[1:], storedRecords)
+
+	// Removing a record that does not exist fails.
+	err = s.clk.HandleSetDynamicSpreadFactorRecordsProposal(s.Ctx, &types.SetDynamicSpreadFactorRecordsProposal{
+		Title:           "Title",
+		Description:     "Description",
+		PoolIdsToRemove: []uint64{pools[0]},
+	})
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorRecordNotFoundError{PoolId: pools[0]})
+}
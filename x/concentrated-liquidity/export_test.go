@@ -146,6 +146,10 @@ func (k Keeper) FungifyChargedPosition(ctx sdk.Context, owner sdk.AccAddress, po
 	return k.fungifyChargedPosition(ctx, owner, positionIds)
 }
 
+func (k Keeper) TransferPositions(ctx sdk.Context, positionIds []uint64, sender, newOwner sdk.AccAddress) error {
+	return k.transferPositions(ctx, positionIds, sender, newOwner)
+}
+
 func (k Keeper) ValidatePositionsAndGetTotalLiquidity(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64, fullyChargedDuration time.Duration) (uint64, int64, int64, sdk.Dec, error) {
 	return k.validatePositionsAndGetTotalLiquidity(ctx, owner, positionIds, fullyChargedDuration)
 }
@@ -337,9 +341,41 @@ func (k Keeper) GetLargestSupportedUptimeDuration(ctx sdk.Context) time.Duration
 func (k Keeper) SetupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension,
 	spreadFactor sdk.Dec, tokenInDenom string,
 	priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
-	return k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	return k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
 }
 
 func MoveRewardsToNewPositionAndDeleteOldAcc(ctx sdk.Context, accum *accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
 	return moveRewardsToNewPositionAndDeleteOldAcc(accum, oldPositionName, newPositionName, growthOutside)
 }
+
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, enabled bool) error {
+	return k.setPositionAutoCompound(ctx, sender, positionId, enabled)
+}
+
+func (k Keeper) SetPositionAutoCompoundRecord(ctx sdk.Context, record model.PositionAutoCompound) {
+	k.setPositionAutoCompoundRecord(ctx, record)
+}
+
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) uint64 {
+	return k.getAutoCompoundCursor(ctx)
+}
+
+func AmountsToSwapToPositionRatio(pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	return amountsToSwapToPositionRatio(pool, position, amount0, amount1)
+}
+
+func (k Keeper) MergePositions(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64) (uint64, error) {
+	return k.mergePositions(ctx, owner, positionIds)
+}
+
+func (k Keeper) SplitPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, liquidityAmounts []sdk.Dec) ([]uint64, error) {
+	return k.splitPosition(ctx, owner, positionId, liquidityAmounts)
+}
+
+func (k Keeper) CompoundPosition(ctx sdk.Context, record model.PositionAutoCompound) error {
+	return k.compoundPosition(ctx, record)
+}
+
+func (k Keeper) GetTickLiquiditySnapshotCursor(ctx sdk.Context) (uint64, bool) {
+	return k.getTickLiquiditySnapshotCursor(ctx)
+}
@@ -100,6 +100,52 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set limit orders and their books
+	for _, book := range genState.LimitOrderBooks {
+		if _, ok := seenPoolIds[book.PoolId]; !ok {
+			panic(fmt.Sprintf("found limit order book with pool id (%d) but there is no pool with such id that exists", book.PoolId))
+		}
+		k.setLimitOrderBook(ctx, book)
+	}
+	for _, order := range genState.LimitOrders {
+		if _, err := k.getLimitOrderBook(ctx, order.PoolId, order.TickIndex, order.TokenInDenom, order.Generation); err != nil {
+			panic(err)
+		}
+		k.setLimitOrder(ctx, order)
+	}
+	if err := k.initLimitOrderEscrows(ctx, genState.LimitOrderBooks, genState.LimitOrders); err != nil {
+		panic(err)
+	}
+	if genState.NextLimitOrderId != 0 {
+		k.SetNextLimitOrderId(ctx, genState.NextLimitOrderId)
+	}
+
+	// set dynamic spread factor records, their effective spread factors are recomputed at the beginning of the next block
+	for _, record := range genState.DynamicSpreadFactorRecords {
+		if err := k.SetDynamicSpreadFactorRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	// set auto-compound records of positions
+	for _, record := range genState.AutoCompoundPositions {
+		if _, err := k.GetPosition(ctx, record.PositionId); err != nil {
+			panic(err)
+		}
+		k.setPositionAutoCompoundRecord(ctx, record)
+	}
+
+	// set tick liquidity snapshots, the next snapshots are taken one interval after the latest imported one
+	for _, snapshot := range genState.TickLiquiditySnapshots {
+		if _, err := k.getPoolById(ctx, snapshot.PoolId); err != nil {
+			panic(err)
+		}
+		k.setTickLiquiditySnapshot(ctx, snapshot)
+		if lastSnapshotTime, found := k.getLastTickLiquiditySnapshotTime(ctx); !found || snapshot.Time.After(lastSnapshotTime) {
+			k.setLastTickLiquiditySnapshotTime(ctx, snapshot.Time)
+		}
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 }
@@ -234,12 +280,43 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	limitOrderBooks, err := k.GetAllLimitOrderBooks(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactorRecords, err := k.GetAllDynamicSpreadFactorRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	autoCompoundPositions, err := k.GetAllPositionAutoCompounds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	tickLiquiditySnapshots, err := k.GetAllTickLiquiditySnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
-		Params:                k.GetParams(ctx),
-		PoolData:              poolData,
-		PositionData:          positionData,
-		NextPositionId:        k.GetNextPositionId(ctx),
-		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		Params:                     k.GetParams(ctx),
+		PoolData:                   poolData,
+		PositionData:               positionData,
+		NextPositionId:             k.GetNextPositionId(ctx),
+		NextIncentiveRecordId:      k.GetNextIncentiveRecordId(ctx),
+		LimitOrders:                limitOrders,
+		LimitOrderBooks:            limitOrderBooks,
+		NextLimitOrderId:           k.GetNextLimitOrderId(ctx),
+		DynamicSpreadFactorRecords: dynamicSpreadFactorRecords,
+		AutoCompoundPositions:      autoCompoundPositions,
+		TickLiquiditySnapshots:     tickLiquiditySnapshots,
 	}
 }
 
@@ -36,6 +36,8 @@ var (
 			AuthorizedQuoteDenoms:        []string{ETH, USDC},
 			BalancerSharesRewardDiscount: types.DefaultBalancerSharesDiscount,
 			AuthorizedUptimes:            types.DefaultAuthorizedUptimes,
+			AutoCompoundSwapTwapWindow:   types.DefaultAutoCompoundSwapTwapWindow,
+			MaxAutoCompoundSwapSlippage:  types.DefaultMaxAutoCompoundSwapSlippage,
 		},
 		PoolData:              []genesis.PoolData{},
 		NextIncentiveRecordId: 2,
@@ -819,6 +821,56 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	}
 }
 
+// TestDynamicSpreadFactorRecordsGenesis tests that dynamic spread factor records are exported and imported,
+// with the effective spread factors of their pools reset to their bounded spread factors.
+func (s *KeeperTestSuite) TestDynamicSpreadFactorRecordsGenesis() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.MustNewDecFromStr("0.003"))
+	record := types.DynamicSpreadFactorRecord{
+		PoolId:               pool.GetId(),
+		MinSpreadFactor:      sdk.MustNewDecFromStr("0.004"),
+		MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: sdk.OneDec(),
+		VolatilityWindow:     time.Hour,
+	}
+	s.Require().NoError(s.clk.SetDynamicSpreadFactorRecord(s.Ctx, record))
+
+	exported := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.DynamicSpreadFactorRecord{record}, exported.DynamicSpreadFactorRecords)
+
+	s.SetupTest()
+	s.clk.InitGenesis(s.Ctx, *exported)
+
+	records, err := s.clk.GetAllDynamicSpreadFactorRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DynamicSpreadFactorRecord{record}, records)
+	effectiveSpreadFactor, err := s.clk.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(record.MinSpreadFactor, effectiveSpreadFactor)
+}
+
+// TestAutoCompoundPositionsGenesis tests that the auto-compound records of positions are exported and imported
+// along with their positions.
+func (s *KeeperTestSuite) TestAutoCompoundPositionsGenesis() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+	s.Require().NoError(s.clk.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, true))
+	expectedRecords := []model.PositionAutoCompound{{PositionId: positionId, LastCompoundTime: defaultBlockTime}}
+	s.clk.SetPositionAutoCompoundRecord(s.Ctx, expectedRecords[0])
+
+	exported := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Equal(expectedRecords, exported.AutoCompoundPositions)
+
+	s.SetupTest()
+	s.clk.InitGenesis(s.Ctx, *exported)
+
+	records, err := s.clk.GetUserAutoCompoundPositions(s.Ctx, s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecords, records)
+}
+
 // TestMarshalUnmarshalGenesis tests the MarshalUnmarshalGenesis functions of the ConcentratedLiquidityKeeper.
 // It checks that the exported genesis can be marshaled and unmarshaled without panicking.
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -854,3 +906,40 @@ func (s *KeeperTestSuite) validateTicks(expectedTicks []genesis.FullTick, actual
 		s.Require().Equal(expectedTicks[i].Info, tick.Info, "tick (%d) infos are not equal", i)
 	}
 }
+
+func (s *KeeperTestSuite) TestTickLiquiditySnapshotsGenesis() {
+	s.SetupTest()
+	poolId := s.setupTickLiquiditySnapshotPool(time.Hour, 48*time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	expectedSnapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(expectedSnapshots, 2)
+
+	exported := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Equal(expectedSnapshots, exported.TickLiquiditySnapshots)
+
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(expectedSnapshots[1].Time)
+	s.clk.InitGenesis(s.Ctx, *exported)
+	// The next pool id is imported by x/poolmanager, whose pool ids the snapshots iterate over.
+	s.App.PoolManagerKeeper.SetNextPoolId(s.Ctx, poolId+1)
+
+	snapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedSnapshots, snapshots)
+
+	// The next snapshot is taken one interval after the latest imported one.
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	snapshots, err = s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	_, err = s.clk.GetTickLiquiditySnapshotAtOrBeforeTime(s.Ctx, poolId, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	snapshots, err = s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 3)
+}
@@ -30,6 +30,21 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSetDynamicSpreadFactorRecordsProposal sets and removes the dynamic spread factor records of a proposal.
+func (k Keeper) HandleSetDynamicSpreadFactorRecordsProposal(ctx sdk.Context, p *types.SetDynamicSpreadFactorRecordsProposal) error {
+	for _, record := range p.Records {
+		if err := k.SetDynamicSpreadFactorRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+	for _, poolId := range p.PoolIdsToRemove {
+		if err := k.RemoveDynamicSpreadFactorRecord(ctx, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
@@ -37,6 +52,8 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.SetDynamicSpreadFactorRecordsProposal:
+			return k.HandleSetDynamicSpreadFactorRecordsProposal(ctx, c)
 
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
@@ -826,8 +826,6 @@ func updateAccumAndClaimRewards(accum *accum.AccumulatorObject, positionKey stri
 // The given growth outside the positions range is used for claim rewards accounting.
 // The rewards are moved as "unclaimed rewards" to the new position.
 // Returns nil on success. Error otherwise.
-// NOTE: It is only used by fungifyChargedPosition which we disabled for launch.
-// nolint: unused
 func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
 	if oldPositionName == newPositionName {
 		return types.ModifySamePositionAccumulatorError{PositionAccName: oldPositionName}
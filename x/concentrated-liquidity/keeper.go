@@ -27,6 +27,7 @@ type Keeper struct {
 	incentivesKeeper     types.IncentivesKeeper
 	lockupKeeper         types.LockupKeeper
 	communityPoolKeeper  types.CommunityPoolKeeper
+	twapKeeper           types.TwapKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, gammKeeper types.GAMMKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, lockupKeeper types.LockupKeeper, communityPoolKeeper types.CommunityPoolKeeper, paramSpace paramtypes.Subspace) *Keeper {
@@ -79,6 +80,11 @@ func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
 	k.incentivesKeeper = incentivesKeeper
 }
 
+// Set the twap keeper.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // GetNextPositionId returns the next position id.
 func (k Keeper) GetNextPositionId(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
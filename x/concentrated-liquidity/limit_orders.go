@@ -0,0 +1,631 @@
+package concentrated_liquidity
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdkprefix "github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+// PlaceLimitOrder places a limit order selling tokenIn at the price of the given tick.
+// tokenIn is sent from the owner to the pool and added to the order book of the tick. It is escrowed in the pool
+// apart from the liquidity of its positions, so it is not counted in the liquidity of the pool.
+// If tokenIn is token0 of the pool, the order is an ask that is filled once the price crosses the tick upwards.
+// If tokenIn is token1 of the pool, the order is a bid that is filled once the price crosses the tick downwards.
+// The tick is initialized so that swaps stop at it to fill the order.
+// Returns the id of the new order.
+//
+// Returns error if:
+// - the pool does not exist or has no liquidity
+// - tokenIn is not one of the pool tokens
+// - the tick is not a multiple of the tick spacing of the pool or is out of bounds
+// - an ask is placed at or below the current tick, or a bid above the current tick
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tickIndex int64, tokenIn sdk.Coin) (uint64, error) {
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	if tokenIn.Denom != pool.GetToken0() && tokenIn.Denom != pool.GetToken1() {
+		return 0, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return 0, types.NotPositiveRequireAmountError{Amount: tokenIn.Amount.String()}
+	}
+
+	tickSpacing := pool.GetTickSpacing()
+	if tickIndex%int64(tickSpacing) != 0 || tickIndex < types.MinInitializedTick || tickIndex > types.MaxTick {
+		return 0, types.InvalidLimitOrderTickSpacingError{TickIndex: tickIndex, TickSpacing: tickSpacing}
+	}
+
+	currentTick := pool.GetCurrentTick()
+	isAsk := tokenIn.Denom == pool.GetToken0()
+	if (isAsk && tickIndex <= currentTick) || (!isAsk && tickIndex > currentTick) {
+		return 0, types.InvalidLimitOrderTickError{TickIndex: tickIndex, CurrentTick: currentTick, IsAsk: isAsk}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(tokenIn))
+	k.updateLimitOrderEscrow(ctx, poolId, tokenIn.Denom, tokenIn.Amount.ToDec())
+
+	// Initialize the tick without adding liquidity to it so that swaps cross it.
+	if err := k.initOrUpdateTick(ctx, poolId, currentTick, tickIndex, sdk.ZeroDec(), false); err != nil {
+		return 0, err
+	}
+
+	// Orders join the latest book at the tick unless all of its orders are filled,
+	// in which case they start the book of the next generation.
+	book, found, err := k.getLatestLimitOrderBook(ctx, poolId, tickIndex, tokenIn.Denom)
+	if err != nil {
+		return 0, err
+	}
+	if !found || book.AmountRemaining.IsZero() {
+		generation := uint64(0)
+		if found {
+			generation = book.Generation + 1
+		}
+		book = model.LimitOrderBook{
+			PoolId:           poolId,
+			TickIndex:        tickIndex,
+			TokenInDenom:     tokenIn.Denom,
+			Generation:       generation,
+			TotalShares:      sdk.ZeroDec(),
+			AmountRemaining:  sdk.ZeroDec(),
+			ProceedsPerShare: sdk.ZeroDec(),
+		}
+	}
+
+	amountIn := tokenIn.Amount.ToDec()
+	shares := amountIn
+	if !book.TotalShares.IsZero() {
+		shares = amountIn.Mul(book.TotalShares).QuoTruncate(book.AmountRemaining)
+	}
+	book.TotalShares = book.TotalShares.Add(shares)
+	book.AmountRemaining = book.AmountRemaining.Add(amountIn)
+	k.setLimitOrderBook(ctx, book)
+
+	orderId := k.getNextLimitOrderIdAndIncrement(ctx)
+	k.setLimitOrder(ctx, model.LimitOrder{
+		OrderId:                    orderId,
+		Owner:                      owner.String(),
+		PoolId:                     poolId,
+		TickIndex:                  tickIndex,
+		TokenInDenom:               tokenIn.Denom,
+		Generation:                 book.Generation,
+		Shares:                     shares,
+		ProceedsPerShareCheckpoint: book.ProceedsPerShare,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPlaceLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTickIndex, strconv.FormatInt(tickIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+	))
+
+	return orderId, nil
+}
+
+// ClaimLimitOrder sends the proceeds of the filled part of the given order to its owner.
+// Orders that are completely filled are deleted once claimed.
+// Returns error if the order does not exist or is not owned by owner.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, book, pool, err := k.getOwnedLimitOrder(ctx, owner, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	_, proceeds := limitOrderAmounts(order, book, pool)
+	if book.AmountRemaining.IsZero() {
+		k.removeLimitOrderFromBook(ctx, order, book, owner)
+	} else {
+		order.ProceedsPerShareCheckpoint = book.ProceedsPerShare
+		k.setLimitOrder(ctx, order)
+	}
+
+	if err := k.sendLimitOrderPayout(ctx, pool, owner, proceeds); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.clearLimitOrderEscrowIfNoBooks(ctx, pool.GetId())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtClaimLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyProceedsClaimed, proceeds.String()),
+	))
+
+	return proceeds, nil
+}
+
+// CancelLimitOrder deletes the given order, refunding its owner the part of the order that is not filled yet
+// and sending them the proceeds of the filled part.
+// The tick of the order is removed if no liquidity and no orders that are not filled are left at it.
+// Returns error if the order does not exist or is not owned by owner.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (amountRefunded sdk.Coin, proceeds sdk.Coin, err error) {
+	order, book, pool, err := k.getOwnedLimitOrder(ctx, owner, orderId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	amountRefunded, proceeds = limitOrderAmounts(order, book, pool)
+
+	// The last order of the book gets all of its remaining amount so that no dust is left behind.
+	amountRemaining := book.AmountRemaining
+	if !order.Shares.Equal(book.TotalShares) {
+		amountRemaining = book.AmountRemaining.Mul(order.Shares).QuoTruncate(book.TotalShares)
+	}
+	book.AmountRemaining = book.AmountRemaining.Sub(amountRemaining)
+	k.removeLimitOrderFromBook(ctx, order, book, owner)
+
+	if err := k.sendLimitOrderPayout(ctx, pool, owner, amountRefunded); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err := k.sendLimitOrderPayout(ctx, pool, owner, proceeds); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err := k.removeTicksIfEmpty(ctx, pool, []int64{order.TickIndex}); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.clearLimitOrderEscrowIfNoBooks(ctx, pool.GetId())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCancelLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyAmountRefunded, amountRefunded.String()),
+		sdk.NewAttribute(types.AttributeKeyProceedsClaimed, proceeds.String()),
+	))
+
+	return amountRefunded, proceeds, nil
+}
+
+// fillLimitOrders fills the limit orders selling orderDenom at the given tick with the amount remaining of a swap
+// that is crossing the tick, at the price of the tick. No spread factor is charged on fills.
+// If outGivenIn is true, amountSpecifiedRemaining is the amount of the other pool token swapped in. Otherwise, it is
+// the amount of orderDenom swapped out.
+// Returns the amount of orderDenom filled, the amount of the other token paid for it, and whether all the orders
+// at the tick are filled. The amounts filled and paid are integers, rounded in favor of the orders. A partial fill
+// with an amount swapped in pays the orders all of it, including the rest that is not enough for one more unit.
+func (k Keeper) fillLimitOrders(ctx sdk.Context, pool types.ConcentratedPoolExtension, tickIndex int64, orderDenom string, amountSpecifiedRemaining sdk.Dec, outGivenIn bool) (amountFilled sdk.Dec, amountPaid sdk.Dec, fullyFilled bool, err error) {
+	book, found, err := k.getLatestLimitOrderBook(ctx, pool.GetId(), tickIndex, orderDenom)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, err
+	}
+	if !found || book.AmountRemaining.IsZero() {
+		return sdk.ZeroDec(), sdk.ZeroDec(), true, nil
+	}
+
+	price, err := math.TickToPrice(tickIndex)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, err
+	}
+	isAsk := orderDenom == pool.GetToken0()
+
+	if outGivenIn {
+		cost := limitOrderCost(book.AmountRemaining, price, isAsk)
+		if amountSpecifiedRemaining.GTE(cost) {
+			amountFilled, amountPaid = book.AmountRemaining, cost
+		} else {
+			amountFilled, amountPaid = sdk.MinDec(limitOrderAmountForCost(amountSpecifiedRemaining, price, isAsk), book.AmountRemaining), amountSpecifiedRemaining
+		}
+	} else {
+		amountFilled = sdk.MinDec(amountSpecifiedRemaining, book.AmountRemaining)
+		amountPaid = limitOrderCost(amountFilled, price, isAsk)
+	}
+
+	book.AmountRemaining = book.AmountRemaining.Sub(amountFilled)
+	book.ProceedsPerShare = book.ProceedsPerShare.Add(amountPaid.QuoTruncate(book.TotalShares))
+	k.setLimitOrderBook(ctx, book)
+
+	// The amount filled leaves the escrow for the swapper while the amount paid is escrowed for the orders.
+	proceedsDenom := pool.GetToken0()
+	if isAsk {
+		proceedsDenom = pool.GetToken1()
+	}
+	k.updateLimitOrderEscrow(ctx, pool.GetId(), orderDenom, amountFilled.Neg())
+	k.updateLimitOrderEscrow(ctx, pool.GetId(), proceedsDenom, amountPaid)
+
+	return amountFilled, amountPaid, book.AmountRemaining.IsZero(), nil
+}
+
+// limitOrderCost returns the amount of the other pool token that must be paid for the given amount of an order,
+// rounded up to an integer. price is the amount of token1 per token0.
+func limitOrderCost(amount sdk.Dec, price sdk.Dec, isAsk bool) sdk.Dec {
+	if isAsk {
+		return amount.MulRoundUp(price).Ceil()
+	}
+	return amount.QuoRoundUp(price).Ceil()
+}
+
+// limitOrderAmountForCost returns the amount of an order that the given cost pays for, rounded down to an integer.
+// price is the amount of token1 per token0.
+func limitOrderAmountForCost(cost sdk.Dec, price sdk.Dec, isAsk bool) sdk.Dec {
+	if isAsk {
+		return cost.QuoTruncate(price).TruncateDec()
+	}
+	return cost.MulTruncate(price).TruncateDec()
+}
+
+// limitOrderAmounts returns the amount of the given order that is not filled yet
+// and the proceeds that it can claim, rounded down.
+func limitOrderAmounts(order model.LimitOrder, book model.LimitOrderBook, pool types.ConcentratedPoolExtension) (amountRemaining sdk.Coin, proceeds sdk.Coin) {
+	proceedsDenom := pool.GetToken0()
+	if order.TokenInDenom == pool.GetToken0() {
+		proceedsDenom = pool.GetToken1()
+	}
+
+	remaining := sdk.ZeroInt()
+	if !book.TotalShares.IsZero() {
+		remaining = book.AmountRemaining.Mul(order.Shares).QuoTruncate(book.TotalShares).TruncateInt()
+	}
+	proceedsAmount := book.ProceedsPerShare.Sub(order.ProceedsPerShareCheckpoint).MulTruncate(order.Shares).TruncateInt()
+
+	return sdk.NewCoin(order.TokenInDenom, remaining), sdk.NewCoin(proceedsDenom, proceedsAmount)
+}
+
+// getOwnedLimitOrder returns the given order along with its book and pool.
+// Returns error if the order does not exist or is not owned by owner.
+func (k Keeper) getOwnedLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (model.LimitOrder, model.LimitOrderBook, types.ConcentratedPoolExtension, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return model.LimitOrder{}, model.LimitOrderBook{}, nil, err
+	}
+	if order.Owner != owner.String() {
+		return model.LimitOrder{}, model.LimitOrderBook{}, nil, types.LimitOrderOwnerMismatchError{OrderId: orderId, Owner: order.Owner, Sender: owner.String()}
+	}
+
+	book, err := k.getLimitOrderBook(ctx, order.PoolId, order.TickIndex, order.TokenInDenom, order.Generation)
+	if err != nil {
+		return model.LimitOrder{}, model.LimitOrderBook{}, nil, err
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return model.LimitOrder{}, model.LimitOrderBook{}, nil, err
+	}
+
+	return order, book, pool, nil
+}
+
+// removeLimitOrderFromBook deletes the given order and removes its shares from its book.
+// The book is deleted once it has no shares left.
+func (k Keeper) removeLimitOrderFromBook(ctx sdk.Context, order model.LimitOrder, book model.LimitOrderBook, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLimitOrderId(order.OrderId))
+	store.Delete(types.KeyAddressPoolIdLimitOrderId(owner, order.PoolId, order.OrderId))
+
+	book.TotalShares = book.TotalShares.Sub(order.Shares)
+	if book.TotalShares.IsZero() {
+		store.Delete(types.KeyLimitOrderBook(book.PoolId, book.TickIndex, book.TokenInDenom, book.Generation))
+		return
+	}
+	k.setLimitOrderBook(ctx, book)
+}
+
+// sendLimitOrderPayout sends the given coin from the pool to the owner of a limit order, if it is positive.
+func (k Keeper) sendLimitOrderPayout(ctx sdk.Context, pool types.ConcentratedPoolExtension, owner sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	k.RecordTotalLiquidityDecrease(ctx, sdk.NewCoins(coin))
+	k.updateLimitOrderEscrow(ctx, pool.GetId(), coin.Denom, coin.Amount.ToDec().Neg())
+	return nil
+}
+
+// removeTicksIfEmpty removes the given ticks of the pool that hold no liquidity and no limit orders that are not
+// filled yet. These ticks were only initialized for limit orders, which are all filled or cancelled.
+func (k Keeper) removeTicksIfEmpty(ctx sdk.Context, pool types.ConcentratedPoolExtension, tickIndexes []int64) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, tickIndex := range tickIndexes {
+		key := types.KeyTick(pool.GetId(), tickIndex)
+		tickInfo := model.TickInfo{}
+		found, err := osmoutils.Get(store, key, &tickInfo)
+		if err != nil {
+			return err
+		}
+		if !found || !tickInfo.LiquidityGross.IsZero() {
+			continue
+		}
+
+		hasOrders := false
+		for _, denom := range []string{pool.GetToken0(), pool.GetToken1()} {
+			book, found, err := k.getLatestLimitOrderBook(ctx, pool.GetId(), tickIndex, denom)
+			if err != nil {
+				return err
+			}
+			hasOrders = hasOrders || (found && book.AmountRemaining.IsPositive())
+		}
+		if !hasOrders {
+			store.Delete(key)
+		}
+	}
+	return nil
+}
+
+// GetLimitOrderEscrow returns the amounts held by the given pool for its limit orders, which are the amounts
+// of the orders that are not filled yet and the proceeds that are not claimed yet. They are rounded up.
+func (k Keeper) GetLimitOrderEscrow(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyLimitOrderEscrowsForPool(poolId))
+	defer iter.Close()
+
+	prefixLen := len(types.KeyLimitOrderEscrowsForPool(poolId))
+	escrow := sdk.Coins{}
+	for ; iter.Valid(); iter.Next() {
+		amount := sdk.DecProto{}
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		escrow = escrow.Add(sdk.NewCoin(string(iter.Key()[prefixLen:]), amount.Dec.Ceil().TruncateInt()))
+	}
+	return escrow, nil
+}
+
+// updateLimitOrderEscrow adds the given amount, which may be negative, to the amount of denom escrowed by the pool
+// for its limit orders.
+func (k Keeper) updateLimitOrderEscrow(ctx sdk.Context, poolId uint64, denom string, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyLimitOrderEscrow(poolId, denom)
+	escrow := sdk.DecProto{Dec: sdk.ZeroDec()}
+	if _, err := osmoutils.Get(store, key, &escrow); err != nil {
+		panic(err)
+	}
+	osmoutils.MustSet(store, key, &sdk.DecProto{Dec: escrow.Dec.Add(amount)})
+}
+
+// initLimitOrderEscrows sets the amounts escrowed by the pools for the given limit orders and their books,
+// which are the amounts of the books that are not filled yet and the proceeds that the orders can claim.
+func (k Keeper) initLimitOrderEscrows(ctx sdk.Context, books []model.LimitOrderBook, orders []model.LimitOrder) error {
+	for _, book := range books {
+		k.updateLimitOrderEscrow(ctx, book.PoolId, book.TokenInDenom, book.AmountRemaining)
+	}
+	for _, order := range orders {
+		book, err := k.getLimitOrderBook(ctx, order.PoolId, order.TickIndex, order.TokenInDenom, order.Generation)
+		if err != nil {
+			return err
+		}
+		pool, err := k.getPoolById(ctx, order.PoolId)
+		if err != nil {
+			return err
+		}
+		_, proceeds := limitOrderAmounts(order, book, pool)
+		k.updateLimitOrderEscrow(ctx, order.PoolId, proceeds.Denom, proceeds.Amount.ToDec())
+	}
+	return nil
+}
+
+// clearLimitOrderEscrowIfNoBooks deletes the escrow of the given pool once it has no limit order books left.
+// Rounding the proceeds of the orders down leaves dust in the escrow that no order can claim.
+func (k Keeper) clearLimitOrderEscrowIfNoBooks(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	booksIter := sdk.KVStorePrefixIterator(store, types.KeyLimitOrderBooksForPool(poolId))
+	hasBooks := booksIter.Valid()
+	booksIter.Close()
+	if hasBooks {
+		return
+	}
+
+	escrowKeys := [][]byte{}
+	escrowIter := sdk.KVStorePrefixIterator(store, types.KeyLimitOrderEscrowsForPool(poolId))
+	for ; escrowIter.Valid(); escrowIter.Next() {
+		escrowKeys = append(escrowKeys, escrowIter.Key())
+	}
+	escrowIter.Close()
+	for _, key := range escrowKeys {
+		store.Delete(key)
+	}
+}
+
+// GetLimitOrder returns the limit order with the given id.
+// Returns types.LimitOrderNotFoundError if it does not exist.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (model.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	order := model.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrderId(orderId), &order)
+	if err != nil {
+		return model.LimitOrder{}, err
+	}
+	if !found {
+		return model.LimitOrder{}, types.LimitOrderNotFoundError{OrderId: orderId}
+	}
+	return order, nil
+}
+
+// setLimitOrder stores the given limit order and indexes it by its owner and pool.
+func (k Keeper) setLimitOrder(ctx sdk.Context, order model.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLimitOrderId(order.OrderId), &order)
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+	osmoutils.MustSet(store, types.KeyAddressPoolIdLimitOrderId(owner, order.PoolId, order.OrderId), &gogotypes.UInt64Value{Value: order.OrderId})
+}
+
+// getLimitOrderBook returns the limit order book of the given generation selling denom at the given tick.
+func (k Keeper) getLimitOrderBook(ctx sdk.Context, poolId uint64, tickIndex int64, denom string, generation uint64) (model.LimitOrderBook, error) {
+	store := ctx.KVStore(k.storeKey)
+	book := model.LimitOrderBook{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrderBook(poolId, tickIndex, denom, generation), &book)
+	if err != nil {
+		return model.LimitOrderBook{}, err
+	}
+	if !found {
+		return model.LimitOrderBook{}, types.LimitOrderBookNotFoundError{PoolId: poolId, TickIndex: tickIndex, TokenInDenom: denom, Generation: generation}
+	}
+	return book, nil
+}
+
+// getLatestLimitOrderBook returns the limit order book of the latest generation selling denom at the given tick.
+// Returns false if there is no book at the tick.
+func (k Keeper) getLatestLimitOrderBook(ctx sdk.Context, poolId uint64, tickIndex int64, denom string) (model.LimitOrderBook, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.KeyLimitOrderBookGenerations(poolId, tickIndex, denom))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return model.LimitOrderBook{}, false, nil
+	}
+
+	book := model.LimitOrderBook{}
+	if err := book.Unmarshal(iter.Value()); err != nil {
+		return model.LimitOrderBook{}, false, err
+	}
+	return book, true, nil
+}
+
+// setLimitOrderBook stores the given limit order book.
+func (k Keeper) setLimitOrderBook(ctx sdk.Context, book model.LimitOrderBook) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLimitOrderBook(book.PoolId, book.TickIndex, book.TokenInDenom, book.Generation), &book)
+}
+
+// GetNextLimitOrderId returns the next limit order id.
+func (k Keeper) GetNextLimitOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextLimitOrderId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(store, types.KeyNextGlobalLimitOrderId, &nextLimitOrderId)
+	if err != nil {
+		panic(err)
+	}
+	// Limit order ids start at 1 on chains that did not have limit orders at genesis.
+	if !found {
+		return 1
+	}
+	return nextLimitOrderId.Value
+}
+
+// SetNextLimitOrderId sets the next limit order id.
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextGlobalLimitOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+// getNextLimitOrderIdAndIncrement returns the next limit order id and increments it.
+func (k Keeper) getNextLimitOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	nextLimitOrderId := k.GetNextLimitOrderId(ctx)
+	k.SetNextLimitOrderId(ctx, nextLimitOrderId+1)
+	return nextLimitOrderId
+}
+
+// GetUserLimitOrdersSerialized returns the limit orders of the given address, in the given pool if poolId is non-zero.
+// Each order is broken down by the amount that is not filled yet and the proceeds it can claim.
+func (k Keeper) GetUserLimitOrdersSerialized(ctx sdk.Context, addr sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]model.FullLimitOrderBreakdown, *query.PageResponse, error) {
+	var prefix []byte
+	var expectedKeyPartCount int
+	if poolId == 0 {
+		expectedKeyPartCount = 2
+		prefix = types.KeyUserLimitOrders(addr)
+	} else {
+		expectedKeyPartCount = 1
+		prefix = types.KeyUserLimitOrdersForPool(addr, poolId)
+	}
+
+	ordersStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
+
+	fullOrders := []model.FullLimitOrderBreakdown{}
+
+	pageRes, err := query.Paginate(ordersStore, pagination, func(key, value []byte) error {
+		parts := bytes.Split(key, []byte(types.KeySeparator))
+		if len(parts) != expectedKeyPartCount {
+			return fmt.Errorf("invalid key format: %s", key)
+		}
+
+		orderId, err := strconv.ParseUint(string(parts[expectedKeyPartCount-1]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse orderId: %w", err)
+		}
+
+		order, book, pool, err := k.getOwnedLimitOrder(ctx, addr, orderId)
+		if err != nil {
+			return err
+		}
+
+		amountRemaining, claimableProceeds := limitOrderAmounts(order, book, pool)
+		fullOrders = append(fullOrders, model.FullLimitOrderBreakdown{
+			LimitOrder:        order,
+			AmountRemaining:   amountRemaining,
+			ClaimableProceeds: claimableProceeds,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fullOrders, pageRes, nil
+}
+
+// GetLimitOrderBookDepth returns the amount of the limit orders that is not filled yet at every tick of the given pool.
+// Asks sell token0 of the pool and bids sell token1. Both are ordered by tick.
+func (k Keeper) GetLimitOrderBookDepth(ctx sdk.Context, poolId uint64) (asks []model.LimitOrderTickDepth, bids []model.LimitOrderTickDepth, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	books, err := k.getLimitOrderBooks(ctx, types.KeyLimitOrderBooksForPool(poolId))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asks, bids = []model.LimitOrderTickDepth{}, []model.LimitOrderTickDepth{}
+	for _, book := range books {
+		amount := book.AmountRemaining.TruncateInt()
+		if amount.IsZero() {
+			continue
+		}
+
+		depth := model.LimitOrderTickDepth{TickIndex: book.TickIndex, Amount: sdk.NewCoin(book.TokenInDenom, amount)}
+		if book.TokenInDenom == pool.GetToken0() {
+			asks = append(asks, depth)
+		} else {
+			bids = append(bids, depth)
+		}
+	}
+
+	return asks, bids, nil
+}
+
+// getLimitOrderBooks returns all the limit order books stored under the given prefix.
+func (k Keeper) getLimitOrderBooks(ctx sdk.Context, prefix []byte) ([]model.LimitOrderBook, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (model.LimitOrderBook, error) {
+		book := model.LimitOrderBook{}
+		err := book.Unmarshal(bz)
+		return book, err
+	})
+}
+
+// GetAllLimitOrderBooks returns the limit order books of all the pools.
+func (k Keeper) GetAllLimitOrderBooks(ctx sdk.Context) ([]model.LimitOrderBook, error) {
+	return k.getLimitOrderBooks(ctx, types.LimitOrderBookPrefix)
+}
+
+// GetAllLimitOrders returns the limit orders of all the pools, ordered by id.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]model.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.LimitOrderIdPrefix, func(bz []byte) (model.LimitOrder, error) {
+		order := model.LimitOrder{}
+		err := order.Unmarshal(bz)
+		return order, err
+	})
+}
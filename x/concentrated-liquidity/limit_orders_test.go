@@ -0,0 +1,435 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+var (
+	// Ticks of the prices 5001 and 4999, right above and below the current price of the default position.
+	askTick = int64(31001000)
+	bidTick = int64(30999000)
+
+	limitOrderAmt0 = sdk.NewInt(1000)
+	limitOrderAmt1 = sdk.NewInt(4_999_000)
+)
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		skipPosition bool
+		tickIndex    int64
+		tokenIn      sdk.Coin
+
+		expectedError error
+	}{
+		"ask above the current tick": {
+			tickIndex: askTick,
+			tokenIn:   sdk.NewCoin(ETH, limitOrderAmt0),
+		},
+		"bid below the current tick": {
+			tickIndex: bidTick,
+			tokenIn:   sdk.NewCoin(USDC, limitOrderAmt1),
+		},
+		"bid at the current tick": {
+			tickIndex: 31000000,
+			tokenIn:   sdk.NewCoin(USDC, limitOrderAmt1),
+		},
+		"error: ask at the current tick": {
+			tickIndex:     31000000,
+			tokenIn:       sdk.NewCoin(ETH, limitOrderAmt0),
+			expectedError: types.InvalidLimitOrderTickError{TickIndex: 31000000, CurrentTick: 31000000, IsAsk: true},
+		},
+		"error: bid above the current tick": {
+			tickIndex:     askTick,
+			tokenIn:       sdk.NewCoin(USDC, limitOrderAmt1),
+			expectedError: types.InvalidLimitOrderTickError{TickIndex: askTick, CurrentTick: 31000000, IsAsk: false},
+		},
+		"error: tick not a multiple of the tick spacing": {
+			tickIndex:     askTick + 1,
+			tokenIn:       sdk.NewCoin(ETH, limitOrderAmt0),
+			expectedError: types.InvalidLimitOrderTickSpacingError{TickIndex: askTick + 1, TickSpacing: DefaultTickSpacing},
+		},
+		"error: tick above the max tick": {
+			tickIndex:     types.MaxTick + int64(DefaultTickSpacing),
+			tokenIn:       sdk.NewCoin(ETH, limitOrderAmt0),
+			expectedError: types.InvalidLimitOrderTickSpacingError{TickIndex: types.MaxTick + int64(DefaultTickSpacing), TickSpacing: DefaultTickSpacing},
+		},
+		"error: denom not in pool": {
+			tickIndex:     askTick,
+			tokenIn:       sdk.NewCoin(FOO, limitOrderAmt0),
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: FOO},
+		},
+		"error: pool without liquidity": {
+			skipPosition:  true,
+			tickIndex:     askTick,
+			tokenIn:       sdk.NewCoin(ETH, limitOrderAmt0),
+			expectedError: types.NoSpotPriceWhenNoLiquidityError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			if !tc.skipPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			orderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), tc.tickIndex, tc.tokenIn)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), orderId)
+
+			// The deposit is held by the pool.
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom).IsZero())
+
+			// The tick is initialized without liquidity.
+			tickInfo, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), tc.tickIndex)
+			s.Require().NoError(err)
+			s.Require().True(tickInfo.LiquidityGross.IsZero())
+
+			order, err := s.clk.GetLimitOrder(s.Ctx, orderId)
+			s.Require().NoError(err)
+			s.Require().Equal(model.LimitOrder{
+				OrderId:                    orderId,
+				Owner:                      owner.String(),
+				PoolId:                     pool.GetId(),
+				TickIndex:                  tc.tickIndex,
+				TokenInDenom:               tc.tokenIn.Denom,
+				Generation:                 0,
+				Shares:                     tc.tokenIn.Amount.ToDec(),
+				ProceedsPerShareCheckpoint: sdk.ZeroDec(),
+			}, order)
+		})
+	}
+}
+
+// TestLimitOrders_Ask tests that asks are filled by swaps moving the price up across their tick,
+// and that a swap running out at the tick does not cross it.
+func (s *KeeperTestSuite) TestLimitOrders_Ask() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	askPrice, err := math.TickToPrice(askTick)
+	s.Require().NoError(err)
+
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0)))
+	orderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+
+	// Moving the price to the tick takes about 10.7 USDC, so the rest of the swap partially fills the ask.
+	swapIn := sdk.NewCoin(USDC, sdk.NewInt(12_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+
+	pool, err = s.clk.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, askSqrtPrice, err := math.TickToSqrtPrice(askTick)
+	s.Require().NoError(err)
+	s.Require().Equal(askTick-1, pool.GetCurrentTick())
+	s.Require().Equal(askSqrtPrice.String(), pool.GetCurrentSqrtPrice().SDKDec().String())
+
+	orders, _, err := s.clk.GetUserLimitOrdersSerialized(s.Ctx, owner, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	remainingAfterFirstSwap := orders[0].AmountRemaining.Amount
+	s.Require().True(remainingAfterFirstSwap.IsPositive())
+	s.Require().True(remainingAfterFirstSwap.LT(limitOrderAmt0))
+	s.Require().True(orders[0].ClaimableProceeds.Amount.IsPositive())
+
+	// The next swap starts at the tick, so it is entirely filled by the ask at its price.
+	swapIn = sdk.NewCoin(USDC, askPrice.MulInt64(100).TruncateInt())
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	tokenOut, err := s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(100).String(), tokenOut.String())
+
+	orders, _, err = s.clk.GetUserLimitOrdersSerialized(s.Ctx, owner, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(remainingAfterFirstSwap.SubRaw(100).String(), orders[0].AmountRemaining.Amount.String())
+
+	claimed, err := s.clk.ClaimLimitOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+	s.Require().Equal(orders[0].ClaimableProceeds, claimed)
+	s.Require().Equal(claimed, s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC))
+
+	// Nothing is left to claim until the order is filled further.
+	claimedAgain, err := s.clk.ClaimLimitOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+	s.Require().True(claimedAgain.IsZero())
+
+	// A large swap fills the rest of the ask and crosses the tick.
+	swapIn = sdk.NewCoin(USDC, sdk.NewInt(9_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+
+	pool, err = s.clk.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(pool.GetCurrentTick(), askTick)
+
+	_, err = s.clk.ClaimLimitOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+
+	// The owner received the price of the tick for the whole order. Partial fills also pay the orders the rest
+	// of the swap that is not enough for a whole unit of ETH, so the first swap paid up to the price of one more unit.
+	expectedProceeds := askPrice.MulInt(limitOrderAmt0).TruncateInt()
+	proceeds := s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount
+	s.Require().True(proceeds.GTE(expectedProceeds.SubRaw(1)), "proceeds %s, expected %s", proceeds, expectedProceeds)
+	s.Require().True(proceeds.LT(expectedProceeds.Add(askPrice.TruncateInt())), "proceeds %s, expected %s", proceeds, expectedProceeds)
+
+	// Completely filled orders are deleted once claimed.
+	_, err = s.clk.GetLimitOrder(s.Ctx, orderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId})
+	asks, _, err := s.clk.GetLimitOrderBookDepth(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(asks)
+}
+
+// TestLimitOrders_Bid tests that bids are filled by exact amount out swaps moving the price down across their tick.
+func (s *KeeperTestSuite) TestLimitOrders_Bid() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(USDC, limitOrderAmt1)))
+	orderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), bidTick, sdk.NewCoin(USDC, limitOrderAmt1))
+	s.Require().NoError(err)
+
+	// Moving the price to the tick gives about 10.7 USDC, so the swap fills the whole bid and crosses the tick.
+	tokenOut := sdk.NewCoin(USDC, sdk.NewInt(20_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(10_000))))
+	tokenIn, err := s.clk.SwapExactAmountOut(s.Ctx, swapper, pool, ETH, sdk.NewInt(10_000), tokenOut, DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().Equal(tokenOut, s.App.BankKeeper.GetBalance(s.Ctx, swapper, USDC))
+	s.Require().Equal(sdk.NewInt(10_000).Sub(tokenIn).String(), s.App.BankKeeper.GetBalance(s.Ctx, swapper, ETH).Amount.String())
+
+	pool, err = s.clk.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Less(pool.GetCurrentTick(), bidTick)
+
+	// The bid bought 1000 ETH at the price of the tick, and its proceeds are rounded down in favor of the pool.
+	expectedProceeds := sdk.NewCoin(ETH, sdk.NewInt(999))
+	orders, _, err := s.clk.GetUserLimitOrdersSerialized(s.Ctx, owner, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	s.Require().True(orders[0].AmountRemaining.IsZero())
+	s.Require().Equal(expectedProceeds, orders[0].ClaimableProceeds)
+
+	_, bids, err := s.clk.GetLimitOrderBookDepth(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(bids)
+
+	// Orders placed at the tick once it is filled go to a new book, without affecting the filled order.
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0)))
+	newOrderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), bidTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+	newOrder, err := s.clk.GetLimitOrder(s.Ctx, newOrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), newOrder.Generation)
+
+	claimed, err := s.clk.ClaimLimitOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedProceeds, claimed)
+	_, err = s.clk.GetLimitOrder(s.Ctx, orderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId})
+}
+
+// TestLimitOrders_Generations tests that orders placed at a tick whose orders are all filled
+// go to the book of the next generation, while the filled orders can still be claimed.
+func (s *KeeperTestSuite) TestLimitOrders_Generations() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0.MulRaw(2))))
+	filledOrderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+
+	// Fill the ask and move the price back below its tick.
+	swapIn := sdk.NewCoin(USDC, sdk.NewInt(20_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	tokenOut, err := s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, sdk.NewCoin(ETH, tokenOut), USDC, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+
+	newOrderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+	newOrder, err := s.clk.GetLimitOrder(s.Ctx, newOrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), newOrder.Generation)
+
+	orders, _, err := s.clk.GetUserLimitOrdersSerialized(s.Ctx, owner, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Require().True(orders[0].AmountRemaining.IsZero())
+	s.Require().True(orders[0].ClaimableProceeds.IsPositive())
+	s.Require().Equal(limitOrderAmt0, orders[1].AmountRemaining.Amount)
+	s.Require().True(orders[1].ClaimableProceeds.IsZero())
+
+	// Cancelling the new order refunds all of it.
+	refunded, proceeds, err := s.clk.CancelLimitOrder(s.Ctx, owner, newOrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(ETH, limitOrderAmt0), refunded)
+	s.Require().True(proceeds.IsZero())
+
+	// Cancelling the filled order claims its proceeds.
+	refunded, proceeds, err = s.clk.CancelLimitOrder(s.Ctx, owner, filledOrderId)
+	s.Require().NoError(err)
+	s.Require().True(refunded.IsZero())
+	s.Require().Equal(orders[0].ClaimableProceeds, proceeds)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0), proceeds).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+	books, err := s.clk.GetAllLimitOrderBooks(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(books)
+}
+
+// TestLimitOrders_ProRata tests that orders of the same book are filled pro rata to their amounts.
+func (s *KeeperTestSuite) TestLimitOrders_ProRata() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owners := []sdk.AccAddress{s.TestAccs[1], s.TestAccs[2]}
+	orderIds := make([]uint64, len(owners))
+	for i, owner := range owners {
+		// The second order is three times as large as the first one.
+		amount := limitOrderAmt0.MulRaw(int64(2*i + 1))
+		s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, amount)))
+		orderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, amount))
+		s.Require().NoError(err)
+		orderIds[i] = orderId
+	}
+
+	asks, bids, err := s.clk.GetLimitOrderBookDepth(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]model.LimitOrderTickDepth{{TickIndex: askTick, Amount: sdk.NewCoin(ETH, limitOrderAmt0.MulRaw(4))}}, asks)
+	s.Require().Empty(bids)
+
+	// Partially fill the book.
+	swapper := s.TestAccs[0]
+	swapIn := sdk.NewCoin(USDC, sdk.NewInt(20_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+
+	refunded, proceeds := make([]sdk.Coin, len(owners)), make([]sdk.Coin, len(owners))
+	for i, owner := range owners {
+		refunded[i], proceeds[i], err = s.clk.CancelLimitOrder(s.Ctx, owner, orderIds[i])
+		s.Require().NoError(err)
+		s.Require().True(refunded[i].IsPositive())
+		s.Require().True(proceeds[i].IsPositive())
+	}
+
+	s.Require().True(refunded[0].Amount.MulRaw(3).Sub(refunded[1].Amount).Abs().LTE(sdk.NewInt(3)))
+	s.Require().True(proceeds[0].Amount.MulRaw(3).Sub(proceeds[1].Amount).Abs().LTE(sdk.NewInt(3)))
+}
+
+func (s *KeeperTestSuite) TestLimitOrders_NotOwner() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner, other := s.TestAccs[1], s.TestAccs[2]
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0)))
+	orderId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+
+	expectedError := types.LimitOrderOwnerMismatchError{OrderId: orderId, Owner: owner.String(), Sender: other.String()}
+	_, err = s.clk.ClaimLimitOrder(s.Ctx, other, orderId)
+	s.Require().ErrorIs(err, expectedError)
+	_, _, err = s.clk.CancelLimitOrder(s.Ctx, other, orderId)
+	s.Require().ErrorIs(err, expectedError)
+
+	_, _, err = s.clk.CancelLimitOrder(s.Ctx, owner, orderId+1)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId + 1})
+}
+
+// TestLimitOrders_TicksAndEscrow tests that ticks initialized for limit orders are removed once their orders
+// are filled or cancelled, and that the amounts escrowed for the orders are not counted as pool liquidity.
+func (s *KeeperTestSuite) TestLimitOrders_TicksAndEscrow() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	liquidityBefore, err := s.clk.GetTotalPoolLiquidity(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0), sdk.NewCoin(USDC, limitOrderAmt1)))
+	askId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), askTick, sdk.NewCoin(ETH, limitOrderAmt0))
+	s.Require().NoError(err)
+	bidId, err := s.clk.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), bidTick, sdk.NewCoin(USDC, limitOrderAmt1))
+	s.Require().NoError(err)
+	s.Require().True(s.isTickInitialized(pool.GetId(), askTick))
+	s.Require().True(s.isTickInitialized(pool.GetId(), bidTick))
+
+	// The deposits are escrowed apart from the pool liquidity.
+	escrow, err := s.clk.GetLimitOrderEscrow(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, limitOrderAmt0), sdk.NewCoin(USDC, limitOrderAmt1)).String(), escrow.String())
+	liquidity, err := s.clk.GetTotalPoolLiquidity(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(liquidityBefore.String(), liquidity.String())
+
+	// Cancelling the bid removes its tick.
+	_, _, err = s.clk.CancelLimitOrder(s.Ctx, owner, bidId)
+	s.Require().NoError(err)
+	s.Require().False(s.isTickInitialized(pool.GetId(), bidTick))
+
+	// A swap filling the ask and crossing its tick removes the tick, and the proceeds of the ask are escrowed.
+	swapIn := sdk.NewCoin(USDC, sdk.NewInt(20_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	_, err = s.clk.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, sdk.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().False(s.isTickInitialized(pool.GetId(), askTick))
+
+	orders, _, err := s.clk.GetUserLimitOrdersSerialized(s.Ctx, owner, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	escrow, err = s.clk.GetLimitOrderEscrow(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(escrow.AmountOf(ETH).IsZero())
+	s.Require().True(escrow.AmountOf(USDC).GTE(orders[0].ClaimableProceeds.Amount))
+
+	poolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	liquidity, err = s.clk.GetTotalPoolLiquidity(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(poolBalance.Sub(escrow).String(), liquidity.String())
+
+	// Once the last order is claimed, nothing is escrowed anymore.
+	_, err = s.clk.ClaimLimitOrder(s.Ctx, owner, askId)
+	s.Require().NoError(err)
+	escrow, err = s.clk.GetLimitOrderEscrow(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(escrow.IsZero())
+	liquidity, err = s.clk.GetTotalPoolLiquidity(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()).String(), liquidity.String())
+}
+
+// isTickInitialized returns whether the given tick of the pool is initialized.
+func (s *KeeperTestSuite) isTickInitialized(poolId uint64, tickIndex int64) bool {
+	ticks, err := s.clk.GetAllInitializedTicksForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	for _, tick := range ticks {
+		if tick.TickIndex == tickIndex {
+			return true
+		}
+	}
+	return false
+}
@@ -151,6 +151,8 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
 
+	k.listeners.AfterLiquidityAdded(ctx, owner, poolId, tokensAdded)
+
 	return positionId, actualAmount0, actualAmount1, liquidityDelta, lowerTick, upperTick, nil
 }
 
@@ -266,15 +268,18 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		}
 	}
 
+	// The actual amounts are negative since the liquidity is leaving the pool.
 	tokensRemoved := sdk.Coins{}
-	if actualAmount0.IsPositive() {
-		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken0(), actualAmount0))
+	if actualAmount0.IsNegative() {
+		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken0(), actualAmount0.Abs()))
 	}
-	if actualAmount1.IsPositive() {
-		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken1(), actualAmount1))
+	if actualAmount1.IsNegative() {
+		tokensRemoved = tokensRemoved.Add(sdk.NewCoin(pool.GetToken1(), actualAmount1.Abs()))
 	}
 	k.RecordTotalLiquidityDecrease(ctx, tokensRemoved)
 
+	k.listeners.AfterLiquidityRemoved(ctx, owner, position.PoolId, tokensRemoved)
+
 	event := &liquidityChangeEvent{
 		eventType:      types.TypeEvtWithdrawPosition,
 		positionId:     positionId,
@@ -335,6 +340,9 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, sdk.Int{}, sdk.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// Withdrawing the position deletes its auto-compound record, which is moved to the new position.
+	autoCompound, autoCompoundErr := k.GetPositionAutoCompound(ctx, positionId)
+
 	// Withdraw full position.
 	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
@@ -371,6 +379,10 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, err
 	}
+	if autoCompoundErr == nil {
+		autoCompound.PositionId = newPositionId
+		k.setPositionAutoCompoundRecord(ctx, autoCompound)
+	}
 
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -0,0 +1,1566 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/limitOrder.proto
+
+// this is a legacy package that requires additional migration logic
+// in order to use the correct packge. Decision made to use legacy package path
+// until clear steps for migration logic and the unknowns for state breaking are
+// investigated for changing proto package.
+
+package model
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitOrder is a one-sided order to sell token_in_denom for the other token
+// of the pool at the price of tick_index. Asks sell token0 and are filled when
+// the price crosses the tick upwards. Bids sell token1 and are filled when the
+// price crosses the tick downwards.
+type LimitOrder struct {
+	OrderId      uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId       uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TickIndex    int64  `protobuf:"varint,4,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	TokenInDenom string `protobuf:"bytes,5,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	// generation of the order book at the tick that the order was placed in.
+	Generation uint64 `protobuf:"varint,6,opt,name=generation,proto3" json:"generation,omitempty" yaml:"generation"`
+	// shares of the order book owned by the order.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares" yaml:"shares"`
+	// proceeds per share of the order book as of the last time the order
+	// claimed its proceeds.
+	ProceedsPerShareCheckpoint github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=proceeds_per_share_checkpoint,json=proceedsPerShareCheckpoint,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proceeds_per_share_checkpoint" yaml:"proceeds_per_share_checkpoint"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c53c582379d8983, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+// LimitOrderBook pools all the limit orders selling token_in_denom at a tick.
+// Orders are filled pro rata to their shares. Once all the orders of a book are
+// filled, orders placed at the tick go to a new book of the next generation.
+type LimitOrderBook struct {
+	PoolId       uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TickIndex    int64                                  `protobuf:"varint,2,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	TokenInDenom string                                 `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	Generation   uint64                                 `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty" yaml:"generation"`
+	TotalShares  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_shares" yaml:"total_shares"`
+	// amount of token_in_denom that is not filled yet.
+	AmountRemaining github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=amount_remaining,json=amountRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_remaining" yaml:"amount_remaining"`
+	// cumulative amount of the other pool token received per share from fills.
+	ProceedsPerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=proceeds_per_share,json=proceedsPerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proceeds_per_share" yaml:"proceeds_per_share"`
+}
+
+func (m *LimitOrderBook) Reset()         { *m = LimitOrderBook{} }
+func (m *LimitOrderBook) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderBook) ProtoMessage()    {}
+func (*LimitOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c53c582379d8983, []int{1}
+}
+func (m *LimitOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderBook.Merge(m, src)
+}
+func (m *LimitOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderBook proto.InternalMessageInfo
+
+func (m *LimitOrderBook) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrderBook) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *LimitOrderBook) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *LimitOrderBook) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+// FullLimitOrderBreakdown returns:
+// - the limit order itself
+// - the amount of the order that is not filled yet
+// - the amount of proceeds that can be claimed by the order
+type FullLimitOrderBreakdown struct {
+	LimitOrder        LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+	AmountRemaining   types.Coin `protobuf:"bytes,2,opt,name=amount_remaining,json=amountRemaining,proto3" json:"amount_remaining" yaml:"amount_remaining"`
+	ClaimableProceeds types.Coin `protobuf:"bytes,3,opt,name=claimable_proceeds,json=claimableProceeds,proto3" json:"claimable_proceeds" yaml:"claimable_proceeds"`
+}
+
+func (m *FullLimitOrderBreakdown) Reset()         { *m = FullLimitOrderBreakdown{} }
+func (m *FullLimitOrderBreakdown) String() string { return proto.CompactTextString(m) }
+func (*FullLimitOrderBreakdown) ProtoMessage()    {}
+func (*FullLimitOrderBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c53c582379d8983, []int{2}
+}
+func (m *FullLimitOrderBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullLimitOrderBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullLimitOrderBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FullLimitOrderBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullLimitOrderBreakdown.Merge(m, src)
+}
+func (m *FullLimitOrderBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullLimitOrderBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullLimitOrderBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullLimitOrderBreakdown proto.InternalMessageInfo
+
+func (m *FullLimitOrderBreakdown) GetLimitOrder() LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return LimitOrder{}
+}
+
+func (m *FullLimitOrderBreakdown) GetAmountRemaining() types.Coin {
+	if m != nil {
+		return m.AmountRemaining
+	}
+	return types.Coin{}
+}
+
+func (m *FullLimitOrderBreakdown) GetClaimableProceeds() types.Coin {
+	if m != nil {
+		return m.ClaimableProceeds
+	}
+	return types.Coin{}
+}
+
+// LimitOrderTickDepth is the amount of limit orders that is not filled yet at
+// a tick.
+type LimitOrderTickDepth struct {
+	TickIndex int64      `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *LimitOrderTickDepth) Reset()         { *m = LimitOrderTickDepth{} }
+func (m *LimitOrderTickDepth) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderTickDepth) ProtoMessage()    {}
+func (*LimitOrderTickDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c53c582379d8983, []int{3}
+}
+func (m *LimitOrderTickDepth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderTickDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderTickDepth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderTickDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderTickDepth.Merge(m, src)
+}
+func (m *LimitOrderTickDepth) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderTickDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderTickDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderTickDepth proto.InternalMessageInfo
+
+func (m *LimitOrderTickDepth) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *LimitOrderTickDepth) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
+	proto.RegisterType((*LimitOrderBook)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderBook")
+	proto.RegisterType((*FullLimitOrderBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullLimitOrderBreakdown")
+	proto.RegisterType((*LimitOrderTickDepth)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderTickDepth")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/limitOrder.proto", fileDescriptor_8c53c582379d8983)
+}
+
+var fileDescriptor_8c53c582379d8983 = []byte{
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0x8e, 0x93, 0x10, 0x60, 0xf8, 0x1f, 0x16, 0x61, 0x90, 0x36, 0x66, 0x47, 0xbb, 0x08, 0x69,
+	0x85, 0xad, 0xec, 0x8f, 0x56, 0xda, 0x1b, 0x24, 0x43, 0xab, 0x46, 0xad, 0x54, 0x34, 0x54, 0x6d,
+	0x55, 0x55, 0xb2, 0x1c, 0x7b, 0x94, 0x8c, 0x6c, 0xcf, 0xa4, 0xf6, 0x04, 0xc8, 0x5b, 0xf4, 0xa6,
+	0x17, 0x7d, 0x8f, 0x3e, 0x43, 0xcb, 0x25, 0x97, 0x55, 0x2f, 0xac, 0x0a, 0xde, 0x20, 0x4f, 0x50,
+	0x79, 0x3c, 0x49, 0x5c, 0x28, 0x85, 0xb4, 0x57, 0x99, 0x39, 0xdf, 0x39, 0xdf, 0x37, 0x73, 0xce,
+	0x97, 0x31, 0xb0, 0x78, 0x12, 0xf1, 0x84, 0x26, 0x96, 0xc7, 0x99, 0x47, 0x98, 0x88, 0x5d, 0x41,
+	0xfc, 0xdd, 0x90, 0xbe, 0xea, 0x51, 0x9f, 0x8a, 0xbe, 0x15, 0xd2, 0x88, 0x8a, 0xc7, 0xb1, 0x4f,
+	0x62, 0xb3, 0x1b, 0x73, 0xc1, 0xe1, 0x1f, 0xaa, 0xc0, 0x2c, 0x16, 0x8c, 0xf2, 0xcd, 0xe3, 0x46,
+	0x8b, 0x08, 0xb7, 0xb1, 0xf9, 0x4b, 0x9b, 0xb7, 0xb9, 0xac, 0xb0, 0xb2, 0x55, 0x5e, 0xbc, 0x59,
+	0xf7, 0x64, 0xb5, 0xd5, 0x72, 0x13, 0x62, 0xa9, 0x54, 0xcb, 0xe3, 0x94, 0xe5, 0x38, 0x7a, 0x57,
+	0x05, 0xe0, 0xd1, 0x48, 0x11, 0x9a, 0x60, 0x86, 0x67, 0x0b, 0x87, 0xfa, 0xba, 0xb6, 0xa5, 0xed,
+	0x54, 0xed, 0xd5, 0x41, 0x6a, 0x2c, 0xf5, 0xdd, 0x28, 0xfc, 0x1f, 0x0d, 0x11, 0x84, 0xa7, 0xe5,
+	0xb2, 0xe9, 0xc3, 0x6d, 0x30, 0xc5, 0x4f, 0x18, 0x89, 0xf5, 0xf2, 0x96, 0xb6, 0x33, 0x6b, 0x2f,
+	0x0f, 0x52, 0x63, 0x5e, 0x25, 0x67, 0x61, 0x84, 0x73, 0x18, 0xfe, 0x09, 0xa6, 0xbb, 0x9c, 0x87,
+	0x19, 0x6d, 0x45, 0xd2, 0xc2, 0x41, 0x6a, 0x2c, 0xe6, 0x99, 0x0a, 0x40, 0xb8, 0x96, 0xad, 0x9a,
+	0x3e, 0xfc, 0x07, 0x00, 0x41, 0xbd, 0xc0, 0xa1, 0xcc, 0x27, 0xa7, 0x7a, 0x75, 0x4b, 0xdb, 0xa9,
+	0xd8, 0x6b, 0x83, 0xd4, 0x58, 0xc9, 0xf3, 0xc7, 0x18, 0xc2, 0xb3, 0xd9, 0xa6, 0x99, 0xad, 0xe1,
+	0x1e, 0x58, 0x14, 0x3c, 0x20, 0xcc, 0xa1, 0xcc, 0xf1, 0x09, 0xe3, 0x91, 0x3e, 0x25, 0xcf, 0xb4,
+	0x31, 0x48, 0x8d, 0x35, 0x55, 0xf9, 0x15, 0x8e, 0xf0, 0xbc, 0x0c, 0x34, 0xd9, 0x41, 0xb6, 0x85,
+	0xff, 0x02, 0xd0, 0x26, 0x8c, 0xc4, 0xae, 0xa0, 0x9c, 0xe9, 0x35, 0x79, 0xcc, 0x82, 0xec, 0x18,
+	0x43, 0xb8, 0x90, 0x08, 0x9f, 0x81, 0x5a, 0xd2, 0x71, 0x63, 0x92, 0xe8, 0xd3, 0x52, 0x6f, 0xef,
+	0x2c, 0x35, 0x4a, 0x9f, 0x52, 0x63, 0xbb, 0x4d, 0x45, 0xa7, 0xd7, 0x32, 0x3d, 0x1e, 0x59, 0x6a,
+	0x08, 0xf9, 0xcf, 0x6e, 0xe2, 0x07, 0x96, 0xe8, 0x77, 0x49, 0x62, 0x1e, 0x10, 0x6f, 0x90, 0x1a,
+	0x0b, 0xb9, 0x40, 0xce, 0x82, 0xb0, 0xa2, 0x83, 0x6f, 0x35, 0xf0, 0x6b, 0x37, 0xe6, 0x1e, 0x21,
+	0x7e, 0xe2, 0x74, 0x49, 0xec, 0xc8, 0xb8, 0xe3, 0x75, 0x88, 0x17, 0x74, 0x39, 0x65, 0x42, 0x9f,
+	0x91, 0x82, 0x4f, 0x27, 0x16, 0xfc, 0x5d, 0x35, 0xfe, 0x7b, 0xe4, 0x08, 0x6f, 0x0e, 0xf1, 0x43,
+	0x12, 0x1f, 0x65, 0xe8, 0xfe, 0x18, 0x7c, 0x5f, 0x05, 0x8b, 0x63, 0xdb, 0xd8, 0x9c, 0x07, 0xc5,
+	0x11, 0x6b, 0x13, 0x8e, 0xb8, 0xfc, 0xc3, 0x23, 0xae, 0xfc, 0xcc, 0x88, 0xab, 0x77, 0x1d, 0x71,
+	0x07, 0xcc, 0x0b, 0x2e, 0xdc, 0xd0, 0x51, 0x83, 0xce, 0x8d, 0x75, 0x6f, 0xe2, 0xbe, 0xaf, 0x0e,
+	0xcf, 0x38, 0xe6, 0x42, 0x78, 0x4e, 0x6e, 0x8f, 0xf2, 0x99, 0x0b, 0xb0, 0xec, 0x46, 0xbc, 0xc7,
+	0x84, 0x13, 0x93, 0xc8, 0xa5, 0x8c, 0xb2, 0xb6, 0x74, 0xe2, 0xac, 0xdd, 0x9c, 0x58, 0x6d, 0x3d,
+	0x57, 0xbb, 0xca, 0x87, 0xf0, 0x52, 0x1e, 0xc2, 0xc3, 0x08, 0xec, 0x03, 0x78, 0xdd, 0x0b, 0xca,
+	0xce, 0x0f, 0x27, 0xd6, 0xdd, 0xb8, 0xc9, 0x5d, 0x08, 0x2f, 0x5f, 0xb5, 0x14, 0xfa, 0x50, 0x06,
+	0xeb, 0xf7, 0x7b, 0x61, 0x58, 0x30, 0x53, 0x4c, 0xdc, 0xc0, 0xe7, 0x27, 0x0c, 0x3e, 0x07, 0x73,
+	0xf2, 0x31, 0x74, 0xe4, 0x6b, 0x23, 0x5d, 0x35, 0xf7, 0x57, 0xc3, 0xbc, 0xd3, 0x73, 0x68, 0x16,
+	0x08, 0xab, 0xd9, 0x15, 0x30, 0x18, 0x3f, 0xac, 0x90, 0x7c, 0xa3, 0xcd, 0x65, 0x49, 0xbf, 0x61,
+	0xe6, 0xb7, 0x32, 0xb3, 0x07, 0x73, 0x44, 0xb6, 0xcf, 0x29, 0xb3, 0x8d, 0x8c, 0x66, 0xa2, 0xbe,
+	0x06, 0x00, 0x7a, 0xa1, 0x4b, 0x23, 0xb7, 0x15, 0x12, 0x67, 0x78, 0x75, 0xbd, 0x72, 0x9b, 0xd0,
+	0x6f, 0x4a, 0x48, 0x35, 0xf2, 0x3a, 0x05, 0xc2, 0x2b, 0xa3, 0xe0, 0xe1, 0x30, 0xf6, 0x46, 0x03,
+	0xab, 0xe3, 0x4b, 0x3f, 0xa1, 0x5e, 0x70, 0x40, 0xba, 0xa2, 0x73, 0xe5, 0xaf, 0xa6, 0xdd, 0xf1,
+	0xaf, 0xf6, 0x00, 0xd4, 0xf2, 0xdb, 0xdc, 0xde, 0x97, 0x35, 0x75, 0xdc, 0x85, 0x62, 0x5f, 0x10,
+	0x56, 0xf5, 0xf6, 0xcb, 0xb3, 0x8b, 0xba, 0x76, 0x7e, 0x51, 0xd7, 0x3e, 0x5f, 0xd4, 0xb5, 0xd7,
+	0x97, 0xf5, 0xd2, 0xf9, 0x65, 0xbd, 0xf4, 0xf1, 0xb2, 0x5e, 0x7a, 0x61, 0x17, 0x2c, 0xa5, 0x86,
+	0xba, 0x1b, 0xba, 0xad, 0x64, 0xb8, 0xb1, 0x8e, 0x1b, 0xff, 0x59, 0xa7, 0x37, 0x7d, 0x27, 0x23,
+	0xee, 0x93, 0xb0, 0x55, 0x93, 0x9f, 0xb1, 0xbf, 0xbf, 0x0c, 0x00, 0xc7, 0xb4, 0x56, 0xf0, 0x56,
+	0x07, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProceedsPerShareCheckpoint.Size()
+		i -= size
+		if _, err := m.ProceedsPerShareCheckpoint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Generation != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TickIndex != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProceedsPerShare.Size()
+		i -= size
+		if _, err := m.ProceedsPerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AmountRemaining.Size()
+		i -= size
+		if _, err := m.AmountRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Generation != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TickIndex != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FullLimitOrderBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullLimitOrderBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullLimitOrderBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimableProceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AmountRemaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderTickDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderTickDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderTickDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.OrderId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovLimitOrder(uint64(m.TickIndex))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Generation))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.ProceedsPerShareCheckpoint.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func (m *LimitOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovLimitOrder(uint64(m.TickIndex))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Generation))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.AmountRemaining.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.ProceedsPerShare.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func (m *FullLimitOrderBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.AmountRemaining.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.ClaimableProceeds.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func (m *LimitOrderTickDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovLimitOrder(uint64(m.TickIndex))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func sovLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitOrder(x uint64) (n int) {
+	return sovLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedsPerShareCheckpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProceedsPerShareCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedsPerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProceedsPerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FullLimitOrderBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FullLimitOrderBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FullLimitOrderBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderTickDepth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderTickDepth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderTickDepth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
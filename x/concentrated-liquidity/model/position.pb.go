@@ -266,10 +266,69 @@ func (m *PositionWithPeriodLock) GetLocks() types2.PeriodLock {
 	return types2.PeriodLock{}
 }
 
+// PositionAutoCompound opts a position in auto-compounding. At the end of every
+// day epoch, the spread rewards and incentives of the position that are in the
+// denoms of its pool are claimed, swapped to the ratio of the position and
+// added to it.
+type PositionAutoCompound struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	// last_compound_time is the last time that rewards were added to the
+	// position, or the zero time if they never were.
+	LastCompoundTime time.Time `protobuf:"bytes,2,opt,name=last_compound_time,json=lastCompoundTime,proto3,stdtime" json:"last_compound_time" yaml:"last_compound_time"`
+}
+
+func (m *PositionAutoCompound) Reset()         { *m = PositionAutoCompound{} }
+func (m *PositionAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*PositionAutoCompound) ProtoMessage()    {}
+func (*PositionAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffdfd7b30d37d326, []int{3}
+}
+func (m *PositionAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionAutoCompound.Merge(m, src)
+}
+func (m *PositionAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionAutoCompound proto.InternalMessageInfo
+
+func (m *PositionAutoCompound) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *PositionAutoCompound) GetLastCompoundTime() time.Time {
+	if m != nil {
+		return m.LastCompoundTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
 	proto.RegisterType((*FullPositionBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullPositionBreakdown")
 	proto.RegisterType((*PositionWithPeriodLock)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithPeriodLock")
+	proto.RegisterType((*PositionAutoCompound)(nil), "osmosis.concentratedliquidity.v1beta1.PositionAutoCompound")
 }
 
 func init() {
@@ -277,51 +336,54 @@ func init() {
 }
 
 var fileDescriptor_ffdfd7b30d37d326 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0x6e, 0xe8, 0x9f, 0xad, 0xae, 0x84, 0x50, 0x36, 0xa6, 0xac, 0x40, 0x53, 0x05, 0xc1, 0x2a,
-	0x41, 0x13, 0x3a, 0x24, 0x26, 0x71, 0x0c, 0x08, 0x69, 0x12, 0x87, 0x11, 0x86, 0x90, 0x10, 0x52,
-	0x71, 0x62, 0xaf, 0x33, 0x4d, 0xea, 0x2c, 0x76, 0x36, 0x26, 0xf1, 0x10, 0xbb, 0xc0, 0x0b, 0x70,
-	0xe3, 0x49, 0x76, 0xdc, 0x11, 0x71, 0xe8, 0xd0, 0xf6, 0x06, 0x7b, 0x02, 0x64, 0xc7, 0x49, 0xaa,
-	0x89, 0xc1, 0x38, 0xec, 0xd4, 0xd8, 0xdf, 0xef, 0xfb, 0x3e, 0xff, 0xfc, 0xfd, 0xe4, 0x82, 0x3e,
-	0x65, 0x11, 0x65, 0x84, 0x39, 0x01, 0x9d, 0x04, 0x78, 0xc2, 0x13, 0xc8, 0x31, 0xea, 0x87, 0x64,
-	0x27, 0x25, 0x88, 0xf0, 0x7d, 0x27, 0xa6, 0x8c, 0x70, 0x42, 0x27, 0x76, 0x9c, 0x50, 0x4e, 0xf5,
-	0x7b, 0xaa, 0xdc, 0x9e, 0x2d, 0x2f, 0xaa, 0xed, 0xdd, 0x81, 0x8f, 0x39, 0x1c, 0xb4, 0x97, 0x03,
-	0x59, 0x37, 0x94, 0x24, 0x27, 0x5b, 0x64, 0x0a, 0x6d, 0x73, 0x44, 0xe9, 0x28, 0xc4, 0x8e, 0x5c,
-	0xf9, 0xe9, 0x96, 0xc3, 0x49, 0x84, 0x19, 0x87, 0x51, 0xac, 0x0a, 0x3a, 0xe7, 0x0b, 0x50, 0x9a,
-	0xc0, 0xf2, 0x08, 0xed, 0xc5, 0x11, 0x1d, 0xd1, 0x4c, 0x58, 0x7c, 0xe5, 0xac, 0xcc, 0xc4, 0xf1,
-	0x21, 0xc3, 0x8e, 0x3a, 0x86, 0x13, 0x50, 0x92, 0xb3, 0x96, 0xf3, 0x3e, 0x43, 0x1a, 0x8c, 0xd3,
-	0x58, 0xfe, 0x64, 0x90, 0xf5, 0xa5, 0x0a, 0xe6, 0x37, 0x54, 0x9b, 0xfa, 0x1a, 0x68, 0xe5, 0x2d,
-	0x0f, 0x09, 0x32, 0xb4, 0xae, 0xd6, 0xab, 0xb9, 0x4b, 0x67, 0x53, 0x53, 0xdf, 0x87, 0x51, 0xf8,
-	0xd4, 0x9a, 0x01, 0x2d, 0x0f, 0xe4, 0xab, 0x75, 0xa4, 0x3f, 0x04, 0x73, 0x10, 0xa1, 0x04, 0x33,
-	0x66, 0x5c, 0xeb, 0x6a, 0xbd, 0xa6, 0xab, 0x9f, 0x4d, 0xcd, 0xeb, 0x19, 0x49, 0x01, 0x96, 0x97,
-	0x97, 0xe8, 0x0f, 0xc0, 0x5c, 0x4c, 0x69, 0x28, 0x2c, 0xaa, 0xd2, 0x62, 0xa6, 0x5a, 0x01, 0x96,
-	0xd7, 0x10, 0x5f, 0xeb, 0x48, 0xbf, 0x03, 0x40, 0x48, 0xf7, 0x70, 0x32, 0xe4, 0x24, 0x18, 0x1b,
-	0xb5, 0xae, 0xd6, 0xab, 0x7a, 0x4d, 0xb9, 0xb3, 0x49, 0x82, 0xb1, 0x80, 0xd3, 0x38, 0xce, 0xe1,
-	0x7a, 0x06, 0xcb, 0x1d, 0x09, 0xbf, 0x01, 0xcd, 0x8f, 0x94, 0x4c, 0x86, 0xe2, 0x9e, 0x8d, 0x46,
-	0x57, 0xeb, 0xb5, 0x56, 0xdb, 0x76, 0x76, 0xc7, 0x76, 0x7e, 0xc7, 0xf6, 0x66, 0x1e, 0x82, 0x7b,
-	0xfb, 0x70, 0x6a, 0x56, 0xce, 0xa6, 0xe6, 0x8d, 0xec, 0x30, 0x05, 0xd5, 0x3a, 0x38, 0x36, 0x35,
-	0x6f, 0x5e, 0xac, 0x45, 0xb1, 0xfe, 0x01, 0x34, 0x8b, 0xdc, 0x8d, 0x39, 0xd9, 0xb1, 0x2b, 0xa8,
-	0x3f, 0xa7, 0xe6, 0xfd, 0x11, 0xe1, 0xdb, 0xa9, 0x6f, 0x07, 0x34, 0x52, 0xd9, 0xab, 0x9f, 0x3e,
-	0x43, 0x63, 0x87, 0xef, 0xc7, 0x98, 0xd9, 0xcf, 0x71, 0x50, 0x9a, 0x14, 0x42, 0x96, 0x57, 0x8a,
-	0x5a, 0x5f, 0xeb, 0xe0, 0xe6, 0x8b, 0x34, 0x0c, 0xf3, 0x6c, 0xdc, 0x04, 0xc3, 0x31, 0xa2, 0x7b,
-	0x13, 0xfd, 0x15, 0x98, 0xcf, 0x6f, 0x5e, 0x26, 0xd4, 0x5a, 0x75, 0xec, 0x4b, 0x0d, 0xa6, 0x5d,
-	0x68, 0xd5, 0xc4, 0x59, 0xbd, 0x42, 0x46, 0xf7, 0x41, 0x03, 0x32, 0x86, 0xf9, 0x23, 0x99, 0x5e,
-	0x6b, 0x75, 0xd9, 0x56, 0x53, 0x2b, 0x06, 0xaa, 0xa0, 0x3f, 0xa3, 0x64, 0xe2, 0x3a, 0x82, 0xfa,
-	0xfd, 0xd8, 0x5c, 0xb9, 0x44, 0x9b, 0x82, 0xe0, 0x29, 0xe5, 0xc2, 0x63, 0x20, 0x33, 0xbf, 0x0a,
-	0x8f, 0x81, 0xfe, 0x19, 0x18, 0x41, 0x08, 0x49, 0x04, 0xfd, 0x10, 0x0f, 0x59, 0x9c, 0x60, 0x88,
-	0x86, 0x09, 0xde, 0x83, 0x09, 0x62, 0x46, 0xad, 0x5b, 0xfd, 0xbb, 0xeb, 0x8a, 0xca, 0xde, 0xcc,
-	0x62, 0xb9, 0x48, 0xc8, 0xf2, 0x96, 0x0a, 0xe8, 0xb5, 0x44, 0xbc, 0x0c, 0xd0, 0x77, 0xc0, 0x62,
-	0x49, 0x22, 0x32, 0x08, 0xb2, 0x8b, 0x99, 0x51, 0xff, 0x97, 0xf3, 0x5d, 0xe5, 0x7c, 0xeb, 0xbc,
-	0x73, 0x29, 0x62, 0x79, 0x0b, 0xc5, 0xf6, 0x7a, 0xb1, 0x2b, 0x2c, 0xb7, 0x68, 0xb2, 0x85, 0x09,
-	0xc7, 0x68, 0xd6, 0xb2, 0xf1, 0x9f, 0x96, 0x7f, 0x12, 0xb1, 0xbc, 0x85, 0x62, 0xbb, 0xb4, 0xb4,
-	0xbe, 0x69, 0x60, 0x29, 0x1f, 0xa4, 0xb7, 0x84, 0x6f, 0x6f, 0xe0, 0x84, 0x50, 0xf4, 0x92, 0x06,
-	0xe3, 0xab, 0x98, 0xcc, 0x27, 0xa0, 0x2e, 0x1e, 0x2b, 0xa6, 0x06, 0xb3, 0x5d, 0xe8, 0x65, 0x2f,
-	0x99, 0x5d, 0xba, 0x2b, 0x6a, 0x56, 0xee, 0xbe, 0x3f, 0x3c, 0xe9, 0x68, 0x47, 0x27, 0x1d, 0xed,
-	0xd7, 0x49, 0x47, 0x3b, 0x38, 0xed, 0x54, 0x8e, 0x4e, 0x3b, 0x95, 0x1f, 0xa7, 0x9d, 0xca, 0x3b,
-	0x77, 0x66, 0xa8, 0x94, 0x58, 0x3f, 0x84, 0x3e, 0xcb, 0x17, 0xce, 0xee, 0x60, 0xcd, 0xf9, 0x74,
-	0xd1, 0x3f, 0x42, 0x44, 0x11, 0x0e, 0xfd, 0x86, 0x7c, 0x3a, 0x1e, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0x8a, 0x90, 0xc2, 0x40, 0x06, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0x8e, 0xc9, 0x0f, 0x64, 0x22, 0xad, 0x90, 0x61, 0x91, 0x93, 0xdd, 0x8d, 0x23, 0xaf, 0x58,
+	0x22, 0xed, 0xc6, 0xde, 0xb0, 0xd2, 0x22, 0xed, 0xdd, 0x9a, 0xaa, 0x12, 0x52, 0x2f, 0xa8, 0x4b,
+	0x55, 0xa9, 0xaa, 0x94, 0xda, 0x9e, 0x21, 0x4c, 0x63, 0x67, 0x8c, 0x67, 0x0c, 0x45, 0xea, 0x43,
+	0x70, 0xd3, 0xbe, 0x40, 0xef, 0xfa, 0x14, 0xbd, 0xe4, 0x92, 0xcb, 0xaa, 0x17, 0xa1, 0x82, 0x37,
+	0xe0, 0x09, 0xaa, 0xf1, 0xcc, 0x38, 0x11, 0x2d, 0x85, 0x56, 0xe2, 0xca, 0x9e, 0xf9, 0xce, 0xf7,
+	0x7d, 0x33, 0xe7, 0x1c, 0x1f, 0x83, 0x1e, 0xa1, 0x31, 0xa1, 0x98, 0x3a, 0x21, 0x19, 0x87, 0x68,
+	0xcc, 0x52, 0x9f, 0x21, 0xd8, 0x8b, 0xf0, 0x7e, 0x86, 0x21, 0x66, 0x47, 0x4e, 0x42, 0x28, 0x66,
+	0x98, 0x8c, 0xed, 0x24, 0x25, 0x8c, 0xe8, 0xab, 0x32, 0xdc, 0x9e, 0x0d, 0x2f, 0xa2, 0xed, 0x83,
+	0x7e, 0x80, 0x98, 0xdf, 0x6f, 0x35, 0xc3, 0x3c, 0x6e, 0x90, 0x93, 0x1c, 0xb1, 0x10, 0x0a, 0x2d,
+	0x73, 0x48, 0xc8, 0x30, 0x42, 0x4e, 0xbe, 0x0a, 0xb2, 0x5d, 0x87, 0xe1, 0x18, 0x51, 0xe6, 0xc7,
+	0x89, 0x0c, 0x68, 0x5f, 0x0d, 0x80, 0x59, 0xea, 0x4f, 0x8f, 0xd0, 0x5a, 0x1e, 0x92, 0x21, 0x11,
+	0xc2, 0xfc, 0x4d, 0xb1, 0x84, 0x89, 0x13, 0xf8, 0x14, 0x39, 0xf2, 0x18, 0x4e, 0x48, 0xb0, 0x62,
+	0x35, 0xd5, 0x3d, 0x23, 0x12, 0x8e, 0xb2, 0x24, 0x7f, 0x08, 0xc8, 0x7a, 0x5d, 0x06, 0x0b, 0xdb,
+	0xf2, 0x9a, 0xfa, 0x06, 0x68, 0xa8, 0x2b, 0x0f, 0x30, 0x34, 0xb4, 0x8e, 0xd6, 0xad, 0xb8, 0x2b,
+	0x97, 0x13, 0x53, 0x3f, 0xf2, 0xe3, 0xe8, 0x3f, 0x6b, 0x06, 0xb4, 0x3c, 0xa0, 0x56, 0x5b, 0x50,
+	0xff, 0x0b, 0xcc, 0xfb, 0x10, 0xa6, 0x88, 0x52, 0x63, 0xae, 0xa3, 0x75, 0xeb, 0xae, 0x7e, 0x39,
+	0x31, 0x7f, 0x12, 0x24, 0x09, 0x58, 0x9e, 0x0a, 0xd1, 0xff, 0x04, 0xf3, 0x09, 0x21, 0x11, 0xb7,
+	0x28, 0xe7, 0x16, 0x33, 0xd1, 0x12, 0xb0, 0xbc, 0x1a, 0x7f, 0xdb, 0x82, 0xfa, 0x6f, 0x00, 0x44,
+	0xe4, 0x10, 0xa5, 0x03, 0x86, 0xc3, 0x91, 0x51, 0xe9, 0x68, 0xdd, 0xb2, 0x57, 0xcf, 0x77, 0x76,
+	0x70, 0x38, 0xe2, 0x70, 0x96, 0x24, 0x0a, 0xae, 0x0a, 0x38, 0xdf, 0xc9, 0xe1, 0xc7, 0xa0, 0xfe,
+	0x82, 0xe0, 0xf1, 0x80, 0xe7, 0xd9, 0xa8, 0x75, 0xb4, 0x6e, 0x63, 0xbd, 0x65, 0x8b, 0x1c, 0xdb,
+	0x2a, 0xc7, 0xf6, 0x8e, 0x2a, 0x82, 0xfb, 0xeb, 0xc9, 0xc4, 0x2c, 0x5d, 0x4e, 0xcc, 0x45, 0x71,
+	0x98, 0x82, 0x6a, 0x1d, 0x9f, 0x99, 0x9a, 0xb7, 0xc0, 0xd7, 0x3c, 0x58, 0x7f, 0x0e, 0xea, 0x45,
+	0xdd, 0x8d, 0xf9, 0xfc, 0xc6, 0x2e, 0xa7, 0x7e, 0x9c, 0x98, 0x7f, 0x0c, 0x31, 0xdb, 0xcb, 0x02,
+	0x3b, 0x24, 0xb1, 0xac, 0xbd, 0x7c, 0xf4, 0x28, 0x1c, 0x39, 0xec, 0x28, 0x41, 0xd4, 0xbe, 0x87,
+	0xc2, 0xa9, 0x49, 0x21, 0x64, 0x79, 0x53, 0x51, 0xeb, 0x4d, 0x15, 0xfc, 0x7c, 0x3f, 0x8b, 0x22,
+	0x55, 0x1b, 0x37, 0x45, 0xfe, 0x08, 0x92, 0xc3, 0xb1, 0xfe, 0x10, 0x2c, 0xa8, 0xcc, 0xe7, 0x15,
+	0x6a, 0xac, 0x3b, 0xf6, 0xad, 0x1a, 0xd3, 0x2e, 0xb4, 0x2a, 0xfc, 0xac, 0x5e, 0x21, 0xa3, 0x07,
+	0xa0, 0xe6, 0x53, 0x8a, 0xd8, 0xdf, 0x79, 0xf5, 0x1a, 0xeb, 0x4d, 0x5b, 0x76, 0x2d, 0x6f, 0xa8,
+	0x82, 0xbe, 0x49, 0xf0, 0xd8, 0x75, 0x38, 0xf5, 0xdd, 0x99, 0xb9, 0x76, 0x8b, 0x6b, 0x72, 0x82,
+	0x27, 0x95, 0x0b, 0x8f, 0xbe, 0x51, 0xbe, 0x23, 0x8f, 0xbe, 0xfe, 0x0a, 0x18, 0x61, 0xe4, 0xe3,
+	0xd8, 0x0f, 0x22, 0x34, 0xa0, 0x49, 0x8a, 0x7c, 0x38, 0x48, 0xd1, 0xa1, 0x9f, 0x42, 0x6a, 0x54,
+	0x3a, 0xe5, 0x6f, 0xbb, 0xae, 0xc9, 0xda, 0x9b, 0xa2, 0x2c, 0xd7, 0x09, 0x59, 0xde, 0x4a, 0x01,
+	0x3d, 0xca, 0x11, 0x4f, 0x00, 0xfa, 0x3e, 0x58, 0x9e, 0x92, 0x70, 0x5e, 0x08, 0x7c, 0x80, 0xa8,
+	0x51, 0xbd, 0xc9, 0xf9, 0x77, 0xe9, 0xfc, 0xcb, 0x55, 0xe7, 0xa9, 0x88, 0xe5, 0x2d, 0x15, 0xdb,
+	0x5b, 0xc5, 0x2e, 0xb7, 0xdc, 0x25, 0xe9, 0x2e, 0xc2, 0x0c, 0xc1, 0x59, 0xcb, 0xda, 0x77, 0x5a,
+	0x7e, 0x4d, 0xc4, 0xf2, 0x96, 0x8a, 0xed, 0xa9, 0xa5, 0xf5, 0x56, 0x03, 0x2b, 0xaa, 0x91, 0x9e,
+	0x60, 0xb6, 0xb7, 0x8d, 0x52, 0x4c, 0xe0, 0x03, 0x12, 0x8e, 0xee, 0xa2, 0x33, 0xff, 0x05, 0x55,
+	0x3e, 0xac, 0xa8, 0x6c, 0xcc, 0x56, 0xa1, 0x27, 0x26, 0x99, 0x3d, 0x75, 0x97, 0x54, 0x11, 0x6e,
+	0xbd, 0xd7, 0xc0, 0xb2, 0x12, 0xfd, 0x3f, 0x63, 0x64, 0x93, 0xc4, 0x09, 0xc9, 0xc6, 0xf0, 0xc7,
+	0x47, 0x1c, 0x01, 0x7a, 0xe4, 0x53, 0x36, 0x08, 0xa5, 0x92, 0x18, 0x29, 0x73, 0x37, 0x8e, 0x94,
+	0x55, 0x99, 0xe9, 0xa6, 0xfc, 0xda, 0xbf, 0xd0, 0x10, 0xb3, 0x65, 0x91, 0x03, 0xea, 0x94, 0x9c,
+	0xed, 0x3e, 0x3b, 0x39, 0x6f, 0x6b, 0xa7, 0xe7, 0x6d, 0xed, 0xd3, 0x79, 0x5b, 0x3b, 0xbe, 0x68,
+	0x97, 0x4e, 0x2f, 0xda, 0xa5, 0x0f, 0x17, 0xed, 0xd2, 0x53, 0x77, 0xe6, 0xbb, 0x90, 0xf9, 0xe8,
+	0x45, 0x7e, 0x40, 0xd5, 0xc2, 0x39, 0xe8, 0x6f, 0x38, 0x2f, 0xaf, 0xfb, 0xa9, 0xc5, 0x04, 0xa2,
+	0x28, 0xa8, 0xe5, 0x47, 0xfd, 0xe7, 0xf3, 0x00, 0xd9, 0xae, 0x20, 0xd9, 0x03, 0x07, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -530,6 +592,42 @@ func (m *PositionWithPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PositionAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastCompoundTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastCompoundTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintPosition(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPosition(v)
 	base := offset
@@ -616,6 +714,20 @@ func (m *PositionWithPeriodLock) Size() (n int) {
 	return n
 }
 
+func (m *PositionAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastCompoundTime)
+	n += 1 + l + sovPosition(uint64(l))
+	return n
+}
+
 func sovPosition(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1214,6 +1326,108 @@ func (m *PositionWithPeriodLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PositionAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPosition(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,15 +15,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -192,10 +196,122 @@ func (m *UptimeTracker) GetUptimeGrowthOutside() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+// TickLiquiditySnapshot is the liquidity net of the initialized ticks of a
+// pool at a point in time. The liquidity distribution of the pool at that time
+// can be rebuilt from it.
+type TickLiquiditySnapshot struct {
+	PoolId uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// ticks are the initialized ticks of the pool, in ascending order of tick
+	// index.
+	Ticks []SnapshotTick `protobuf:"bytes,3,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+}
+
+func (m *TickLiquiditySnapshot) Reset()         { *m = TickLiquiditySnapshot{} }
+func (m *TickLiquiditySnapshot) String() string { return proto.CompactTextString(m) }
+func (*TickLiquiditySnapshot) ProtoMessage()    {}
+func (*TickLiquiditySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ccb7e45032b943a, []int{3}
+}
+func (m *TickLiquiditySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquiditySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquiditySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquiditySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquiditySnapshot.Merge(m, src)
+}
+func (m *TickLiquiditySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquiditySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquiditySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquiditySnapshot proto.InternalMessageInfo
+
+func (m *TickLiquiditySnapshot) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TickLiquiditySnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TickLiquiditySnapshot) GetTicks() []SnapshotTick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+type SnapshotTick struct {
+	TickIndex    int64                                  `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+}
+
+func (m *SnapshotTick) Reset()         { *m = SnapshotTick{} }
+func (m *SnapshotTick) String() string { return proto.CompactTextString(m) }
+func (*SnapshotTick) ProtoMessage()    {}
+func (*SnapshotTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ccb7e45032b943a, []int{4}
+}
+func (m *SnapshotTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotTick.Merge(m, src)
+}
+func (m *SnapshotTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotTick proto.InternalMessageInfo
+
+func (m *SnapshotTick) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.TickInfo")
 	proto.RegisterType((*UptimeTrackers)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeTrackers")
 	proto.RegisterType((*UptimeTracker)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeTracker")
+	proto.RegisterType((*TickLiquiditySnapshot)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquiditySnapshot")
+	proto.RegisterType((*SnapshotTick)(nil), "osmosis.concentratedliquidity.v1beta1.SnapshotTick")
 }
 
 func init() {
@@ -203,40 +319,50 @@ func init() {
 }
 
 var fileDescriptor_1ccb7e45032b943a = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xee, 0x22, 0x3a, 0x75, 0xbb, 0x90, 0x5d, 0x25, 0x2e, 0x92, 0x96, 0x80, 0x52,
-	0x90, 0x26, 0xb4, 0xab, 0x88, 0x1e, 0xe3, 0xe2, 0x2a, 0x88, 0x0b, 0xa1, 0x5e, 0x44, 0x09, 0x93,
-	0x64, 0xda, 0x1d, 0x9a, 0x64, 0xb2, 0x33, 0x2f, 0x5d, 0x7b, 0xf1, 0xe6, 0xdd, 0x9b, 0xdf, 0xc1,
-	0xbb, 0xdf, 0xa1, 0xc7, 0x3d, 0x8a, 0x87, 0x2a, 0xed, 0x37, 0xf0, 0x13, 0x48, 0x92, 0x49, 0x77,
-	0x5b, 0x10, 0x56, 0xc1, 0x53, 0x32, 0x2f, 0xff, 0xf7, 0xff, 0xff, 0x78, 0x2f, 0x09, 0xee, 0x70,
-	0x19, 0x73, 0xc9, 0xa4, 0x1d, 0xf0, 0x24, 0xa0, 0x09, 0x08, 0x02, 0x34, 0xec, 0x44, 0xec, 0x24,
-	0x63, 0x21, 0x83, 0x89, 0x0d, 0x2c, 0x18, 0xbd, 0x48, 0x06, 0xdc, 0x4a, 0x05, 0x07, 0xae, 0xdd,
-	0x55, 0x72, 0xeb, 0xa2, 0x7c, 0xa9, 0xb6, 0xc6, 0x5d, 0x9f, 0x02, 0xe9, 0xee, 0xdd, 0x0e, 0x0a,
-	0x9d, 0x57, 0x34, 0xd9, 0xe5, 0xa1, 0x74, 0xd8, 0xdb, 0x1d, 0xf2, 0x21, 0x2f, 0xeb, 0xf9, 0x9d,
-	0xaa, 0x1a, 0xa5, 0xc6, 0xf6, 0x89, 0xa4, 0xb6, 0x72, 0xb1, 0x03, 0xce, 0x92, 0xf2, 0xb9, 0xf9,
-	0x75, 0x13, 0x5f, 0xeb, 0x2b, 0x14, 0xed, 0x04, 0x6f, 0x2f, 0x23, 0xbd, 0xa1, 0xe0, 0x52, 0xea,
-	0xa8, 0x85, 0xda, 0xd7, 0x9d, 0xe7, 0xd3, 0x59, 0xb3, 0xf6, 0x7d, 0xd6, 0xbc, 0x37, 0x64, 0x70,
-	0x9c, 0xf9, 0x56, 0xc0, 0x63, 0x15, 0xae, 0x2e, 0x1d, 0x19, 0x8e, 0x6c, 0x98, 0xa4, 0x54, 0x5a,
-	0x07, 0x34, 0xf8, 0x35, 0x6b, 0xde, 0x9a, 0x90, 0x38, 0x7a, 0x62, 0xae, 0xd9, 0x99, 0x6e, 0x63,
-	0x59, 0x39, 0xcc, 0x0b, 0xda, 0x08, 0x6f, 0x9d, 0x6b, 0x12, 0x0a, 0xfa, 0x95, 0x22, 0xf0, 0xd9,
-	0x5f, 0x07, 0xee, 0xae, 0x07, 0x26, 0x14, 0x4c, 0xf7, 0xc6, 0xf2, 0xfc, 0x8a, 0x82, 0x36, 0x45,
-	0xf8, 0xb1, 0x4c, 0x05, 0x25, 0xa1, 0x27, 0xe8, 0x29, 0x11, 0x61, 0x4e, 0x75, 0x0a, 0xc7, 0x1e,
-	0x4f, 0x53, 0x2e, 0x19, 0x50, 0x2f, 0x64, 0x82, 0x06, 0xc0, 0x78, 0xe2, 0xf1, 0x81, 0x17, 0x11,
-	0x09, 0x1e, 0x08, 0x32, 0xa6, 0x42, 0x92, 0x48, 0xdf, 0x68, 0x6d, 0xb4, 0xeb, 0xbd, 0x3b, 0x96,
-	0x9a, 0x7a, 0x3e, 0xd1, 0x6a, 0x2f, 0x39, 0xc3, 0x53, 0xce, 0x12, 0x67, 0x3f, 0xe7, 0xfe, 0xf2,
-	0xa3, 0x79, 0xff, 0x72, 0xdc, 0x79, 0x8f, 0x74, 0x7b, 0x25, 0x93, 0x5b, 0x20, 0x1d, 0x16, 0x44,
-	0x47, 0x0a, 0xe8, 0xa0, 0xe2, 0x39, 0x1a, 0xbc, 0x24, 0x12, 0xfa, 0x15, 0x8c, 0xf6, 0x01, 0x6f,
-	0x67, 0x29, 0xb0, 0x98, 0xe6, 0x80, 0xc1, 0x88, 0x0a, 0xa9, 0x6f, 0xb6, 0x50, 0xbb, 0xde, 0x7b,
-	0x68, 0x5d, 0xea, 0x4d, 0xb2, 0x5e, 0x17, 0xdd, 0x7d, 0xd5, 0xec, 0x18, 0x39, 0xf8, 0xf9, 0xde,
-	0xd6, 0xbc, 0x4d, 0xb7, 0x91, 0xad, 0xe8, 0x4d, 0x8e, 0x1b, 0xab, 0x0e, 0xda, 0x3b, 0xbc, 0x19,
-	0x31, 0x09, 0x3a, 0x2a, 0xc6, 0xf4, 0xe0, 0x5f, 0x30, 0x9c, 0x1d, 0x45, 0x51, 0xaf, 0x96, 0x29,
-	0xc1, 0x74, 0x0b, 0x5b, 0xf3, 0x33, 0xc2, 0x5b, 0x2b, 0x62, 0xed, 0x23, 0xc2, 0x37, 0x15, 0x67,
-	0xb5, 0xc6, 0x0c, 0x24, 0x0b, 0xa9, 0x42, 0xf8, 0x0f, 0x9b, 0xda, 0x29, 0xf3, 0xd4, 0x8e, 0xca,
-	0x34, 0xe7, 0xed, 0x74, 0x6e, 0xa0, 0xb3, 0xb9, 0x81, 0x7e, 0xce, 0x0d, 0xf4, 0x69, 0x61, 0xd4,
-	0xce, 0x16, 0x46, 0xed, 0xdb, 0xc2, 0xa8, 0xbd, 0x71, 0x2e, 0x78, 0xab, 0x71, 0x74, 0x22, 0xe2,
-	0xcb, 0xea, 0x60, 0x8f, 0xbb, 0x8f, 0xec, 0xf7, 0x7f, 0xfa, 0x43, 0xc4, 0x3c, 0xa4, 0x91, 0x7f,
-	0xb5, 0xf8, 0x4e, 0xf7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x27, 0xc8, 0x48, 0x50, 0x04,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0x3b, 0x52, 0x51, 0xa6, 0x50, 0xe2, 0x02, 0x5a, 0x89, 0xd9, 0x25, 0x93, 0x68, 0x48,
+	0x48, 0x77, 0x43, 0xc1, 0x18, 0x3d, 0xae, 0x44, 0x24, 0x21, 0x92, 0xac, 0xf5, 0x62, 0x34, 0x9b,
+	0xe9, 0xee, 0xb4, 0x4c, 0xba, 0xdd, 0x59, 0x76, 0xa6, 0xbc, 0x5c, 0xbc, 0x79, 0xe7, 0xe6, 0x77,
+	0xf0, 0xe6, 0xc1, 0xef, 0xc0, 0x91, 0xa3, 0xf1, 0x50, 0x0c, 0xdc, 0x3d, 0xf0, 0x09, 0xcc, 0xbc,
+	0x6c, 0xa1, 0x24, 0x26, 0x68, 0xa2, 0xa7, 0xdd, 0x99, 0xf9, 0x3f, 0xcf, 0xf3, 0x9b, 0xf9, 0xcf,
+	0x33, 0xb0, 0xce, 0x78, 0x8f, 0x71, 0xca, 0xbd, 0x88, 0xa5, 0x11, 0x49, 0x45, 0x8e, 0x05, 0x89,
+	0xeb, 0x09, 0xdd, 0xe9, 0xd3, 0x98, 0x8a, 0x03, 0x4f, 0xd0, 0xa8, 0xbb, 0x91, 0xb6, 0x99, 0x9b,
+	0xe5, 0x4c, 0x30, 0xeb, 0xa1, 0x91, 0xbb, 0x97, 0xe5, 0x43, 0xb5, 0xbb, 0xbb, 0xdc, 0x22, 0x02,
+	0x2f, 0xcf, 0xdf, 0x8f, 0x94, 0x2e, 0x54, 0x41, 0x9e, 0x1e, 0xe8, 0x0c, 0xf3, 0xb3, 0x1d, 0xd6,
+	0x61, 0x7a, 0x5e, 0xfe, 0x99, 0x59, 0x5b, 0x6b, 0xbc, 0x16, 0xe6, 0xc4, 0x33, 0x59, 0xbc, 0x88,
+	0xd1, 0xd4, 0xac, 0x3b, 0x1d, 0xc6, 0x3a, 0x09, 0xf1, 0xd4, 0xa8, 0xd5, 0x6f, 0x7b, 0x82, 0xf6,
+	0x08, 0x17, 0xb8, 0x97, 0x69, 0x01, 0xfa, 0x5a, 0x86, 0xb7, 0x9b, 0x86, 0xd5, 0xda, 0x81, 0xd3,
+	0x43, 0xa6, 0xb0, 0x93, 0x33, 0xce, 0x6b, 0x60, 0x01, 0x2c, 0x4e, 0xf8, 0x2f, 0x8f, 0x06, 0x4e,
+	0xe9, 0xfb, 0xc0, 0x79, 0xd4, 0xa1, 0x62, 0xbb, 0xdf, 0x72, 0x23, 0xd6, 0x33, 0x74, 0xe6, 0x53,
+	0xe7, 0x71, 0xd7, 0x13, 0x07, 0x19, 0xe1, 0xee, 0x1a, 0x89, 0xce, 0x07, 0xce, 0xdd, 0x03, 0xdc,
+	0x4b, 0x9e, 0xa1, 0x2b, 0xe9, 0x50, 0x50, 0x1d, 0xce, 0xac, 0xcb, 0x09, 0xab, 0x0b, 0xa7, 0x2e,
+	0x34, 0x29, 0x11, 0xb5, 0x1b, 0xaa, 0xe0, 0x8b, 0x3f, 0x2e, 0x38, 0x7b, 0xb5, 0x60, 0x4a, 0x04,
+	0x0a, 0x26, 0x87, 0xe3, 0x57, 0x44, 0x58, 0x47, 0x00, 0x3e, 0xe5, 0x59, 0x4e, 0x70, 0x1c, 0xe6,
+	0x64, 0x0f, 0xe7, 0xb1, 0xa4, 0xda, 0x13, 0xdb, 0x21, 0xcb, 0x32, 0xc6, 0xa9, 0x20, 0x61, 0x4c,
+	0x73, 0x12, 0x09, 0xca, 0xd2, 0x90, 0xb5, 0xc3, 0x04, 0x73, 0x11, 0x8a, 0x1c, 0xef, 0x92, 0x9c,
+	0xe3, 0xa4, 0x36, 0xb6, 0x30, 0xb6, 0x58, 0x69, 0x3c, 0x70, 0x8d, 0x2d, 0xf2, 0xc8, 0x0b, 0xe3,
+	0x24, 0xc3, 0x73, 0x46, 0x53, 0x7f, 0x45, 0x72, 0x7f, 0x3e, 0x71, 0x96, 0xae, 0xc7, 0x2d, 0x63,
+	0x78, 0xd0, 0xd0, 0x4c, 0x81, 0x42, 0x5a, 0x57, 0x44, 0x5b, 0x06, 0x68, 0xad, 0xe0, 0xd9, 0x6a,
+	0x6f, 0x62, 0x2e, 0x9a, 0x05, 0x8c, 0xf5, 0x01, 0x4e, 0xf7, 0x33, 0x69, 0xa6, 0x04, 0x8c, 0xba,
+	0x24, 0xe7, 0xb5, 0xf2, 0x02, 0x58, 0xac, 0x34, 0x1e, 0xbb, 0xd7, 0xba, 0x6a, 0xee, 0x1b, 0x15,
+	0xdd, 0x34, 0xc1, 0xbe, 0x2d, 0xc1, 0x2f, 0x7c, 0xbb, 0x92, 0x1b, 0x05, 0xd5, 0xfe, 0x88, 0x1e,
+	0x31, 0x58, 0x1d, 0xcd, 0x60, 0xbd, 0x87, 0xe5, 0x84, 0x72, 0x51, 0x03, 0xea, 0x98, 0x56, 0xff,
+	0x06, 0xc3, 0x9f, 0x31, 0x14, 0x95, 0xc2, 0x4c, 0x2e, 0x50, 0xa0, 0xd2, 0xa2, 0x4f, 0x00, 0x4e,
+	0x8d, 0x88, 0xad, 0x8f, 0x00, 0xce, 0x19, 0xce, 0xc2, 0xc6, 0xbe, 0xe0, 0x34, 0x26, 0x35, 0xf0,
+	0xaf, 0x9c, 0x9a, 0xd1, 0xf5, 0x8c, 0x47, 0xba, 0x1a, 0xfa, 0x09, 0xe0, 0x9c, 0x6c, 0xa1, 0xcd,
+	0x62, 0x73, 0xaf, 0x53, 0x9c, 0xf1, 0x6d, 0x26, 0xac, 0x25, 0x78, 0x2b, 0x63, 0x2c, 0x09, 0x69,
+	0xac, 0xfa, 0xa8, 0xec, 0x5b, 0xe7, 0x03, 0xa7, 0xaa, 0xf7, 0x66, 0x16, 0x50, 0x30, 0x2e, 0xff,
+	0x36, 0x62, 0x6b, 0x1d, 0x96, 0x65, 0x6e, 0xd5, 0x00, 0x95, 0xc6, 0xbc, 0xab, 0x3b, 0xd7, 0x2d,
+	0x3a, 0xd7, 0x6d, 0x16, 0x9d, 0xeb, 0xdf, 0x1b, 0x3d, 0x25, 0x19, 0x85, 0x0e, 0x4f, 0x1c, 0x10,
+	0xa8, 0x04, 0x56, 0x08, 0x6f, 0xca, 0xd7, 0x87, 0x9b, 0x0b, 0xbb, 0x72, 0x4d, 0x27, 0x0a, 0x6a,
+	0xb9, 0x15, 0x7f, 0xd6, 0x94, 0x98, 0x2c, 0x4a, 0x44, 0x5d, 0x8e, 0x02, 0x9d, 0x17, 0x7d, 0x01,
+	0x70, 0xf2, 0xb2, 0xda, 0x5a, 0x85, 0x50, 0xae, 0x84, 0x34, 0x8d, 0xc9, 0xbe, 0xda, 0xea, 0x98,
+	0x3f, 0x77, 0x3e, 0x70, 0xee, 0x5c, 0x44, 0xeb, 0x35, 0x14, 0x4c, 0xe8, 0x87, 0x31, 0x26, 0xfb,
+	0xff, 0xb5, 0xf5, 0xfd, 0x77, 0x47, 0xa7, 0x36, 0x38, 0x3e, 0xb5, 0xc1, 0x8f, 0x53, 0x1b, 0x1c,
+	0x9e, 0xd9, 0xa5, 0xe3, 0x33, 0xbb, 0xf4, 0xed, 0xcc, 0x2e, 0xbd, 0xf5, 0x2f, 0xd5, 0x31, 0x27,
+	0x55, 0x4f, 0x70, 0x8b, 0x17, 0x03, 0x6f, 0x77, 0xf9, 0x89, 0xb7, 0xff, 0xbb, 0x77, 0xbe, 0xc7,
+	0x62, 0x92, 0xb4, 0xc6, 0x95, 0x4b, 0x2b, 0xbf, 0x06, 0x00, 0x70, 0x17, 0x97, 0x39, 0x16, 0x06,
 	0x00, 0x00,
 }
 
@@ -381,6 +507,94 @@ func (m *UptimeTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TickLiquiditySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquiditySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquiditySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTickInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTickInfo(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotTick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotTick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTickInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTickInfo(v)
 	base := offset
@@ -443,6 +657,40 @@ func (m *UptimeTracker) Size() (n int) {
 	return n
 }
 
+func (m *TickLiquiditySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTickInfo(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTickInfo(uint64(l))
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovTickInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovTickInfo(uint64(m.TickIndex))
+	}
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	return n
+}
+
 func sovTickInfo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -802,6 +1050,245 @@ func (m *UptimeTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TickLiquiditySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquiditySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquiditySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, SnapshotTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTickInfo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
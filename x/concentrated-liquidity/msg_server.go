@@ -200,3 +200,193 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 
 	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives, ForfeitedIncentives: totalForefeitedIncentives}, nil
 }
+
+// PlaceLimitOrder places a limit order selling the given token at the price of the given tick.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: place limit order event is emitted in keeper.PlaceLimitOrder(...)
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+// CancelLimitOrder cancels the given limit order, refunding the part that is not filled yet and claiming its proceeds.
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amountRefunded, proceedsClaimed, err := server.keeper.CancelLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: cancel limit order event is emitted in keeper.CancelLimitOrder(...)
+
+	return &types.MsgCancelLimitOrderResponse{AmountRefunded: amountRefunded, ProceedsClaimed: proceedsClaimed}, nil
+}
+
+// ClaimLimitOrder claims the proceeds of the filled part of the given limit order.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	proceedsClaimed, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: claim limit order event is emitted in keeper.ClaimLimitOrder(...)
+
+	return &types.MsgClaimLimitOrderResponse{ProceedsClaimed: proceedsClaimed}, nil
+}
+
+func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgTransferPositions) (*types.MsgTransferPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.transferPositions(ctx, msg.PositionIds, sender, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: transfer positions event is emitted in keeper.transferPositions(...)
+
+	return &types.MsgTransferPositionsResponse{}, nil
+}
+
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.setPositionAutoCompound(ctx, sender, msg.PositionId, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: set position auto compound event is emitted in keeper.setPositionAutoCompound(...)
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
+
+func (server msgServer) MergePositions(goCtx context.Context, msg *types.MsgMergePositions) (*types.MsgMergePositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPositionId, err := server.keeper.mergePositions(ctx, sender, msg.PositionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: merge positions event is emitted in keeper.mergePositions(...)
+
+	return &types.MsgMergePositionsResponse{NewPositionId: newPositionId}, nil
+}
+
+func (server msgServer) SplitPosition(goCtx context.Context, msg *types.MsgSplitPosition) (*types.MsgSplitPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPositionIds, err := server.keeper.splitPosition(ctx, sender, msg.PositionId, msg.LiquidityAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: split position event is emitted in keeper.splitPosition(...)
+
+	return &types.MsgSplitPositionResponse{NewPositionIds: newPositionIds}, nil
+}
@@ -173,6 +173,7 @@ func (k Keeper) CalculateSpotPrice(
 }
 
 // GetTotalPoolLiquidity returns the coins in the pool owned by all LPs
+// The amounts escrowed by the pool for its limit orders are not owned by LPs, so they are excluded.
 func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
 	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
@@ -185,7 +186,20 @@ func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins
 	// a pool address.
 	filteredPoolBalance := poolBalance.FilterDenoms([]string{pool.GetToken0(), pool.GetToken1()})
 
-	return filteredPoolBalance, nil
+	limitOrderEscrow, err := k.GetLimitOrderEscrow(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolLiquidity sdk.Coins
+	for _, coin := range filteredPoolBalance {
+		amount := coin.Amount.Sub(limitOrderEscrow.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			poolLiquidity = append(poolLiquidity, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return poolLiquidity, nil
 }
 
 // asPoolI takes a types.ConcentratedPoolExtension and attempts to convert it to a
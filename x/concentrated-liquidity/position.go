@@ -2,6 +2,7 @@ package concentrated_liquidity
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -388,6 +389,9 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the auto-compound record of the position (if it exists)
+	k.deletePositionAutoCompound(ctx, positionId)
+
 	return nil
 }
 
@@ -577,57 +581,10 @@ func (k Keeper) fungifyChargedPosition(ctx sdk.Context, owner sdk.AccAddress, po
 		return 0, err
 	}
 
-	// Get the new position's uptime accum name and the pool's uptime accumulators.
-	newPositionUptimeAccName := string(types.KeyPositionId(newPositionId))
-	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
-	if err != nil {
-		return 0, err
-	}
-
-	// Get the new position's spread reward accum name and the pool's spread reward accumulator.
-	newPositionSpreadRewardAccName := types.KeySpreadRewardPositionAccumulator(newPositionId)
-	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
-	if err != nil {
-		return 0, err
-	}
-
-	// Compute uptime growth outside of the range between lower tick and upper tick
-	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
-	if err != nil {
-		return 0, err
-	}
-
-	// Compute the spread reward growth outside of the range between lower tick and upper tick
-	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
-	if err != nil {
-		return 0, err
-	}
-
 	// Move unclaimed rewards from the old positions to the new position.
 	// Also, delete the old positions from state.
-
-	// Loop through each of the old position IDs.
-	for _, oldPositionId := range positionIds {
-		// Loop through each uptime accumulator for the pool.
-		for uptimeIndex, uptimeAccum := range uptimeAccumulators {
-			// Move rewards into the new uptime accumulator and delete the old uptime accumulator.
-			oldPositionName := string(types.KeyPositionId(oldPositionId))
-			if err := moveRewardsToNewPositionAndDeleteOldAcc(uptimeAccum, oldPositionName, newPositionUptimeAccName, uptimeGrowthOutside[uptimeIndex]); err != nil {
-				return 0, err
-			}
-		}
-
-		// Move spread rewards into the new spread reward accumulator and delete the old spread reward accumulator.
-		oldPositionSpreadRewardName := types.KeySpreadRewardPositionAccumulator(oldPositionId)
-		if err := moveRewardsToNewPositionAndDeleteOldAcc(spreadRewardAccumulator, oldPositionSpreadRewardName, newPositionSpreadRewardAccName, spreadRewardGrowthOutside); err != nil {
-			return 0, err
-		}
-
-		// Remove the old position from state.
-		err = k.deletePosition(ctx, oldPositionId, owner, poolId)
-		if err != nil {
-			return 0, err
-		}
+	if err := k.moveRewardsAndDeletePositions(ctx, owner, poolId, lowerTick, upperTick, positionIds, newPositionId); err != nil {
+		return 0, err
 	}
 
 	// Query claimable incentives for events.
@@ -720,6 +677,292 @@ func (k Keeper) validatePositionsAndGetTotalLiquidity(ctx sdk.Context, owner sdk
 	return basePosition.PoolId, basePosition.LowerTick, basePosition.UpperTick, totalLiquidity, nil
 }
 
+// transferPositions transfers the given positions from sender to newOwner by re-keying the owner indexes
+// of the positions. The spread reward and incentive accumulators of a position are keyed by its id, so its
+// unclaimed spread rewards and incentives, as well as its join time, move along with it.
+// Returns error if:
+// - any of the positions does not exist
+// - sender does not own all the positions
+// - any of the positions has an underlying lock, whether it is superfluid staked or not
+func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender, newOwner sdk.AccAddress) error {
+	// Validate all the positions before transferring any of them.
+	positions := make([]model.Position, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if position.Address != sender.String() {
+			return types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: sender.String()}
+		}
+
+		// Positions linked to a lock are owned through the lock, so they can only be moved with it.
+		lockId, err := k.GetLockIdFromPositionId(ctx, positionId)
+		if err == nil {
+			return types.PositionHasUnderlyingLockError{PositionId: positionId, LockId: lockId}
+		}
+		if !errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: positionId}) {
+			return err
+		}
+
+		positions = append(positions, position)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, position := range positions {
+		store.Delete(types.KeyAddressPoolIdPositionId(sender, position.PoolId, position.PositionId))
+		store.Set(types.KeyAddressPoolIdPositionId(newOwner, position.PoolId, position.PositionId), []byte{1})
+
+		position.Address = newOwner.String()
+		osmoutils.MustSet(store, types.KeyPositionId(position.PositionId), &position)
+
+		// Auto-compounding is opted in by the owner, so the new owner has to opt in again.
+		k.deletePositionAutoCompound(ctx, position.PositionId)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferPositions,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
+		),
+	})
+
+	return nil
+}
+
+// mergePositions merges the given positions into a new position with their combined liquidity, and returns its id.
+// The unclaimed spread rewards and incentives of the positions are moved to the new position, and the positions are deleted.
+// The join time of the new position is the latest join time of the positions, so that merging cannot be used to
+// shorten the time a position has to be held to qualify for incentives. If any of the positions is opted in
+// auto-compounding, the new position is opted in as well.
+// Returns error if:
+// - fewer than two or duplicate positions are given
+// - any of the positions does not exist
+// - owner does not own all the positions
+// - the positions are not all in the same pool and tick range
+// - any of the positions has an underlying lock, whether it is superfluid staked or not
+func (k Keeper) mergePositions(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64) (uint64, error) {
+	if len(positionIds) < MinNumPositions {
+		return 0, types.PositionQuantityTooLowError{MinNumPositions: MinNumPositions, NumPositions: len(positionIds)}
+	}
+	if osmoutils.ContainsDuplicate(positionIds) {
+		return 0, types.DuplicatePositionIdsError{PositionIds: positionIds}
+	}
+
+	// Validate all the positions and sum their liquidity.
+	basePosition, err := k.getPositionToMoveRewards(ctx, owner, positionIds[0])
+	if err != nil {
+		return 0, err
+	}
+	totalLiquidity := basePosition.Liquidity
+	joinTime := basePosition.JoinTime
+	autoCompound, autoCompoundErr := k.GetPositionAutoCompound(ctx, basePosition.PositionId)
+	for _, positionId := range positionIds[1:] {
+		position, err := k.getPositionToMoveRewards(ctx, owner, positionId)
+		if err != nil {
+			return 0, err
+		}
+		if position.PoolId != basePosition.PoolId {
+			return 0, types.PositionsNotInSamePoolError{Position1PoolId: position.PoolId, Position2PoolId: basePosition.PoolId}
+		}
+		if position.LowerTick != basePosition.LowerTick || position.UpperTick != basePosition.UpperTick {
+			return 0, types.PositionsNotInSameTickRangeError{Position1TickLower: position.LowerTick, Position1TickUpper: position.UpperTick, Position2TickLower: basePosition.LowerTick, Position2TickUpper: basePosition.UpperTick}
+		}
+
+		totalLiquidity = totalLiquidity.Add(position.Liquidity)
+		if position.JoinTime.After(joinTime) {
+			joinTime = position.JoinTime
+		}
+		if autoCompoundErr != nil {
+			autoCompound, autoCompoundErr = k.GetPositionAutoCompound(ctx, positionId)
+		}
+	}
+
+	// The liquidity of the tick range is unchanged, so only the position and its accumulators are created.
+	newPositionId := k.getNextPositionIdAndIncrement(ctx)
+	if err := k.initPositionInExistingRange(ctx, basePosition.PoolId, owner, basePosition.LowerTick, basePosition.UpperTick, totalLiquidity, joinTime, newPositionId); err != nil {
+		return 0, err
+	}
+
+	if err := k.moveRewardsAndDeletePositions(ctx, owner, basePosition.PoolId, basePosition.LowerTick, basePosition.UpperTick, positionIds, newPositionId); err != nil {
+		return 0, err
+	}
+
+	if autoCompoundErr == nil {
+		autoCompound.PositionId = newPositionId
+		k.setPositionAutoCompoundRecord(ctx, autoCompound)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergePositions,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(basePosition.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeInputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(positionIds)), ","), "[]")),
+			sdk.NewAttribute(types.AttributeOutputPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeLiquidity, totalLiquidity.String()),
+			sdk.NewAttribute(types.AttributeJoinTime, joinTime.String()),
+		),
+	})
+
+	return newPositionId, nil
+}
+
+// splitPosition splits the given position into new positions over the same tick range, and returns their ids.
+// A new position is created for each of the given liquidity amounts, followed by a new position with the remaining
+// liquidity of the position. The unclaimed spread rewards and incentives of the position are moved to the last new
+// position, and the position is deleted. The new positions keep the join time of the position, as well as its
+// auto-compound record if it is opted in auto-compounding.
+// Returns error if:
+// - the position does not exist
+// - owner does not own the position
+// - the position has an underlying lock, whether it is superfluid staked or not
+// - any of the liquidity amounts is not positive
+// - the liquidity amounts add up to the liquidity of the position or more
+func (k Keeper) splitPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, liquidityAmounts []sdk.Dec) ([]uint64, error) {
+	position, err := k.getPositionToMoveRewards(ctx, owner, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingLiquidity := position.Liquidity
+	for _, liquidity := range liquidityAmounts {
+		if !liquidity.IsPositive() {
+			return nil, types.NonPositiveLiquidityForNewPositionError{LiquidityDelta: liquidity, PositionId: positionId}
+		}
+		remainingLiquidity = remainingLiquidity.Sub(liquidity)
+	}
+	if !remainingLiquidity.IsPositive() {
+		return nil, types.SplitLiquidityExceedsPositionError{PositionId: positionId, Liquidity: position.Liquidity, LiquidityToSplit: position.Liquidity.Sub(remainingLiquidity)}
+	}
+	autoCompound, autoCompoundErr := k.GetPositionAutoCompound(ctx, positionId)
+
+	// The liquidity of the tick range is unchanged, so only the positions and their accumulators are created.
+	newPositionLiquidities := append(append([]sdk.Dec{}, liquidityAmounts...), remainingLiquidity)
+	newPositionIds := make([]uint64, 0, len(newPositionLiquidities))
+	for _, liquidity := range newPositionLiquidities {
+		newPositionId := k.getNextPositionIdAndIncrement(ctx)
+		if err := k.initPositionInExistingRange(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidity, position.JoinTime, newPositionId); err != nil {
+			return nil, err
+		}
+		newPositionIds = append(newPositionIds, newPositionId)
+	}
+
+	remainderPositionId := newPositionIds[len(newPositionIds)-1]
+	if err := k.moveRewardsAndDeletePositions(ctx, owner, position.PoolId, position.LowerTick, position.UpperTick, []uint64{positionId}, remainderPositionId); err != nil {
+		return nil, err
+	}
+
+	if autoCompoundErr == nil {
+		for _, newPositionId := range newPositionIds {
+			autoCompound.PositionId = newPositionId
+			k.setPositionAutoCompoundRecord(ctx, autoCompound)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeOutputPositionIds, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(newPositionIds)), ","), "[]")),
+		),
+	})
+
+	return newPositionIds, nil
+}
+
+// getPositionToMoveRewards returns the given position after checking that it is owned by owner and
+// has no underlying lock, so that its liquidity and rewards can be moved to new positions.
+func (k Keeper) getPositionToMoveRewards(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (model.Position, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return model.Position{}, err
+	}
+	if position.Address != owner.String() {
+		return model.Position{}, types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: owner.String()}
+	}
+
+	lockId, err := k.GetLockIdFromPositionId(ctx, positionId)
+	if err == nil {
+		return model.Position{}, types.PositionHasUnderlyingLockError{PositionId: positionId, LockId: lockId}
+	}
+	if !errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: positionId}) {
+		return model.Position{}, err
+	}
+	return position, nil
+}
+
+// initPositionInExistingRange creates a position with the given liquidity and initializes its uptime and spread reward
+// accumulators, without updating the liquidity of its ticks and pool. It is used when the liquidity of existing positions
+// in the same tick range is moved to the new position.
+func (k Keeper) initPositionInExistingRange(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidity sdk.Dec, joinTime time.Time, positionId uint64) error {
+	if err := k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidity, joinTime, positionId); err != nil {
+		return err
+	}
+	return k.initOrUpdatePositionSpreadRewardAccumulator(ctx, poolId, lowerTick, upperTick, positionId, liquidity)
+}
+
+// moveRewardsAndDeletePositions moves the unclaimed spread rewards and incentives of the given old positions to the new
+// position, and deletes the old positions from state. All the positions must be in the given pool and tick range.
+func (k Keeper) moveRewardsAndDeletePositions(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, oldPositionIds []uint64, newPositionId uint64) error {
+	// Get the new position's uptime accum name and the pool's uptime accumulators.
+	newPositionUptimeAccName := string(types.KeyPositionId(newPositionId))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// Get the new position's spread reward accum name and the pool's spread reward accumulator.
+	newPositionSpreadRewardAccName := types.KeySpreadRewardPositionAccumulator(newPositionId)
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// Compute uptime growth outside of the range between lower tick and upper tick
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	// Compute the spread reward growth outside of the range between lower tick and upper tick
+	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	// Loop through each of the old position IDs.
+	for _, oldPositionId := range oldPositionIds {
+		// Loop through each uptime accumulator for the pool.
+		for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+			// Move rewards into the new uptime accumulator and delete the old uptime accumulator.
+			oldPositionName := string(types.KeyPositionId(oldPositionId))
+			if err := moveRewardsToNewPositionAndDeleteOldAcc(uptimeAccum, oldPositionName, newPositionUptimeAccName, uptimeGrowthOutside[uptimeIndex]); err != nil {
+				return err
+			}
+		}
+
+		// Move spread rewards into the new spread reward accumulator and delete the old spread reward accumulator.
+		oldPositionSpreadRewardName := types.KeySpreadRewardPositionAccumulator(oldPositionId)
+		if err := moveRewardsToNewPositionAndDeleteOldAcc(spreadRewardAccumulator, oldPositionSpreadRewardName, newPositionSpreadRewardAccName, spreadRewardGrowthOutside); err != nil {
+			return err
+		}
+
+		// Remove the old position from state.
+		if err := k.deletePosition(ctx, oldPositionId, owner, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetLockIdFromPositionId returns the lock id associated with the given position id.
 func (k Keeper) GetLockIdFromPositionId(ctx sdk.Context, positionId uint64) (uint64, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -2658,3 +2658,423 @@ func (s *KeeperTestSuite) TestNegativeTickRange_SpreadFactor() {
 		s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, s.TestAccs[0], DefaultCoins, sdk.ZeroInt(), sdk.ZeroInt(), DefaultCurrTick-25, DefaultCurrTick+50)
 	})
 }
+
+// TestTransferPositions tests that transferred positions are re-keyed to the new owner
+// and keep their join time, unclaimed spread rewards and incentives.
+func (s *KeeperTestSuite) TestTransferPositions() {
+	s.SetupTest()
+	oldOwner := s.TestAccs[0]
+
+	testIncentiveRecord := types.IncentiveRecord{
+		PoolId: 1,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, sdk.NewDec(1000000000000000000)),
+			EmissionRate:  sdk.NewDec(1), // 1 per second
+			StartTime:     defaultBlockTime,
+		},
+		MinUptime: time.Nanosecond,
+	}
+	pool, positionIds, _ := s.runFungifySetup(oldOwner, 2, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{testIncentiveRecord})
+	newOwner := s.TestAccs[1]
+	s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewInt(1_000_000))))
+
+	// Earn spread rewards and incentives.
+	swapAmountIn := sdk.NewCoin(ETH, sdk.NewInt(1_000_000))
+	s.FundAcc(oldOwner, sdk.NewCoins(swapAmountIn))
+	s.swapAndTrackXTimesInARow(pool.GetId(), swapAmountIn, USDC, types.MinSpotPrice, 1)
+	s.AddBlockTime(time.Hour)
+	err := s.clk.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	transferredPositionId := positionIds[0]
+	positionBefore, err := s.clk.GetPosition(s.Ctx, transferredPositionId)
+	s.Require().NoError(err)
+	expectedSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, transferredPositionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedSpreadRewards.IsZero())
+	expectedIncentives, _, err := s.clk.GetClaimableIncentives(s.Ctx, transferredPositionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedIncentives.IsZero())
+
+	// System under test.
+	err = s.clk.TransferPositions(s.Ctx, []uint64{transferredPositionId}, oldOwner, newOwner)
+	s.Require().NoError(err)
+
+	// The position is re-keyed to the new owner without changing anything else.
+	positionAfter, err := s.clk.GetPosition(s.Ctx, transferredPositionId)
+	s.Require().NoError(err)
+	positionBefore.Address = newOwner.String()
+	s.Require().Equal(positionBefore, positionAfter)
+
+	newOwnerPositions, err := s.clk.GetUserPositions(s.Ctx, newOwner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal([]model.Position{positionAfter}, newOwnerPositions)
+	oldOwnerPositions, err := s.clk.GetUserPositions(s.Ctx, oldOwner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(oldOwnerPositions, 1)
+	s.Require().Equal(positionIds[1], oldOwnerPositions[0].PositionId)
+
+	// The old owner can no longer claim the rewards of the position.
+	_, err = s.clk.CollectSpreadRewards(s.Ctx, oldOwner, transferredPositionId)
+	s.Require().Error(err)
+	_, _, err = s.clk.CollectIncentives(s.Ctx, oldOwner, transferredPositionId)
+	s.Require().Error(err)
+
+	// The new owner claims the rewards accrued before the transfer.
+	collectedSpreadRewards, err := s.clk.CollectSpreadRewards(s.Ctx, newOwner, transferredPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedSpreadRewards, collectedSpreadRewards)
+	collectedIncentives, _, err := s.clk.CollectIncentives(s.Ctx, newOwner, transferredPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedIncentives, collectedIncentives)
+
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtTransferPositions, 1)
+}
+
+func (s *KeeperTestSuite) TestTransferPositions_Errors() {
+	tests := map[string]struct {
+		lockPosition bool
+		sender       func(owner sdk.AccAddress) sdk.AccAddress
+		positionIds  func(positionId uint64) []uint64
+
+		expectedError func(positionId uint64, owner, sender sdk.AccAddress) error
+	}{
+		"error: position does not exist": {
+			positionIds: func(positionId uint64) []uint64 { return []uint64{positionId, positionId + 1} },
+			expectedError: func(positionId uint64, _, _ sdk.AccAddress) error {
+				return types.PositionIdNotFoundError{PositionId: positionId + 1}
+			},
+		},
+		"error: sender does not own the position": {
+			sender: func(sdk.AccAddress) sdk.AccAddress { return s.TestAccs[2] },
+			expectedError: func(_ uint64, owner, sender sdk.AccAddress) error {
+				return types.PositionOwnerMismatchError{PositionOwner: owner.String(), Sender: sender.String()}
+			},
+		},
+		"error: position has an underlying lock": {
+			lockPosition: true,
+			expectedError: func(positionId uint64, _, _ sdk.AccAddress) error {
+				return types.PositionHasUnderlyingLockError{PositionId: positionId, LockId: 1}
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			pool := s.PrepareConcentratedPool()
+			s.FundAcc(owner, DefaultCoins)
+
+			var positionId uint64
+			var err error
+			if tc.lockPosition {
+				positionId, _, _, _, _, err = s.clk.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime)
+			} else {
+				positionId, _, _, _, _, _, err = s.clk.CreatePosition(s.Ctx, pool.GetId(), owner, DefaultCoins, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+			}
+			s.Require().NoError(err)
+
+			sender := owner
+			if tc.sender != nil {
+				sender = tc.sender(owner)
+			}
+			positionIds := []uint64{positionId}
+			if tc.positionIds != nil {
+				positionIds = tc.positionIds(positionId)
+			}
+
+			err = s.clk.TransferPositions(s.Ctx, positionIds, sender, s.TestAccs[1])
+			s.Require().ErrorIs(err, tc.expectedError(positionId, owner, sender))
+
+			// Nothing is transferred.
+			position, err := s.clk.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), position.Address)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMergePositions() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+
+	testIncentiveRecord := types.IncentiveRecord{
+		PoolId: 1,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, sdk.NewDec(1000000000000000000)),
+			EmissionRate:  sdk.NewDec(1), // 1 per second
+			StartTime:     defaultBlockTime,
+		},
+		MinUptime: time.Nanosecond,
+	}
+	pool, positionIds, totalLiquidity := s.runFungifySetup(owner, 2, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{testIncentiveRecord})
+	s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewInt(1_000_000))))
+
+	// Earn spread rewards and incentives, then add a younger position in the same tick range.
+	swapAmountIn := sdk.NewCoin(ETH, sdk.NewInt(1_000_000))
+	s.swapAndTrackXTimesInARow(pool.GetId(), swapAmountIn, USDC, types.MinSpotPrice, 1)
+	s.AddBlockTime(time.Hour)
+	latestJoinTime := s.Ctx.BlockTime()
+	_, youngestPositionId := s.SetupPosition(pool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	youngestPosition, err := s.clk.GetPosition(s.Ctx, youngestPositionId)
+	s.Require().NoError(err)
+	totalLiquidity = totalLiquidity.Add(youngestPosition.Liquidity)
+	positionIds = append(positionIds, youngestPositionId)
+	s.Require().NoError(s.clk.SetPositionAutoCompound(s.Ctx, owner, positionIds[1], true))
+
+	s.swapAndTrackXTimesInARow(pool.GetId(), swapAmountIn, USDC, types.MinSpotPrice, 1)
+	s.AddBlockTime(time.Hour)
+	err = s.clk.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	expectedSpreadRewards := sdk.NewCoins()
+	expectedIncentives := sdk.NewCoins()
+	for _, positionId := range positionIds {
+		spreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		expectedSpreadRewards = expectedSpreadRewards.Add(spreadRewards...)
+		incentives, _, err := s.clk.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+		expectedIncentives = expectedIncentives.Add(incentives...)
+	}
+	s.Require().False(expectedSpreadRewards.IsZero())
+	s.Require().False(expectedIncentives.IsZero())
+	lowerTickBefore, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	poolBefore, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	// System under test.
+	newPositionId, err := s.clk.MergePositions(s.Ctx, owner, positionIds)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtMergePositions, 1)
+
+	// The merged positions are deleted, and the new position holds their combined liquidity
+	// with the latest of their join times.
+	for _, positionId := range positionIds {
+		_, err := s.clk.GetPosition(s.Ctx, positionId)
+		s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+	}
+	newPosition, err := s.clk.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(totalLiquidity, newPosition.Liquidity)
+	s.Require().Equal(latestJoinTime, newPosition.JoinTime)
+	s.Require().Equal(DefaultLowerTick, newPosition.LowerTick)
+	s.Require().Equal(DefaultUpperTick, newPosition.UpperTick)
+
+	// The liquidity of the tick range and pool is unchanged.
+	lowerTickAfter, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	s.Require().Equal(lowerTickBefore, lowerTickAfter)
+	poolAfter, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(poolBefore.GetLiquidity(), poolAfter.GetLiquidity())
+
+	// The unclaimed rewards of the merged positions move to the new position, up to the truncation
+	// of the rewards of each merged position.
+	spreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.requireCoinsWithinTruncation(expectedSpreadRewards, spreadRewards, len(positionIds))
+	incentives, _, err := s.clk.GetClaimableIncentives(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.requireCoinsWithinTruncation(expectedIncentives, incentives, len(positionIds))
+
+	// The new position is opted in auto-compounding since one of the merged positions was.
+	_, err = s.clk.GetPositionAutoCompound(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMergePositions_Errors() {
+	tests := map[string]struct {
+		positionIds func(positionIds []uint64, otherRangePositionId, otherOwnerPositionId, lockedPositionId uint64) []uint64
+		expectedErr error
+	}{
+		"single position": {
+			positionIds: func(positionIds []uint64, _, _, _ uint64) []uint64 { return positionIds[:1] },
+			expectedErr: types.PositionQuantityTooLowError{MinNumPositions: cl.MinNumPositions, NumPositions: 1},
+		},
+		"duplicate positions": {
+			positionIds: func(positionIds []uint64, _, _, _ uint64) []uint64 { return []uint64{positionIds[0], positionIds[0]} },
+			expectedErr: types.DuplicatePositionIdsError{PositionIds: []uint64{1, 1}},
+		},
+		"position does not exist": {
+			positionIds: func(positionIds []uint64, _, _, _ uint64) []uint64 { return []uint64{positionIds[0], 100} },
+			expectedErr: types.PositionIdNotFoundError{PositionId: 100},
+		},
+		"positions in different tick ranges": {
+			positionIds: func(positionIds []uint64, otherRangePositionId, _, _ uint64) []uint64 {
+				return []uint64{positionIds[0], otherRangePositionId}
+			},
+			expectedErr: types.PositionsNotInSameTickRangeError{Position1TickLower: DefaultMinTick, Position1TickUpper: DefaultMaxTick, Position2TickLower: DefaultLowerTick, Position2TickUpper: DefaultUpperTick},
+		},
+		"position owned by another address": {
+			positionIds: func(positionIds []uint64, _, otherOwnerPositionId, _ uint64) []uint64 {
+				return []uint64{positionIds[0], otherOwnerPositionId}
+			},
+			expectedErr: types.PositionOwnerMismatchError{},
+		},
+		"position with an underlying lock": {
+			positionIds: func(positionIds []uint64, _, _, lockedPositionId uint64) []uint64 {
+				return []uint64{positionIds[0], lockedPositionId}
+			},
+			expectedErr: types.PositionHasUnderlyingLockError{PositionId: 5, LockId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			pool, positionIds, _ := s.runFungifySetup(owner, 2, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{})
+			otherRangePositionId := s.SetupFullRangePositionAcc(pool.GetId(), owner)
+			otherOwnerPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			s.FundAcc(owner, DefaultCoins)
+			lockedPositionId, _, _, _, _, err := s.clk.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, DefaultFungifyFullChargeDuration)
+			s.Require().NoError(err)
+
+			// System under test.
+			_, err = s.clk.MergePositions(s.Ctx, owner, tc.positionIds(positionIds, otherRangePositionId, otherOwnerPositionId, lockedPositionId))
+			if _, ok := tc.expectedErr.(types.PositionOwnerMismatchError); ok {
+				s.Require().ErrorIs(err, types.PositionOwnerMismatchError{PositionOwner: s.TestAccs[1].String(), Sender: owner.String()})
+			} else {
+				s.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			// The positions are left untouched.
+			for _, positionId := range positionIds {
+				_, err := s.clk.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitPosition() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	pool, positionIds, liquidity := s.runFungifySetup(owner, 1, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{})
+	positionId := positionIds[0]
+	s.Require().NoError(s.clk.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+
+	// Earn spread rewards.
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(100_000)), USDC, types.MinSpotPrice, 1)
+	s.AddBlockTime(time.Hour)
+
+	positionBefore, err := s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	expectedSpreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedSpreadRewards.IsZero())
+	lowerTickBefore, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+
+	liquidityAmounts := []sdk.Dec{liquidity.QuoInt64(4), liquidity.QuoInt64(3)}
+	expectedLiquidities := []sdk.Dec{liquidityAmounts[0], liquidityAmounts[1], liquidity.Sub(liquidityAmounts[0]).Sub(liquidityAmounts[1])}
+
+	// System under test.
+	newPositionIds, err := s.clk.SplitPosition(s.Ctx, owner, positionId, liquidityAmounts)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSplitPosition, 1)
+
+	// The position is deleted and its liquidity is divided between the new positions,
+	// which keep its tick range, join time and auto-compound record.
+	_, err = s.clk.GetPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+	s.Require().Len(newPositionIds, len(expectedLiquidities))
+	for i, newPositionId := range newPositionIds {
+		newPosition, err := s.clk.GetPosition(s.Ctx, newPositionId)
+		s.Require().NoError(err)
+		expectedPosition := positionBefore
+		expectedPosition.PositionId = newPositionId
+		expectedPosition.Liquidity = expectedLiquidities[i]
+		s.Require().Equal(expectedPosition, newPosition)
+
+		_, err = s.clk.GetPositionAutoCompound(s.Ctx, newPositionId)
+		s.Require().NoError(err)
+	}
+
+	// The liquidity of the tick range is unchanged.
+	lowerTickAfter, err := s.clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	s.Require().Equal(lowerTickBefore, lowerTickAfter)
+
+	// The unclaimed spread rewards of the position move to the last new position.
+	for i, newPositionId := range newPositionIds {
+		spreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, newPositionId)
+		s.Require().NoError(err)
+		if i == len(newPositionIds)-1 {
+			s.Require().Equal(expectedSpreadRewards, spreadRewards)
+		} else {
+			s.Require().True(spreadRewards.IsZero())
+		}
+	}
+
+	// Spread rewards earned after the split accrue to all the new positions.
+	s.swapAndTrackXTimesInARow(pool.GetId(), sdk.NewCoin(ETH, sdk.NewInt(100_000)), USDC, types.MinSpotPrice, 1)
+	spreadRewards, err := s.clk.GetClaimableSpreadRewards(s.Ctx, newPositionIds[0])
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+}
+
+func (s *KeeperTestSuite) TestSplitPosition_Errors() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	pool, positionIds, liquidity := s.runFungifySetup(owner, 1, DefaultFungifyFullChargeDuration, DefaultSpreadFactor, []types.IncentiveRecord{})
+	s.FundAcc(owner, DefaultCoins)
+	lockedPositionId, _, _, _, _, err := s.clk.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, DefaultFungifyFullChargeDuration)
+	s.Require().NoError(err)
+
+	tests := map[string]struct {
+		sender           sdk.AccAddress
+		positionId       uint64
+		liquidityAmounts []sdk.Dec
+		expectedErr      error
+	}{
+		"liquidity amounts add up to the liquidity of the position": {
+			sender:           owner,
+			positionId:       positionIds[0],
+			liquidityAmounts: []sdk.Dec{liquidity.QuoInt64(2), liquidity.Sub(liquidity.QuoInt64(2))},
+			expectedErr:      types.SplitLiquidityExceedsPositionError{PositionId: positionIds[0], Liquidity: liquidity, LiquidityToSplit: liquidity},
+		},
+		"non-positive liquidity amount": {
+			sender:           owner,
+			positionId:       positionIds[0],
+			liquidityAmounts: []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()},
+			expectedErr:      types.NonPositiveLiquidityForNewPositionError{LiquidityDelta: sdk.ZeroDec(), PositionId: positionIds[0]},
+		},
+		"position owned by another address": {
+			sender:           s.TestAccs[1],
+			positionId:       positionIds[0],
+			liquidityAmounts: []sdk.Dec{sdk.OneDec()},
+			expectedErr:      types.PositionOwnerMismatchError{PositionOwner: owner.String(), Sender: s.TestAccs[1].String()},
+		},
+		"position with an underlying lock": {
+			sender:           owner,
+			positionId:       lockedPositionId,
+			liquidityAmounts: []sdk.Dec{sdk.OneDec()},
+			expectedErr:      types.PositionHasUnderlyingLockError{PositionId: lockedPositionId, LockId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			cacheCtx, _ := s.Ctx.CacheContext()
+
+			// System under test.
+			_, err := s.clk.SplitPosition(cacheCtx, tc.sender, tc.positionId, tc.liquidityAmounts)
+			s.Require().EqualError(err, tc.expectedErr.Error())
+		})
+	}
+}
+
+// requireCoinsWithinTruncation asserts that actual is at least expected, and exceeds it by at most
+// numTruncations in each denom, which is the most that truncating numTruncations amounts can lose.
+func (s *KeeperTestSuite) requireCoinsWithinTruncation(expected, actual sdk.Coins, numTruncations int) {
+	s.Require().Equal(len(expected), len(actual))
+	for _, coin := range expected {
+		diff := actual.AmountOf(coin.Denom).Sub(coin.Amount)
+		s.Require().False(diff.IsNegative(), "denom %s: expected %s, got %s", coin.Denom, coin.Amount, actual.AmountOf(coin.Denom))
+		s.Require().True(diff.LTE(sdk.NewInt(int64(numTruncations))), "denom %s: expected %s, got %s", coin.Denom, coin.Amount, actual.AmountOf(coin.Denom))
+	}
+}
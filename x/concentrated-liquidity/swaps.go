@@ -2,6 +2,7 @@ package concentrated_liquidity
 
 import (
 	fmt "fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	db "github.com/tendermint/tm-db"
@@ -59,6 +60,11 @@ type SwapState struct {
 	globalSpreadRewardGrowth sdk.Dec
 
 	swapStrategy swapstrategy.SwapStrategy
+
+	// Ticks without liquidity that were crossed after their limit orders were filled.
+	// Initialized to empty.
+	// They are removed once the swap is complete, unless limit orders that are not filled are left at them.
+	emptyTicksCrossed []int64
 }
 
 // swapNoProgressLimit is the maximum number of iterations that can be performed
@@ -96,6 +102,8 @@ type PoolUpdates struct {
 
 var (
 	smallestDec = sdk.SmallestDec()
+	// tickCrossingMaxAmountIn is the amount in used to simulate swaps that should consume whole buckets of liquidity.
+	tickCrossingMaxAmountIn = sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 128))
 )
 
 // updateSpreadRewardGrowthGlobal updates the swap state's spread reward growth global per unit of liquidity
@@ -311,7 +319,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInMin.Denom, priceLimit)
+	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInMin.Denom, priceLimit)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
@@ -392,7 +400,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		// bucket has been consumed and we must move on to the next bucket to complete the swap
 		if nextInitializedTickSqrtPriceBigDec.Equal(computedSqrtPrice) {
 			swapState, err = k.swapCrossTickLogic(ctx, swapState, swapStrategy,
-				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInMin.Denom)
+				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInMin.Denom, true)
 			if err != nil {
 				return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 			}
@@ -426,6 +434,10 @@ func (k Keeper) computeOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, types.OverChargeSwapOutGivenInError{AmountSpecifiedRemaining: swapState.amountSpecifiedRemaining}
 	}
 
+	if err := k.removeTicksIfEmpty(ctx, p, swapState.emptyTicksCrossed); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
+	}
+
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
 	spreadRewardGrowth := sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.globalSpreadRewardGrowthPerUnitLiquidity)
 	spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(spreadRewardGrowth))
@@ -445,6 +457,88 @@ func (k Keeper) computeOutAmtGivenIn(
 	return tokenIn, tokenOut, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, swapState.globalSpreadRewardGrowth, nil
 }
 
+// ComputeTickCrossingAmountsIn returns the cumulative amounts of tokenInDenom, spread factor included, that need to be
+// swapped into the pool to cross each of its next maxTicksCrossed initialized ticks, in ascending order.
+// The amount out of a swap is piecewise in the amount in, with pieces delimited by these amounts, so callers searching
+// over the amount in can step through tick boundaries exactly. Fewer amounts are returned if the pool runs out of
+// initialized ticks in the direction of the swap.
+// All computations are done on a cached context, no state is written.
+func (k Keeper) ComputeTickCrossingAmountsIn(ctx sdk.Context, poolId uint64, tokenInDenom string, maxTicksCrossed uint64) ([]sdk.Int, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	p, err := k.getPoolForSwap(cacheCtx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutDenom := p.GetToken1()
+	if tokenInDenom == tokenOutDenom {
+		tokenOutDenom = p.GetToken0()
+	}
+	if err := checkDenomValidity(tokenInDenom, tokenOutDenom, p.GetToken0(), p.GetToken1()); err != nil {
+		return nil, err
+	}
+
+	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(cacheCtx, p, p.GetSpreadFactor(cacheCtx), tokenInDenom, sdk.ZeroDec())
+	if err != nil {
+		return nil, err
+	}
+
+	spreadRewardAccumulator, uptimeAccums, err := k.getSwapAccumulators(cacheCtx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The swap is simulated with an amount in that is never exhausted, so that every bucket is fully consumed.
+	swapState := newSwapState(tickCrossingMaxAmountIn, p, swapStrategy)
+
+	nextInitTickIter := swapStrategy.InitializeNextTickIterator(cacheCtx, poolId, swapState.tick)
+	defer nextInitTickIter.Close()
+
+	sqrtPriceLimitBigDec := osmomath.BigDecFromSDKDec(sqrtPriceLimit)
+	amountsIn := make([]sdk.Int, 0, maxTicksCrossed)
+	for uint64(len(amountsIn)) < maxTicksCrossed && nextInitTickIter.Valid() && !swapState.sqrtPrice.Equal(sqrtPriceLimitBigDec) {
+		nextInitializedTick, err := types.TickIndexFromBytes(nextInitTickIter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		_, nextInitializedTickSqrtPrice, err := math.TickToSqrtPrice(nextInitializedTick)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert next tick (%v) to nextSqrtPrice", nextInitializedTick)
+		}
+
+		sqrtPriceTarget := swapStrategy.GetSqrtTargetPrice(nextInitializedTickSqrtPrice)
+		computedSqrtPrice, amountIn, amountOut, spreadRewardCharge := swapStrategy.ComputeSwapWithinBucketOutGivenIn(
+			swapState.sqrtPrice,
+			sqrtPriceTarget,
+			swapState.liquidity,
+			swapState.amountSpecifiedRemaining,
+		)
+
+		swapState.updateSpreadRewardGrowthGlobal(spreadRewardCharge)
+		swapState.sqrtPrice = computedSqrtPrice
+		swapState.amountSpecifiedRemaining.SubMut(amountIn.Add(spreadRewardCharge))
+		swapState.amountCalculated.AddMut(amountOut)
+
+		// The price limit was reached before the next initialized tick, so no more ticks can be crossed.
+		if !osmomath.BigDecFromSDKDec(nextInitializedTickSqrtPrice).Equal(computedSqrtPrice) {
+			break
+		}
+
+		swapState, err = k.swapCrossTickLogic(cacheCtx, swapState, swapStrategy,
+			nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInDenom, true)
+		if err != nil {
+			return nil, err
+		}
+
+		// Round the amount in up so that swapping it is guaranteed to cross the tick.
+		amountsIn = append(amountsIn, tickCrossingMaxAmountIn.ToDec().Sub(swapState.amountSpecifiedRemaining).Ceil().TruncateInt())
+	}
+
+	return amountsIn, nil
+}
+
 // computeInAmtGivenOut calculates tokens to be swapped in given the desired token out and spread factor deducted. It also returns
 // what the updated tick, liquidity, and currentSqrtPrice for the pool would be after this swap.
 // Note this method is mutative, some of the tick and accumulator updates get written to store.
@@ -466,7 +560,7 @@ func (k Keeper) computeInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 	}
@@ -543,7 +637,7 @@ func (k Keeper) computeInAmtGivenOut(
 		// bucket has been consumed and we must move on to the next bucket by crossing a tick to complete the swap
 		if nextInitializedTickSqrtPriceBigDec.Equal(computedSqrtPrice) {
 			swapState, err = k.swapCrossTickLogic(ctx, swapState, swapStrategy,
-				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInDenom)
+				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInDenom, false)
 			if err != nil {
 				return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
 			}
@@ -576,6 +670,10 @@ func (k Keeper) computeInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, fmt.Errorf("over charged problem swap in given out by %s", swapState.amountSpecifiedRemaining)
 	}
 
+	if err := k.removeTicksIfEmpty(ctx, p, swapState.emptyTicksCrossed); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, sdk.Dec{}, err
+	}
+
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
 	spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(tokenInDenom, swapState.globalSpreadRewardGrowthPerUnitLiquidity)))
 
@@ -605,12 +703,43 @@ func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmoma
 }
 
 // logic for crossing a tick during a swap
+// Before crossing the tick, the limit orders placed at it in the direction of the swap are filled.
+// If the swap runs out before all of them are filled, the tick is not crossed and the swap state
+// stays at the tick so that the next swap in the same direction fills the rest.
+// Crossed ticks without liquidity are recorded in the swap state to be removed once the swap is complete.
+// outGivenIn indicates whether the amount specified remaining in the swap state is the amount swapped in.
 func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	swapState SwapState, strategy swapstrategy.SwapStrategy,
 	nextInitializedTick int64, nextTickIter db.Iterator,
 	p types.ConcentratedPoolExtension,
 	spreadRewardAccum *accum.AccumulatorObject, uptimeAccums []*accum.AccumulatorObject,
-	tokenInDenom string) (SwapState, error) {
+	tokenInDenom string, outGivenIn bool) (SwapState, error) {
+	// Swaps moving the price up buy token0 from asks, swaps moving it down buy token1 from bids.
+	orderDenom := p.GetToken0()
+	if strategy.ZeroForOne() {
+		orderDenom = p.GetToken1()
+	}
+	amountFilled, amountPaid, fullyFilled, err := k.fillLimitOrders(ctx, p, nextInitializedTick, orderDenom, swapState.amountSpecifiedRemaining, outGivenIn)
+	if err != nil {
+		return swapState, err
+	}
+	if outGivenIn {
+		swapState.amountSpecifiedRemaining.SubMut(amountPaid)
+		swapState.amountCalculated.AddMut(amountFilled)
+	} else {
+		swapState.amountSpecifiedRemaining.SubMut(amountFilled)
+		swapState.amountCalculated.AddMut(amountPaid)
+	}
+	if !fullyFilled {
+		// The price is at the tick but the tick is not crossed, so the active range is still
+		// the one below the tick when moving up and the one starting at the tick when moving down.
+		swapState.tick = nextInitializedTick - 1
+		if strategy.ZeroForOne() {
+			swapState.tick = nextInitializedTick
+		}
+		return swapState, nil
+	}
+
 	nextInitializedTickInfo, err := ParseTickFromBz(nextTickIter.Value())
 	if err != nil {
 		return swapState, err
@@ -629,6 +758,12 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	// Move next tick iterator to the next tick as the tick is crossed.
 	nextTickIter.Next()
 
+	// Ticks without liquidity are only initialized for limit orders. They cannot be removed while iterating
+	// over the ticks, so they are removed once the swap is complete.
+	if nextInitializedTickInfo.LiquidityGross.IsZero() {
+		swapState.emptyTicksCrossed = append(swapState.emptyTicksCrossed, nextInitializedTick)
+	}
+
 	liquidityNet = swapState.swapStrategy.SetLiquidityDeltaSign(liquidityNet)
 	// Update the swapState's liquidity with the new tick's liquidity
 	swapState.liquidity.AddMut(liquidityNet)
@@ -735,7 +870,9 @@ func checkDenomValidity(inDenom, outDenom, asset0, asset1 string) error {
 	return nil
 }
 
-func (k Keeper) setupSwapStrategy(p types.ConcentratedPoolExtension, spreadFactor sdk.Dec, tokenInDenom string, priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
+// setupSwapStrategy returns the swap strategy for swapping tokenInDenom in the given pool up to the given price limit.
+// Pools in the dynamic spread factor mode charge their effective spread factor instead of the given spread factor.
+func (k Keeper) setupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension, spreadFactor sdk.Dec, tokenInDenom string, priceLimit sdk.Dec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit sdk.Dec, err error) {
 	zeroForOne := getZeroForOne(tokenInDenom, p.GetToken0())
 
 	// take provided price limit and turn this into a sqrt price limit since formulas use sqrtPrice
@@ -745,6 +882,7 @@ func (k Keeper) setupSwapStrategy(p types.ConcentratedPoolExtension, spreadFacto
 	}
 
 	// set the swap strategy
+	spreadFactor = k.getSwapSpreadFactor(ctx, p, spreadFactor)
 	swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, k.storeKey, spreadFactor)
 
 	// get current sqrt price from pool
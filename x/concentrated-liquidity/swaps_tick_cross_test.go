@@ -1541,3 +1541,82 @@ func (s *KeeperTestSuite) TestSwapOutGivenIn_GetLiquidityFromAmountsPositionBoun
 		s.Ctx = setupCtx
 	})
 }
+
+// TestComputeTickCrossingAmountsIn tests that swapping the returned amounts in crosses the next initialized ticks
+// exactly, and that swapping one unit less does not cross them.
+// For position layout, see diagram above the definition of defaultTickSpacingsAway variable.
+func (s *KeeperTestSuite) TestComputeTickCrossingAmountsIn() {
+	tests := map[string]struct {
+		isZeroForOne    bool
+		maxTicksCrossed uint64
+		// indexes of the positions in defaultTickSpacingsAway whose ticks are expected to be crossed, in order.
+		expectedPositionsCrossed []int
+	}{
+		"zero for one, crosses three lower ticks": {
+			isZeroForOne:             true,
+			maxTicksCrossed:          3,
+			expectedPositionsCrossed: []int{3, 2, 1},
+		},
+		"one for zero, crosses two upper ticks": {
+			isZeroForOne:             false,
+			maxTicksCrossed:          2,
+			expectedPositionsCrossed: []int{3, 2},
+		},
+		"zero max ticks crossed": {
+			isZeroForOne:             true,
+			maxTicksCrossed:          0,
+			expectedPositionsCrossed: []int{},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, positionMetas := s.setupPoolAndPositions(tickSpacing100, defaultTickSpacingsAway, DefaultCoins)
+
+			tokenInDenom, tokenOutDenom := ETH, USDC
+			if !tc.isZeroForOne {
+				tokenInDenom, tokenOutDenom = USDC, ETH
+			}
+
+			amountsIn, err := s.App.ConcentratedLiquidityKeeper.ComputeTickCrossingAmountsIn(s.Ctx, poolId, tokenInDenom, tc.maxTicksCrossed)
+			s.Require().NoError(err)
+			s.Require().Len(amountsIn, len(tc.expectedPositionsCrossed))
+
+			for i, positionIndex := range tc.expectedPositionsCrossed {
+				if i > 0 {
+					s.Require().True(amountsIn[i].GT(amountsIn[i-1]))
+				}
+
+				crossedTick := positionMetas[positionIndex].lowerTick
+				if !tc.isZeroForOne {
+					crossedTick = positionMetas[positionIndex].upperTick
+				}
+
+				// Swapping the amount in crosses the tick.
+				cacheCtx, _ := s.Ctx.CacheContext()
+				_, _, poolUpdates, _, err := s.App.ConcentratedLiquidityKeeper.ComputeOutAmtGivenIn(cacheCtx, poolId, sdk.NewCoin(tokenInDenom, amountsIn[i]), tokenOutDenom, sdk.ZeroDec(), sdk.ZeroDec())
+				s.Require().NoError(err)
+				if tc.isZeroForOne {
+					s.Require().Less(poolUpdates.NewCurrentTick, crossedTick)
+				} else {
+					s.Require().GreaterOrEqual(poolUpdates.NewCurrentTick, crossedTick)
+				}
+
+				// Swapping one unit less does not.
+				cacheCtx, _ = s.Ctx.CacheContext()
+				_, _, poolUpdates, _, err = s.App.ConcentratedLiquidityKeeper.ComputeOutAmtGivenIn(cacheCtx, poolId, sdk.NewCoin(tokenInDenom, amountsIn[i].Sub(sdk.OneInt())), tokenOutDenom, sdk.ZeroDec(), sdk.ZeroDec())
+				s.Require().NoError(err)
+				if tc.isZeroForOne {
+					s.Require().GreaterOrEqual(poolUpdates.NewCurrentTick, crossedTick)
+				} else {
+					s.Require().Less(poolUpdates.NewCurrentTick, crossedTick)
+				}
+			}
+
+			// No state is written.
+			s.validateIteratorLeftZeroForOne(poolId, positionMetas[3].lowerTick)
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+// TakeTickLiquiditySnapshots snapshots the initialized-tick liquidity distribution of every pool once the
+// tick liquidity snapshot interval has elapsed since the last round of snapshots started, and prunes the snapshots
+// that are older than the keep period. Pools without initialized ticks are only snapshotted if they have an
+// earlier snapshot.
+// A round snapshots at most MaxTickLiquiditySnapshotPoolsPerBlock pool ids per block, starting from the stored cursor,
+// and the pool ids that are not reached are snapshotted in the following blocks.
+// Snapshots are disabled if the interval is zero.
+// Each pool is snapshotted under its own cache context and gas limit. If the snapshot of a pool fails, e.g. because
+// it has too many initialized ticks, the error is logged and the other pools are still snapshotted.
+func (k Keeper) TakeTickLiquiditySnapshots(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.TickLiquiditySnapshotInterval == 0 {
+		return
+	}
+
+	startPoolId, inProgress := k.getTickLiquiditySnapshotCursor(ctx)
+	if !inProgress {
+		lastSnapshotTime, found := k.getLastTickLiquiditySnapshotTime(ctx)
+		if found && ctx.BlockTime().Before(lastSnapshotTime.Add(params.TickLiquiditySnapshotInterval)) {
+			return
+		}
+		k.setLastTickLiquiditySnapshotTime(ctx, ctx.BlockTime())
+		startPoolId = 1
+	}
+
+	nextPoolId := k.poolmanagerKeeper.GetNextPoolId(ctx)
+	endPoolId := startPoolId + types.MaxTickLiquiditySnapshotPoolsPerBlock
+	if endPoolId > nextPoolId {
+		endPoolId = nextPoolId
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	lastKeptTime := ctx.BlockTime().Add(-params.TickLiquiditySnapshotKeepPeriod)
+	for poolId := startPoolId; poolId < endPoolId; poolId++ {
+		// Pool ids are shared by all the pool types, so the ids of pools of other types are skipped.
+		if !store.Has(types.KeyPool(poolId)) {
+			continue
+		}
+		err := k.takeTickLiquiditySnapshotWithGasLimit(ctx, poolId, lastKeptTime)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to snapshot the tick liquidity of pool (%d): %s", poolId, err))
+		}
+	}
+
+	if endPoolId >= nextPoolId {
+		k.setTickLiquiditySnapshotCursor(ctx, 0)
+		return
+	}
+	k.setTickLiquiditySnapshotCursor(ctx, endPoolId)
+}
+
+// takeTickLiquiditySnapshotWithGasLimit snapshots the tick liquidity of the given pool and prunes its snapshots taken
+// before lastKeptTime under a cache context with a gas meter limited to TickLiquiditySnapshotGasLimitPerPool.
+// The state changes are discarded if the snapshot errors, panics or runs out of gas.
+func (k Keeper) takeTickLiquiditySnapshotWithGasLimit(ctx sdk.Context, poolId uint64, lastKeptTime time.Time) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			isOutOfGas, descriptor := osmoutils.IsOutOfGasError(r)
+			if !isOutOfGas {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas: %s", descriptor)
+		}
+	}()
+
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.TickLiquiditySnapshotGasLimitPerPool))
+	return osmoutils.ApplyFuncIfNoError(limitedCtx, func(ctx sdk.Context) error {
+		if err := k.takeTickLiquiditySnapshot(ctx, poolId); err != nil {
+			return err
+		}
+		k.pruneTickLiquiditySnapshotsBeforeTimeButNewest(ctx, poolId, lastKeptTime)
+		return nil
+	})
+}
+
+// takeTickLiquiditySnapshot stores the liquidity net of every initialized tick of the given pool at the current block time.
+// Ticks that are left with no liquidity once all of their positions are withdrawn are not snapshotted.
+// If the pool has no such ticks, an empty snapshot is stored only if the pool has an earlier snapshot, so that
+// the history records the liquidity dropping to zero.
+func (k Keeper) takeTickLiquiditySnapshot(ctx sdk.Context, poolId uint64) error {
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	snapshot := model.TickLiquiditySnapshot{
+		PoolId: poolId,
+		Time:   ctx.BlockTime(),
+		Ticks:  make([]model.SnapshotTick, 0, len(ticks)),
+	}
+	for _, tick := range ticks {
+		if tick.Info.LiquidityGross.IsZero() && tick.Info.LiquidityNet.IsZero() {
+			continue
+		}
+		snapshot.Ticks = append(snapshot.Ticks, model.SnapshotTick{
+			TickIndex:    tick.TickIndex,
+			LiquidityNet: tick.Info.LiquidityNet,
+		})
+	}
+	if len(snapshot.Ticks) == 0 && !k.hasTickLiquiditySnapshot(ctx, poolId) {
+		return nil
+	}
+
+	k.setTickLiquiditySnapshot(ctx, snapshot)
+	return nil
+}
+
+// pruneTickLiquiditySnapshotsBeforeTimeButNewest prunes the snapshots of the given pool taken before the given time
+// but the newest of them. Similar to the pruning of historical TWAP records, the newest snapshot older than the
+// keep period is preserved so that the liquidity at any time within the keep period can be queried.
+func (k Keeper) pruneTickLiquiditySnapshotsBeforeTimeButNewest(ctx sdk.Context, poolId uint64, lastKeptTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.KeyTickLiquiditySnapshotsForPool(poolId), types.KeyTickLiquiditySnapshot(poolId, lastKeptTime))
+
+	keysToDelete := [][]byte{}
+	// The first snapshot iterated over is the newest one before the given time, which is kept.
+	if iter.Valid() {
+		iter.Next()
+	}
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// GetTickLiquiditySnapshotAtOrBeforeTime returns the newest tick liquidity snapshot of the given pool
+// taken at or before the given time.
+// Returns error if there is no such snapshot.
+func (k Keeper) GetTickLiquiditySnapshotAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time) (model.TickLiquiditySnapshot, error) {
+	store := ctx.KVStore(k.storeKey)
+	// The end of the iterator is exclusive, so the smallest key greater than the key of the given time is used
+	// for the snapshot taken at exactly the given time to be included.
+	end := append(types.KeyTickLiquiditySnapshot(poolId, t), 0x00)
+	iter := store.ReverseIterator(types.KeyTickLiquiditySnapshotsForPool(poolId), end)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return model.TickLiquiditySnapshot{}, types.TickLiquiditySnapshotNotFoundError{PoolId: poolId, Time: t}
+	}
+	return parseTickLiquiditySnapshotFromBz(iter.Value())
+}
+
+// GetLiquidityPerTickRangeAtTime returns the liquidity depth of the given pool within the [lowerTick, upperTick] window,
+// as of the newest tick liquidity snapshot taken at or before the given time, along with the time of that snapshot.
+// The ranges between initialized ticks are clipped to the window.
+// Returns error if lower tick is not less than upper tick or if there is no snapshot at or before the given time.
+func (k Keeper) GetLiquidityPerTickRangeAtTime(ctx sdk.Context, poolId uint64, t time.Time, lowerTick, upperTick int64) ([]queryproto.LiquidityDepthWithRange, time.Time, error) {
+	if lowerTick >= upperTick {
+		return nil, time.Time{}, types.InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	snapshot, err := k.GetTickLiquiditySnapshotAtOrBeforeTime(ctx, poolId, t)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	liquidityDepthsForRange := []queryproto.LiquidityDepthWithRange{}
+	totalLiquidityWithinRange := sdk.ZeroDec()
+	for i := 0; i < len(snapshot.Ticks)-1; i++ {
+		totalLiquidityWithinRange = totalLiquidityWithinRange.Add(snapshot.Ticks[i].LiquidityNet)
+
+		rangeLowerTick := snapshot.Ticks[i].TickIndex
+		rangeUpperTick := snapshot.Ticks[i+1].TickIndex
+		if rangeUpperTick <= lowerTick {
+			continue
+		}
+		if rangeLowerTick >= upperTick {
+			break
+		}
+
+		if rangeLowerTick < lowerTick {
+			rangeLowerTick = lowerTick
+		}
+		if rangeUpperTick > upperTick {
+			rangeUpperTick = upperTick
+		}
+		liquidityDepthsForRange = append(liquidityDepthsForRange, queryproto.LiquidityDepthWithRange{
+			LowerTick:       rangeLowerTick,
+			UpperTick:       rangeUpperTick,
+			LiquidityAmount: totalLiquidityWithinRange,
+		})
+	}
+
+	return liquidityDepthsForRange, snapshot.Time, nil
+}
+
+// GetAllTickLiquiditySnapshots returns the tick liquidity snapshots of all pools, ordered by pool id and time.
+func (k Keeper) GetAllTickLiquiditySnapshots(ctx sdk.Context) ([]model.TickLiquiditySnapshot, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.TickLiquiditySnapshotPrefix, parseTickLiquiditySnapshotFromBz)
+}
+
+// hasTickLiquiditySnapshot returns whether the given pool has any tick liquidity snapshot.
+func (k Keeper) hasTickLiquiditySnapshot(ctx sdk.Context, poolId uint64) bool {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyTickLiquiditySnapshotsForPool(poolId))
+	defer iter.Close()
+	return iter.Valid()
+}
+
+func (k Keeper) setTickLiquiditySnapshot(ctx sdk.Context, snapshot model.TickLiquiditySnapshot) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTickLiquiditySnapshot(snapshot.PoolId, snapshot.Time), &snapshot)
+}
+
+func (k Keeper) getLastTickLiquiditySnapshotTime(ctx sdk.Context) (time.Time, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastTickLiquiditySnapshotTime)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	lastSnapshotTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return lastSnapshotTime, true
+}
+
+func (k Keeper) setLastTickLiquiditySnapshotTime(ctx sdk.Context, lastSnapshotTime time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastTickLiquiditySnapshotTime, sdk.FormatTimeBytes(lastSnapshotTime))
+}
+
+// getTickLiquiditySnapshotCursor returns the pool id from which the current round of snapshots resumes.
+// Returns false if no round is in progress.
+func (k Keeper) getTickLiquiditySnapshotCursor(ctx sdk.Context) (uint64, bool) {
+	cursor := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTickLiquiditySnapshotCursor, &cursor)
+	if err != nil {
+		panic(err)
+	}
+	return cursor.Value, found
+}
+
+// setTickLiquiditySnapshotCursor sets the pool id from which the current round of snapshots resumes.
+// A zero pool id ends the round.
+func (k Keeper) setTickLiquiditySnapshotCursor(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if poolId == 0 {
+		store.Delete(types.KeyTickLiquiditySnapshotCursor)
+		return
+	}
+	osmoutils.MustSet(store, types.KeyTickLiquiditySnapshotCursor, &gogotypes.UInt64Value{Value: poolId})
+}
+
+func parseTickLiquiditySnapshotFromBz(bz []byte) (model.TickLiquiditySnapshot, error) {
+	snapshot := model.TickLiquiditySnapshot{}
+	err := snapshot.Unmarshal(bz)
+	return snapshot, err
+}
@@ -0,0 +1,296 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+)
+
+// setupTickLiquiditySnapshotPool creates a pool with liquidity of 10 in [-100, 0) and 15 in [0, 100),
+// and sets the given tick liquidity snapshot params.
+func (s *KeeperTestSuite) setupTickLiquiditySnapshotPool(interval, keepPeriod time.Duration) uint64 {
+	pool := s.PrepareConcentratedPool()
+	for _, tick := range []struct {
+		tickIndex    int64
+		liquidityNet sdk.Dec
+	}{
+		{-100, sdk.NewDec(10)},
+		{0, sdk.NewDec(5)},
+		{100, sdk.NewDec(-15)},
+	} {
+		s.clk.SetTickInfo(s.Ctx, pool.GetId(), tick.tickIndex, &model.TickInfo{LiquidityNet: tick.liquidityNet})
+	}
+
+	params := s.clk.GetParams(s.Ctx)
+	params.TickLiquiditySnapshotInterval = interval
+	params.TickLiquiditySnapshotKeepPeriod = keepPeriod
+	s.clk.SetParams(s.Ctx, params)
+	return pool.GetId()
+}
+
+func (s *KeeperTestSuite) TestTakeTickLiquiditySnapshots() {
+	s.SetupTest()
+	poolId := s.setupTickLiquiditySnapshotPool(time.Hour, 2*time.Hour)
+	// A pool without initialized ticks is not snapshotted.
+	s.PrepareConcentratedPool()
+	startTime := s.Ctx.BlockTime()
+
+	expectedSnapshotTimes := func(offsets ...time.Duration) []time.Time {
+		times := []time.Time{}
+		for _, offset := range offsets {
+			times = append(times, startTime.Add(offset))
+		}
+		return times
+	}
+	requireSnapshotTimes := func(expected []time.Time) {
+		snapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+		s.Require().NoError(err)
+		times := []time.Time{}
+		for _, snapshot := range snapshots {
+			s.Require().Equal(poolId, snapshot.PoolId)
+			times = append(times, snapshot.Time)
+		}
+		s.Require().Equal(expected, times)
+	}
+
+	// The first snapshot is taken right away.
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	requireSnapshotTimes(expectedSnapshotTimes(0))
+	snapshot, err := s.clk.GetTickLiquiditySnapshotAtOrBeforeTime(s.Ctx, poolId, startTime)
+	s.Require().NoError(err)
+	s.Require().Equal([]model.SnapshotTick{
+		{TickIndex: -100, LiquidityNet: sdk.NewDec(10)},
+		{TickIndex: 0, LiquidityNet: sdk.NewDec(5)},
+		{TickIndex: 100, LiquidityNet: sdk.NewDec(-15)},
+	}, snapshot.Ticks)
+
+	// No snapshot is taken before the interval elapses.
+	s.AddBlockTime(30 * time.Minute)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	requireSnapshotTimes(expectedSnapshotTimes(0))
+
+	// Snapshots are taken once per interval.
+	s.AddBlockTime(30 * time.Minute)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	// The snapshot at the start is the newest one older than the keep period, so it is not pruned yet.
+	requireSnapshotTimes(expectedSnapshotTimes(0, time.Hour, 2*time.Hour, 3*time.Hour))
+
+	// Once a newer snapshot is older than the keep period, the one at the start is pruned.
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	requireSnapshotTimes(expectedSnapshotTimes(time.Hour, 2*time.Hour, 3*time.Hour, 4*time.Hour))
+}
+
+// TestTakeTickLiquiditySnapshots_AllPositionsWithdrawn tests that an empty snapshot is taken once all the positions
+// of a pool that was snapshotted before are withdrawn, so that the liquidity at later times is zero.
+func (s *KeeperTestSuite) TestTakeTickLiquiditySnapshots_AllPositionsWithdrawn() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	liquidity, positionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	params := s.clk.GetParams(s.Ctx)
+	params.TickLiquiditySnapshotInterval = time.Hour
+	params.TickLiquiditySnapshotKeepPeriod = 2 * time.Hour
+	s.clk.SetParams(s.Ctx, params)
+
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	snapshot, err := s.clk.GetTickLiquiditySnapshotAtOrBeforeTime(s.Ctx, pool.GetId(), s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Len(snapshot.Ticks, 2)
+
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidity)
+	s.Require().NoError(err)
+
+	s.AddBlockTime(time.Hour)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	snapshot, err = s.clk.GetTickLiquiditySnapshotAtOrBeforeTime(s.Ctx, pool.GetId(), s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime(), snapshot.Time)
+	s.Require().Empty(snapshot.Ticks)
+
+	liquidityDepths, _, err := s.clk.GetLiquidityPerTickRangeAtTime(s.Ctx, pool.GetId(), s.Ctx.BlockTime(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Empty(liquidityDepths)
+}
+
+func (s *KeeperTestSuite) TestTakeTickLiquiditySnapshots_Batches() {
+	s.SetupTest()
+	numPools := types.MaxTickLiquiditySnapshotPoolsPerBlock + 1
+	for i := 0; i < numPools; i++ {
+		s.setupTickLiquiditySnapshotPool(time.Hour, 2*time.Hour)
+	}
+	startTime := s.Ctx.BlockTime()
+
+	snapshotTimes := func() []time.Time {
+		snapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+		s.Require().NoError(err)
+		times := []time.Time{}
+		for _, snapshot := range snapshots {
+			times = append(times, snapshot.Time)
+		}
+		return times
+	}
+
+	// The first block of a round snapshots a full batch and leaves the last pool for the next block.
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.Require().Len(snapshotTimes(), numPools-1)
+	cursor, inProgress := s.clk.GetTickLiquiditySnapshotCursor(s.Ctx)
+	s.Require().True(inProgress)
+	s.Require().Equal(uint64(numPools), cursor)
+
+	// The next block resumes from the cursor and ends the round, even though the interval has not elapsed.
+	s.AddBlockTime(5 * time.Second)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	times := snapshotTimes()
+	s.Require().Len(times, numPools)
+	s.Require().Equal(s.Ctx.BlockTime(), times[numPools-1])
+	_, inProgress = s.clk.GetTickLiquiditySnapshotCursor(s.Ctx)
+	s.Require().False(inProgress)
+
+	// No new round starts before the interval elapses since the start of the last one.
+	s.AddBlockTime(time.Hour - 10*time.Second)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.Require().Len(snapshotTimes(), numPools)
+	s.AddBlockTime(5 * time.Second)
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+	s.Require().Len(snapshotTimes(), 2*numPools-1)
+	s.Require().Equal(startTime.Add(time.Hour), s.Ctx.BlockTime())
+}
+
+func (s *KeeperTestSuite) TestTakeTickLiquiditySnapshots_GasLimit() {
+	s.SetupTest()
+	poolId := s.setupTickLiquiditySnapshotPool(time.Hour, 2*time.Hour)
+	// A pool with too many initialized ticks runs out of gas, without preventing the other pools from being snapshotted.
+	expensivePoolId := s.setupTickLiquiditySnapshotPool(time.Hour, 2*time.Hour)
+	for tickIndex := int64(1); tickIndex <= 10_000; tickIndex++ {
+		s.clk.SetTickInfo(s.Ctx, expensivePoolId, tickIndex*int64(DefaultTickSpacing), &model.TickInfo{LiquidityNet: sdk.OneDec()})
+	}
+
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+
+	snapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 1)
+	s.Require().Equal(poolId, snapshots[0].PoolId)
+}
+
+func (s *KeeperTestSuite) TestTakeTickLiquiditySnapshots_Disabled() {
+	s.SetupTest()
+	s.setupTickLiquiditySnapshotPool(0, 2*time.Hour)
+
+	s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+
+	snapshots, err := s.clk.GetAllTickLiquiditySnapshots(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(snapshots)
+}
+
+func (s *KeeperTestSuite) TestGetLiquidityPerTickRangeAtTime() {
+	tests := map[string]struct {
+		timeOffset time.Duration
+		lowerTick  int64
+		upperTick  int64
+
+		expectedLiquidity    []queryproto.LiquidityDepthWithRange
+		expectedSnapshotTime time.Duration
+		expectedErr          error
+	}{
+		"window covering all ticks": {
+			timeOffset: 0,
+			lowerTick:  -1000,
+			upperTick:  1000,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: -100, UpperTick: 0, LiquidityAmount: sdk.NewDec(10)},
+				{LowerTick: 0, UpperTick: 100, LiquidityAmount: sdk.NewDec(15)},
+			},
+		},
+		"window clipping the ranges": {
+			timeOffset: 0,
+			lowerTick:  -50,
+			upperTick:  50,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: -50, UpperTick: 0, LiquidityAmount: sdk.NewDec(10)},
+				{LowerTick: 0, UpperTick: 50, LiquidityAmount: sdk.NewDec(15)},
+			},
+		},
+		"window within a single range": {
+			timeOffset: 0,
+			lowerTick:  10,
+			upperTick:  20,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: 10, UpperTick: 20, LiquidityAmount: sdk.NewDec(15)},
+			},
+		},
+		"window outside of the ranges": {
+			timeOffset:        0,
+			lowerTick:         100,
+			upperTick:         200,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{},
+		},
+		"time between snapshots: the older snapshot is used": {
+			timeOffset: 59 * time.Minute,
+			lowerTick:  -1000,
+			upperTick:  1000,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: -100, UpperTick: 0, LiquidityAmount: sdk.NewDec(10)},
+				{LowerTick: 0, UpperTick: 100, LiquidityAmount: sdk.NewDec(15)},
+			},
+		},
+		"time of the newer snapshot": {
+			timeOffset: time.Hour,
+			lowerTick:  -1000,
+			upperTick:  1000,
+			expectedLiquidity: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: -100, UpperTick: 0, LiquidityAmount: sdk.NewDec(10)},
+				{LowerTick: 0, UpperTick: 100, LiquidityAmount: sdk.NewDec(15)},
+				{LowerTick: 100, UpperTick: 200, LiquidityAmount: sdk.NewDec(20)},
+			},
+			expectedSnapshotTime: time.Hour,
+		},
+		"error: time before the first snapshot": {
+			timeOffset:  -time.Second,
+			lowerTick:   -1000,
+			upperTick:   1000,
+			expectedErr: types.TickLiquiditySnapshotNotFoundError{PoolId: 1, Time: defaultBlockTime.Add(-time.Second)},
+		},
+		"error: lower tick equal to upper tick": {
+			timeOffset:  0,
+			lowerTick:   100,
+			upperTick:   100,
+			expectedErr: types.InvalidLowerUpperTickError{LowerTick: 100, UpperTick: 100},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultBlockTime)
+			poolId := s.setupTickLiquiditySnapshotPool(time.Hour, 48*time.Hour)
+			s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+
+			// Add liquidity of 20 in [100, 200) for the next snapshot.
+			s.clk.SetTickInfo(s.Ctx, poolId, 100, &model.TickInfo{LiquidityNet: sdk.NewDec(5)})
+			s.clk.SetTickInfo(s.Ctx, poolId, 200, &model.TickInfo{LiquidityNet: sdk.NewDec(-20)})
+			s.AddBlockTime(time.Hour)
+			s.clk.TakeTickLiquiditySnapshots(s.Ctx)
+
+			// System under test.
+			liquidity, snapshotTime, err := s.clk.GetLiquidityPerTickRangeAtTime(s.Ctx, poolId, defaultBlockTime.Add(tc.timeOffset), tc.lowerTick, tc.upperTick)
+			if tc.expectedErr != nil {
+				s.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedLiquidity, liquidity)
+			s.Require().Equal(defaultBlockTime.Add(tc.expectedSnapshotTime), snapshotTime)
+		})
+	}
+}
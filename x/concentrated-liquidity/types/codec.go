@@ -19,10 +19,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/cl-place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "osmosis/cl-cancel-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/cl-claim-limit-order", nil)
+	cdc.RegisterConcrete(&MsgTransferPositions{}, "osmosis/cl-transfer-positions", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-position-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgMergePositions{}, "osmosis/cl-merge-positions", nil)
+	cdc.RegisterConcrete(&MsgSplitPosition{}, "osmosis/cl-split-position", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SetDynamicSpreadFactorRecordsProposal{}, "osmosis/cl-set-dynamic-spread-factor-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +47,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+		&MsgTransferPositions{},
+		&MsgSetPositionAutoCompound{},
+		&MsgMergePositions{},
+		&MsgSplitPosition{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&SetDynamicSpreadFactorRecordsProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
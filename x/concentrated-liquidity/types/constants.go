@@ -24,6 +24,19 @@ const (
 	ConcentratedGasFeeForSwap           = 10_000
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
+	// AutoCompoundEpochIdentifier is the epoch at the end of which the rewards of positions
+	// opted in auto-compounding are compounded.
+	AutoCompoundEpochIdentifier = "day"
+	// MaxAutoCompoundPositionsPerEpoch is the max number of positions compounded at the end of an epoch.
+	// The positions that are not reached are compounded at the end of the following epochs.
+	MaxAutoCompoundPositionsPerEpoch = 100
+	// AutoCompoundGasLimitPerPosition is the gas limit for compounding a single position.
+	AutoCompoundGasLimitPerPosition = 3_000_000
+	// MaxTickLiquiditySnapshotPoolsPerBlock is the max number of pool ids whose tick liquidity is snapshotted per block.
+	// The pools that are not reached are snapshotted in the following blocks.
+	MaxTickLiquiditySnapshotPoolsPerBlock = 10
+	// TickLiquiditySnapshotGasLimitPerPool is the gas limit for snapshotting the tick liquidity of a single pool.
+	TickLiquiditySnapshotGasLimitPerPool = 5_000_000
 )
 
 var (
@@ -48,4 +61,11 @@ var (
 	DefaultBalancerSharesDiscount = sdk.MustNewDecFromStr("0.05")
 	// By default, we only authorize one nanosecond (one block) uptime as an option
 	DefaultAuthorizedUptimes = []time.Duration{time.Nanosecond}
+	// By default, tick liquidity snapshots are taken hourly and kept for two days.
+	DefaultTickLiquiditySnapshotInterval   = time.Hour
+	DefaultTickLiquiditySnapshotKeepPeriod = 48 * time.Hour
+	// By default, the swaps of auto-compounded rewards get at most 5% less than their value at the
+	// five minute twap of the pool.
+	DefaultAutoCompoundSwapTwapWindow  = 5 * time.Minute
+	DefaultMaxAutoCompoundSwapSlippage = sdk.NewDecWithPrec(5, 2)
 )
@@ -887,3 +887,115 @@ type ComputedSqrtPriceInequalityError struct {
 func (e ComputedSqrtPriceInequalityError) Error() string {
 	return fmt.Sprintf("edge case has occurred when swapping at tick boundaries, with izZeroForOne (%t), NextInitializedTickSqrtPrice (%s), computedSqrtPrice (%s). Please try again with a different swap amount", e.IsZeroForOne, e.NextInitializedTickSqrtPrice, e.ComputedSqrtPrice)
 }
+
+type LimitOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order (%d) not found", e.OrderId)
+}
+
+type LimitOrderOwnerMismatchError struct {
+	OrderId uint64
+	Owner   string
+	Sender  string
+}
+
+func (e LimitOrderOwnerMismatchError) Error() string {
+	return fmt.Sprintf("limit order (%d) is owned by (%s), not (%s)", e.OrderId, e.Owner, e.Sender)
+}
+
+type LimitOrderBookNotFoundError struct {
+	PoolId       uint64
+	TickIndex    int64
+	TokenInDenom string
+	Generation   uint64
+}
+
+func (e LimitOrderBookNotFoundError) Error() string {
+	return fmt.Sprintf("limit order book of generation (%d) selling (%s) at tick (%d) of pool (%d) not found", e.Generation, e.TokenInDenom, e.TickIndex, e.PoolId)
+}
+
+type InvalidLimitOrderTickError struct {
+	TickIndex   int64
+	CurrentTick int64
+	IsAsk       bool
+}
+
+func (e InvalidLimitOrderTickError) Error() string {
+	if e.IsAsk {
+		return fmt.Sprintf("asks must be placed above the current tick (%d), got tick (%d)", e.CurrentTick, e.TickIndex)
+	}
+	return fmt.Sprintf("bids must be placed at or below the current tick (%d), got tick (%d)", e.CurrentTick, e.TickIndex)
+}
+
+type InvalidLimitOrderTickSpacingError struct {
+	TickIndex   int64
+	TickSpacing uint64
+}
+
+func (e InvalidLimitOrderTickSpacingError) Error() string {
+	return fmt.Sprintf("limit order tick (%d) must be within the min and max ticks and a multiple of the tick spacing (%d)", e.TickIndex, e.TickSpacing)
+}
+
+type PositionHasUnderlyingLockError struct {
+	PositionId uint64
+	LockId     uint64
+}
+
+func (e PositionHasUnderlyingLockError) Error() string {
+	return fmt.Sprintf("position (%d) has an underlying lock (%d) and cannot be transferred, merged or split", e.PositionId, e.LockId)
+}
+
+type DuplicatePositionIdsError struct {
+	PositionIds []uint64
+}
+
+func (e DuplicatePositionIdsError) Error() string {
+	return fmt.Sprintf("position ids (%v) must not contain duplicates", e.PositionIds)
+}
+
+type DynamicSpreadFactorRecordNotFoundError struct {
+	PoolId uint64
+}
+
+func (e DynamicSpreadFactorRecordNotFoundError) Error() string {
+	return fmt.Sprintf("dynamic spread factor record not found for pool (%d)", e.PoolId)
+}
+
+type NonPositiveTwapError struct {
+	PoolId uint64
+	Twap   sdk.Dec
+}
+
+func (e NonPositiveTwapError) Error() string {
+	return fmt.Sprintf("twap of pool (%d) must be positive to compute its volatility, got (%s)", e.PoolId, e.Twap)
+}
+
+type PositionAutoCompoundNotFoundError struct {
+	PositionId uint64
+}
+
+func (e PositionAutoCompoundNotFoundError) Error() string {
+	return fmt.Sprintf("position (%d) is not opted in auto-compounding", e.PositionId)
+}
+
+type SplitLiquidityExceedsPositionError struct {
+	PositionId       uint64
+	Liquidity        sdk.Dec
+	LiquidityToSplit sdk.Dec
+}
+
+func (e SplitLiquidityExceedsPositionError) Error() string {
+	return fmt.Sprintf("liquidity to split (%s) must be less than the liquidity (%s) of position (%d)", e.LiquidityToSplit, e.Liquidity, e.PositionId)
+}
+
+type TickLiquiditySnapshotNotFoundError struct {
+	PoolId uint64
+	Time   time.Time
+}
+
+func (e TickLiquiditySnapshotNotFoundError) Error() string {
+	return fmt.Sprintf("no tick liquidity snapshot found for pool (%d) at or before time (%s)", e.PoolId, e.Time)
+}
@@ -12,6 +12,14 @@ const (
 	TypeEvtFungifyChargedPosition    = "fungify_charged_position"
 	TypeEvtMoveRewards               = "move_rewards"
 	TypeEvtCrossTick                 = "cross_tick"
+	TypeEvtPlaceLimitOrder           = "place_limit_order"
+	TypeEvtCancelLimitOrder          = "cancel_limit_order"
+	TypeEvtClaimLimitOrder           = "claim_limit_order"
+	TypeEvtTransferPositions         = "transfer_positions"
+	TypeEvtSetPositionAutoCompound   = "set_position_auto_compound"
+	TypeEvtCompoundPosition          = "compound_position"
+	TypeEvtMergePositions            = "merge_positions"
+	TypeEvtSplitPosition             = "split_position"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -36,6 +44,7 @@ const (
 	AttributeIncentiveMinUptime                                    = "incentive_min_uptime"
 	AttributeInputPositionIds                                      = "input_position_ids"
 	AttributeOutputPositionId                                      = "output_position_id"
+	AttributeOutputPositionIds                                     = "output_position_ids"
 	AttributePoolAccumName                                         = "pool_accum_name"
 	AttributeOldPositionAccumName                                  = "old_position_accum_name"
 	AttributeNewPositionAccumName                                  = "new_position_accum_name"
@@ -46,4 +55,10 @@ const (
 	AttributeKeyTickIndex                                          = "tick_idx"
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
+	AttributeKeyLimitOrderId                                       = "limit_order_id"
+	AttributeKeyTokenIn                                            = "token_in"
+	AttributeKeyAmountRefunded                                     = "amount_refunded"
+	AttributeKeyProceedsClaimed                                    = "proceeds_claimed"
+	AttributeKeyNewOwner                                           = "new_owner"
+	AttributeKeyAutoCompoundEnabled                                = "auto_compound_enabled"
 )
@@ -33,6 +33,7 @@ type PoolManagerKeeper interface {
 	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
 	GetNextPoolId(ctx sdk.Context) uint64
 	CreateConcentratedPoolAsPoolManager(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (poolmanagertypes.PoolI, error)
+	RouteExactAmountInWithoutTakerFee(ctx sdk.Context, sender sdk.AccAddress, route []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
 }
 
 type GAMMKeeper interface {
@@ -67,3 +68,8 @@ type LockupKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// TwapKeeper defines the contract needed to be fulfilled for the twap keeper.
+type TwapKeeper interface {
+	GetArithmeticTwap(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+}
@@ -248,10 +248,16 @@ type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containining serialized pool struct and ticks.
-	PoolData              []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
-	PositionData          []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
-	NextPositionId        uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
-	NextIncentiveRecordId uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	PoolData                   []PoolData                         `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	PositionData               []PositionData                     `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	NextPositionId             uint64                             `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	NextIncentiveRecordId      uint64                             `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	LimitOrders                []model.LimitOrder                 `protobuf:"bytes,6,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	LimitOrderBooks            []model.LimitOrderBook             `protobuf:"bytes,7,rep,name=limit_order_books,json=limitOrderBooks,proto3" json:"limit_order_books"`
+	NextLimitOrderId           uint64                             `protobuf:"varint,8,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty" yaml:"next_limit_order_id"`
+	DynamicSpreadFactorRecords []types1.DynamicSpreadFactorRecord `protobuf:"bytes,9,rep,name=dynamic_spread_factor_records,json=dynamicSpreadFactorRecords,proto3" json:"dynamic_spread_factor_records" yaml:"dynamic_spread_factor_records"`
+	AutoCompoundPositions      []model.PositionAutoCompound       `protobuf:"bytes,10,rep,name=auto_compound_positions,json=autoCompoundPositions,proto3" json:"auto_compound_positions" yaml:"auto_compound_positions"`
+	TickLiquiditySnapshots     []model.TickLiquiditySnapshot      `protobuf:"bytes,11,rep,name=tick_liquidity_snapshots,json=tickLiquiditySnapshots,proto3" json:"tick_liquidity_snapshots" yaml:"tick_liquidity_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -322,6 +328,48 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderBooks() []model.LimitOrderBook {
+	if m != nil {
+		return m.LimitOrderBooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderId() uint64 {
+	if m != nil {
+		return m.NextLimitOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDynamicSpreadFactorRecords() []types1.DynamicSpreadFactorRecord {
+	if m != nil {
+		return m.DynamicSpreadFactorRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundPositions() []model.PositionAutoCompound {
+	if m != nil {
+		return m.AutoCompoundPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTickLiquiditySnapshots() []model.TickLiquiditySnapshot {
+	if m != nil {
+		return m.TickLiquiditySnapshots
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -388,62 +436,77 @@ func init() {
 }
 
 var fileDescriptor_5c140d686ee6724a = []byte{
-	// 870 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xee, 0x34, 0x69, 0x69, 0x9d, 0xec, 0xd2, 0xb5, 0xba, 0x74, 0xb6, 0x68, 0x93, 0xe0, 0x55,
-	0xa5, 0xa2, 0xa5, 0x33, 0x6a, 0xcb, 0x87, 0xc4, 0xad, 0xb3, 0x7c, 0x28, 0x20, 0x41, 0x65, 0x96,
-	0x0b, 0x5f, 0xc1, 0x19, 0xbb, 0xc1, 0xec, 0xcc, 0x78, 0x18, 0x3b, 0xa5, 0xb9, 0xf2, 0x0b, 0x10,
-	0x27, 0x7e, 0x04, 0x47, 0x24, 0xce, 0xdc, 0x56, 0x88, 0x43, 0x8f, 0x9c, 0x22, 0xd4, 0xfe, 0x83,
-	0xfc, 0x02, 0x34, 0xfe, 0x48, 0x27, 0xa5, 0x55, 0xd3, 0xbd, 0x8d, 0xfd, 0x3e, 0xcf, 0xf3, 0x3e,
-	0xf6, 0xfb, 0xbe, 0x4e, 0xc0, 0x1b, 0x42, 0xa6, 0x42, 0x72, 0x19, 0xc6, 0x22, 0x8b, 0x59, 0xa6,
-	0x0a, 0xa2, 0x18, 0xdd, 0x49, 0xf8, 0x0f, 0x43, 0x4e, 0xb9, 0x1a, 0x85, 0x03, 0x96, 0x31, 0xc9,
-	0x65, 0x90, 0x17, 0x42, 0x09, 0xb8, 0x65, 0xd1, 0x41, 0x15, 0x3d, 0x05, 0x07, 0xc7, 0xbb, 0x7d,
-	0xa6, 0xc8, 0xee, 0xe6, 0xfa, 0x40, 0x0c, 0x84, 0x66, 0x84, 0xe5, 0x97, 0x21, 0x6f, 0x3e, 0x88,
-	0x35, 0xbb, 0x67, 0x02, 0x66, 0x61, 0x43, 0x2d, 0xb3, 0x0a, 0xfb, 0x44, 0xb2, 0xd0, 0xaa, 0x84,
-	0xb1, 0xe0, 0x99, 0xa3, 0x0e, 0x84, 0x18, 0x24, 0x2c, 0xd4, 0xab, 0xfe, 0xf0, 0x28, 0x24, 0xd9,
-	0xc8, 0x86, 0x5e, 0x73, 0x07, 0x20, 0x71, 0x3c, 0x4c, 0xa7, 0x64, 0xbd, 0xb2, 0x90, 0xc7, 0x37,
-	0x9c, 0x31, 0x27, 0x05, 0x49, 0x9d, 0x95, 0x9d, 0x9b, 0xc0, 0x42, 0x72, 0xc5, 0x45, 0x36, 0x27,
-	0x5c, 0xf1, 0xf8, 0x59, 0x37, 0x3b, 0x72, 0x77, 0xf0, 0xd6, 0x0d, 0x70, 0xae, 0x77, 0xf9, 0x31,
-	0xeb, 0x15, 0x2c, 0x16, 0x05, 0x35, 0x34, 0xf4, 0xb7, 0x07, 0x56, 0x3e, 0x18, 0x26, 0xc9, 0x53,
-	0x1e, 0x3f, 0x83, 0x8f, 0xc1, 0x4b, 0xb9, 0x10, 0x49, 0x8f, 0x53, 0xdf, 0xeb, 0x78, 0xdb, 0xf5,
-	0x08, 0x4e, 0xc6, 0xed, 0xbb, 0x23, 0x92, 0x26, 0xef, 0x22, 0x1b, 0x40, 0x78, 0xb9, 0xfc, 0xea,
-	0x52, 0xf8, 0x26, 0x00, 0xa5, 0x85, 0x1e, 0xcf, 0x28, 0x3b, 0xf1, 0x17, 0x3b, 0xde, 0x76, 0x2d,
-	0xba, 0x3f, 0x19, 0xb7, 0xef, 0x19, 0xfc, 0x45, 0x0c, 0xe1, 0x55, 0xe3, 0x95, 0xb2, 0x13, 0xf8,
-	0x35, 0xa8, 0xf3, 0xec, 0x48, 0xf8, 0xb5, 0x8e, 0xb7, 0xdd, 0xd8, 0x0b, 0x83, 0xb9, 0xca, 0x1e,
-	0x3c, 0xb5, 0x67, 0x8d, 0xfc, 0xe7, 0xe3, 0xf6, 0xc2, 0x64, 0xdc, 0x5e, 0x9b, 0x49, 0x72, 0x24,
-	0x10, 0xd6, 0xb2, 0xe8, 0x8f, 0x3a, 0x58, 0x39, 0x14, 0x22, 0x79, 0x8f, 0x28, 0x02, 0xf7, 0x41,
-	0xbd, 0xf4, 0xaa, 0xcf, 0xd2, 0xd8, 0x5b, 0x0f, 0x4c, 0xa9, 0x03, 0x57, 0xea, 0xe0, 0x20, 0x1b,
-	0x45, 0xab, 0x7f, 0xfd, 0xbe, 0xb3, 0x54, 0x32, 0xba, 0x58, 0x83, 0xe1, 0x97, 0x60, 0xa9, 0x54,
-	0x95, 0xfe, 0x62, 0xa7, 0x76, 0x0b, 0x87, 0xee, 0x0e, 0xa3, 0x75, 0xeb, 0xb0, 0x79, 0xe1, 0x50,
-	0x22, 0x6c, 0x34, 0xe1, 0xaf, 0x1e, 0x78, 0x20, 0xf3, 0x82, 0x11, 0xda, 0x2b, 0xd8, 0x8f, 0xa4,
-	0xa0, 0x3d, 0xdd, 0x4d, 0xc3, 0x84, 0x28, 0x51, 0xd8, 0x3b, 0xd9, 0x9b, 0x33, 0xe3, 0x41, 0xc9,
-	0xfc, 0xb4, 0xff, 0x3d, 0x8b, 0x55, 0xb4, 0x6d, 0x93, 0x76, 0x4c, 0xd2, 0x6b, 0x53, 0x20, 0xbc,
-	0x61, 0x62, 0x58, 0x87, 0x0e, 0x2e, 0x22, 0xf0, 0x17, 0x0f, 0x6c, 0x4c, 0x7b, 0x44, 0x56, 0x49,
-	0xd2, 0xaf, 0xeb, 0xab, 0x78, 0x11, 0x63, 0x5b, 0xd6, 0xd8, 0x43, 0x63, 0xec, 0xea, 0x04, 0x08,
-	0xbf, 0x72, 0x11, 0xa8, 0x78, 0x92, 0x90, 0x83, 0x7b, 0x97, 0xfb, 0x56, 0xfa, 0x4b, 0xda, 0xcd,
-	0xdb, 0x73, 0xba, 0xe9, 0x3a, 0x3e, 0xd6, 0xf4, 0xa8, 0x5e, 0x3a, 0xc2, 0x6b, 0x7c, 0x76, 0x5b,
-	0xa2, 0x3f, 0x17, 0x41, 0xf3, 0xd0, 0x4e, 0xa0, 0xee, 0x9e, 0x8f, 0xc1, 0x8a, 0x9b, 0x48, 0xdb,
-	0x41, 0xf3, 0xf6, 0x82, 0x93, 0xc1, 0x53, 0x81, 0x72, 0xb2, 0x12, 0x51, 0xf6, 0x2a, 0xd5, 0x93,
-	0x32, 0x33, 0x59, 0x36, 0x80, 0xf0, 0x72, 0xf9, 0xd5, 0xa5, 0xf0, 0x5b, 0xb0, 0x79, 0x45, 0x05,
-	0xed, 0xf9, 0x6d, 0x97, 0x3c, 0x9c, 0x7a, 0x31, 0xef, 0x91, 0xcb, 0x3d, 0x73, 0xca, 0xff, 0x17,
-	0xdb, 0x84, 0xe1, 0xe7, 0x60, 0x7d, 0x98, 0x2b, 0x9e, 0xb2, 0x19, 0x69, 0x57, 0xe8, 0xb9, 0xb4,
-	0xa1, 0x11, 0xa8, 0xa8, 0x4a, 0xf4, 0x5b, 0x0d, 0x34, 0x3f, 0x34, 0xcf, 0xfa, 0x67, 0x8a, 0x28,
-	0x06, 0x9f, 0x80, 0x65, 0xf3, 0x04, 0xda, 0x1b, 0xdc, 0xba, 0xe1, 0x06, 0x0f, 0x35, 0xd8, 0x66,
-	0xb0, 0x54, 0x88, 0xc1, 0xaa, 0x7e, 0x7c, 0x28, 0x51, 0xe4, 0x96, 0x53, 0xe9, 0x9e, 0x02, 0xab,
-	0xb8, 0x92, 0xbb, 0xa7, 0xe1, 0x1b, 0x70, 0xc7, 0xd5, 0xc6, 0xe8, 0xd6, 0xb4, 0xee, 0xfe, 0x2d,
-	0x2b, 0x5c, 0xd1, 0x6e, 0xe6, 0xd5, 0xe6, 0x79, 0x1f, 0xac, 0x65, 0xec, 0x44, 0xf5, 0xa6, 0x49,
-	0x38, 0xf5, 0xeb, 0xba, 0xf0, 0xaf, 0x4e, 0xc6, 0xed, 0x0d, 0x53, 0xf8, 0xcb, 0x08, 0x84, 0xef,
-	0x96, 0x5b, 0x4e, 0xbc, 0x4b, 0xe1, 0x57, 0xc0, 0xd7, 0xa0, 0xcb, 0x43, 0x50, 0xca, 0x2d, 0x69,
-	0xb9, 0x47, 0x93, 0x71, 0xbb, 0x5d, 0x91, 0xbb, 0x02, 0x89, 0xf0, 0xfd, 0x32, 0x74, 0x69, 0x10,
-	0xba, 0x14, 0xfd, 0xe4, 0x81, 0x46, 0x65, 0x58, 0xe1, 0x23, 0x50, 0xcf, 0x48, 0xca, 0x74, 0xad,
-	0x56, 0xa3, 0x97, 0x27, 0xe3, 0x76, 0xc3, 0x2a, 0x93, 0x94, 0x21, 0xac, 0x83, 0xf0, 0x13, 0x70,
-	0xc7, 0xf4, 0x4c, 0x2c, 0x32, 0xc5, 0x32, 0xa5, 0xfb, 0xb9, 0xb1, 0xf7, 0xfa, 0x35, 0x3d, 0x53,
-	0x19, 0xe7, 0x27, 0x86, 0x80, 0x9b, 0x1a, 0x61, 0x57, 0x11, 0x7d, 0x7e, 0xd6, 0xf2, 0x4e, 0xcf,
-	0x5a, 0xde, 0xbf, 0x67, 0x2d, 0xef, 0xe7, 0xf3, 0xd6, 0xc2, 0xe9, 0x79, 0x6b, 0xe1, 0x9f, 0xf3,
-	0xd6, 0xc2, 0x17, 0x1f, 0x0d, 0xb8, 0xfa, 0x6e, 0xd8, 0x0f, 0x62, 0x91, 0x86, 0x56, 0x7c, 0x27,
-	0x21, 0x7d, 0xe9, 0x16, 0xe1, 0xf1, 0xee, 0x3b, 0xe1, 0xc9, 0xb5, 0x3f, 0x8f, 0xa3, 0x9c, 0x49,
-	0xf7, 0x27, 0xa3, 0xbf, 0xac, 0x1f, 0xfd, 0xfd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xc3,
-	0xb9, 0xcc, 0x95, 0x08, 0x00, 0x00,
+	// 1117 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x4e, 0x1a, 0x8f, 0xdd, 0x3f, 0x19, 0x92, 0x66, 0x1b, 0x14, 0xdb, 0x6c, 0x09,
+	0x04, 0xb5, 0xf1, 0x2a, 0x09, 0x05, 0x09, 0x38, 0x90, 0x4d, 0x29, 0x32, 0x14, 0x1a, 0x6d, 0xca,
+	0xa5, 0xfc, 0x59, 0xc6, 0x3b, 0x13, 0x77, 0xc8, 0xee, 0x8e, 0xd9, 0x19, 0x87, 0xf8, 0xca, 0x27,
+	0x40, 0x9c, 0x90, 0x38, 0xf0, 0x19, 0x90, 0x2a, 0x71, 0xe6, 0x56, 0x21, 0x0e, 0x3d, 0x72, 0xb2,
+	0x50, 0xf2, 0x0d, 0xfc, 0x09, 0xd0, 0xce, 0xcc, 0xae, 0xd7, 0x21, 0xc6, 0x36, 0xb7, 0x9d, 0x7d,
+	0xef, 0xfd, 0x7e, 0xbf, 0x79, 0xf3, 0xde, 0x9b, 0x01, 0x77, 0x19, 0x0f, 0x19, 0xa7, 0xdc, 0xf6,
+	0x59, 0xe4, 0x93, 0x48, 0xc4, 0x48, 0x10, 0xbc, 0x15, 0xd0, 0x6f, 0x3b, 0x14, 0x53, 0xd1, 0xb5,
+	0x5b, 0x24, 0x22, 0x9c, 0xf2, 0x7a, 0x3b, 0x66, 0x82, 0xc1, 0x0d, 0xed, 0x5d, 0xcf, 0x7b, 0x67,
+	0xce, 0xf5, 0x93, 0xed, 0x26, 0x11, 0x68, 0x7b, 0x6d, 0xb9, 0xc5, 0x5a, 0x4c, 0x46, 0xd8, 0xc9,
+	0x97, 0x0a, 0x5e, 0xbb, 0xe5, 0xcb, 0x68, 0x4f, 0x19, 0xd4, 0x42, 0x9b, 0x2a, 0x6a, 0x65, 0x37,
+	0x11, 0x27, 0xb6, 0x46, 0xb1, 0x7d, 0x46, 0xa3, 0x34, 0xb4, 0xc5, 0x58, 0x2b, 0x20, 0xb6, 0x5c,
+	0x35, 0x3b, 0x47, 0x36, 0x8a, 0xba, 0xda, 0xf4, 0x4a, 0xba, 0x01, 0xe4, 0xfb, 0x9d, 0x30, 0x0b,
+	0x96, 0x2b, 0xed, 0x72, 0x67, 0xcc, 0x1e, 0xdb, 0x28, 0x46, 0x61, 0x2a, 0x65, 0x6b, 0x9c, 0x33,
+	0xe3, 0x54, 0x50, 0x16, 0x4d, 0xe8, 0x2e, 0xa8, 0x7f, 0xdc, 0x88, 0x8e, 0xd2, 0x1c, 0xdc, 0x1b,
+	0xe3, 0x4e, 0xe5, 0x5f, 0x7a, 0x42, 0xbc, 0x98, 0xf8, 0x2c, 0xc6, 0x3a, 0xcc, 0x1e, 0x13, 0x16,
+	0xd0, 0x90, 0x8a, 0x47, 0x31, 0x26, 0xb1, 0x0e, 0xd8, 0x1c, 0x77, 0xac, 0xec, 0x44, 0x79, 0x5a,
+	0x7f, 0x1a, 0x60, 0xf1, 0x41, 0x27, 0x08, 0x1e, 0x53, 0xff, 0x18, 0xde, 0x01, 0x57, 0xda, 0x8c,
+	0x05, 0x1e, 0xc5, 0xa6, 0x51, 0x33, 0x36, 0x0b, 0x0e, 0xec, 0xf7, 0xaa, 0xd7, 0xba, 0x28, 0x0c,
+	0xde, 0xb1, 0xb4, 0xc1, 0x72, 0x17, 0x92, 0xaf, 0x06, 0x86, 0x6f, 0x02, 0x90, 0xec, 0xce, 0xa3,
+	0x11, 0x26, 0xa7, 0xe6, 0x6c, 0xcd, 0xd8, 0x9c, 0x73, 0x56, 0xfa, 0xbd, 0xea, 0x92, 0xf2, 0x1f,
+	0xd8, 0x2c, 0xb7, 0xa8, 0xd2, 0x80, 0xc9, 0x29, 0xfc, 0x12, 0x14, 0x68, 0x74, 0xc4, 0xcc, 0xb9,
+	0x9a, 0xb1, 0x59, 0xda, 0xb1, 0xeb, 0x13, 0x55, 0x54, 0xfd, 0xb1, 0x4e, 0xa3, 0x63, 0x3e, 0xef,
+	0x55, 0x67, 0xfa, 0xbd, 0xea, 0x8d, 0x21, 0x92, 0x23, 0x66, 0xb9, 0x12, 0xd6, 0xfa, 0xad, 0x00,
+	0x16, 0x0f, 0x18, 0x0b, 0xee, 0x23, 0x81, 0xe0, 0x2e, 0x28, 0x24, 0x5a, 0xe5, 0x5e, 0x4a, 0x3b,
+	0xcb, 0x75, 0x55, 0x45, 0xf5, 0xb4, 0x8a, 0xea, 0x7b, 0x51, 0xd7, 0x29, 0xfe, 0xf1, 0x6c, 0x6b,
+	0x3e, 0x89, 0x68, 0xb8, 0xd2, 0x19, 0x7e, 0x0e, 0xe6, 0x13, 0x54, 0x6e, 0xce, 0xd6, 0xe6, 0xa6,
+	0x50, 0x98, 0xe6, 0xd0, 0x59, 0xd6, 0x0a, 0xcb, 0x03, 0x85, 0xdc, 0x72, 0x15, 0x26, 0xfc, 0xc9,
+	0x00, 0xb7, 0x78, 0x3b, 0x26, 0x08, 0x7b, 0x31, 0xf9, 0x0e, 0xc5, 0xd8, 0x93, 0x85, 0xda, 0x09,
+	0x90, 0x60, 0xb1, 0xce, 0xc9, 0xce, 0x84, 0x8c, 0x7b, 0x49, 0xe4, 0xa3, 0xe6, 0x37, 0xc4, 0x17,
+	0xce, 0xa6, 0x26, 0xad, 0x29, 0xd2, 0x91, 0x14, 0x96, 0xbb, 0xaa, 0x6c, 0xae, 0x34, 0xed, 0x0d,
+	0x2c, 0xf0, 0x47, 0x03, 0xac, 0x66, 0xe5, 0xc7, 0xf3, 0x41, 0xdc, 0x2c, 0xd4, 0xe6, 0xfe, 0xa7,
+	0xb0, 0x0d, 0x2d, 0x6c, 0x5d, 0x09, 0xbb, 0x9c, 0xc0, 0x72, 0x6f, 0x0e, 0x0c, 0x39, 0x4d, 0x1c,
+	0x52, 0xb0, 0x74, 0xb1, 0x25, 0xb8, 0x39, 0x2f, 0xd5, 0xbc, 0x35, 0xa1, 0x9a, 0x46, 0x1a, 0xef,
+	0xca, 0x70, 0xa7, 0x90, 0x28, 0x72, 0x6f, 0xd0, 0xe1, 0xdf, 0xdc, 0xfa, 0x7d, 0x16, 0x94, 0x0f,
+	0x74, 0x73, 0xcb, 0xea, 0xf9, 0x18, 0x2c, 0xa6, 0xcd, 0xae, 0x2b, 0x68, 0xd2, 0x5a, 0x48, 0x61,
+	0xdc, 0x0c, 0x20, 0xe9, 0xac, 0x80, 0x25, 0xb5, 0x8a, 0xcd, 0xd9, 0x8b, 0x9d, 0xa5, 0x0d, 0x96,
+	0xbb, 0x90, 0x7c, 0x35, 0x30, 0xfc, 0x1a, 0xac, 0x5d, 0x72, 0x82, 0x7a, 0xff, 0xba, 0x4a, 0xd6,
+	0x33, 0x2d, 0xd2, 0x98, 0x71, 0x0f, 0xed, 0xf2, 0xdf, 0x87, 0xad, 0xcc, 0xf0, 0x33, 0xb0, 0xdc,
+	0x69, 0x0b, 0x1a, 0x92, 0x21, 0xe8, 0xf4, 0xa0, 0x27, 0xc2, 0x86, 0x0a, 0x20, 0x87, 0xca, 0xad,
+	0x67, 0x45, 0x50, 0xfe, 0x50, 0xdd, 0x18, 0x87, 0x02, 0x09, 0x02, 0xf7, 0xc1, 0x82, 0x9a, 0xae,
+	0x3a, 0x83, 0x1b, 0x63, 0x32, 0x78, 0x20, 0x9d, 0x35, 0x83, 0x0e, 0x85, 0x2e, 0x28, 0xca, 0xe1,
+	0x83, 0x91, 0x40, 0x53, 0x76, 0x65, 0x3a, 0x0a, 0x34, 0xe2, 0x62, 0x3b, 0x1d, 0x0d, 0x5f, 0x81,
+	0xab, 0xe9, 0xd9, 0x28, 0xdc, 0x39, 0x89, 0xbb, 0x3b, 0xe5, 0x09, 0xe7, 0xb0, 0xcb, 0xed, 0x7c,
+	0xf1, 0x7c, 0x00, 0x6e, 0x44, 0xe4, 0x54, 0x78, 0x19, 0x09, 0xc5, 0x66, 0x41, 0x1e, 0xfc, 0xcb,
+	0xfd, 0x5e, 0x75, 0x55, 0x1d, 0xfc, 0x45, 0x0f, 0xcb, 0xbd, 0x96, 0xfc, 0x4a, 0xc1, 0x1b, 0x18,
+	0x7e, 0x01, 0x4c, 0xe9, 0x74, 0xb1, 0x09, 0x12, 0xb8, 0x79, 0x09, 0x77, 0xbb, 0xdf, 0xab, 0x56,
+	0x73, 0x70, 0x97, 0x78, 0x5a, 0xee, 0x4a, 0x62, 0xba, 0xd0, 0x08, 0x0d, 0x0c, 0x9f, 0x80, 0xb2,
+	0xbc, 0x39, 0x3c, 0x96, 0x5c, 0x1d, 0xdc, 0x5c, 0x90, 0x39, 0xd8, 0x9e, 0x30, 0x07, 0x0f, 0xb3,
+	0x4b, 0x47, 0x67, 0xa0, 0x34, 0xb8, 0x86, 0x38, 0x6c, 0x81, 0xa5, 0x1c, 0xb6, 0xd7, 0x64, 0xec,
+	0x98, 0x9b, 0x57, 0x24, 0xc1, 0xbd, 0xe9, 0x09, 0x18, 0x3b, 0xd6, 0x24, 0xd7, 0x83, 0xa1, 0xbf,
+	0x1c, 0x7e, 0x02, 0x5e, 0x92, 0x1b, 0xcf, 0xb3, 0x51, 0x6c, 0x2e, 0xca, 0xec, 0x54, 0xfa, 0xbd,
+	0xea, 0x5a, 0x2e, 0x3b, 0xc3, 0x4e, 0x96, 0x2b, 0x0f, 0x69, 0xc0, 0xd3, 0xc0, 0xf0, 0x57, 0x03,
+	0xac, 0xe3, 0x6e, 0x84, 0x42, 0xea, 0x7b, 0xba, 0x09, 0x8f, 0x90, 0x2f, 0x58, 0x9c, 0xf5, 0x48,
+	0x51, 0x6e, 0xe2, 0xfd, 0x09, 0x37, 0x71, 0x5f, 0x61, 0x1d, 0x4a, 0xa8, 0x07, 0x12, 0x49, 0xb7,
+	0xd1, 0x5d, 0x3d, 0x1a, 0x5f, 0x55, 0xfa, 0xfe, 0x93, 0xd4, 0x72, 0xd7, 0xf0, 0x28, 0x20, 0x0e,
+	0x7f, 0x36, 0xc0, 0x2a, 0xea, 0x08, 0xe6, 0xf9, 0x2c, 0x6c, 0xb3, 0x4e, 0x84, 0xb3, 0xa2, 0xe2,
+	0x26, 0x90, 0x6a, 0xdf, 0x9d, 0xb2, 0xae, 0xf7, 0x3a, 0x82, 0xed, 0x6b, 0x30, 0xe7, 0x35, 0x2d,
+	0xb4, 0xa2, 0x84, 0x8e, 0x60, 0xb2, 0xdc, 0x15, 0x94, 0x8b, 0x4a, 0x91, 0x38, 0xfc, 0xc5, 0x00,
+	0xa6, 0xbc, 0xa7, 0x33, 0x3a, 0x8f, 0x47, 0xa8, 0xcd, 0x9f, 0x32, 0xc1, 0xcd, 0x92, 0x94, 0xf7,
+	0xde, 0x14, 0xcf, 0x80, 0x87, 0xe9, 0xdf, 0x43, 0x0d, 0xe2, 0xbc, 0xae, 0xf5, 0x55, 0x73, 0x6f,
+	0x82, 0x4b, 0xb8, 0x2c, 0xf7, 0xa6, 0xb8, 0x2c, 0x9e, 0x5b, 0xdf, 0x1b, 0xa0, 0x94, 0xbb, 0xb4,
+	0xe0, 0x6d, 0x50, 0x88, 0x50, 0x48, 0xe4, 0xcc, 0x2a, 0x3a, 0xd7, 0xfb, 0xbd, 0x6a, 0x49, 0xd7,
+	0x10, 0x0a, 0x89, 0xe5, 0x4a, 0x23, 0xfc, 0x14, 0x5c, 0x55, 0xb3, 0xd3, 0x67, 0x91, 0x20, 0x91,
+	0x90, 0x73, 0xbd, 0xb4, 0xf3, 0xc6, 0x88, 0xd9, 0x99, 0xbb, 0xd6, 0xf6, 0x55, 0x80, 0x5b, 0x96,
+	0x1e, 0x7a, 0xe5, 0xe0, 0xe7, 0x67, 0x15, 0xe3, 0xc5, 0x59, 0xc5, 0xf8, 0xfb, 0xac, 0x62, 0xfc,
+	0x70, 0x5e, 0x99, 0x79, 0x71, 0x5e, 0x99, 0xf9, 0xeb, 0xbc, 0x32, 0xf3, 0xe4, 0xa3, 0x16, 0x15,
+	0x4f, 0x3b, 0xcd, 0xba, 0xcf, 0xc2, 0xf4, 0x21, 0xb8, 0x15, 0xa0, 0x26, 0x4f, 0x17, 0xf6, 0xc9,
+	0xf6, 0xdb, 0xf6, 0xe9, 0xc8, 0x17, 0x68, 0xb7, 0x4d, 0x78, 0xfa, 0x8e, 0x6f, 0x2e, 0xc8, 0xc7,
+	0xcf, 0xee, 0x3f, 0x03, 0x00, 0xfe, 0xda, 0xc9, 0x5d, 0xf8, 0x0b, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -660,6 +723,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TickLiquiditySnapshots) > 0 {
+		for iNdEx := len(m.TickLiquiditySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickLiquiditySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AutoCompoundPositions) > 0 {
+		for iNdEx := len(m.AutoCompoundPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DynamicSpreadFactorRecords) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LimitOrderBooks) > 0 {
+		for iNdEx := len(m.LimitOrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextIncentiveRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
 		i--
@@ -864,6 +1002,39 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrderBooks) > 0 {
+		for _, e := range m.LimitOrderBooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderId))
+	}
+	if len(m.DynamicSpreadFactorRecords) > 0 {
+		for _, e := range m.DynamicSpreadFactorRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundPositions) > 0 {
+		for _, e := range m.AutoCompoundPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TickLiquiditySnapshots) > 0 {
+		for _, e := range m.TickLiquiditySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1572,6 +1743,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderBooks = append(m.LimitOrderBooks, model.LimitOrderBook{})
+			if err := m.LimitOrderBooks[len(m.LimitOrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderId", wireType)
+			}
+			m.NextLimitOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactorRecords = append(m.DynamicSpreadFactorRecords, types1.DynamicSpreadFactorRecord{})
+			if err := m.DynamicSpreadFactorRecords[len(m.DynamicSpreadFactorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundPositions = append(m.AutoCompoundPositions, model.PositionAutoCompound{})
+			if err := m.AutoCompoundPositions[len(m.AutoCompoundPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquiditySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickLiquiditySnapshots = append(m.TickLiquiditySnapshots, model.TickLiquiditySnapshot{})
+			if err := m.TickLiquiditySnapshots[len(m.TickLiquiditySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,7 +2,9 @@ package genesis_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
@@ -50,6 +52,25 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			exepectedError: true,
 		},
+		{
+			name: "invalid dynamic spread factor record",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              genesis.DefaultGenesis().PoolData,
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				DynamicSpreadFactorRecords: []types.DynamicSpreadFactorRecord{
+					{
+						PoolId:               1,
+						MinSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+						MaxSpreadFactor:      sdk.MustNewDecFromStr("0.001"),
+						VolatilityMultiplier: sdk.OneDec(),
+						VolatilityWindow:     time.Hour,
+					},
+				},
+			},
+			exepectedError: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -11,6 +11,7 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSetDynamicSpreadFactorRecords   = "SetDynamicSpreadFactorRecords"
 )
 
 func init() {
@@ -18,11 +19,14 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/CreateCLPoolsProposal")
 	govtypes.RegisterProposalType(ProposalTypeTickSpacingDecrease)
 	govtypes.RegisterProposalTypeCodec(&TickSpacingDecreaseProposal{}, "osmosis/TickSpacingDecreaseProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetDynamicSpreadFactorRecords)
+	govtypes.RegisterProposalTypeCodec(&SetDynamicSpreadFactorRecordsProposal{}, "osmosis/SetDynamicSpreadFactorRecordsProposal")
 }
 
 var (
 	_ govtypes.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypes.Content = &TickSpacingDecreaseProposal{}
+	_ govtypes.Content = &SetDynamicSpreadFactorRecordsProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -154,3 +158,99 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetDynamicSpreadFactorRecordsProposal(title, description string, records []DynamicSpreadFactorRecord, poolIdsToRemove []uint64) govtypes.Content {
+	return &SetDynamicSpreadFactorRecordsProposal{
+		Title:           title,
+		Description:     description,
+		Records:         records,
+		PoolIdsToRemove: poolIdsToRemove,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDynamicSpreadFactorRecordsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDynamicSpreadFactorRecordsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDynamicSpreadFactorRecordsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDynamicSpreadFactorRecordsProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSpreadFactorRecords
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetDynamicSpreadFactorRecordsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Records) == 0 && len(p.PoolIdsToRemove) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	poolIds := make(map[uint64]bool, len(p.Records)+len(p.PoolIdsToRemove))
+	for _, record := range p.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if poolIds[record.PoolId] {
+			return fmt.Errorf("duplicate pool id (%d)", record.PoolId)
+		}
+		poolIds[record.PoolId] = true
+	}
+	for _, poolId := range p.PoolIdsToRemove {
+		if poolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if poolIds[poolId] {
+			return fmt.Errorf("duplicate pool id (%d)", poolId)
+		}
+		poolIds[poolId] = true
+	}
+	return nil
+}
+
+// String returns a string containing the set dynamic spread factor records proposal.
+func (p SetDynamicSpreadFactorRecordsProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.Records {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityMultiplier: %s, VolatilityWindow: %s) ", record.PoolId, record.MinSpreadFactor, record.MaxSpreadFactor, record.VolatilityMultiplier, record.VolatilityWindow)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Spread Factor Records Proposal:
+Title:              %s
+Description:        %s
+Records:            %s
+Pool IDs To Remove: %v
+`, p.Title, p.Description, recordsStr, p.PoolIdsToRemove))
+	return b.String()
+}
+
+// Validate returns an error if the record does not have a pool id, if its spread factor bounds are not
+// within [0, 1) or the min is greater than the max, if its volatility multiplier is negative, or if its
+// volatility window is not positive.
+func (r DynamicSpreadFactorRecord) Validate() error {
+	if r.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	for _, spreadFactor := range []sdk.Dec{r.MinSpreadFactor, r.MaxSpreadFactor} {
+		if spreadFactor.IsNil() || spreadFactor.IsNegative() || spreadFactor.GTE(sdk.OneDec()) {
+			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+		}
+	}
+	if r.MinSpreadFactor.GT(r.MaxSpreadFactor) {
+		return fmt.Errorf("min spread factor (%s) must not be greater than max spread factor (%s)", r.MinSpreadFactor, r.MaxSpreadFactor)
+	}
+	if r.VolatilityMultiplier.IsNil() || r.VolatilityMultiplier.IsNegative() {
+		return fmt.Errorf("volatility multiplier must not be negative, got (%s)", r.VolatilityMultiplier)
+	}
+	if r.VolatilityWindow <= 0 {
+		return fmt.Errorf("volatility window must be positive, got (%s)", r.VolatilityWindow)
+	}
+	return nil
+}
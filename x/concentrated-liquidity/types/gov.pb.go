@@ -8,15 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -225,11 +229,117 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 	return 0
 }
 
+// SetDynamicSpreadFactorRecordsProposal is a gov Content type for opting pools
+// in or out of the dynamic spread factor mode. The records are set for their
+// pools, replacing any existing record, and the records of the pools in
+// pool_ids_to_remove are removed so that these pools are back to their static
+// spread factor. The proposal will fail if one of the pools does not exist.
+type SetDynamicSpreadFactorRecordsProposal struct {
+	Title           string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Records         []DynamicSpreadFactorRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+	PoolIdsToRemove []uint64                    `protobuf:"varint,4,rep,packed,name=pool_ids_to_remove,json=poolIdsToRemove,proto3" json:"pool_ids_to_remove,omitempty"`
+}
+
+func (m *SetDynamicSpreadFactorRecordsProposal) Reset()      { *m = SetDynamicSpreadFactorRecordsProposal{} }
+func (*SetDynamicSpreadFactorRecordsProposal) ProtoMessage() {}
+func (*SetDynamicSpreadFactorRecordsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{4}
+}
+func (m *SetDynamicSpreadFactorRecordsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicSpreadFactorRecordsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicSpreadFactorRecordsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDynamicSpreadFactorRecordsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicSpreadFactorRecordsProposal.Merge(m, src)
+}
+func (m *SetDynamicSpreadFactorRecordsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicSpreadFactorRecordsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicSpreadFactorRecordsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicSpreadFactorRecordsProposal proto.InternalMessageInfo
+
+// DynamicSpreadFactorRecord opts a pool in the dynamic spread factor mode.
+// Each block, the effective spread factor of the pool is recomputed as its
+// spread factor plus volatility_multiplier times the realized volatility of its
+// TWAP over the last volatility_window, bounded by min_spread_factor and
+// max_spread_factor.
+type DynamicSpreadFactorRecord struct {
+	PoolId               uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinSpreadFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	VolatilityWindow     time.Duration                          `protobuf:"bytes,5,opt,name=volatility_window,json=volatilityWindow,proto3,stdduration" json:"volatility_window" yaml:"volatility_window"`
+}
+
+func (m *DynamicSpreadFactorRecord) Reset()         { *m = DynamicSpreadFactorRecord{} }
+func (m *DynamicSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorRecord) ProtoMessage()    {}
+func (*DynamicSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{5}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorRecord.Merge(m, src)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DynamicSpreadFactorRecord) GetVolatilityWindow() time.Duration {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
+	proto.RegisterType((*SetDynamicSpreadFactorRecordsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetDynamicSpreadFactorRecordsProposal")
+	proto.RegisterType((*DynamicSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorRecord")
 }
 
 func init() {
@@ -237,44 +347,59 @@ func init() {
 }
 
 var fileDescriptor_e6d167276ceeedc2 = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x4e, 0x6c, 0xb7, 0xe2, 0xb4, 0x2b, 0x1a, 0x2b, 0x5b, 0x77, 0x25, 0x29, 0x01, 0xa5, 0x1e,
-	0x9a, 0x18, 0x3d, 0x08, 0x3d, 0x69, 0x77, 0x59, 0x58, 0x10, 0x2d, 0x71, 0x4f, 0x22, 0x84, 0x74,
-	0x32, 0xd6, 0xa1, 0xe9, 0x4c, 0x36, 0x33, 0xdb, 0xdd, 0x1e, 0xbd, 0x09, 0x5e, 0xbc, 0x08, 0x1e,
-	0xfb, 0x73, 0xf6, 0xb8, 0x47, 0xf1, 0x10, 0xa4, 0xbd, 0x78, 0xb5, 0xbf, 0x40, 0x32, 0x93, 0xb6,
-	0xe9, 0x6a, 0xc1, 0x65, 0x4f, 0xc9, 0x7b, 0xf3, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xc3, 0x80, 0x06,
-	0x65, 0x03, 0xca, 0x30, 0xb3, 0x21, 0x25, 0x10, 0x11, 0x1e, 0xfb, 0x1c, 0x05, 0xcd, 0x10, 0x1f,
-	0x1d, 0xe3, 0x00, 0xf3, 0x91, 0xdd, 0xa3, 0x43, 0x2b, 0x8a, 0x29, 0xa7, 0xda, 0x83, 0x0c, 0x69,
-	0xe5, 0x91, 0x0b, 0xa0, 0x35, 0x74, 0xba, 0x88, 0xfb, 0xce, 0x76, 0xb5, 0x47, 0x7b, 0x54, 0x54,
-	0xd8, 0xe9, 0x9f, 0x2c, 0x36, 0xa7, 0x2a, 0x68, 0xec, 0xc6, 0xc8, 0xe7, 0x68, 0x37, 0x57, 0xfd,
-	0x72, 0x5e, 0xdd, 0xa1, 0x34, 0x64, 0x9d, 0x98, 0x46, 0x94, 0xf9, 0xa1, 0x56, 0x05, 0x1b, 0x1c,
-	0xf3, 0x10, 0xd5, 0xd4, 0xba, 0xda, 0xb8, 0xe1, 0xca, 0x40, 0xab, 0x83, 0x72, 0x80, 0x18, 0x8c,
-	0x71, 0xc4, 0x31, 0x25, 0xb5, 0x6b, 0xe2, 0x2c, 0x9f, 0xd2, 0x8e, 0x40, 0x25, 0xa2, 0x34, 0xf4,
-	0x62, 0x04, 0x69, 0x1c, 0xb0, 0x5a, 0xa1, 0x5e, 0x68, 0x94, 0x9f, 0x38, 0xd6, 0x7f, 0x09, 0xb7,
-	0x52, 0x0d, 0xae, 0xa8, 0x6c, 0xef, 0x9c, 0x25, 0x86, 0x32, 0x4b, 0x8c, 0x3b, 0x23, 0x7f, 0x10,
-	0xb6, 0xcc, 0x7c, 0x53, 0xd3, 0x2d, 0x47, 0x0b, 0x20, 0x6b, 0x55, 0x3e, 0x8d, 0x0d, 0xe5, 0xdb,
-	0xd8, 0x50, 0x7e, 0x8d, 0x0d, 0xd5, 0xfc, 0xad, 0x82, 0x9d, 0x43, 0x0c, 0xfb, 0x6f, 0x22, 0x1f,
-	0x62, 0xd2, 0xdb, 0x43, 0x30, 0x46, 0x3e, 0x43, 0x57, 0x36, 0xf6, 0x59, 0x05, 0x86, 0x10, 0x81,
-	0x03, 0x8f, 0x53, 0x8f, 0x63, 0xd8, 0xf7, 0x98, 0xe4, 0xb8, 0x60, 0xf6, 0xf9, 0x25, 0xcc, 0x1e,
-	0x04, 0x87, 0x34, 0xa7, 0x36, 0xf3, 0x5e, 0x4c, 0xbd, 0xbb, 0xdb, 0xd1, 0x3a, 0xc0, 0x45, 0xcf,
-	0x01, 0xb8, 0xb7, 0xb6, 0x99, 0xb6, 0x05, 0xae, 0x67, 0xba, 0x85, 0xe5, 0xa2, 0x5b, 0x92, 0x7d,
-	0xb5, 0x06, 0xb8, 0x45, 0xd0, 0xc9, 0x8a, 0x13, 0x61, 0xbc, 0xe8, 0xde, 0x24, 0xe8, 0x24, 0xd7,
-	0xa8, 0x55, 0x14, 0x2c, 0x5f, 0x0b, 0x00, 0x2c, 0x17, 0xa4, 0x3d, 0x02, 0xa5, 0x00, 0x11, 0x3a,
-	0x78, 0x2c, 0x27, 0xd9, 0xbe, 0x3d, 0x4b, 0x8c, 0x4d, 0xb9, 0x2c, 0x99, 0x37, 0xdd, 0x0c, 0xb0,
-	0x80, 0x3a, 0x72, 0xb0, 0x7f, 0x41, 0x9d, 0x39, 0xd4, 0xd1, 0x5a, 0xa0, 0xb2, 0x22, 0xa8, 0x90,
-	0x0a, 0x6a, 0x6f, 0x2d, 0x2f, 0x42, 0xfe, 0xd4, 0x74, 0xcb, 0x7c, 0x29, 0x53, 0xfb, 0xa8, 0x82,
-	0xbb, 0xe8, 0x34, 0xa2, 0x04, 0x11, 0xee, 0xf9, 0xdc, 0x8b, 0x62, 0x0c, 0x91, 0x47, 0x09, 0xaa,
-	0x15, 0x05, 0xed, 0xab, 0x74, 0xac, 0x3f, 0x12, 0xe3, 0x61, 0x0f, 0xf3, 0x0f, 0xc7, 0x5d, 0x0b,
-	0xd2, 0x81, 0x0d, 0xc5, 0xae, 0xb2, 0x4f, 0x93, 0x05, 0x7d, 0x9b, 0x8f, 0x22, 0xc4, 0xac, 0x03,
-	0xc2, 0x67, 0x89, 0x71, 0x5f, 0x72, 0xfe, 0xb3, 0xa9, 0xe9, 0x6a, 0xf3, 0xfc, 0x0b, 0xde, 0x49,
-	0xb3, 0xaf, 0x09, 0xd2, 0xfa, 0x60, 0x93, 0x45, 0x31, 0xf2, 0x03, 0xef, 0xbd, 0x0f, 0x39, 0x8d,
-	0x6b, 0x1b, 0x82, 0x7a, 0xff, 0x12, 0xd4, 0x7b, 0x08, 0xce, 0x12, 0xa3, 0x2a, 0xa9, 0x57, 0x9a,
-	0x99, 0x6e, 0x45, 0xc6, 0xfb, 0x22, 0x94, 0x7b, 0x69, 0xbf, 0x3b, 0x9b, 0xe8, 0xea, 0xf9, 0x44,
-	0x57, 0x7f, 0x4e, 0x74, 0xf5, 0xcb, 0x54, 0x57, 0xce, 0xa7, 0xba, 0xf2, 0x7d, 0xaa, 0x2b, 0x6f,
-	0xdb, 0x39, 0xb6, 0xec, 0x4e, 0x36, 0x43, 0xbf, 0xcb, 0xe6, 0x81, 0x3d, 0x74, 0x9e, 0xd9, 0xa7,
-	0xeb, 0x9e, 0x1d, 0xa1, 0xa6, 0x5b, 0x12, 0x8f, 0xc7, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x72, 0xf6, 0xcc, 0xa4, 0xa5, 0x04, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0x5f, 0xf2, 0xfa, 0xc4, 0x24, 0xef, 0xa3, 0x43, 0x9e, 0x9e, 0xdb, 0xa2, 0xb8, 0xb2,
+	0x28, 0x0a, 0xaa, 0x6a, 0x93, 0xb2, 0x40, 0xca, 0x0a, 0xd2, 0xa8, 0x52, 0x11, 0x1f, 0x95, 0x5b,
+	0x09, 0x09, 0x21, 0x99, 0x89, 0x3d, 0x0d, 0xa3, 0xd8, 0x33, 0xae, 0x67, 0xf2, 0xb5, 0x44, 0x6c,
+	0x90, 0xd8, 0xb0, 0x41, 0xea, 0xb2, 0xbf, 0x81, 0x5f, 0xd1, 0x65, 0x97, 0x88, 0x45, 0x40, 0xcd,
+	0x86, 0x2d, 0x59, 0xb3, 0x40, 0x9e, 0x71, 0x12, 0x27, 0x6d, 0xa4, 0xd7, 0x76, 0x95, 0xcc, 0x9d,
+	0x3b, 0xe7, 0xdc, 0x33, 0xf7, 0x5c, 0x0f, 0xa8, 0x32, 0x1e, 0x32, 0x4e, 0xb8, 0xed, 0x31, 0xea,
+	0x61, 0x2a, 0x62, 0x24, 0xb0, 0xbf, 0x17, 0x90, 0xf3, 0x2e, 0xf1, 0x89, 0x18, 0xda, 0x6d, 0xd6,
+	0xb3, 0xa2, 0x98, 0x09, 0x06, 0x77, 0xd2, 0x4c, 0x2b, 0x9b, 0x39, 0x4b, 0xb4, 0x7a, 0xb5, 0x16,
+	0x16, 0xa8, 0xb6, 0x59, 0x6e, 0xb3, 0x36, 0x93, 0x27, 0xec, 0xe4, 0x9f, 0x3a, 0xbc, 0x59, 0x69,
+	0x33, 0xd6, 0x0e, 0xb0, 0x2d, 0x57, 0xad, 0xee, 0x99, 0xed, 0x77, 0x63, 0x24, 0x08, 0xa3, 0x6a,
+	0xdf, 0x1c, 0x6b, 0xa0, 0x7a, 0x10, 0x63, 0x24, 0xf0, 0x41, 0x06, 0xfd, 0x8b, 0x29, 0xfa, 0x31,
+	0x63, 0x01, 0x3f, 0x8e, 0x59, 0xc4, 0x38, 0x0a, 0x60, 0x19, 0x3c, 0x15, 0x44, 0x04, 0x58, 0xd7,
+	0xb6, 0xb5, 0xea, 0x3b, 0x8e, 0x5a, 0xc0, 0x6d, 0x50, 0xf4, 0x31, 0xf7, 0x62, 0x12, 0x25, 0xb8,
+	0xfa, 0x13, 0xb9, 0x97, 0x0d, 0xc1, 0x73, 0x50, 0x8a, 0x18, 0x0b, 0xdc, 0x18, 0x7b, 0x2c, 0xf6,
+	0xb9, 0x9e, 0xdf, 0xce, 0x57, 0x8b, 0xfb, 0x35, 0xeb, 0xad, 0x84, 0x59, 0x49, 0x0d, 0x8e, 0x3c,
+	0xd9, 0xd8, 0xba, 0x1a, 0x19, 0xb9, 0xc9, 0xc8, 0x78, 0x77, 0x88, 0xc2, 0xa0, 0x6e, 0x66, 0x41,
+	0x4d, 0xa7, 0x18, 0xcd, 0x12, 0x79, 0xbd, 0xf4, 0xf3, 0xa5, 0x91, 0xbb, 0xb8, 0x34, 0x72, 0xff,
+	0x5c, 0x1a, 0x9a, 0xf9, 0xaf, 0x06, 0xb6, 0x4e, 0x89, 0xd7, 0x39, 0x89, 0x90, 0x47, 0x68, 0xbb,
+	0x89, 0xbd, 0x18, 0x23, 0x8e, 0x1f, 0x2d, 0xec, 0x17, 0x0d, 0x18, 0xb2, 0x08, 0xe2, 0xbb, 0x82,
+	0xb9, 0x82, 0x78, 0x1d, 0x97, 0x2b, 0x8e, 0x25, 0xb1, 0x9f, 0xde, 0x43, 0xec, 0x91, 0x7f, 0xca,
+	0x32, 0xd5, 0xa6, 0xda, 0x0b, 0x89, 0x76, 0x67, 0x33, 0x5a, 0x95, 0xb0, 0xac, 0xd9, 0x07, 0x1b,
+	0x2b, 0xc1, 0xe0, 0x1b, 0xf0, 0x2c, 0xad, 0x5b, 0x4a, 0x2e, 0x38, 0x6b, 0x0a, 0x17, 0x56, 0xc1,
+	0x2b, 0x8a, 0xfb, 0x0b, 0x4a, 0xa4, 0xf0, 0x82, 0xf3, 0x82, 0xe2, 0x7e, 0x06, 0xa8, 0x5e, 0x90,
+	0x2c, 0xbf, 0xe5, 0x01, 0x98, 0x37, 0x08, 0x7e, 0x08, 0xd6, 0x7c, 0x4c, 0x59, 0xf8, 0x91, 0xba,
+	0xc9, 0xc6, 0xfa, 0x64, 0x64, 0x3c, 0x57, 0xcd, 0x52, 0x71, 0xd3, 0x49, 0x13, 0x66, 0xa9, 0x35,
+	0xfd, 0xc9, 0x9d, 0xa9, 0xb5, 0x69, 0x6a, 0x0d, 0xd6, 0x41, 0x69, 0xa1, 0xa0, 0x7c, 0x52, 0x50,
+	0xe3, 0xcd, 0xdc, 0x08, 0xd9, 0x5d, 0xd3, 0x29, 0x8a, 0x79, 0x99, 0xf0, 0x47, 0x0d, 0xbc, 0xc6,
+	0x83, 0x88, 0x51, 0x4c, 0x85, 0x8b, 0x84, 0x1b, 0xc5, 0xc4, 0xc3, 0x2e, 0xa3, 0x58, 0x2f, 0x48,
+	0xda, 0xaf, 0x92, 0x6b, 0xfd, 0x73, 0x64, 0x7c, 0xd0, 0x26, 0xe2, 0x87, 0x6e, 0xcb, 0xf2, 0x58,
+	0x68, 0x7b, 0xb2, 0x57, 0xe9, 0xcf, 0x1e, 0xf7, 0x3b, 0xb6, 0x18, 0x46, 0x98, 0x5b, 0x47, 0x54,
+	0x4c, 0x46, 0xc6, 0x7b, 0x8a, 0xf3, 0x4e, 0x50, 0xd3, 0x81, 0xd3, 0xf8, 0x67, 0xe2, 0x38, 0x89,
+	0x7e, 0x4d, 0x31, 0xec, 0x80, 0xe7, 0x3c, 0x8a, 0x31, 0xf2, 0xdd, 0x33, 0xe4, 0x09, 0x16, 0xeb,
+	0x4f, 0x25, 0xf5, 0xe1, 0x3d, 0xa8, 0x9b, 0xd8, 0x9b, 0x8c, 0x8c, 0xb2, 0xa2, 0x5e, 0x00, 0x33,
+	0x9d, 0x92, 0x5a, 0x1f, 0xca, 0x65, 0xda, 0x97, 0xff, 0x34, 0xb0, 0x73, 0x82, 0x45, 0x73, 0x48,
+	0x51, 0x48, 0xbc, 0x93, 0x4c, 0x42, 0xea, 0x96, 0x47, 0x7b, 0xff, 0x7b, 0xf0, 0xec, 0x61, 0x16,
+	0x5f, 0x59, 0x53, 0x6a, 0xf1, 0x29, 0x2c, 0xdc, 0x05, 0x30, 0x35, 0x29, 0x4f, 0xa6, 0x2b, 0xc6,
+	0x21, 0xeb, 0x25, 0x6d, 0xcb, 0x57, 0x0b, 0xce, 0x4b, 0xe5, 0x57, 0x7e, 0xca, 0x1c, 0x19, 0x5e,
+	0x32, 0xff, 0xef, 0x05, 0xb0, 0xb1, 0x92, 0x07, 0xee, 0x2e, 0xb9, 0xbf, 0x01, 0x27, 0x23, 0xe3,
+	0x45, 0xe6, 0x9b, 0x42, 0x7c, 0x73, 0x36, 0x11, 0x3d, 0xb0, 0x1e, 0x12, 0xea, 0x2e, 0x36, 0x50,
+	0x59, 0xf6, 0xf3, 0x7b, 0x37, 0x50, 0x57, 0x24, 0xb7, 0x00, 0x4d, 0xe7, 0x65, 0x48, 0x68, 0xb6,
+	0x54, 0xc9, 0x8b, 0x06, 0x4b, 0xbc, 0xf9, 0x47, 0xf2, 0xa2, 0xc1, 0x6d, 0x5e, 0x34, 0x58, 0xe0,
+	0xfd, 0x49, 0x03, 0xaf, 0x7b, 0x2c, 0x40, 0x82, 0x04, 0x44, 0x0c, 0xdd, 0xb0, 0x1b, 0x08, 0x12,
+	0x05, 0x04, 0xc7, 0x0f, 0x18, 0x18, 0x45, 0x9e, 0x0e, 0xcc, 0x9d, 0xa0, 0xa6, 0x53, 0x9e, 0xc7,
+	0xbf, 0x9c, 0x85, 0x61, 0x00, 0xd6, 0x33, 0xf9, 0x7d, 0x42, 0x7d, 0xd6, 0x97, 0x63, 0x53, 0xdc,
+	0xdf, 0xb0, 0xd4, 0x9b, 0x66, 0x4d, 0xdf, 0x34, 0xab, 0x99, 0xbe, 0x69, 0x8d, 0xf7, 0xd3, 0xf7,
+	0x41, 0xbf, 0xc5, 0xa8, 0x10, 0xcc, 0x8b, 0xbf, 0x0c, 0xcd, 0x79, 0x35, 0x8f, 0x7f, 0x23, 0xc3,
+	0x6a, 0x66, 0x1a, 0xdf, 0x5d, 0xdd, 0x54, 0xb4, 0xeb, 0x9b, 0x8a, 0xf6, 0xf7, 0x4d, 0x45, 0xfb,
+	0x75, 0x5c, 0xc9, 0x5d, 0x8f, 0x2b, 0xb9, 0x3f, 0xc6, 0x95, 0xdc, 0xb7, 0x8d, 0x8c, 0xd6, 0xd4,
+	0xe4, 0x7b, 0x01, 0x6a, 0xf1, 0xe9, 0xc2, 0xee, 0xd5, 0x3e, 0xb1, 0x07, 0xab, 0x9e, 0x72, 0x79,
+	0x17, 0xad, 0x35, 0x59, 0xee, 0xc7, 0xff, 0x0f, 0x00, 0x2c, 0x68, 0xb2, 0x3b, 0xf9, 0x07, 0x00,
+	0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -410,6 +535,85 @@ func (this *PoolRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetDynamicSpreadFactorRecordsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDynamicSpreadFactorRecordsProposal)
+	if !ok {
+		that2, ok := that.(SetDynamicSpreadFactorRecordsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(&that1.Records[i]) {
+			return false
+		}
+	}
+	if len(this.PoolIdsToRemove) != len(that1.PoolIdsToRemove) {
+		return false
+	}
+	for i := range this.PoolIdsToRemove {
+		if this.PoolIdsToRemove[i] != that1.PoolIdsToRemove[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DynamicSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.MinSpreadFactor.Equal(that1.MinSpreadFactor) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if !this.VolatilityMultiplier.Equal(that1.VolatilityMultiplier) {
+		return false
+	}
+	if this.VolatilityWindow != that1.VolatilityWindow {
+		return false
+	}
+	return true
+}
 func (m *CreateConcentratedLiquidityPoolsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -607,6 +811,141 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDynamicSpreadFactorRecordsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicSpreadFactorRecordsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicSpreadFactorRecordsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdsToRemove) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIdsToRemove)*10)
+		var j1 int
+		for _, num := range m.PoolIdsToRemove {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VolatilityWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -703,6 +1042,56 @@ func (m *PoolRecord) Size() (n int) {
 	return n
 }
 
+func (m *SetDynamicSpreadFactorRecordsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.PoolIdsToRemove) > 0 {
+		l = 0
+		for _, e := range m.PoolIdsToRemove {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1294,6 +1683,434 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDynamicSpreadFactorRecordsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorRecordsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorRecordsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DynamicSpreadFactorRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIdsToRemove = append(m.PoolIdsToRemove, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIdsToRemove) == 0 {
+					m.PoolIdsToRemove = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIdsToRemove = append(m.PoolIdsToRemove, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdsToRemove", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VolatilityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
@@ -192,3 +193,142 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSetDynamicSpreadFactorRecordsProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.SetDynamicSpreadFactorRecordsProposal
+	}{
+		{ // empty title
+			proposal: &types.SetDynamicSpreadFactorRecordsProposal{
+				Title:       "",
+				Description: "proposal to set dynamic spread factor records",
+			},
+		},
+		{ // empty description
+			proposal: &types.SetDynamicSpreadFactorRecordsProposal{
+				Title:       "title",
+				Description: "",
+			},
+		},
+		{ // happy path
+			proposal: &types.SetDynamicSpreadFactorRecordsProposal{
+				Title:       "title",
+				Description: "proposal to set dynamic spread factor records",
+				Records: []types.DynamicSpreadFactorRecord{
+					{
+						PoolId:               1,
+						MinSpreadFactor:      sdk.MustNewDecFromStr("0.001"),
+						MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+						VolatilityMultiplier: sdk.MustNewDecFromStr("0.5"),
+						VolatilityWindow:     time.Hour,
+					},
+				},
+				PoolIdsToRemove: []uint64{2, 3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.SetDynamicSpreadFactorRecordsProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
+
+func TestSetDynamicSpreadFactorRecordsProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.DynamicSpreadFactorRecord{
+		PoolId:               1,
+		MinSpreadFactor:      sdk.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      sdk.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: sdk.MustNewDecFromStr("0.5"),
+		VolatilityWindow:     time.Hour,
+	}
+
+	tests := []struct {
+		name            string
+		modifyFunc      func(types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord
+		poolIdsToRemove []uint64
+		expectPass      bool
+	}{
+		{
+			name:       "proper msg",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord { return record },
+			expectPass: true,
+		},
+		{
+			name:            "proper msg with pool ids to remove",
+			modifyFunc:      func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord { return record },
+			poolIdsToRemove: []uint64{2},
+			expectPass:      true,
+		},
+		{
+			name: "zero pool id",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.PoolId = 0
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative min spread factor",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MinSpreadFactor = sdk.MustNewDecFromStr("-0.001")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "max spread factor of one",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MaxSpreadFactor = sdk.OneDec()
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "min spread factor above max spread factor",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MinSpreadFactor = sdk.MustNewDecFromStr("0.02")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative volatility multiplier",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.VolatilityMultiplier = sdk.MustNewDecFromStr("-1")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero volatility window",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.VolatilityWindow = 0
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name:            "pool id both set and removed",
+			modifyFunc:      func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord { return record },
+			poolIdsToRemove: []uint64{1},
+			expectPass:      false,
+		},
+	}
+
+	for _, test := range tests {
+		records := []types.DynamicSpreadFactorRecord{test.modifyFunc(baseRecord)}
+
+		proposal := types.NewSetDynamicSpreadFactorRecordsProposal("title", "description", records, test.poolIdsToRemove)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -49,6 +50,22 @@ var (
 
 	KeyTotalLiquidity = []byte{0x13}
 
+	KeyNextGlobalLimitOrderId = []byte{0x14}
+	LimitOrderIdPrefix        = []byte{0x15}
+	LimitOrderBookPrefix      = []byte{0x16}
+	UserLimitOrderPrefix      = []byte{0x17}
+	LimitOrderEscrowPrefix    = []byte{0x1E}
+
+	DynamicSpreadFactorRecordPrefix = []byte{0x18}
+	EffectiveSpreadFactorPrefix     = []byte{0x19}
+
+	PositionAutoCompoundPrefix = []byte{0x1A}
+	KeyAutoCompoundCursor      = []byte{0x1D}
+
+	TickLiquiditySnapshotPrefix      = []byte{0x1B}
+	KeyLastTickLiquiditySnapshotTime = []byte{0x1C}
+	KeyTickLiquiditySnapshotCursor   = []byte{0x1F}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -266,6 +283,68 @@ func KeyBalancerFullRange(clPoolId, balancerPoolId, uptimeIndex uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s%d%s%d", BalancerFullRangePrefix, KeySeparator, clPoolId, KeySeparator, balancerPoolId, KeySeparator, uptimeIndex))
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrderId returns the key consisted of (LimitOrderIdPrefix | order Id) and is used to store limit orders.
+func KeyLimitOrderId(orderId uint64) []byte {
+	return append(LimitOrderIdPrefix, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyAddressPoolIdLimitOrderId returns the full key needed to store the limit order id for given addr + pool id + order id combination.
+func KeyAddressPoolIdLimitOrderId(addr sdk.AccAddress, poolId uint64, orderId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%x%s%d%s%d", UserLimitOrderPrefix, KeySeparator, addr.Bytes(), KeySeparator, poolId, KeySeparator, orderId))
+}
+
+// KeyUserLimitOrdersForPool returns the prefix key used to create KeyAddressPoolIdLimitOrderId, which only includes addr + pool id.
+// This key can be used to iterate over the limit orders of an address in a specific pool.
+func KeyUserLimitOrdersForPool(addr sdk.AccAddress, poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%x%s%d%s", UserLimitOrderPrefix, KeySeparator, addr.Bytes(), KeySeparator, poolId, KeySeparator))
+}
+
+// KeyUserLimitOrders returns the prefix key used to create KeyAddressPoolIdLimitOrderId, which only includes the addr.
+// This key can be used to iterate over all the limit orders of an address.
+func KeyUserLimitOrders(addr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s%s%x%s", UserLimitOrderPrefix, KeySeparator, addr.Bytes(), KeySeparator))
+}
+
+// KeyLimitOrderBooksForPool returns the prefix key of the limit order books of the given pool.
+// Iterating over it yields the books ordered by tick index.
+func KeyLimitOrderBooksForPool(poolId uint64) []byte {
+	key := make([]byte, 0, len(LimitOrderBookPrefix)+uint64ByteSize)
+	key = append(key, LimitOrderBookPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyLimitOrderBookGenerations returns the prefix key of all the generations of the limit order books
+// selling the given denom at the given tick, which are ordered by generation.
+func KeyLimitOrderBookGenerations(poolId uint64, tickIndex int64, denom string) []byte {
+	key := KeyLimitOrderBooksForPool(poolId)
+	key = append(key, TickIndexToBytes(tickIndex)...)
+	key = append(key, []byte(denom)...)
+	key = append(key, KeySeparator...)
+	return key
+}
+
+// KeyLimitOrderBook returns the key used to store the limit order book of the given generation
+// selling the given denom at the given tick.
+func KeyLimitOrderBook(poolId uint64, tickIndex int64, denom string, generation uint64) []byte {
+	return append(KeyLimitOrderBookGenerations(poolId, tickIndex, denom), sdk.Uint64ToBigEndian(generation)...)
+}
+
+// KeyLimitOrderEscrowsForPool returns the prefix key of the amounts escrowed for the limit orders of the given pool.
+func KeyLimitOrderEscrowsForPool(poolId uint64) []byte {
+	key := make([]byte, 0, len(LimitOrderEscrowPrefix)+uint64ByteSize)
+	key = append(key, LimitOrderEscrowPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyLimitOrderEscrow returns the key of the amount of the given denom escrowed for the limit orders of the given pool.
+func KeyLimitOrderEscrow(poolId uint64, denom string) []byte {
+	return append(KeyLimitOrderEscrowsForPool(poolId), []byte(denom)...)
+}
+
 // Helper Functions
 func GetPoolIdFromShareDenom(denom string) (uint64, error) {
 	if !strings.HasPrefix(denom, ConcentratedLiquidityTokenPrefix) {
@@ -294,3 +373,32 @@ func MustGetPoolIdFromShareDenom(denom string) uint64 {
 func GetDenomPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
+
+// KeyDynamicSpreadFactorRecord returns the key of the dynamic spread factor record of the given pool.
+func KeyDynamicSpreadFactorRecord(poolId uint64) []byte {
+	return append(DynamicSpreadFactorRecordPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyEffectiveSpreadFactor returns the key of the effective spread factor of the given pool.
+func KeyEffectiveSpreadFactor(poolId uint64) []byte {
+	return append(EffectiveSpreadFactorPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPositionAutoCompound returns the key of the auto-compound record of the given position.
+func KeyPositionAutoCompound(positionId uint64) []byte {
+	return append(PositionAutoCompoundPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyTickLiquiditySnapshotsForPool returns the prefix key of the tick liquidity snapshots of the given pool.
+// Iterating over it yields the snapshots ordered by time.
+func KeyTickLiquiditySnapshotsForPool(poolId uint64) []byte {
+	key := make([]byte, 0, len(TickLiquiditySnapshotPrefix)+uint64ByteSize)
+	key = append(key, TickLiquiditySnapshotPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyTickLiquiditySnapshot returns the key of the tick liquidity snapshot of the given pool taken at the given time.
+func KeyTickLiquiditySnapshot(poolId uint64, snapshotTime time.Time) []byte {
+	return append(KeyTickLiquiditySnapshotsForPool(poolId), sdk.FormatTimeBytes(snapshotTime)...)
+}
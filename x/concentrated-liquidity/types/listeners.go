@@ -13,6 +13,10 @@ type ConcentratedLiquidityListener interface {
 	AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
 	// AfterConcentratedPoolSwap is called after a swap in a concentrated liquidity pool.
 	AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// AfterLiquidityAdded is called after liquidity is added to a concentrated liquidity pool by creating a position.
+	AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins)
+	// AfterLiquidityRemoved is called after liquidity is removed from a concentrated liquidity pool by withdrawing a position.
+	AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins)
 }
 
 type ConcentratedLiquidityListeners []ConcentratedLiquidityListener
@@ -43,6 +47,18 @@ func (l ConcentratedLiquidityListeners) AfterConcentratedPoolSwap(ctx sdk.Contex
 	}
 }
 
+func (l ConcentratedLiquidityListeners) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins) {
+	for i := range l {
+		l[i].AfterLiquidityAdded(ctx, sender, poolId, tokensAdded)
+	}
+}
+
+func (l ConcentratedLiquidityListeners) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins) {
+	for i := range l {
+		l[i].AfterLiquidityRemoved(ctx, sender, poolId, tokensRemoved)
+	}
+}
+
 // Creates hooks for the x/concentrated-liquidity module.
 func NewConcentratedLiquidityListeners(listeners ...ConcentratedLiquidityListener) ConcentratedLiquidityListeners {
 	return listeners
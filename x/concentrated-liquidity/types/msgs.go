@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
 // constants.
@@ -15,6 +17,13 @@ const (
 	TypeMsgCollectSpreadRewards    = "collect-spread-rewards"
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
+	TypeMsgPlaceLimitOrder         = "place-limit-order"
+	TypeMsgCancelLimitOrder        = "cancel-limit-order"
+	TypeMsgClaimLimitOrder         = "claim-limit-order"
+	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
+	TypeMsgMergePositions          = "merge-positions"
+	TypeMsgSplitPosition           = "split-position"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -221,3 +230,229 @@ func (msg MsgFungifyChargedPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("Invalid pool id (%s)", strconv.FormatUint(msg.PoolId, 10))
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid limit order id (%s)", strconv.FormatUint(msg.OrderId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid limit order id (%s)", strconv.FormatUint(msg.OrderId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTransferPositions{}
+
+func (msg MsgTransferPositions) Route() string { return RouterKey }
+func (msg MsgTransferPositions) Type() string  { return TypeMsgTransferPositions }
+func (msg MsgTransferPositions) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return fmt.Errorf("Invalid new owner address (%s)", err)
+	}
+
+	if sender.Equals(newOwner) {
+		return fmt.Errorf("New owner must be different from the sender (%s)", msg.Sender)
+	}
+
+	if len(msg.PositionIds) == 0 {
+		return fmt.Errorf("Must provide at least 1 position")
+	}
+
+	if osmoutils.ContainsDuplicate(msg.PositionIds) {
+		return DuplicatePositionIdsError{PositionIds: msg.PositionIds}
+	}
+
+	return nil
+}
+
+func (msg MsgTransferPositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMergePositions{}
+
+func (msg MsgMergePositions) Route() string { return RouterKey }
+func (msg MsgMergePositions) Type() string  { return TypeMsgMergePositions }
+func (msg MsgMergePositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PositionIds) < 2 {
+		return PositionQuantityTooLowError{MinNumPositions: 2, NumPositions: len(msg.PositionIds)}
+	}
+
+	if osmoutils.ContainsDuplicate(msg.PositionIds) {
+		return DuplicatePositionIdsError{PositionIds: msg.PositionIds}
+	}
+
+	return nil
+}
+
+func (msg MsgMergePositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMergePositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitPosition{}
+
+func (msg MsgSplitPosition) Route() string { return RouterKey }
+func (msg MsgSplitPosition) Type() string  { return TypeMsgSplitPosition }
+func (msg MsgSplitPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.LiquidityAmounts) == 0 {
+		return fmt.Errorf("Must provide at least 1 liquidity amount")
+	}
+
+	for _, liquidity := range msg.LiquidityAmounts {
+		if liquidity.IsNil() || !liquidity.IsPositive() {
+			return NotPositiveRequireAmountError{Amount: liquidity.String()}
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -20,13 +20,14 @@ type extMsg interface {
 	Type() string
 }
 
-var addr1 string
+var addr1, addr2 string
 var invalidAddr sdk.AccAddress
 
 func init() {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 = sdk.AccAddress(pk1.Address()).String()
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
 	invalidAddr = sdk.AccAddress("invalid")
 }
 
@@ -275,6 +276,244 @@ func TestMsgFungifyChargedPositions(t *testing.T) {
 	}
 }
 
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgPlaceLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPlaceLimitOrder{
+				Sender:    addr1,
+				PoolId:    1,
+				TickIndex: 100,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgPlaceLimitOrder{
+				Sender:    invalidAddr.String(),
+				PoolId:    1,
+				TickIndex: 100,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero pool id",
+			msg: types.MsgPlaceLimitOrder{
+				Sender:    addr1,
+				TickIndex: 100,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: zero token in",
+			msg: types.MsgPlaceLimitOrder{
+				Sender:    addr1,
+				PoolId:    1,
+				TickIndex: 100,
+				TokenIn:   sdk.NewCoin("foo", sdk.ZeroInt()),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgPlaceLimitOrder)
+	}
+}
+
+func TestMsgCancelLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelLimitOrder
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgCancelLimitOrder{Sender: addr1, OrderId: 1},
+			expectPass: true,
+		},
+		{
+			name:       "error: invalid sender",
+			msg:        types.MsgCancelLimitOrder{Sender: invalidAddr.String(), OrderId: 1},
+			expectPass: false,
+		},
+		{
+			name:       "error: zero order id",
+			msg:        types.MsgCancelLimitOrder{Sender: addr1},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCancelLimitOrder)
+	}
+}
+
+func TestMsgClaimLimitOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgClaimLimitOrder
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgClaimLimitOrder{Sender: addr1, OrderId: 1},
+			expectPass: true,
+		},
+		{
+			name:       "error: invalid sender",
+			msg:        types.MsgClaimLimitOrder{Sender: invalidAddr.String(), OrderId: 1},
+			expectPass: false,
+		},
+		{
+			name:       "error: zero order id",
+			msg:        types.MsgClaimLimitOrder{Sender: addr1},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgClaimLimitOrder)
+	}
+}
+
+func TestMsgTransferPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferPositions
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgTransferPositions{Sender: addr1, NewOwner: addr2, PositionIds: []uint64{1, 2}},
+			expectPass: true,
+		},
+		{
+			name:       "error: invalid sender",
+			msg:        types.MsgTransferPositions{Sender: invalidAddr.String(), NewOwner: addr2, PositionIds: []uint64{1}},
+			expectPass: false,
+		},
+		{
+			name:       "error: invalid new owner",
+			msg:        types.MsgTransferPositions{Sender: addr1, NewOwner: invalidAddr.String(), PositionIds: []uint64{1}},
+			expectPass: false,
+		},
+		{
+			name:       "error: new owner is the sender",
+			msg:        types.MsgTransferPositions{Sender: addr1, NewOwner: addr1, PositionIds: []uint64{1}},
+			expectPass: false,
+		},
+		{
+			name:       "error: no position ids",
+			msg:        types.MsgTransferPositions{Sender: addr1, NewOwner: addr2},
+			expectPass: false,
+		},
+		{
+			name:       "error: duplicate position ids",
+			msg:        types.MsgTransferPositions{Sender: addr1, NewOwner: addr2, PositionIds: []uint64{1, 1}},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name:       "opt in",
+			msg:        types.MsgSetPositionAutoCompound{Sender: addr1, PositionId: 1, Enabled: true},
+			expectPass: true,
+		},
+		{
+			name:       "opt out",
+			msg:        types.MsgSetPositionAutoCompound{Sender: addr1, PositionId: 1, Enabled: false},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        types.MsgSetPositionAutoCompound{Sender: invalidAddr.String(), PositionId: 1, Enabled: true},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
+
+func TestMsgMergePositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgMergePositions
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgMergePositions{Sender: addr1, PositionIds: []uint64{1, 2}},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        types.MsgMergePositions{Sender: invalidAddr.String(), PositionIds: []uint64{1, 2}},
+			expectPass: false,
+		},
+		{
+			name:       "single position",
+			msg:        types.MsgMergePositions{Sender: addr1, PositionIds: []uint64{1}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate positions",
+			msg:        types.MsgMergePositions{Sender: addr1, PositionIds: []uint64{1, 2, 1}},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgMergePositions)
+	}
+}
+
+func TestMsgSplitPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitPosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgSplitPosition{Sender: addr1, PositionId: 1, LiquidityAmounts: []sdk.Dec{sdk.OneDec(), sdk.NewDec(2)}},
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        types.MsgSplitPosition{Sender: invalidAddr.String(), PositionId: 1, LiquidityAmounts: []sdk.Dec{sdk.OneDec()}},
+			expectPass: false,
+		},
+		{
+			name:       "no liquidity amounts",
+			msg:        types.MsgSplitPosition{Sender: addr1, PositionId: 1},
+			expectPass: false,
+		},
+		{
+			name:       "zero liquidity amount",
+			msg:        types.MsgSplitPosition{Sender: addr1, PositionId: 1, LiquidityAmounts: []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()}},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSplitPosition)
+	}
+}
+
 func TestMsgWithdrawPosition(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -348,6 +587,39 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgPlaceLimitOrder",
+			clMsg: &types.MsgPlaceLimitOrder{
+				Sender:    addr1,
+				PoolId:    defaultPoolId,
+				TickIndex: 100,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+		},
+		{
+			name:  "MsgCancelLimitOrder",
+			clMsg: &types.MsgCancelLimitOrder{Sender: addr1, OrderId: 1},
+		},
+		{
+			name:  "MsgClaimLimitOrder",
+			clMsg: &types.MsgClaimLimitOrder{Sender: addr1, OrderId: 1},
+		},
+		{
+			name:  "MsgTransferPositions",
+			clMsg: &types.MsgTransferPositions{Sender: addr1, NewOwner: addr2, PositionIds: []uint64{1, 2}},
+		},
+		{
+			name:  "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{Sender: addr1, PositionId: 1, Enabled: true},
+		},
+		{
+			name:  "MsgMergePositions",
+			clMsg: &types.MsgMergePositions{Sender: addr1, PositionIds: []uint64{1, 2}},
+		},
+		{
+			name:  "MsgSplitPosition",
+			clMsg: &types.MsgSplitPosition{Sender: addr1, PositionId: 1, LiquidityAmounts: []sdk.Dec{sdk.OneDec()}},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -16,6 +16,10 @@ var (
 	KeyAuthorizedQuoteDenoms              = []byte("AuthorizedQuoteDenoms")
 	KeyAuthorizedUptimes                  = []byte("AuthorizedUptimes")
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
+	KeyTickLiquiditySnapshotInterval      = []byte("TickLiquiditySnapshotInterval")
+	KeyTickLiquiditySnapshotKeepPeriod    = []byte("TickLiquiditySnapshotKeepPeriod")
+	KeyAutoCompoundSwapTwapWindow         = []byte("AutoCompoundSwapTwapWindow")
+	KeyMaxAutoCompoundSwapSlippage        = []byte("MaxAutoCompoundSwapSlippage")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -25,7 +29,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec, discountRate sdk.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec, discountRate sdk.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, tickLiquiditySnapshotInterval, tickLiquiditySnapshotKeepPeriod, autoCompoundSwapTwapWindow time.Duration, maxAutoCompoundSwapSlippage sdk.Dec) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -33,6 +37,10 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []sdk.Dec
 		BalancerSharesRewardDiscount:        discountRate,
 		AuthorizedUptimes:                   authorizedUptimes,
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
+		TickLiquiditySnapshotInterval:       tickLiquiditySnapshotInterval,
+		TickLiquiditySnapshotKeepPeriod:     tickLiquiditySnapshotKeepPeriod,
+		AutoCompoundSwapTwapWindow:          autoCompoundSwapTwapWindow,
+		MaxAutoCompoundSwapSlippage:         maxAutoCompoundSwapSlippage,
 	}
 }
 
@@ -50,6 +58,10 @@ func DefaultParams() Params {
 		BalancerSharesRewardDiscount:        DefaultBalancerSharesDiscount,
 		AuthorizedUptimes:                   DefaultAuthorizedUptimes,
 		IsPermissionlessPoolCreationEnabled: false,
+		TickLiquiditySnapshotInterval:       DefaultTickLiquiditySnapshotInterval,
+		TickLiquiditySnapshotKeepPeriod:     DefaultTickLiquiditySnapshotKeepPeriod,
+		AutoCompoundSwapTwapWindow:          DefaultAutoCompoundSwapTwapWindow,
+		MaxAutoCompoundSwapSlippage:         DefaultMaxAutoCompoundSwapSlippage,
 	}
 }
 
@@ -73,6 +85,18 @@ func (p Params) Validate() error {
 	if err := validateAuthorizedUptimes(p.AuthorizedUptimes); err != nil {
 		return err
 	}
+	if err := validateNonNegativeDuration(p.TickLiquiditySnapshotInterval); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration(p.TickLiquiditySnapshotKeepPeriod); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundSwapTwapWindow(p.AutoCompoundSwapTwapWindow); err != nil {
+		return err
+	}
+	if err := validateMaxAutoCompoundSwapSlippage(p.MaxAutoCompoundSwapSlippage); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -85,6 +109,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIsPermisionlessPoolCreationEnabled, &p.IsPermissionlessPoolCreationEnabled, validateIsPermissionLessPoolCreationEnabled),
 		paramtypes.NewParamSetPair(KeyDiscountRate, &p.BalancerSharesRewardDiscount, validateBalancerSharesDiscount),
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
+		paramtypes.NewParamSetPair(KeyTickLiquiditySnapshotInterval, &p.TickLiquiditySnapshotInterval, validateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyTickLiquiditySnapshotKeepPeriod, &p.TickLiquiditySnapshotKeepPeriod, validateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyAutoCompoundSwapTwapWindow, &p.AutoCompoundSwapTwapWindow, validateAutoCompoundSwapTwapWindow),
+		paramtypes.NewParamSetPair(KeyMaxAutoCompoundSwapSlippage, &p.MaxAutoCompoundSwapSlippage, validateMaxAutoCompoundSwapSlippage),
 	}
 }
 
@@ -233,3 +261,45 @@ func validateAuthorizedUptimes(i interface{}) error {
 
 	return nil
 }
+
+// validateNonNegativeDuration validates that the given parameter is a non-negative duration.
+func validateNonNegativeDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("duration (%s) cannot be negative", duration)
+	}
+
+	return nil
+}
+
+// validateAutoCompoundSwapTwapWindow validates that the given parameter is a positive duration.
+func validateAutoCompoundSwapTwapWindow(i interface{}) error {
+	window, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window <= 0 {
+		return fmt.Errorf("auto-compound swap twap window must be positive, got %s", window)
+	}
+
+	return nil
+}
+
+// validateMaxAutoCompoundSwapSlippage validates that the given parameter is a sdk.Dec in [0, 1).
+func validateMaxAutoCompoundSwapSlippage(i interface{}) error {
+	slippage, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if slippage.IsNil() || slippage.IsNegative() || slippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max auto-compound swap slippage must be in [0, 1), got %s", slippage)
+	}
+
+	return nil
+}
@@ -58,6 +58,22 @@ type Params struct {
 	// allowing permissionless pool creation by switching this flag to true
 	// with a governance proposal.
 	IsPermissionlessPoolCreationEnabled bool `protobuf:"varint,6,opt,name=is_permissionless_pool_creation_enabled,json=isPermissionlessPoolCreationEnabled,proto3" json:"is_permissionless_pool_creation_enabled,omitempty" yaml:"is_permissionless_pool_creation_enabled"`
+	// tick_liquidity_snapshot_interval is the minimum time between two
+	// snapshots of the tick liquidity distribution of every pool. Snapshots are
+	// disabled if it is zero.
+	TickLiquiditySnapshotInterval time.Duration `protobuf:"bytes,7,opt,name=tick_liquidity_snapshot_interval,json=tickLiquiditySnapshotInterval,proto3,stdduration" json:"tick_liquidity_snapshot_interval" yaml:"tick_liquidity_snapshot_interval"`
+	// tick_liquidity_snapshot_keep_period is how long tick liquidity snapshots
+	// are kept for. Snapshots older than this period are pruned when a new
+	// snapshot is taken.
+	TickLiquiditySnapshotKeepPeriod time.Duration `protobuf:"bytes,8,opt,name=tick_liquidity_snapshot_keep_period,json=tickLiquiditySnapshotKeepPeriod,proto3,stdduration" json:"tick_liquidity_snapshot_keep_period" yaml:"tick_liquidity_snapshot_keep_period"`
+	// auto_compound_swap_twap_window is the window of the TWAP that the swaps
+	// of rewards to the ratio of auto-compounded positions are priced with.
+	AutoCompoundSwapTwapWindow time.Duration `protobuf:"bytes,9,opt,name=auto_compound_swap_twap_window,json=autoCompoundSwapTwapWindow,proto3,stdduration" json:"auto_compound_swap_twap_window" yaml:"auto_compound_swap_twap_window"`
+	// max_auto_compound_swap_slippage is the max slippage of the swaps of
+	// rewards to the ratio of auto-compounded positions, relative to the TWAP
+	// over auto_compound_swap_twap_window. It bounds the value that can be
+	// extracted from these swaps by moving the price of the pool around them.
+	MaxAutoCompoundSwapSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_auto_compound_swap_slippage,json=maxAutoCompoundSwapSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_auto_compound_swap_slippage" yaml:"max_auto_compound_swap_slippage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,6 +137,27 @@ func (m *Params) GetIsPermissionlessPoolCreationEnabled() bool {
 	return false
 }
 
+func (m *Params) GetTickLiquiditySnapshotInterval() time.Duration {
+	if m != nil {
+		return m.TickLiquiditySnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetTickLiquiditySnapshotKeepPeriod() time.Duration {
+	if m != nil {
+		return m.TickLiquiditySnapshotKeepPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundSwapTwapWindow() time.Duration {
+	if m != nil {
+		return m.AutoCompoundSwapTwapWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -130,42 +167,53 @@ func init() {
 }
 
 var fileDescriptor_cd3784445b6f6ba7 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x52, 0x22, 0x6a, 0x26, 0x2c, 0x10, 0x4e, 0x05, 0xb6, 0x65, 0xa4, 0x12, 0x09,
-	0x6a, 0x8b, 0x32, 0x20, 0xc1, 0x66, 0x02, 0x73, 0x71, 0x40, 0x42, 0x15, 0xd2, 0xe9, 0x7c, 0xbe,
-	0x3a, 0xa7, 0xd8, 0x7e, 0xee, 0xdd, 0x19, 0x08, 0x3b, 0x88, 0x09, 0x31, 0xb2, 0xf1, 0x75, 0x3a,
-	0x76, 0x44, 0x0c, 0x06, 0x25, 0x1b, 0x63, 0x3e, 0x01, 0xca, 0xd9, 0xa1, 0x0e, 0xa5, 0x02, 0x26,
-	0xfb, 0xbd, 0xff, 0xef, 0xbd, 0x7b, 0xf7, 0xde, 0x3b, 0xfd, 0x16, 0x88, 0x0c, 0x04, 0x13, 0x3e,
-	0x81, 0x9c, 0xd0, 0x5c, 0x72, 0x2c, 0x69, 0xbc, 0x93, 0xb2, 0xc3, 0x92, 0xc5, 0x4c, 0x4e, 0xfd,
-	0x02, 0x73, 0x9c, 0x09, 0xaf, 0xe0, 0x20, 0xc1, 0xb8, 0xde, 0xc0, 0x5e, 0x1b, 0xfe, 0xc5, 0x6e,
-	0x5d, 0x4e, 0x20, 0x01, 0x45, 0xfa, 0xcb, 0xbf, 0x3a, 0x68, 0xab, 0x4f, 0x54, 0x14, 0xaa, 0x85,
-	0xda, 0x68, 0x24, 0x2b, 0x01, 0x48, 0x52, 0xea, 0x2b, 0x2b, 0x2a, 0x0f, 0xfc, 0xb8, 0xe4, 0x58,
-	0x32, 0xc8, 0x6b, 0xdd, 0x7d, 0xd7, 0xd3, 0x7b, 0x7b, 0xaa, 0x00, 0x63, 0x5f, 0xbf, 0x8a, 0x4b,
-	0x39, 0x06, 0xce, 0xde, 0xd0, 0x18, 0x49, 0x46, 0x26, 0x48, 0x14, 0x98, 0xb0, 0x3c, 0x31, 0x35,
-	0xa7, 0x3b, 0xd8, 0x08, 0xdc, 0x45, 0x65, 0x5b, 0x53, 0x9c, 0xa5, 0xf7, 0xdd, 0x33, 0x40, 0x37,
-	0xbc, 0x72, 0xa2, 0x3c, 0x65, 0x64, 0x32, 0xaa, 0xfd, 0xc6, 0x07, 0x4d, 0xef, 0xb7, 0x62, 0x44,
-	0xc1, 0x29, 0x8e, 0xd1, 0x01, 0x26, 0x12, 0xb8, 0x30, 0xcf, 0x39, 0xdd, 0xc1, 0x66, 0x10, 0x1e,
-	0x55, 0x76, 0xe7, 0x6b, 0x65, 0x6f, 0x27, 0x4c, 0x8e, 0xcb, 0xc8, 0x23, 0x90, 0x35, 0x77, 0x69,
-	0x3e, 0x3b, 0x22, 0x9e, 0xf8, 0x72, 0x5a, 0x50, 0xe1, 0x0d, 0x29, 0x59, 0x54, 0xb6, 0x73, 0xaa,
-	0x98, 0xf5, 0xc4, 0x6e, 0xd8, 0xba, 0xd1, 0x48, 0x49, 0x8f, 0x6b, 0xc5, 0xf8, 0xac, 0xe9, 0x76,
-	0x84, 0x53, 0x9c, 0x13, 0xca, 0x91, 0x18, 0x63, 0x4e, 0x05, 0xe2, 0xf4, 0x15, 0xe6, 0x31, 0x8a,
-	0x99, 0x20, 0x50, 0xe6, 0xd2, 0xec, 0x3a, 0xda, 0x60, 0x33, 0x78, 0xfe, 0xdf, 0x65, 0x6d, 0xd7,
-	0x65, 0xfd, 0x25, 0xbd, 0x1b, 0x5e, 0x5b, 0x11, 0x23, 0x05, 0x84, 0x4a, 0x1f, 0x36, 0xf2, 0x6f,
-	0xe3, 0x38, 0x2c, 0x41, 0x52, 0x14, 0xd3, 0x1c, 0x32, 0x61, 0x6e, 0xa8, 0x7e, 0xfd, 0x79, 0x1c,
-	0x6d, 0x70, 0x6d, 0x1c, 0x4f, 0x96, 0xc2, 0x50, 0xf9, 0x8d, 0xb7, 0x9a, 0x6e, 0xb4, 0x62, 0xca,
-	0x42, 0xb2, 0x8c, 0x0a, 0xf3, 0xbc, 0xd3, 0x1d, 0x5c, 0xdc, 0xed, 0x7b, 0xf5, 0xce, 0x78, 0xab,
-	0x9d, 0xf1, 0x86, 0xcd, 0xce, 0x04, 0x0f, 0x96, 0xbd, 0xf8, 0x51, 0xd9, 0xc6, 0x6a, 0x8b, 0x6e,
-	0x43, 0xc6, 0x24, 0xcd, 0x0a, 0x39, 0x5d, 0x54, 0x76, 0xff, 0x54, 0x31, 0x4d, 0x62, 0xf7, 0xd3,
-	0x37, 0x5b, 0x0b, 0x2f, 0x9d, 0x08, 0xcf, 0x6a, 0xbf, 0xf1, 0x5e, 0xd3, 0x6f, 0x32, 0x81, 0x0a,
-	0xca, 0x33, 0x26, 0x04, 0x83, 0x3c, 0xa5, 0x42, 0xa0, 0x02, 0x20, 0x45, 0x84, 0x53, 0x75, 0x02,
-	0xa2, 0x39, 0x8e, 0x52, 0x1a, 0x9b, 0x3d, 0x47, 0x1b, 0x5c, 0x08, 0x76, 0x17, 0x95, 0xed, 0xd5,
-	0xe7, 0xfc, 0x63, 0xa0, 0x1b, 0xde, 0x60, 0x62, 0x6f, 0x0d, 0xdc, 0x03, 0x48, 0x1f, 0x36, 0xd8,
-	0xa3, 0x9a, 0x0a, 0x5e, 0x1c, 0xcd, 0x2c, 0xed, 0x78, 0x66, 0x69, 0xdf, 0x67, 0x96, 0xf6, 0x71,
-	0x6e, 0x75, 0x8e, 0xe7, 0x56, 0xe7, 0xcb, 0xdc, 0xea, 0xec, 0x07, 0xad, 0xc1, 0x37, 0xaf, 0x73,
-	0x27, 0xc5, 0x91, 0x58, 0x19, 0xfe, 0xcb, 0x3b, 0xf7, 0xfc, 0xd7, 0x67, 0xbd, 0x6e, 0xb5, 0x18,
-	0x51, 0x4f, 0xf5, 0xf2, 0xee, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x1a, 0x55, 0xb5, 0x0c,
-	0x04, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0xe3, 0x6f, 0xfa, 0x2d, 0xad, 0x99, 0xb0, 0x40, 0x38, 0x85, 0xda, 0x96, 0x2b, 0xda,
+	0x08, 0xa8, 0xad, 0xb6, 0x12, 0x48, 0x30, 0x91, 0x06, 0x24, 0x04, 0x43, 0x70, 0x8a, 0x40, 0x15,
+	0xd2, 0xe9, 0x62, 0x5f, 0x93, 0x53, 0x6c, 0xdf, 0xd5, 0x77, 0x6e, 0x1a, 0x76, 0x24, 0x26, 0xd4,
+	0x0d, 0x26, 0xf8, 0x77, 0x3a, 0x76, 0x44, 0x0c, 0x06, 0xb5, 0x1b, 0x63, 0x06, 0x66, 0x64, 0xdf,
+	0xa5, 0x4d, 0x7f, 0x06, 0xc4, 0x92, 0xf8, 0xee, 0xf9, 0xbc, 0x8f, 0x1f, 0xbf, 0xf7, 0x5a, 0x56,
+	0xef, 0x10, 0x16, 0x11, 0x86, 0x99, 0xeb, 0x93, 0xd8, 0x47, 0x31, 0x4f, 0x20, 0x47, 0xc1, 0x62,
+	0x88, 0x37, 0x53, 0x1c, 0x60, 0xde, 0x77, 0x29, 0x4c, 0x60, 0xc4, 0x1c, 0x9a, 0x10, 0x4e, 0xb4,
+	0x59, 0x09, 0x3b, 0xa3, 0xf0, 0x21, 0x3b, 0x73, 0xb5, 0x4d, 0xda, 0xa4, 0x20, 0xdd, 0xfc, 0x4a,
+	0x14, 0xcd, 0x54, 0xfc, 0xa2, 0x0a, 0x08, 0x41, 0x2c, 0xa4, 0x64, 0xb4, 0x09, 0x69, 0x87, 0xc8,
+	0x2d, 0x56, 0xad, 0x74, 0xc3, 0x0d, 0xd2, 0x04, 0x72, 0x4c, 0x62, 0xa1, 0xdb, 0xbf, 0x54, 0x75,
+	0xb2, 0x51, 0x04, 0xd0, 0xd6, 0xd5, 0xeb, 0x30, 0xe5, 0x1d, 0x92, 0xe0, 0xb7, 0x28, 0x00, 0x1c,
+	0xfb, 0x5d, 0xc0, 0x28, 0xf4, 0x71, 0xdc, 0xd6, 0x15, 0xab, 0x5c, 0x9d, 0xa8, 0xd9, 0x83, 0xcc,
+	0x34, 0xfa, 0x30, 0x0a, 0x1f, 0xd8, 0xe7, 0x80, 0xb6, 0x77, 0xed, 0x48, 0x59, 0xc3, 0x7e, 0xb7,
+	0x29, 0xf6, 0xb5, 0x0f, 0x8a, 0x5a, 0x19, 0xa9, 0x61, 0x34, 0x41, 0x30, 0x00, 0x1b, 0xd0, 0xe7,
+	0x24, 0x61, 0xfa, 0x7f, 0x56, 0xb9, 0x3a, 0x5d, 0xf3, 0x76, 0x33, 0xb3, 0xf4, 0x2d, 0x33, 0xe7,
+	0xdb, 0x98, 0x77, 0xd2, 0x96, 0xe3, 0x93, 0x48, 0x3e, 0x8b, 0xfc, 0x5b, 0x64, 0x41, 0xd7, 0xe5,
+	0x7d, 0x8a, 0x98, 0x53, 0x47, 0xfe, 0x20, 0x33, 0xad, 0x53, 0x61, 0x8e, 0x1b, 0xdb, 0xde, 0xc8,
+	0x13, 0x35, 0x0b, 0xe9, 0x89, 0x50, 0xb4, 0x2f, 0x8a, 0x6a, 0xb6, 0x60, 0x08, 0x63, 0x1f, 0x25,
+	0x80, 0x75, 0x60, 0x82, 0x18, 0x48, 0x50, 0x0f, 0x26, 0x01, 0x08, 0x30, 0xf3, 0x49, 0x1a, 0x73,
+	0xbd, 0x6c, 0x29, 0xd5, 0xe9, 0xda, 0xeb, 0xbf, 0x8e, 0x35, 0x2f, 0x62, 0x8d, 0xb1, 0xb7, 0xbd,
+	0x9b, 0x43, 0xa2, 0x59, 0x00, 0x5e, 0xa1, 0xd7, 0xa5, 0x7c, 0xe2, 0x38, 0x36, 0x53, 0xc2, 0x11,
+	0x08, 0x50, 0x4c, 0x22, 0xa6, 0x4f, 0x14, 0xfd, 0x3a, 0xfb, 0x38, 0x46, 0xc1, 0x63, 0xc7, 0xf1,
+	0x22, 0x17, 0xea, 0xc5, 0xbe, 0xf6, 0x4e, 0x51, 0xb5, 0x91, 0x9a, 0x94, 0x72, 0x1c, 0x21, 0xa6,
+	0xff, 0x6f, 0x95, 0xab, 0x97, 0x97, 0x2b, 0x8e, 0x98, 0x19, 0x67, 0x38, 0x33, 0x4e, 0x5d, 0xce,
+	0x4c, 0xed, 0x61, 0xde, 0x8b, 0x9f, 0x99, 0xa9, 0x0d, 0xa7, 0xe8, 0x2e, 0x89, 0x30, 0x47, 0x11,
+	0xe5, 0xfd, 0x41, 0x66, 0x56, 0x4e, 0x85, 0x91, 0xc6, 0xf6, 0xa7, 0xef, 0xa6, 0xe2, 0x5d, 0x39,
+	0x12, 0x5e, 0x8a, 0x7d, 0xed, 0xbd, 0xa2, 0x2e, 0x60, 0x06, 0x28, 0x4a, 0x22, 0xcc, 0x18, 0x26,
+	0x71, 0x88, 0x18, 0x03, 0x94, 0x90, 0x10, 0xf8, 0x09, 0x2a, 0xee, 0x00, 0x50, 0x0c, 0x5b, 0x21,
+	0x0a, 0xf4, 0x49, 0x4b, 0xa9, 0x4e, 0xd5, 0x96, 0x07, 0x99, 0xe9, 0x88, 0xfb, 0xfc, 0x61, 0xa1,
+	0xed, 0xcd, 0x61, 0xd6, 0x38, 0x06, 0x36, 0x08, 0x09, 0x57, 0x25, 0xf6, 0x58, 0x50, 0xda, 0x47,
+	0x45, 0xb5, 0x8a, 0x51, 0x3e, 0x7c, 0xd9, 0x00, 0x8b, 0x21, 0x65, 0x1d, 0xc2, 0x01, 0x8e, 0x39,
+	0x4a, 0xb6, 0x60, 0xa8, 0x5f, 0xb2, 0x94, 0x8b, 0x1b, 0xb4, 0x92, 0x37, 0x68, 0x90, 0x99, 0x0b,
+	0x22, 0xe2, 0x38, 0x43, 0xd1, 0x98, 0xd9, 0x1c, 0x7b, 0x3e, 0xa4, 0x9a, 0x12, 0x7a, 0x2a, 0x99,
+	0x7c, 0x54, 0xe7, 0xce, 0x33, 0xea, 0x22, 0x44, 0xf3, 0x2e, 0x60, 0x12, 0xe8, 0x53, 0xe3, 0xc2,
+	0xdd, 0x93, 0xe1, 0x6e, 0x5f, 0x1c, 0x6e, 0xc4, 0x53, 0xe4, 0x33, 0xcf, 0xcc, 0xf7, 0x0c, 0x21,
+	0xda, 0x28, 0x28, 0x6d, 0x47, 0x51, 0x0d, 0x98, 0x72, 0x02, 0x7c, 0x12, 0x51, 0x92, 0xc6, 0x01,
+	0x60, 0x3d, 0x48, 0x01, 0xcf, 0x7f, 0x7a, 0x38, 0x0e, 0x48, 0x4f, 0x9f, 0x1e, 0x17, 0x6e, 0x49,
+	0x86, 0xbb, 0x75, 0x38, 0x44, 0x17, 0xd8, 0x89, 0x5c, 0x33, 0x39, 0xb4, 0x2a, 0x99, 0x66, 0x0f,
+	0xd2, 0xb5, 0x1e, 0xa4, 0xaf, 0x0a, 0x40, 0xfb, 0xac, 0xa8, 0x66, 0x04, 0xb7, 0xc1, 0x19, 0x3e,
+	0x2c, 0xc4, 0x94, 0xc2, 0x36, 0xd2, 0xd5, 0x7f, 0x7b, 0xbf, 0xc7, 0xd8, 0xdb, 0xde, 0x8d, 0x08,
+	0x6e, 0x3f, 0x3a, 0x11, 0xb1, 0x29, 0xd5, 0xda, 0x9b, 0xdd, 0x7d, 0x43, 0xd9, 0xdb, 0x37, 0x94,
+	0x1f, 0xfb, 0x86, 0xb2, 0x73, 0x60, 0x94, 0xf6, 0x0e, 0x8c, 0xd2, 0xd7, 0x03, 0xa3, 0xb4, 0x5e,
+	0x1b, 0x09, 0x22, 0xbf, 0x06, 0x8b, 0x21, 0x6c, 0xb1, 0xe1, 0xc2, 0xdd, 0x5a, 0xba, 0xef, 0x6e,
+	0x9f, 0xf7, 0x35, 0x29, 0x82, 0xb6, 0x26, 0x8b, 0x06, 0xaf, 0xfc, 0x1e, 0x00, 0xa2, 0x55, 0x26,
+	0x5c, 0x7c, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -188,6 +236,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxAutoCompoundSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxAutoCompoundSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AutoCompoundSwapTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundSwapTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TickLiquiditySnapshotKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TickLiquiditySnapshotKeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TickLiquiditySnapshotInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TickLiquiditySnapshotInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
 	if m.IsPermissionlessPoolCreationEnabled {
 		i--
 		if m.IsPermissionlessPoolCreationEnabled {
@@ -244,20 +326,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.AuthorizedTickSpacing) > 0 {
-		dAtA2 := make([]byte, len(m.AuthorizedTickSpacing)*10)
-		var j1 int
+		dAtA5 := make([]byte, len(m.AuthorizedTickSpacing)*10)
+		var j4 int
 		for _, num := range m.AuthorizedTickSpacing {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j4++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintParams(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -311,6 +393,14 @@ func (m *Params) Size() (n int) {
 	if m.IsPermissionlessPoolCreationEnabled {
 		n += 2
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TickLiquiditySnapshotInterval)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TickLiquiditySnapshotKeepPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundSwapTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxAutoCompoundSwapSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -581,6 +671,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsPermissionlessPoolCreationEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquiditySnapshotInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TickLiquiditySnapshotInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquiditySnapshotKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TickLiquiditySnapshotKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSwapTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AutoCompoundSwapTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAutoCompoundSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -303,6 +303,27 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewMigrateSharesToConcentratedPositionCmd(t *testing.T) {
+	desc, _ := cli.NewMigrateSharesToConcentratedPositionCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMigrateSharesToConcentratedPosition]{
+		"migrate locked shares": {
+			Cmd: "100gamm/pool/1 2 [-108000000] 342000000 10 20 --lock-id=3 --min-amounts-out=5stake --min-amounts-out=5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMigrateSharesToConcentratedPosition{
+				Sender:          testAddresses[0].String(),
+				LockId:          3,
+				SharesToMigrate: sdk.NewInt64Coin("gamm/pool/1", 100),
+				PoolIdEntering:  2,
+				LowerTick:       -108000000,
+				UpperTick:       342000000,
+				TokenOutMins:    sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("uosmo", 5)),
+				TokenMinAmount0: sdk.NewInt(10),
+				TokenMinAmount1: sdk.NewInt(20),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
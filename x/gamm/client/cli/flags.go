@@ -42,6 +42,9 @@ const (
 	FlagScalingFactors = "scaling-factors"
 
 	FlagMigrationRecords = "migration-records"
+
+	// Will be parsed to uint64.
+	FlagLockId = "lock-id"
 )
 
 type createBalancerPoolInputs struct {
@@ -120,5 +123,6 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 func FlagSetMigratePosition() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum tokens out")
+	fs.Uint64(FlagLockId, 0, "The id of the lock holding the shares to migrate, if they are locked")
 	return fs
 }
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetConcentratedPoolIdLinkFromCFMMRequest)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCFMMConcentratedPoolLinksRequest)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdStableswapAmplification)
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
@@ -361,3 +362,12 @@ func GetCFMMConcentratedPoolLinksRequest() (*osmocli.QueryDescriptor, *types.Que
 {{.CommandPrefix}} cfmm-cl-pool-links`,
 	}, &types.QueryCFMMConcentratedPoolLinksRequest{}
 }
+
+func GetCmdStableswapAmplification() (*osmocli.QueryDescriptor, *types.QueryStableswapAmplificationRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "stableswap-amplification [pool-id] [time]",
+		Short: "Query the amplification of a stableswap pool at the given time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} stableswap-amplification 1 1690000000`,
+	}, &types.QueryStableswapAmplificationRequest{}
+}
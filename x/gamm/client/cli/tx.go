@@ -34,6 +34,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewStableSwapRampAmplificationCmd)
+	osmocli.AddTxCmd(txCmd, NewMigrateSharesToConcentratedPositionCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
@@ -182,6 +184,31 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampAmplificationCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapRampAmplification) {
+	return &osmocli.TxCliDesc{
+		Use:     "ramp-amplification [pool-id] [future-amplification] [future-amplification-time]",
+		Short:   "linearly ramp the amplification of a stableswap pool until the given time",
+		Example: "osmosisd tx gamm ramp-amplification 1 10 1690000000 --from val --chain-id osmosis-1",
+	}, &stableswap.MsgStableSwapRampAmplification{}
+}
+
+func NewMigrateSharesToConcentratedPositionCmd() (*osmocli.TxCliDesc, *types.MsgMigrateSharesToConcentratedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:   "migrate-shares-to-concentrated-position [shares-to-migrate] [pool-id-entering] [lower-tick] [upper-tick] [token-min-amount0] [token-min-amount1]",
+		Short: "exit balancer pool shares and create a position in the given range of a concentrated pool with the same denoms",
+		Long: `Exit balancer pool shares and create a position in the given range of a concentrated pool with the same denoms.
+If the shares are locked, the lock id must be provided with --lock-id, and the range must be full range.`,
+		Example: "osmosisd tx gamm migrate-shares-to-concentrated-position 100gamm/pool/1 2 [-1000] 1000 0 0 --min-amounts-out=1uosmo,1uatom --from val --chain-id osmosis-1",
+		CustomFlagOverrides: map[string]string{
+			"LockId": FlagLockId,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenOutMins": osmocli.FlagOnlyParser(minAmountsOutParser),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetMigratePosition()}},
+	}, &types.MsgMigrateSharesToConcentratedPosition{}
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/types"
@@ -21,6 +23,10 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) RampStableSwapAmplification(ctx sdk.Context, poolId uint64, futureAmplification sdk.Dec, futureAmplificationTime time.Time, sender string) error {
+	return k.rampStableSwapAmplification(ctx, poolId, futureAmplification, futureAmplificationTime, sender)
+}
+
 func AsCFMMPool(pool poolmanagertypes.PoolI) (types.CFMMPoolI, error) {
 	return asCFMMPool(pool)
 }
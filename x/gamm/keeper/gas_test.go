@@ -80,8 +80,9 @@ func (s *KeeperTestSuite) TestJoinPoolGas() {
 
 	avgGas, maxGas := s.measureAvgAndMaxJoinPoolGas(totalNumJoins, defaultAddr, poolIDFn, minShareOutAmountFn, maxCoinsFn)
 	fmt.Printf("test deets: total %d of pools joined, begin average at %d\n", totalNumJoins, startAveragingAt)
-	s.Assert().LessOrEqual(int(avgGas), 101000, "average gas / join pool")
-	s.Assert().LessOrEqual(int(maxGas), 101000, "max gas / join pool")
+	// Joins read the poolmanager pool hook contracts param when calling the pool hooks.
+	s.Assert().LessOrEqual(int(avgGas), 102000, "average gas / join pool")
+	s.Assert().LessOrEqual(int(maxGas), 102000, "max gas / join pool")
 }
 
 func (s *KeeperTestSuite) TestRepeatedJoinPoolDistinctDenom() {
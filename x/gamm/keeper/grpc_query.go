@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v17/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/v2types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
@@ -518,3 +519,31 @@ func (q Querier) CFMMConcentratedPoolLinks(ctx context.Context, req *types.Query
 		MigrationRecords: &poolLinks,
 	}, nil
 }
+
+// StableswapAmplification returns the amplification of the given stableswap pool at the given time,
+// or at the current block time if no time is given.
+func (q Querier) StableswapAmplification(ctx context.Context, req *types.QueryStableswapAmplificationRequest) (*types.QueryStableswapAmplificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, types.ErrNotStableSwapPool.Error())
+	}
+
+	t := req.Time
+	if t.IsZero() {
+		t = sdkCtx.BlockTime()
+	}
+
+	return &types.QueryStableswapAmplificationResponse{
+		Amplification: stableswapPool.GetAmplification(t),
+	}, nil
+}
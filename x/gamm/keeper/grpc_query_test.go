@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"errors"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -907,6 +908,78 @@ func (s *KeeperTestSuite) TestQueryStableswapPoolSpotPrice() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStableswapAmplification() {
+	queryClient := s.queryClient
+	balancerPoolId := s.PrepareBalancerPool()
+	// only two-asset stableswap pools can be amplified
+	stableswapPoolId := s.prepareCustomStableswapPool(
+		defaultAcctFunds,
+		stableswap.PoolParams{
+			SwapFee: defaultSpreadFactor,
+			ExitFee: defaultZeroExitFee,
+		},
+		sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2))),
+		[]uint64{1, 1},
+	)
+
+	// ramp the amplification of the stableswap pool from 1 to 10 over two days
+	startTime := s.Ctx.BlockTime()
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, stableswapPoolId)
+	s.Require().NoError(err)
+	stableswapPool := pool.(*stableswap.Pool)
+	stableswapPool.ScalingFactorController = s.TestAccs[0].String()
+	s.Require().NoError(s.App.GAMMKeeper.SetPool(s.Ctx, stableswapPool))
+	err = s.App.GAMMKeeper.RampStableSwapAmplification(s.Ctx, stableswapPoolId, sdk.NewDec(10), startTime.Add(48*time.Hour), s.TestAccs[0].String())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name                  string
+		req                   *types.QueryStableswapAmplificationRequest
+		expectErr             bool
+		expectedAmplification sdk.Dec
+	}{
+		{
+			name:      "non-existent pool",
+			req:       &types.QueryStableswapAmplificationRequest{PoolId: 0},
+			expectErr: true,
+		},
+		{
+			name:      "not a stableswap pool",
+			req:       &types.QueryStableswapAmplificationRequest{PoolId: balancerPoolId},
+			expectErr: true,
+		},
+		{
+			name:                  "no time is the block time",
+			req:                   &types.QueryStableswapAmplificationRequest{PoolId: stableswapPoolId},
+			expectedAmplification: sdk.OneDec(),
+		},
+		{
+			name:                  "halfway through the ramp",
+			req:                   &types.QueryStableswapAmplificationRequest{PoolId: stableswapPoolId, Time: startTime.Add(24 * time.Hour)},
+			expectedAmplification: sdk.MustNewDecFromStr("5.5"),
+		},
+		{
+			name:                  "after the ramp",
+			req:                   &types.QueryStableswapAmplificationRequest{PoolId: stableswapPoolId, Time: startTime.Add(72 * time.Hour)},
+			expectedAmplification: sdk.NewDec(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			result, err := queryClient.StableswapAmplification(gocontext.Background(), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err, "expected error")
+			} else {
+				s.Require().NoError(err, "unexpected error")
+				s.Require().Equal(tc.expectedAmplification, result.Amplification)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestV2QueryStableswapPoolSpotPrice() {
 	v2queryClient := v2types.NewQueryClient(s.QueryHelper)
 	poolIDEven := s.PrepareBasicStableswapPool()
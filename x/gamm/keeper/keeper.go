@@ -34,11 +34,12 @@ type Keeper struct {
 	communityPoolKeeper         types.CommunityPoolKeeper
 	poolManager                 types.PoolManager
 	concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
+	lockupKeeper                types.LockupKeeper
 	poolIncentivesKeeper        types.PoolIncentivesKeeper
 	incentivesKeeper            types.IncentivesKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, lockupKeeper types.LockupKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper) Keeper {
 	// Ensure that the module account are set.
 	moduleAddr, perms := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
 	if moduleAddr == nil {
@@ -62,6 +63,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		bankKeeper:                  bankKeeper,
 		communityPoolKeeper:         communityPoolKeeper,
 		concentratedLiquidityKeeper: concentratedLiquidityKeeper,
+		lockupKeeper:                lockupKeeper,
 		poolIncentivesKeeper:        poolIncentivesKeeper,
 		incentivesKeeper:            incentivesKeeper,
 	}
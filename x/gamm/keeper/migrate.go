@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v17/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v17/x/gamm/types/migration"
+	lockuptypes "github.com/osmosis-labs/osmosis/v17/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -56,6 +60,148 @@ func (k Keeper) MigrateUnlockedPositionFromBalancerToConcentrated(ctx sdk.Contex
 	return positionId, amount0, amount1, liquidity, poolIdLeaving, poolIdEntering, nil
 }
 
+// MigrateSharesToConcentratedPosition migrates the given balancer pool shares of the sender to a concentrated liquidity
+// position in the [lowerTick, upperTick) range of the given concentrated pool. Unlike MigrateUnlockedPositionFromBalancerToConcentrated,
+// the concentrated pool does not need to be linked to the balancer pool, it only needs to have the same denoms.
+// The shares are exited with tokenOutMins, and the exited coins are used to create the position with tokenMinAmount0 and tokenMinAmount1.
+// Any exited coins that are not needed for the position stay in the sender's balance.
+//
+// If lockId is non-zero, the shares are migrated from the given lock of the sender, which may be partially migrated.
+// Since only full range positions can be locked, locked shares can only be migrated to a full range position,
+// which is locked for the remaining duration of the lock, and keeps unlocking if the lock was unlocking.
+// Superfluid delegated or undelegating locks must instead be migrated through the superfluid module.
+func (k Keeper) MigrateSharesToConcentratedPosition(ctx sdk.Context,
+	sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin,
+	poolIdEntering uint64, lowerTick, upperTick int64,
+	tokenOutMins sdk.Coins, tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, concentratedLockId uint64, err error) {
+	// Get the balancer poolId by parsing the gamm share denom.
+	poolIdLeaving, err := types.GetPoolIdFromShareDenom(sharesToMigrate.Denom)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+	if err := k.validateMigrationPools(ctx, poolIdLeaving, poolIdEntering); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	if lockId == 0 {
+		exitCoins, err := k.exitPoolForMigration(ctx, sender, poolIdLeaving, sharesToMigrate, tokenOutMins)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+		}
+
+		positionId, amount0, amount1, liquidity, _, _, err = k.concentratedLiquidityKeeper.CreatePosition(ctx, poolIdEntering, sender, exitCoins, tokenMinAmount0, tokenMinAmount1, lowerTick, upperTick)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+		}
+		return positionId, amount0, amount1, liquidity, 0, nil
+	}
+
+	if lowerTick != cltypes.MinInitializedTick || upperTick != cltypes.MaxTick {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, types.LockedMigrationNotFullRangeError{LockId: lockId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	lock, err := k.validateMigrationLock(ctx, sender, lockId, sharesToMigrate.Denom)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	// Before the lock is broken, note the time remaining on it.
+	remainingLockDuration := lock.Duration
+	if lock.IsUnlocking() {
+		remainingLockDuration = lock.EndTime.Sub(ctx.BlockTime())
+		if remainingLockDuration <= 0 {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, types.MaturedLockMigrationError{LockId: lockId, EndTime: lock.EndTime}
+		}
+	}
+
+	// Unlock the shares to migrate, the remaining shares stay in the original lock.
+	if err := k.lockupKeeper.PartialForceUnlock(ctx, *lock, sdk.NewCoins(sharesToMigrate)); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	exitCoins, err := k.exitPoolForMigration(ctx, sender, poolIdLeaving, sharesToMigrate, tokenOutMins)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	// Create a full range position locked for the remaining duration of the lock, which keeps unlocking if the lock was unlocking.
+	if lock.IsUnlocking() {
+		positionId, amount0, amount1, liquidity, concentratedLockId, err = k.concentratedLiquidityKeeper.CreateFullRangePositionUnlocking(ctx, poolIdEntering, sender, exitCoins, remainingLockDuration)
+	} else {
+		positionId, amount0, amount1, liquidity, concentratedLockId, err = k.concentratedLiquidityKeeper.CreateFullRangePositionLocked(ctx, poolIdEntering, sender, exitCoins, remainingLockDuration)
+	}
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	// Full range positions are created without minimum amounts, so they are checked here.
+	if amount0.LT(tokenMinAmount0) || amount1.LT(tokenMinAmount1) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, 0, errorsmod.Wrapf(types.ErrLimitMinAmount,
+			"position amounts (%s, %s) are less than the minimum amounts (%s, %s)", amount0, amount1, tokenMinAmount0, tokenMinAmount1)
+	}
+
+	return positionId, amount0, amount1, liquidity, concentratedLockId, nil
+}
+
+// validateMigrationPools validates that the pool leaving is a balancer pool,
+// and that the pool entering is a concentrated pool with the same denoms.
+func (k Keeper) validateMigrationPools(ctx sdk.Context, poolIdLeaving, poolIdEntering uint64) error {
+	poolLeaving, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return err
+	}
+	if _, ok := poolLeaving.(*balancer.Pool); !ok {
+		return errorsmod.Wrapf(types.ErrInvalidMigration, "pool leaving (%d) must be a balancer pool", poolIdLeaving)
+	}
+
+	concentratedPool, err := k.concentratedLiquidityKeeper.GetConcentratedPoolById(ctx, poolIdEntering)
+	if err != nil {
+		return err
+	}
+
+	denomsLeaving := osmoutils.CoinsDenoms(poolLeaving.GetTotalPoolLiquidity(ctx))
+	denomsEntering := []string{concentratedPool.GetToken0(), concentratedPool.GetToken1()}
+	sort.Strings(denomsEntering)
+	if len(denomsLeaving) != 2 || denomsLeaving[0] != denomsEntering[0] || denomsLeaving[1] != denomsEntering[1] {
+		return types.MigrationPoolDenomsMismatchError{PoolIdLeaving: poolIdLeaving, PoolIdEntering: poolIdEntering, DenomsLeaving: denomsLeaving, DenomsEntering: denomsEntering}
+	}
+	return nil
+}
+
+// validateMigrationLock returns the given lock, after validating that it is owned by the sender,
+// only contains the given shares and is not superfluid delegated or undelegating.
+func (k Keeper) validateMigrationLock(ctx sdk.Context, sender sdk.AccAddress, lockId uint64, shareDenom string) (*lockuptypes.PeriodLock, error) {
+	lock, err := k.lockupKeeper.GetLockByID(ctx, lockId)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != sender.String() {
+		return nil, types.LockNotOwnedBySenderError{LockId: lockId, Owner: lock.Owner, Sender: sender.String()}
+	}
+	if len(lock.Coins) != 1 || lock.Coins[0].Denom != shareDenom {
+		return nil, types.LockDenomMismatchError{LockId: lockId, LockCoins: lock.Coins, ExpectedDenom: shareDenom}
+	}
+	if k.lockupKeeper.HasAnySyntheticLockups(ctx, lockId) {
+		return nil, types.SuperfluidLockMigrationError{LockId: lockId}
+	}
+	return lock, nil
+}
+
+// exitPoolForMigration exits the given shares of the sender from the given balancer pool,
+// and returns the exited coins.
+func (k Keeper) exitPoolForMigration(ctx sdk.Context, sender sdk.AccAddress, poolIdLeaving uint64, sharesToMigrate sdk.Coin, tokenOutMins sdk.Coins) (sdk.Coins, error) {
+	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, tokenOutMins)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	// Defense in depth, ensuring we are returning exactly two coins.
+	if len(exitCoins) != 2 {
+		return sdk.Coins{}, fmt.Errorf("Balancer pool must have exactly two tokens")
+	}
+	return exitCoins, nil
+}
+
 // GetAllMigrationInfo gets all existing links between Balancer Pool and Concentrated Pool,
 // wraps and returns them in `MigrationRecords`.
 func (k Keeper) GetAllMigrationInfo(ctx sdk.Context) (gammmigration.MigrationRecords, error) {
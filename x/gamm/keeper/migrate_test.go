@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,8 +10,10 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v17/x/gamm/types/migration"
+	lockuptypes "github.com/osmosis-labs/osmosis/v17/x/lockup/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v17/x/pool-incentives/types"
 )
 
@@ -998,3 +1001,207 @@ func (suite *KeeperTestSuite) TestRedirectDistributionRecord() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrateSharesToConcentratedPosition() {
+	defaultAccountFunds := sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(200000000000)), sdk.NewCoin(USDC, sdk.NewInt(200000000000)))
+	defaultGammShares := sdk.NewCoin("gamm/pool/1", sdk.MustNewDecFromStr("100000000000000000000").RoundInt())
+	halfGammShares := sdk.NewCoin(defaultGammShares.Denom, defaultGammShares.Amount.QuoRaw(2))
+	lockDuration := time.Hour * 24 * 14
+
+	type lockSetup int
+	const (
+		noLock lockSetup = iota
+		bondedLock
+		unlockingLock
+		superfluidLock
+		otherOwnerLock
+	)
+
+	tests := []struct {
+		name            string
+		lock            lockSetup
+		sharesToMigrate sdk.Coin
+		clPoolDenoms    [2]string
+		lowerTick       int64
+		upperTick       int64
+		tokenMinAmount0 sdk.Int
+		tokenMinAmount1 sdk.Int
+		expectedErr     error
+	}{
+		{
+			name:            "unlocked shares to a custom range",
+			lock:            noLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       -1000,
+			upperTick:       1000,
+		},
+		{
+			name:            "half of the unlocked shares to a full range",
+			lock:            noLock,
+			sharesToMigrate: halfGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+		},
+		{
+			name:            "half of the shares of a bonded lock to a full range",
+			lock:            bondedLock,
+			sharesToMigrate: halfGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+		},
+		{
+			name:            "all of the shares of an unlocking lock to a full range",
+			lock:            unlockingLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+		},
+		{
+			name:            "error: locked shares to a custom range",
+			lock:            bondedLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       -1000,
+			upperTick:       1000,
+			expectedErr:     types.LockedMigrationNotFullRangeError{LockId: 1, LowerTick: -1000, UpperTick: 1000},
+		},
+		{
+			name:            "error: lock owned by another account",
+			lock:            otherOwnerLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+			expectedErr:     types.LockNotOwnedBySenderError{LockId: 1},
+		},
+		{
+			name:            "error: superfluid lock",
+			lock:            superfluidLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+			expectedErr:     types.SuperfluidLockMigrationError{LockId: 1},
+		},
+		{
+			name:            "error: concentrated pool with other denoms",
+			lock:            noLock,
+			sharesToMigrate: defaultGammShares,
+			clPoolDenoms:    [2]string{ETH, "uosmo"},
+			lowerTick:       -1000,
+			upperTick:       1000,
+			expectedErr: types.MigrationPoolDenomsMismatchError{
+				PoolIdLeaving: 1, PoolIdEntering: 2, DenomsLeaving: []string{ETH, USDC}, DenomsEntering: []string{ETH, "uosmo"},
+			},
+		},
+		{
+			name:            "error: locked full range position below the minimum amounts",
+			lock:            bondedLock,
+			sharesToMigrate: defaultGammShares,
+			lowerTick:       cltypes.MinInitializedTick,
+			upperTick:       cltypes.MaxTick,
+			tokenMinAmount0: sdk.NewInt(200000000000),
+			expectedErr:     types.ErrLimitMinAmount,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, defaultAccountFunds)
+
+			balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(ETH, sdk.NewInt(100000000000)), sdk.NewCoin(USDC, sdk.NewInt(100000000000)))
+			clPoolDenoms := tc.clPoolDenoms
+			if clPoolDenoms[0] == "" {
+				clPoolDenoms = [2]string{ETH, USDC}
+			}
+			clPool := s.PrepareConcentratedPoolWithCoins(clPoolDenoms[0], clPoolDenoms[1])
+
+			_, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, balancerPoolId, defaultGammShares.Amount, sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(999999999999999)), sdk.NewCoin(USDC, sdk.NewInt(999999999999999))))
+			s.Require().NoError(err)
+
+			lockId := uint64(0)
+			var lock lockuptypes.PeriodLock
+			if tc.lock != noLock {
+				lockOwner := sender
+				if tc.lock == otherOwnerLock {
+					lockOwner = s.TestAccs[1]
+					s.FundAcc(lockOwner, sdk.NewCoins(defaultGammShares))
+				}
+				lock, err = s.App.LockupKeeper.CreateLock(s.Ctx, lockOwner, sdk.NewCoins(defaultGammShares), lockDuration)
+				s.Require().NoError(err)
+				lockId = lock.ID
+
+				switch tc.lock {
+				case unlockingLock:
+					_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lockId, nil)
+					s.Require().NoError(err)
+					// Part of the lock duration elapses before the migration.
+					s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+				case superfluidLock:
+					err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lockId, "gamm/pool/1/superbonding/osmovaloper", lockDuration, false)
+					s.Require().NoError(err)
+				}
+			}
+			sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, defaultGammShares.Denom)
+
+			tokenMinAmount0, tokenMinAmount1 := tc.tokenMinAmount0, tc.tokenMinAmount1
+			if tokenMinAmount0.IsNil() {
+				tokenMinAmount0 = sdk.ZeroInt()
+			}
+			if tokenMinAmount1.IsNil() {
+				tokenMinAmount1 = sdk.ZeroInt()
+			}
+
+			// System under test.
+			positionId, amount0, amount1, liquidity, concentratedLockId, err := s.App.GAMMKeeper.MigrateSharesToConcentratedPosition(s.Ctx, sender, lockId, tc.sharesToMigrate,
+				clPool.GetId(), tc.lowerTick, tc.upperTick, sdk.Coins{}, tokenMinAmount0, tokenMinAmount1)
+			if tc.expectedErr != nil {
+				expectedErr := tc.expectedErr
+				if tc.lock == otherOwnerLock {
+					expectedErr = types.LockNotOwnedBySenderError{LockId: lockId, Owner: s.TestAccs[1].String(), Sender: sender.String()}
+				}
+				s.Require().ErrorContains(err, expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.String(), position.Address)
+			s.Require().Equal(clPool.GetId(), position.PoolId)
+			s.Require().Equal(tc.lowerTick, position.LowerTick)
+			s.Require().Equal(tc.upperTick, position.UpperTick)
+			s.Require().Equal(liquidity, position.Liquidity)
+			s.Require().True(amount0.IsPositive())
+			s.Require().True(amount1.IsPositive())
+
+			if tc.lock == noLock {
+				s.Require().Zero(concentratedLockId)
+				sharesAfter := s.App.BankKeeper.GetBalance(s.Ctx, sender, defaultGammShares.Denom)
+				s.Require().Equal(sharesBefore.Sub(tc.sharesToMigrate), sharesAfter)
+				return
+			}
+
+			// The position is locked for the remaining duration of the original lock.
+			concentratedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, concentratedLockId)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.String(), concentratedLock.Owner)
+			s.Require().Equal(tc.lock == unlockingLock, concentratedLock.IsUnlocking())
+			if tc.lock == unlockingLock {
+				s.Require().Equal(lock.Duration-time.Hour, concentratedLock.Duration)
+			} else {
+				s.Require().Equal(lock.Duration, concentratedLock.Duration)
+			}
+
+			// The shares that were not migrated stay in the original lock.
+			remainingShares := defaultGammShares.Sub(tc.sharesToMigrate)
+			if remainingShares.IsZero() {
+				_, err = s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+				s.Require().Error(err)
+			} else {
+				originalLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoins(remainingShares), originalLock.Coins)
+			}
+		})
+	}
+}
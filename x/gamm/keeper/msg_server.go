@@ -63,6 +63,16 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapAmplification(ctx, msg.PoolID, msg.FutureAmplification, msg.FutureAmplificationTime, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -142,6 +152,37 @@ func (server msgServer) ExitPool(goCtx context.Context, msg *types.MsgExitPool)
 	}, nil
 }
 
+func (server msgServer) MigrateSharesToConcentratedPosition(goCtx context.Context, msg *types.MsgMigrateSharesToConcentratedPosition) (*types.MsgMigrateSharesToConcentratedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, amount0, amount1, liquidity, concentratedLockId, err := server.keeper.MigrateSharesToConcentratedPosition(ctx, sender, msg.LockId, msg.SharesToMigrate,
+		msg.PoolIdEntering, msg.LowerTick, msg.UpperTick, msg.TokenOutMins, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateSharesToConcentratedPositionResponse{
+		PositionId:         positionId,
+		Amount0:            amount0,
+		Amount1:            amount1,
+		LiquidityCreated:   liquidity,
+		ConcentratedLockId: concentratedLockId,
+	}, nil
+}
+
 func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgSwapExactAmountIn) (*types.MsgSwapExactAmountInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
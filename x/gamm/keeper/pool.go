@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -327,6 +328,26 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// rampStableSwapAmplification linearly ramps the amplification of the given stableswap pool to the given
+// future amplification at the given future time.
+// Returns error if the pool is not a stableswap pool, if the sender is not its scaling factor controller
+// or if the ramp is invalid.
+func (k Keeper) rampStableSwapAmplification(ctx sdk.Context, poolId uint64, futureAmplification sdk.Dec, futureAmplificationTime time.Time, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.RampAmplification(ctx, futureAmplification, futureAmplificationTime, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
 // asCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
@@ -518,6 +518,97 @@ func (s *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRampStableSwapAmplification() {
+	controllerAddr := s.TestAccs[0]
+	failAddr := s.TestAccs[1]
+
+	testcases := []struct {
+		name                    string
+		poolId                  uint64
+		futureAmplification     sdk.Dec
+		futureAmplificationTime time.Duration
+		sender                  sdk.AccAddress
+		expError                error
+		isStableSwapPool        bool
+	}{
+		{
+			name:                    "Error: Pool does not exist",
+			poolId:                  2,
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: stableswap.MinAmplificationRampDuration,
+			sender:                  controllerAddr,
+			expError:                types.PoolDoesNotExistError{PoolId: defaultPoolId + 1},
+			isStableSwapPool:        false,
+		},
+		{
+			name:                    "Error: Pool id is not of type stableswap pool",
+			poolId:                  1,
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: stableswap.MinAmplificationRampDuration,
+			sender:                  controllerAddr,
+			expError:                fmt.Errorf("pool id 1 is not of type stableswap pool"),
+			isStableSwapPool:        false,
+		},
+		{
+			name:                    "Error: Can not ramp amplification",
+			poolId:                  1,
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: stableswap.MinAmplificationRampDuration,
+			sender:                  failAddr,
+			expError:                types.ErrNotScalingFactorGovernor,
+			isStableSwapPool:        true,
+		},
+		{
+			name:                    "Valid case",
+			poolId:                  1,
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: stableswap.MinAmplificationRampDuration,
+			sender:                  controllerAddr,
+			isStableSwapPool:        true,
+		},
+	}
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.isStableSwapPool == true {
+				poolId := s.prepareCustomStableswapPool(
+					defaultAcctFunds,
+					stableswap.PoolParams{
+						SwapFee: defaultSpreadFactor,
+						ExitFee: defaultZeroExitFee,
+					},
+					sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2))),
+					[]uint64{1, 1},
+				)
+				pool, _ := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+				stableswapPool, _ := pool.(*stableswap.Pool)
+				stableswapPool.ScalingFactorController = controllerAddr.String()
+				err := s.App.GAMMKeeper.SetPool(s.Ctx, stableswapPool)
+				s.Require().NoError(err)
+			} else {
+				s.prepareCustomBalancerPool(
+					defaultAcctFunds,
+					defaultPoolAssets,
+					defaultPoolParams)
+			}
+			futureAmplificationTime := s.Ctx.BlockTime().Add(tc.futureAmplificationTime)
+			err := s.App.GAMMKeeper.RampStableSwapAmplification(s.Ctx, tc.poolId, tc.futureAmplification, futureAmplificationTime, tc.sender.String())
+			if tc.expError != nil {
+				s.Require().Error(err)
+				s.Require().EqualError(err, tc.expError.Error())
+			} else {
+				s.Require().NoError(err)
+
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, tc.poolId)
+				s.Require().NoError(err)
+				stableswapPool, _ := pool.(*stableswap.Pool)
+				s.Require().Equal(stableswap.MinAmplification, stableswapPool.GetAmplification(s.Ctx.BlockTime()))
+				s.Require().Equal(tc.futureAmplification, stableswapPool.GetAmplification(futureAmplificationTime))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetMaximalNoSwapLPAmount() {
 	tests := map[string]struct {
 		poolId              uint64
@@ -32,17 +32,44 @@ func cfmmConstantMultiNoVY(xReserve, yReserve, wSumSquares osmomath.BigDec) osmo
 	return xReserve.Mul(x2.Add(y2).Add(wSumSquares))
 }
 
+// Amplified multi-asset CFMM, scaled by 8A, is
+// (A - 1)(x^4 + 6x^2y^2 + y^4) + 4(A + 1)(x^3y + xy^3) + 8Awxy = k,
+// where A >= 1 is the amplification of the pool.
+// Since xy(x^2 + y^2) = ((x + y)^4 - (x - y)^4) / 8, this is derived from
+// ((x + y)^4 - (x - y)^4 / A) / 8 + wxy = k,
+// which is the unamplified CFMM when A = 1 and tends to the constant sum x + y = k as A grows.
+// Unlike the unamplified CFMM, the pool can be drained of x for finite amounts of y when A > 1.
+// For more than two assets, this is not a single invariant of all the reserves when A > 1, so only
+// two-asset pools can be amplified, in which case w = 0.
+func cfmmConstantAmplified(xReserve, yReserve, wSumSquares, amplification osmomath.BigDec) osmomath.BigDec {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() || wSumSquares.IsNegative() {
+		panic("invalid input: reserves must be positive")
+	}
+
+	x2 := xReserve.Mul(xReserve)
+	y2 := yReserve.Mul(yReserve)
+	xy := xReserve.Mul(yReserve)
+	evenTerm := x2.Mul(x2).Add(x2.Mul(y2).MulInt64(6)).Add(y2.Mul(y2)).Mul(amplification.Sub(one))
+	oddTerm := xy.Mul(x2.Add(y2)).Mul(amplification.Add(one)).MulInt64(4)
+	wTerm := xy.Mul(wSumSquares).Mul(amplification).MulInt64(8)
+	return evenTerm.Add(oddTerm).Add(wTerm)
+}
+
 // Solidly's CFMM is xy(x^2 + y^2) = k, and our multi-asset CFMM is xyz(x^2 + y^2 + w) = k
 // So we want to solve for a given addition of `b` units of y into the pool,
 // how many units `a` of x do we get out.
 // So we solve the following expression for `a`:
 // xy(x^2 + y^2 + w) = (x - a)(y + b)((x - a)^2 + (y + b)^2 + w)
 // with w set to 0 for 2 asset pools
-func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) osmomath.BigDec {
+// If the pool is amplified, the amplified CFMM is solved instead.
+func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec, amplification osmomath.BigDec) osmomath.BigDec {
 	wSumSquares := osmomath.ZeroDec()
 	for _, assetReserve := range remReserves {
 		wSumSquares = wSumSquares.Add(assetReserve.Mul(assetReserve))
 	}
+	if !amplification.Equal(one) {
+		return solveAmplifiedCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn, amplification)
+	}
 	return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
 }
 
@@ -155,7 +182,105 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	return xOut
 }
 
-func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err error) {
+// $$k_{target} = k(x_0, y_0) - k(x_0, y_f)$$
+// where k is the amplified CFMM
+func amplifiedTargetKCalculator(x0, y0, w, yf, amplification osmomath.BigDec) osmomath.BigDec {
+	return cfmmConstantAmplified(x0, y0, w, amplification).Sub(cfmmConstantAmplified(x0, yf, w, amplification))
+}
+
+// $$k_{iter}(x_f) = k(x_f, y_f) - k(x_0, y_f)$$
+// where k is the amplified CFMM, expanded as a quartic in x_out = x_0 - x_f without a constant term:
+// $$k_{iter}(x_f) = c_4 x_{out}^4 - (4 c_4 x_0 + c_{31} y_f) x_{out}^3 + (6 c_4 x_0^2 + c_{22} y_f^2 + 3 c_{31} x_0 y_f) x_{out}^2
+// - (4 c_4 x_0^3 + 2 c_{22} x_0 y_f^2 + 3 c_{31} x_0^2 y_f + c_{31} y_f^3 + c_w y_f) x_{out}$$
+// with c_4 = A - 1, c_{22} = 6(A - 1), c_{31} = 4(A + 1) and c_w = 8Aw.
+func amplifiedIterKCalculator(x0, w, yf, amplification osmomath.BigDec) func(osmomath.BigDec) osmomath.BigDec {
+	c4 := amplification.Sub(one)
+	c22 := c4.MulInt64(6)
+	c31 := amplification.Add(one).MulInt64(4)
+	cw := amplification.Mul(w).MulInt64(8)
+
+	x02 := x0.Mul(x0)
+	yf2 := yf.Mul(yf)
+
+	// compute coefficients first
+	quarticCoeff := c4
+	cubicCoeff := c4.Mul(x0).MulInt64(4).Add(c31.Mul(yf)).Neg()
+	quadraticCoeff := c4.Mul(x02).MulInt64(6).Add(c22.Mul(yf2)).Add(c31.Mul(x0).Mul(yf).MulInt64(3))
+	linearCoeff := c4.Mul(x02).Mul(x0).MulInt64(4).
+		Add(c22.Mul(x0).Mul(yf2).MulInt64(2)).
+		Add(c31.Mul(x02).Mul(yf).MulInt64(3)).
+		Add(c31.Mul(yf2).Mul(yf)).
+		Add(cw.Mul(yf)).Neg()
+	return func(xf osmomath.BigDec) osmomath.BigDec {
+		xOut := x0.Sub(xf)
+		// horners method
+		// ax^4 + bx^3 + cx^2 + dx = x(d + x(c + x(b + ax)))
+		res := quarticCoeff.Mul(xOut)
+		res = res.Add(cubicCoeff).Mul(xOut)
+		res = res.Add(quadraticCoeff).Mul(xOut)
+		res = res.Add(linearCoeff).Mul(xOut)
+		return res
+	}
+}
+
+func deriveAmplifiedXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal, amplification osmomath.BigDec) (
+	xFinalLowerbound, xFinalUpperbound osmomath.BigDec,
+) {
+	xFinalLowerbound, xFinalUpperbound = xReserve, xReserve
+
+	k0 := cfmmConstantAmplified(xReserve, yFinal, wSumSquares, amplification)
+	k := cfmmConstantAmplified(xReserve, yReserve, wSumSquares, amplification)
+	// (A - 1)y_f^4 is the only term of the amplified CFMM that does not depend on x,
+	// so it is the value of the CFMM when the pool is drained of x.
+	yFinal2 := yFinal.Mul(yFinal)
+	drainedK := yFinal2.Mul(yFinal2).Mul(amplification.Sub(one))
+	if k0.GT(k) {
+		// The pool is drained of x before k is reached.
+		if drainedK.GTE(k) {
+			panic("invalid output: greater than full pool reserves")
+		}
+		xFinalLowerbound = osmomath.ZeroDec()
+	} else if k0.LT(k) {
+		// The other terms of the CFMM are at least linear in x,
+		// so assuming a linear relationship for them gives an upperbound.
+		xFinalUpperbound = xReserve.Mul(k.Sub(drainedK)).Quo(k0.Sub(drainedK)).Ceil()
+	}
+	// else
+	// k remains unchanged.
+	// So we keep bounds equal to each other
+	return xFinalLowerbound, xFinalUpperbound
+}
+
+// solveAmplifiedCFMMBinarySearchMulti searches the correct dx of the amplified CFMM using binary search over constant K.
+func solveAmplifiedCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn, amplification osmomath.BigDec) osmomath.BigDec {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() || wSumSquares.IsNegative() {
+		panic("invalid input: reserves and input must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+	yFinal := yReserve.Add(yIn)
+	xLowEst, xHighEst := deriveAmplifiedXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal, amplification)
+	targetK := amplifiedTargetKCalculator(xReserve, yReserve, wSumSquares, yFinal, amplification)
+	iterKCalc := amplifiedIterKCalculator(xReserve, wSumSquares, yFinal, amplification)
+	maxIterations := 256
+
+	// we use the same error tolerance and rounding direction as the unamplified CFMM,
+	// see solveCFMMBinarySearchMulti for the reasoning.
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 12), RoundingDir: osmomath.RoundUp}
+
+	xEst, err := osmomath.BinarySearchBigDec(iterKCalc, xLowEst, xHighEst, targetK, errTolerance, maxIterations)
+	if err != nil {
+		panic(err)
+	}
+
+	xOut := xReserve.Sub(xEst)
+	if xOut.Abs().GTE(xReserve) {
+		panic("invalid output: greater than full pool reserves")
+	}
+	return xOut
+}
+
+func (p Pool) spotPrice(quoteDenom, baseDenom string, amplification sdk.Dec) (spotPrice sdk.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 spread factor, at the current liquidity.
 	// The spot price of the pool is then lim a -> 0, f_{y -> x}(a) / a
@@ -171,7 +296,7 @@ func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err er
 	// xReserve & yReserve.
 	a := sdk.OneInt()
 
-	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(baseDenom, a), quoteDenom, sdk.ZeroDec())
+	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(baseDenom, a), quoteDenom, sdk.ZeroDec(), amplification)
 	// fmt.Println("spot price res", res)
 	return res, err
 }
@@ -181,7 +306,8 @@ func oneMinus(spreadFactor sdk.Dec) osmomath.BigDec {
 }
 
 // calcOutAmtGivenIn calculate amount of specified denom to output from a pool in sdk.Dec given the input `tokenIn`
-func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
+// at the given amplification of the pool.
+func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFactor sdk.Dec, amplification sdk.Dec) (sdk.Dec, error) {
 	// round liquidity down, and round token in down
 	reserves, err := p.scaledSortedPoolReserves(tokenIn.Denom, tokenOutDenom, osmomath.RoundDown)
 	if err != nil {
@@ -197,14 +323,15 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFa
 	ammIn := tokenInDec.Mul(oneMinus(spreadFactor))
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn, osmomath.BigDecFromSDKDec(amplification))
 	// fmt.Println("cfmmout ", cfmmOut)
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
 
 // calcInAmtGivenOut calculates exact input amount given the desired output and return as a decimal
-func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadFactor sdk.Dec) (sdk.Dec, error) {
+// at the given amplification of the pool.
+func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadFactor sdk.Dec, amplification sdk.Dec) (sdk.Dec, error) {
 	// round liquidity down, and round token out up
 	reserves, err := p.scaledSortedPoolReserves(tokenInDenom, tokenOut.Denom, osmomath.RoundDown)
 	if err != nil {
@@ -218,7 +345,7 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg(), osmomath.BigDecFromSDKDec(amplification))
 	// returned cfmmIn is negative, representing we need to add this many tokens to pool.
 	// We invert that negative here.
 	cfmmIn = cfmmIn.Neg()
@@ -230,9 +357,13 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 // calcSingleAssetJoinShares calculates the number of LP shares that
 // should be granted given the passed in single-token input (non-mutative)
-func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor sdk.Dec) (sdk.Int, error) {
+func (p *Pool) calcSingleAssetJoinShares(ctx sdk.Context, tokenIn sdk.Coin, spreadFactor sdk.Dec) (sdk.Int, error) {
+	// The swaps of the binary search are not given the context, so the amplification is fixed
+	// to its current value in the copies of the pool.
+	amplification := p.GetAmplification(ctx.BlockTime())
 	poolWithAddedLiquidityAndShares := func(newLiquidity sdk.Coin, newShares sdk.Int) types.CFMMPoolI {
 		paCopy := p.Copy()
+		paCopy.fixAmplification(amplification)
 		paCopy.updatePoolForJoin(sdk.NewCoins(newLiquidity), newShares)
 		return &paCopy
 	}
@@ -288,7 +419,7 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, sprea
 	}
 
 	if len(tokensIn) == 1 && tokensIn[0].Amount.GT(sdk.OneInt()) {
-		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], spreadFactor)
+		numShares, err = p.calcSingleAssetJoinShares(ctx, tokensIn[0], spreadFactor)
 		if err != nil {
 			return sdk.ZeroInt(), sdk.NewCoins(), err
 		}
@@ -20,11 +20,11 @@ func BenchmarkBinarySearchMultiAsset(b *testing.B) {
 	}
 }
 
-func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
+func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
 	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yIn := osmomath.NewBigDec(rand.Int63n(100000))
-	solve(xReserve, yReserve, []osmomath.BigDec{}, yIn)
+	solve(xReserve, yReserve, []osmomath.BigDec{}, yIn, osmomath.OneDec())
 }
 
 func runCalcTwoAsset(solve func(osmomath.BigDec, osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
@@ -430,7 +430,7 @@ func TestCFMMInvariantTwoAssets(t *testing.T) {
 
 				// using two-asset cfmm
 				k0 := cfmmConstant(test.xReserve, test.yReserve)
-				xOut := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, osmomath.OneDec())
 
 				k1 := cfmmConstant(test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn))
 				osmomath.DecApproxEq(t, k0, k1, kErrTolerance)
@@ -566,7 +566,7 @@ func TestCFMMInvariantMultiAssets(t *testing.T) {
 
 				// using multi-asset cfmm
 				k2 := cfmmConstantMulti(test.xReserve, test.yReserve, uReserve, wSumSquares)
-				xOut2 := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut2 := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, osmomath.OneDec())
 				k3 := cfmmConstantMulti(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), uReserve, wSumSquares)
 				osmomath.DecApproxEq(t, k2, k3, kErrTolerance)
 			}
@@ -1059,7 +1059,7 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 			ctx := sdk.Context{}
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
-			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor)
+			shares, err := p.calcSingleAssetJoinShares(ctx, tc.tokenIn, tc.spreadFactor)
 			require.NoError(t, err, "test: %s", name)
 
 			p.updatePoolForJoin(sdk.Coins{tc.tokenIn}, shares)
@@ -1246,3 +1246,91 @@ func TestSingleAssetJoinSpreadFactorRatio(t *testing.T) {
 		})
 	}
 }
+
+func TestCFMMInvariantAmplified(t *testing.T) {
+	// the amplified CFMM is scaled by 8A, so a relative tolerance is used
+	kErrTolerance := osmomath.NewDecWithPrec(1, 10)
+	amplifications := []osmomath.BigDec{osmomath.NewBigDec(2), osmomath.NewBigDec(100), osmomath.NewBigDec(1_000_000)}
+
+	tests := map[string]CFMMTestCase{
+		"two-asset even pool, small input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(1_000_000),
+			remReserves: []osmomath.BigDec{},
+			yIn:         osmomath.NewBigDec(100),
+		},
+		"two-asset even pool, large input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(1_000_000),
+			remReserves: []osmomath.BigDec{},
+			yIn:         osmomath.NewBigDec(500_000),
+		},
+		"two-asset uneven pool, small input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(3_000_000),
+			remReserves: []osmomath.BigDec{},
+			yIn:         osmomath.NewBigDec(100),
+		},
+		"two-asset even pool, negative input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(1_000_000),
+			remReserves: []osmomath.BigDec{},
+			yIn:         osmomath.NewBigDec(-100_000),
+		},
+		"three-asset uneven pool, small input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(2_000_000),
+			remReserves: []osmomath.BigDec{osmomath.NewBigDec(3_000_000)},
+			yIn:         osmomath.NewBigDec(100),
+		},
+		"five-asset even pool, medium input": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(1_000_000),
+			remReserves: []osmomath.BigDec{osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(1_000_000)},
+			yIn:         osmomath.NewBigDec(10_000),
+		},
+	}
+
+	for name, test := range tests {
+		for _, amplification := range amplifications {
+			t.Run(fmt.Sprintf("%s, amplification %s", name, amplification), func(t *testing.T) {
+				wSumSquares := calcWSumSquares(test.remReserves)
+
+				k0 := cfmmConstantAmplified(test.xReserve, test.yReserve, wSumSquares, amplification)
+				xOut := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, amplification)
+				k1 := cfmmConstantAmplified(test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn), wSumSquares, amplification)
+				osmomath.DecApproxEq(t, k0, k1, k0.Mul(kErrTolerance))
+			})
+		}
+	}
+}
+
+func TestSolveAmplifiedCFMM(t *testing.T) {
+	xReserve := osmomath.NewBigDec(1_000_000)
+	yReserve := osmomath.NewBigDec(1_000_000)
+	wSumSquares := osmomath.ZeroDec()
+	yIn := osmomath.NewBigDec(100_000)
+
+	// at an amplification of one, the amplified CFMM is the unamplified CFMM scaled by 8
+	require.Equal(t,
+		cfmmConstantMultiNoV(xReserve, yReserve, wSumSquares).MulInt64(8),
+		cfmmConstantAmplified(xReserve, yReserve, wSumSquares, osmomath.OneDec()))
+	osmomath.DecApproxEq(t,
+		solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn),
+		solveAmplifiedCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn, osmomath.OneDec()),
+		osmomath.OneDec())
+
+	// a higher amplification gives a better rate for a balanced pool, tending to 1:1
+	prevXOut := solveCfmm(xReserve, yReserve, []osmomath.BigDec{}, yIn, osmomath.OneDec())
+	for _, amplification := range []int64{2, 10, 100, 1000} {
+		xOut := solveCfmm(xReserve, yReserve, []osmomath.BigDec{}, yIn, osmomath.NewBigDec(amplification))
+		require.True(t, xOut.GT(prevXOut), "amplification %d: %s <= %s", amplification, xOut, prevXOut)
+		require.True(t, xOut.LT(yIn), "amplification %d: %s >= %s", amplification, xOut, yIn)
+		prevXOut = xOut
+	}
+
+	// a highly amplified pool can be drained of x for finite amounts of y
+	osmoassert.ConditionalPanic(t, true, func() {
+		solveCfmm(osmomath.NewBigDec(100_000), yReserve, []osmomath.BigDec{}, osmomath.NewBigDec(500_000), osmomath.NewBigDec(1_000_000))
+	})
+}
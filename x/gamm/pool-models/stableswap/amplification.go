@@ -0,0 +1,99 @@
+package stableswap
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/gamm/types"
+)
+
+var (
+	// MinAmplification is the amplification of a pool that is not amplified.
+	MinAmplification = sdk.OneDec()
+	// MaxAmplification is the largest amplification that a pool can be ramped to.
+	MaxAmplification = sdk.NewDec(1_000_000)
+	// MaxAmplificationChange is the largest factor by which a single ramp can
+	// increase or decrease the amplification of a pool.
+	MaxAmplificationChange = sdk.NewDec(10)
+)
+
+// MinAmplificationRampDuration is the shortest duration over which the amplification of a pool can be ramped,
+// so that its curve cannot be changed abruptly.
+const MinAmplificationRampDuration = 24 * time.Hour
+
+// GetAmplification returns the amplification of the pool at the given time, linearly interpolated
+// between the initial and the future amplification of its ramp.
+// Returns MinAmplification if the amplification of the pool was never ramped.
+func (p Pool) GetAmplification(t time.Time) sdk.Dec {
+	if p.FutureAmplification.IsNil() || p.FutureAmplification.IsZero() {
+		return MinAmplification
+	}
+	if !t.Before(p.FutureAmplificationTime) {
+		return p.FutureAmplification
+	}
+	if !t.After(p.InitialAmplificationTime) {
+		return p.InitialAmplification
+	}
+
+	elapsed := t.Sub(p.InitialAmplificationTime)
+	rampDuration := p.FutureAmplificationTime.Sub(p.InitialAmplificationTime)
+	change := p.FutureAmplification.Sub(p.InitialAmplification)
+	return p.InitialAmplification.Add(change.MulInt64(int64(elapsed)).QuoInt64(int64(rampDuration)))
+}
+
+// RampAmplification linearly ramps the amplification of the pool from its value at the current block time
+// to the given future amplification at the given future time, replacing any ramp in progress.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor.
+// Only two-asset pools can be amplified. The amplified CFMM is defined pair by pair, so for more assets
+// it is not a single invariant of all the reserves, and cycles of swaps through the pool would be profitable.
+// Returns error if the pool has more than two assets, if the future amplification is out of bounds, changes
+// the amplification by more than MaxAmplificationChange, or if the ramp is shorter than MinAmplificationRampDuration.
+func (p *Pool) RampAmplification(ctx sdk.Context, futureAmplification sdk.Dec, futureAmplificationTime time.Time, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if len(p.PoolLiquidity) > 2 {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp,
+			"only two-asset pools can be amplified, pool has %d assets", len(p.PoolLiquidity))
+	}
+
+	if err := validateAmplification(futureAmplification); err != nil {
+		return err
+	}
+
+	if futureAmplificationTime.Before(ctx.BlockTime().Add(MinAmplificationRampDuration)) {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp,
+			"ramp must end at least %s after the block time %s, got %s", MinAmplificationRampDuration, ctx.BlockTime(), futureAmplificationTime)
+	}
+
+	currentAmplification := p.GetAmplification(ctx.BlockTime())
+	if futureAmplification.GT(currentAmplification.Mul(MaxAmplificationChange)) || currentAmplification.GT(futureAmplification.Mul(MaxAmplificationChange)) {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp,
+			"ramp cannot change the amplification by more than %s times, current %s, future %s", MaxAmplificationChange, currentAmplification, futureAmplification)
+	}
+
+	p.InitialAmplification = currentAmplification
+	p.InitialAmplificationTime = ctx.BlockTime()
+	p.FutureAmplification = futureAmplification
+	p.FutureAmplificationTime = futureAmplificationTime
+	return nil
+}
+
+// fixAmplification sets the amplification of the pool to the given value at any time.
+func (p *Pool) fixAmplification(amplification sdk.Dec) {
+	p.InitialAmplification = amplification
+	p.FutureAmplification = amplification
+	p.InitialAmplificationTime = time.Time{}
+	p.FutureAmplificationTime = time.Time{}
+}
+
+func validateAmplification(amplification sdk.Dec) error {
+	if amplification.IsNil() || amplification.LT(MinAmplification) || amplification.GT(MaxAmplification) {
+		return errorsmod.Wrapf(types.ErrInvalidAmplification,
+			"amplification must be between %s and %s, got %s", MinAmplification, MaxAmplification, amplification)
+	}
+	return nil
+}
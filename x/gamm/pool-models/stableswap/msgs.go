@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +14,7 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampAmplification    = "stable_swap_ramp_amplification"
 )
 
 var (
@@ -169,3 +172,47 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	futureAmplification sdk.Dec,
+	futureAmplificationTime time.Time,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:                  sender,
+		PoolID:                  poolID,
+		FutureAmplification:     futureAmplification,
+		FutureAmplificationTime: futureAmplificationTime,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampAmplification) Type() string { return TypeMsgStableSwapRampAmplification }
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateAmplification(msg.FutureAmplification)
+}
+
+func (msg MsgStableSwapRampAmplification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
@@ -2,6 +2,7 @@ package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -296,6 +297,66 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapRampAmplificationValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+	futureTime := time.Unix(1_000_000, 0).UTC()
+
+	default_msg := stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, sdk.NewDec(10), futureTime)
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_ramp_amplification")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapRampAmplification
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        default_msg,
+			expectPass: true,
+		},
+		{
+			name:       "maximum amplification",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, stableswap.MaxAmplification, futureTime),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(invalidAddr.String(), 1, sdk.NewDec(10), futureTime),
+			expectPass: false,
+		},
+		{
+			name:       "amplification below one",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, sdk.MustNewDecFromStr("0.99"), futureTime),
+			expectPass: false,
+		},
+		{
+			name:       "amplification above maximum",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, stableswap.MaxAmplification.Add(sdk.OneDec()), futureTime),
+			expectPass: false,
+		},
+		{
+			name:       "nil amplification",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, sdk.Dec{}, futureTime),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
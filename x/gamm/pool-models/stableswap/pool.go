@@ -243,7 +243,7 @@ func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDeno
 	if tokenIn.Len() != 1 {
 		return sdk.Coin{}, errors.New("stableswap CalcOutAmtGivenIn: tokenIn is of wrong length")
 	}
-	outAmtDec, err := p.calcOutAmtGivenIn(tokenIn[0], tokenOutDenom, spreadFactor)
+	outAmtDec, err := p.calcOutAmtGivenIn(tokenIn[0], tokenOutDenom, spreadFactor, p.GetAmplification(ctx.BlockTime()))
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -279,7 +279,7 @@ func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDeno
 		return sdk.Coin{}, errors.New("stableswap CalcInAmtGivenOut: tokenOut is of wrong length")
 	}
 
-	amt, err := p.calcInAmtGivenOut(tokenOut[0], tokenInDenom, spreadFactor)
+	amt, err := p.calcInAmtGivenOut(tokenOut[0], tokenInDenom, spreadFactor, p.GetAmplification(ctx.BlockTime()))
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -313,7 +313,7 @@ func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDen
 // SpotPrice calculates the approximate amount of `baseDenom` one would receive for
 // an input dx of `quoteDenom` (to simplify calculations, we approximate dx = 1)
 func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
-	return p.spotPrice(quoteAssetDenom, baseAssetDenom)
+	return p.spotPrice(quoteAssetDenom, baseAssetDenom, p.GetAmplification(ctx.BlockTime()))
 }
 
 func (p Pool) Copy() Pool {
@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -1199,6 +1200,207 @@ func TestSetScalingFactors(t *testing.T) {
 	}
 }
 
+func TestGetAmplification(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	rampingPool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+	rampingPool.InitialAmplification = sdk.NewDec(10)
+	rampingPool.InitialAmplificationTime = startTime
+	rampingPool.FutureAmplification = sdk.NewDec(100)
+	rampingPool.FutureAmplificationTime = startTime.Add(10 * time.Hour)
+
+	tests := map[string]struct {
+		pool                  Pool
+		time                  time.Time
+		expectedAmplification sdk.Dec
+	}{
+		"never ramped": {
+			pool:                  poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors),
+			time:                  startTime,
+			expectedAmplification: MinAmplification,
+		},
+		"before the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime.Add(-time.Hour),
+			expectedAmplification: sdk.NewDec(10),
+		},
+		"at the start of the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime,
+			expectedAmplification: sdk.NewDec(10),
+		},
+		"during the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime.Add(time.Hour),
+			expectedAmplification: sdk.NewDec(19),
+		},
+		"one second into the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime.Add(time.Second),
+			expectedAmplification: sdk.MustNewDecFromStr("10.0025"),
+		},
+		"at the end of the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime.Add(10 * time.Hour),
+			expectedAmplification: sdk.NewDec(100),
+		},
+		"after the ramp": {
+			pool:                  rampingPool,
+			time:                  startTime.Add(100 * time.Hour),
+			expectedAmplification: sdk.NewDec(100),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedAmplification, tc.pool.GetAmplification(tc.time))
+		})
+	}
+}
+
+func TestRampAmplification(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	failAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	blockTime := time.Unix(1_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		poolAssets              sdk.Coins
+		initialAmplification    sdk.Dec
+		futureAmplification     sdk.Dec
+		futureAmplificationTime time.Time
+		sender                  string
+		expError                error
+	}{
+		"valid ramp up from an unramped pool": {
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+		},
+		"valid ramp down": {
+			initialAmplification:    sdk.NewDec(100),
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: blockTime.Add(7 * 24 * time.Hour),
+			sender:                  addr.String(),
+		},
+		"sender is not scaling factor governor": {
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  failAddr.String(),
+			expError:                types.ErrNotScalingFactorGovernor,
+		},
+		"amplification below minimum": {
+			futureAmplification:     sdk.MustNewDecFromStr("0.5"),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplification,
+		},
+		"amplification above maximum": {
+			initialAmplification:    sdk.NewDec(500_000),
+			futureAmplification:     MaxAmplification.Add(sdk.OneDec()),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplification,
+		},
+		"ramp too short": {
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration - time.Second),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplificationRamp,
+		},
+		"ramp up too large": {
+			futureAmplification:     sdk.NewDec(11),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplificationRamp,
+		},
+		"ramp down too large": {
+			initialAmplification:    sdk.NewDec(100),
+			futureAmplification:     sdk.NewDec(9),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplificationRamp,
+		},
+		"three-asset pool cannot be amplified": {
+			poolAssets:              threeUnevenStablePoolAssets,
+			futureAmplification:     sdk.NewDec(10),
+			futureAmplificationTime: blockTime.Add(MinAmplificationRampDuration),
+			sender:                  addr.String(),
+			expError:                types.ErrInvalidAmplificationRamp,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			if tc.poolAssets != nil {
+				pool = poolStructFromAssets(tc.poolAssets, defaultThreeAssetScalingFactors)
+			}
+			pool.ScalingFactorController = addr.String()
+			if !tc.initialAmplification.IsNil() {
+				pool.fixAmplification(tc.initialAmplification)
+			}
+			currentAmplification := pool.GetAmplification(blockTime)
+
+			err := pool.RampAmplification(ctx, tc.futureAmplification, tc.futureAmplificationTime, tc.sender)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Equal(t, currentAmplification, pool.GetAmplification(tc.futureAmplificationTime))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, currentAmplification, pool.GetAmplification(blockTime))
+			require.Equal(t, tc.futureAmplification, pool.GetAmplification(tc.futureAmplificationTime))
+		})
+	}
+}
+
+// TestAmplifiedSwapCycles tests that a cycle of swaps through a single pool returns less than it puts in
+// once the pool is ramped to an amplification above one.
+func TestAmplifiedSwapCycles(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	blockTime := time.Unix(1_000_000, 0).UTC()
+	rampEndTime := blockTime.Add(MinAmplificationRampDuration)
+
+	tests := map[string]struct {
+		poolAssets     sdk.Coins
+		scalingFactors []uint64
+		cycle          []string
+	}{
+		"two-asset pool": {
+			poolAssets:     twoUnevenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			cycle:          []string{"bar", "foo", "bar"},
+		},
+		"three-asset pool": {
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			cycle:          []string{"asset/a", "asset/b", "asset/c", "asset/a"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			pool.ScalingFactorController = addr.String()
+			// The ramp of a pool with more than two assets is rejected, so it is swapped through unamplified.
+			err := pool.RampAmplification(sdk.Context{}.WithBlockTime(blockTime), sdk.NewDec(10), rampEndTime, addr.String())
+			if len(tc.poolAssets) > 2 {
+				require.ErrorIs(t, err, types.ErrInvalidAmplificationRamp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewDec(10), pool.GetAmplification(rampEndTime))
+			}
+
+			ctx := sdk.Context{}.WithBlockTime(rampEndTime)
+			tokenIn := sdk.NewInt64Coin(tc.cycle[0], 10_000)
+			amountIn := tokenIn.Amount
+			for _, tokenOutDenom := range tc.cycle[1:] {
+				tokenOut, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, sdk.ZeroDec())
+				require.NoError(t, err)
+				tokenIn = tokenOut
+			}
+			require.True(t, tokenIn.Amount.LT(amountIn), "cycle returned %s for %s", tokenIn.Amount, amountIn)
+		})
+	}
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
@@ -1348,7 +1550,7 @@ func TestStableswapSpotPrice(t *testing.T) {
 				if (tc.expectedPrice != sdk.Dec{}) {
 					expectedSpotPrice = tc.expectedPrice
 				} else {
-					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, sdk.ZeroDec())
+					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, sdk.ZeroDec(), MinAmplification)
 					require.NoError(t, err)
 				}
 
@@ -13,15 +13,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -96,6 +99,16 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// The amplification of the pool flattens its curve around the point where
+	// the scaled reserves are balanced. It is linearly ramped from
+	// initial_amplification at initial_amplification_time to
+	// future_amplification at future_amplification_time by the
+	// scaling_factor_controller. If future_amplification is unset, the pool is
+	// not amplified, i.e. its amplification is 1.
+	InitialAmplification     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=initial_amplification,json=initialAmplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_amplification" yaml:"initial_amplification"`
+	FutureAmplification      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=future_amplification,json=futureAmplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"future_amplification" yaml:"future_amplification"`
+	InitialAmplificationTime time.Time                              `protobuf:"bytes,11,opt,name=initial_amplification_time,json=initialAmplificationTime,proto3,stdtime" json:"initial_amplification_time" yaml:"initial_amplification_time"`
+	FutureAmplificationTime  time.Time                              `protobuf:"bytes,12,opt,name=future_amplification_time,json=futureAmplificationTime,proto3,stdtime" json:"future_amplification_time" yaml:"future_amplification_time"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -140,50 +153,58 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x4c,
-	0x18, 0x8e, 0xdb, 0xb4, 0xe9, 0x77, 0xfd, 0xbe, 0x54, 0x9f, 0xa9, 0x84, 0xdb, 0x0a, 0x5f, 0x6a,
-	0xd1, 0x2a, 0xaa, 0x88, 0x4d, 0x40, 0x02, 0xd1, 0xad, 0x29, 0x2a, 0x42, 0x42, 0xa8, 0xb8, 0x13,
-	0x3f, 0xa4, 0x70, 0xb6, 0x2f, 0xee, 0x09, 0x3b, 0x67, 0x7c, 0xe7, 0xd2, 0x2e, 0xcc, 0x88, 0x89,
-	0x91, 0xb1, 0x33, 0x13, 0x03, 0x7f, 0x44, 0x05, 0x4b, 0x47, 0xc4, 0x60, 0x50, 0x3b, 0xb0, 0xa2,
-	0xfc, 0x05, 0xe8, 0xce, 0x97, 0xa4, 0x29, 0x50, 0x55, 0x62, 0x49, 0xee, 0x7d, 0xdf, 0xe7, 0x7d,
-	0xde, 0x5f, 0x8f, 0x0c, 0x6e, 0x51, 0x16, 0x53, 0x46, 0x98, 0x13, 0xa2, 0x38, 0x76, 0x12, 0x4a,
-	0xa3, 0x46, 0x4c, 0x03, 0x1c, 0x31, 0x87, 0x71, 0xe4, 0x45, 0x98, 0xbd, 0x40, 0xc9, 0x89, 0x67,
-	0x5b, 0x20, 0xec, 0x24, 0xa5, 0x9c, 0xea, 0x2b, 0x2a, 0xd5, 0x16, 0xa9, 0xb6, 0x08, 0x14, 0x99,
-	0xf6, 0x10, 0x6e, 0xef, 0x34, 0x3d, 0xcc, 0x51, 0x73, 0x7e, 0xce, 0x97, 0xe0, 0xb6, 0xcc, 0x74,
-	0x0a, 0xa3, 0xa0, 0x99, 0x9f, 0x0d, 0x69, 0x48, 0x0b, 0xbf, 0x78, 0x29, 0xef, 0xff, 0x28, 0x26,
-	0x5d, 0xea, 0xc8, 0x5f, 0xe5, 0x32, 0x43, 0x4a, 0xc3, 0x08, 0x3b, 0xd2, 0xf2, 0xb2, 0x8e, 0x13,
-	0x64, 0x29, 0xe2, 0x84, 0x76, 0x55, 0x1c, 0x9e, 0x8e, 0x73, 0x12, 0x63, 0xc6, 0x51, 0x9c, 0xf4,
-	0x09, 0x8a, 0xba, 0x0e, 0xca, 0xf8, 0xb6, 0xa3, 0x3a, 0x93, 0xc6, 0xa9, 0xb8, 0x87, 0x18, 0x1e,
-	0xc4, 0x7d, 0x4a, 0x54, 0x01, 0xeb, 0x87, 0x06, 0xc0, 0x26, 0xa5, 0xd1, 0x26, 0x4a, 0x51, 0xcc,
-	0xf4, 0x27, 0x60, 0x4a, 0xae, 0xa4, 0x83, 0xb1, 0xa1, 0xd5, 0xb4, 0xfa, 0x3f, 0xad, 0xb5, 0x83,
-	0x1c, 0x96, 0xbe, 0xe4, 0x70, 0x39, 0x24, 0x7c, 0x3b, 0xf3, 0x6c, 0x9f, 0xc6, 0x6a, 0x56, 0xf5,
-	0xd7, 0x60, 0xc1, 0x33, 0x87, 0xef, 0x25, 0x98, 0xd9, 0xb7, 0xb1, 0xdf, 0xcb, 0xe1, 0xcc, 0x1e,
-	0x8a, 0xa3, 0x55, 0xab, 0xcf, 0x63, 0xb9, 0x15, 0xf1, 0xdc, 0xc0, 0x58, 0xb0, 0xe3, 0x5d, 0xc2,
-	0x25, 0xfb, 0xd8, 0xdf, 0xb1, 0xf7, 0x79, 0x2c, 0xb7, 0x22, 0x9e, 0x1b, 0x18, 0xaf, 0x2e, 0xbf,
-	0xfe, 0xfe, 0x7e, 0x65, 0x71, 0xe4, 0xf6, 0x5b, 0x83, 0xab, 0x0d, 0x67, 0xb4, 0x3e, 0x4d, 0x80,
-	0xb2, 0x30, 0xf5, 0x2b, 0xa0, 0x82, 0x82, 0x20, 0xc5, 0x8c, 0xa9, 0x59, 0xf5, 0x5e, 0x0e, 0xab,
-	0x05, 0xbf, 0x0a, 0x58, 0x6e, 0x1f, 0xa2, 0x57, 0xc1, 0x18, 0x09, 0x64, 0xdb, 0x65, 0x77, 0x8c,
-	0x04, 0xfa, 0x4b, 0x30, 0x2d, 0xf4, 0xd1, 0x4e, 0x24, 0xab, 0x31, 0x5e, 0xd3, 0xea, 0xd3, 0xd7,
-	0x6e, 0xd8, 0xe7, 0x17, 0x90, 0x3d, 0xec, 0xa9, 0xb5, 0x24, 0xf6, 0xd0, 0xcb, 0xe1, 0x25, 0xb5,
-	0xbb, 0x51, 0x71, 0xaa, 0x1a, 0x96, 0x0b, 0x92, 0xe1, 0xa9, 0x1e, 0x80, 0xd9, 0x4e, 0xc6, 0xb3,
-	0x14, 0x17, 0x90, 0x90, 0xee, 0xe0, 0xb4, 0x4b, 0x53, 0xa3, 0x2c, 0x47, 0x81, 0xbd, 0x1c, 0x2e,
-	0x14, 0x64, 0xbf, 0x43, 0x59, 0xae, 0x5e, 0xb8, 0x45, 0x0f, 0x77, 0x94, 0x53, 0x7f, 0x08, 0xfe,
-	0xe5, 0x94, 0xa3, 0xa8, 0xcd, 0xb6, 0x51, 0x8a, 0x99, 0x31, 0x21, 0x67, 0x9a, 0xb3, 0x95, 0xb6,
-	0x85, 0x86, 0x06, 0xcd, 0xaf, 0x53, 0xd2, 0x6d, 0x2d, 0xa8, 0xb6, 0x2f, 0x14, 0x95, 0x4e, 0x26,
-	0x5b, 0xee, 0xb4, 0x34, 0xb7, 0xa4, 0xa5, 0xa7, 0xa0, 0x2a, 0x1b, 0x88, 0xc8, 0xf3, 0x8c, 0x04,
-	0x84, 0xef, 0x19, 0x93, 0xb5, 0xf1, 0xb3, 0xc9, 0xaf, 0x0a, 0xf2, 0x77, 0x5f, 0x61, 0xfd, 0x1c,
-	0xda, 0x10, 0x09, 0xcc, 0xfd, 0x4f, 0x94, 0xb8, 0xd7, 0xaf, 0xa0, 0xdf, 0x07, 0x33, 0xcc, 0x47,
-	0x11, 0xe9, 0x86, 0xed, 0x0e, 0xf2, 0x39, 0x4d, 0x99, 0x51, 0xa9, 0x8d, 0xd7, 0xcb, 0xad, 0xa5,
-	0x5e, 0x0e, 0x17, 0x7f, 0xd9, 0xf4, 0x29, 0xac, 0xe5, 0x56, 0x95, 0x67, 0xa3, 0x70, 0xe8, 0x4f,
-	0xc1, 0xdc, 0x28, 0xa6, 0xed, 0xd3, 0x2e, 0x4f, 0x69, 0x14, 0xe1, 0xd4, 0x98, 0x92, 0x6b, 0xbf,
-	0xdc, 0xcb, 0x61, 0x4d, 0x31, 0xff, 0x09, 0x6a, 0xb9, 0x17, 0x47, 0x88, 0xd7, 0x07, 0x91, 0xd5,
-	0xe6, 0xab, 0x7d, 0x58, 0x7a, 0xbb, 0x0f, 0x4b, 0x1f, 0x3f, 0x34, 0x26, 0xc4, 0x69, 0xee, 0x0a,
-	0x4d, 0x2f, 0x9c, 0xa1, 0xe9, 0xd6, 0xe3, 0x83, 0x23, 0x53, 0x3b, 0x3c, 0x32, 0xb5, 0x6f, 0x47,
-	0xa6, 0xf6, 0xe6, 0xd8, 0x2c, 0x1d, 0x1e, 0x9b, 0xa5, 0xcf, 0xc7, 0x66, 0xe9, 0xd1, 0xda, 0x89,
-	0xbd, 0x29, 0x86, 0x46, 0x84, 0x3c, 0xd6, 0x37, 0x9c, 0x9d, 0xe6, 0x4d, 0x67, 0xf7, 0xac, 0x8f,
-	0xa4, 0x37, 0x29, 0x3f, 0x12, 0xd7, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x67, 0x8c, 0xb0, 0xa0,
-	0x52, 0x05, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0x3b, 0x45,
+	0x14, 0xef, 0x42, 0xbf, 0x14, 0xa6, 0x58, 0xe2, 0x82, 0x61, 0x5b, 0xb4, 0x5b, 0x36, 0x42, 0x1a,
+	0x42, 0x77, 0xad, 0x26, 0x1a, 0xb9, 0x51, 0x0c, 0xc6, 0x44, 0x09, 0x2e, 0x5e, 0xfc, 0x91, 0xac,
+	0xd3, 0xdd, 0xe9, 0x32, 0x71, 0xb7, 0xb3, 0xee, 0x4c, 0x11, 0x2e, 0xc6, 0x44, 0x13, 0x8d, 0x27,
+	0x8e, 0x1e, 0x39, 0x7b, 0xf2, 0xe0, 0x1f, 0x41, 0x3c, 0x71, 0x34, 0x1e, 0x16, 0x03, 0x31, 0x5e,
+	0x4d, 0xff, 0x02, 0x33, 0xb3, 0xb3, 0xfd, 0x65, 0xa9, 0x90, 0xef, 0xa5, 0x9d, 0xf7, 0xeb, 0xf3,
+	0x3e, 0xef, 0xcd, 0x9b, 0xb7, 0xe0, 0x6d, 0x42, 0x43, 0x42, 0x31, 0xb5, 0x7c, 0x18, 0x86, 0x56,
+	0x44, 0x48, 0xd0, 0x08, 0x89, 0x87, 0x02, 0x6a, 0x51, 0x06, 0xdb, 0x01, 0xa2, 0x5f, 0xc1, 0x68,
+	0xe4, 0xe8, 0x70, 0x0f, 0x33, 0x8a, 0x09, 0x23, 0xea, 0x8e, 0x0c, 0x35, 0x79, 0xa8, 0xc9, 0x0d,
+	0x69, 0xa4, 0x39, 0x74, 0x37, 0xcf, 0x9a, 0x6d, 0xc4, 0x60, 0xb3, 0x52, 0x76, 0x85, 0xb3, 0x23,
+	0x22, 0xad, 0x54, 0x48, 0x61, 0x2a, 0x6b, 0x3e, 0xf1, 0x49, 0xaa, 0xe7, 0x27, 0xa9, 0x7d, 0x11,
+	0x86, 0xb8, 0x4b, 0x2c, 0xf1, 0x2b, 0x55, 0x55, 0x9f, 0x10, 0x3f, 0x40, 0x96, 0x90, 0xda, 0xbd,
+	0x8e, 0xe5, 0xf5, 0x62, 0xc8, 0x30, 0xe9, 0x4a, 0xbb, 0x3e, 0x69, 0x67, 0x38, 0x44, 0x94, 0xc1,
+	0x30, 0xca, 0x00, 0xd2, 0xbc, 0x16, 0xec, 0xb1, 0x53, 0x4b, 0x32, 0x13, 0xc2, 0x84, 0xbd, 0x0d,
+	0x29, 0x1a, 0xd8, 0x5d, 0x82, 0x65, 0x02, 0xe3, 0x1f, 0x05, 0x80, 0x63, 0x42, 0x82, 0x63, 0x18,
+	0xc3, 0x90, 0xaa, 0x9f, 0x81, 0x45, 0xd1, 0x92, 0x0e, 0x42, 0x9a, 0x52, 0x53, 0xea, 0x4b, 0xad,
+	0xfd, 0xeb, 0x44, 0xcf, 0xfd, 0x91, 0xe8, 0xdb, 0x3e, 0x66, 0xa7, 0xbd, 0xb6, 0xe9, 0x92, 0x50,
+	0xd6, 0x2a, 0xff, 0x1a, 0xd4, 0xfb, 0xc2, 0x62, 0x17, 0x11, 0xa2, 0xe6, 0x3b, 0xc8, 0xed, 0x27,
+	0xfa, 0xca, 0x05, 0x0c, 0x83, 0x3d, 0x23, 0xc3, 0x31, 0xec, 0x02, 0x3f, 0x1e, 0x22, 0xc4, 0xd1,
+	0xd1, 0x39, 0x66, 0x02, 0x7d, 0xee, 0xf9, 0xd0, 0x33, 0x1c, 0xc3, 0x2e, 0xf0, 0xe3, 0x21, 0x42,
+	0x7b, 0xdb, 0x3f, 0xfe, 0xfd, 0xcb, 0xce, 0xe6, 0xd8, 0xdd, 0x9f, 0x0c, 0x6e, 0x6d, 0x58, 0xa3,
+	0xf1, 0xd7, 0x12, 0xc8, 0x73, 0x51, 0xdd, 0x05, 0x05, 0xe8, 0x79, 0x31, 0xa2, 0x54, 0xd6, 0xaa,
+	0xf6, 0x13, 0xbd, 0x94, 0xe2, 0x4b, 0x83, 0x61, 0x67, 0x2e, 0x6a, 0x09, 0xcc, 0x61, 0x4f, 0xd0,
+	0xce, 0xdb, 0x73, 0xd8, 0x53, 0xbf, 0x06, 0x45, 0x3e, 0x1f, 0x4e, 0x24, 0x50, 0xb5, 0xf9, 0x9a,
+	0x52, 0x2f, 0xbe, 0xfe, 0xa6, 0xf9, 0xf8, 0x01, 0x32, 0x87, 0x9c, 0x5a, 0x5b, 0xbc, 0x0f, 0xfd,
+	0x44, 0x7f, 0x45, 0xf6, 0x6e, 0x7c, 0x38, 0x65, 0x0e, 0xc3, 0x06, 0xd1, 0xf0, 0xaa, 0x3e, 0x04,
+	0x6b, 0x9d, 0x1e, 0xeb, 0xc5, 0x28, 0x75, 0xf1, 0xc9, 0x19, 0x8a, 0xbb, 0x24, 0xd6, 0xf2, 0xa2,
+	0x14, 0xbd, 0x9f, 0xe8, 0x1b, 0x29, 0xd8, 0x34, 0x2f, 0xc3, 0x56, 0x53, 0x35, 0xe7, 0xf0, 0xae,
+	0x54, 0xaa, 0x1f, 0x83, 0x65, 0x46, 0x18, 0x0c, 0x1c, 0x7a, 0x0a, 0x63, 0x44, 0xb5, 0x67, 0xa2,
+	0xa6, 0xb2, 0x29, 0x67, 0x9b, 0xcf, 0xd0, 0x80, 0xfc, 0x01, 0xc1, 0xdd, 0xd6, 0x86, 0xa4, 0xbd,
+	0x9a, 0x66, 0x1a, 0x0d, 0x36, 0xec, 0xa2, 0x10, 0x4f, 0x84, 0xa4, 0xc6, 0xa0, 0x24, 0x08, 0x04,
+	0xf8, 0xcb, 0x1e, 0xf6, 0x30, 0xbb, 0xd0, 0x16, 0x6a, 0xf3, 0xb3, 0xc1, 0x5f, 0xe3, 0xe0, 0x3f,
+	0xdf, 0xea, 0xf5, 0x47, 0xcc, 0x06, 0x0f, 0xa0, 0xf6, 0x0b, 0x3c, 0xc5, 0xfb, 0x59, 0x06, 0xf5,
+	0x08, 0xac, 0x50, 0x17, 0x06, 0xb8, 0xeb, 0x3b, 0x1d, 0xe8, 0x32, 0x12, 0x53, 0xad, 0x50, 0x9b,
+	0xaf, 0xe7, 0x5b, 0x5b, 0xfd, 0x44, 0xdf, 0xfc, 0x4f, 0xa7, 0x27, 0x7c, 0x0d, 0xbb, 0x24, 0x35,
+	0x87, 0xa9, 0x42, 0xfd, 0x1c, 0x94, 0xc7, 0x7d, 0x1c, 0x97, 0x74, 0x59, 0x4c, 0x82, 0x00, 0xc5,
+	0xda, 0xa2, 0x68, 0xfb, 0xab, 0xfd, 0x44, 0xaf, 0x49, 0xe4, 0x87, 0x5c, 0x0d, 0x7b, 0x7d, 0x0c,
+	0xf8, 0x60, 0x60, 0x51, 0xbf, 0x55, 0xc0, 0x4b, 0xb8, 0x8b, 0x19, 0x86, 0x81, 0x03, 0xc3, 0x28,
+	0xc0, 0x1d, 0xec, 0x8a, 0x75, 0xa0, 0x2d, 0x09, 0xf8, 0xa3, 0x27, 0x3f, 0x97, 0x97, 0x53, 0x32,
+	0x53, 0x41, 0x0d, 0x7b, 0x4d, 0xea, 0xf7, 0x47, 0xd5, 0xea, 0x37, 0xca, 0x60, 0xb4, 0xc6, 0x49,
+	0x00, 0x41, 0xe2, 0x83, 0x27, 0x93, 0x18, 0x1f, 0xc4, 0x09, 0x0e, 0xab, 0xa9, 0x7a, 0x9c, 0xc2,
+	0xf7, 0x0a, 0xa8, 0x4c, 0xe5, 0xec, 0xf0, 0x05, 0xa8, 0x15, 0xc5, 0x60, 0x56, 0xcc, 0x74, 0x3b,
+	0x9a, 0xd9, 0x76, 0x34, 0x3f, 0xca, 0xb6, 0x63, 0xab, 0x21, 0x27, 0x73, 0x73, 0x46, 0xfd, 0x02,
+	0xcb, 0xb8, 0xbc, 0xd5, 0x15, 0x5b, 0x9b, 0xd6, 0x08, 0x8e, 0xa6, 0x7e, 0xa7, 0x80, 0xf2, 0x34,
+	0xe2, 0x29, 0x91, 0xe5, 0xff, 0x25, 0xb2, 0x2b, 0x89, 0xd4, 0x1e, 0xee, 0xc1, 0x08, 0x8f, 0xf5,
+	0x29, 0xcd, 0xe0, 0x58, 0x7b, 0xcd, 0x1f, 0xae, 0xf4, 0xdc, 0x4f, 0x57, 0x7a, 0xee, 0xb7, 0x5f,
+	0x1b, 0xcf, 0xf8, 0xa3, 0x7d, 0x8f, 0x6f, 0xbb, 0x8d, 0x19, 0xdb, 0xae, 0xf5, 0xe9, 0xf5, 0x5d,
+	0x55, 0xb9, 0xb9, 0xab, 0x2a, 0x7f, 0xde, 0x55, 0x95, 0xcb, 0xfb, 0x6a, 0xee, 0xe6, 0xbe, 0x9a,
+	0xfb, 0xfd, 0xbe, 0x9a, 0xfb, 0x64, 0x7f, 0xe4, 0xe6, 0x24, 0x42, 0x23, 0x80, 0x6d, 0x9a, 0x09,
+	0xd6, 0x59, 0xf3, 0x2d, 0xeb, 0x7c, 0xd6, 0xe7, 0xb3, 0xbd, 0x20, 0x4a, 0x7d, 0xe3, 0xdf, 0x01,
+	0x00, 0xb6, 0xc7, 0xd4, 0xdb, 0x6c, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -249,6 +270,42 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FutureAmplificationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureAmplificationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.InitialAmplificationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.InitialAmplificationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FutureAmplification.Size()
+		i -= size
+		if _, err := m.FutureAmplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InitialAmplification.Size()
+		i -= size
+		if _, err := m.InitialAmplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -257,20 +314,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.ScalingFactors)*10)
+		var j3 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -392,6 +449,14 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	l = m.InitialAmplification.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.FutureAmplification.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.InitialAmplificationTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureAmplificationTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
 	return n
 }
 
@@ -839,6 +904,140 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplificationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.InitialAmplificationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplificationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FutureAmplificationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -12,18 +12,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -263,11 +267,115 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Linearly ramps the amplification of the pool from its current value
+// to future_amplification at future_amplification_time.
+type MsgStableSwapRampAmplification struct {
+	Sender                  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                  uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	FutureAmplification     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=future_amplification,json=futureAmplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"future_amplification" yaml:"future_amplification"`
+	FutureAmplificationTime time.Time                              `protobuf:"bytes,4,opt,name=future_amplification_time,json=futureAmplificationTime,proto3,stdtime" json:"future_amplification_time" yaml:"future_amplification_time"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetFutureAmplificationTime() time.Time {
+	if m != nil {
+		return m.FutureAmplificationTime
+	}
+	return time.Time{}
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
 }
 
 func init() {
@@ -275,49 +383,59 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0x10, 0xc4, 0x55, 0x80, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8, 0x0c, 0x69,
-	0x21, 0x3e, 0xda, 0x4a, 0x20, 0xba, 0x35, 0x41, 0x45, 0x15, 0x44, 0x2a, 0xae, 0x58, 0x60, 0x08,
-	0x17, 0xfb, 0x6a, 0x0e, 0x6c, 0x9f, 0xf1, 0x5d, 0xfa, 0x63, 0x64, 0x65, 0xe2, 0xcf, 0x40, 0x4c,
-	0xfc, 0x15, 0xa8, 0x63, 0x47, 0xa6, 0x80, 0xd2, 0xa1, 0x7b, 0x16, 0xc4, 0x86, 0xee, 0x7c, 0xf9,
-	0x25, 0x35, 0xfd, 0x81, 0xba, 0x24, 0xe7, 0xe7, 0xef, 0x7d, 0xdf, 0x7b, 0xdf, 0x7b, 0x3e, 0xf0,
-	0x80, 0xb2, 0x90, 0x32, 0xc2, 0xa0, 0x8f, 0xc2, 0x10, 0xc6, 0x94, 0x06, 0xd5, 0x90, 0x7a, 0x38,
-	0x60, 0x90, 0x71, 0xd4, 0x0a, 0x30, 0xdb, 0x43, 0x31, 0xe4, 0xfb, 0x76, 0x9c, 0x50, 0x4e, 0xf5,
-	0x25, 0x85, 0xb6, 0x05, 0xda, 0x16, 0xe8, 0x14, 0x6c, 0x0f, 0xc1, 0xf6, 0xee, 0x72, 0x0b, 0x73,
-	0xb4, 0x5c, 0x32, 0x5c, 0x09, 0x86, 0x2d, 0xc4, 0x30, 0x54, 0x41, 0xe8, 0x52, 0x12, 0xa5, 0x5c,
-	0xa5, 0x82, 0x4f, 0x7d, 0x2a, 0x8f, 0x50, 0x9c, 0x54, 0x74, 0x06, 0x85, 0x24, 0xa2, 0x50, 0xfe,
-	0xaa, 0xd0, 0x93, 0x8b, 0x94, 0x38, 0x3c, 0x36, 0x05, 0x22, 0x4d, 0xb5, 0x4e, 0x72, 0x60, 0xae,
-	0xc1, 0xfc, 0x7a, 0x82, 0x11, 0xc7, 0xdb, 0x03, 0xc8, 0x16, 0xa5, 0x81, 0xbe, 0x08, 0xf2, 0x0c,
-	0x47, 0x1e, 0x4e, 0x8a, 0x5a, 0x59, 0xab, 0xdc, 0xa8, 0xcd, 0xf4, 0x3a, 0xe6, 0xcd, 0x03, 0x14,
-	0x06, 0x6b, 0x56, 0x1a, 0xb7, 0x1c, 0x05, 0xd0, 0x29, 0x98, 0x16, 0xa4, 0xcd, 0x18, 0x25, 0x28,
-	0x64, 0xc5, 0xa9, 0xb2, 0x56, 0x99, 0x5e, 0x79, 0x64, 0x5f, 0xdc, 0x0c, 0x5b, 0x28, 0x6e, 0xc9,
-	0xec, 0xda, 0x6c, 0xaf, 0x63, 0xea, 0xa9, 0xce, 0x08, 0xa9, 0xe5, 0x80, 0x78, 0x80, 0xd1, 0x3f,
-	0x69, 0x60, 0x96, 0x44, 0x84, 0x13, 0x14, 0xc8, 0x76, 0x9a, 0x01, 0xf9, 0xd8, 0x26, 0x1e, 0xe1,
-	0x07, 0xc5, 0x6c, 0x39, 0x5b, 0x99, 0x5e, 0x99, 0xb7, 0x53, 0x77, 0x6d, 0xe1, 0xee, 0x40, 0xa5,
-	0x4e, 0x49, 0x54, 0x7b, 0x78, 0xd8, 0x31, 0x33, 0xdf, 0x7e, 0x99, 0x15, 0x9f, 0xf0, 0x77, 0xed,
-	0x96, 0xed, 0xd2, 0x10, 0xaa, 0x51, 0xa4, 0x7f, 0x55, 0xe6, 0x7d, 0x80, 0xfc, 0x20, 0xc6, 0x4c,
-	0x26, 0x30, 0xa7, 0xa0, 0xa4, 0x44, 0x91, 0x2f, 0xfa, 0x42, 0x7a, 0x03, 0xdc, 0x66, 0x2e, 0x0a,
-	0x48, 0xe4, 0x37, 0x77, 0x90, 0xcb, 0x69, 0xc2, 0x8a, 0xb9, 0x72, 0xb6, 0x92, 0xab, 0xdd, 0xeb,
-	0x75, 0xcc, 0xb2, 0x32, 0x6a, 0xe8, 0xfa, 0x38, 0xd6, 0x72, 0x6e, 0xa9, 0xc0, 0x46, 0x9a, 0xab,
-	0xbf, 0x04, 0x85, 0x9d, 0x36, 0x6f, 0x27, 0x38, 0x6d, 0xc8, 0xa7, 0xbb, 0x38, 0x89, 0x68, 0x52,
-	0xbc, 0x26, 0xcd, 0x37, 0x7b, 0x1d, 0xf3, 0x4e, 0xca, 0x79, 0x1a, 0xca, 0x72, 0xf4, 0x34, 0x2c,
-	0x4a, 0x7c, 0xa6, 0x82, 0xfa, 0x5b, 0x30, 0x3f, 0xae, 0xda, 0x74, 0x69, 0xc4, 0x13, 0x1a, 0x04,
-	0x38, 0x29, 0xe6, 0x25, 0xef, 0x68, 0xad, 0x93, 0xa0, 0x96, 0x33, 0x37, 0x56, 0x6b, 0x7d, 0xf0,
-	0x66, 0xad, 0xf2, 0xf9, 0xe4, 0xfb, 0xd2, 0xc2, 0xd8, 0xfe, 0xb9, 0x72, 0x97, 0xaa, 0xc3, 0xce,
-	0xab, 0xa2, 0x52, 0x6b, 0x03, 0x98, 0x13, 0x16, 0xcd, 0xc1, 0x2c, 0xa6, 0x11, 0xc3, 0xfa, 0x02,
-	0xb8, 0x2e, 0x9b, 0x22, 0x9e, 0xdc, 0xb8, 0x5c, 0x0d, 0x74, 0x3b, 0x66, 0x5e, 0x40, 0x36, 0x9f,
-	0x3a, 0x79, 0xf1, 0x6a, 0xd3, 0xb3, 0xfe, 0x68, 0xe0, 0x6e, 0x83, 0xf9, 0x29, 0xc5, 0xf6, 0x1e,
-	0x8a, 0xd7, 0xbd, 0xf7, 0x6d, 0xc6, 0xb7, 0xc7, 0xcd, 0xbc, 0xc4, 0xee, 0x8e, 0xa8, 0x4e, 0x4d,
-	0x52, 0x3d, 0x6d, 0xd6, 0xd9, 0xff, 0x9f, 0xf5, 0xda, 0xaa, 0xb0, 0xcd, 0x1e, 0xb3, 0x6d, 0xc4,
-	0x2f, 0x24, 0x3b, 0xaa, 0xaa, 0x9c, 0xaa, 0x12, 0xb4, 0xee, 0x83, 0xc5, 0x73, 0x1b, 0xef, 0x7b,
-	0xb9, 0xf2, 0x77, 0x0a, 0x64, 0x1b, 0xcc, 0xd7, 0xbf, 0x6a, 0xa0, 0x70, 0xea, 0xd7, 0x5d, 0xbf,
-	0xcc, 0xd7, 0x39, 0x61, 0x72, 0xa5, 0xe7, 0x57, 0x40, 0x32, 0x18, 0xff, 0x0f, 0x0d, 0x18, 0xe7,
-	0x8c, 0xb5, 0x71, 0x49, 0xbd, 0xb3, 0xe9, 0x4a, 0xaf, 0xae, 0x94, 0xae, 0xdf, 0x48, 0xed, 0xcd,
-	0x61, 0xd7, 0xd0, 0x8e, 0xba, 0x86, 0xf6, 0xbb, 0x6b, 0x68, 0x5f, 0x8e, 0x8d, 0xcc, 0xd1, 0xb1,
-	0x91, 0xf9, 0x79, 0x6c, 0x64, 0x5e, 0xaf, 0x8f, 0x5c, 0x39, 0x4a, 0xba, 0x1a, 0xa0, 0x16, 0xeb,
-	0x3f, 0xc0, 0xdd, 0xe5, 0xc7, 0x70, 0xff, 0xac, 0x7b, 0xbc, 0x95, 0x97, 0x17, 0xf7, 0xea, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0xd0, 0x8d, 0x70, 0x98, 0x06, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbd, 0x6f, 0xf3, 0x44,
+	0x1c, 0x8e, 0xdf, 0x84, 0x20, 0xae, 0x02, 0xf4, 0x9a, 0xe8, 0xad, 0x9b, 0x57, 0xb2, 0x83, 0x5f,
+	0xf4, 0x2a, 0x2d, 0xb5, 0x8f, 0xb6, 0x12, 0x88, 0x6c, 0x4d, 0xaa, 0xa2, 0x02, 0x91, 0x8a, 0x0b,
+	0x0b, 0x0c, 0xe1, 0xe2, 0x5c, 0xcc, 0x81, 0xed, 0x33, 0xbe, 0x4b, 0x3f, 0x36, 0x90, 0x98, 0x98,
+	0xfa, 0x67, 0x20, 0x58, 0x58, 0x19, 0x58, 0x51, 0xc7, 0x8e, 0x88, 0x21, 0x45, 0xe9, 0xd0, 0x3d,
+	0x0b, 0x2b, 0xba, 0xb3, 0xf3, 0x61, 0x35, 0x49, 0x3f, 0x94, 0x25, 0x39, 0xff, 0xfc, 0xdc, 0xf3,
+	0xfc, 0xbe, 0x65, 0xb0, 0x49, 0x59, 0x40, 0x19, 0x61, 0xd0, 0x43, 0x41, 0x00, 0x23, 0x4a, 0x7d,
+	0x2b, 0xa0, 0x1d, 0xec, 0x33, 0xc8, 0x38, 0x6a, 0xfb, 0x98, 0x9d, 0xa0, 0x08, 0xf2, 0x53, 0x3b,
+	0x8a, 0x29, 0xa7, 0xea, 0x46, 0x8a, 0xb6, 0x05, 0xda, 0x16, 0xe8, 0x04, 0x6c, 0x4f, 0xc0, 0xf6,
+	0xf1, 0x56, 0x1b, 0x73, 0xb4, 0x55, 0xd6, 0x5d, 0x09, 0x86, 0x6d, 0xc4, 0x30, 0x4c, 0x8d, 0xd0,
+	0xa5, 0x24, 0x4c, 0xb8, 0xca, 0x25, 0x8f, 0x7a, 0x54, 0x1e, 0xa1, 0x38, 0xa5, 0xd6, 0xa7, 0x28,
+	0x20, 0x21, 0x85, 0xf2, 0x37, 0x35, 0x19, 0x1e, 0xa5, 0x9e, 0x8f, 0xa1, 0x7c, 0x6a, 0xf7, 0xba,
+	0x90, 0x93, 0x00, 0x33, 0x8e, 0x82, 0x28, 0x05, 0x7c, 0x78, 0x9f, 0x18, 0x26, 0xc7, 0x96, 0x40,
+	0x24, 0x57, 0xcd, 0x9b, 0x02, 0x58, 0x6d, 0x32, 0xaf, 0x11, 0x63, 0xc4, 0xf1, 0xd1, 0x18, 0x72,
+	0x48, 0xa9, 0xaf, 0xae, 0x83, 0x22, 0xc3, 0x61, 0x07, 0xc7, 0x9a, 0x52, 0x51, 0xaa, 0xaf, 0xd5,
+	0x9f, 0x0e, 0xfb, 0xc6, 0xeb, 0x67, 0x28, 0xf0, 0x6b, 0x66, 0x62, 0x37, 0x9d, 0x14, 0xa0, 0x52,
+	0xb0, 0x22, 0x48, 0x5b, 0x11, 0x8a, 0x51, 0xc0, 0xb4, 0x27, 0x15, 0xa5, 0xba, 0xb2, 0xfd, 0xbe,
+	0x7d, 0xff, 0x6c, 0xd9, 0x42, 0xf1, 0x50, 0xde, 0xae, 0x3f, 0x1b, 0xf6, 0x0d, 0x35, 0xd1, 0x99,
+	0x22, 0x35, 0x1d, 0x10, 0x8d, 0x31, 0xea, 0x8f, 0x0a, 0x78, 0x46, 0x42, 0xc2, 0x09, 0xf2, 0x65,
+	0x38, 0x2d, 0x9f, 0x7c, 0xdf, 0x23, 0x1d, 0xc2, 0xcf, 0xb4, 0x7c, 0x25, 0x5f, 0x5d, 0xd9, 0x5e,
+	0xb3, 0x93, 0xf4, 0xdb, 0x22, 0xfd, 0x63, 0x95, 0x06, 0x25, 0x61, 0xfd, 0xbd, 0x8b, 0xbe, 0x91,
+	0xfb, 0xf5, 0xca, 0xa8, 0x7a, 0x84, 0x7f, 0xd3, 0x6b, 0xdb, 0x2e, 0x0d, 0x60, 0x5a, 0xab, 0xe4,
+	0xcf, 0x62, 0x9d, 0xef, 0x20, 0x3f, 0x8b, 0x30, 0x93, 0x17, 0x98, 0x53, 0x4a, 0xa5, 0x84, 0x93,
+	0x9f, 0x8e, 0x84, 0xd4, 0x26, 0x78, 0x93, 0xb9, 0xc8, 0x27, 0xa1, 0xd7, 0xea, 0x22, 0x97, 0xd3,
+	0x98, 0x69, 0x85, 0x4a, 0xbe, 0x5a, 0xa8, 0xbf, 0x33, 0xec, 0x1b, 0x95, 0x34, 0x51, 0x93, 0xac,
+	0x67, 0xb1, 0xa6, 0xf3, 0x46, 0x6a, 0xd8, 0x4f, 0xee, 0xaa, 0x9f, 0x81, 0x52, 0xb7, 0xc7, 0x7b,
+	0x31, 0x4e, 0x02, 0xf2, 0xe8, 0x31, 0x8e, 0x43, 0x1a, 0x6b, 0xaf, 0xc8, 0xe4, 0x1b, 0xc3, 0xbe,
+	0xf1, 0x3c, 0xe1, 0x9c, 0x85, 0x32, 0x1d, 0x35, 0x31, 0x0b, 0x17, 0x3f, 0x4a, 0x8d, 0xea, 0xd7,
+	0x60, 0x2d, 0xab, 0xda, 0x72, 0x69, 0xc8, 0x63, 0xea, 0xfb, 0x38, 0xd6, 0x8a, 0x92, 0x77, 0xda,
+	0xd7, 0x79, 0x50, 0xd3, 0x59, 0xcd, 0xf8, 0xda, 0x18, 0xbf, 0xa9, 0x55, 0x7f, 0xbe, 0xf9, 0x7d,
+	0xe3, 0x45, 0xa6, 0xff, 0x5c, 0xd9, 0x4b, 0xd6, 0x24, 0x72, 0x4b, 0x78, 0x6a, 0xee, 0x03, 0x63,
+	0x4e, 0xa3, 0x39, 0x98, 0x45, 0x34, 0x64, 0x58, 0x7d, 0x01, 0x5e, 0x95, 0x41, 0x91, 0x8e, 0xec,
+	0xb8, 0x42, 0x1d, 0x0c, 0xfa, 0x46, 0x51, 0x40, 0x0e, 0xf6, 0x9c, 0xa2, 0x78, 0x75, 0xd0, 0x31,
+	0xff, 0x53, 0xc0, 0xdb, 0x4d, 0xe6, 0x25, 0x14, 0x47, 0x27, 0x28, 0xda, 0xed, 0x7c, 0xdb, 0x63,
+	0xfc, 0x28, 0x9b, 0xcc, 0x07, 0xf4, 0xee, 0x94, 0xea, 0x93, 0x79, 0xaa, 0xb3, 0x6a, 0x9d, 0x7f,
+	0x7c, 0xad, 0x6b, 0x3b, 0x22, 0x6d, 0x76, 0x26, 0x6d, 0x53, 0xf9, 0x42, 0x32, 0x22, 0x2b, 0xbd,
+	0x63, 0xa5, 0x82, 0xe6, 0xbb, 0x60, 0xfd, 0xce, 0xc0, 0x47, 0xb9, 0x34, 0x7f, 0xcb, 0x03, 0x3d,
+	0x83, 0x76, 0x50, 0x10, 0xed, 0x06, 0x91, 0x4f, 0xba, 0xc4, 0x45, 0x9c, 0xd0, 0x70, 0xe9, 0x39,
+	0xfa, 0x41, 0x19, 0x77, 0x30, 0x9a, 0x16, 0xd2, 0xf2, 0x92, 0xbe, 0x29, 0xc6, 0xee, 0x9f, 0xbe,
+	0xf1, 0xf2, 0x1e, 0x63, 0xb7, 0x87, 0xdd, 0x5b, 0xfd, 0x9e, 0xe1, 0x34, 0x9d, 0xb7, 0x12, 0x73,
+	0x36, 0xa4, 0x9f, 0x14, 0xb0, 0x36, 0x0b, 0xde, 0x12, 0x2b, 0x53, 0x2b, 0xc8, 0xb5, 0x54, 0xb6,
+	0x93, 0x7d, 0x6a, 0x8f, 0xf6, 0xa9, 0xfd, 0xf9, 0x68, 0x9f, 0xd6, 0x37, 0x85, 0x8f, 0x93, 0x8a,
+	0xce, 0xa5, 0x32, 0xcf, 0xaf, 0x0c, 0xc5, 0x59, 0x9d, 0xe1, 0x82, 0xe0, 0xaa, 0x41, 0x51, 0xde,
+	0x8d, 0x79, 0xe5, 0x8d, 0x51, 0x10, 0x59, 0xd9, 0x68, 0xaa, 0xe0, 0xe5, 0xe2, 0x62, 0x8d, 0xea,
+	0xba, 0xfd, 0x47, 0x01, 0xe4, 0x9b, 0xcc, 0x53, 0x7f, 0x51, 0x40, 0x69, 0xe6, 0xd6, 0x6e, 0x3c,
+	0x64, 0xeb, 0xce, 0x99, 0xc8, 0xf2, 0x27, 0x4b, 0x20, 0x19, 0x8f, 0xf5, 0x5f, 0x0a, 0xd0, 0xef,
+	0x18, 0xd7, 0xe6, 0x03, 0xf5, 0x16, 0xd3, 0x95, 0xbf, 0x58, 0x2a, 0xdd, 0x38, 0x90, 0x3f, 0x15,
+	0xf0, 0x7c, 0xd1, 0x40, 0x7d, 0xfc, 0x68, 0xd9, 0x5b, 0x5c, 0x65, 0x67, 0x79, 0x5c, 0x23, 0xff,
+	0xeb, 0x5f, 0x5d, 0x0c, 0x74, 0xe5, 0x72, 0xa0, 0x2b, 0xff, 0x0e, 0x74, 0xe5, 0xfc, 0x5a, 0xcf,
+	0x5d, 0x5e, 0xeb, 0xb9, 0xbf, 0xaf, 0xf5, 0xdc, 0x97, 0xbb, 0x53, 0x33, 0x99, 0xea, 0x5a, 0x3e,
+	0x6a, 0xb3, 0xd1, 0x03, 0x3c, 0xde, 0xfa, 0x00, 0x9e, 0x2e, 0xfa, 0xbe, 0x68, 0x17, 0xe5, 0x38,
+	0xed, 0xfc, 0x3f, 0x00, 0xec, 0xd4, 0xed, 0xa0, 0x51, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +452,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 type msgClient struct {
@@ -362,10 +481,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -378,6 +507,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -419,6 +551,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -431,6 +581,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -628,6 +782,82 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FutureAmplificationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureAmplificationTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FutureAmplification.Size()
+		i -= size
+		if _, err := m.FutureAmplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -721,6 +951,35 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.FutureAmplification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FutureAmplificationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1315,6 +1574,224 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplificationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FutureAmplificationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
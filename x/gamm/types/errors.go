@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -50,6 +51,64 @@ func (e BalancerPoolMigrationLinkNotFoundError) Error() string {
 	return fmt.Sprintf("given PoolIdEntering (%d) does not have a canonical link for any balancer pool", e.PoolIdEntering)
 }
 
+type MigrationPoolDenomsMismatchError struct {
+	PoolIdLeaving  uint64
+	PoolIdEntering uint64
+	DenomsLeaving  []string
+	DenomsEntering []string
+}
+
+func (e MigrationPoolDenomsMismatchError) Error() string {
+	return fmt.Sprintf("denoms of pool leaving (%d) %v do not match denoms of pool entering (%d) %v", e.PoolIdLeaving, e.DenomsLeaving, e.PoolIdEntering, e.DenomsEntering)
+}
+
+type LockNotOwnedBySenderError struct {
+	LockId uint64
+	Owner  string
+	Sender string
+}
+
+func (e LockNotOwnedBySenderError) Error() string {
+	return fmt.Sprintf("lock (%d) is owned by %s, not by sender %s", e.LockId, e.Owner, e.Sender)
+}
+
+type LockDenomMismatchError struct {
+	LockId        uint64
+	LockCoins     sdk.Coins
+	ExpectedDenom string
+}
+
+func (e LockDenomMismatchError) Error() string {
+	return fmt.Sprintf("lock (%d) must only contain %s, got %s", e.LockId, e.ExpectedDenom, e.LockCoins)
+}
+
+type SuperfluidLockMigrationError struct {
+	LockId uint64
+}
+
+func (e SuperfluidLockMigrationError) Error() string {
+	return fmt.Sprintf("lock (%d) is superfluid delegated or undelegating, it must be migrated through the superfluid module", e.LockId)
+}
+
+type LockedMigrationNotFullRangeError struct {
+	LockId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e LockedMigrationNotFullRangeError) Error() string {
+	return fmt.Sprintf("shares of lock (%d) can only be migrated to a full range position, got range [%d, %d)", e.LockId, e.LowerTick, e.UpperTick)
+}
+
+type MaturedLockMigrationError struct {
+	LockId  uint64
+	EndTime time.Time
+}
+
+func (e MaturedLockMigrationError) Error() string {
+	return fmt.Sprintf("lock (%d) has matured at %s and cannot be migrated", e.LockId, e.EndTime)
+}
+
 // x/gamm module sentinel errors.
 var (
 	ErrPoolNotFound        = errorsmod.Register(ModuleName, 1, "pool not found")
@@ -90,4 +149,8 @@ var (
 	ErrInvalidScalingFactors      = errorsmod.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = errorsmod.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+	ErrInvalidAmplification       = errorsmod.Register(ModuleName, 67, "invalid stableswap amplification")
+	ErrInvalidAmplificationRamp   = errorsmod.Register(ModuleName, 68, "invalid stableswap amplification ramp")
+
+	ErrInvalidMigration = errorsmod.Register(ModuleName, 70, "invalid migration to concentrated liquidity")
 )
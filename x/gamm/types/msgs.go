@@ -20,6 +20,8 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+
+	TypeMsgMigrateSharesToConcentratedPosition = "migrate_shares_to_concentrated_position"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -348,3 +350,57 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgMigrateSharesToConcentratedPosition{}
+
+func (msg MsgMigrateSharesToConcentratedPosition) Route() string { return RouterKey }
+func (msg MsgMigrateSharesToConcentratedPosition) Type() string {
+	return TypeMsgMigrateSharesToConcentratedPosition
+}
+func (msg MsgMigrateSharesToConcentratedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.SharesToMigrate.IsValid() || !msg.SharesToMigrate.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveRequireAmount, msg.SharesToMigrate.String())
+	}
+	if !strings.HasPrefix(msg.SharesToMigrate.Denom, GAMMTokenPrefix) {
+		return errorsmod.Wrapf(ErrInvalidMigration, "shares to migrate must be gamm shares, got %s", msg.SharesToMigrate.Denom)
+	}
+
+	if msg.PoolIdEntering == 0 {
+		return errorsmod.Wrap(ErrInvalidMigration, "pool id entering must be positive")
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return errorsmod.Wrapf(ErrInvalidMigration, "lower tick (%d) must be less than upper tick (%d)", msg.LowerTick, msg.UpperTick)
+	}
+
+	tokenOutMins := sdk.Coins(msg.TokenOutMins)
+	if !tokenOutMins.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, tokenOutMins.String())
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() {
+		return errorsmod.Wrap(ErrNotPositiveCriteria, msg.TokenMinAmount0.String())
+	}
+	if msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return errorsmod.Wrap(ErrNotPositiveCriteria, msg.TokenMinAmount1.String())
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateSharesToConcentratedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateSharesToConcentratedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
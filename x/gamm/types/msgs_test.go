@@ -873,6 +873,143 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 }
 
 // Test authz serialize and de-serializes for gamm msg.
+func TestMsgMigrateSharesToConcentratedPosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+		properMsg := gammtypes.MsgMigrateSharesToConcentratedPosition{
+			Sender:          addr1,
+			LockId:          1,
+			SharesToMigrate: sdk.NewCoin("gamm/pool/1", sdk.NewInt(100)),
+			PoolIdEntering:  2,
+			LowerTick:       -1000,
+			UpperTick:       1000,
+			TokenOutMins:    sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(10)), sdk.NewCoin("test2", sdk.NewInt(20))),
+			TokenMinAmount0: sdk.NewInt(10),
+			TokenMinAmount1: sdk.NewInt(20),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "migrate_shares_to_concentrated_position")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgMigrateSharesToConcentratedPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unlocked shares can pass",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.LockId = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "'empty token min out' can pass",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.TokenOutMins = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero min amounts can pass",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.TokenMinAmount0 = sdk.ZeroInt()
+				msg.TokenMinAmount1 = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero shares",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.SharesToMigrate.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "shares that are not gamm shares",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.SharesToMigrate.Denom = "uosmo"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero pool id entering",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.PoolIdEntering = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "lower tick equal to upper tick",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.LowerTick = msg.UpperTick
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative token out min",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.TokenOutMins[1].Amount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative min amount",
+			msg: createMsg(func(msg gammtypes.MsgMigrateSharesToConcentratedPosition) gammtypes.MsgMigrateSharesToConcentratedPosition {
+				msg.TokenMinAmount1 = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -966,6 +1103,19 @@ func TestAuthzMsg(t *testing.T) {
 				InitialPoolLiquidity: sdk.NewCoins(coin),
 			},
 		},
+		{
+			name: "MsgMigrateSharesToConcentratedPosition",
+			gammMsg: &gammtypes.MsgMigrateSharesToConcentratedPosition{
+				Sender:          addr1,
+				SharesToMigrate: sdk.NewCoin("gamm/pool/1", sdk.NewInt(100)),
+				PoolIdEntering:  2,
+				LowerTick:       -1000,
+				UpperTick:       1000,
+				TokenOutMins:    sdk.NewCoins(coin),
+				TokenMinAmount0: sdk.NewInt(1),
+				TokenMinAmount1: sdk.NewInt(1),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -14,6 +14,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	migration "github.com/osmosis-labs/osmosis/v17/x/gamm/types/migration"
 	types2 "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -23,12 +25,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1672,6 +1676,98 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) GetMigrationRecords() *migratio
 	return nil
 }
 
+// =============================== StableswapAmplification
+type QueryStableswapAmplificationRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// time is the time at which the amplification is evaluated. If unset, the
+	// current block time is used.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *QueryStableswapAmplificationRequest) Reset()         { *m = QueryStableswapAmplificationRequest{} }
+func (m *QueryStableswapAmplificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStableswapAmplificationRequest) ProtoMessage()    {}
+func (*QueryStableswapAmplificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
+}
+func (m *QueryStableswapAmplificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStableswapAmplificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStableswapAmplificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStableswapAmplificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStableswapAmplificationRequest.Merge(m, src)
+}
+func (m *QueryStableswapAmplificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStableswapAmplificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStableswapAmplificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStableswapAmplificationRequest proto.InternalMessageInfo
+
+func (m *QueryStableswapAmplificationRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryStableswapAmplificationRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+type QueryStableswapAmplificationResponse struct {
+	Amplification github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=amplification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amplification" yaml:"amplification"`
+}
+
+func (m *QueryStableswapAmplificationResponse) Reset()         { *m = QueryStableswapAmplificationResponse{} }
+func (m *QueryStableswapAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStableswapAmplificationResponse) ProtoMessage()    {}
+func (*QueryStableswapAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
+}
+func (m *QueryStableswapAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStableswapAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStableswapAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStableswapAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStableswapAmplificationResponse.Merge(m, src)
+}
+func (m *QueryStableswapAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStableswapAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStableswapAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStableswapAmplificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
@@ -1707,141 +1803,151 @@ func init() {
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
 	proto.RegisterType((*QueryCFMMConcentratedPoolLinksRequest)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksRequest")
 	proto.RegisterType((*QueryCFMMConcentratedPoolLinksResponse)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksResponse")
+	proto.RegisterType((*QueryStableswapAmplificationRequest)(nil), "osmosis.gamm.v1beta1.QueryStableswapAmplificationRequest")
+	proto.RegisterType((*QueryStableswapAmplificationResponse)(nil), "osmosis.gamm.v1beta1.QueryStableswapAmplificationResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 2055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xd6, 0xc8, 0xb2, 0x22, 0x8d, 0x6d, 0xfd, 0x4c, 0xe4, 0x98, 0xa6, 0x6c, 0xd1, 0x99, 0x26,
-	0x92, 0x63, 0x4b, 0x4b, 0xc9, 0x56, 0x90, 0x56, 0x8d, 0x13, 0x4b, 0x8a, 0x64, 0x53, 0xb0, 0x2c,
-	0x65, 0x1d, 0xb4, 0x68, 0x8b, 0x76, 0xb1, 0x22, 0xd7, 0xd4, 0xc6, 0xdc, 0x1d, 0x9a, 0x3b, 0x1b,
-	0x4b, 0x08, 0x8c, 0x00, 0x39, 0xa5, 0xbd, 0xa4, 0x40, 0xdb, 0x14, 0x2d, 0x8a, 0xf6, 0x12, 0x14,
-	0x45, 0xcf, 0x05, 0x7a, 0xea, 0xa1, 0xe8, 0xc5, 0xe8, 0xc9, 0x68, 0x7b, 0x28, 0x7a, 0x60, 0x0b,
-	0xbb, 0xbd, 0xf5, 0xa4, 0x4b, 0xae, 0xc5, 0xcc, 0xbc, 0xfd, 0x21, 0xb9, 0x5c, 0xfe, 0xa4, 0x06,
-	0x92, 0x13, 0xb9, 0x33, 0xef, 0xe7, 0x7b, 0x3f, 0xf3, 0xe6, 0xcd, 0xc3, 0x17, 0x98, 0xe7, 0x30,
-	0xcf, 0xf6, 0xf2, 0x65, 0xd3, 0x71, 0xf2, 0xef, 0x2d, 0xed, 0x59, 0xdc, 0x5c, 0xca, 0xdf, 0xf7,
-	0xad, 0xda, 0xa1, 0x56, 0xad, 0x31, 0xce, 0xc8, 0x14, 0x50, 0x68, 0x82, 0x42, 0x03, 0x8a, 0xec,
-	0x54, 0x99, 0x95, 0x99, 0x24, 0xc8, 0x8b, 0x7f, 0x8a, 0x36, 0x7b, 0x3e, 0x51, 0x1a, 0x3f, 0x80,
-	0xed, 0xf9, 0x60, 0xbb, 0xca, 0x58, 0xc5, 0x31, 0x5d, 0xb3, 0x6c, 0xd5, 0x42, 0x2a, 0xef, 0x81,
-	0x59, 0x35, 0x6a, 0xcc, 0xe7, 0x16, 0x50, 0xcf, 0x14, 0x25, 0x79, 0x7e, 0xcf, 0xf4, 0xac, 0x90,
-	0xaa, 0xc8, 0x6c, 0x17, 0xf6, 0x2f, 0xc5, 0xf7, 0x25, 0xe2, 0x90, 0xaa, 0x6a, 0x96, 0x6d, 0xd7,
-	0xe4, 0x36, 0x0b, 0x68, 0xcf, 0x95, 0x19, 0x2b, 0x57, 0xac, 0xbc, 0x59, 0xb5, 0xf3, 0xa6, 0xeb,
-	0x32, 0x2e, 0x37, 0x3d, 0xd8, 0x3d, 0x0b, 0xbb, 0xf2, 0x6b, 0xcf, 0xbf, 0x9b, 0x37, 0xdd, 0xc3,
-	0x60, 0x4b, 0x29, 0x31, 0x94, 0xa9, 0xea, 0x03, 0xb6, 0x5e, 0x4c, 0x34, 0xd6, 0xdb, 0x37, 0x6b,
-	0x56, 0x49, 0x91, 0xd0, 0x75, 0x3c, 0xf1, 0xb6, 0x00, 0xb6, 0xcb, 0x58, 0x45, 0xb7, 0xee, 0xfb,
-	0x96, 0xc7, 0xc9, 0x65, 0xfc, 0x9c, 0x30, 0xdf, 0xb0, 0x4b, 0x19, 0x74, 0x01, 0x5d, 0x1c, 0x5a,
-	0x23, 0x47, 0xf5, 0xdc, 0xd8, 0xa1, 0xe9, 0x54, 0x56, 0x28, 0x6c, 0x50, 0x7d, 0x58, 0xfc, 0x2b,
-	0x94, 0x56, 0x06, 0x33, 0x88, 0xde, 0xc2, 0x93, 0x31, 0x21, 0x5e, 0x95, 0xb9, 0x9e, 0x45, 0xae,
-	0xe2, 0x21, 0x41, 0x22, 0x45, 0x9c, 0xb8, 0x32, 0xa5, 0x29, 0x0b, 0xb4, 0xc0, 0x02, 0x6d, 0xd5,
-	0x3d, 0x5c, 0x1b, 0xfd, 0xf3, 0xef, 0x16, 0x8e, 0x0b, 0xae, 0x82, 0x2e, 0x89, 0xa5, 0xb4, 0xef,
-	0xc4, 0xa4, 0x79, 0x01, 0xa6, 0x4d, 0x8c, 0x23, 0x97, 0x65, 0x06, 0xa5, 0xcc, 0x59, 0x0d, 0xac,
-	0x15, 0xfe, 0xd5, 0x54, 0x46, 0x80, 0x91, 0xda, 0xae, 0x59, 0xb6, 0x80, 0x57, 0x8f, 0x71, 0xd2,
-	0x1f, 0x23, 0x4c, 0xe2, 0xd2, 0x01, 0xec, 0xab, 0xf8, 0xb8, 0xd0, 0xef, 0x65, 0xd0, 0x85, 0x63,
-	0xdd, 0xa0, 0x55, 0xd4, 0xe4, 0x46, 0x02, 0xaa, 0xb9, 0x8e, 0xa8, 0x94, 0xce, 0x06, 0x58, 0x59,
-	0x3c, 0x25, 0x51, 0xdd, 0xf6, 0x9d, 0xb8, 0xd9, 0xd2, 0x1f, 0xb7, 0xf1, 0xe9, 0xa6, 0x3d, 0x00,
-	0xbd, 0x84, 0x47, 0x5d, 0xdf, 0x31, 0x02, 0xe0, 0x22, 0x52, 0x53, 0x47, 0xf5, 0xdc, 0x84, 0x8a,
-	0x54, 0xb8, 0x45, 0xf5, 0x11, 0x17, 0x58, 0xa5, 0xbc, 0x75, 0xd0, 0x25, 0x56, 0xde, 0x39, 0xac,
-	0x5a, 0xfd, 0x84, 0x9d, 0x6e, 0x01, 0xa8, 0x48, 0x48, 0x04, 0x4a, 0x12, 0xf3, 0xc3, 0xaa, 0x25,
-	0xe5, 0x8c, 0xc6, 0x41, 0x85, 0x5b, 0x54, 0x1f, 0xa9, 0x02, 0x2b, 0xfd, 0x3d, 0xc2, 0x33, 0x52,
-	0xd8, 0xba, 0x59, 0x29, 0x6e, 0x31, 0xdb, 0x15, 0x42, 0xef, 0x88, 0x2c, 0xf5, 0xfa, 0xc1, 0x46,
-	0xf6, 0xf1, 0x28, 0x67, 0xf7, 0x2c, 0xd7, 0x33, 0x6c, 0x11, 0x14, 0x11, 0xd0, 0xb3, 0x0d, 0x41,
-	0x09, 0xc2, 0xb1, 0xce, 0x6c, 0x77, 0x6d, 0xf1, 0x51, 0x3d, 0x37, 0xf0, 0xdb, 0x7f, 0xe6, 0x2e,
-	0x96, 0x6d, 0xbe, 0xef, 0xef, 0x69, 0x45, 0xe6, 0xc0, 0x29, 0x82, 0x9f, 0x05, 0xaf, 0x74, 0x2f,
-	0x2f, 0x30, 0x7b, 0x92, 0xc1, 0xd3, 0x47, 0x94, 0xf4, 0x82, 0x4b, 0x3f, 0x1c, 0xc4, 0xb9, 0xb6,
-	0xc8, 0xc1, 0x21, 0x1e, 0x9e, 0x90, 0x27, 0xce, 0x60, 0x3e, 0x37, 0x4c, 0x87, 0xf9, 0x2e, 0x07,
-	0xbf, 0x14, 0x84, 0xe6, 0x7f, 0xd4, 0x73, 0xb3, 0x5d, 0x68, 0x2e, 0xb8, 0xfc, 0xa8, 0x9e, 0x3b,
-	0xa3, 0x2c, 0x6e, 0x96, 0x47, 0xf5, 0x31, 0xb9, 0xb4, 0xe3, 0xf3, 0x55, 0xb9, 0x40, 0xde, 0xc5,
-	0x18, 0x5c, 0xc0, 0x7c, 0xfe, 0x2c, 0x7c, 0x00, 0x1e, 0xde, 0xf1, 0x39, 0xfd, 0x39, 0xc2, 0x73,
-	0xa1, 0x13, 0x36, 0x0e, 0x6c, 0x2e, 0x9c, 0x20, 0xa9, 0x36, 0x6b, 0xcc, 0x69, 0x8c, 0xe3, 0x99,
-	0xa6, 0x38, 0x86, 0x31, 0xfb, 0x06, 0x1e, 0x57, 0x56, 0xd9, 0x6e, 0xe0, 0xa4, 0x41, 0xe9, 0x24,
-	0xad, 0x37, 0x27, 0xe9, 0xa7, 0xa4, 0x98, 0x82, 0xab, 0x1c, 0x41, 0x3f, 0x41, 0xf8, 0x62, 0x67,
-	0x70, 0x10, 0xaa, 0x46, 0xaf, 0xa1, 0x67, 0xea, 0xb5, 0x0d, 0xfc, 0x42, 0x78, 0x80, 0x76, 0xcd,
-	0x9a, 0xe9, 0xf4, 0x95, 0xeb, 0xf4, 0x06, 0x3e, 0xd3, 0x22, 0x06, 0xac, 0x99, 0xc7, 0xc3, 0x55,
-	0xb9, 0x92, 0x56, 0x82, 0x75, 0xa0, 0xa1, 0x6f, 0xc3, 0x19, 0x7c, 0x87, 0x71, 0xb3, 0x22, 0xa4,
-	0xdd, 0xb2, 0xef, 0xfb, 0x76, 0xc9, 0xe6, 0x87, 0x7d, 0x5f, 0x0b, 0x9f, 0x22, 0x38, 0x1d, 0x49,
-	0x32, 0x01, 0xe4, 0x43, 0x3c, 0x5a, 0x09, 0x16, 0x3b, 0x7b, 0xfc, 0x2d, 0xe1, 0xf1, 0xa8, 0x9a,
-	0x84, 0x9c, 0xb4, 0xb7, 0x28, 0x84, 0x7c, 0x12, 0xe6, 0x26, 0xb8, 0x50, 0xa2, 0xec, 0xbf, 0xec,
-	0x50, 0x1f, 0x67, 0x5a, 0xe5, 0x80, 0x99, 0xdf, 0xc2, 0x27, 0xb9, 0x58, 0x36, 0x64, 0x76, 0x06,
-	0x11, 0x49, 0xb1, 0x74, 0x1a, 0x2c, 0x7d, 0x5e, 0x29, 0x8b, 0x33, 0x53, 0xfd, 0x04, 0x8f, 0x54,
-	0xd0, 0x3f, 0x20, 0xfc, 0x52, 0x4b, 0x0d, 0xba, 0xcd, 0xee, 0x3c, 0x30, 0xab, 0x5f, 0x8a, 0x1a,
-	0xfa, 0x19, 0xc2, 0x2f, 0x77, 0xc0, 0x0f, 0x4e, 0xfc, 0xa0, 0xb7, 0xe3, 0xb9, 0x01, 0x2e, 0x9c,
-	0x0c, 0x5c, 0x18, 0xb0, 0xd2, 0x3e, 0xcf, 0x2c, 0xd9, 0xc6, 0x58, 0x85, 0x00, 0xaa, 0x6a, 0x3f,
-	0xf5, 0x69, 0x54, 0x49, 0x10, 0x25, 0xe0, 0xbf, 0x08, 0x2e, 0xd1, 0x3b, 0x55, 0xc6, 0x77, 0x6b,
-	0x76, 0xb1, 0xaf, 0xab, 0x98, 0x6c, 0xe0, 0x09, 0x61, 0xbc, 0x61, 0x7a, 0x9e, 0xc5, 0x8d, 0x92,
-	0xe5, 0x32, 0x07, 0xb0, 0x4d, 0x47, 0x57, 0x46, 0x33, 0x05, 0xd5, 0xc7, 0xc4, 0xd2, 0xaa, 0x58,
-	0x79, 0x4b, 0x2c, 0x90, 0x9b, 0x78, 0xf2, 0xbe, 0xcf, 0x78, 0xa3, 0x9c, 0x63, 0x52, 0xce, 0xb9,
-	0xa3, 0x7a, 0x2e, 0xa3, 0xe4, 0xb4, 0x90, 0x50, 0x7d, 0x5c, 0xae, 0x45, 0x92, 0xc4, 0xa1, 0xda,
-	0x1a, 0x1a, 0x19, 0x9a, 0x38, 0xae, 0x9f, 0x78, 0x60, 0xf3, 0x7d, 0x11, 0xc9, 0x4d, 0xcb, 0xa2,
-	0x7f, 0x44, 0x78, 0x3a, 0x6a, 0xbd, 0xbe, 0x69, 0xf3, 0xfd, 0x4d, 0xbb, 0xc2, 0xad, 0x5a, 0x60,
-	0xf4, 0x35, 0x7c, 0xca, 0xb1, 0x5d, 0x23, 0x5e, 0x0e, 0x84, 0xf2, 0xcc, 0x51, 0x3d, 0x37, 0xa5,
-	0x94, 0x37, 0x6c, 0x53, 0xfd, 0xa4, 0x63, 0xbb, 0x61, 0x45, 0x21, 0xd3, 0xf1, 0xc6, 0x43, 0xda,
-	0x1f, 0xb5, 0x18, 0x4d, 0xed, 0xe3, 0xb1, 0xbe, 0xdb, 0xc7, 0x5f, 0x22, 0x7c, 0x2e, 0xd9, 0x86,
-	0x2f, 0x48, 0x23, 0xa9, 0xc3, 0xb5, 0x12, 0x4b, 0x29, 0x40, 0xb6, 0x8c, 0xb1, 0x57, 0x65, 0xdc,
-	0xa8, 0x8a, 0x55, 0xf0, 0xed, 0xe9, 0xe8, 0x78, 0x44, 0x7b, 0x54, 0x1f, 0xf5, 0x02, 0x6e, 0x59,
-	0x20, 0x7f, 0x30, 0x88, 0xcf, 0x2b, 0xa1, 0x0f, 0xcc, 0xea, 0xc6, 0x81, 0x59, 0x84, 0x2e, 0xa3,
-	0xe0, 0x06, 0xa1, 0x7b, 0x05, 0x0f, 0x7b, 0x96, 0x5b, 0xb2, 0x6a, 0x20, 0x77, 0xf2, 0xa8, 0x9e,
-	0x3b, 0x05, 0x72, 0xe5, 0x3a, 0xd5, 0x81, 0x20, 0x9e, 0xda, 0x83, 0x1d, 0x53, 0x5b, 0xc3, 0xaa,
-	0x4e, 0x88, 0x22, 0xa4, 0x52, 0xf1, 0xf9, 0xa3, 0x7a, 0x6e, 0x3c, 0x76, 0xa0, 0x0d, 0xdb, 0xa5,
-	0xfa, 0x73, 0xf2, 0x6f, 0xc1, 0x25, 0xdf, 0xc5, 0xc3, 0xf2, 0x7d, 0xe6, 0x65, 0x86, 0xa4, 0xfb,
-	0x35, 0x2d, 0x78, 0x1a, 0xc6, 0xde, 0x73, 0xa1, 0x13, 0x85, 0x39, 0xa1, 0x25, 0x82, 0x6d, 0xed,
-	0x34, 0x94, 0x0c, 0xc0, 0xae, 0x64, 0x51, 0x1d, 0x84, 0x4a, 0x67, 0xfc, 0x2c, 0x68, 0x56, 0x13,
-	0x9c, 0x11, 0x75, 0x7c, 0x0a, 0xdb, 0xff, 0xaf, 0xe3, 0x6b, 0x96, 0x47, 0xf5, 0x31, 0xb9, 0x14,
-	0x76, 0x7c, 0x12, 0xdb, 0xc7, 0x83, 0xc9, 0xd8, 0x76, 0x7c, 0xfe, 0xac, 0x23, 0xf5, 0xbd, 0xd0,
-	0xf3, 0xc7, 0xa4, 0xe7, 0xf3, 0x5d, 0x7a, 0x5e, 0x40, 0xeb, 0xc2, 0xf5, 0xe2, 0x59, 0x11, 0xfa,
-	0x20, 0x33, 0xd4, 0xfc, 0xac, 0x08, 0xb7, 0x28, 0x5c, 0x2c, 0x3b, 0xbe, 0xf2, 0xc8, 0x4f, 0x83,
-	0x16, 0x24, 0xc9, 0x23, 0x10, 0xae, 0x2a, 0x1e, 0x0f, 0x52, 0xa9, 0x31, 0x5a, 0x37, 0x7b, 0x8e,
-	0xd6, 0x0b, 0x8d, 0x99, 0x19, 0x06, 0xeb, 0x14, 0x24, 0x68, 0x2c, 0x56, 0xe7, 0x70, 0x36, 0xea,
-	0x16, 0x9a, 0x7b, 0x2d, 0xfa, 0x8b, 0xa0, 0x56, 0x36, 0x6f, 0x7f, 0x21, 0xda, 0x26, 0x5a, 0xc6,
-	0x97, 0xd4, 0x95, 0xcd, 0xdc, 0xa2, 0xe5, 0xf2, 0x9a, 0xc9, 0xad, 0x92, 0xac, 0x67, 0xa5, 0x5b,
-	0xb6, 0x7b, 0x4f, 0x74, 0xd6, 0xeb, 0x9b, 0xdb, 0xdb, 0x41, 0xce, 0x7d, 0x0d, 0x9f, 0x2c, 0xde,
-	0x75, 0xd4, 0x6b, 0x34, 0xba, 0xd2, 0xce, 0x44, 0xdd, 0x4d, 0x7c, 0x97, 0xea, 0x58, 0x7c, 0x2a,
-	0x69, 0xd4, 0xc0, 0x97, 0xbb, 0x52, 0x04, 0x6e, 0x59, 0xc4, 0x53, 0xc5, 0x18, 0x65, 0xa3, 0x46,
-	0x9d, 0x14, 0x5b, 0xa4, 0xd0, 0xb9, 0xa0, 0xf9, 0xd8, 0xdc, 0xde, 0x6e, 0x56, 0x22, 0x54, 0x04,
-	0xdd, 0x13, 0x7d, 0x88, 0x67, 0x3b, 0x11, 0x02, 0x88, 0x3b, 0x78, 0xd2, 0xb1, 0xcb, 0x35, 0x59,
-	0x8f, 0x8d, 0x9a, 0x55, 0x64, 0xb5, 0x52, 0xd0, 0xf0, 0xcd, 0x6a, 0x49, 0xa3, 0x2a, 0x6d, 0x3b,
-	0x20, 0xd7, 0x15, 0xb5, 0x3e, 0xe1, 0x34, 0xad, 0x5c, 0xf9, 0x28, 0x83, 0x8f, 0x4b, 0xfd, 0xe4,
-	0x03, 0x2c, 0xaf, 0x0e, 0x8f, 0xcc, 0x25, 0x0b, 0x6b, 0x99, 0x9d, 0x64, 0x2f, 0x76, 0x26, 0x54,
-	0xd0, 0xe9, 0x57, 0x3e, 0xfc, 0xeb, 0xbf, 0x7f, 0x34, 0x78, 0x9e, 0x4c, 0xe7, 0x13, 0x27, 0x47,
-	0xea, 0xae, 0xfa, 0x18, 0xe1, 0x91, 0x60, 0x16, 0x41, 0x2e, 0xa5, 0xc8, 0x6e, 0x1a, 0x66, 0x64,
-	0x2f, 0x77, 0x45, 0x0b, 0x50, 0x2e, 0x49, 0x28, 0x2f, 0x92, 0x5c, 0x32, 0x94, 0x70, 0xba, 0xf1,
-	0xd1, 0x20, 0x22, 0x9f, 0x22, 0x3c, 0xd6, 0x78, 0x50, 0xc8, 0x62, 0x8a, 0xae, 0xc4, 0x23, 0x97,
-	0x5d, 0xea, 0x81, 0x03, 0x30, 0x2e, 0x48, 0x8c, 0x73, 0xe4, 0xe5, 0x64, 0x8c, 0xaa, 0x69, 0x0f,
-	0x4f, 0x0d, 0xf9, 0x35, 0xc2, 0xe3, 0x4d, 0x7d, 0x03, 0x59, 0xea, 0x14, 0x9b, 0x96, 0x3e, 0x29,
-	0x7b, 0xa5, 0x17, 0x16, 0x40, 0x3a, 0x2f, 0x91, 0xce, 0x92, 0x97, 0x92, 0x91, 0xde, 0x95, 0xd4,
-	0x70, 0x60, 0x3c, 0xf2, 0x7d, 0x84, 0x87, 0x84, 0x24, 0x32, 0xdb, 0x41, 0x55, 0x00, 0x69, 0xae,
-	0x23, 0x1d, 0xe0, 0x58, 0x4c, 0xf7, 0x98, 0x54, 0x9f, 0x7f, 0x1f, 0x8e, 0xed, 0x43, 0x11, 0xdb,
-	0x4f, 0x10, 0x1e, 0x09, 0x86, 0x4c, 0xa9, 0xd9, 0xd6, 0x34, 0xce, 0x4a, 0xcd, 0xb6, 0xe6, 0xa9,
-	0x15, 0x5d, 0x92, 0xb8, 0x2e, 0x93, 0x57, 0xda, 0xe3, 0x92, 0x8d, 0x65, 0x84, 0x8d, 0xfc, 0x04,
-	0xe1, 0x4c, 0xbb, 0x27, 0x0b, 0x59, 0x49, 0x51, 0xde, 0xe1, 0x9d, 0x96, 0xfd, 0x7a, 0x5f, 0xbc,
-	0x60, 0xc8, 0x00, 0xf9, 0x13, 0xc2, 0xa4, 0x75, 0x1c, 0x45, 0x96, 0xbb, 0x94, 0xda, 0x88, 0xe5,
-	0xd5, 0x1e, 0xb9, 0x00, 0xc5, 0x75, 0xe9, 0xce, 0x15, 0xf2, 0xd5, 0xae, 0xc2, 0x9c, 0x7f, 0x97,
-	0xd9, 0xae, 0x21, 0xa7, 0xeb, 0x96, 0xb8, 0xa2, 0x0d, 0xdb, 0x25, 0xff, 0x41, 0x78, 0x3a, 0x65,
-	0x64, 0x43, 0xae, 0x75, 0x00, 0x96, 0x3e, 0x87, 0xca, 0xbe, 0xd1, 0x2f, 0x3b, 0x18, 0x78, 0x43,
-	0x1a, 0xb8, 0x4a, 0xde, 0xec, 0xce, 0x40, 0xeb, 0xc0, 0xe6, 0xca, 0x40, 0x35, 0xe4, 0x52, 0x7d,
-	0x81, 0xb0, 0xf3, 0x57, 0x08, 0xe3, 0x68, 0x76, 0x43, 0xe6, 0x3b, 0x24, 0x6d, 0xc3, 0xa4, 0x28,
-	0xbb, 0xd0, 0x25, 0x35, 0x80, 0x5e, 0x96, 0xa0, 0x35, 0x32, 0xdf, 0x1d, 0x68, 0x35, 0x18, 0x22,
-	0x8f, 0x10, 0x26, 0xad, 0x03, 0x9c, 0xd4, 0x7c, 0x6a, 0x3b, 0x43, 0x4a, 0xcd, 0xa7, 0xf6, 0x53,
-	0x22, 0xba, 0x21, 0x91, 0xbf, 0x4e, 0x56, 0xba, 0x43, 0xae, 0x0a, 0xaf, 0xfc, 0x0c, 0xab, 0xaf,
-	0xa8, 0x25, 0xbf, 0x41, 0xf8, 0x44, 0x6c, 0x3a, 0x43, 0x16, 0x3a, 0xa1, 0x69, 0x4c, 0x1a, 0xad,
-	0x5b, 0x72, 0x40, 0xbd, 0x22, 0x51, 0x2f, 0x93, 0x2b, 0xbd, 0xa0, 0x56, 0xe3, 0x01, 0x91, 0x17,
-	0xa3, 0xe1, 0x1b, 0x8e, 0xa4, 0xd5, 0xb2, 0xe6, 0xe1, 0x41, 0x76, 0xbe, 0x3b, 0x62, 0x00, 0xf9,
-	0x5a, 0x8f, 0x49, 0x21, 0x98, 0xe5, 0xa5, 0xfb, 0x18, 0xe1, 0xb3, 0x1b, 0x1e, 0xb7, 0x1d, 0x93,
-	0x5b, 0x2d, 0x6f, 0x21, 0x72, 0x35, 0x0d, 0x44, 0x9b, 0x67, 0x64, 0x76, 0xb9, 0x37, 0x26, 0xb0,
-	0xe0, 0xa6, 0xb4, 0xe0, 0x4d, 0x72, 0x2d, 0xd9, 0x82, 0xd8, 0x29, 0x04, 0xb4, 0xf9, 0x58, 0xa9,
-	0x09, 0x4f, 0xa2, 0x30, 0xe9, 0x6f, 0x08, 0x67, 0xdb, 0x98, 0xb4, 0xe3, 0x73, 0xd2, 0x03, 0xbc,
-	0xe8, 0xc5, 0x95, 0x9a, 0xf2, 0xed, 0x5f, 0x25, 0xb4, 0x20, 0xad, 0xba, 0x4e, 0xde, 0xf8, 0x1c,
-	0x56, 0x31, 0x9f, 0x0b, 0xb3, 0x3e, 0x43, 0x78, 0x26, 0xbd, 0x81, 0x26, 0xd7, 0xd3, 0xea, 0x61,
-	0x37, 0x4d, 0x7e, 0x76, 0xf5, 0x73, 0x48, 0x00, 0x93, 0x77, 0xa5, 0xc9, 0x5b, 0xe4, 0x66, 0xb2,
-	0xc9, 0x49, 0x9d, 0xbd, 0x51, 0xb1, 0xdd, 0x7b, 0xc6, 0xdd, 0x1a, 0x73, 0x0c, 0xf1, 0x6a, 0xc8,
-	0xbf, 0x1f, 0x7f, 0x4a, 0x3c, 0x24, 0x7f, 0x41, 0xf8, 0x6c, 0xdb, 0x86, 0x9d, 0xa4, 0x5e, 0xb4,
-	0x1d, 0xde, 0x03, 0xd9, 0xd7, 0xfb, 0x63, 0xee, 0xae, 0x34, 0x48, 0x2b, 0x5a, 0xed, 0x15, 0xc6,
-	0x7a, 0x6b, 0x5b, 0x8f, 0x9e, 0xcc, 0xa0, 0xc7, 0x4f, 0x66, 0xd0, 0xbf, 0x9e, 0xcc, 0xa0, 0x1f,
-	0x3e, 0x9d, 0x19, 0x78, 0xfc, 0x74, 0x66, 0xe0, 0xef, 0x4f, 0x67, 0x06, 0xbe, 0xbd, 0x18, 0x7b,
-	0xcb, 0x81, 0xdc, 0x85, 0x8a, 0xb9, 0xe7, 0x85, 0x4a, 0xde, 0x5b, 0x7a, 0x2d, 0x7f, 0xa0, 0x54,
-	0xc9, 0x97, 0xdd, 0xde, 0xb0, 0x9c, 0x4b, 0x5d, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc,
-	0xa6, 0x71, 0x9c, 0x65, 0x1f, 0x00, 0x00,
+	// 2178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4b, 0x6c, 0x1c, 0xc7,
+	0x11, 0x65, 0x53, 0x14, 0x4d, 0x16, 0xcd, 0x5f, 0x9b, 0x12, 0x97, 0x43, 0x89, 0x2b, 0xb7, 0x6d,
+	0x52, 0x16, 0xc9, 0x5d, 0x52, 0xa2, 0xe0, 0x98, 0xb1, 0x6c, 0x91, 0x14, 0x29, 0x91, 0x10, 0x25,
+	0x7a, 0x24, 0x24, 0x48, 0x82, 0x64, 0x30, 0xdc, 0x1d, 0x2e, 0xc7, 0xda, 0x99, 0x59, 0xed, 0xf4,
+	0x5a, 0x24, 0x0c, 0xc1, 0x80, 0x4f, 0x49, 0x2e, 0x36, 0x10, 0xc7, 0x41, 0x3e, 0x48, 0x2e, 0x46,
+	0x10, 0xe4, 0x1c, 0x20, 0xa7, 0x1c, 0x82, 0x5c, 0x84, 0x9c, 0x04, 0x27, 0x87, 0x20, 0x40, 0xe8,
+	0x40, 0x4a, 0x6e, 0x39, 0xf1, 0xe2, 0x6b, 0xd0, 0xdd, 0x35, 0x9f, 0xfd, 0x7f, 0x1c, 0x01, 0xce,
+	0x89, 0x3b, 0xdd, 0x55, 0xd5, 0xaf, 0x3e, 0x5d, 0x5d, 0x55, 0x84, 0x73, 0x9e, 0xef, 0x78, 0xbe,
+	0xed, 0xa7, 0x73, 0xa6, 0xe3, 0xa4, 0xdf, 0x5d, 0xdc, 0xb5, 0xb8, 0xb9, 0x98, 0xbe, 0x5f, 0xb2,
+	0x8a, 0x87, 0xa9, 0x42, 0xd1, 0xe3, 0x1e, 0x1d, 0x43, 0x8a, 0x94, 0xa0, 0x48, 0x21, 0x85, 0x36,
+	0x96, 0xf3, 0x72, 0x9e, 0x24, 0x48, 0x8b, 0x5f, 0x8a, 0x56, 0x3b, 0x5b, 0x53, 0x1a, 0x3f, 0xc0,
+	0xed, 0xb9, 0x60, 0xbb, 0xe0, 0x79, 0x79, 0xc7, 0x74, 0xcd, 0x9c, 0x55, 0x0c, 0xa9, 0xfc, 0x07,
+	0x66, 0xc1, 0x28, 0x7a, 0x25, 0x6e, 0x21, 0xf5, 0x54, 0x46, 0x92, 0xa7, 0x77, 0x4d, 0xdf, 0x0a,
+	0xa9, 0x32, 0x9e, 0xed, 0xe2, 0xfe, 0x85, 0xf8, 0xbe, 0x44, 0x1c, 0x52, 0x15, 0xcc, 0x9c, 0xed,
+	0x9a, 0xdc, 0xf6, 0x02, 0xda, 0x33, 0x39, 0xcf, 0xcb, 0xe5, 0xad, 0xb4, 0x59, 0xb0, 0xd3, 0xa6,
+	0xeb, 0x7a, 0x5c, 0x6e, 0xfa, 0xb8, 0x3b, 0x81, 0xbb, 0xf2, 0x6b, 0xb7, 0xb4, 0x97, 0x36, 0x5d,
+	0xd4, 0x5e, 0x4b, 0x56, 0x6e, 0x71, 0xdb, 0xb1, 0x7c, 0x6e, 0x3a, 0x85, 0x80, 0x57, 0xa1, 0x30,
+	0x94, 0x2d, 0xd4, 0x07, 0x6e, 0xbd, 0x58, 0xd3, 0x1a, 0xfe, 0xbe, 0x59, 0xb4, 0xb2, 0x8a, 0x84,
+	0xad, 0xc1, 0xc8, 0xdb, 0x02, 0xf9, 0x8e, 0xe7, 0xe5, 0x75, 0xeb, 0x7e, 0xc9, 0xf2, 0x39, 0x9d,
+	0x85, 0xe7, 0x84, 0x7d, 0x0c, 0x3b, 0x9b, 0x20, 0xe7, 0xc8, 0xf9, 0x9e, 0x55, 0x7a, 0x7c, 0x94,
+	0x1c, 0x3a, 0x34, 0x9d, 0xfc, 0x32, 0xc3, 0x0d, 0xa6, 0xf7, 0x8a, 0x5f, 0x9b, 0xd9, 0xe5, 0xee,
+	0x04, 0x61, 0x37, 0x61, 0x34, 0x26, 0xc4, 0x2f, 0x78, 0xae, 0x6f, 0xd1, 0x4b, 0xd0, 0x23, 0x48,
+	0xa4, 0x88, 0x81, 0x8b, 0x63, 0x29, 0xa5, 0x47, 0x2a, 0xd0, 0x23, 0xb5, 0xe2, 0x1e, 0xae, 0xf6,
+	0xff, 0xf9, 0x77, 0xf3, 0x27, 0x05, 0xd7, 0xa6, 0x2e, 0x89, 0xa5, 0xb4, 0xef, 0xc4, 0xa4, 0xf9,
+	0x01, 0xa6, 0x0d, 0x80, 0xc8, 0xa6, 0x89, 0x6e, 0x29, 0x73, 0x3a, 0x85, 0xda, 0x0a, 0x07, 0xa4,
+	0x54, 0xc8, 0xa0, 0x92, 0xa9, 0x1d, 0x33, 0x67, 0x21, 0xaf, 0x1e, 0xe3, 0x64, 0x1f, 0x13, 0xa0,
+	0x71, 0xe9, 0x08, 0xf6, 0x32, 0x9c, 0x14, 0xe7, 0xfb, 0x09, 0x72, 0xee, 0x44, 0x2b, 0x68, 0x15,
+	0x35, 0xbd, 0x5e, 0x03, 0xd5, 0x4c, 0x53, 0x54, 0xea, 0xcc, 0x32, 0x58, 0x1a, 0x8c, 0x49, 0x54,
+	0xb7, 0x4a, 0x4e, 0x5c, 0x6d, 0x69, 0x8f, 0x5b, 0x70, 0xaa, 0x62, 0x0f, 0x41, 0x2f, 0x42, 0xbf,
+	0x5b, 0x72, 0x8c, 0x00, 0xb8, 0xf0, 0xd4, 0xd8, 0xf1, 0x51, 0x72, 0x44, 0x79, 0x2a, 0xdc, 0x62,
+	0x7a, 0x9f, 0x8b, 0xac, 0x52, 0xde, 0x1a, 0x9e, 0x25, 0x56, 0xee, 0x1e, 0x16, 0xac, 0x4e, 0xdc,
+	0xce, 0xb6, 0xe0, 0x54, 0x85, 0x90, 0x08, 0x94, 0x24, 0xe6, 0x87, 0x05, 0x4b, 0xca, 0xe9, 0x8f,
+	0x83, 0x0a, 0xb7, 0x98, 0xde, 0x57, 0x40, 0x56, 0xf6, 0x7b, 0x02, 0x53, 0x52, 0xd8, 0x9a, 0x99,
+	0xcf, 0x6c, 0x79, 0xb6, 0x2b, 0x84, 0xde, 0x11, 0x51, 0xea, 0x77, 0x82, 0x8d, 0xee, 0x43, 0x3f,
+	0xf7, 0xee, 0x59, 0xae, 0x6f, 0xd8, 0xc2, 0x29, 0xc2, 0xa1, 0x13, 0x65, 0x4e, 0x09, 0xdc, 0xb1,
+	0xe6, 0xd9, 0xee, 0xea, 0xc2, 0xa3, 0xa3, 0x64, 0xd7, 0x6f, 0x3f, 0x4f, 0x9e, 0xcf, 0xd9, 0x7c,
+	0xbf, 0xb4, 0x9b, 0xca, 0x78, 0x0e, 0xde, 0x22, 0xfc, 0x33, 0xef, 0x67, 0xef, 0xa5, 0x05, 0x66,
+	0x5f, 0x32, 0xf8, 0x7a, 0x9f, 0x92, 0xbe, 0xe9, 0xb2, 0x0f, 0xba, 0x21, 0x59, 0x17, 0x39, 0x1a,
+	0xc4, 0x87, 0x11, 0x79, 0xe3, 0x0c, 0xaf, 0xc4, 0x0d, 0xd3, 0xf1, 0x4a, 0x2e, 0x47, 0xbb, 0x6c,
+	0x8a, 0x93, 0xff, 0x7e, 0x94, 0x9c, 0x6e, 0xe1, 0xe4, 0x4d, 0x97, 0x1f, 0x1f, 0x25, 0xc7, 0x95,
+	0xc6, 0x95, 0xf2, 0x98, 0x3e, 0x24, 0x97, 0x6e, 0x97, 0xf8, 0x8a, 0x5c, 0xa0, 0xef, 0x00, 0xa0,
+	0x09, 0xbc, 0x12, 0x7f, 0x16, 0x36, 0x40, 0x0b, 0xdf, 0x2e, 0x71, 0xf6, 0x33, 0x02, 0x33, 0xa1,
+	0x11, 0xd6, 0x0f, 0x6c, 0x2e, 0x8c, 0x20, 0xa9, 0x36, 0x8a, 0x9e, 0x53, 0xee, 0xc7, 0xf1, 0x0a,
+	0x3f, 0x86, 0x3e, 0xfb, 0x06, 0x0c, 0x2b, 0xad, 0x6c, 0x37, 0x30, 0x52, 0xb7, 0x34, 0x52, 0xaa,
+	0x3d, 0x23, 0xe9, 0x83, 0x52, 0xcc, 0xa6, 0xab, 0x0c, 0xc1, 0x3e, 0x21, 0x70, 0xbe, 0x39, 0x38,
+	0x74, 0x55, 0xb9, 0xd5, 0xc8, 0x33, 0xb5, 0xda, 0x3a, 0x9c, 0x0e, 0x2f, 0xd0, 0x8e, 0x59, 0x34,
+	0x9d, 0x8e, 0x62, 0x9d, 0x5d, 0x87, 0xf1, 0x2a, 0x31, 0xa8, 0xcd, 0x1c, 0xf4, 0x16, 0xe4, 0x4a,
+	0xa3, 0x14, 0xac, 0x23, 0x0d, 0x7b, 0x1b, 0xef, 0xe0, 0x5d, 0x8f, 0x9b, 0x79, 0x21, 0xed, 0xa6,
+	0x7d, 0xbf, 0x64, 0x67, 0x6d, 0x7e, 0xd8, 0xf1, 0xb3, 0xf0, 0x29, 0x81, 0x64, 0x5d, 0x99, 0x08,
+	0xf2, 0x21, 0xf4, 0xe7, 0x83, 0xc5, 0xe6, 0x16, 0xbf, 0x26, 0x2c, 0x1e, 0x65, 0x93, 0x90, 0x93,
+	0xb5, 0xe7, 0x85, 0x90, 0x4f, 0xc2, 0xdc, 0x80, 0xf1, 0x08, 0x65, 0xe7, 0x69, 0x87, 0x95, 0x20,
+	0x51, 0x2d, 0x07, 0xd5, 0xfc, 0x16, 0x3c, 0xcf, 0xc5, 0xb2, 0x21, 0xa3, 0x33, 0xf0, 0x48, 0x03,
+	0x4d, 0x27, 0x51, 0xd3, 0x17, 0xd4, 0x61, 0x71, 0x66, 0xa6, 0x0f, 0xf0, 0xe8, 0x08, 0xf6, 0x07,
+	0x02, 0x2f, 0x57, 0xe5, 0xa0, 0x5b, 0xde, 0x9d, 0x07, 0x66, 0xe1, 0xff, 0x22, 0x87, 0x7e, 0x41,
+	0xe0, 0x95, 0x26, 0xf8, 0xd1, 0x88, 0xef, 0xb7, 0x77, 0x3d, 0xd7, 0xd1, 0x84, 0xa3, 0x81, 0x09,
+	0x03, 0x56, 0xd6, 0xe1, 0x9d, 0xa5, 0xdb, 0x00, 0xca, 0x05, 0x98, 0x55, 0x3b, 0xc9, 0x4f, 0xfd,
+	0x4a, 0x82, 0x48, 0x01, 0xff, 0x21, 0xf8, 0x88, 0xde, 0x29, 0x78, 0x7c, 0xa7, 0x68, 0x67, 0x3a,
+	0x7a, 0x8a, 0xe9, 0x3a, 0x8c, 0x08, 0xe5, 0x0d, 0xd3, 0xf7, 0x2d, 0x6e, 0x64, 0x2d, 0xd7, 0x73,
+	0x10, 0xdb, 0x64, 0xf4, 0x64, 0x54, 0x52, 0x30, 0x7d, 0x48, 0x2c, 0xad, 0x88, 0x95, 0x6b, 0x62,
+	0x81, 0xde, 0x80, 0xd1, 0xfb, 0x25, 0x8f, 0x97, 0xcb, 0x39, 0x21, 0xe5, 0x9c, 0x39, 0x3e, 0x4a,
+	0x26, 0x94, 0x9c, 0x2a, 0x12, 0xa6, 0x0f, 0xcb, 0xb5, 0x48, 0x92, 0xb8, 0x54, 0x5b, 0x3d, 0x7d,
+	0x3d, 0x23, 0x27, 0xf5, 0x81, 0x07, 0x36, 0xdf, 0x17, 0x9e, 0xdc, 0xb0, 0x2c, 0xf6, 0x47, 0x02,
+	0x93, 0x51, 0xe9, 0xf5, 0x4d, 0x9b, 0xef, 0x6f, 0xd8, 0x79, 0x6e, 0x15, 0x03, 0xa5, 0xaf, 0xc0,
+	0xa0, 0x63, 0xbb, 0x46, 0x3c, 0x1d, 0x88, 0xc3, 0x13, 0xc7, 0x47, 0xc9, 0x31, 0x75, 0x78, 0xd9,
+	0x36, 0xd3, 0x9f, 0x77, 0x6c, 0x37, 0xcc, 0x28, 0x74, 0x32, 0x5e, 0x78, 0x48, 0xfd, 0xa3, 0x12,
+	0xa3, 0xa2, 0x7c, 0x3c, 0xd1, 0x71, 0xf9, 0xf8, 0x4b, 0x02, 0x67, 0x6a, 0xeb, 0xf0, 0x15, 0x29,
+	0x24, 0x75, 0x38, 0x5d, 0x19, 0x52, 0x88, 0x6c, 0x09, 0xc0, 0x2f, 0x78, 0xdc, 0x28, 0x88, 0x55,
+	0xb4, 0xed, 0xa9, 0xe8, 0x7a, 0x44, 0x7b, 0x4c, 0xef, 0xf7, 0x03, 0x6e, 0x99, 0x20, 0x7f, 0xd8,
+	0x0d, 0x67, 0x95, 0xd0, 0x07, 0x66, 0x61, 0xfd, 0xc0, 0xcc, 0x60, 0x95, 0xb1, 0xe9, 0x06, 0xae,
+	0x7b, 0x15, 0x7a, 0x7d, 0xcb, 0xcd, 0x5a, 0x45, 0x94, 0x3b, 0x7a, 0x7c, 0x94, 0x1c, 0x44, 0xb9,
+	0x72, 0x9d, 0xe9, 0x48, 0x10, 0x0f, 0xed, 0xee, 0xa6, 0xa1, 0x9d, 0x02, 0x95, 0x27, 0x0c, 0x5b,
+	0x39, 0xad, 0x7f, 0xf5, 0x85, 0xe3, 0xa3, 0xe4, 0x70, 0xec, 0x42, 0x1b, 0xb6, 0xcb, 0xf4, 0xe7,
+	0xe4, 0xcf, 0x4d, 0x97, 0x7e, 0x17, 0x7a, 0x65, 0x03, 0xe7, 0x27, 0x7a, 0xa4, 0xf9, 0x53, 0xa9,
+	0xa0, 0x77, 0x8c, 0x35, 0x7c, 0xa1, 0x11, 0x85, 0x3a, 0xa1, 0x26, 0x82, 0x6d, 0xf5, 0x14, 0xa6,
+	0x0c, 0xc4, 0xae, 0x64, 0x31, 0x1d, 0x85, 0x4a, 0x63, 0xfc, 0x34, 0x28, 0x56, 0x6b, 0x18, 0x23,
+	0xaa, 0xf8, 0x14, 0xb6, 0xff, 0x5d, 0xc5, 0x57, 0x29, 0x8f, 0xe9, 0x43, 0x72, 0x29, 0xac, 0xf8,
+	0x24, 0xb6, 0x0f, 0xbb, 0x6b, 0x63, 0xbb, 0x5d, 0xe2, 0xcf, 0xda, 0x53, 0xdf, 0x0b, 0x2d, 0x7f,
+	0x42, 0x5a, 0x3e, 0xdd, 0xa2, 0xe5, 0x05, 0xb4, 0x16, 0x4c, 0x2f, 0xda, 0x8a, 0xd0, 0x06, 0x89,
+	0x9e, 0xca, 0xb6, 0x22, 0xdc, 0x62, 0xf8, 0xb0, 0xdc, 0x2e, 0x29, 0x8b, 0xfc, 0x24, 0x28, 0x41,
+	0x6a, 0x59, 0x04, 0xdd, 0x55, 0x80, 0xe1, 0x20, 0x94, 0xca, 0xbd, 0x75, 0xa3, 0x6d, 0x6f, 0x9d,
+	0x2e, 0x8f, 0xcc, 0xd0, 0x59, 0x83, 0x18, 0xa0, 0x31, 0x5f, 0x9d, 0x01, 0x2d, 0xaa, 0x16, 0x2a,
+	0x6b, 0x2d, 0xf6, 0x8b, 0x20, 0x57, 0x56, 0x6e, 0x7f, 0x25, 0xca, 0x26, 0x96, 0x83, 0x0b, 0xea,
+	0xc9, 0xf6, 0xdc, 0x8c, 0xe5, 0xf2, 0xa2, 0xc9, 0xad, 0xac, 0xcc, 0x67, 0xd9, 0x9b, 0xb6, 0x7b,
+	0x4f, 0x54, 0xd6, 0x6b, 0x1b, 0xdb, 0xdb, 0x41, 0xcc, 0xbd, 0x0e, 0xcf, 0x67, 0xf6, 0x1c, 0xc7,
+	0x08, 0xa2, 0x49, 0x3d, 0x69, 0xe3, 0x51, 0x75, 0x13, 0xdf, 0x65, 0x3a, 0x88, 0x4f, 0x25, 0x8d,
+	0x19, 0x30, 0xdb, 0xd2, 0x41, 0x68, 0x96, 0x05, 0x18, 0xcb, 0xc4, 0x28, 0xcb, 0x4f, 0xd4, 0x69,
+	0xa6, 0x4a, 0x0a, 0x9b, 0x09, 0x8a, 0x8f, 0x8d, 0xed, 0xed, 0xca, 0x43, 0xc4, 0x11, 0x41, 0xf5,
+	0xc4, 0x1e, 0xc2, 0x74, 0x33, 0x42, 0x04, 0x71, 0x07, 0x46, 0x1d, 0x3b, 0x57, 0x94, 0xf9, 0xd8,
+	0x28, 0x5a, 0x19, 0xaf, 0x98, 0x0d, 0x0a, 0xbe, 0xe9, 0x54, 0xad, 0x59, 0x56, 0x6a, 0x3b, 0x20,
+	0xd7, 0x15, 0xb5, 0x3e, 0xe2, 0x54, 0xac, 0xb0, 0x9f, 0x13, 0x78, 0x49, 0x05, 0x32, 0x37, 0x77,
+	0xf3, 0x96, 0x2f, 0x2f, 0x50, 0x21, 0x6f, 0xef, 0xd9, 0x19, 0xa4, 0xeb, 0xa0, 0x72, 0xb8, 0x0e,
+	0x3d, 0xdc, 0x76, 0x2c, 0x7c, 0x6f, 0xb4, 0xaa, 0xb7, 0xea, 0x6e, 0x30, 0x6a, 0x5a, 0x1d, 0xc7,
+	0x08, 0x1a, 0xc0, 0x00, 0xb7, 0x1d, 0x8b, 0x7d, 0xf4, 0x79, 0x92, 0xe8, 0x52, 0x00, 0xfb, 0x38,
+	0xa8, 0x41, 0xeb, 0xa2, 0x43, 0xdb, 0xe4, 0x61, 0xd0, 0x8c, 0x6f, 0xe0, 0x4d, 0xdb, 0x68, 0xe3,
+	0xa6, 0x5d, 0xb3, 0x32, 0x51, 0x45, 0x50, 0x26, 0x8c, 0xe9, 0xe5, 0xc2, 0x2f, 0xfe, 0x63, 0x02,
+	0x4e, 0x4a, 0x58, 0xf4, 0x7d, 0x90, 0xef, 0xad, 0x4f, 0x67, 0x6a, 0x7b, 0xa0, 0x6a, 0xe0, 0xa4,
+	0x9d, 0x6f, 0x4e, 0xa8, 0x74, 0x62, 0x2f, 0x7d, 0xf0, 0x97, 0x7f, 0xfd, 0xa8, 0xfb, 0x2c, 0x9d,
+	0x4c, 0xd7, 0x1c, 0xb7, 0xa9, 0x07, 0xfe, 0x43, 0x02, 0x7d, 0xc1, 0x00, 0x87, 0x5e, 0x68, 0x20,
+	0xbb, 0x62, 0x02, 0xa4, 0xcd, 0xb6, 0x44, 0x8b, 0x50, 0x2e, 0x48, 0x28, 0x2f, 0xd2, 0x64, 0x6d,
+	0x28, 0xe1, 0x48, 0xe8, 0xfb, 0xdd, 0x84, 0x7e, 0x4a, 0x60, 0xa8, 0x3c, 0xbb, 0xd0, 0x85, 0x06,
+	0x67, 0xd5, 0xcc, 0x53, 0xda, 0x62, 0x1b, 0x1c, 0x88, 0x71, 0x5e, 0x62, 0x9c, 0xa1, 0xaf, 0xd4,
+	0xc6, 0xa8, 0x3a, 0x9d, 0x30, 0xd5, 0xd0, 0x5f, 0x13, 0x18, 0xae, 0x28, 0xb6, 0xe8, 0x62, 0x33,
+	0xdf, 0x54, 0x15, 0x97, 0xda, 0xc5, 0x76, 0x58, 0x10, 0xe9, 0x9c, 0x44, 0x3a, 0x4d, 0x5f, 0xae,
+	0x8d, 0x74, 0x4f, 0x52, 0x63, 0x96, 0xf1, 0xe9, 0x0f, 0x08, 0xf4, 0x08, 0x49, 0x74, 0xba, 0xc9,
+	0x51, 0x01, 0xa4, 0x99, 0xa6, 0x74, 0x88, 0x63, 0xa1, 0xb1, 0xc5, 0xe4, 0xf1, 0xe9, 0xf7, 0xf0,
+	0x76, 0x3f, 0x14, 0xbe, 0xfd, 0x84, 0x40, 0x5f, 0x30, 0x99, 0x6b, 0x18, 0x6d, 0x15, 0x33, 0x40,
+	0x6d, 0xb6, 0x25, 0x5a, 0xc4, 0xb5, 0x28, 0x71, 0xcd, 0xd2, 0x57, 0xeb, 0xe3, 0x92, 0xd5, 0x78,
+	0x84, 0x8d, 0xfe, 0x98, 0x40, 0xa2, 0x5e, 0x9f, 0x47, 0x97, 0x1b, 0x1c, 0xde, 0xa4, 0xb9, 0xd5,
+	0xbe, 0xde, 0x11, 0x2f, 0x2a, 0xd2, 0x45, 0xff, 0x44, 0x80, 0x56, 0xcf, 0xf0, 0xe8, 0x52, 0x8b,
+	0x52, 0xcb, 0xb1, 0x5c, 0x6e, 0x93, 0x0b, 0x51, 0x5c, 0x95, 0xe6, 0x5c, 0xa6, 0x5f, 0x6b, 0xc9,
+	0xcd, 0xe9, 0x77, 0x3c, 0xdb, 0x35, 0xe4, 0xff, 0x2c, 0x2c, 0x51, 0xd7, 0x18, 0xb6, 0x4b, 0xff,
+	0x4d, 0x60, 0xb2, 0xc1, 0x9c, 0x8b, 0x5e, 0x69, 0x02, 0xac, 0xf1, 0xf0, 0x4e, 0x7b, 0xb3, 0x53,
+	0x76, 0x54, 0xf0, 0xba, 0x54, 0x70, 0x85, 0xbe, 0xd5, 0x9a, 0x82, 0xd6, 0x81, 0xcd, 0x95, 0x82,
+	0x6a, 0x32, 0xa8, 0x8a, 0x29, 0xa1, 0xe7, 0xaf, 0x08, 0x40, 0x34, 0xf0, 0xa2, 0x73, 0x4d, 0x82,
+	0xb6, 0x6c, 0xbc, 0xa6, 0xcd, 0xb7, 0x48, 0x8d, 0xa0, 0x97, 0x24, 0xe8, 0x14, 0x9d, 0x6b, 0x0d,
+	0xb4, 0x9a, 0xa6, 0xd1, 0x47, 0x04, 0x68, 0xf5, 0xd4, 0xab, 0x61, 0x3c, 0xd5, 0x1d, 0xbc, 0x69,
+	0x97, 0xdb, 0xe4, 0x42, 0xe4, 0xeb, 0x12, 0xf9, 0x1b, 0x74, 0xb9, 0x35, 0xe4, 0x2a, 0xf1, 0xca,
+	0xcf, 0x30, 0xfb, 0x8a, 0x5c, 0xf2, 0x1b, 0x02, 0x03, 0xb1, 0x91, 0x16, 0x9d, 0x6f, 0x86, 0xa6,
+	0x3c, 0x68, 0x52, 0xad, 0x92, 0x23, 0xea, 0x65, 0x89, 0x7a, 0x89, 0x5e, 0x6c, 0x07, 0xb5, 0x9a,
+	0xa9, 0x88, 0xb8, 0xe8, 0x0f, 0x1b, 0x5f, 0xda, 0x28, 0x97, 0x55, 0x4e, 0x5c, 0xb4, 0xb9, 0xd6,
+	0x88, 0x11, 0xe4, 0x6b, 0x6d, 0x06, 0x85, 0x60, 0x96, 0x8f, 0xee, 0x63, 0x02, 0x13, 0xeb, 0x3e,
+	0xb7, 0x1d, 0x93, 0x5b, 0x55, 0x0d, 0x24, 0xbd, 0xd4, 0x08, 0x44, 0x9d, 0xde, 0x5b, 0x5b, 0x6a,
+	0x8f, 0x09, 0x35, 0xb8, 0x21, 0x35, 0x78, 0x8b, 0x5e, 0xa9, 0xad, 0x41, 0xec, 0x16, 0x22, 0xda,
+	0x74, 0x2c, 0xd5, 0x84, 0x37, 0x51, 0xa8, 0xf4, 0x57, 0x02, 0x5a, 0x1d, 0x95, 0xc4, 0xcc, 0xac,
+	0x0d, 0x78, 0x51, 0x9b, 0xaa, 0x5d, 0x6e, 0x93, 0x0b, 0xb5, 0xda, 0x94, 0x5a, 0x5d, 0xa5, 0x6f,
+	0x7e, 0x09, 0xad, 0xbc, 0x12, 0x17, 0x6a, 0x7d, 0x41, 0x60, 0xaa, 0x71, 0xd7, 0x41, 0xaf, 0x36,
+	0xca, 0x87, 0xad, 0x74, 0x46, 0xda, 0xca, 0x97, 0x90, 0x80, 0x2a, 0xef, 0x48, 0x95, 0xb7, 0xe8,
+	0x8d, 0xda, 0x2a, 0xd7, 0x6a, 0x87, 0x8c, 0xbc, 0xed, 0xde, 0x33, 0xf6, 0x8a, 0x9e, 0x63, 0x88,
+	0x56, 0x2b, 0xfd, 0x5e, 0xbc, 0xff, 0x7a, 0x48, 0x3f, 0x23, 0x30, 0x51, 0xb7, 0xcb, 0xa1, 0x0d,
+	0x1f, 0xda, 0x26, 0x4d, 0x94, 0xf6, 0x46, 0x67, 0xcc, 0xad, 0xa5, 0x06, 0xa9, 0x45, 0xb5, 0xbe,
+	0x79, 0x09, 0xfb, 0x33, 0x02, 0xe3, 0x75, 0x9a, 0x13, 0xfa, 0x7a, 0xa3, 0x60, 0x6b, 0xd8, 0x6e,
+	0x69, 0xcb, 0x9d, 0xb0, 0xb6, 0xf6, 0xde, 0xfb, 0x21, 0xbb, 0x51, 0xd6, 0xd5, 0x44, 0x51, 0xbc,
+	0xba, 0xf5, 0xe8, 0xc9, 0x14, 0x79, 0xfc, 0x64, 0x8a, 0xfc, 0xf3, 0xc9, 0x14, 0xf9, 0xe8, 0xe9,
+	0x54, 0xd7, 0xe3, 0xa7, 0x53, 0x5d, 0x7f, 0x7b, 0x3a, 0xd5, 0xf5, 0xed, 0x85, 0x58, 0x23, 0x85,
+	0xd2, 0xe7, 0xf3, 0xe6, 0xae, 0x1f, 0x1e, 0xf5, 0xee, 0xe2, 0x6b, 0xe9, 0x03, 0x75, 0xa0, 0x6c,
+	0xab, 0x76, 0x7b, 0x65, 0xd7, 0x77, 0xe9, 0xbf, 0x03, 0x00, 0xd6, 0x71, 0xd8, 0x59, 0x90, 0x21,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1891,6 +1997,10 @@ type QueryClient interface {
 	// CFMMConcentratedPoolLinks returns migration links between CFMM and
 	// Concentrated pools.
 	CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error)
+	// StableswapAmplification returns the effective amplification of the given
+	// stableswap pool at the given time, interpolated along its amplification
+	// ramp.
+	StableswapAmplification(ctx context.Context, in *QueryStableswapAmplificationRequest, opts ...grpc.CallOption) (*QueryStableswapAmplificationResponse, error)
 }
 
 type queryClient struct {
@@ -2060,6 +2170,15 @@ func (c *queryClient) CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCF
 	return out, nil
 }
 
+func (c *queryClient) StableswapAmplification(ctx context.Context, in *QueryStableswapAmplificationRequest, opts ...grpc.CallOption) (*QueryStableswapAmplificationResponse, error) {
+	out := new(QueryStableswapAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/StableswapAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -2097,6 +2216,10 @@ type QueryServer interface {
 	// CFMMConcentratedPoolLinks returns migration links between CFMM and
 	// Concentrated pools.
 	CFMMConcentratedPoolLinks(context.Context, *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error)
+	// StableswapAmplification returns the effective amplification of the given
+	// stableswap pool at the given time, interpolated along its amplification
+	// ramp.
+	StableswapAmplification(context.Context, *QueryStableswapAmplificationRequest) (*QueryStableswapAmplificationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2154,6 +2277,9 @@ func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Cont
 func (*UnimplementedQueryServer) CFMMConcentratedPoolLinks(ctx context.Context, req *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CFMMConcentratedPoolLinks not implemented")
 }
+func (*UnimplementedQueryServer) StableswapAmplification(ctx context.Context, req *QueryStableswapAmplificationRequest) (*QueryStableswapAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableswapAmplification not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2465,6 +2591,24 @@ func _Query_CFMMConcentratedPoolLinks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StableswapAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStableswapAmplificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StableswapAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/StableswapAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StableswapAmplification(ctx, req.(*QueryStableswapAmplificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2537,6 +2681,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CFMMConcentratedPoolLinks",
 			Handler:    _Query_CFMMConcentratedPoolLinks_Handler,
 		},
+		{
+			MethodName: "StableswapAmplification",
+			Handler:    _Query_StableswapAmplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -3762,6 +3910,75 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStableswapAmplificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStableswapAmplificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStableswapAmplificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStableswapAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStableswapAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStableswapAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amplification.Size()
+		i -= size
+		if _, err := m.Amplification.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4267,6 +4484,31 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStableswapAmplificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStableswapAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amplification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7388,6 +7630,192 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStableswapAmplificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStableswapAmplificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStableswapAmplificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStableswapAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStableswapAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStableswapAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
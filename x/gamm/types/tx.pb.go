@@ -842,6 +842,173 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgMigrateSharesToConcentratedPosition
+// MsgMigrateSharesToConcentratedPosition exits the given balancer pool shares
+// and creates a position in the given range of a concentrated liquidity pool
+// with the same denoms, using the exited tokens.
+type MsgMigrateSharesToConcentratedPosition struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// lock_id is the ID of the lock holding the shares to migrate, or 0 if the
+	// shares are not locked. Locked shares can only be migrated to a full range
+	// position, which is locked for the remaining duration of the lock.
+	LockId          uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	SharesToMigrate types.Coin `protobuf:"bytes,3,opt,name=shares_to_migrate,json=sharesToMigrate,proto3" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+	PoolIdEntering  uint64     `protobuf:"varint,4,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	LowerTick       int64      `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick       int64      `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// token_out_mins indicates minimum token to exit the balancer pool with.
+	TokenOutMins []types.Coin `protobuf:"bytes,7,rep,name=token_out_mins,json=tokenOutMins,proto3" json:"token_out_mins" yaml:"token_out_min_amounts"`
+	// token_min_amount0 and token_min_amount1 indicate the minimum amounts of
+	// each token to be added to the concentrated liquidity position.
+	TokenMinAmount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
+	TokenMinAmount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) Reset() {
+	*m = MsgMigrateSharesToConcentratedPosition{}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateSharesToConcentratedPosition) ProtoMessage()    {}
+func (*MsgMigrateSharesToConcentratedPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.Merge(m, src)
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesToConcentratedPosition proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetSharesToMigrate() types.Coin {
+	if m != nil {
+		return m.SharesToMigrate
+	}
+	return types.Coin{}
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetPoolIdEntering() uint64 {
+	if m != nil {
+		return m.PoolIdEntering
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetTokenOutMins() []types.Coin {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type MsgMigrateSharesToConcentratedPositionResponse struct {
+	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	// concentrated_lock_id is the ID of the lock of the created position, or 0
+	// if the migrated shares were not locked.
+	ConcentratedLockId uint64 `protobuf:"varint,5,opt,name=concentrated_lock_id,json=concentratedLockId,proto3" json:"concentrated_lock_id,omitempty" yaml:"concentrated_lock_id"`
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Reset() {
+	*m = MsgMigrateSharesToConcentratedPositionResponse{}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMigrateSharesToConcentratedPositionResponse) ProtoMessage() {}
+func (*MsgMigrateSharesToConcentratedPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.Merge(m, src)
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetConcentratedLockId() uint64 {
+	if m != nil {
+		return m.ConcentratedLockId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -859,88 +1026,113 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgMigrateSharesToConcentratedPosition)(nil), "osmosis.gamm.v1beta1.MsgMigrateSharesToConcentratedPosition")
+	proto.RegisterType((*MsgMigrateSharesToConcentratedPositionResponse)(nil), "osmosis.gamm.v1beta1.MsgMigrateSharesToConcentratedPositionResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5d, 0x6f, 0xdb, 0x54,
-	0x18, 0xae, 0x93, 0xac, 0xed, 0x4e, 0xe9, 0x47, 0xbc, 0x7e, 0xa4, 0xee, 0x96, 0xb4, 0x07, 0xd4,
-	0x75, 0xeb, 0x62, 0xaf, 0x9d, 0xc4, 0x50, 0x6f, 0x10, 0x81, 0x49, 0x64, 0x5a, 0x94, 0xc9, 0xbb,
-	0x99, 0xb8, 0x89, 0x9c, 0xc6, 0xca, 0xcc, 0xea, 0x73, 0xa2, 0x1c, 0xbb, 0xcb, 0x84, 0x04, 0x12,
-	0x12, 0x48, 0x70, 0x05, 0x42, 0x7c, 0xfc, 0x0c, 0x6e, 0xf9, 0x07, 0xbb, 0xdc, 0x05, 0x48, 0x30,
-	0xa4, 0x08, 0xb5, 0x17, 0xdc, 0xa2, 0xfc, 0x02, 0x74, 0x7c, 0x8e, 0x1d, 0xdb, 0xb1, 0x9b, 0xb8,
-	0x4b, 0xd6, 0x9b, 0xad, 0x39, 0xe7, 0xfd, 0x3a, 0xcf, 0xfb, 0xf8, 0x39, 0xaf, 0x0d, 0xae, 0x61,
-	0x62, 0x62, 0x62, 0x10, 0xa5, 0xa9, 0x99, 0xa6, 0x72, 0xbc, 0x57, 0xd7, 0x2d, 0x6d, 0x4f, 0xb1,
-	0x3a, 0x72, 0xab, 0x8d, 0x2d, 0x2c, 0x2e, 0xf3, 0x6d, 0x99, 0x6e, 0xcb, 0x7c, 0x5b, 0x5a, 0x6e,
-	0xe2, 0x26, 0x76, 0x0c, 0x14, 0xfa, 0x17, 0xb3, 0x95, 0xb2, 0x9a, 0x69, 0x20, 0xac, 0x38, 0xff,
-	0xf2, 0xa5, 0xfc, 0xa1, 0xe3, 0xaf, 0xd4, 0x35, 0xa2, 0x7b, 0xc1, 0x0f, 0xb1, 0x81, 0xf8, 0xfe,
-	0x2d, 0x37, 0x7b, 0x0b, 0xe3, 0x23, 0x53, 0x43, 0x5a, 0x53, 0x6f, 0x7b, 0x76, 0xe4, 0x99, 0xd6,
-	0xaa, 0xb5, 0xb1, 0x6d, 0xe9, 0xcc, 0x1a, 0xbe, 0x4a, 0x81, 0xb9, 0x0a, 0x69, 0xde, 0xc7, 0x06,
-	0x7a, 0x88, 0xf1, 0x91, 0x78, 0x03, 0x4c, 0x13, 0x1d, 0x35, 0xf4, 0x76, 0x4e, 0xd8, 0x14, 0x76,
-	0x2e, 0x97, 0xb2, 0xbd, 0x6e, 0x61, 0xfe, 0xb9, 0x66, 0x1e, 0x1d, 0x40, 0xb6, 0x0e, 0x55, 0x6e,
-	0x20, 0xee, 0x82, 0x19, 0x9a, 0xa2, 0x66, 0x34, 0x72, 0xa9, 0x4d, 0x61, 0x27, 0x53, 0x12, 0x7b,
-	0xdd, 0xc2, 0x02, 0xb3, 0xe5, 0x1b, 0x50, 0x9d, 0xa6, 0x7f, 0x95, 0x1b, 0x62, 0x1b, 0x2c, 0x91,
-	0x27, 0x5a, 0x5b, 0xaf, 0x61, 0xdb, 0xaa, 0x69, 0x26, 0xb6, 0x91, 0x95, 0x4b, 0x3b, 0x19, 0x3e,
-	0x7e, 0xd1, 0x2d, 0x4c, 0xbd, 0xea, 0x16, 0xb6, 0x9b, 0x86, 0xf5, 0xc4, 0xae, 0xcb, 0x87, 0xd8,
-	0x54, 0xf8, 0x11, 0xd9, 0x7f, 0x45, 0xd2, 0x78, 0xaa, 0x58, 0xcf, 0x5b, 0x3a, 0x91, 0xcb, 0xc8,
-	0xea, 0x75, 0x0b, 0xab, 0xbe, 0x1c, 0x2c, 0x14, 0x8d, 0x0a, 0xd5, 0x05, 0x27, 0x43, 0xd5, 0xb6,
-	0x3e, 0x70, 0x16, 0xc5, 0x3a, 0x98, 0xb7, 0xf0, 0x53, 0x1d, 0xd5, 0x0c, 0x54, 0x33, 0xb5, 0x0e,
-	0xc9, 0x65, 0x36, 0xd3, 0x3b, 0x73, 0xfb, 0xeb, 0x32, 0x8b, 0x2b, 0x53, 0x04, 0x5d, 0xfc, 0xe5,
-	0x0f, 0xb1, 0x81, 0x4a, 0x6f, 0xd3, 0x5a, 0x7a, 0xdd, 0xc2, 0x06, 0xcb, 0xe0, 0xf7, 0xe6, 0x99,
-	0x08, 0x54, 0xe7, 0x9c, 0xe5, 0x32, 0xaa, 0x68, 0x1d, 0x72, 0xb0, 0xf1, 0xed, 0xbf, 0xbf, 0xde,
-	0x5c, 0x0d, 0x34, 0xfc, 0x53, 0x6c, 0xa0, 0x22, 0x2d, 0x0e, 0xfe, 0x25, 0x80, 0x2b, 0x3e, 0x70,
-	0x55, 0x9d, 0xb4, 0x30, 0x22, 0xba, 0x48, 0x22, 0xc0, 0x60, 0x70, 0x97, 0x13, 0x83, 0xb1, 0xc6,
-	0x9b, 0x13, 0x8a, 0x37, 0x88, 0x46, 0x05, 0xcc, 0xba, 0xe7, 0xc9, 0xa5, 0x86, 0x01, 0xb1, 0xc6,
-	0x81, 0x58, 0x0c, 0x02, 0x01, 0xd5, 0x19, 0x7e, 0x78, 0xf8, 0x37, 0x23, 0xce, 0xbd, 0x8e, 0x61,
-	0x4d, 0x94, 0x38, 0x2d, 0xb0, 0xc8, 0xce, 0x66, 0xa0, 0x31, 0xf1, 0x26, 0x14, 0x0e, 0xaa, 0xf3,
-	0xce, 0x4a, 0x19, 0x71, 0xa0, 0x74, 0xb0, 0xc0, 0xce, 0x4b, 0xd1, 0x34, 0x0d, 0x34, 0x02, 0x6f,
-	0xde, 0xe1, 0x70, 0x5d, 0xf5, 0xc3, 0xc5, 0xdd, 0xfb, 0xc4, 0x79, 0xcb, 0x59, 0xaf, 0xda, 0x56,
-	0xc5, 0x40, 0x91, 0xcc, 0xd1, 0x3b, 0x86, 0xc5, 0x98, 0xd3, 0x74, 0x88, 0xe3, 0x82, 0xeb, 0x11,
-	0xe7, 0x21, 0xb8, 0xec, 0xc5, 0xce, 0x09, 0xc3, 0xaa, 0xca, 0xf1, 0xaa, 0x96, 0x42, 0x55, 0x41,
-	0x75, 0xd6, 0xad, 0x04, 0x7e, 0x9d, 0x06, 0xcb, 0x15, 0xd2, 0x7c, 0xf4, 0x4c, 0x6b, 0xdd, 0xeb,
-	0x68, 0x87, 0x9c, 0x2c, 0x65, 0x94, 0xa4, 0x9f, 0x0f, 0xc0, 0xb4, 0x23, 0x29, 0x84, 0xf3, 0x4a,
-	0x96, 0x5d, 0x85, 0xf3, 0x49, 0x90, 0x57, 0x1a, 0x4d, 0xe5, 0x66, 0x51, 0xa9, 0x5b, 0x29, 0x43,
-	0xeb, 0x54, 0x79, 0x8c, 0x00, 0x4f, 0x69, 0xa7, 0x5f, 0x8f, 0xa7, 0xe2, 0xe7, 0x60, 0x39, 0xaa,
-	0x1d, 0xb9, 0x8c, 0x73, 0xaa, 0x4a, 0x62, 0x12, 0x6d, 0xc4, 0xb7, 0x18, 0xaa, 0x59, 0x5f, 0x87,
-	0xd9, 0x19, 0x0f, 0xb6, 0x69, 0x9b, 0xb7, 0x02, 0x6d, 0xa6, 0x22, 0x5c, 0xd4, 0x29, 0xda, 0x45,
-	0xe6, 0x58, 0x34, 0x10, 0xfc, 0x41, 0x00, 0x57, 0xa3, 0x1a, 0xe1, 0x17, 0x8d, 0x7e, 0xd2, 0xf1,
-	0x88, 0x46, 0x38, 0x1e, 0x54, 0x17, 0xdc, 0x03, 0xb0, 0xf4, 0xf0, 0x9b, 0x34, 0x58, 0x19, 0xac,
-	0xaa, 0x6a, 0x5b, 0x49, 0xf8, 0x51, 0x09, 0xf1, 0x43, 0x19, 0x91, 0x1f, 0x55, 0xdb, 0x8a, 0x22,
-	0xc8, 0x67, 0xe0, 0x4a, 0x84, 0x30, 0x73, 0x55, 0x78, 0x90, 0x18, 0x0b, 0x29, 0x56, 0xeb, 0xa1,
-	0xba, 0xd4, 0x97, 0x7a, 0x2e, 0x0e, 0x81, 0x27, 0x30, 0x33, 0x8c, 0x9e, 0x23, 0x3c, 0x81, 0x07,
-	0xd7, 0x29, 0x41, 0xe0, 0x10, 0x82, 0x50, 0x9f, 0xef, 0x05, 0x70, 0x2d, 0xb2, 0x17, 0x1e, 0x45,
-	0x5a, 0x60, 0xd1, 0x3b, 0x46, 0x80, 0x21, 0xe7, 0xd6, 0xca, 0x50, 0x38, 0xa8, 0xce, 0x73, 0x44,
-	0x38, 0x3f, 0xfe, 0x4b, 0x81, 0x75, 0x7e, 0xc3, 0xb1, 0xba, 0x2c, 0xbd, 0x8d, 0xce, 0xa3, 0x21,
-	0x89, 0xee, 0x84, 0xf1, 0x4b, 0x44, 0xff, 0xfa, 0x1c, 0x9f, 0x44, 0x44, 0xc5, 0x84, 0x6a, 0xd6,
-	0xbd, 0x96, 0xfb, 0x12, 0x71, 0x8b, 0x32, 0xe0, 0xfa, 0xe0, 0x0c, 0xc1, 0x69, 0x40, 0x21, 0xf5,
-	0x09, 0xc5, 0x2f, 0x02, 0xd8, 0x8a, 0x85, 0xfc, 0x42, 0x47, 0x0c, 0xf8, 0x7b, 0x3a, 0xc0, 0x86,
-	0x47, 0x74, 0xf7, 0x5c, 0x8a, 0x91, 0x88, 0x0d, 0xef, 0xbb, 0xf7, 0xb5, 0x81, 0x6a, 0x0d, 0x1d,
-	0x61, 0x93, 0x4b, 0xc1, 0x7a, 0xaf, 0x5b, 0x58, 0x09, 0xd1, 0xd8, 0xd9, 0x77, 0x6f, 0xe2, 0x32,
-	0xfa, 0x88, 0xfe, 0x8c, 0xc4, 0x2a, 0x33, 0xe9, 0x71, 0x2c, 0x46, 0xc5, 0x2e, 0xbd, 0x09, 0x15,
-	0x3b, 0x9b, 0x71, 0x4e, 0xa1, 0x7e, 0xe1, 0xf9, 0x31, 0xc8, 0xb8, 0x60, 0x5b, 0x2f, 0x50, 0x7c,
-	0xfe, 0x48, 0x83, 0x1c, 0x9f, 0x92, 0x42, 0x75, 0x4d, 0x50, 0x7b, 0x4a, 0xee, 0x31, 0x69, 0x73,
-	0xfd, 0x74, 0x93, 0xc2, 0x85, 0x7b, 0x06, 0x6e, 0xe1, 0x55, 0xdb, 0x62, 0x84, 0x8b, 0x98, 0x69,
-	0x33, 0x93, 0x9d, 0x69, 0xe3, 0xa6, 0xa0, 0x4b, 0x6f, 0x68, 0x0a, 0xda, 0xa5, 0x84, 0xdb, 0x1e,
-	0x1c, 0x76, 0x07, 0x09, 0x67, 0x20, 0xf8, 0xb3, 0x00, 0x36, 0xe3, 0xfa, 0x7a, 0xb1, 0xe3, 0x50,
-	0x2f, 0x05, 0x24, 0x5f, 0x65, 0x7e, 0xed, 0x9d, 0xa4, 0xc2, 0x05, 0x86, 0x8e, 0xf4, 0x18, 0x86,
-	0x0e, 0xaa, 0x3e, 0x1e, 0x65, 0x7c, 0xea, 0x93, 0x79, 0x3d, 0xf5, 0x89, 0x08, 0x09, 0xd5, 0x25,
-	0xce, 0xc4, 0xbe, 0xfa, 0x14, 0x29, 0x19, 0x76, 0x62, 0xc8, 0x10, 0xbc, 0xef, 0x68, 0xd9, 0x3f,
-	0x09, 0x00, 0xc6, 0x83, 0xee, 0xd7, 0x9f, 0xf0, 0x43, 0x25, 0x4c, 0xf4, 0xa1, 0xda, 0xff, 0x6d,
-	0x06, 0xa4, 0x2b, 0xa4, 0x29, 0x3e, 0x06, 0xb3, 0xde, 0xf7, 0x93, 0x2d, 0x39, 0xea, 0xeb, 0x8e,
-	0xec, 0xfb, 0x0a, 0x20, 0xdd, 0x18, 0x6a, 0xe2, 0x9d, 0xe9, 0x31, 0x98, 0xf5, 0x5e, 0xb0, 0xe3,
-	0x23, 0xbb, 0x26, 0x67, 0x44, 0x1e, 0x78, 0x93, 0x24, 0x20, 0x3b, 0xf8, 0xce, 0x77, 0x33, 0xd6,
-	0x7f, 0xc0, 0x56, 0xda, 0x1f, 0xdd, 0xd6, 0x4b, 0x7a, 0x0c, 0xc4, 0x88, 0x37, 0x89, 0xdd, 0x51,
-	0x23, 0x55, 0x6d, 0x4b, 0xba, 0x93, 0xc0, 0xd8, 0xcb, 0xfb, 0xa5, 0x00, 0x56, 0x63, 0x46, 0x54,
-	0xe5, 0xcc, 0x66, 0x0c, 0x3a, 0x48, 0x77, 0x13, 0x3a, 0x44, 0x16, 0x11, 0x9a, 0x8c, 0x86, 0x17,
-	0x11, 0x74, 0x18, 0xa1, 0x88, 0x98, 0x4b, 0xfa, 0x2b, 0x01, 0xac, 0xc5, 0xa9, 0xd7, 0xed, 0x33,
-	0xd9, 0x13, 0xe1, 0x21, 0xbd, 0x97, 0xd4, 0xc3, 0xab, 0xe3, 0x0b, 0xb0, 0x12, 0x7d, 0x6d, 0xcb,
-	0x43, 0x43, 0x06, 0xec, 0xa5, 0x77, 0x93, 0xd9, 0xbb, 0x05, 0x94, 0xee, 0xbf, 0x38, 0xc9, 0x0b,
-	0x2f, 0x4f, 0xf2, 0xc2, 0x3f, 0x27, 0x79, 0xe1, 0xbb, 0xd3, 0xfc, 0xd4, 0xcb, 0xd3, 0xfc, 0xd4,
-	0x9f, 0xa7, 0xf9, 0xa9, 0x4f, 0x6e, 0xfb, 0x64, 0x82, 0xc7, 0x2e, 0x1e, 0x69, 0x75, 0xe2, 0xfe,
-	0x50, 0x8e, 0xf7, 0xee, 0x2a, 0x1d, 0x26, 0x5b, 0x8e, 0x68, 0xd4, 0xa7, 0x9d, 0x4f, 0xa9, 0x77,
-	0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x0d, 0xb5, 0xdc, 0xf8, 0x15, 0x00, 0x00,
+	// 1581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6b, 0xdb, 0x56,
+	0x1f, 0x8f, 0x62, 0xd7, 0x49, 0x4e, 0x9e, 0xa4, 0xb1, 0x9a, 0xa4, 0xaa, 0xd2, 0xda, 0xe9, 0xe9,
+	0x43, 0x9a, 0xbe, 0x58, 0x8e, 0xdb, 0x87, 0x27, 0x23, 0x0c, 0xb6, 0xb9, 0x0d, 0xcc, 0xa5, 0x26,
+	0x9d, 0xda, 0x8b, 0xd2, 0x1b, 0xa3, 0xd8, 0xc2, 0xd5, 0x62, 0x9f, 0xe3, 0x59, 0xc7, 0x89, 0xcb,
+	0x60, 0x83, 0xc1, 0x06, 0xdb, 0xd5, 0xc6, 0xd8, 0xcb, 0x07, 0xd8, 0xcd, 0xee, 0xf6, 0x31, 0x7a,
+	0xd9, 0x8b, 0x0d, 0xb6, 0x0e, 0xcc, 0x68, 0x19, 0xbb, 0x1a, 0x0c, 0x7f, 0x82, 0x71, 0x5e, 0x24,
+	0x4b, 0xb6, 0x54, 0x5b, 0x8d, 0xdd, 0xde, 0xb4, 0xd6, 0x39, 0xff, 0xf7, 0xff, 0xef, 0xfc, 0xce,
+	0x5f, 0x0a, 0x38, 0x87, 0xed, 0x3a, 0xb6, 0x2d, 0x3b, 0x5b, 0x35, 0xea, 0xf5, 0xec, 0x61, 0x6e,
+	0xdf, 0x24, 0x46, 0x2e, 0x4b, 0xda, 0x5a, 0xa3, 0x89, 0x09, 0x96, 0x97, 0xc5, 0xb6, 0x46, 0xb7,
+	0x35, 0xb1, 0xad, 0x2e, 0x57, 0x71, 0x15, 0x33, 0x81, 0x2c, 0xfd, 0xc5, 0x65, 0xd5, 0xa4, 0x51,
+	0xb7, 0x10, 0xce, 0xb2, 0x7f, 0xc5, 0x52, 0xaa, 0xcc, 0xf4, 0xb3, 0xfb, 0x86, 0x6d, 0xba, 0xc6,
+	0xcb, 0xd8, 0x42, 0x62, 0xff, 0xaa, 0xe3, 0xbd, 0x81, 0x71, 0xad, 0x6e, 0x20, 0xa3, 0x6a, 0x36,
+	0x5d, 0x39, 0xfb, 0xc8, 0x68, 0x94, 0x9a, 0xb8, 0x45, 0x4c, 0x2e, 0x0d, 0x9f, 0x4e, 0x83, 0xf9,
+	0xa2, 0x5d, 0xbd, 0x85, 0x2d, 0x74, 0x07, 0xe3, 0x9a, 0x7c, 0x09, 0x24, 0x6c, 0x13, 0x55, 0xcc,
+	0xa6, 0x22, 0xad, 0x4b, 0x9b, 0x73, 0xf9, 0x64, 0xb7, 0x93, 0x5e, 0x78, 0x64, 0xd4, 0x6b, 0x3b,
+	0x90, 0xaf, 0x43, 0x5d, 0x08, 0xc8, 0x57, 0xc0, 0x0c, 0x75, 0x51, 0xb2, 0x2a, 0xca, 0xf4, 0xba,
+	0xb4, 0x19, 0xcf, 0xcb, 0xdd, 0x4e, 0x7a, 0x91, 0xcb, 0x8a, 0x0d, 0xa8, 0x27, 0xe8, 0xaf, 0x42,
+	0x45, 0x6e, 0x82, 0x25, 0xfb, 0xa1, 0xd1, 0x34, 0x4b, 0xb8, 0x45, 0x4a, 0x46, 0x1d, 0xb7, 0x10,
+	0x51, 0x62, 0xcc, 0xc3, 0xbb, 0x8f, 0x3b, 0xe9, 0xa9, 0xa7, 0x9d, 0xf4, 0x46, 0xd5, 0x22, 0x0f,
+	0x5b, 0xfb, 0x5a, 0x19, 0xd7, 0xb3, 0x22, 0x45, 0xfe, 0x5f, 0xc6, 0xae, 0x1c, 0x64, 0xc9, 0xa3,
+	0x86, 0x69, 0x6b, 0x05, 0x44, 0xba, 0x9d, 0xf4, 0xaa, 0xc7, 0x07, 0x37, 0x45, 0xad, 0x42, 0x7d,
+	0x91, 0x79, 0xd8, 0x6b, 0x91, 0x77, 0xd8, 0xa2, 0xbc, 0x0f, 0x16, 0x08, 0x3e, 0x30, 0x51, 0xc9,
+	0x42, 0xa5, 0xba, 0xd1, 0xb6, 0x95, 0xf8, 0x7a, 0x6c, 0x73, 0xfe, 0xda, 0x19, 0x8d, 0xdb, 0xd5,
+	0x68, 0x05, 0x9d, 0xfa, 0x6b, 0x37, 0xb0, 0x85, 0xf2, 0x17, 0x68, 0x2c, 0xdd, 0x4e, 0x7a, 0x8d,
+	0x7b, 0xf0, 0x6a, 0x0b, 0x4f, 0x36, 0xd4, 0xe7, 0xd9, 0x72, 0x01, 0x15, 0x8d, 0xb6, 0xbd, 0xb3,
+	0xf6, 0xc5, 0x5f, 0x3f, 0x5d, 0x5e, 0xf5, 0x35, 0xfc, 0x7d, 0x6c, 0xa1, 0x0c, 0x0d, 0x0e, 0xfe,
+	0x26, 0x81, 0x53, 0x9e, 0xe2, 0xea, 0xa6, 0xdd, 0xc0, 0xc8, 0x36, 0x65, 0x3b, 0xa0, 0x18, 0xbc,
+	0xdc, 0x85, 0xc8, 0xc5, 0x38, 0x2d, 0x9a, 0xd3, 0x67, 0x6f, 0xb0, 0x1a, 0x45, 0x30, 0xeb, 0xe4,
+	0xa3, 0x4c, 0x0f, 0x2b, 0xc4, 0x69, 0x51, 0x88, 0x93, 0xfe, 0x42, 0x40, 0x7d, 0x46, 0x24, 0x0f,
+	0x7f, 0xe7, 0xc0, 0xd9, 0x6d, 0x5b, 0x64, 0xa2, 0xc0, 0x69, 0x80, 0x93, 0x3c, 0x37, 0x0b, 0x8d,
+	0x09, 0x37, 0x7d, 0xe6, 0xa0, 0xbe, 0xc0, 0x56, 0x0a, 0x48, 0x14, 0xca, 0x04, 0x8b, 0x3c, 0x5f,
+	0x5a, 0xcd, 0xba, 0x85, 0x46, 0xc0, 0xcd, 0x7f, 0x45, 0xb9, 0xce, 0x7a, 0xcb, 0x25, 0xd4, 0x7b,
+	0xc0, 0xf9, 0x0f, 0x5b, 0xdf, 0x6b, 0x91, 0xa2, 0x85, 0x02, 0x91, 0x63, 0xb6, 0x2d, 0xc2, 0x91,
+	0x53, 0x05, 0xa7, 0x3c, 0xc5, 0x75, 0x81, 0x73, 0x07, 0xcc, 0xb9, 0xb6, 0x15, 0x69, 0x58, 0x54,
+	0x8a, 0x88, 0x6a, 0xa9, 0x2f, 0x2a, 0xa8, 0xcf, 0x3a, 0x91, 0xc0, 0xcf, 0x62, 0x60, 0xb9, 0x68,
+	0x57, 0xef, 0x1e, 0x19, 0x8d, 0xdd, 0xb6, 0x51, 0x16, 0x60, 0x29, 0xa0, 0x28, 0xfd, 0xbc, 0x0d,
+	0x12, 0x8c, 0x52, 0x6c, 0x81, 0x2b, 0x4d, 0x73, 0x18, 0xce, 0x43, 0x41, 0x6e, 0x68, 0xd4, 0x95,
+	0xe3, 0x45, 0xa7, 0x6a, 0xf9, 0x38, 0x8d, 0x53, 0x17, 0x36, 0x7c, 0x38, 0xa5, 0x9d, 0x3e, 0x1e,
+	0x4e, 0xe5, 0x8f, 0xc0, 0x72, 0x50, 0x3b, 0x94, 0x38, 0xcb, 0xaa, 0x18, 0x19, 0x44, 0x6b, 0xe1,
+	0x2d, 0x86, 0x7a, 0xd2, 0xd3, 0x61, 0x9e, 0xe3, 0xce, 0x06, 0x6d, 0xf3, 0x79, 0x5f, 0x9b, 0x29,
+	0x09, 0x67, 0x4c, 0x5a, 0xed, 0x0c, 0x57, 0xcc, 0x58, 0x08, 0x7e, 0x2d, 0x81, 0xb3, 0x41, 0x8d,
+	0xf0, 0x92, 0x46, 0xcf, 0xe9, 0x78, 0x48, 0xa3, 0xdf, 0x1e, 0xd4, 0x17, 0x9d, 0x04, 0xb8, 0x7b,
+	0xf8, 0x79, 0x0c, 0xac, 0x0c, 0x46, 0xb5, 0xd7, 0x22, 0x51, 0xf0, 0x51, 0xec, 0xc3, 0x47, 0x76,
+	0x44, 0x7c, 0xec, 0xb5, 0x48, 0x10, 0x40, 0x3e, 0x04, 0xa7, 0x02, 0x88, 0x59, 0xb0, 0xc2, 0xed,
+	0xc8, 0xb5, 0x50, 0x43, 0xb9, 0x1e, 0xea, 0x4b, 0x3d, 0xaa, 0x17, 0xe4, 0xe0, 0x3b, 0x81, 0xf1,
+	0x75, 0xe9, 0xd8, 0x27, 0x70, 0xe7, 0x22, 0x05, 0x08, 0x1c, 0x02, 0x10, 0xaa, 0xf3, 0x95, 0x04,
+	0xce, 0x05, 0xf6, 0xc2, 0x85, 0x48, 0x03, 0x9c, 0x74, 0xd3, 0xf0, 0x21, 0xe4, 0xa5, 0xb9, 0xb2,
+	0xcf, 0x1c, 0xd4, 0x17, 0x44, 0x45, 0x04, 0x3e, 0xfe, 0x99, 0x06, 0x67, 0xc4, 0x0d, 0xc7, 0xe3,
+	0x22, 0x66, 0x13, 0xbd, 0x0c, 0x87, 0x44, 0xba, 0x13, 0xc6, 0x4f, 0x11, 0xbd, 0xeb, 0x73, 0x7c,
+	0x14, 0x11, 0x64, 0x13, 0xea, 0x49, 0xe7, 0x5a, 0xee, 0x51, 0xc4, 0x55, 0x8a, 0x80, 0x8b, 0x83,
+	0x33, 0x84, 0x80, 0x01, 0x2d, 0xa9, 0x87, 0x28, 0xbe, 0x97, 0xc0, 0xf9, 0xd0, 0x92, 0xbf, 0xd6,
+	0x11, 0x03, 0xfe, 0x1c, 0xf3, 0xa1, 0xe1, 0x2e, 0xdd, 0x7d, 0x29, 0xc6, 0x88, 0x84, 0x86, 0xb7,
+	0x9c, 0xfb, 0xda, 0x42, 0xa5, 0x8a, 0x89, 0x70, 0x5d, 0x50, 0xc1, 0x99, 0x6e, 0x27, 0xbd, 0xd2,
+	0x07, 0x63, 0xb6, 0xef, 0xdc, 0xc4, 0x05, 0x74, 0x93, 0x3e, 0x06, 0xd6, 0x2a, 0x3e, 0xe9, 0x71,
+	0x2c, 0x84, 0xc5, 0x4e, 0xbc, 0x0a, 0x16, 0x7b, 0x31, 0xe2, 0x58, 0xa0, 0x5e, 0xe2, 0xf9, 0xc6,
+	0x8f, 0x38, 0x7f, 0x5b, 0x5f, 0x23, 0xf9, 0xfc, 0x12, 0x03, 0x8a, 0x98, 0x92, 0xfa, 0xe2, 0x9a,
+	0x20, 0xf7, 0xe4, 0x9d, 0x34, 0x69, 0x73, 0xbd, 0x70, 0x53, 0xfb, 0x03, 0x77, 0x05, 0x9c, 0xc0,
+	0xf7, 0x5a, 0x84, 0x03, 0x2e, 0x60, 0xa6, 0x8d, 0x4f, 0x76, 0xa6, 0x0d, 0x9b, 0x82, 0x4e, 0xbc,
+	0xa2, 0x29, 0xe8, 0x0a, 0x05, 0xdc, 0xc6, 0xe0, 0xb0, 0x3b, 0x08, 0x38, 0x0b, 0xc1, 0xef, 0x24,
+	0xb0, 0x1e, 0xd6, 0xd7, 0xd7, 0x3b, 0x0e, 0x75, 0xa7, 0x81, 0xea, 0x89, 0xcc, 0xcb, 0xbd, 0x93,
+	0x64, 0x38, 0xdf, 0xd0, 0x11, 0x1b, 0xc3, 0xd0, 0x41, 0xd9, 0xc7, 0x85, 0x8c, 0x87, 0x7d, 0xe2,
+	0xc7, 0x63, 0x9f, 0x00, 0x93, 0x50, 0x5f, 0x12, 0x48, 0xec, 0xb1, 0x4f, 0x86, 0x82, 0x61, 0x33,
+	0x04, 0x0c, 0xfe, 0xfb, 0x8e, 0x86, 0xfd, 0xad, 0x04, 0x60, 0x78, 0xd1, 0xbd, 0xfc, 0xd3, 0x7f,
+	0xa8, 0xa4, 0x89, 0x1e, 0x2a, 0xf8, 0x43, 0x02, 0x6c, 0x14, 0xed, 0x6a, 0xd1, 0xaa, 0x36, 0x0d,
+	0x62, 0x32, 0x98, 0xda, 0xf7, 0xf0, 0x0d, 0x8c, 0xca, 0x26, 0x22, 0x74, 0xa9, 0x72, 0x07, 0xdb,
+	0x16, 0xb1, 0x70, 0x54, 0x36, 0xaa, 0xe1, 0xf2, 0x41, 0x20, 0x32, 0xc4, 0x06, 0xd4, 0x13, 0xf4,
+	0x57, 0xa1, 0x22, 0x57, 0x01, 0x9f, 0x27, 0xec, 0x12, 0xc1, 0xa5, 0x3a, 0x0f, 0x64, 0x38, 0x42,
+	0xd6, 0x05, 0x42, 0x14, 0x4f, 0x9e, 0x5e, 0x0b, 0x50, 0xe7, 0xa5, 0xb4, 0xef, 0x61, 0x91, 0x9c,
+	0xbc, 0x0b, 0x96, 0x04, 0x2c, 0x4b, 0x26, 0x22, 0x66, 0xd3, 0x42, 0x55, 0x86, 0x96, 0x78, 0x7e,
+	0xad, 0x77, 0x80, 0xfa, 0x25, 0xa0, 0xbe, 0xc8, 0x11, 0xbc, 0x2b, 0x16, 0xe4, 0xff, 0x01, 0x50,
+	0xc3, 0x47, 0x66, 0xb3, 0x44, 0xac, 0xf2, 0x01, 0x63, 0x9f, 0x58, 0x7e, 0xa5, 0xdb, 0x49, 0x27,
+	0x9d, 0xfc, 0x9c, 0x3d, 0xa8, 0xcf, 0xb1, 0x87, 0x7b, 0x56, 0xf9, 0x80, 0x6a, 0xb5, 0x1a, 0x0d,
+	0x47, 0x2b, 0xd1, 0xaf, 0xd5, 0xdb, 0x83, 0xfa, 0x1c, 0x7b, 0x60, 0x5a, 0x83, 0xef, 0xf1, 0x33,
+	0x13, 0x78, 0x8f, 0x97, 0x0f, 0x01, 0xe7, 0x3b, 0x8f, 0xcc, 0x96, 0x32, 0xcb, 0xba, 0x7c, 0x2b,
+	0x32, 0xf2, 0x14, 0xaf, 0x63, 0x8f, 0x41, 0xa8, 0xf3, 0x5b, 0xc7, 0x65, 0xd4, 0xad, 0x20, 0xbf,
+	0x39, 0x65, 0x6e, 0xbc, 0x7e, 0x73, 0x03, 0x7e, 0x73, 0x3b, 0x59, 0x7a, 0x7a, 0x2f, 0xfb, 0x4e,
+	0xaf, 0x80, 0x0d, 0x27, 0x72, 0x3b, 0x43, 0x70, 0xa6, 0x5c, 0xcb, 0x34, 0x04, 0xf6, 0xe1, 0xdf,
+	0x31, 0xa0, 0x8d, 0x76, 0x4c, 0xdc, 0xb3, 0xbc, 0x0d, 0xe6, 0x1d, 0x75, 0x7a, 0x0e, 0x24, 0x06,
+	0xb4, 0xd5, 0x6e, 0x27, 0x2d, 0x3b, 0x40, 0x73, 0x37, 0xa1, 0x0e, 0x9c, 0xa7, 0x42, 0x45, 0x7e,
+	0x00, 0x66, 0x9c, 0x16, 0x4c, 0xb3, 0x52, 0xbc, 0x1d, 0xb9, 0x14, 0xe2, 0xa8, 0xb9, 0x85, 0x77,
+	0x0c, 0xf6, 0x6c, 0xe7, 0x94, 0xd8, 0x38, 0x6c, 0xe7, 0x5c, 0xdb, 0x39, 0xf9, 0x08, 0x24, 0x6b,
+	0xd6, 0x07, 0x2d, 0xab, 0x62, 0x91, 0x47, 0xa5, 0x72, 0xd3, 0xa4, 0x55, 0x51, 0xe2, 0x91, 0x9b,
+	0x79, 0xd3, 0x2c, 0xf7, 0x9a, 0x39, 0x60, 0x10, 0xea, 0x4b, 0xee, 0xda, 0x0d, 0xbe, 0x24, 0xbf,
+	0x07, 0x96, 0xcb, 0x9e, 0x4e, 0x94, 0x1c, 0xea, 0x39, 0xc1, 0x4a, 0x9e, 0xee, 0x5d, 0xf5, 0x41,
+	0x52, 0x50, 0x97, 0xbd, 0xcb, 0xb7, 0x19, 0x27, 0x5d, 0xfb, 0x73, 0x16, 0xc4, 0x8a, 0x76, 0x55,
+	0xbe, 0x0f, 0x66, 0xdd, 0xcf, 0xca, 0xe7, 0xb5, 0xa0, 0x8f, 0xde, 0x9a, 0xe7, 0xe3, 0xa8, 0x7a,
+	0x69, 0xa8, 0x88, 0x0b, 0x8f, 0xfb, 0x60, 0xd6, 0xfd, 0xee, 0x18, 0x6e, 0xd9, 0x11, 0x51, 0x2f,
+	0x0d, 0x15, 0xf1, 0x4c, 0x15, 0xc9, 0xc1, 0x4f, 0x61, 0x97, 0x43, 0xf5, 0x07, 0x64, 0xd5, 0x6b,
+	0xa3, 0xcb, 0xba, 0x4e, 0x0f, 0x81, 0x1c, 0xf0, 0x81, 0xe5, 0xca, 0xa8, 0x96, 0xf6, 0x5a, 0x44,
+	0xbd, 0x1e, 0x41, 0xd8, 0xf5, 0xfb, 0x89, 0x04, 0x56, 0x43, 0xde, 0xdc, 0xb3, 0x2f, 0x6c, 0xc6,
+	0xa0, 0x82, 0xba, 0x1d, 0x51, 0x21, 0x30, 0x88, 0xbe, 0x17, 0xc6, 0xe1, 0x41, 0xf8, 0x15, 0xd4,
+	0xed, 0x88, 0x0a, 0x6e, 0x10, 0x9f, 0x4a, 0xe0, 0x74, 0xd8, 0x50, 0xb7, 0xf5, 0x42, 0xf4, 0x04,
+	0x68, 0xa8, 0x6f, 0x44, 0xd5, 0x70, 0xe3, 0xf8, 0x18, 0xac, 0x04, 0xbf, 0xcd, 0x68, 0x43, 0x4d,
+	0xfa, 0xe4, 0xd5, 0xff, 0x47, 0x93, 0x77, 0x03, 0xf8, 0x51, 0x02, 0x17, 0x46, 0x99, 0x67, 0xde,
+	0x0c, 0xb5, 0x3f, 0x82, 0xb6, 0x7a, 0xf3, 0x38, 0xda, 0x4e, 0xac, 0xf9, 0x5b, 0x8f, 0x9f, 0xa5,
+	0xa4, 0x27, 0xcf, 0x52, 0xd2, 0x1f, 0xcf, 0x52, 0xd2, 0x97, 0xcf, 0x53, 0x53, 0x4f, 0x9e, 0xa7,
+	0xa6, 0x7e, 0x7d, 0x9e, 0x9a, 0x7a, 0xb0, 0xe5, 0xa1, 0x4a, 0xe1, 0x29, 0x53, 0x33, 0xf6, 0x6d,
+	0xe7, 0x21, 0x7b, 0x98, 0xdb, 0xce, 0xb6, 0xf9, 0xdd, 0xc5, 0x88, 0x73, 0x3f, 0xc1, 0xfe, 0x1a,
+	0x76, 0xfd, 0xdf, 0x01, 0x00, 0x58, 0x2d, 0x15, 0x10, 0xbb, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -963,6 +1155,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateSharesToConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToConcentratedPositionResponse, error)
 }
 
 type msgClient struct {
@@ -1045,6 +1238,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) MigrateSharesToConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToConcentratedPositionResponse, error) {
+	out := new(MsgMigrateSharesToConcentratedPositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/MigrateSharesToConcentratedPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1055,6 +1257,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateSharesToConcentratedPosition(context.Context, *MsgMigrateSharesToConcentratedPosition) (*MsgMigrateSharesToConcentratedPositionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1085,6 +1288,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) MigrateSharesToConcentratedPosition(ctx context.Context, req *MsgMigrateSharesToConcentratedPosition) (*MsgMigrateSharesToConcentratedPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSharesToConcentratedPosition not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1234,6 +1440,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateSharesToConcentratedPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateSharesToConcentratedPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateSharesToConcentratedPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/MigrateSharesToConcentratedPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateSharesToConcentratedPosition(ctx, req.(*MsgMigrateSharesToConcentratedPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1270,6 +1494,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "MigrateSharesToConcentratedPosition",
+			Handler:    _Msg_MigrateSharesToConcentratedPosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2037,6 +2265,163 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateSharesToConcentratedPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdEntering))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SharesToMigrate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcentratedLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConcentratedLockId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2328,11 +2713,69 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgMigrateSharesToConcentratedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdEntering))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ConcentratedLockId != 0 {
+		n += 1 + sovTx(uint64(m.ConcentratedLockId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4482,6 +4925,489 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateSharesToConcentratedPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
+			}
+			m.PoolIdEntering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedLockId", wireType)
+			}
+			m.ConcentratedLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentratedLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
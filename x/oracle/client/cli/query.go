@@ -0,0 +1,36 @@
+package oraclecli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPrice)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPriceRoutes)
+
+	return cmd
+}
+
+func GetCmdPrice() (*osmocli.QueryDescriptor, *queryproto.PriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "price [denom] [quote-denom]",
+		Short: "Query the price of a denom in a quote denom over its registered price route",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} price uatom ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858`,
+	}, &queryproto.PriceRequest{}
+}
+
+func GetCmdPriceRoutes() (*osmocli.QueryDescriptor, *queryproto.PriceRoutesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "price-routes",
+		Short: "Query all the registered price routes",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} price-routes`,
+	}, &queryproto.PriceRoutesRequest{}
+}
@@ -0,0 +1,104 @@
+package oraclecli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+func NewCmdSubmitUpdatePriceRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-price-routes-proposal [price-routes-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal registering price routes",
+		Long: `Submit a proposal registering price routes, from a json file of the form:
+{
+  "price_routes": [
+    {
+      "denom": "uatom",
+      "quote_denom": "uusdc",
+      "hops": [
+        {"pool_id": "1", "quote_denom": "uosmo", "min_quote_liquidity": "1000000000"},
+        {"pool_id": "678", "quote_denom": "uusdc", "min_quote_liquidity": "1000000000"}
+      ],
+      "twap_window": "600s",
+      "max_staleness": "3600s"
+    }
+  ]
+}
+A price route without hops removes the route registered for its denom and quote denom.`,
+		Example: "osmosisd tx gov submit-proposal update-price-routes-proposal price_routes.json --from val --keyring-backend test --title \"Test\" --description \"Test\" -b=block --chain-id localosmosis --fees=100000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parsePriceRoutesArgsToContent(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+
+	return cmd
+}
+
+func parsePriceRoutesArgsToContent(cmd *cobra.Command, clientCtx client.Context, priceRoutesFile string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(priceRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	priceRoutes := types.GenesisState{}
+	if err := clientCtx.Codec.UnmarshalJSON(contents, &priceRoutes); err != nil {
+		return nil, fmt.Errorf("failed to parse price routes file: %w", err)
+	}
+
+	content := types.NewUpdatePriceRoutesProposal(title, description, priceRoutes.PriceRoutes)
+	return &content, nil
+}
@@ -0,0 +1,41 @@
+package grpc
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/oracle/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/client"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/client/queryproto"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ queryproto.QueryServer = Querier{}
+
+func (q Querier) PriceRoutes(grpcCtx context.Context,
+	req *queryproto.PriceRoutesRequest,
+) (*queryproto.PriceRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PriceRoutes(ctx, *req)
+}
+
+func (q Querier) Price(grpcCtx context.Context,
+	req *queryproto.PriceRequest,
+) (*queryproto.PriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Price(ctx, *req)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	oraclecli "github.com/osmosis-labs/osmosis/v17/x/oracle/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+var (
+	UpdatePriceRoutesProposalHandler = govclient.NewProposalHandler(oraclecli.NewCmdSubmitUpdatePriceRoutesProposal, UpdatePriceRoutesProposalRESTHandler)
+)
+
+func UpdatePriceRoutesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-price-routes",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func emptyHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
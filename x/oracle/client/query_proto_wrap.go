@@ -0,0 +1,36 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/client/queryproto"
+)
+
+// This file should evolve to being code gen'd, off of `proto/osmosis/oracle/v1beta1/query.yml`
+
+type Querier struct {
+	K oracle.Keeper
+}
+
+func (q Querier) Price(ctx sdk.Context,
+	req queryproto.PriceRequest,
+) (*queryproto.PriceResponse, error) {
+	price, err := q.K.GetPrice(ctx, req.Denom, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.PriceResponse{Price: price}, nil
+}
+
+func (q Querier) PriceRoutes(ctx sdk.Context,
+	req queryproto.PriceRoutesRequest,
+) (*queryproto.PriceRoutesResponse, error) {
+	priceRoutes, err := q.K.GetAllPriceRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.PriceRoutesResponse{PriceRoutes: priceRoutes}, nil
+}
@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/oracle/v1beta1/query.proto
+
+package queryproto
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PriceRequest struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+}
+
+func (m *PriceRequest) Reset()         { *m = PriceRequest{} }
+func (m *PriceRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceRequest) ProtoMessage()    {}
+func (*PriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{0}
+}
+func (m *PriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRequest.Merge(m, src)
+}
+func (m *PriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRequest proto.InternalMessageInfo
+
+func (m *PriceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PriceRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+type PriceResponse struct {
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+}
+
+func (m *PriceResponse) Reset()         { *m = PriceResponse{} }
+func (m *PriceResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceResponse) ProtoMessage()    {}
+func (*PriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{1}
+}
+func (m *PriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceResponse.Merge(m, src)
+}
+func (m *PriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceResponse proto.InternalMessageInfo
+
+type PriceRoutesRequest struct {
+}
+
+func (m *PriceRoutesRequest) Reset()         { *m = PriceRoutesRequest{} }
+func (m *PriceRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceRoutesRequest) ProtoMessage()    {}
+func (*PriceRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{2}
+}
+func (m *PriceRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRoutesRequest.Merge(m, src)
+}
+func (m *PriceRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRoutesRequest proto.InternalMessageInfo
+
+type PriceRoutesResponse struct {
+	PriceRoutes []types.PriceRoute `protobuf:"bytes,1,rep,name=price_routes,json=priceRoutes,proto3" json:"price_routes"`
+}
+
+func (m *PriceRoutesResponse) Reset()         { *m = PriceRoutesResponse{} }
+func (m *PriceRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceRoutesResponse) ProtoMessage()    {}
+func (*PriceRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{3}
+}
+func (m *PriceRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRoutesResponse.Merge(m, src)
+}
+func (m *PriceRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRoutesResponse proto.InternalMessageInfo
+
+func (m *PriceRoutesResponse) GetPriceRoutes() []types.PriceRoute {
+	if m != nil {
+		return m.PriceRoutes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PriceRequest)(nil), "osmosis.oracle.v1beta1.PriceRequest")
+	proto.RegisterType((*PriceResponse)(nil), "osmosis.oracle.v1beta1.PriceResponse")
+	proto.RegisterType((*PriceRoutesRequest)(nil), "osmosis.oracle.v1beta1.PriceRoutesRequest")
+	proto.RegisterType((*PriceRoutesResponse)(nil), "osmosis.oracle.v1beta1.PriceRoutesResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/oracle/v1beta1/query.proto", fileDescriptor_a199bc01df476dac)
+}
+
+var fileDescriptor_a199bc01df476dac = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x93, 0xd5, 0x08, 0x4e, 0x56, 0x90, 0xb1, 0x94, 0x12, 0x34, 0x29, 0xa3, 0x5b, 0x16,
+	0x4b, 0x33, 0x6c, 0x3d, 0x14, 0x3c, 0xf4, 0xb0, 0xf4, 0xe6, 0x45, 0x83, 0x07, 0xf1, 0x52, 0x92,
+	0x74, 0x88, 0xc1, 0x24, 0x2f, 0x9b, 0x99, 0x14, 0x17, 0x6f, 0x7e, 0x02, 0xa1, 0x37, 0x3f, 0x51,
+	0x8f, 0x05, 0x2f, 0xe2, 0x21, 0xc8, 0xae, 0x9f, 0x60, 0x3f, 0x81, 0x64, 0x66, 0xd6, 0x8d, 0xe0,
+	0xee, 0xf6, 0x34, 0x99, 0x79, 0xbf, 0xf9, 0xbf, 0xff, 0x7b, 0x6f, 0x82, 0x08, 0xf0, 0x1c, 0x78,
+	0xca, 0x29, 0x54, 0x61, 0x9c, 0x31, 0x7a, 0x39, 0x8a, 0x98, 0x08, 0x47, 0x74, 0x52, 0xb3, 0x6a,
+	0xea, 0x97, 0x15, 0x08, 0xc0, 0xbb, 0x9a, 0xf1, 0x15, 0xe3, 0x6b, 0xc6, 0xd9, 0x49, 0x20, 0x01,
+	0x89, 0xd0, 0xf6, 0x4b, 0xd1, 0xce, 0x70, 0x8d, 0x62, 0x59, 0xa5, 0x31, 0x3b, 0xaf, 0xa0, 0x16,
+	0x4c, 0x93, 0x8f, 0x13, 0x80, 0x24, 0x63, 0x34, 0x2c, 0x53, 0x1a, 0x16, 0x05, 0x88, 0x50, 0xa4,
+	0x50, 0x70, 0x15, 0x25, 0x80, 0xfa, 0xaf, 0xdb, 0x2b, 0x01, 0x9b, 0xd4, 0x8c, 0x0b, 0x7c, 0x80,
+	0xac, 0x0b, 0x56, 0x40, 0xbe, 0x67, 0xee, 0x9b, 0xc3, 0xfb, 0xe3, 0x87, 0x8b, 0xc6, 0xeb, 0x4f,
+	0xc3, 0x3c, 0x7b, 0x49, 0xe4, 0x31, 0x09, 0x54, 0x18, 0x9f, 0x20, 0x7b, 0x52, 0x83, 0x60, 0xe7,
+	0x8a, 0xee, 0x49, 0x7a, 0x77, 0xd1, 0x78, 0x58, 0xd1, 0x9d, 0x20, 0x09, 0x90, 0xdc, 0x9d, 0xc9,
+	0x0d, 0x43, 0x0f, 0x74, 0x42, 0x5e, 0x42, 0xc1, 0x19, 0x7e, 0x8b, 0x2c, 0x69, 0x5a, 0x67, 0x3c,
+	0xbd, 0x6e, 0x3c, 0xe3, 0x67, 0xe3, 0x1d, 0x24, 0xa9, 0xf8, 0x50, 0x47, 0x7e, 0x0c, 0x39, 0x8d,
+	0x65, 0xb1, 0x7a, 0x39, 0xe2, 0x17, 0x1f, 0xa9, 0x98, 0x96, 0x8c, 0xfb, 0x67, 0x2c, 0x5e, 0xf9,
+	0x93, 0x22, 0x24, 0x50, 0x62, 0x64, 0x07, 0x61, 0x95, 0xa6, 0xed, 0x04, 0xd7, 0xd5, 0x91, 0x08,
+	0x3d, 0xfa, 0xe7, 0x54, 0x5b, 0x78, 0x85, 0xfa, 0x9d, 0xbe, 0xf1, 0x3d, 0x73, 0xff, 0xce, 0xd0,
+	0x3e, 0x26, 0xfe, 0xff, 0x27, 0xe2, 0xaf, 0x24, 0xc6, 0x77, 0x5b, 0xb7, 0x81, 0x5d, 0xae, 0x44,
+	0x8f, 0xbf, 0xf5, 0x90, 0xf5, 0xa6, 0x9d, 0x2b, 0xfe, 0x8c, 0x2c, 0x89, 0xe2, 0x67, 0x9b, 0x95,
+	0x94, 0x39, 0x67, 0xb0, 0x85, 0x52, 0x66, 0xc9, 0xe0, 0xcb, 0xf7, 0xdf, 0x57, 0x3d, 0x0f, 0x3f,
+	0xa1, 0x6b, 0x9e, 0x80, 0xca, 0x79, 0x65, 0x22, 0xbb, 0x53, 0x2b, 0x7e, 0xbe, 0xbd, 0x9a, 0x65,
+	0x9b, 0x9c, 0xc3, 0x5b, 0xb1, 0xda, 0xcf, 0xa1, 0xf4, 0x33, 0xc0, 0x4f, 0x37, 0xfa, 0x51, 0x97,
+	0xc6, 0xef, 0xae, 0x67, 0xae, 0x79, 0x33, 0x73, 0xcd, 0x5f, 0x33, 0xd7, 0xfc, 0x3a, 0x77, 0x8d,
+	0x9b, 0xb9, 0x6b, 0xfc, 0x98, 0xbb, 0xc6, 0xfb, 0xd3, 0xce, 0xbc, 0xb5, 0xd0, 0x51, 0x16, 0x46,
+	0xfc, 0xaf, 0xea, 0xe5, 0xe8, 0x84, 0x7e, 0x5a, 0x6a, 0xc7, 0x59, 0xca, 0x0a, 0xa1, 0xfe, 0x1f,
+	0xf9, 0x90, 0xa3, 0x7b, 0x72, 0x79, 0xf1, 0x67, 0x00, 0x10, 0xd6, 0x22, 0xac, 0x6b, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Price returns the price of a denom in units of a quote denom, as the
+	// geometric TWAP over its registered price route.
+	Price(ctx context.Context, in *PriceRequest, opts ...grpc.CallOption) (*PriceResponse, error)
+	// PriceRoutes returns all the registered price routes.
+	PriceRoutes(ctx context.Context, in *PriceRoutesRequest, opts ...grpc.CallOption) (*PriceRoutesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Price(ctx context.Context, in *PriceRequest, opts ...grpc.CallOption) (*PriceResponse, error) {
+	out := new(PriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRoutes(ctx context.Context, in *PriceRoutesRequest, opts ...grpc.CallOption) (*PriceRoutesResponse, error) {
+	out := new(PriceRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/PriceRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Price returns the price of a denom in units of a quote denom, as the
+	// geometric TWAP over its registered price route.
+	Price(context.Context, *PriceRequest) (*PriceResponse, error)
+	// PriceRoutes returns all the registered price routes.
+	PriceRoutes(context.Context, *PriceRoutesRequest) (*PriceRoutesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *PriceRequest) (*PriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) PriceRoutes(ctx context.Context, req *PriceRoutesRequest) (*PriceRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRoutes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.oracle.v1beta1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*PriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.oracle.v1beta1.Query/PriceRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRoutes(ctx, req.(*PriceRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.oracle.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "PriceRoutes",
+			Handler:    _Query_PriceRoutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/oracle/v1beta1/query.proto",
+}
+
+func (m *PriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceRoutes) > 0 {
+		for iNdEx := len(m.PriceRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PriceRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PriceRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceRoutes) > 0 {
+		for _, e := range m.PriceRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRoutes = append(m.PriceRoutes, types.PriceRoute{})
+			if err := m.PriceRoutes[len(m.PriceRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,40 @@
+package oracle
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+func NewUpdatePriceRoutesProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdatePriceRoutesProposal:
+			return k.HandleUpdatePriceRoutesProposal(ctx, c)
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleUpdatePriceRoutesProposal registers the price routes of the proposal.
+// Price routes without hops remove the registered route of their denom and quote denom instead.
+func (k Keeper) HandleUpdatePriceRoutesProposal(ctx sdk.Context, p *types.UpdatePriceRoutesProposal) error {
+	for _, route := range p.PriceRoutes {
+		if len(route.Hops) == 0 {
+			if err := route.ValidateDenoms(); err != nil {
+				return err
+			}
+			k.DeletePriceRoute(ctx, route.Denom, route.QuoteDenom)
+			continue
+		}
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		k.SetPriceRoute(ctx, route)
+	}
+	return nil
+}
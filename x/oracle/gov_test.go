@@ -0,0 +1,71 @@
+package oracle_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestHandleUpdatePriceRoutesProposal() {
+	routeAC := newPriceRoute(1, 2)
+	updatedRouteAC := newPriceRoute(3, 4)
+	updatedRouteAC.TwapWindow = time.Hour
+	routeCA := types.PriceRoute{
+		Denom:      denomC,
+		QuoteDenom: denomA,
+		Hops: []types.PriceRouteHop{
+			{PoolId: 2, QuoteDenom: denomB, MinQuoteLiquidity: routeAC.Hops[0].MinQuoteLiquidity},
+			{PoolId: 1, QuoteDenom: denomA, MinQuoteLiquidity: routeAC.Hops[0].MinQuoteLiquidity},
+		},
+		TwapWindow:   time.Minute,
+		MaxStaleness: time.Minute,
+	}
+	invalidRoute := newPriceRoute(1, 2)
+	invalidRoute.TwapWindow = 0
+
+	tests := map[string]struct {
+		priceRoutes    []types.PriceRoute
+		expectedRoutes []types.PriceRoute
+		expectErr      bool
+	}{
+		"add a price route": {
+			priceRoutes:    []types.PriceRoute{routeCA},
+			expectedRoutes: []types.PriceRoute{routeAC, routeCA},
+		},
+		"replace a price route": {
+			priceRoutes:    []types.PriceRoute{updatedRouteAC},
+			expectedRoutes: []types.PriceRoute{updatedRouteAC},
+		},
+		"remove a price route": {
+			priceRoutes:    []types.PriceRoute{{Denom: denomA, QuoteDenom: denomC}},
+			expectedRoutes: []types.PriceRoute{},
+		},
+		"remove a price route that is not registered": {
+			priceRoutes:    []types.PriceRoute{{Denom: denomC, QuoteDenom: denomA}},
+			expectedRoutes: []types.PriceRoute{routeAC},
+		},
+		"invalid price route": {
+			priceRoutes: []types.PriceRoute{invalidRoute},
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.OracleKeeper.SetPriceRoute(s.Ctx, routeAC)
+
+			proposal := types.NewUpdatePriceRoutesProposal("title", "description", tc.priceRoutes)
+			err := s.App.OracleKeeper.HandleUpdatePriceRoutesProposal(s.Ctx, &proposal)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			priceRoutes, err := s.App.OracleKeeper.GetAllPriceRoutes(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRoutes, priceRoutes)
+		})
+	}
+}
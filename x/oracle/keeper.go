@@ -0,0 +1,37 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	twapKeeper        types.TwapKeeper
+	poolmanagerKeeper types.PoolManagerKeeper
+}
+
+func NewKeeper(storeKey sdk.StoreKey, twapKeeper types.TwapKeeper, poolmanagerKeeper types.PoolManagerKeeper) *Keeper {
+	return &Keeper{storeKey: storeKey, twapKeeper: twapKeeper, poolmanagerKeeper: poolmanagerKeeper}
+}
+
+// InitGenesis initializes the oracle module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	for _, route := range genState.PriceRoutes {
+		k.SetPriceRoute(ctx, route)
+	}
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	priceRoutes, err := k.GetAllPriceRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{PriceRoutes: priceRoutes}
+}
@@ -0,0 +1,67 @@
+package oracle_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+var baseTime = time.Unix(1257894000, 0).UTC()
+
+const (
+	denomA = "tokenA"
+	denomB = "tokenB"
+	denomC = "tokenC"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+// newPriceRoute returns a price route of denom A in denom C through the pools with the given ids,
+// with a twap window of 10 minutes and a max staleness of 1 hour.
+func newPriceRoute(poolIdAB, poolIdBC uint64) types.PriceRoute {
+	return types.PriceRoute{
+		Denom:      denomA,
+		QuoteDenom: denomC,
+		Hops: []types.PriceRouteHop{
+			{PoolId: poolIdAB, QuoteDenom: denomB, MinQuoteLiquidity: sdk.NewInt(1000)},
+			{PoolId: poolIdBC, QuoteDenom: denomC, MinQuoteLiquidity: sdk.NewInt(1000)},
+		},
+		TwapWindow:   10 * time.Minute,
+		MaxStaleness: time.Hour,
+	}
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.SetupTest()
+	genesis := &types.GenesisState{
+		PriceRoutes: []types.PriceRoute{
+			newPriceRoute(1, 2),
+			{
+				Denom:        denomB,
+				QuoteDenom:   denomC,
+				Hops:         []types.PriceRouteHop{{PoolId: 2, QuoteDenom: denomC, MinQuoteLiquidity: sdk.ZeroInt()}},
+				TwapWindow:   time.Minute,
+				MaxStaleness: time.Minute,
+			},
+		},
+	}
+
+	s.App.OracleKeeper.InitGenesis(s.Ctx, genesis)
+
+	s.Require().Equal(genesis, s.App.OracleKeeper.ExportGenesis(s.Ctx))
+}
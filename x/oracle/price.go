@@ -0,0 +1,87 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+// GetPrice returns the price of the given denom in units of the given quote denom.
+// The price is the geometric twap over the registered price route of the denom and quote denom,
+// from the twap window of the route before the block time until the block time.
+//
+// This function will error if:
+// * no price route is registered for the denom and quote denom
+// * a pool of the route holds less than the min quote liquidity of its hop
+// * the most recent twap record of a pool of the route is older than the max staleness of the route
+// * the geometric twap over the route errors, including when there were spot price errors within the twap window
+func (k Keeper) GetPrice(ctx sdk.Context, denom, quoteDenom string) (sdk.Dec, error) {
+	route, err := k.GetPriceRoute(ctx, denom, quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	baseDenom := route.Denom
+	for _, hop := range route.Hops {
+		if err := k.validateHopLiquidity(ctx, hop); err != nil {
+			return sdk.Dec{}, err
+		}
+		if err := k.validateHopStaleness(ctx, hop, baseDenom, route); err != nil {
+			return sdk.Dec{}, err
+		}
+		baseDenom = hop.QuoteDenom
+	}
+
+	blockTime := ctx.BlockTime()
+	price, err := k.twapKeeper.GetGeometricTwapOverRoute(ctx, route.Denom, route.TwapRoutes(), blockTime.Add(-route.TwapWindow), blockTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return price, nil
+}
+
+// validateHopLiquidity returns an error if the pool of the hop holds less than its min quote liquidity.
+func (k Keeper) validateHopLiquidity(ctx sdk.Context, hop types.PriceRouteHop) error {
+	liquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, hop.PoolId)
+	if err != nil {
+		return err
+	}
+	quoteLiquidity := liquidity.AmountOf(hop.QuoteDenom)
+	if quoteLiquidity.LT(hop.MinQuoteLiquidity) {
+		return types.InsufficientLiquidityError{
+			PoolId:            hop.PoolId,
+			QuoteDenom:        hop.QuoteDenom,
+			Liquidity:         quoteLiquidity,
+			MinQuoteLiquidity: hop.MinQuoteLiquidity,
+		}
+	}
+	return nil
+}
+
+// validateHopStaleness returns an error if the most recent twap record of the pool of the hop
+// for its base and quote denoms is older than the max staleness of the route.
+// Twap records are only updated when the spot price of a pool may change, so a stale record
+// means that the pool has not been traded against for a while.
+func (k Keeper) validateHopStaleness(ctx sdk.Context, hop types.PriceRouteHop, baseDenom string, route types.PriceRoute) error {
+	records, err := k.twapKeeper.GetAllMostRecentRecordsForPool(ctx, hop.PoolId)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		isHopRecord := (record.Asset0Denom == baseDenom && record.Asset1Denom == hop.QuoteDenom) ||
+			(record.Asset0Denom == hop.QuoteDenom && record.Asset1Denom == baseDenom)
+		if !isHopRecord {
+			continue
+		}
+		if record.Time.Add(route.MaxStaleness).Before(ctx.BlockTime()) {
+			return types.StaleTwapRecordError{
+				PoolId:       hop.PoolId,
+				RecordTime:   record.Time,
+				MaxStaleness: route.MaxStaleness,
+				BlockTime:    ctx.BlockTime(),
+			}
+		}
+		return nil
+	}
+	return types.TwapRecordNotFoundError{PoolId: hop.PoolId, BaseDenom: baseDenom, QuoteDenom: hop.QuoteDenom}
+}
@@ -0,0 +1,92 @@
+package oracle_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetPrice() {
+	tests := map[string]struct {
+		denom      string
+		quoteDenom string
+		// timeElapsed is the time elapsed since the creation of the pools.
+		timeElapsed   time.Duration
+		updateRoute   func(route *types.PriceRoute)
+		expectedPrice sdk.Dec
+		expectedErr   error
+		expectErr     bool
+	}{
+		"price over the route": {
+			denom:         denomA,
+			quoteDenom:    denomC,
+			timeElapsed:   30 * time.Minute,
+			expectedPrice: sdk.NewDec(8),
+		},
+		"record as old as the max staleness": {
+			denom:         denomA,
+			quoteDenom:    denomC,
+			timeElapsed:   time.Hour,
+			expectedPrice: sdk.NewDec(8),
+		},
+		"no price route": {
+			denom:       denomC,
+			quoteDenom:  denomA,
+			timeElapsed: 30 * time.Minute,
+			expectedErr: types.PriceRouteNotFoundError{Denom: denomC, QuoteDenom: denomA},
+		},
+		"insufficient liquidity": {
+			denom:       denomA,
+			quoteDenom:  denomC,
+			timeElapsed: 30 * time.Minute,
+			updateRoute: func(route *types.PriceRoute) {
+				route.Hops[1].MinQuoteLiquidity = sdk.NewInt(4_000_001)
+			},
+			expectedErr: types.InsufficientLiquidityError{PoolId: 2, QuoteDenom: denomC, Liquidity: sdk.NewInt(4_000_000), MinQuoteLiquidity: sdk.NewInt(4_000_001)},
+		},
+		"stale twap record": {
+			denom:       denomA,
+			quoteDenom:  denomC,
+			timeElapsed: time.Hour + time.Second,
+			expectedErr: types.StaleTwapRecordError{PoolId: 1, RecordTime: baseTime, MaxStaleness: time.Hour, BlockTime: baseTime.Add(time.Hour + time.Second)},
+		},
+		"twap window starting before the creation of the pools": {
+			denom:       denomA,
+			quoteDenom:  denomC,
+			timeElapsed: 5 * time.Minute,
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(baseTime)
+			// The price of token A is 2 token B, and the price of token B is 4 token C.
+			poolIdAB := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denomA, 1_000_000), sdk.NewInt64Coin(denomB, 2_000_000))
+			poolIdBC := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denomB, 1_000_000), sdk.NewInt64Coin(denomC, 4_000_000))
+
+			route := newPriceRoute(poolIdAB, poolIdBC)
+			if tc.updateRoute != nil {
+				tc.updateRoute(&route)
+			}
+			s.App.OracleKeeper.SetPriceRoute(s.Ctx, route)
+			s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(tc.timeElapsed))
+
+			price, err := s.App.OracleKeeper.GetPrice(s.Ctx, tc.denom, tc.quoteDenom)
+			if tc.expectedErr != nil {
+				s.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), tc.expectedPrice, price, sdk.MustNewDecFromStr("0.000000001"))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+// GetPriceRoute returns the price route of the given denom in units of the given quote denom.
+// Returns error if no price route is registered for them.
+func (k Keeper) GetPriceRoute(ctx sdk.Context, denom, quoteDenom string) (types.PriceRoute, error) {
+	route := types.PriceRoute{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatPriceRouteKey(denom, quoteDenom), &route)
+	if err != nil {
+		return types.PriceRoute{}, err
+	}
+	if !found {
+		return types.PriceRoute{}, types.PriceRouteNotFoundError{Denom: denom, QuoteDenom: quoteDenom}
+	}
+	return route, nil
+}
+
+// GetAllPriceRoutes returns all the registered price routes, ordered by denom and quote denom.
+func (k Keeper) GetAllPriceRoutes(ctx sdk.Context) ([]types.PriceRoute, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PriceRoutePrefix, parsePriceRouteFromBz)
+}
+
+// SetPriceRoute registers the given price route, replacing the one of the same denom and quote denom if any.
+func (k Keeper) SetPriceRoute(ctx sdk.Context, route types.PriceRoute) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatPriceRouteKey(route.Denom, route.QuoteDenom), &route)
+}
+
+// DeletePriceRoute removes the price route of the given denom in units of the given quote denom.
+func (k Keeper) DeletePriceRoute(ctx sdk.Context, denom, quoteDenom string) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatPriceRouteKey(denom, quoteDenom))
+}
+
+func parsePriceRouteFromBz(bz []byte) (types.PriceRoute, error) {
+	route := types.PriceRoute{}
+	err := route.Unmarshal(bz)
+	return route, err
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PriceRouteNotFoundError struct {
+	Denom      string
+	QuoteDenom string
+}
+
+func (e PriceRouteNotFoundError) Error() string {
+	return fmt.Sprintf("no price route is registered for denom %s in quote denom %s", e.Denom, e.QuoteDenom)
+}
+
+type InsufficientLiquidityError struct {
+	PoolId            uint64
+	QuoteDenom        string
+	Liquidity         sdk.Int
+	MinQuoteLiquidity sdk.Int
+}
+
+func (e InsufficientLiquidityError) Error() string {
+	return fmt.Sprintf("pool %d has too little liquidity of %s to be trusted (liquidity %s, min liquidity %s)",
+		e.PoolId, e.QuoteDenom, e.Liquidity, e.MinQuoteLiquidity)
+}
+
+type StaleTwapRecordError struct {
+	PoolId       uint64
+	RecordTime   time.Time
+	MaxStaleness time.Duration
+	BlockTime    time.Time
+}
+
+func (e StaleTwapRecordError) Error() string {
+	return fmt.Sprintf("the most recent twap record of pool %d is too old to be trusted (record time %s, max staleness %s, block time %s)",
+		e.PoolId, e.RecordTime, e.MaxStaleness, e.BlockTime)
+}
+
+type TwapRecordNotFoundError struct {
+	PoolId     uint64
+	BaseDenom  string
+	QuoteDenom string
+}
+
+func (e TwapRecordNotFoundError) Error() string {
+	return fmt.Sprintf("pool %d has no twap record for denoms %s and %s", e.PoolId, e.BaseDenom, e.QuoteDenom)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// TwapKeeper defines the contract needed to compute prices from twap records.
+type TwapKeeper interface {
+	GetGeometricTwapOverRoute(ctx sdk.Context, baseAssetDenom string, routes []twaptypes.TwapRoute, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+	GetAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]twaptypes.TwapRecord, error)
+}
+
+// PoolManagerKeeper defines the contract needed to check the liquidity of pools.
+type PoolManagerKeeper interface {
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default oracle genesis state, which has no price routes.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PriceRoutes: []PriceRoute{},
+	}
+}
+
+// Validate returns an error if any of the price routes is invalid,
+// or if there are several price routes for the same denom and quote denom.
+func (g *GenesisState) Validate() error {
+	seenRoutes := map[string]bool{}
+	for _, route := range g.PriceRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		key := string(FormatPriceRouteKey(route.Denom, route.QuoteDenom))
+		if seenRoutes[key] {
+			return fmt.Errorf("duplicate price route of %s in %s", route.Denom, route.QuoteDenom)
+		}
+		seenRoutes[key] = true
+	}
+	return nil
+}
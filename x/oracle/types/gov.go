@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdatePriceRoutes = "UpdatePriceRoutes"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePriceRoutes)
+	govtypes.RegisterProposalTypeCodec(&UpdatePriceRoutesProposal{}, "osmosis/UpdatePriceRoutesProposal")
+}
+
+var _ govtypes.Content = &UpdatePriceRoutesProposal{}
+
+func NewUpdatePriceRoutesProposal(title, description string, priceRoutes []PriceRoute) UpdatePriceRoutesProposal {
+	return UpdatePriceRoutesProposal{
+		Title:       title,
+		Description: description,
+		PriceRoutes: priceRoutes,
+	}
+}
+
+func (p *UpdatePriceRoutesProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePriceRoutesProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePriceRoutesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePriceRoutesProposal) ProposalType() string {
+	return ProposalTypeUpdatePriceRoutes
+}
+
+func (p *UpdatePriceRoutesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.PriceRoutes) == 0 {
+		return fmt.Errorf("proposal must update at least one price route")
+	}
+	for _, route := range p.PriceRoutes {
+		// A price route without hops removes the route of its denom and quote denom.
+		if len(route.Hops) == 0 {
+			if err := route.ValidateDenoms(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := route.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p UpdatePriceRoutesProposal) String() string {
+	var b strings.Builder
+	for _, route := range p.PriceRoutes {
+		b.WriteString(fmt.Sprintf("(Denom: %s, QuoteDenom: %s, Hops: %d, TwapWindow: %s, MaxStaleness: %s) ",
+			route.Denom, route.QuoteDenom, len(route.Hops), route.TwapWindow, route.MaxStaleness))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Update Price Routes Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
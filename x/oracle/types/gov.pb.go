@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/oracle/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdatePriceRoutesProposal is a gov Content type for registering the routes
+// used to price denoms. It can be used to add a price route for a denom and
+// quote denom pair, or to replace the registered one. If a price route has no
+// hops, the route of its denom and quote denom pair is removed instead.
+type UpdatePriceRoutesProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PriceRoutes []PriceRoute `protobuf:"bytes,3,rep,name=price_routes,json=priceRoutes,proto3" json:"price_routes" yaml:"price_routes"`
+}
+
+func (m *UpdatePriceRoutesProposal) Reset()      { *m = UpdatePriceRoutesProposal{} }
+func (*UpdatePriceRoutesProposal) ProtoMessage() {}
+func (*UpdatePriceRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f7a3e078ab32a28, []int{0}
+}
+func (m *UpdatePriceRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePriceRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePriceRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePriceRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePriceRoutesProposal.Merge(m, src)
+}
+func (m *UpdatePriceRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePriceRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePriceRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePriceRoutesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdatePriceRoutesProposal)(nil), "osmosis.oracle.v1beta1.UpdatePriceRoutesProposal")
+}
+
+func init() { proto.RegisterFile("osmosis/oracle/v1beta1/gov.proto", fileDescriptor_3f7a3e078ab32a28) }
+
+var fileDescriptor_3f7a3e078ab32a28 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x93, 0x16, 0x05, 0xd3, 0x0e, 0x1a, 0xa5, 0xb4, 0x15, 0x92, 0x9a, 0x41, 0x8a, 0xd0,
+	0x1c, 0xad, 0x83, 0xd2, 0xb1, 0xae, 0x0e, 0x25, 0xe0, 0xe2, 0x52, 0x92, 0xf4, 0x88, 0x07, 0x49,
+	0xde, 0x91, 0xbb, 0x16, 0xfb, 0x0d, 0xc4, 0xc9, 0xd1, 0xb1, 0x1f, 0x41, 0xd0, 0x0f, 0x51, 0x9c,
+	0x3a, 0x3a, 0x05, 0x69, 0x07, 0x9d, 0xfb, 0x09, 0x24, 0xb9, 0x84, 0x66, 0xb0, 0x4b, 0xc8, 0x7b,
+	0xff, 0x1f, 0xef, 0xbd, 0xfb, 0xff, 0x95, 0x16, 0xb0, 0x00, 0x18, 0x61, 0x08, 0x22, 0xdb, 0xf5,
+	0x31, 0x9a, 0x76, 0x1d, 0xcc, 0xed, 0x2e, 0xf2, 0x60, 0x6a, 0xd2, 0x08, 0x38, 0xa8, 0xb5, 0x8c,
+	0x30, 0x05, 0x61, 0x66, 0x44, 0xf3, 0xc4, 0x03, 0x0f, 0x52, 0x04, 0x25, 0x7f, 0x82, 0x6e, 0x36,
+	0xdc, 0x14, 0x1f, 0x09, 0x41, 0x14, 0x99, 0x74, 0x64, 0x07, 0x24, 0x04, 0x94, 0x7e, 0xb3, 0x56,
+	0x7b, 0xc7, 0x76, 0x1a, 0x11, 0x17, 0x8f, 0x22, 0x98, 0x70, 0x2c, 0x48, 0xe3, 0xbd, 0xa4, 0x34,
+	0xee, 0xe8, 0xd8, 0xe6, 0x78, 0x98, 0x68, 0x56, 0x22, 0xb1, 0x61, 0x04, 0x14, 0x98, 0xed, 0xab,
+	0xe7, 0xca, 0x1e, 0x27, 0xdc, 0xc7, 0x75, 0xb9, 0x25, 0xb7, 0x0f, 0x06, 0x87, 0x9b, 0x58, 0xaf,
+	0xce, 0xec, 0xc0, 0xef, 0x1b, 0x69, 0xdb, 0xb0, 0x84, 0xac, 0x5e, 0x2b, 0x95, 0x31, 0x66, 0x6e,
+	0x44, 0x28, 0x27, 0x10, 0xd6, 0x4b, 0x29, 0x5d, 0xdb, 0xc4, 0xba, 0x2a, 0xe8, 0x82, 0x68, 0x58,
+	0x45, 0x54, 0x75, 0x94, 0x6a, 0xe1, 0x28, 0x56, 0x2f, 0xb7, 0xca, 0xed, 0x4a, 0xcf, 0x30, 0xff,
+	0x37, 0xc7, 0xdc, 0x1e, 0x39, 0x38, 0x5d, 0xc4, 0xba, 0xb4, 0x89, 0xf5, 0x63, 0xb1, 0xa2, 0x38,
+	0xc5, 0xb0, 0x2a, 0x74, 0xfb, 0x9a, 0xfe, 0xf0, 0x69, 0xae, 0x4b, 0xaf, 0x73, 0x5d, 0xfa, 0x9d,
+	0xeb, 0xf2, 0xe7, 0x47, 0xa7, 0x99, 0xd9, 0x97, 0x64, 0x91, 0x0f, 0xbe, 0x81, 0x90, 0xe3, 0x90,
+	0x3f, 0xff, 0xbc, 0x5d, 0x9c, 0xe5, 0xe6, 0xed, 0xf4, 0x65, 0x70, 0xbb, 0x58, 0x69, 0xf2, 0x72,
+	0xa5, 0xc9, 0xdf, 0x2b, 0x4d, 0x7e, 0x59, 0x6b, 0xd2, 0x72, 0xad, 0x49, 0x5f, 0x6b, 0x4d, 0xba,
+	0xef, 0x79, 0x84, 0x3f, 0x4c, 0x1c, 0xd3, 0x85, 0x00, 0x65, 0x73, 0x3a, 0xbe, 0xed, 0xb0, 0xbc,
+	0x40, 0xd3, 0xee, 0x15, 0x7a, 0xcc, 0x73, 0xe1, 0x33, 0x8a, 0x99, 0xb3, 0x9f, 0x46, 0x71, 0xf9,
+	0x37, 0x00, 0x76, 0x7f, 0xed, 0x41, 0x34, 0x02, 0x00, 0x00,
+}
+
+func (this *UpdatePriceRoutesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdatePriceRoutesProposal)
+	if !ok {
+		that2, ok := that.(UpdatePriceRoutesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PriceRoutes) != len(that1.PriceRoutes) {
+		return false
+	}
+	for i := range this.PriceRoutes {
+		if !this.PriceRoutes[i].Equal(&that1.PriceRoutes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *UpdatePriceRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePriceRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePriceRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceRoutes) > 0 {
+		for iNdEx := len(m.PriceRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdatePriceRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PriceRoutes) > 0 {
+		for _, e := range m.PriceRoutes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePriceRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePriceRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePriceRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRoutes = append(m.PriceRoutes, PriceRoute{})
+			if err := m.PriceRoutes[len(m.PriceRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
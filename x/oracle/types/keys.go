@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	ModuleName = "oracle"
+
+	StoreKey  = ModuleName
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+	// Contract: Coin denoms cannot contain this character
+	KeySeparator = "|"
+)
+
+// format is price_route | denom | quote denom
+var PriceRoutePrefix = []byte("price_route" + KeySeparator)
+
+// FormatPriceRouteKey returns the key of the price route of the given denom in units of the given quote denom.
+func FormatPriceRouteKey(denom, quoteDenom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", PriceRoutePrefix, denom, KeySeparator, quoteDenom))
+}
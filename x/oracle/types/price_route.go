@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// Validate returns an error if the price route is not a valid route of pools from its denom to its quote denom,
+// or if its twap window or max staleness are not positive.
+func (r PriceRoute) Validate() error {
+	if err := r.ValidateDenoms(); err != nil {
+		return err
+	}
+	if len(r.Hops) == 0 {
+		return fmt.Errorf("price route of %s in %s must have at least one hop", r.Denom, r.QuoteDenom)
+	}
+
+	baseDenom := r.Denom
+	for _, hop := range r.Hops {
+		if hop.PoolId == 0 {
+			return fmt.Errorf("price route of %s in %s has a hop with pool id 0", r.Denom, r.QuoteDenom)
+		}
+		if err := sdk.ValidateDenom(hop.QuoteDenom); err != nil {
+			return err
+		}
+		if hop.QuoteDenom == baseDenom {
+			return fmt.Errorf("price route of %s in %s has a hop of pool %d with the same base and quote denom %s", r.Denom, r.QuoteDenom, hop.PoolId, baseDenom)
+		}
+		if hop.MinQuoteLiquidity.IsNil() || hop.MinQuoteLiquidity.IsNegative() {
+			return fmt.Errorf("price route of %s in %s has a hop of pool %d with an invalid min quote liquidity %s", r.Denom, r.QuoteDenom, hop.PoolId, hop.MinQuoteLiquidity)
+		}
+		baseDenom = hop.QuoteDenom
+	}
+	if baseDenom != r.QuoteDenom {
+		return fmt.Errorf("price route of %s in %s must end with quote denom %s, ends with %s", r.Denom, r.QuoteDenom, r.QuoteDenom, baseDenom)
+	}
+
+	if r.TwapWindow <= 0 {
+		return fmt.Errorf("price route of %s in %s must have a positive twap window, got %s", r.Denom, r.QuoteDenom, r.TwapWindow)
+	}
+	if r.MaxStaleness <= 0 {
+		return fmt.Errorf("price route of %s in %s must have a positive max staleness, got %s", r.Denom, r.QuoteDenom, r.MaxStaleness)
+	}
+	return nil
+}
+
+// ValidateDenoms returns an error if the denom or the quote denom of the price route are invalid or equal.
+func (r PriceRoute) ValidateDenoms() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.QuoteDenom); err != nil {
+		return err
+	}
+	if r.Denom == r.QuoteDenom {
+		return fmt.Errorf("price route denom and quote denom must differ, got %s", r.Denom)
+	}
+	return nil
+}
+
+// TwapRoutes returns the hops of the price route as a twap route.
+func (r PriceRoute) TwapRoutes() []twaptypes.TwapRoute {
+	twapRoutes := make([]twaptypes.TwapRoute, 0, len(r.Hops))
+	for _, hop := range r.Hops {
+		twapRoutes = append(twapRoutes, twaptypes.TwapRoute{PoolId: hop.PoolId, QuoteAsset: hop.QuoteDenom})
+	}
+	return twapRoutes
+}
@@ -0,0 +1,895 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/oracle/v1beta1/price_route.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceRouteHop is a single pool of a price route.
+// The base denom of each hop is the quote denom of the previous hop,
+// or the denom priced by the route for the first hop.
+type PriceRouteHop struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// quote_denom is the denom that the base denom of the hop is priced in.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// min_quote_liquidity is the minimum amount of the quote denom that the
+	// pool must hold for its price to be trusted.
+	MinQuoteLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_quote_liquidity,json=minQuoteLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_quote_liquidity" yaml:"min_quote_liquidity"`
+}
+
+func (m *PriceRouteHop) Reset()         { *m = PriceRouteHop{} }
+func (m *PriceRouteHop) String() string { return proto.CompactTextString(m) }
+func (*PriceRouteHop) ProtoMessage()    {}
+func (*PriceRouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e9728afe2dfd1c, []int{0}
+}
+func (m *PriceRouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRouteHop.Merge(m, src)
+}
+func (m *PriceRouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRouteHop proto.InternalMessageInfo
+
+func (m *PriceRouteHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PriceRouteHop) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// PriceRoute is a governance registered route of pools that is used to price
+// a denom in a quote denom, via the geometric TWAP over its hops.
+type PriceRoute struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// quote_denom is the denom that the price is returned in.
+	// It must be the quote denom of the last hop.
+	QuoteDenom string          `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	Hops       []PriceRouteHop `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops" yaml:"hops"`
+	// twap_window is the duration, ending at the block time, over which the
+	// geometric TWAP is computed.
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	// max_staleness is the maximum age of the most recent TWAP record of every
+	// hop for the price to be trusted.
+	MaxStaleness time.Duration `protobuf:"bytes,5,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness" yaml:"max_staleness"`
+}
+
+func (m *PriceRoute) Reset()         { *m = PriceRoute{} }
+func (m *PriceRoute) String() string { return proto.CompactTextString(m) }
+func (*PriceRoute) ProtoMessage()    {}
+func (*PriceRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e9728afe2dfd1c, []int{1}
+}
+func (m *PriceRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRoute.Merge(m, src)
+}
+func (m *PriceRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRoute proto.InternalMessageInfo
+
+func (m *PriceRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PriceRoute) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *PriceRoute) GetHops() []PriceRouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *PriceRoute) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *PriceRoute) GetMaxStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceRouteHop)(nil), "osmosis.oracle.v1beta1.PriceRouteHop")
+	proto.RegisterType((*PriceRoute)(nil), "osmosis.oracle.v1beta1.PriceRoute")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/oracle/v1beta1/price_route.proto", fileDescriptor_b1e9728afe2dfd1c)
+}
+
+var fileDescriptor_b1e9728afe2dfd1c = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xdc, 0x6d, 0xc5, 0xd9, 0x56, 0x34, 0x2d, 0x25, 0xee, 0x21, 0x59, 0x02, 0x96,
+	0x05, 0xe9, 0x0c, 0xbb, 0x1e, 0x0a, 0x3d, 0x86, 0x1e, 0x2c, 0x2c, 0xa2, 0xf1, 0x20, 0xf4, 0x12,
+	0x93, 0xcd, 0x98, 0x0e, 0x26, 0x99, 0x34, 0x33, 0xe9, 0xee, 0x82, 0x17, 0xff, 0x03, 0x8f, 0x1e,
+	0xfd, 0x73, 0x7a, 0xec, 0x51, 0x3c, 0x44, 0xd9, 0xbd, 0x78, 0xce, 0xcd, 0x9b, 0x64, 0x66, 0x82,
+	0x2b, 0x14, 0x84, 0x9e, 0x32, 0xef, 0xd7, 0xe7, 0xbd, 0x37, 0xdf, 0x0c, 0x1c, 0x31, 0x9e, 0x32,
+	0x4e, 0x39, 0x66, 0x45, 0x30, 0x4b, 0x08, 0xbe, 0x1a, 0x87, 0x44, 0x04, 0x63, 0x9c, 0x17, 0x74,
+	0x46, 0xfc, 0x82, 0x95, 0x82, 0xa0, 0xbc, 0x60, 0x82, 0x19, 0x07, 0x3a, 0x13, 0xa9, 0x4c, 0xa4,
+	0x33, 0x07, 0xfb, 0x31, 0x8b, 0x99, 0x4c, 0xc1, 0xcd, 0x49, 0x65, 0x0f, 0xac, 0x98, 0xb1, 0x38,
+	0x21, 0x58, 0x5a, 0x61, 0xf9, 0x1e, 0x47, 0x65, 0x11, 0x08, 0xca, 0x32, 0x15, 0x77, 0x7e, 0x03,
+	0xb8, 0xfb, 0xaa, 0xe9, 0xe1, 0x35, 0x2d, 0x5e, 0xb0, 0xdc, 0x78, 0x06, 0xef, 0xe7, 0x8c, 0x25,
+	0x3e, 0x8d, 0x4c, 0x30, 0x04, 0xa3, 0x9e, 0x6b, 0xd4, 0x95, 0xfd, 0x70, 0x19, 0xa4, 0xc9, 0x89,
+	0xa3, 0x03, 0x8e, 0xb7, 0xdd, 0x9c, 0xce, 0x22, 0xe3, 0x18, 0xf6, 0x2f, 0x4b, 0x26, 0x88, 0x1f,
+	0x91, 0x8c, 0xa5, 0xe6, 0xbd, 0x21, 0x18, 0x3d, 0x70, 0x0f, 0xea, 0xca, 0x36, 0x54, 0xc1, 0x46,
+	0xd0, 0xf1, 0xa0, 0xb4, 0x4e, 0x1b, 0xc3, 0xf8, 0x08, 0xf7, 0x52, 0x9a, 0xf9, 0x2a, 0x9e, 0xd0,
+	0xcb, 0x92, 0x46, 0x54, 0x2c, 0xcd, 0xae, 0x04, 0x4c, 0xaf, 0x2b, 0xbb, 0xf3, 0xbd, 0xb2, 0x0f,
+	0x63, 0x2a, 0x2e, 0xca, 0x10, 0xcd, 0x58, 0x8a, 0x67, 0x72, 0x6d, 0xfd, 0x39, 0xe2, 0xd1, 0x07,
+	0x2c, 0x96, 0x39, 0xe1, 0xe8, 0x2c, 0x13, 0x75, 0x65, 0x0f, 0x54, 0xbb, 0x5b, 0x90, 0x8e, 0xf7,
+	0x38, 0xa5, 0xd9, 0xeb, 0xc6, 0x39, 0x6d, 0x7d, 0x27, 0xbd, 0x5f, 0x5f, 0x6d, 0xe0, 0x7c, 0xea,
+	0x42, 0xf8, 0x77, 0x77, 0xe3, 0x10, 0x6e, 0xa9, 0x2d, 0x80, 0x1c, 0xe2, 0x51, 0x5d, 0xd9, 0x3b,
+	0x0a, 0xab, 0xe7, 0x57, 0xe1, 0xbb, 0xef, 0xfc, 0x12, 0xf6, 0x2e, 0x58, 0xce, 0xcd, 0xee, 0xb0,
+	0x3b, 0xea, 0x4f, 0x9e, 0xa2, 0xdb, 0x85, 0x44, 0xff, 0xc8, 0xe1, 0xee, 0x35, 0x77, 0x51, 0x57,
+	0x76, 0x5f, 0xc1, 0x1b, 0x80, 0xe3, 0x49, 0x8e, 0x71, 0x0e, 0xfb, 0x62, 0x1e, 0xe4, 0xfe, 0x9c,
+	0x66, 0x11, 0x9b, 0x9b, 0xbd, 0x21, 0x18, 0xf5, 0x27, 0x4f, 0x90, 0x52, 0x1c, 0xb5, 0x8a, 0xa3,
+	0x53, 0xad, 0xb8, 0x6b, 0x69, 0x94, 0x9e, 0x73, 0xa3, 0xd6, 0xf9, 0xf2, 0xc3, 0x06, 0x1e, 0x6c,
+	0x3c, 0x6f, 0xa5, 0xc3, 0x78, 0x07, 0x77, 0xd3, 0x60, 0xe1, 0x73, 0x11, 0x24, 0x24, 0x23, 0x9c,
+	0x9b, 0x5b, 0xff, 0xa3, 0x0f, 0x35, 0x7d, 0x5f, 0x4b, 0xb1, 0x59, 0xad, 0xf8, 0x3b, 0x69, 0xb0,
+	0x78, 0xd3, 0xba, 0x94, 0x06, 0xee, 0xf4, 0x7a, 0x65, 0x81, 0x9b, 0x95, 0x05, 0x7e, 0xae, 0x2c,
+	0xf0, 0x79, 0x6d, 0x75, 0x6e, 0xd6, 0x56, 0xe7, 0xdb, 0xda, 0xea, 0x9c, 0x4f, 0x36, 0xc4, 0xd7,
+	0x37, 0x75, 0x94, 0x04, 0x21, 0x6f, 0x0d, 0x7c, 0x35, 0x3e, 0xc6, 0x8b, 0xf6, 0xbd, 0xc8, 0x9f,
+	0x21, 0xdc, 0x96, 0x63, 0x3d, 0xff, 0x33, 0x00, 0x31, 0xce, 0x5d, 0xc3, 0x4e, 0x03, 0x00, 0x00,
+}
+
+func (this *PriceRouteHop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceRouteHop)
+	if !ok {
+		that2, ok := that.(PriceRouteHop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return false
+	}
+	if !this.MinQuoteLiquidity.Equal(that1.MinQuoteLiquidity) {
+		return false
+	}
+	return true
+}
+func (this *PriceRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceRoute)
+	if !ok {
+		that2, ok := that.(PriceRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return false
+	}
+	if len(this.Hops) != len(that1.Hops) {
+		return false
+	}
+	for i := range this.Hops {
+		if !this.Hops[i].Equal(&that1.Hops[i]) {
+			return false
+		}
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
+	return true
+}
+func (m *PriceRouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinQuoteLiquidity.Size()
+		i -= size
+		if _, err := m.MinQuoteLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintPriceRoute(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPriceRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPriceRoute(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPriceRoute(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintPriceRoute(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPriceRoute(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceRouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPriceRoute(uint64(m.PoolId))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovPriceRoute(uint64(l))
+	}
+	l = m.MinQuoteLiquidity.Size()
+	n += 1 + l + sovPriceRoute(uint64(l))
+	return n
+}
+
+func (m *PriceRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPriceRoute(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovPriceRoute(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovPriceRoute(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovPriceRoute(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovPriceRoute(uint64(l))
+	return n
+}
+
+func sovPriceRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceRoute(x uint64) (n int) {
+	return sovPriceRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceRouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuoteLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuoteLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, PriceRouteHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceRoute = fmt.Errorf("proto: unexpected end of group")
+)
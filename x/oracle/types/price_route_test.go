@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v17/x/oracle/types"
+)
+
+func validPriceRoute() types.PriceRoute {
+	return types.PriceRoute{
+		Denom:      "uatom",
+		QuoteDenom: "uusdc",
+		Hops: []types.PriceRouteHop{
+			{PoolId: 1, QuoteDenom: "uosmo", MinQuoteLiquidity: sdk.NewInt(1000)},
+			{PoolId: 2, QuoteDenom: "uusdc", MinQuoteLiquidity: sdk.ZeroInt()},
+		},
+		TwapWindow:   10 * time.Minute,
+		MaxStaleness: time.Hour,
+	}
+}
+
+func TestPriceRouteValidate(t *testing.T) {
+	tests := map[string]struct {
+		updateRoute func(route *types.PriceRoute)
+		expectErr   bool
+	}{
+		"valid price route": {
+			updateRoute: func(route *types.PriceRoute) {},
+		},
+		"invalid denom": {
+			updateRoute: func(route *types.PriceRoute) { route.Denom = "1atom" },
+			expectErr:   true,
+		},
+		"same denom and quote denom": {
+			updateRoute: func(route *types.PriceRoute) { route.QuoteDenom = route.Denom },
+			expectErr:   true,
+		},
+		"no hops": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops = nil },
+			expectErr:   true,
+		},
+		"hop with pool id 0": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops[0].PoolId = 0 },
+			expectErr:   true,
+		},
+		"hop with the same base and quote denom": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops[0].QuoteDenom = "uatom" },
+			expectErr:   true,
+		},
+		"hop with negative min quote liquidity": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops[1].MinQuoteLiquidity = sdk.NewInt(-1) },
+			expectErr:   true,
+		},
+		"hop with nil min quote liquidity": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops[1].MinQuoteLiquidity = sdk.Int{} },
+			expectErr:   true,
+		},
+		"last hop not ending with the quote denom": {
+			updateRoute: func(route *types.PriceRoute) { route.Hops = route.Hops[:1] },
+			expectErr:   true,
+		},
+		"zero twap window": {
+			updateRoute: func(route *types.PriceRoute) { route.TwapWindow = 0 },
+			expectErr:   true,
+		},
+		"zero max staleness": {
+			updateRoute: func(route *types.PriceRoute) { route.MaxStaleness = 0 },
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			route := validPriceRoute()
+			tc.updateRoute(&route)
+
+			err := route.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	otherRoute := validPriceRoute()
+	otherRoute.Denom = "uion"
+	invalidRoute := validPriceRoute()
+	invalidRoute.TwapWindow = 0
+
+	tests := map[string]struct {
+		genesis   types.GenesisState
+		expectErr bool
+	}{
+		"default genesis": {
+			genesis: *types.DefaultGenesis(),
+		},
+		"distinct price routes": {
+			genesis: types.GenesisState{PriceRoutes: []types.PriceRoute{validPriceRoute(), otherRoute}},
+		},
+		"duplicate price routes": {
+			genesis:   types.GenesisState{PriceRoutes: []types.PriceRoute{validPriceRoute(), validPriceRoute()}},
+			expectErr: true,
+		},
+		"invalid price route": {
+			genesis:   types.GenesisState{PriceRoutes: []types.PriceRoute{invalidRoute}},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdatePriceRoutesProposalValidateBasic(t *testing.T) {
+	invalidRoute := validPriceRoute()
+	invalidRoute.TwapWindow = 0
+
+	tests := map[string]struct {
+		priceRoutes []types.PriceRoute
+		expectErr   bool
+	}{
+		"valid price route": {
+			priceRoutes: []types.PriceRoute{validPriceRoute()},
+		},
+		"removal of a price route": {
+			priceRoutes: []types.PriceRoute{{Denom: "uatom", QuoteDenom: "uusdc"}},
+		},
+		"removal of a price route with invalid denoms": {
+			priceRoutes: []types.PriceRoute{{Denom: "uatom", QuoteDenom: "uatom"}},
+			expectErr:   true,
+		},
+		"invalid price route": {
+			priceRoutes: []types.PriceRoute{invalidRoute},
+			expectErr:   true,
+		},
+		"no price routes": {
+			priceRoutes: []types.PriceRoute{},
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := types.NewUpdatePriceRoutesProposal("title", "description", tc.priceRoutes)
+			err := proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -80,3 +80,11 @@ func (h Hooks) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddre
 // AfterConcentratedPoolSwap is a noop.
 func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
+
+// AfterLiquidityAdded is a noop.
+func (h Hooks) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins) {
+}
+
+// AfterLiquidityRemoved is a noop.
+func (h Hooks) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins) {
+}
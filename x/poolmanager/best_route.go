@@ -0,0 +1,293 @@
+package poolmanager
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// bestRoutePool is a pool that the best route search can hop through.
+type bestRoutePool struct {
+	id     uint64
+	denoms []string
+}
+
+// bestRouteCandidate is a route found by the best route search
+// along with its estimated token out for the full token in.
+type bestRouteCandidate struct {
+	route    []types.SwapAmountInRoute
+	tokenOut sdk.Int
+}
+
+// EstimateBestRoute searches all the pools for the route that yields the most tokenOutDenom for tokenIn.
+// Routes have at most maxHops hops and never go through the same pool or denom twice. If maxSplits is
+// greater than one, tokenIn may be split across up to maxSplits routes that do not share any pool.
+// Each route is estimated through MultihopEstimateOutGivenExactAmountIn, so the returned amount accounts
+// for the spread factors and taker fees charged by SplitRouteExactAmountIn when executing the routes.
+//
+// The search consumes at most types.BestRouteSearchGasLimit gas, which is charged to the given context.
+// Once it runs out of gas, the best route found so far is returned.
+//
+// Returns error if:
+//   - the inputs are invalid
+//   - no route from tokenIn to tokenOutDenom is found within the gas limit
+func (k Keeper) EstimateBestRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+	maxSplits uint64,
+) ([]types.SwapAmountInSplitRoute, sdk.Int, error) {
+	if err := types.ValidateBestRouteSearch(tokenIn, tokenOutDenom, maxHops, maxSplits); err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	searchGasMeter := sdk.NewGasMeter(types.BestRouteSearchGasLimit)
+	searchCtx := ctx.WithGasMeter(searchGasMeter)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(searchGasMeter.GasConsumedToLimit(), "best route search")
+	}()
+
+	candidates, err := k.findBestRouteCandidates(searchCtx, tokenIn, tokenOutDenom, maxHops)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+	if len(candidates) == 0 {
+		return nil, sdk.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	routes := []types.SwapAmountInSplitRoute{{Pools: candidates[0].route, TokenInAmount: tokenIn.Amount}}
+	tokenOutAmount := candidates[0].tokenOut
+
+	if maxSplits > 1 {
+		splitRoutes, splitTokenOutAmount := k.splitBestRouteCandidates(searchCtx, candidates, tokenIn, maxSplits)
+		if len(splitRoutes) > 1 && splitTokenOutAmount.GT(tokenOutAmount) {
+			routes, tokenOutAmount = splitRoutes, splitTokenOutAmount
+		}
+	}
+
+	return routes, tokenOutAmount, nil
+}
+
+// findBestRouteCandidates returns all the routes from tokenIn to tokenOutDenom with at most maxHops hops,
+// sorted from the highest estimated token out to the lowest. Routes that fail to estimate are skipped.
+// If the context runs out of gas, the routes found until then are returned.
+func (k Keeper) findBestRouteCandidates(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops uint64) (candidates []bestRouteCandidate, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+		}
+		sortBestRouteCandidates(candidates)
+	}()
+
+	poolsByDenom, err := k.getBestRoutePoolsByDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		route        []types.SwapAmountInRoute
+		usedPools    = map[uint64]bool{}
+		visitedDenom = map[string]bool{tokenIn.Denom: true}
+		search       func(denom string)
+	)
+
+	// search does a depth-first traversal of the pools, extending the current route
+	// by one hop through every pool containing denom that was not visited yet.
+	search = func(denom string) {
+		for _, pool := range poolsByDenom[denom] {
+			if usedPools[pool.id] {
+				continue
+			}
+
+			for _, nextDenom := range pool.denoms {
+				if visitedDenom[nextDenom] {
+					continue
+				}
+
+				ctx.GasMeter().ConsumeGas(types.BestRouteSearchStepGas, "best route search step")
+				route = append(route, types.SwapAmountInRoute{PoolId: pool.id, TokenOutDenom: nextDenom})
+
+				if nextDenom == tokenOutDenom {
+					candidateRoute := make([]types.SwapAmountInRoute, len(route))
+					copy(candidateRoute, route)
+
+					tokenOut, err := k.estimateBestRouteCandidate(ctx, candidateRoute, tokenIn)
+					if err == nil {
+						candidates = append(candidates, bestRouteCandidate{route: candidateRoute, tokenOut: tokenOut})
+					}
+				} else if uint64(len(route)) < maxHops {
+					usedPools[pool.id] = true
+					visitedDenom[nextDenom] = true
+
+					search(nextDenom)
+
+					delete(usedPools, pool.id)
+					delete(visitedDenom, nextDenom)
+				}
+
+				route = route[:len(route)-1]
+			}
+		}
+	}
+	search(tokenIn.Denom)
+
+	return candidates, nil
+}
+
+// splitBestRouteCandidates splits tokenIn across up to maxSplits of the given candidates, which must be sorted
+// from best to worst. Only candidates that do not share any pool are combined so that their estimates remain
+// independent of each other. tokenIn is allocated in types.BestRouteSplitIncrements equal parts, each part
+// going to the route whose token out increases the most from it.
+// Returns the routes that got a non-zero part of tokenIn and their total token out.
+// Returns no routes if fewer than two candidates can be combined or if the context runs out of gas.
+func (k Keeper) splitBestRouteCandidates(ctx sdk.Context, candidates []bestRouteCandidate, tokenIn sdk.Coin, maxSplits uint64) (routes []types.SwapAmountInSplitRoute, tokenOutAmount sdk.Int) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			routes, tokenOutAmount = nil, sdk.Int{}
+		}
+	}()
+
+	selected := selectPoolDisjointCandidates(candidates, maxSplits)
+	if len(selected) < 2 {
+		return nil, sdk.Int{}
+	}
+
+	amountsIn := make([]sdk.Int, len(selected))
+	amountsOut := make([]sdk.Int, len(selected))
+	for i := range selected {
+		amountsIn[i] = sdk.ZeroInt()
+		amountsOut[i] = sdk.ZeroInt()
+	}
+
+	for i := int64(0); i < types.BestRouteSplitIncrements; i++ {
+		// Compute the increment as the difference between two cumulative parts so that
+		// the increments add up to exactly tokenIn.
+		increment := tokenIn.Amount.MulRaw(i + 1).QuoRaw(types.BestRouteSplitIncrements).Sub(tokenIn.Amount.MulRaw(i).QuoRaw(types.BestRouteSplitIncrements))
+		if increment.IsZero() {
+			continue
+		}
+
+		bestIndex, bestGain, bestAmountOut := -1, sdk.Int{}, sdk.Int{}
+		for j, candidate := range selected {
+			amountOut, err := k.estimateBestRouteCandidate(ctx, candidate.route, sdk.NewCoin(tokenIn.Denom, amountsIn[j].Add(increment)))
+			if err != nil {
+				continue
+			}
+
+			gain := amountOut.Sub(amountsOut[j])
+			if bestIndex == -1 || gain.GT(bestGain) {
+				bestIndex, bestGain, bestAmountOut = j, gain, amountOut
+			}
+		}
+
+		if bestIndex == -1 {
+			return nil, sdk.Int{}
+		}
+
+		amountsIn[bestIndex] = amountsIn[bestIndex].Add(increment)
+		amountsOut[bestIndex] = bestAmountOut
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for i, candidate := range selected {
+		if amountsIn[i].IsZero() {
+			continue
+		}
+
+		routes = append(routes, types.SwapAmountInSplitRoute{Pools: candidate.route, TokenInAmount: amountsIn[i]})
+		tokenOutAmount = tokenOutAmount.Add(amountsOut[i])
+	}
+
+	return routes, tokenOutAmount
+}
+
+// estimateBestRouteCandidate estimates the token out of the given route. It panics with sdk.ErrorOutOfGas
+// if the context ran out of gas, since MultihopEstimateOutGivenExactAmountIn recovers from it.
+func (k Keeper) estimateBestRouteCandidate(ctx sdk.Context, route []types.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	tokenOut, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+	if ctx.GasMeter().IsOutOfGas() {
+		panic(sdk.ErrorOutOfGas{Descriptor: "best route search"})
+	}
+
+	return tokenOut, err
+}
+
+// getBestRoutePoolsByDenom returns all the active pools, indexed by each of their denoms.
+// Pools are ordered by id for every denom to keep the search deterministic.
+func (k Keeper) getBestRoutePoolsByDenom(ctx sdk.Context) (map[string][]bestRoutePool, error) {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsByDenom := make(map[string][]bestRoutePool)
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+
+		denoms, err := k.RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil {
+			continue
+		}
+
+		routePool := bestRoutePool{id: pool.GetId(), denoms: denoms}
+		for _, denom := range denoms {
+			poolsByDenom[denom] = append(poolsByDenom[denom], routePool)
+		}
+	}
+
+	return poolsByDenom, nil
+}
+
+// selectPoolDisjointCandidates returns up to maxSplits of the given candidates, in order,
+// such that no two of the returned candidates go through the same pool.
+func selectPoolDisjointCandidates(candidates []bestRouteCandidate, maxSplits uint64) []bestRouteCandidate {
+	var (
+		selected  []bestRouteCandidate
+		usedPools = map[uint64]bool{}
+	)
+
+	for _, candidate := range candidates {
+		if uint64(len(selected)) == maxSplits {
+			break
+		}
+
+		sharesPool := false
+		for _, step := range candidate.route {
+			if usedPools[step.PoolId] {
+				sharesPool = true
+				break
+			}
+		}
+		if sharesPool {
+			continue
+		}
+
+		for _, step := range candidate.route {
+			usedPools[step.PoolId] = true
+		}
+		selected = append(selected, candidate)
+	}
+
+	return selected
+}
+
+// sortBestRouteCandidates sorts the candidates from the highest token out to the lowest,
+// preferring routes with fewer hops when the token out is equal.
+func sortBestRouteCandidates(candidates []bestRouteCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].tokenOut.Equal(candidates[j].tokenOut) {
+			return candidates[i].tokenOut.GT(candidates[j].tokenOut)
+		}
+		return len(candidates[i].route) < len(candidates[j].route)
+	})
+}
@@ -0,0 +1,267 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+var (
+	shallowPoolAmount = sdk.NewInt(1_000_000)
+	deepPoolAmount    = sdk.NewInt(1_000_000_000)
+	bestRouteSwapIn   = sdk.NewCoin(foo, sdk.NewInt(100_000))
+)
+
+func (s *KeeperTestSuite) TestEstimateBestRoute() {
+	tests := map[string]struct {
+		poolCoins     []sdk.Coins
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       uint64
+		maxSplits     uint64
+
+		expectedRoutes [][]types.SwapAmountInRoute
+		expectedError  error
+	}{
+		"single pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)), // pool 1.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       3,
+			maxSplits:     1,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: bar}},
+			},
+		},
+		"deep two hop route is preferred over shallow direct pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)), // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(baz, deepPoolAmount)),       // pool 2.
+				sdk.NewCoins(sdk.NewCoin(baz, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),       // pool 3.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       2,
+			maxSplits:     1,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 2, TokenOutDenom: baz}, {PoolId: 3, TokenOutDenom: bar}},
+			},
+		},
+		"max hops excludes the deeper route": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)), // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(baz, deepPoolAmount)),       // pool 2.
+				sdk.NewCoins(sdk.NewCoin(baz, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),       // pool 3.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       1,
+			maxSplits:     1,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: bar}},
+			},
+		},
+		"split across two shallow pools": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)), // pool 1.
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)), // pool 2.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       1,
+			maxSplits:     2,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: bar}},
+				{{PoolId: 2, TokenOutDenom: bar}},
+			},
+		},
+		"routes sharing a pool are not split": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)), // pool 1.
+				sdk.NewCoins(sdk.NewCoin(bar, deepPoolAmount), sdk.NewCoin(baz, deepPoolAmount)),       // pool 2.
+				sdk.NewCoins(sdk.NewCoin(bar, deepPoolAmount), sdk.NewCoin(baz, deepPoolAmount)),       // pool 3.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: baz,
+			maxHops:       2,
+			maxSplits:     2,
+
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: bar}, {PoolId: 2, TokenOutDenom: baz}},
+			},
+		},
+		"error: no route": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)), // pool 1.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: baz,
+			maxHops:       3,
+			maxSplits:     1,
+
+			expectedError: types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: baz},
+		},
+		"error: max hops is zero": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)), // pool 1.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       0,
+			maxSplits:     1,
+
+			expectedError: types.InvalidMaxHopsError{MaxHops: 0},
+		},
+		"error: too many splits": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)), // pool 1.
+			},
+			tokenIn:       bestRouteSwapIn,
+			tokenOutDenom: bar,
+			maxHops:       1,
+			maxSplits:     types.MaxBestRouteSplits + 1,
+
+			expectedError: types.InvalidMaxSplitsError{MaxSplits: types.MaxBestRouteSplits + 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+			s.createBalancerPoolsFromCoins(tc.poolCoins)
+
+			gasBefore := s.Ctx.GasMeter().GasConsumed()
+
+			routes, tokenOutAmount, err := poolmanagerKeeper.EstimateBestRoute(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxSplits)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Len(routes, len(tc.expectedRoutes))
+			totalTokenIn := sdk.ZeroInt()
+			for i, route := range routes {
+				s.Require().Equal(tc.expectedRoutes[i], route.Pools)
+				totalTokenIn = totalTokenIn.Add(route.TokenInAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount.String(), totalTokenIn.String())
+
+			// The estimate must match the output of every route estimated separately.
+			expectedTokenOutAmount := sdk.ZeroInt()
+			for _, route := range routes {
+				routeTokenOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route.Pools, sdk.NewCoin(tc.tokenIn.Denom, route.TokenInAmount))
+				s.Require().NoError(err)
+				expectedTokenOutAmount = expectedTokenOutAmount.Add(routeTokenOut)
+			}
+			s.Require().Equal(expectedTokenOutAmount.String(), tokenOutAmount.String())
+
+			// The search gas is charged to the context.
+			gasConsumed := s.Ctx.GasMeter().GasConsumed() - gasBefore
+			s.Require().Positive(gasConsumed)
+			s.Require().LessOrEqual(gasConsumed, uint64(types.BestRouteSearchGasLimit))
+		})
+	}
+}
+
+// TestEstimateBestRoute_SplitBeatsSingleRoute tests that splitting across shallow pools
+// yields more tokens than the best single route.
+func (s *KeeperTestSuite) TestEstimateBestRoute_SplitBeatsSingleRoute() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.createBalancerPoolsFromCoins([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+		sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+	})
+
+	_, singleTokenOut, err := poolmanagerKeeper.EstimateBestRoute(s.Ctx, bestRouteSwapIn, bar, 1, 1)
+	s.Require().NoError(err)
+
+	_, splitTokenOut, err := poolmanagerKeeper.EstimateBestRoute(s.Ctx, bestRouteSwapIn, bar, 1, 2)
+	s.Require().NoError(err)
+
+	s.Require().True(splitTokenOut.GT(singleTokenOut))
+}
+
+// TestEstimateBestRoute_ConcentratedPool tests that concentrated liquidity pools
+// are searched along with balancer pools.
+func (s *KeeperTestSuite) TestEstimateBestRoute_ConcentratedPool() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	// pool 1 is a shallow balancer pool, pool 2 is a concentrated pool with a full range position.
+	s.createBalancerPoolsFromCoins([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+	})
+	clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(foo, bar)
+
+	routes, _, err := poolmanagerKeeper.EstimateBestRoute(s.Ctx, bestRouteSwapIn, bar, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SwapAmountInSplitRoute{
+		{
+			Pools:         []types.SwapAmountInRoute{{PoolId: clPool.GetId(), TokenOutDenom: bar}},
+			TokenInAmount: bestRouteSwapIn.Amount,
+		},
+	}, routes)
+}
+
+func (s *KeeperTestSuite) TestSwapBestRoute() {
+	tests := map[string]struct {
+		tokenOutMinAmount sdk.Int
+		expectedError     bool
+	}{
+		"valid swap": {
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"error: token out min amount too high": {
+			tokenOutMinAmount: bestRouteSwapIn.Amount,
+			expectedError:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+			msgServer := poolmanager.NewMsgServerImpl(poolmanagerKeeper)
+			s.createBalancerPoolsFromCoins([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+			})
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(bestRouteSwapIn))
+
+			expectedRoutes, expectedTokenOut, err := poolmanagerKeeper.EstimateBestRoute(s.Ctx, bestRouteSwapIn, bar, 1, 2)
+			s.Require().NoError(err)
+
+			response, err := msgServer.SwapBestRoute(sdk.WrapSDKContext(s.Ctx), &types.MsgSwapBestRoute{
+				Sender:            sender.String(),
+				TokenIn:           bestRouteSwapIn,
+				TokenOutDenom:     bar,
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+				MaxHops:           1,
+				MaxSplits:         2,
+			})
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().Nil(response)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(expectedRoutes, response.Routes)
+			s.Require().Equal(expectedTokenOut.String(), response.TokenOutAmount.String())
+
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, foo).IsZero())
+			s.Require().Equal(expectedTokenOut.String(), s.App.BankKeeper.GetBalance(s.Ctx, sender, bar).Amount.String())
+		})
+	}
+}
@@ -25,10 +25,17 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateBestRoute)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalPoolLiquidity)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolume)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalVolumeForPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdDenomPairRoute)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllDenomPairRoutes)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountInByDenoms)
 
 	return cmd
 }
@@ -146,6 +153,17 @@ func GetCmdEstimateSinglePoolSwapExactAmountIn() (*osmocli.QueryDescriptor, *que
 	}, &queryproto.EstimateSinglePoolSwapExactAmountInRequest{}
 }
 
+// GetCmdEstimateBestRoute returns the route yielding the most token out for the given token in.
+func GetCmdEstimateBestRoute() (*osmocli.QueryDescriptor, *queryproto.EstimateBestRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-best-route <tokenIn> <tokenOutDenom> <maxHops> <maxSplits>",
+		Short: "Query estimate-best-route",
+		Long: `Query estimate-best-route.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-best-route 1000stake uosmo 3 2`,
+		QueryFnName: "EstimateBestRoute",
+	}, &queryproto.EstimateBestRouteRequest{}
+}
+
 // GetCmdEstimateSinglePoolSwapExactAmountOut returns estimation of input coin to get exact amount of x token output.
 func GetCmdEstimateSinglePoolSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.EstimateSinglePoolSwapExactAmountOutRequest) {
 	return &osmocli.QueryDescriptor{
@@ -165,3 +183,63 @@ func GetCmdTotalPoolLiquidity() (*osmocli.QueryDescriptor, *queryproto.TotalPool
 		{{.CommandPrefix}} total-pool-liquidity 1`,
 	}, &queryproto.TotalPoolLiquidityRequest{}
 }
+
+// GetCmdTradingPairTakerFee returns the taker fee charged on swaps between the given denoms.
+func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingPairTakerFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "trading-pair-taker-fee [denom0] [denom1]",
+		Short: "Query trading pair taker fee",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} trading-pair-taker-fee uosmo uion`,
+	}, &queryproto.TradingPairTakerFeeRequest{}
+}
+
+// GetCmdPoolVolume returns the cumulative amount of each denom swapped into a pool.
+func GetCmdPoolVolume() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume [poolID]",
+		Short: "Query the cumulative swap volume of a pool by denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-volume 1`,
+	}, &queryproto.PoolVolumeRequest{}
+}
+
+// GetCmdTotalVolumeForPool returns the cumulative swap volume of a pool in uosmo.
+func GetCmdTotalVolumeForPool() (*osmocli.QueryDescriptor, *queryproto.TotalVolumeForPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "total-volume-for-pool [poolID]",
+		Short: "Query the cumulative swap volume of a pool in uosmo",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} total-volume-for-pool 1`,
+	}, &queryproto.TotalVolumeForPoolRequest{}
+}
+
+// GetCmdDenomPairRoute returns the canonical route registered for a denom pair.
+func GetCmdDenomPairRoute() (*osmocli.QueryDescriptor, *queryproto.DenomPairRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-pair-route [token-in-denom] [token-out-denom]",
+		Short: "Query the canonical route registered for a denom pair",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} denom-pair-route uatom uosmo`,
+	}, &queryproto.DenomPairRouteRequest{}
+}
+
+// GetCmdAllDenomPairRoutes returns all the registered denom pair routes.
+func GetCmdAllDenomPairRoutes() (*osmocli.QueryDescriptor, *queryproto.AllDenomPairRoutesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-denom-pair-routes",
+		Short: "Query all the registered denom pair routes",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} all-denom-pair-routes`,
+	}, &queryproto.AllDenomPairRoutesRequest{}
+}
+
+// GetCmdEstimateSwapExactAmountInByDenoms returns the estimated token out of swapping through the registered route of a denom pair.
+func GetCmdEstimateSwapExactAmountInByDenoms() (*osmocli.QueryDescriptor, *queryproto.EstimateSwapExactAmountInByDenomsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-swap-exact-amount-in-by-denoms [token-in] [token-out-denom]",
+		Short: "Query estimate-swap-exact-amount-in through the registered route of a denom pair",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-swap-exact-amount-in-by-denoms 1000uatom uosmo`,
+	}, &queryproto.EstimateSwapExactAmountInByDenomsRequest{}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/spf13/cobra"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/pool-models/balancer"
@@ -31,6 +33,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewSwapBestRouteCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInByDenomsCmd)
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
@@ -63,6 +67,22 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 	}, &types.MsgSwapExactAmountOut{}
 }
 
+func NewSwapBestRouteCmd() (*osmocli.TxCliDesc, *types.MsgSwapBestRoute) {
+	return &osmocli.TxCliDesc{
+		Use:     "swap-best-route [token-in] [token-out-denom] [token-out-min-amount] [max-hops] [max-splits]",
+		Short:   "swap exact amount in through the best route found on-chain",
+		Example: "osmosisd tx poolmanager swap-best-route 2000000uosmo uion 1 3 2 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgSwapBestRoute{}
+}
+
+func NewSwapExactAmountInByDenomsCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInByDenoms) {
+	return &osmocli.TxCliDesc{
+		Use:     "swap-exact-amount-in-by-denoms [token-in] [token-out-denom] [token-out-min-amount]",
+		Short:   "swap exact amount in through the route registered for the denom pair",
+		Example: "osmosisd tx poolmanager swap-exact-amount-in-by-denoms 2000000uosmo uion 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgSwapExactAmountInByDenoms{}
+}
+
 func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountIn) {
 	return &osmocli.TxCliDesc{
 		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount] [flags]",
@@ -446,3 +466,187 @@ func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
 	}
 	return sdk.NormalizeCoins(decCoins), nil
 }
+
+func NewCmdHandleDenomPairTakerFeeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-pair-taker-fee-proposal [denom0-1,denom1-1,taker-fee-1,denom0-2,denom1-2,taker-fee-2,...] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a denom pair taker fee proposal",
+		Example: "osmosisd tx gov submit-proposal denom-pair-taker-fee-proposal uion,uosmo,0.0016,stake,uosmo,0.005 --from val --keyring-backend test --title \"Test\" --description \"Test\" -b=block --chain-id localosmosis --fees=100000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDenomPairTakerFeeArgToContent(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
+
+func parseDenomPairTakerFeeArgToContent(cmd *cobra.Command, arg string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	denomPairTakerFee, err := ParseDenomPairTakerFee(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.DenomPairTakerFeeProposal{
+		Title:             title,
+		Description:       description,
+		DenomPairTakerFee: denomPairTakerFee,
+	}
+
+	return content, nil
+}
+
+// ParseDenomPairTakerFee parses a comma separated list of denom0, denom1 and taker fee triplets.
+func ParseDenomPairTakerFee(arg string) ([]types.DenomPairTakerFee, error) {
+	denomPairTakerFeeRecords := strings.Split(arg, ",")
+
+	if len(denomPairTakerFeeRecords)%3 != 0 {
+		return nil, fmt.Errorf("denomPairTakerFeeRecords must be a list of denom0, denom1, and takerFee separated by commas")
+	}
+
+	var finalDenomPairTakerFeeRecords []types.DenomPairTakerFee
+	for i := 0; i < len(denomPairTakerFeeRecords); i += 3 {
+		denom0 := denomPairTakerFeeRecords[i]
+		denom1 := denomPairTakerFeeRecords[i+1]
+		takerFee, err := sdk.NewDecFromStr(denomPairTakerFeeRecords[i+2])
+		if err != nil {
+			return nil, err
+		}
+		finalDenomPairTakerFeeRecords = append(finalDenomPairTakerFeeRecords, types.DenomPairTakerFee{
+			Denom0:   denom0,
+			Denom1:   denom1,
+			TakerFee: takerFee,
+		})
+	}
+
+	return finalDenomPairTakerFeeRecords, nil
+}
+
+func NewCmdHandleDenomPairRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-pair-routes-proposal [token-in-denom] [token-out-denom] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal registering the route of a denom pair",
+		Long:    "Submit a proposal registering the route of a denom pair. If no route is given with the swap route flags, the route registered for the denom pair is removed.",
+		Example: "osmosisd tx gov submit-proposal denom-pair-routes-proposal uion uatom --swap-route-pool-ids 1,2 --swap-route-denoms uosmo,uatom --from val --keyring-backend test --title \"Test\" --description \"Test\" -b=block --chain-id localosmosis --fees=100000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDenomPairRoutesArgsToContent(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FlagSetMultihopSwapRoutes())
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
+
+func parseDenomPairRoutesArgsToContent(cmd *cobra.Command, tokenInDenom, tokenOutDenom string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty route removes the route registered for the denom pair.
+	route := []types.SwapAmountInRoute{}
+	if poolIds, err := cmd.Flags().GetString(FlagSwapRoutePoolIds); err == nil && poolIds != "" {
+		route, err = swapAmountInRoutes(cmd.Flags())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := &types.DenomPairRoutesProposal{
+		Title:       title,
+		Description: description,
+		DenomPairRoutes: []types.DenomPairRoute{{
+			TokenInDenom:  tokenInDenom,
+			TokenOutDenom: tokenOutDenom,
+			Route:         route,
+		}},
+	}
+
+	return content, nil
+}
@@ -20,6 +20,56 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) EstimateSwapExactAmountInByDenoms(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountInByDenomsRequest,
+) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountInByDenoms(ctx, *req)
+}
+
+func (q Querier) DenomPairRoute(grpcCtx context.Context,
+	req *queryproto.DenomPairRouteRequest,
+) (*queryproto.DenomPairRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DenomPairRoute(ctx, *req)
+}
+
+func (q Querier) AllDenomPairRoutes(grpcCtx context.Context,
+	req *queryproto.AllDenomPairRoutesRequest,
+) (*queryproto.AllDenomPairRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllDenomPairRoutes(ctx, *req)
+}
+
+func (q Querier) TotalVolumeForPool(grpcCtx context.Context,
+	req *queryproto.TotalVolumeForPoolRequest,
+) (*queryproto.TotalVolumeForPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TotalVolumeForPool(ctx, *req)
+}
+
+func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
+	req *queryproto.TradingPairTakerFeeRequest,
+) (*queryproto.TradingPairTakerFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TradingPairTakerFee(ctx, *req)
+}
+
 func (q Querier) TotalPoolLiquidity(grpcCtx context.Context,
 	req *queryproto.TotalPoolLiquidityRequest,
 ) (*queryproto.TotalPoolLiquidityResponse, error) {
@@ -50,6 +100,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) PoolVolume(grpcCtx context.Context,
+	req *queryproto.PoolVolumeRequest,
+) (*queryproto.PoolVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolume(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -110,6 +170,16 @@ func (q Querier) EstimateSinglePoolSwapExactAmountOut(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountOut(ctx, *req)
 }
 
+func (q Querier) EstimateBestRoute(grpcCtx context.Context,
+	req *queryproto.EstimateBestRouteRequest,
+) (*queryproto.EstimateBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateBestRoute(ctx, *req)
+}
+
 func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	req *queryproto.EstimateSinglePoolSwapExactAmountInRequest,
 ) (*queryproto.EstimateSwapExactAmountInResponse, error) {
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+var (
+	DenomPairTakerFeeProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal, DenomPairTakerFeeProposalRESTHandler)
+	DenomPairRoutesProposalHandler   = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairRoutesProposal, DenomPairRoutesProposalRESTHandler)
+)
+
+func DenomPairTakerFeeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "denom-pair-taker-fee",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func DenomPairRoutesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "denom-pair-routes",
+		Handler:  emptyHandler(clientCtx),
+	}
+}
+
+func emptyHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
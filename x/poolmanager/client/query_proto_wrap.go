@@ -75,6 +75,28 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 	}, nil
 }
 
+// EstimateBestRoute estimates the route yielding the most token out for the given token in.
+func (q Querier) EstimateBestRoute(ctx sdk.Context, req queryproto.EstimateBestRouteRequest) (*queryproto.EstimateBestRouteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	routes, tokenOutAmount, err := q.K.EstimateBestRoute(ctx, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxSplits)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 func (q Querier) EstimateSinglePoolSwapExactAmountOut(ctx sdk.Context, req queryproto.EstimateSinglePoolSwapExactAmountOutRequest) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
 	routeReq := &queryproto.EstimateSwapExactAmountOutRequest{
 		PoolId:   req.PoolId,
@@ -189,3 +211,85 @@ func (q Querier) TotalLiquidity(ctx sdk.Context, req queryproto.TotalLiquidityRe
 		Liquidity: totalLiquidity,
 	}, nil
 }
+
+// TradingPairTakerFee returns the taker fee for the given denom pair.
+func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPairTakerFeeRequest) (*queryproto.TradingPairTakerFeeResponse, error) {
+	takerFee, err := q.K.GetTradingPairTakerFee(ctx, req.Denom0, req.Denom1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.TradingPairTakerFeeResponse{
+		TakerFee: takerFee,
+	}, nil
+}
+
+// PoolVolume returns the cumulative amount of each denom swapped into the given pool.
+func (q Querier) PoolVolume(ctx sdk.Context, req queryproto.PoolVolumeRequest) (*queryproto.PoolVolumeResponse, error) {
+	poolVolume, err := q.K.GetPoolVolume(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.PoolVolumeResponse{
+		PoolVolume: poolVolume,
+	}, nil
+}
+
+// TotalVolumeForPool returns the cumulative volume of the given pool in uosmo.
+func (q Querier) TotalVolumeForPool(ctx sdk.Context, req queryproto.TotalVolumeForPoolRequest) (*queryproto.TotalVolumeForPoolResponse, error) {
+	osmoVolume, err := q.K.GetOsmoVolumeForPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.TotalVolumeForPoolResponse{
+		OsmoVolume: osmoVolume,
+	}, nil
+}
+
+// DenomPairRoute returns the canonical route registered for the given denom pair.
+func (q Querier) DenomPairRoute(ctx sdk.Context, req queryproto.DenomPairRouteRequest) (*queryproto.DenomPairRouteResponse, error) {
+	route, err := q.K.GetDenomPairRoute(ctx, req.TokenInDenom, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &queryproto.DenomPairRouteResponse{
+		Route: route,
+	}, nil
+}
+
+// AllDenomPairRoutes returns all the registered denom pair routes.
+func (q Querier) AllDenomPairRoutes(ctx sdk.Context, req queryproto.AllDenomPairRoutesRequest) (*queryproto.AllDenomPairRoutesResponse, error) {
+	denomPairRoutes, err := q.K.GetAllDenomPairRoutes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.AllDenomPairRoutesResponse{
+		DenomPairRoutes: denomPairRoutes,
+	}, nil
+}
+
+// EstimateSwapExactAmountInByDenoms estimates the token out of swapping the given token in
+// through the canonical route registered for the denom pair.
+func (q Querier) EstimateSwapExactAmountInByDenoms(ctx sdk.Context, req queryproto.EstimateSwapExactAmountInByDenomsRequest) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenOutAmount, err := q.K.EstimateSwapExactAmountInByDenoms(ctx, tokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
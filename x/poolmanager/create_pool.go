@@ -22,7 +22,8 @@ func (k Keeper) validateCreatedPool(ctx sdk.Context, poolId uint64, pool types.P
 // CreatePool attempts to create a pool returning the newly created pool ID or
 // an error upon failure. The pool creation fee is used to fund the community
 // pool. It will create a dedicated module account for the pool and sends the
-// initial liquidity to the created module account.
+// initial liquidity to the created module account. The pool hooks are called
+// once the initial liquidity is in the pool.
 //
 // After the initial liquidity is sent to the pool's account, this function calls an
 // InitializePool function from the source module. That module is responsible for:
@@ -64,6 +65,8 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	k.afterPoolCreated(ctx, sender, pool.GetId(), poolType)
+
 	return pool.GetId(), nil
 }
 
@@ -87,7 +90,14 @@ func (k Keeper) CreateConcentratedPoolAsPoolManager(ctx sdk.Context, msg types.C
 		return nil, types.InvalidPoolTypeError{PoolType: msg.GetPoolType()}
 	}
 
-	return k.createPoolZeroLiquidityNoCreationFee(ctx, msg)
+	pool, err := k.createPoolZeroLiquidityNoCreationFee(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.afterPoolCreated(ctx, creator, pool.GetId(), msg.GetPoolType())
+
+	return pool, nil
 }
 
 // createPoolZeroLiquidityNoCreationFee is an internal helper to create a pool from message with zero initial liquidity
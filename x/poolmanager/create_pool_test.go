@@ -66,7 +66,9 @@ func (s *KeeperTestSuite) TestPoolCreationFee() {
 
 		// set pool creation fee
 		poolmanagerKeeper.SetParams(s.Ctx, types.Params{
-			PoolCreationFee: test.poolCreationFee,
+			PoolCreationFee:  test.poolCreationFee,
+			TakerFeeParams:   types.DefaultParams().TakerFeeParams,
+			VolumeTwapWindow: types.DefaultVolumeTwapWindow,
 		})
 
 		// fund sender test account
@@ -0,0 +1,102 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// SetDenomPairRoute registers the route of the given record as the canonical route
+// for swapping its token in denom for its token out denom.
+func (k Keeper) SetDenomPairRoute(ctx sdk.Context, record types.DenomPairRoute) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatDenomPairRouteKey(record.TokenInDenom, record.TokenOutDenom), &record)
+}
+
+// DeleteDenomPairRoute removes the canonical route registered for swapping tokenInDenom for tokenOutDenom.
+func (k Keeper) DeleteDenomPairRoute(ctx sdk.Context, tokenInDenom, tokenOutDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatDenomPairRouteKey(tokenInDenom, tokenOutDenom))
+}
+
+// GetDenomPairRoute returns the canonical route registered for swapping tokenInDenom for tokenOutDenom.
+// Returns types.DenomPairRouteNotFoundError if no route is registered for the pair.
+func (k Keeper) GetDenomPairRoute(ctx sdk.Context, tokenInDenom, tokenOutDenom string) ([]types.SwapAmountInRoute, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	record := types.DenomPairRoute{}
+	found, err := osmoutils.Get(store, types.FormatDenomPairRouteKey(tokenInDenom, tokenOutDenom), &record)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.DenomPairRouteNotFoundError{TokenInDenom: tokenInDenom, TokenOutDenom: tokenOutDenom}
+	}
+
+	return record.Route, nil
+}
+
+// GetAllDenomPairRoutes returns all the registered denom pair routes.
+func (k Keeper) GetAllDenomPairRoutes(ctx sdk.Context) ([]types.DenomPairRoute, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.DenomPairRoutePrefix, func(bz []byte) (types.DenomPairRoute, error) {
+		record := types.DenomPairRoute{}
+		err := record.Unmarshal(bz)
+		return record, err
+	})
+}
+
+// SwapExactAmountInByDenoms swaps tokenIn for tokenOutDenom through the canonical route
+// registered for the pair. See RouteExactAmountIn for the swap itself.
+func (k Keeper) SwapExactAmountInByDenoms(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+) (sdk.Int, error) {
+	route, err := k.GetDenomPairRoute(ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.RouteExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
+}
+
+// EstimateSwapExactAmountInByDenoms estimates the token out of swapping tokenIn for tokenOutDenom
+// through the canonical route registered for the pair.
+func (k Keeper) EstimateSwapExactAmountInByDenoms(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Int, error) {
+	route, err := k.GetDenomPairRoute(ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+}
+
+// validateDenomPairRoutePools returns an error if any pool of the given route does not exist
+// or does not contain both the denom swapped into it and the denom swapped out of it.
+func (k Keeper) validateDenomPairRoutePools(ctx sdk.Context, record types.DenomPairRoute) error {
+	hopTokenInDenom := record.TokenInDenom
+	for _, hop := range record.Route {
+		denoms, err := k.RouteGetPoolDenoms(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+
+		if !osmoutils.Contains(denoms, hopTokenInDenom) || !osmoutils.Contains(denoms, hop.TokenOutDenom) {
+			return types.InvalidDenomPairRouteError{
+				TokenInDenom:  record.TokenInDenom,
+				TokenOutDenom: record.TokenOutDenom,
+				Reason:        fmt.Sprintf("pool %d does not contain both (%s) and (%s)", hop.PoolId, hopTokenInDenom, hop.TokenOutDenom),
+			}
+		}
+
+		hopTokenInDenom = hop.TokenOutDenom
+	}
+
+	return nil
+}
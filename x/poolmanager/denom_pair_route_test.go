@@ -0,0 +1,128 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// fooBazRoute is the route from foo to baz through the foo/bar and bar/baz pools
+// created by createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins}).
+var fooBazRoute = []types.SwapAmountInRoute{
+	{PoolId: 1, TokenOutDenom: bar},
+	{PoolId: 2, TokenOutDenom: baz},
+}
+
+func (s *KeeperTestSuite) TestHandleDenomPairRoutesProposal() {
+	tests := map[string]struct {
+		registeredRoutes []types.DenomPairRoute
+		records          []types.DenomPairRoute
+
+		expectedRoutes []types.DenomPairRoute
+		expectError    bool
+	}{
+		"register a multihop route": {
+			records:        []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute}},
+			expectedRoutes: []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute}},
+		},
+		"register routes in both directions": {
+			records: []types.DenomPairRoute{
+				{TokenInDenom: foo, TokenOutDenom: bar, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}},
+				{TokenInDenom: bar, TokenOutDenom: foo, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: foo}}},
+			},
+			expectedRoutes: []types.DenomPairRoute{
+				{TokenInDenom: bar, TokenOutDenom: foo, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: foo}}},
+				{TokenInDenom: foo, TokenOutDenom: bar, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}},
+			},
+		},
+		"empty route removes the registered route": {
+			registeredRoutes: []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute}},
+			records: []types.DenomPairRoute{
+				{TokenInDenom: foo, TokenOutDenom: baz},
+				{TokenInDenom: foo, TokenOutDenom: bar, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}},
+			},
+			expectedRoutes: []types.DenomPairRoute{
+				{TokenInDenom: foo, TokenOutDenom: bar, Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}},
+			},
+		},
+		"error: route does not end in token out denom": {
+			records:     []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute[:1]}},
+			expectError: true,
+		},
+		"error: pool does not contain token in denom": {
+			records:     []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute[1:]}},
+			expectError: true,
+		},
+		"error: pool does not exist": {
+			records:     []types.DenomPairRoute{{TokenInDenom: foo, TokenOutDenom: bar, Route: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: bar}}}},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+			s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+
+			for _, registeredRoute := range tc.registeredRoutes {
+				poolmanagerKeeper.SetDenomPairRoute(s.Ctx, registeredRoute)
+			}
+
+			proposal := types.NewDenomPairRoutesProposal("title", "description", tc.records)
+			err := poolmanagerKeeper.HandleDenomPairRoutesProposal(s.Ctx, proposal.(*types.DenomPairRoutesProposal))
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			routes, err := poolmanagerKeeper.GetAllDenomPairRoutes(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRoutes, routes)
+		})
+	}
+}
+
+// TestSwapExactAmountInByDenoms tests that swaps by denoms go through the registered route
+// and that the estimate matches the executed swap.
+func (s *KeeperTestSuite) TestSwapExactAmountInByDenoms() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	// No route is registered yet.
+	_, err := poolmanagerKeeper.EstimateSwapExactAmountInByDenoms(s.Ctx, tokenIn, baz)
+	s.Require().ErrorIs(err, types.DenomPairRouteNotFoundError{TokenInDenom: foo, TokenOutDenom: baz})
+	_, err = poolmanagerKeeper.SwapExactAmountInByDenoms(s.Ctx, sender, tokenIn, baz, sdk.OneInt())
+	s.Require().ErrorIs(err, types.DenomPairRouteNotFoundError{TokenInDenom: foo, TokenOutDenom: baz})
+
+	poolmanagerKeeper.SetDenomPairRoute(s.Ctx, types.DenomPairRoute{TokenInDenom: foo, TokenOutDenom: baz, Route: fooBazRoute})
+
+	// The route is not registered in the reverse direction.
+	_, err = poolmanagerKeeper.GetDenomPairRoute(s.Ctx, baz, foo)
+	s.Require().Error(err)
+
+	expectedTokenOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, fooBazRoute, tokenIn)
+	s.Require().NoError(err)
+
+	estimatedTokenOut, err := poolmanagerKeeper.EstimateSwapExactAmountInByDenoms(s.Ctx, tokenIn, baz)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenOut, estimatedTokenOut)
+
+	tokenOut, err := poolmanagerKeeper.SwapExactAmountInByDenoms(s.Ctx, sender, tokenIn, baz, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenOut, tokenOut)
+	s.Require().Equal(tokenOut, s.App.BankKeeper.GetBalance(s.Ctx, sender, baz).Amount)
+
+	// Both pools of the route were swapped through.
+	for _, poolId := range []uint64{1, 2} {
+		volume, err := poolmanagerKeeper.GetPoolVolume(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.Require().False(volume.IsZero())
+	}
+}
@@ -62,3 +62,32 @@ func (k Keeper) CreateOsmoMultihopExpectedSwapOuts(
 ) ([]sdk.Int, error) {
 	return k.createOsmoMultihopExpectedSwapOuts(ctx, route, tokenOut, cumulativeRouteSwapFee, sumOfSwapFees)
 }
+
+func (k Keeper) GetOsmoRoutedMultihopTotalTakerFee(ctx sdk.Context, inDenom, outDenom string) (
+	routeTakerFee sdk.Dec, sumOfTakerFees sdk.Dec, err error,
+) {
+	return k.getOsmoRoutedMultihopTotalTakerFee(ctx, inDenom, outDenom)
+}
+
+func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, takerFee sdk.Dec, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
+	return k.chargeTakerFee(ctx, tokenIn, takerFee, sender, exactIn)
+}
+
+func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTakerFee, error) {
+	return k.getAllTradingPairTakerFees(ctx)
+}
+
+func CalcTakerFeeExactIn(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	return calcTakerFeeExactIn(tokenIn, takerFee)
+}
+
+func CalcTakerFeeExactOut(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	return calcTakerFeeExactOut(tokenIn, takerFee)
+}
+
+// SetHooksUnsafe replaces the poolmanager hooks with the given hooks.
+// This utility function is only exposed for testing and should not be moved
+// outside of the _test.go files.
+func (k *Keeper) SetHooksUnsafe(hooks types.PoolHooks) {
+	k.hooks = hooks
+}
@@ -0,0 +1,57 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+func NewPoolManagerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.DenomPairTakerFeeProposal:
+			return k.HandleDenomPairTakerFeeProposal(ctx, c)
+		case *types.DenomPairRoutesProposal:
+			return k.HandleDenomPairRoutesProposal(ctx, c)
+		default:
+			return fmt.Errorf("unrecognized poolmanager proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleDenomPairTakerFeeProposal sets the taker fee of every denom pair in the proposal.
+func (k Keeper) HandleDenomPairTakerFeeProposal(ctx sdk.Context, p *types.DenomPairTakerFeeProposal) error {
+	if err := types.ValidateDenomPairTakerFees(p.DenomPairTakerFee); err != nil {
+		return err
+	}
+
+	for _, denomPairTakerFee := range p.DenomPairTakerFee {
+		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
+	}
+	return nil
+}
+
+// HandleDenomPairRoutesProposal registers the route of every denom pair in the proposal.
+// Records with an empty route remove the route registered for their denom pair.
+// The pools of every route must exist and contain the denoms swapped through them.
+func (k Keeper) HandleDenomPairRoutesProposal(ctx sdk.Context, p *types.DenomPairRoutesProposal) error {
+	if err := types.ValidateDenomPairRoutes(p.DenomPairRoutes, true); err != nil {
+		return err
+	}
+
+	for _, denomPairRoute := range p.DenomPairRoutes {
+		if len(denomPairRoute.Route) == 0 {
+			k.DeleteDenomPairRoute(ctx, denomPairRoute.TokenInDenom, denomPairRoute.TokenOutDenom)
+			continue
+		}
+
+		if err := k.validateDenomPairRoutePools(ctx, denomPairRoute); err != nil {
+			return err
+		}
+		k.SetDenomPairRoute(ctx, denomPairRoute)
+	}
+	return nil
+}
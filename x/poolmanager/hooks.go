@@ -0,0 +1,165 @@
+package poolmanager
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v17/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+var (
+	_ gammtypes.GammHooks                                      = &gammhook{}
+	_ concentratedliquiditytypes.ConcentratedLiquidityListener = &concentratedLiquidityListener{}
+)
+
+// afterPoolCreated calls the AfterPoolCreated pool hooks and pool hook contracts.
+func (k Keeper) afterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolType types.PoolType) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolCreated(ctx, sender, poolId, poolType)
+	}
+
+	k.callPoolHookContracts(ctx, types.AfterPoolCreatedSudoMsg{
+		AfterPoolCreated: types.AfterPoolCreatedMsg{Sender: sender.String(), PoolId: poolId, PoolType: poolType.String()},
+	})
+}
+
+// afterSwap calls the AfterSwap pool hooks and pool hook contracts.
+func (k Keeper) afterSwap(ctx sdk.Context, sender sdk.AccAddress, route []types.SwapHop) {
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, sender, route)
+	}
+
+	k.callPoolHookContracts(ctx, types.AfterSwapSudoMsg{
+		AfterSwap: types.AfterSwapMsg{Sender: sender.String(), Route: route},
+	})
+}
+
+// afterJoin calls the AfterJoin pool hooks and pool hook contracts.
+func (k Keeper) afterJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterJoin(ctx, sender, poolId, tokensIn)
+	}
+
+	k.callPoolHookContracts(ctx, types.AfterJoinSudoMsg{
+		AfterJoin: types.AfterJoinMsg{Sender: sender.String(), PoolId: poolId, TokensIn: tokensIn},
+	})
+}
+
+// afterExit calls the AfterExit pool hooks and pool hook contracts.
+func (k Keeper) afterExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterExit(ctx, sender, poolId, tokensOut)
+	}
+
+	k.callPoolHookContracts(ctx, types.AfterExitSudoMsg{
+		AfterExit: types.AfterExitMsg{Sender: sender.String(), PoolId: poolId, TokensOut: tokensOut},
+	})
+}
+
+// callPoolHookContracts sends the given sudo message to every pool hook contract in the params.
+func (k Keeper) callPoolHookContracts(ctx sdk.Context, sudoMsg interface{}) {
+	if k.contractKeeper == nil {
+		return
+	}
+
+	var contracts []string
+	k.paramSpace.Get(ctx, types.KeyPoolHookContracts, &contracts)
+	if len(contracts) == 0 {
+		return
+	}
+
+	msgBz, err := json.Marshal(sudoMsg)
+	if err != nil {
+		ctx.Logger().Error("failed to marshal pool hook sudo message", "error", err)
+		return
+	}
+
+	for _, contract := range contracts {
+		k.callPoolHookContract(ctx, contract, msgBz)
+	}
+}
+
+// callPoolHookContract sends msgBz to the given contract with at most types.PoolHookContractGasLimit gas.
+// If the contract errors, panics or runs out of gas, its state changes are reverted without failing the caller.
+// The gas consumed by the contract is charged to the given context.
+func (k Keeper) callPoolHookContract(ctx sdk.Context, contract string, msgBz []byte) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		ctx.Logger().Error("invalid pool hook contract address", "contract", contract, "error", err)
+		return
+	}
+
+	hookGasMeter := sdk.NewGasMeter(types.PoolHookContractGasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			ctx.Logger().Error("pool hook contract ran out of gas", "contract", contract)
+		}
+		ctx.GasMeter().ConsumeGas(hookGasMeter.GasConsumedToLimit(), "pool hook contract")
+	}()
+
+	_ = osmoutils.ApplyFuncIfNoError(ctx.WithGasMeter(hookGasMeter), func(cacheCtx sdk.Context) error {
+		_, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, msgBz)
+		return err
+	})
+}
+
+// gammhook forwards the x/gamm joins and exits to the pool hooks.
+// Pool creation and swaps are already handled by the poolmanager.
+type gammhook struct {
+	k *Keeper
+}
+
+func (k *Keeper) GammHooks() gammtypes.GammHooks {
+	return &gammhook{k}
+}
+
+func (hook *gammhook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	hook.k.afterJoin(ctx, sender, poolId, enterCoins)
+}
+
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	hook.k.afterExit(ctx, sender, poolId, exitCoins)
+}
+
+func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// concentratedLiquidityListener forwards the x/concentrated-liquidity liquidity changes to the pool hooks.
+// Pool creation and swaps are already handled by the poolmanager.
+type concentratedLiquidityListener struct {
+	k *Keeper
+}
+
+func (k *Keeper) ConcentratedLiquidityListener() concentratedliquiditytypes.ConcentratedLiquidityListener {
+	return &concentratedLiquidityListener{k}
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (l *concentratedLiquidityListener) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+func (l *concentratedLiquidityListener) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins) {
+	l.k.afterJoin(ctx, sender, poolId, tokensAdded)
+}
+
+func (l *concentratedLiquidityListener) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins) {
+	l.k.afterExit(ctx, sender, poolId, tokensRemoved)
+}
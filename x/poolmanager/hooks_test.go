@@ -0,0 +1,138 @@
+package poolmanager_test
+
+import (
+	"os"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v17/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+var _ types.PoolHooks = &poolHooksMock{}
+
+// poolHooksMock records the calls made to the pool hooks.
+type poolHooksMock struct {
+	createdPools []uint64
+	poolTypes    []types.PoolType
+	swapRoutes   [][]types.SwapHop
+	joins        map[uint64]sdk.Coins
+	exits        map[uint64]sdk.Coins
+}
+
+func newPoolHooksMock() *poolHooksMock {
+	return &poolHooksMock{joins: map[uint64]sdk.Coins{}, exits: map[uint64]sdk.Coins{}}
+}
+
+func (h *poolHooksMock) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolType types.PoolType) {
+	h.createdPools = append(h.createdPools, poolId)
+	h.poolTypes = append(h.poolTypes, poolType)
+}
+
+func (h *poolHooksMock) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, route []types.SwapHop) {
+	h.swapRoutes = append(h.swapRoutes, route)
+}
+
+func (h *poolHooksMock) AfterJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins) {
+	h.joins[poolId] = h.joins[poolId].Add(tokensIn...)
+}
+
+func (h *poolHooksMock) AfterExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins) {
+	h.exits[poolId] = h.exits[poolId].Add(tokensOut...)
+}
+
+// TestPoolHooks tests that the pool hooks are called on pool creation, swaps, joins and exits
+// of both CFMM and concentrated liquidity pools.
+func (s *KeeperTestSuite) TestPoolHooks() {
+	s.SetupTest()
+	hooks := newPoolHooksMock()
+	s.App.PoolManagerKeeper.SetHooksUnsafe(hooks)
+
+	// Pool creation.
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+	clPool := s.PrepareConcentratedPool()
+
+	s.Require().Equal([]uint64{1, 2, 3}, hooks.createdPools)
+	s.Require().Equal([]types.PoolType{types.Balancer, types.Balancer, types.Concentrated}, hooks.poolTypes)
+
+	// Multihop swap.
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount)))
+	tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: bar},
+		{PoolId: 2, TokenOutDenom: baz},
+	}, sdk.NewCoin(foo, defaultSwapAmount), sdk.OneInt())
+	s.Require().NoError(err)
+
+	s.Require().Len(hooks.swapRoutes, 1)
+	route := hooks.swapRoutes[0]
+	s.Require().Len(route, 2)
+	s.Require().Equal(uint64(1), route[0].PoolId)
+	s.Require().Equal(sdk.NewCoin(foo, defaultSwapAmount).String(), route[0].TokenIn.String())
+	s.Require().Equal(route[0].TokenOut.String(), route[1].TokenIn.String())
+	s.Require().Equal(uint64(2), route[1].PoolId)
+	s.Require().Equal(sdk.NewCoin(baz, tokenOutAmount).String(), route[1].TokenOut.String())
+
+	// CFMM join and exit.
+	s.FundAcc(sender, fooBarCoins)
+	tokensJoined, sharesOut, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, 1, gammtypes.OneShare.MulRaw(10), fooBarCoins)
+	s.Require().NoError(err)
+	s.Require().Equal(tokensJoined.String(), hooks.joins[1].String())
+
+	tokensExited, err := s.App.GAMMKeeper.ExitPool(s.Ctx, sender, 1, sharesOut, sdk.NewCoins())
+	s.Require().NoError(err)
+	s.Require().Equal(tokensExited.String(), hooks.exits[1].String())
+
+	// Concentrated liquidity join and exit.
+	positionId, liquidity := s.CreateFullRangePosition(clPool, sdk.NewCoins(sdk.NewCoin(clPool.GetToken0(), sdk.NewInt(1_000_000)), sdk.NewCoin(clPool.GetToken1(), sdk.NewInt(5_000_000_000))))
+	s.Require().False(hooks.joins[clPool.GetId()].IsZero())
+
+	amount0, amount1, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(clPool.GetToken0(), amount0), sdk.NewCoin(clPool.GetToken1(), amount1)).String(), hooks.exits[clPool.GetId()].String())
+}
+
+// TestPoolHookContracts tests that the pool hook contracts are called on swaps
+// and that a failing contract does not fail the swap.
+func (s *KeeperTestSuite) TestPoolHookContracts() {
+	tests := map[string]struct {
+		deployContract bool
+	}{
+		"contract that fails on the pool hook sudo messages": {
+			deployContract: true,
+		},
+		"address that is not a contract": {
+			deployContract: false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins})
+
+			hookContract := s.TestAccs[2]
+			if tc.deployContract {
+				contractKeeper := wasmkeeper.NewGovPermissionKeeper(s.App.WasmKeeper)
+				wasmCode, err := os.ReadFile("../tokenfactory/keeper/testdata/no100.wasm")
+				s.Require().NoError(err)
+				codeID, _, err := contractKeeper.Create(s.Ctx, s.TestAccs[0], wasmCode, nil)
+				s.Require().NoError(err)
+				hookContract, _, err = contractKeeper.Instantiate(s.Ctx, codeID, s.TestAccs[0], s.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+				s.Require().NoError(err)
+			}
+
+			params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			params.PoolHookContracts = []string{hookContract.String()}
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, params)
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount)))
+
+			_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 1, sdk.NewCoin(foo, defaultSwapAmount), bar, sdk.OneInt())
+			s.Require().NoError(err)
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, bar).IsPositive())
+		})
+	}
+}
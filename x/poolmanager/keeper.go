@@ -20,6 +20,11 @@ type Keeper struct {
 	bankKeeper           types.BankI
 	accountKeeper        types.AccountI
 	communityPoolKeeper  types.CommunityPoolI
+	protorevKeeper       types.ProtorevKeeperI
+	twapKeeper           types.TwapKeeperI
+	contractKeeper       types.ContractKeeperI
+
+	hooks types.PoolHooks
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -88,14 +93,44 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, poolRoute := range genState.PoolRoutes {
 		k.SetPoolRoute(ctx, poolRoute.PoolId, poolRoute.PoolType)
 	}
+
+	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
+		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
+	}
+
+	for _, poolVolume := range genState.PoolVolumes {
+		k.setPoolVolume(ctx, poolVolume)
+	}
+
+	for _, denomPairRoute := range genState.DenomPairRoutes {
+		k.SetDenomPairRoute(ctx, denomPairRoute)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	denomPairTakerFees, err := k.getAllTradingPairTakerFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	poolVolumes, err := k.getAllPoolVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	denomPairRoutes, err := k.GetAllDenomPairRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		NextPoolId: k.GetNextPoolId(ctx),
-		PoolRoutes: k.getAllPoolRoutes(ctx),
+		Params:                 k.GetParams(ctx),
+		NextPoolId:             k.GetNextPoolId(ctx),
+		PoolRoutes:             k.getAllPoolRoutes(ctx),
+		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolVolumes:            poolVolumes,
+		DenomPairRoutes:        denomPairRoutes,
 	}
 }
 
@@ -117,3 +152,29 @@ func (k Keeper) SetNextPoolId(ctx sdk.Context, poolId uint64) {
 func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentivesKeeperI) {
 	k.poolIncentivesKeeper = poolIncentivesKeeper
 }
+
+// SetProtorevKeeper sets protorev keeper
+func (k *Keeper) SetProtorevKeeper(protorevKeeper types.ProtorevKeeperI) {
+	k.protorevKeeper = protorevKeeper
+}
+
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeperI) {
+	k.twapKeeper = twapKeeper
+}
+
+// SetContractKeeper sets contract keeper
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeperI) {
+	k.contractKeeper = contractKeeper
+}
+
+// SetHooks sets the poolmanager hooks.
+func (k *Keeper) SetHooks(hooks types.PoolHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set poolmanager hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
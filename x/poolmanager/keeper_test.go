@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -29,6 +30,50 @@ var (
 			PoolType: types.Stableswap,
 		},
 	}
+	testTakerFeeParams = types.TakerFeeParams{
+		DefaultTakerFee: sdk.MustNewDecFromStr("0.0015"),
+		TakerFeeDistribution: types.TakerFeeDistributionPercentage{
+			StakingRewards: sdk.MustNewDecFromStr("0.3"),
+			CommunityPool:  sdk.MustNewDecFromStr("0.7"),
+		},
+	}
+	testVolumeTwapWindow   = 10 * time.Minute
+	testDenomPairTakerFees = []types.DenomPairTakerFee{
+		{
+			Denom0:   "bar",
+			Denom1:   "foo",
+			TakerFee: sdk.MustNewDecFromStr("0.0016"),
+		},
+		{
+			Denom0:   "baz",
+			Denom1:   "foo",
+			TakerFee: sdk.MustNewDecFromStr("0.0017"),
+		},
+	}
+	testPoolVolumes = []types.PoolVolume{
+		{
+			PoolId:     1,
+			PoolVolume: sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 2000)),
+			OsmoVolume: sdk.NewInt(3000),
+		},
+		{
+			PoolId:     2,
+			PoolVolume: sdk.NewCoins(sdk.NewInt64Coin("foo", 4000)),
+			OsmoVolume: sdk.ZeroInt(),
+		},
+	}
+	testDenomPairRoutes = []types.DenomPairRoute{
+		{
+			TokenInDenom:  "bar",
+			TokenOutDenom: "foo",
+			Route:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "foo"}},
+		},
+		{
+			TokenInDenom:  "foo",
+			TokenOutDenom: "baz",
+			Route:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}, {PoolId: 2, TokenOutDenom: "baz"}},
+		},
+	}
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -68,28 +113,64 @@ func (s *KeeperTestSuite) createBalancerPoolsFromCoins(poolCoins []sdk.Coins) {
 func (s *KeeperTestSuite) TestInitGenesis() {
 	s.App.PoolManagerKeeper.InitGenesis(s.Ctx, &types.GenesisState{
 		Params: types.Params{
-			PoolCreationFee: testPoolCreationFee,
+			PoolCreationFee:  testPoolCreationFee,
+			TakerFeeParams:   testTakerFeeParams,
+			VolumeTwapWindow: testVolumeTwapWindow,
 		},
-		NextPoolId: testExpectedPoolId,
-		PoolRoutes: testPoolRoute,
+		NextPoolId:             testExpectedPoolId,
+		PoolRoutes:             testPoolRoute,
+		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolVolumes:            testPoolVolumes,
+		DenomPairRoutes:        testDenomPairRoutes,
 	})
 
 	s.Require().Equal(uint64(testExpectedPoolId), s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx))
 	s.Require().Equal(testPoolCreationFee, s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
 	s.Require().Equal(testPoolRoute, s.App.PoolManagerKeeper.GetAllPoolRoutes(s.Ctx))
+	s.Require().Equal(testTakerFeeParams, s.App.PoolManagerKeeper.GetParams(s.Ctx).TakerFeeParams)
+	s.Require().Equal(testVolumeTwapWindow, s.App.PoolManagerKeeper.GetParams(s.Ctx).VolumeTwapWindow)
+
+	takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, "foo", "bar")
+	s.Require().NoError(err)
+	s.Require().Equal(testDenomPairTakerFees[0].TakerFee, takerFee)
+
+	poolVolume, err := s.App.PoolManagerKeeper.GetPoolVolume(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(testPoolVolumes[0].PoolVolume, poolVolume)
+
+	osmoVolume, err := s.App.PoolManagerKeeper.GetOsmoVolumeForPool(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(testPoolVolumes[0].OsmoVolume.String(), osmoVolume.String())
+
+	route, err := s.App.PoolManagerKeeper.GetDenomPairRoute(s.Ctx, "foo", "baz")
+	s.Require().NoError(err)
+	s.Require().Equal(testDenomPairRoutes[1].Route, route)
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
 	s.App.PoolManagerKeeper.InitGenesis(s.Ctx, &types.GenesisState{
 		Params: types.Params{
-			PoolCreationFee: testPoolCreationFee,
+			PoolCreationFee:  testPoolCreationFee,
+			TakerFeeParams:   testTakerFeeParams,
+			VolumeTwapWindow: testVolumeTwapWindow,
 		},
-		NextPoolId: testExpectedPoolId,
-		PoolRoutes: testPoolRoute,
+		NextPoolId:             testExpectedPoolId,
+		PoolRoutes:             testPoolRoute,
+		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolVolumes:            testPoolVolumes,
+		DenomPairRoutes:        testDenomPairRoutes,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
 	s.Require().Equal(uint64(testExpectedPoolId), genesis.NextPoolId)
 	s.Require().Equal(testPoolCreationFee, genesis.Params.PoolCreationFee)
 	s.Require().Equal(testPoolRoute, genesis.PoolRoutes)
+	s.Require().Equal(testTakerFeeParams, genesis.Params.TakerFeeParams)
+	s.Require().Equal(testVolumeTwapWindow, genesis.Params.VolumeTwapWindow)
+	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Len(genesis.PoolVolumes, len(testPoolVolumes))
+	for i, poolVolume := range genesis.PoolVolumes {
+		s.Require().True(testPoolVolumes[i].Equal(poolVolume))
+	}
+	s.Require().Equal(testDenomPairRoutes, genesis.DenomPairRoutes)
 }
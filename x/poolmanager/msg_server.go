@@ -119,3 +119,58 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 
 	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
+
+func (server msgServer) SwapBestRoute(goCtx context.Context, msg *types.MsgSwapBestRoute) (*types.MsgSwapBestRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, _, err := server.keeper.EstimateBestRoute(ctx, msg.TokenIn, msg.TokenOutDenom, msg.MaxHops, msg.MaxSplits)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, routes, msg.TokenIn.Denom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapBestRouteResponse{TokenOutAmount: tokenOutAmount, Routes: routes}, nil
+}
+
+func (server msgServer) SwapExactAmountInByDenoms(goCtx context.Context, msg *types.MsgSwapExactAmountInByDenoms) (*types.MsgSwapExactAmountInByDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SwapExactAmountInByDenoms(ctx, sender, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactAmountInByDenomsResponse{TokenOutAmount: tokenOutAmount}, nil
+}
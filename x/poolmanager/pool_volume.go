@@ -0,0 +1,108 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	appparams "github.com/osmosis-labs/osmosis/v17/app/params"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// trackVolume adds tokenIn to the cumulative volume of the given pool. The pool's OSMO volume
+// is increased by the value of the swap in uosmo. If neither tokenIn nor tokenOut is OSMO, tokenIn is
+// valued at the TWAP over the volume twap window of the OSMO pool it is paired with in protorev. Swaps
+// that cannot be valued in uosmo only add to the per-denom volume.
+func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOut sdk.Coin) {
+	poolVolume := k.getPoolVolume(ctx, poolId)
+	poolVolume.PoolVolume = poolVolume.PoolVolume.Add(tokenIn)
+
+	osmoVolume, found := k.getOsmoValue(ctx, tokenIn, tokenOut)
+	if found {
+		poolVolume.OsmoVolume = poolVolume.OsmoVolume.Add(osmoVolume)
+	}
+
+	k.setPoolVolume(ctx, poolVolume)
+}
+
+// getOsmoValue returns the value of a swap of tokenIn for tokenOut in uosmo.
+// Returns false if no OSMO price is available for tokenIn, including when the twap records of
+// the OSMO pool paired with tokenIn do not cover the whole volume twap window.
+func (k Keeper) getOsmoValue(ctx sdk.Context, tokenIn sdk.Coin, tokenOut sdk.Coin) (sdk.Int, bool) {
+	if tokenIn.Denom == appparams.BaseCoinUnit {
+		return tokenIn.Amount, true
+	}
+	if tokenOut.Denom == appparams.BaseCoinUnit {
+		return tokenOut.Amount, true
+	}
+	if k.protorevKeeper == nil || k.twapKeeper == nil {
+		return sdk.Int{}, false
+	}
+
+	osmoPoolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, appparams.BaseCoinUnit, tokenIn.Denom)
+	if err != nil {
+		return sdk.Int{}, false
+	}
+
+	// Number of uosmo per unit of tokenIn averaged over the volume twap window, so that swaps in the
+	// same block cannot significantly move the price used to value this one. Swaps are not valued at the
+	// spot price if the twap records do not cover the window, e.g. right after the pool was created.
+	startTime := ctx.BlockTime().Add(-k.GetParams(ctx).VolumeTwapWindow)
+	price, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, osmoPoolId, tokenIn.Denom, appparams.BaseCoinUnit, startTime)
+	if err != nil {
+		return sdk.Int{}, false
+	}
+
+	return price.MulInt(tokenIn.Amount).TruncateInt(), true
+}
+
+// GetPoolVolume returns the cumulative amount of each denom swapped into the given pool.
+// Returns error if the pool does not exist.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	if _, err := k.GetPoolModule(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	return k.getPoolVolume(ctx, poolId).PoolVolume, nil
+}
+
+// GetOsmoVolumeForPool returns the cumulative volume of the given pool in uosmo.
+// Returns error if the pool does not exist.
+func (k Keeper) GetOsmoVolumeForPool(ctx sdk.Context, poolId uint64) (sdk.Int, error) {
+	if _, err := k.GetPoolModule(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.getPoolVolume(ctx, poolId).OsmoVolume, nil
+}
+
+// getPoolVolume returns the volume stored for the given pool, or an empty volume if none is stored.
+func (k Keeper) getPoolVolume(ctx sdk.Context, poolId uint64) types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+
+	poolVolume := types.PoolVolume{}
+	found, err := osmoutils.Get(store, types.FormatPoolVolumeKey(poolId), &poolVolume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.PoolVolume{PoolId: poolId, PoolVolume: sdk.NewCoins(), OsmoVolume: sdk.ZeroInt()}
+	}
+
+	return poolVolume
+}
+
+// setPoolVolume stores the given pool volume.
+func (k Keeper) setPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatPoolVolumeKey(poolVolume.PoolId), &poolVolume)
+}
+
+// getAllPoolVolumes returns the volume of every pool that has one stored, ordered by pool id.
+func (k Keeper) getAllPoolVolumes(ctx sdk.Context) ([]types.PoolVolume, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.KeyPoolVolumePrefix, func(bz []byte) (types.PoolVolume, error) {
+		poolVolume := types.PoolVolume{}
+		err := poolVolume.Unmarshal(bz)
+		return poolVolume, err
+	})
+}
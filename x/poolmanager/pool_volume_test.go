@@ -0,0 +1,162 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// TestTrackVolume tests that swaps add the token in to the per-denom volume of each pool
+// they go through and that the OSMO volume is valued using the twap of the OSMO pool paired with the token in.
+func (s *KeeperTestSuite) TestTrackVolume() {
+	var (
+		// 1 foo is worth 2 uosmo in the foo/uosmo pool.
+		fooUosmoTwoToOneCoins = sdk.NewCoins(fooCoin, sdk.NewCoin(uosmo, defaultPoolInitAmount.MulRaw(2)))
+		swapAmount            = sdk.NewInt(1000)
+	)
+
+	tests := map[string]struct {
+		swap func(sender sdk.AccAddress) error
+		// twapRecordsTooRecent swaps right after the pools are created, before their
+		// twap records cover the volume twap window.
+		twapRecordsTooRecent bool
+
+		expectedVolumes     map[uint64]sdk.Coins
+		expectedOsmoVolumes map[uint64]sdk.Int
+	}{
+		"single pool swap of a token paired with OSMO": {
+			swap: func(sender sdk.AccAddress) error {
+				_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 2, sdk.NewCoin(foo, swapAmount), bar, sdk.OneInt())
+				return err
+			},
+			expectedVolumes: map[uint64]sdk.Coins{
+				1: sdk.NewCoins(),
+				2: sdk.NewCoins(sdk.NewCoin(foo, swapAmount)),
+			},
+			expectedOsmoVolumes: map[uint64]sdk.Int{
+				1: sdk.ZeroInt(),
+				2: swapAmount.MulRaw(2),
+			},
+		},
+		"single pool swap of a token paired with OSMO, twap records do not cover the window": {
+			swap: func(sender sdk.AccAddress) error {
+				_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 2, sdk.NewCoin(foo, swapAmount), bar, sdk.OneInt())
+				return err
+			},
+			twapRecordsTooRecent: true,
+			expectedVolumes: map[uint64]sdk.Coins{
+				2: sdk.NewCoins(sdk.NewCoin(foo, swapAmount)),
+			},
+			expectedOsmoVolumes: map[uint64]sdk.Int{
+				// Not valued at the spot price.
+				2: sdk.ZeroInt(),
+			},
+		},
+		"single pool swap of a token not paired with OSMO": {
+			swap: func(sender sdk.AccAddress) error {
+				_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 2, sdk.NewCoin(bar, swapAmount), foo, sdk.OneInt())
+				return err
+			},
+			expectedVolumes: map[uint64]sdk.Coins{
+				2: sdk.NewCoins(sdk.NewCoin(bar, swapAmount)),
+			},
+			expectedOsmoVolumes: map[uint64]sdk.Int{
+				2: sdk.ZeroInt(),
+			},
+		},
+		"multihop exact amount in": {
+			swap: func(sender sdk.AccAddress) error {
+				_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, []types.SwapAmountInRoute{
+					{PoolId: 1, TokenOutDenom: foo},
+					{PoolId: 2, TokenOutDenom: bar},
+				}, sdk.NewCoin(uosmo, swapAmount), sdk.OneInt())
+				return err
+			},
+			expectedVolumes: map[uint64]sdk.Coins{
+				1: sdk.NewCoins(sdk.NewCoin(uosmo, swapAmount)),
+				// 1000 uosmo is swapped for 499 foo in the 1:2 foo/uosmo pool.
+				2: sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(499))),
+			},
+			expectedOsmoVolumes: map[uint64]sdk.Int{
+				1: swapAmount,
+				2: sdk.NewInt(998),
+			},
+		},
+		"multihop exact amount out": {
+			swap: func(sender sdk.AccAddress) error {
+				_, err := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, sender, []types.SwapAmountOutRoute{
+					{PoolId: 2, TokenInDenom: bar},
+					{PoolId: 1, TokenInDenom: foo},
+				}, swapAmount.MulRaw(2), sdk.NewCoin(uosmo, swapAmount))
+				return err
+			},
+			expectedVolumes: map[uint64]sdk.Coins{
+				// 501 foo is required for 1000 uosmo, and 502 bar for 501 foo.
+				1: sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(501))),
+				2: sdk.NewCoins(sdk.NewCoin(bar, sdk.NewInt(502))),
+			},
+			expectedOsmoVolumes: map[uint64]sdk.Int{
+				// Valued at the uosmo received.
+				1: swapAmount,
+				// bar is not paired with OSMO.
+				2: sdk.ZeroInt(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.createBalancerPoolsFromCoins([]sdk.Coins{fooUosmoTwoToOneCoins, fooBarCoins})
+			if !tc.twapRecordsTooRecent {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.DefaultVolumeTwapWindow))
+			}
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, swapAmount), sdk.NewCoin(bar, swapAmount), sdk.NewCoin(uosmo, swapAmount)))
+
+			s.Require().NoError(tc.swap(sender))
+
+			for poolId, expectedVolume := range tc.expectedVolumes {
+				volume, err := s.App.PoolManagerKeeper.GetPoolVolume(s.Ctx, poolId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedVolume.String(), volume.String())
+			}
+			for poolId, expectedOsmoVolume := range tc.expectedOsmoVolumes {
+				osmoVolume, err := s.App.PoolManagerKeeper.GetOsmoVolumeForPool(s.Ctx, poolId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedOsmoVolume.String(), osmoVolume.String())
+			}
+		})
+	}
+}
+
+// TestTrackVolume_Cumulative tests that the volume of a pool accumulates across swaps.
+func (s *KeeperTestSuite) TestTrackVolume_Cumulative() {
+	s.SetupTest()
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooUosmoCoins})
+
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount), sdk.NewCoin(uosmo, defaultSwapAmount)))
+
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 1, sdk.NewCoin(foo, defaultSwapAmount), uosmo, sdk.OneInt())
+	s.Require().NoError(err)
+	fooOsmoValue := s.App.BankKeeper.GetBalance(s.Ctx, sender, uosmo).Amount.Sub(defaultSwapAmount)
+
+	_, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, 1, sdk.NewCoin(uosmo, defaultSwapAmount), foo, sdk.OneInt())
+	s.Require().NoError(err)
+
+	volume, err := s.App.PoolManagerKeeper.GetPoolVolume(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount), sdk.NewCoin(uosmo, defaultSwapAmount)).String(), volume.String())
+
+	osmoVolume, err := s.App.PoolManagerKeeper.GetOsmoVolumeForPool(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(fooOsmoValue.Add(defaultSwapAmount).String(), osmoVolume.String())
+
+	// Querying the volume of a pool that does not exist fails.
+	_, err = s.App.PoolManagerKeeper.GetPoolVolume(s.Ctx, 2)
+	s.Require().Error(err)
+	_, err = s.App.PoolManagerKeeper.GetOsmoVolumeForPool(s.Ctx, 2)
+	s.Require().Error(err)
+}
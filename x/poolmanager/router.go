@@ -27,11 +27,40 @@ func (k Keeper) RouteExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	return k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount, true)
+}
+
+// RouteExactAmountInWithoutTakerFee is RouteExactAmountIn without charging the taker fee on any hop of the route.
+// It is meant for swaps made by the protocol itself, such as the swaps of the non-OSMO tx fees collected by x/txfees,
+// which would otherwise pay a taker fee to the same destinations as the fees themselves, and the swaps of the
+// protorev profits to OSMO.
+func (k Keeper) RouteExactAmountInWithoutTakerFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	return k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount, false)
+}
+
+// routeExactAmountIn implements RouteExactAmountIn, charging the taker fee on every hop of the route if
+// chargeTakerFee is true.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+	chargeTakerFee bool,
 ) (tokenOutAmount sdk.Int, err error) {
 	var (
 		isMultiHopRouted   bool
 		routeSpreadFactor  sdk.Dec
 		sumOfSpreadFactors sdk.Dec
+		routeTakerFee      sdk.Dec
+		sumOfTakerFees     sdk.Dec
 	)
 
 	// Ensure that provided route is not empty and has valid denom format.
@@ -50,15 +79,23 @@ func (k Keeper) RouteExactAmountIn(
 	// two pools to later calculate the following:
 	// total_spread_factor = max(spread_factor1, spread_factor2)
 	// fee_per_pool = total_spread_factor * ((pool_fee) / (spread_factor1 + spread_factor2))
+	// The taker fee of each hop is discounted the same way.
 	if k.isOsmoRoutedMultihop(ctx, routeStep, route[0].TokenOutDenom, tokenIn.Denom) {
 		isMultiHopRouted = true
 		routeSpreadFactor, sumOfSpreadFactors, err = k.getOsmoRoutedMultihopTotalSpreadFactor(ctx, routeStep)
 		if err != nil {
 			return sdk.Int{}, err
 		}
+		if chargeTakerFee {
+			routeTakerFee, sumOfTakerFees, err = k.getOsmoRoutedMultihopTotalTakerFee(ctx, tokenIn.Denom, route[len(route)-1].TokenOutDenom)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+		}
 	}
 
 	// Iterate through the route and execute a series of swaps through each pool.
+	swapHops := make([]types.SwapHop, 0, len(route))
 	for i, routeStep := range route {
 		// To prevent the multihop swap from being interrupted prematurely, we keep
 		// the minimum expected output at a very low number until the last pool
@@ -92,14 +129,33 @@ func (k Keeper) RouteExactAmountIn(
 			spreadFactor = routeSpreadFactor.MulRoundUp((spreadFactor.QuoRoundUp(sumOfSpreadFactors)))
 		}
 
-		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, routeStep.TokenOutDenom, _outMinAmount, spreadFactor)
+		// Charge the taker fee on the current hop and swap the remainder.
+		tokenInAfterTakerFee := tokenIn
+		if chargeTakerFee {
+			takerFee, err := k.getHopTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom, isMultiHopRouted, routeTakerFee, sumOfTakerFees)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			tokenInAfterTakerFee, err = k.chargeTakerFee(ctx, tokenIn, takerFee, sender, true)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+		}
+
+		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterTakerFee, routeStep.TokenOutDenom, _outMinAmount, spreadFactor)
 		if err != nil {
 			return sdk.Int{}, err
 		}
 
+		k.trackVolume(ctx, pool.GetId(), tokenInAfterTakerFee, sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount))
+		swapHops = append(swapHops, types.SwapHop{PoolId: pool.GetId(), TokenIn: tokenInAfterTakerFee, TokenOut: sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)})
+
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
 	}
+
+	k.afterSwap(ctx, sender, swapHops)
+
 	return tokenOutAmount, nil
 }
 
@@ -176,6 +232,8 @@ func (k Keeper) SplitRouteExactAmountIn(
 // The method succeeds when tokenOutAmount is greater than tokenOutMinAmount defined.
 // Errors otherwise. Also, errors if the pool id is invalid, if tokens do not belong to the pool with given
 // id or if sender does not have the swapped-in tokenIn.
+// The swap is routed through RouteExactAmountIn as a single hop route, so that it is charged the taker fee,
+// tracked in the pool volume and reported to the pool hooks like any other swap.
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -184,34 +242,8 @@ func (k Keeper) SwapExactAmountIn(
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
 ) (tokenOutAmount sdk.Int, err error) {
-	// Get the pool-specific module implementation to ensure that
-	// swaps are routed to the pool type corresponding to pool ID's pool.
-	swapModule, err := k.GetPoolModule(ctx, poolId)
-	if err != nil {
-		return sdk.Int{}, err
-	}
-
-	// Get pool as a general pool type. Note that the underlying function used
-	// still varies with the pool type.
-	pool, poolErr := swapModule.GetPool(ctx, poolId)
-	if poolErr != nil {
-		return sdk.Int{}, poolErr
-	}
-
-	// Check if pool has swaps enabled.
-	if !pool.IsActive(ctx) {
-		return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
-	}
-
-	spreadFactor := pool.GetSpreadFactor(ctx)
-
-	// routeStep to the pool-specific SwapExactAmountIn implementation.
-	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, spreadFactor)
-	if err != nil {
-		return sdk.Int{}, err
-	}
-
-	return tokenOutAmount, nil
+	route := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	return k.RouteExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
 }
 
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
@@ -223,6 +255,8 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		isMultiHopRouted   bool
 		routeSpreadFactor  sdk.Dec
 		sumOfSpreadFactors sdk.Dec
+		routeTakerFee      sdk.Dec
+		sumOfTakerFees     sdk.Dec
 	)
 
 	// recover from panic
@@ -244,6 +278,10 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		if err != nil {
 			return sdk.Int{}, err
 		}
+		routeTakerFee, sumOfTakerFees, err = k.getOsmoRoutedMultihopTotalTakerFee(ctx, tokenIn.Denom, route[len(route)-1].TokenOutDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
 	}
 
 	for _, routeStep := range route {
@@ -266,7 +304,14 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			spreadFactor = routeSpreadFactor.Mul((spreadFactor.Quo(sumOfSpreadFactors)))
 		}
 
-		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, routeStep.TokenOutDenom, spreadFactor)
+		// Deduct the taker fee the same way the swap itself would.
+		takerFee, err := k.getHopTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom, isMultiHopRouted, routeTakerFee, sumOfTakerFees)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInAfterTakerFee, _ := calcTakerFeeExactIn(tokenIn, takerFee)
+
+		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenInAfterTakerFee, routeStep.TokenOutDenom, spreadFactor)
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -295,6 +340,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, sdk.Dec{}, sdk.Dec{}
+	routeTakerFee, sumOfTakerFees := sdk.Dec{}, sdk.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
 	routeStep := types.SwapAmountOutRoutes(route)
 	if err := routeStep.Validate(); err != nil {
@@ -328,6 +374,10 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		if err != nil {
 			return sdk.Int{}, err
 		}
+		routeTakerFee, sumOfTakerFees, err = k.getOsmoRoutedMultihopTotalTakerFee(ctx, route[0].TokenInDenom, tokenOut.Denom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
 		insExpected, err = k.createOsmoMultihopExpectedSwapOuts(ctx, route, tokenOut, routeSpreadFactor, sumOfSpreadFactors)
 	} else {
 		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
@@ -344,6 +394,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
 	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
 	// swaps on each pool.
+	swapHops := make([]types.SwapHop, 0, len(route))
 	for i, routeStep := range route {
 		// Get underlying pool type corresponding to the pool ID at the current routeStep.
 		swapModule, err := k.GetPoolModule(ctx, routeStep.PoolId)
@@ -382,6 +433,24 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return sdk.Int{}, swapErr
 		}
 
+		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, _tokenInAmount), _tokenOut)
+		swapHops = append(swapHops, types.SwapHop{PoolId: pool.GetId(), TokenIn: sdk.NewCoin(routeStep.TokenInDenom, _tokenInAmount), TokenOut: _tokenOut})
+
+		// Charge the taker fee on top of the amount swapped into the current pool.
+		takerFee, err := k.getHopTakerFee(ctx, routeStep.TokenInDenom, _tokenOut.Denom, isMultiHopRouted, routeTakerFee, sumOfTakerFees)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInWithTakerFee, err := k.chargeTakerFee(ctx, sdk.NewCoin(routeStep.TokenInDenom, _tokenInAmount), takerFee, sender, false)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		_tokenInAmount = tokenInWithTakerFee.Amount
+
+		if _tokenInAmount.GT(insExpected[i]) {
+			return sdk.Int{}, types.PriceImpactProtectionExactOutError{Actual: _tokenInAmount, MaxAmount: insExpected[i]}
+		}
+
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
 		// swaps.
@@ -390,6 +459,8 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 	}
 
+	k.afterSwap(ctx, sender, swapHops)
+
 	return tokenInAmount, nil
 }
 
@@ -643,7 +714,7 @@ func (k Keeper) getOsmoRoutedMultihopTotalSpreadFactor(ctx sdk.Context, route ty
 // the routeStep of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
 // amount for this last pool and then chains that input as the output of the previous pool in the routeStep, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
-// routeStep of pools for the original multihop transaction.
+// routeStep of pools for the original multihop transaction. Each input includes the taker fee charged on its hop.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
@@ -668,6 +739,13 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
+		// Account for the taker fee charged on top of the pool's input.
+		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		if err != nil {
+			return nil, err
+		}
+		tokenIn, _ = calcTakerFeeExactOut(tokenIn, takerFee)
+
 		insExpected[i] = tokenIn.Amount
 		tokenOut = tokenIn
 	}
@@ -675,13 +753,19 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	return insExpected, nil
 }
 
-// createOsmoMultihopExpectedSwapOuts does the same as createMultihopExpectedSwapOuts, however discounts the swap fee.
+// createOsmoMultihopExpectedSwapOuts does the same as createMultihopExpectedSwapOuts, however discounts the swap fee
+// and the taker fee.
 func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 	cumulativeRouteSpreadFactor, sumOfSpreadFactors sdk.Dec,
 ) ([]sdk.Int, error) {
+	routeTakerFee, sumOfTakerFees, err := k.getOsmoRoutedMultihopTotalTakerFee(ctx, route[0].TokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+
 	insExpected := make([]sdk.Int, len(route))
 	for i := len(route) - 1; i >= 0; i-- {
 		routeStep := route[i]
@@ -702,6 +786,13 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
+		// Account for the discounted taker fee charged on top of the pool's input.
+		takerFee, err := k.getHopTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom, true, routeTakerFee, sumOfTakerFees)
+		if err != nil {
+			return nil, err
+		}
+		tokenIn, _ = calcTakerFeeExactOut(tokenIn, takerFee)
+
 		insExpected[i] = tokenIn.Amount
 		tokenOut = tokenIn
 	}
@@ -0,0 +1,176 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	appparams "github.com/osmosis-labs/osmosis/v17/app/params"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// SetDenomPairTakerFee sets the taker fee for the given trading pair,
+// overriding the default taker fee for swaps between the two denoms.
+// The order of the denoms does not matter.
+func (k Keeper) SetDenomPairTakerFee(ctx sdk.Context, denom0, denom1 string, takerFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatDenomTradePairKey(denom0, denom1), &sdk.DecProto{Dec: takerFee})
+}
+
+// GetTradingPairTakerFee returns the taker fee for the given trading pair.
+// If the trading pair does not exist in the denom pair taker fee store, it
+// returns the default taker fee.
+func (k Keeper) GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (sdk.Dec, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	takerFee := &sdk.DecProto{}
+	found, err := osmoutils.Get(store, types.FormatDenomTradePairKey(denom0, denom1), takerFee)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !found {
+		return k.GetParams(ctx).TakerFeeParams.DefaultTakerFee, nil
+	}
+
+	return takerFee.Dec, nil
+}
+
+// getAllTradingPairTakerFees returns all the custom taker fees set for denom pairs.
+func (k Keeper) getAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTakerFee, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(store, types.DenomTradePairPrefix, parseDenomPairTakerFeeWithKey)
+}
+
+// parseDenomPairTakerFeeWithKey parses the denom pair from the key
+// and the taker fee from the value. Returns error if parsing fails.
+func parseDenomPairTakerFeeWithKey(key []byte, value []byte) (types.DenomPairTakerFee, error) {
+	denom0, denom1, err := types.ParseDenomTradePairKey(key)
+	if err != nil {
+		return types.DenomPairTakerFee{}, err
+	}
+
+	takerFee := &sdk.DecProto{}
+	if err := takerFee.Unmarshal(value); err != nil {
+		return types.DenomPairTakerFee{}, err
+	}
+
+	return types.DenomPairTakerFee{
+		Denom0:   denom0,
+		Denom1:   denom1,
+		TakerFee: takerFee.Dec,
+	}, nil
+}
+
+// getOsmoRoutedMultihopTotalTakerFee is the taker fee counterpart of getOsmoRoutedMultihopTotalSpreadFactor.
+// Given the in and out denoms of an OSMO-routed multihop, it returns the taker fee for the whole route and
+// the sum of the taker fees of both hops. Each hop is then charged routeTakerFee * (hopTakerFee / sumOfTakerFees),
+// which discounts the route to the highest taker fee of its two hops instead of charging both of them.
+func (k Keeper) getOsmoRoutedMultihopTotalTakerFee(ctx sdk.Context, inDenom, outDenom string) (
+	routeTakerFee sdk.Dec, sumOfTakerFees sdk.Dec, err error,
+) {
+	firstHopTakerFee, err := k.GetTradingPairTakerFee(ctx, inDenom, appparams.BaseCoinUnit)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	secondHopTakerFee, err := k.GetTradingPairTakerFee(ctx, appparams.BaseCoinUnit, outDenom)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return sdk.MaxDec(firstHopTakerFee, secondHopTakerFee), firstHopTakerFee.Add(secondHopTakerFee), nil
+}
+
+// getHopTakerFee returns the taker fee to charge on a single hop of a route swapping
+// tokenInDenom for tokenOutDenom. If the route is an OSMO-routed multihop, the
+// taker fee is discounted by the route-wide values of getOsmoRoutedMultihopTotalTakerFee.
+func (k Keeper) getHopTakerFee(ctx sdk.Context, tokenInDenom, tokenOutDenom string, isMultiHopRouted bool, routeTakerFee, sumOfTakerFees sdk.Dec) (sdk.Dec, error) {
+	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if isMultiHopRouted && sumOfTakerFees.IsPositive() {
+		takerFee = routeTakerFee.MulRoundUp(takerFee.QuoRoundUp(sumOfTakerFees))
+	}
+
+	return takerFee, nil
+}
+
+// chargeTakerFee charges the taker fee on the given tokenIn and sends it from the sender
+// to the taker fee destinations.
+// If exactIn is true, the taker fee is deducted from tokenIn and the returned coin
+// is the amount left to swap.
+// If exactIn is false, tokenIn is the amount that the pool requires and the taker fee is
+// charged on top of it. The returned coin is the total amount paid by the sender.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, takerFee sdk.Dec, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
+	var tokenInAfterTakerFee, takerFeeCoin sdk.Coin
+	if exactIn {
+		tokenInAfterTakerFee, takerFeeCoin = calcTakerFeeExactIn(tokenIn, takerFee)
+	} else {
+		tokenInAfterTakerFee, takerFeeCoin = calcTakerFeeExactOut(tokenIn, takerFee)
+	}
+
+	if !takerFeeCoin.IsPositive() {
+		return tokenInAfterTakerFee, nil
+	}
+
+	if err := k.distributeTakerFee(ctx, sender, takerFeeCoin); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTakerFeeCharged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyTakerFee, takerFeeCoin.String()),
+	))
+
+	return tokenInAfterTakerFee, nil
+}
+
+// distributeTakerFee sends the taker fee from the sender to the staking rewards
+// fee collector and the community pool according to the taker fee distribution
+// parameters.
+func (k Keeper) distributeTakerFee(ctx sdk.Context, sender sdk.AccAddress, takerFeeCoin sdk.Coin) error {
+	distribution := k.GetParams(ctx).TakerFeeParams.TakerFeeDistribution
+
+	stakingRewardsAmount := distribution.StakingRewards.MulInt(takerFeeCoin.Amount).TruncateInt()
+	communityPoolAmount := takerFeeCoin.Amount.Sub(stakingRewardsAmount)
+
+	if stakingRewardsAmount.IsPositive() {
+		stakingRewardsCoins := sdk.NewCoins(sdk.NewCoin(takerFeeCoin.Denom, stakingRewardsAmount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, stakingRewardsCoins); err != nil {
+			return err
+		}
+	}
+
+	if communityPoolAmount.IsPositive() {
+		communityPoolCoins := sdk.NewCoins(sdk.NewCoin(takerFeeCoin.Denom, communityPoolAmount))
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, communityPoolCoins, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// calcTakerFeeExactIn returns the amount left to swap after deducting the taker fee
+// from tokenIn, as well as the taker fee itself. The taker fee is rounded up, so that
+// a positive taker fee charges at least one unit of tokenIn however small it is.
+func calcTakerFeeExactIn(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	takerFeeAmount := tokenIn.Amount.ToDec().MulRoundUp(takerFee).Ceil().TruncateInt()
+	takerFeeCoin := sdk.NewCoin(tokenIn.Denom, takerFeeAmount)
+	tokenInAfterSubTakerFee := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(takerFeeAmount))
+
+	return tokenInAfterSubTakerFee, takerFeeCoin
+}
+
+// calcTakerFeeExactOut returns the total amount to pay so that tokenIn is left
+// after deducting the taker fee, as well as the taker fee itself. The total amount is rounded up.
+func calcTakerFeeExactOut(tokenIn sdk.Coin, takerFee sdk.Dec) (sdk.Coin, sdk.Coin) {
+	amountInAfterAddTakerFee := tokenIn.Amount.ToDec().Quo(sdk.OneDec().Sub(takerFee)).Ceil().TruncateInt()
+	tokenInAfterAddTakerFee := sdk.NewCoin(tokenIn.Denom, amountInAfterAddTakerFee)
+	takerFeeCoin := sdk.NewCoin(tokenIn.Denom, amountInAfterAddTakerFee.Sub(tokenIn.Amount))
+
+	return tokenInAfterAddTakerFee, takerFeeCoin
+}
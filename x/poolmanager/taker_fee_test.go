@@ -0,0 +1,413 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+var (
+	defaultTakerFee = sdk.MustNewDecFromStr("0.002")
+	customTakerFee  = sdk.MustNewDecFromStr("0.005")
+)
+
+func (s *KeeperTestSuite) setTakerFeeParams(defaultTakerFee, stakingRewards, communityPool sdk.Dec) {
+	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	params.TakerFeeParams = types.TakerFeeParams{
+		DefaultTakerFee: defaultTakerFee,
+		TakerFeeDistribution: types.TakerFeeDistributionPercentage{
+			StakingRewards: stakingRewards,
+			CommunityPool:  communityPool,
+		},
+	}
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestGetTradingPairTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+
+	// No custom fee set, default is returned.
+	takerFee, err := poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, foo, bar)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultTakerFee, takerFee)
+
+	// Custom fee is set regardless of the order of the denoms.
+	poolmanagerKeeper.SetDenomPairTakerFee(s.Ctx, foo, bar, customTakerFee)
+
+	takerFee, err = poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, foo, bar)
+	s.Require().NoError(err)
+	s.Require().Equal(customTakerFee, takerFee)
+
+	takerFee, err = poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, bar, foo)
+	s.Require().NoError(err)
+	s.Require().Equal(customTakerFee, takerFee)
+
+	// Other pairs are not affected.
+	takerFee, err = poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, foo, baz)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultTakerFee, takerFee)
+
+	// A zero custom fee overrides a non-zero default.
+	poolmanagerKeeper.SetDenomPairTakerFee(s.Ctx, baz, foo, sdk.ZeroDec())
+
+	takerFee, err = poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, foo, baz)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroDec(), takerFee)
+
+	allTakerFees, err := poolmanagerKeeper.GetAllTradingPairTakerFees(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomPairTakerFee{
+		{Denom0: bar, Denom1: foo, TakerFee: customTakerFee},
+		{Denom0: baz, Denom1: foo, TakerFee: sdk.ZeroDec()},
+	}, allTakerFees)
+}
+
+func (s *KeeperTestSuite) TestCalcTakerFee() {
+	tests := map[string]struct {
+		tokenIn  sdk.Coin
+		takerFee sdk.Dec
+
+		expectedTokenInAfterTakerFeeExactIn  sdk.Coin
+		expectedTakerFeeExactIn              sdk.Coin
+		expectedTokenInAfterTakerFeeExactOut sdk.Coin
+		expectedTakerFeeExactOut             sdk.Coin
+	}{
+		"zero taker fee": {
+			tokenIn:  sdk.NewCoin(foo, sdk.NewInt(1000)),
+			takerFee: sdk.ZeroDec(),
+
+			expectedTokenInAfterTakerFeeExactIn:  sdk.NewCoin(foo, sdk.NewInt(1000)),
+			expectedTakerFeeExactIn:              sdk.NewCoin(foo, sdk.ZeroInt()),
+			expectedTokenInAfterTakerFeeExactOut: sdk.NewCoin(foo, sdk.NewInt(1000)),
+			expectedTakerFeeExactOut:             sdk.NewCoin(foo, sdk.ZeroInt()),
+		},
+		"exact division": {
+			tokenIn:  sdk.NewCoin(foo, sdk.NewInt(1000)),
+			takerFee: sdk.MustNewDecFromStr("0.2"),
+
+			expectedTokenInAfterTakerFeeExactIn:  sdk.NewCoin(foo, sdk.NewInt(800)),
+			expectedTakerFeeExactIn:              sdk.NewCoin(foo, sdk.NewInt(200)),
+			expectedTokenInAfterTakerFeeExactOut: sdk.NewCoin(foo, sdk.NewInt(1250)),
+			expectedTakerFeeExactOut:             sdk.NewCoin(foo, sdk.NewInt(250)),
+		},
+		"rounding in favor of the protocol": {
+			tokenIn:  sdk.NewCoin(foo, sdk.NewInt(1001)),
+			takerFee: defaultTakerFee,
+
+			// 1001 * 0.998 = 998.998, rounded down to 998.
+			expectedTokenInAfterTakerFeeExactIn: sdk.NewCoin(foo, sdk.NewInt(998)),
+			expectedTakerFeeExactIn:             sdk.NewCoin(foo, sdk.NewInt(3)),
+			// 1001 / 0.998 = 1003.006..., rounded up to 1004.
+			expectedTokenInAfterTakerFeeExactOut: sdk.NewCoin(foo, sdk.NewInt(1004)),
+			expectedTakerFeeExactOut:             sdk.NewCoin(foo, sdk.NewInt(3)),
+		},
+		"tiny amounts are charged at least one unit": {
+			tokenIn:  sdk.NewCoin(foo, sdk.NewInt(1)),
+			takerFee: defaultTakerFee,
+
+			// 1 * 0.002 = 0.002, rounded up to 1.
+			expectedTokenInAfterTakerFeeExactIn: sdk.NewCoin(foo, sdk.ZeroInt()),
+			expectedTakerFeeExactIn:             sdk.NewCoin(foo, sdk.NewInt(1)),
+			// 1 / 0.998 = 1.002..., rounded up to 2.
+			expectedTokenInAfterTakerFeeExactOut: sdk.NewCoin(foo, sdk.NewInt(2)),
+			expectedTakerFeeExactOut:             sdk.NewCoin(foo, sdk.NewInt(1)),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tokenInAfterTakerFee, takerFee := poolmanager.CalcTakerFeeExactIn(tc.tokenIn, tc.takerFee)
+			s.Require().Equal(tc.expectedTokenInAfterTakerFeeExactIn.String(), tokenInAfterTakerFee.String())
+			s.Require().Equal(tc.expectedTakerFeeExactIn.String(), takerFee.String())
+
+			tokenInAfterTakerFee, takerFee = poolmanager.CalcTakerFeeExactOut(tc.tokenIn, tc.takerFee)
+			s.Require().Equal(tc.expectedTokenInAfterTakerFeeExactOut.String(), tokenInAfterTakerFee.String())
+			s.Require().Equal(tc.expectedTakerFeeExactOut.String(), takerFee.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestChargeTakerFee() {
+	tests := map[string]struct {
+		tokenIn        sdk.Coin
+		takerFee       sdk.Dec
+		exactIn        bool
+		stakingRewards sdk.Dec
+		communityPool  sdk.Dec
+
+		expectedTokenIn             sdk.Coin
+		expectedFeeCollectorBalance sdk.Int
+		expectedCommunityPoolFunds  sdk.Int
+		expectError                 bool
+	}{
+		"exact in, all to staking rewards": {
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(1000)),
+			takerFee:       sdk.MustNewDecFromStr("0.1"),
+			exactIn:        true,
+			stakingRewards: sdk.OneDec(),
+			communityPool:  sdk.ZeroDec(),
+
+			expectedTokenIn:             sdk.NewCoin(foo, sdk.NewInt(900)),
+			expectedFeeCollectorBalance: sdk.NewInt(100),
+			expectedCommunityPoolFunds:  sdk.ZeroInt(),
+		},
+		"exact in, split between staking rewards and community pool": {
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(1000)),
+			takerFee:       sdk.MustNewDecFromStr("0.1"),
+			exactIn:        true,
+			stakingRewards: sdk.MustNewDecFromStr("0.333"),
+			communityPool:  sdk.MustNewDecFromStr("0.667"),
+
+			expectedTokenIn: sdk.NewCoin(foo, sdk.NewInt(900)),
+			// 100 * 0.333 = 33.3, rounded down. The remainder goes to the community pool.
+			expectedFeeCollectorBalance: sdk.NewInt(33),
+			expectedCommunityPoolFunds:  sdk.NewInt(67),
+		},
+		"exact out, all to community pool": {
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(900)),
+			takerFee:       sdk.MustNewDecFromStr("0.1"),
+			exactIn:        false,
+			stakingRewards: sdk.ZeroDec(),
+			communityPool:  sdk.OneDec(),
+
+			expectedTokenIn:             sdk.NewCoin(foo, sdk.NewInt(1000)),
+			expectedFeeCollectorBalance: sdk.ZeroInt(),
+			expectedCommunityPoolFunds:  sdk.NewInt(100),
+		},
+		"zero taker fee": {
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(1000)),
+			takerFee:       sdk.ZeroDec(),
+			exactIn:        true,
+			stakingRewards: sdk.OneDec(),
+			communityPool:  sdk.ZeroDec(),
+
+			expectedTokenIn:             sdk.NewCoin(foo, sdk.NewInt(1000)),
+			expectedFeeCollectorBalance: sdk.ZeroInt(),
+			expectedCommunityPoolFunds:  sdk.ZeroInt(),
+		},
+		"error: insufficient funds": {
+			tokenIn:        sdk.NewCoin(bar, sdk.NewInt(1000)),
+			takerFee:       sdk.MustNewDecFromStr("0.1"),
+			exactIn:        true,
+			stakingRewards: sdk.OneDec(),
+			communityPool:  sdk.ZeroDec(),
+
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+			s.setTakerFeeParams(tc.takerFee, tc.stakingRewards, tc.communityPool)
+
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(10000))))
+
+			feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddr, tc.tokenIn.Denom)
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(tc.tokenIn.Denom)
+			senderBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom)
+
+			tokenIn, err := poolmanagerKeeper.ChargeTakerFee(s.Ctx, tc.tokenIn, tc.takerFee, sender, tc.exactIn)
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTokenIn.String(), tokenIn.String())
+
+			feeCollectorBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddr, tc.tokenIn.Denom)
+			communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(tc.tokenIn.Denom)
+			senderBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom)
+
+			s.Require().Equal(tc.expectedFeeCollectorBalance.String(), feeCollectorBalanceAfter.Amount.Sub(feeCollectorBalanceBefore.Amount).String())
+			s.Require().Equal(tc.expectedCommunityPoolFunds.ToDec().String(), communityPoolAfter.Sub(communityPoolBefore).String())
+			s.Require().Equal(tc.expectedFeeCollectorBalance.Add(tc.expectedCommunityPoolFunds).String(), senderBalanceBefore.Amount.Sub(senderBalanceAfter.Amount).String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetOsmoRoutedMultihopTotalTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+	poolmanagerKeeper.SetDenomPairTakerFee(s.Ctx, uosmo, bar, customTakerFee)
+
+	routeTakerFee, sumOfTakerFees, err := poolmanagerKeeper.GetOsmoRoutedMultihopTotalTakerFee(s.Ctx, foo, bar)
+	s.Require().NoError(err)
+	s.Require().Equal(customTakerFee, routeTakerFee)
+	s.Require().Equal(defaultTakerFee.Add(customTakerFee), sumOfTakerFees)
+}
+
+// TestRouteExactAmountInWithTakerFee tests that the taker fee is deducted from the token in
+// before swapping and that the estimate matches the executed swap.
+func (s *KeeperTestSuite) TestRouteExactAmountInWithTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+
+	route := []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: bar},
+		{PoolId: 2, TokenOutDenom: baz},
+	}
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+
+	estimatedTokenOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+
+	tokenOut, err := poolmanagerKeeper.RouteExactAmountIn(s.Ctx, sender, route, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenOut, tokenOut)
+
+	// The first hop charges the taker fee in foo, the second hop in bar.
+	firstHopTokenIn, firstHopTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, defaultTakerFee)
+	s.Require().True(firstHopTakerFee.IsPositive())
+
+	feeCollectorBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+	takerFeesCollected := feeCollectorBalanceAfter.Sub(feeCollectorBalanceBefore)
+	s.Require().Equal(firstHopTakerFee.Amount, takerFeesCollected.AmountOf(foo))
+	s.Require().True(takerFeesCollected.AmountOf(bar).IsPositive())
+
+	// Swapping the same amount without a taker fee yields more tokens.
+	s.setTakerFeeParams(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec())
+	tokenOutNoTakerFee, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+	s.Require().True(tokenOutNoTakerFee.GT(tokenOut))
+
+	// Swapping the amount left after the taker fee on the first hop yields
+	// more tokens as well since the second hop is charged too.
+	tokenOutFirstHopOnly, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, firstHopTokenIn)
+	s.Require().NoError(err)
+	s.Require().True(tokenOutFirstHopOnly.GT(tokenOut))
+}
+
+// TestSwapExactAmountInWithTakerFee tests that single pool swaps through SwapExactAmountIn
+// are charged the taker fee like swaps through RouteExactAmountIn.
+func (s *KeeperTestSuite) TestSwapExactAmountInWithTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins})
+
+	route := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+
+	estimatedTokenOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+
+	tokenOut, err := poolmanagerKeeper.SwapExactAmountIn(s.Ctx, sender, 1, tokenIn, bar, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenOut, tokenOut)
+
+	_, takerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, defaultTakerFee)
+	s.Require().True(takerFee.IsPositive())
+	feeCollectorBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+	s.Require().Equal(takerFee.Amount, feeCollectorBalanceAfter.Sub(feeCollectorBalanceBefore).AmountOf(foo))
+}
+
+// TestRouteExactAmountInWithoutTakerFee tests that no taker fee is charged on any hop of the route
+// and that the swap returns the same amount as a swap with a zero taker fee.
+func (s *KeeperTestSuite) TestRouteExactAmountInWithoutTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec())
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+
+	route := []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: bar},
+		{PoolId: 2, TokenOutDenom: baz},
+	}
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	estimatedTokenOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+
+	feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+	tokenOut, err := poolmanagerKeeper.RouteExactAmountInWithoutTakerFee(s.Ctx, sender, route, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenOut, tokenOut)
+	s.Require().Equal(feeCollectorBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr))
+}
+
+// TestRouteExactAmountOutWithTakerFee tests that the taker fee is charged on top of the
+// token in required by the pools and that the estimate matches the executed swap.
+func (s *KeeperTestSuite) TestRouteExactAmountOutWithTakerFee() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	s.setTakerFeeParams(defaultTakerFee, sdk.OneDec(), sdk.ZeroDec())
+	s.createBalancerPoolsFromCoins([]sdk.Coins{fooBarCoins, barBazCoins})
+
+	route := []types.SwapAmountOutRoute{
+		{PoolId: 1, TokenInDenom: foo},
+		{PoolId: 2, TokenInDenom: bar},
+	}
+	tokenOut := sdk.NewCoin(baz, defaultSwapAmount)
+
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount.MulRaw(2))))
+
+	estimatedTokenIn, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, route, tokenOut)
+	s.Require().NoError(err)
+
+	senderBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, foo)
+	tokenIn, err := poolmanagerKeeper.RouteExactAmountOut(s.Ctx, sender, route, estimatedTokenIn, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenIn, tokenIn)
+
+	senderBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, sender, foo)
+	s.Require().Equal(tokenIn, senderBalanceBefore.Amount.Sub(senderBalanceAfter.Amount))
+	s.Require().Equal(tokenOut.Amount, s.App.BankKeeper.GetBalance(s.Ctx, sender, baz).Amount)
+
+	// Swapping for the same amount without a taker fee requires fewer tokens.
+	s.setTakerFeeParams(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec())
+	tokenInNoTakerFee, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, route, tokenOut)
+	s.Require().NoError(err)
+	s.Require().True(tokenInNoTakerFee.LT(tokenIn))
+}
+
+func (s *KeeperTestSuite) TestHandleDenomPairTakerFeeProposal() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	proposal := types.NewDenomPairTakerFeeProposal("title", "description", []types.DenomPairTakerFee{
+		{Denom0: bar, Denom1: foo, TakerFee: customTakerFee},
+	})
+	err := poolmanagerKeeper.HandleDenomPairTakerFeeProposal(s.Ctx, proposal.(*types.DenomPairTakerFeeProposal))
+	s.Require().NoError(err)
+
+	takerFee, err := poolmanagerKeeper.GetTradingPairTakerFee(s.Ctx, foo, bar)
+	s.Require().NoError(err)
+	s.Require().Equal(customTakerFee, takerFee)
+
+	// Unsorted denoms are rejected.
+	proposal = types.NewDenomPairTakerFeeProposal("title", "description", []types.DenomPairTakerFee{
+		{Denom0: foo, Denom1: baz, TakerFee: customTakerFee},
+	})
+	err = poolmanagerKeeper.HandleDenomPairTakerFeeProposal(s.Ctx, proposal.(*types.DenomPairTakerFeeProposal))
+	s.Require().Error(err)
+}
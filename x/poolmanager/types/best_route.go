@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBestRouteHops is the maximum number of hops a route found by the best route search may have.
+	MaxBestRouteHops = 4
+	// MaxBestRouteSplits is the maximum number of paths the best route search may split the token in across.
+	MaxBestRouteSplits = 5
+	// BestRouteSplitIncrements is the number of equal parts the token in is divided into
+	// when allocating it across split paths.
+	BestRouteSplitIncrements = 10
+	// BestRouteSearchGasLimit is the maximum amount of gas the best route search may consume.
+	// Once it is reached, the best route found so far is returned.
+	BestRouteSearchGasLimit = 25_000_000
+	// BestRouteSearchStepGas is the gas consumed for every path visited by the best route search.
+	BestRouteSearchStepGas = 1_000
+)
+
+// ValidateBestRouteSearch validates the inputs of the best route search.
+func ValidateBestRouteSearch(tokenIn sdk.Coin, tokenOutDenom string, maxHops, maxSplits uint64) error {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return fmt.Errorf("token in must be valid and positive, was (%s)", tokenIn)
+	}
+
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return err
+	}
+
+	if tokenIn.Denom == tokenOutDenom {
+		return fmt.Errorf("token in denom and token out denom must differ, both were (%s)", tokenOutDenom)
+	}
+
+	if maxHops == 0 || maxHops > MaxBestRouteHops {
+		return InvalidMaxHopsError{MaxHops: maxHops}
+	}
+
+	if maxSplits == 0 || maxSplits > MaxBestRouteSplits {
+		return InvalidMaxSplitsError{MaxSplits: maxSplits}
+	}
+
+	return nil
+}
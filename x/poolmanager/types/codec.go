@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
@@ -15,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgSwapBestRoute{}, "osmosis/poolmanager/swap-best-route", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInByDenoms{}, "osmosis/poolmanager/swap-exact-amount-in-by-denoms", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +27,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapExactAmountOut{},
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgSwapBestRoute{},
+		&MsgSwapExactAmountInByDenoms{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&DenomPairTakerFeeProposal{},
+		&DenomPairRoutesProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the denoms of the record are invalid or identical, or if the
+// route is not a valid route from TokenInDenom to TokenOutDenom. An empty route is only
+// valid if allowEmptyRoute is true.
+func (r DenomPairRoute) Validate(allowEmptyRoute bool) error {
+	if err := sdk.ValidateDenom(r.TokenInDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.TokenOutDenom); err != nil {
+		return err
+	}
+	if r.TokenInDenom == r.TokenOutDenom {
+		return InvalidDenomPairRouteError{TokenInDenom: r.TokenInDenom, TokenOutDenom: r.TokenOutDenom, Reason: "denoms must be different"}
+	}
+
+	if len(r.Route) == 0 {
+		if allowEmptyRoute {
+			return nil
+		}
+		return InvalidDenomPairRouteError{TokenInDenom: r.TokenInDenom, TokenOutDenom: r.TokenOutDenom, Reason: "route cannot be empty"}
+	}
+
+	if err := SwapAmountInRoutes(r.Route).Validate(); err != nil {
+		return err
+	}
+
+	if lastHop := r.Route[len(r.Route)-1]; lastHop.TokenOutDenom != r.TokenOutDenom {
+		return InvalidDenomPairRouteError{
+			TokenInDenom:  r.TokenInDenom,
+			TokenOutDenom: r.TokenOutDenom,
+			Reason:        fmt.Sprintf("last hop must swap to token out denom, was (%s)", lastHop.TokenOutDenom),
+		}
+	}
+
+	return nil
+}
+
+// ValidateDenomPairRoutes validates each of the given records and returns an
+// error if the same ordered denom pair appears more than once.
+func ValidateDenomPairRoutes(records []DenomPairRoute, allowEmptyRoutes bool) error {
+	seen := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		if err := record.Validate(allowEmptyRoutes); err != nil {
+			return err
+		}
+		key := string(FormatDenomPairRouteKey(record.TokenInDenom, record.TokenOutDenom))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate route for denom pair (%s, %s)", record.TokenInDenom, record.TokenOutDenom)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -133,3 +133,47 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type InvalidMaxHopsError struct {
+	MaxHops uint64
+}
+
+func (e InvalidMaxHopsError) Error() string {
+	return fmt.Sprintf("max hops must be between 1 and %d, was (%d)", MaxBestRouteHops, e.MaxHops)
+}
+
+type InvalidMaxSplitsError struct {
+	MaxSplits uint64
+}
+
+func (e InvalidMaxSplitsError) Error() string {
+	return fmt.Sprintf("max splits must be between 1 and %d, was (%d)", MaxBestRouteSplits, e.MaxSplits)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type DenomPairRouteNotFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e DenomPairRouteNotFoundError) Error() string {
+	return fmt.Sprintf("no route registered from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type InvalidDenomPairRouteError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	Reason        string
+}
+
+func (e InvalidDenomPairRouteError) Error() string {
+	return fmt.Sprintf("invalid route from (%s) to (%s): %s", e.TokenInDenom, e.TokenOutDenom, e.Reason)
+}
@@ -4,7 +4,9 @@ const (
 	AttributeValueCategory       = ModuleName
 	TypeEvtPoolCreated           = "pool_created"
 	TypeEvtSplitRouteSwapExactIn = "split_route_swap_exact_in"
+	TypeEvtTakerFeeCharged       = "taker_fee_charged"
 	AttributeKeyTokensIn         = "tokens_in"
 	AttributeKeyTokensOut        = "tokens_out"
 	AttributeKeyPoolId           = "pool_id"
+	AttributeKeyTakerFee         = "taker_fee"
 )
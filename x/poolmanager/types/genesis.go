@@ -19,5 +19,14 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateDenomPairTakerFees(gs.DenomPairTakerFeeStore); err != nil {
+		return err
+	}
+	if err := ValidatePoolVolumes(gs.PoolVolumes); err != nil {
+		return err
+	}
+	if err := ValidateDenomPairRoutes(gs.DenomPairRoutes, false); err != nil {
+		return err
+	}
 	return nil
 }
@@ -12,15 +12,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +34,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the poolmanager module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// taker_fee_params is the container of taker fee parameters.
+	TakerFeeParams TakerFeeParams `protobuf:"bytes,2,opt,name=taker_fee_params,json=takerFeeParams,proto3" json:"taker_fee_params" yaml:"taker_fee_params"`
+	// pool_hook_contracts is the list of CosmWasm contracts that are called
+	// through sudo on the pool hooks (pool creation, swaps, joins and exits).
+	PoolHookContracts []string `protobuf:"bytes,3,rep,name=pool_hook_contracts,json=poolHookContracts,proto3" json:"pool_hook_contracts,omitempty" yaml:"pool_hook_contracts"`
+	// volume_twap_window is the time window of the arithmetic twap used to value
+	// the swaps tracked in the pool volumes in uosmo.
+	VolumeTwapWindow time.Duration `protobuf:"bytes,4,opt,name=volume_twap_window,json=volumeTwapWindow,proto3,stdduration" json:"volume_twap_window" yaml:"volume_twap_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -73,6 +84,121 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetTakerFeeParams() TakerFeeParams {
+	if m != nil {
+		return m.TakerFeeParams
+	}
+	return TakerFeeParams{}
+}
+
+func (m *Params) GetPoolHookContracts() []string {
+	if m != nil {
+		return m.PoolHookContracts
+	}
+	return nil
+}
+
+func (m *Params) GetVolumeTwapWindow() time.Duration {
+	if m != nil {
+		return m.VolumeTwapWindow
+	}
+	return 0
+}
+
+// TakerFeeParams holds the parameters governing the protocol taker fee that is
+// charged on every hop of a swap routed through the poolmanager.
+type TakerFeeParams struct {
+	// default_taker_fee is the taker fee charged on swaps between denom pairs
+	// that do not have a taker fee set in the denom pair taker fee store.
+	DefaultTakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=default_taker_fee,json=defaultTakerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_taker_fee" yaml:"default_taker_fee"`
+	// taker_fee_distribution defines how the collected taker fees are split
+	// between their destinations.
+	TakerFeeDistribution TakerFeeDistributionPercentage `protobuf:"bytes,2,opt,name=taker_fee_distribution,json=takerFeeDistribution,proto3" json:"taker_fee_distribution" yaml:"taker_fee_distribution"`
+}
+
+func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
+func (m *TakerFeeParams) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeParams) ProtoMessage()    {}
+func (*TakerFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+}
+func (m *TakerFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeParams.Merge(m, src)
+}
+func (m *TakerFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeParams proto.InternalMessageInfo
+
+func (m *TakerFeeParams) GetTakerFeeDistribution() TakerFeeDistributionPercentage {
+	if m != nil {
+		return m.TakerFeeDistribution
+	}
+	return TakerFeeDistributionPercentage{}
+}
+
+// TakerFeeDistributionPercentage defines what percentage of the taker fee
+// goes to each destination. The percentages must sum up to one.
+type TakerFeeDistributionPercentage struct {
+	// staking_rewards is the portion of the taker fee sent to the fee collector
+	// to be distributed to stakers.
+	StakingRewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_rewards" yaml:"staking_rewards"`
+	// community_pool is the portion of the taker fee sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+}
+
+func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistributionPercentage{} }
+func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
+func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{2}
+}
+func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDistributionPercentage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDistributionPercentage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDistributionPercentage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDistributionPercentage.Merge(m, src)
+}
+func (m *TakerFeeDistributionPercentage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDistributionPercentage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDistributionPercentage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDistributionPercentage proto.InternalMessageInfo
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -81,13 +207,21 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// pool_routes is the container of the mappings from pool id to pool type.
 	PoolRoutes []ModuleRoute `protobuf:"bytes,3,rep,name=pool_routes,json=poolRoutes,proto3" json:"pool_routes"`
+	// denom_pair_taker_fee_store is the container of the taker fees set for
+	// specific denom pairs.
+	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,4,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// pool_volumes is the container of the cumulative swap volume of each pool.
+	PoolVolumes []PoolVolume `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes"`
+	// denom_pair_routes is the container of the canonical routes registered
+	// for denom pairs.
+	DenomPairRoutes []DenomPairRoute `protobuf:"bytes,6,rep,name=denom_pair_routes,json=denomPairRoutes,proto3" json:"denom_pair_routes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,9 +271,216 @@ func (m *GenesisState) GetPoolRoutes() []ModuleRoute {
 	return nil
 }
 
+func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
+	if m != nil {
+		return m.DenomPairTakerFeeStore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomPairRoutes() []DenomPairRoute {
+	if m != nil {
+		return m.DenomPairRoutes
+	}
+	return nil
+}
+
+// DenomPairTakerFee is the taker fee charged on swaps between denom0 and
+// denom1, in either direction. denom0 must be lexicographically smaller than
+// denom1.
+type DenomPairTakerFee struct {
+	Denom0   string                                 `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1   string                                 `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
+func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
+func (*DenomPairTakerFee) ProtoMessage()    {}
+func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+}
+func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairTakerFee.Merge(m, src)
+}
+func (m *DenomPairTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairTakerFee proto.InternalMessageInfo
+
+func (m *DenomPairTakerFee) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *DenomPairTakerFee) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
+// PoolVolume is the cumulative swap volume of a pool since volume tracking
+// started.
+type PoolVolume struct {
+	// pool_id is the id of the pool the volume belongs to.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// pool_volume is the total amount of each denom swapped into the pool.
+	PoolVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pool_volume,json=poolVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_volume" yaml:"pool_volume"`
+	// osmo_volume is the total volume of the pool in uosmo. Each swap is valued
+	// at the time it happens, using the TWAP of the OSMO pool paired with the
+	// token in. Swaps that cannot be valued in uosmo are not counted.
+	OsmoVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=osmo_volume,json=osmoVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"osmo_volume" yaml:"osmo_volume"`
+}
+
+func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
+func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolVolume) ProtoMessage()    {}
+func (*PoolVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+}
+func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolume.Merge(m, src)
+}
+func (m *PoolVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolume proto.InternalMessageInfo
+
+func (m *PoolVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PoolVolume
+	}
+	return nil
+}
+
+// DenomPairRoute is the canonical route to swap token_in_denom for
+// token_out_denom. The pair is ordered: the route of the reverse pair is
+// registered separately.
+type DenomPairRoute struct {
+	TokenInDenom  string `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// route is the sequence of pools to swap through. The token out denom of
+	// its last hop must be token_out_denom.
+	Route []SwapAmountInRoute `protobuf:"bytes,3,rep,name=route,proto3" json:"route" yaml:"route"`
+}
+
+func (m *DenomPairRoute) Reset()         { *m = DenomPairRoute{} }
+func (m *DenomPairRoute) String() string { return proto.CompactTextString(m) }
+func (*DenomPairRoute) ProtoMessage()    {}
+func (*DenomPairRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
+}
+func (m *DenomPairRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairRoute.Merge(m, src)
+}
+func (m *DenomPairRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairRoute proto.InternalMessageInfo
+
+func (m *DenomPairRoute) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *DenomPairRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *DenomPairRoute) GetRoute() []SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
+	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
+	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
+	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*DenomPairRoute)(nil), "osmosis.poolmanager.v1beta1.DenomPairRoute")
 }
 
 func init() {
@@ -147,36 +488,175 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x92, 0xe8, 0x8a, 0xbd, 0x48, 0x08, 0x8b, 0xc2, 0x09, 0x92, 0xcf, 0x32, 0x8d, 0x29,
-	0xb2, 0xab, 0x0b, 0x45, 0x24, 0x3a, 0x2e, 0x12, 0x08, 0x09, 0x44, 0x30, 0x1d, 0x8d, 0xb5, 0xb6,
-	0x27, 0xc6, 0xc2, 0xf6, 0x58, 0xde, 0x75, 0x94, 0xfb, 0x0b, 0x24, 0x7a, 0x3e, 0x80, 0xef, 0xa0,
-	0x48, 0x79, 0x25, 0xd5, 0x81, 0xee, 0xfe, 0x80, 0x2f, 0x40, 0xbb, 0x5e, 0xa3, 0x83, 0x48, 0x57,
-	0xd9, 0xb3, 0xf3, 0xde, 0x9b, 0x79, 0x6f, 0xe8, 0x13, 0x94, 0x35, 0xca, 0x52, 0xf2, 0x16, 0xb1,
-	0xaa, 0x45, 0x23, 0x0a, 0xe8, 0xf8, 0xf5, 0x3c, 0x05, 0x25, 0xe6, 0xbc, 0x80, 0x06, 0x64, 0x29,
-	0x59, 0xdb, 0xa1, 0x42, 0xf7, 0x91, 0x85, 0xb2, 0x1d, 0x28, 0xb3, 0xd0, 0x93, 0x87, 0x05, 0x16,
-	0x68, 0x70, 0x5c, 0xff, 0x0d, 0x94, 0x93, 0xe3, 0x02, 0xb1, 0xa8, 0x80, 0x9b, 0x2a, 0xed, 0xaf,
-	0xb8, 0x68, 0x96, 0x63, 0x2b, 0x33, 0x72, 0xc9, 0xc0, 0x19, 0x0a, 0xdb, 0xf2, 0xff, 0x67, 0xe5,
-	0x7d, 0x27, 0x54, 0x89, 0xcd, 0xd8, 0x1f, 0xd0, 0x3c, 0x15, 0x12, 0xfe, 0xee, 0x9a, 0x61, 0x39,
-	0xf6, 0xd9, 0x3e, 0x4f, 0x35, 0xe6, 0x7d, 0x05, 0x49, 0x87, 0xbd, 0x82, 0x01, 0x1f, 0x7e, 0x25,
-	0x74, 0x72, 0x29, 0x3a, 0x51, 0x4b, 0xf7, 0x0b, 0xa1, 0x0f, 0x34, 0x2b, 0xc9, 0x3a, 0x30, 0x23,
-	0x93, 0x2b, 0x00, 0x8f, 0x04, 0x07, 0xd1, 0xf4, 0xec, 0x98, 0xd9, 0x2d, 0xf5, 0xdc, 0xd1, 0x38,
-	0xbb, 0xc0, 0xb2, 0x59, 0xbc, 0xbe, 0x5d, 0xcf, 0x9c, 0xdf, 0xeb, 0x99, 0xb7, 0x14, 0x75, 0xf5,
-	0x2c, 0xbc, 0xa3, 0x10, 0x7e, 0xfb, 0x39, 0x8b, 0x8a, 0x52, 0x7d, 0xec, 0x53, 0x96, 0x61, 0x6d,
-	0xed, 0xda, 0xcf, 0xa9, 0xcc, 0x3f, 0x71, 0xb5, 0x6c, 0x41, 0x1a, 0x31, 0x19, 0xdf, 0xd7, 0xfc,
-	0x0b, 0x4b, 0x7f, 0x01, 0x10, 0x7e, 0x27, 0xf4, 0xe8, 0xe5, 0x70, 0x8b, 0xf7, 0x4a, 0x28, 0x70,
-	0x03, 0x7a, 0xd4, 0xc0, 0x8d, 0x4a, 0xcc, 0xa0, 0x32, 0xf7, 0x48, 0x40, 0xa2, 0xc3, 0x98, 0xea,
-	0xb7, 0x4b, 0xc4, 0xea, 0x55, 0xee, 0x3e, 0xa7, 0x93, 0xd6, 0x58, 0xf2, 0xee, 0x05, 0x24, 0x9a,
-	0x9e, 0x3d, 0x66, 0x7b, 0xae, 0xc7, 0x06, 0xf7, 0x8b, 0x43, 0x6d, 0x23, 0xb6, 0x44, 0xf7, 0x2d,
-	0x9d, 0x1a, 0x7d, 0x13, 0x95, 0xf4, 0x0e, 0x4c, 0x08, 0xd1, 0x5e, 0x9d, 0x37, 0x26, 0xdc, 0x58,
-	0x13, 0xac, 0x18, 0xd5, 0x30, 0xf3, 0x20, 0x17, 0xef, 0x6e, 0x37, 0x3e, 0x59, 0x6d, 0x7c, 0xf2,
-	0x6b, 0xe3, 0x93, 0xcf, 0x5b, 0xdf, 0x59, 0x6d, 0x7d, 0xe7, 0xc7, 0xd6, 0x77, 0x3e, 0x9c, 0xef,
-	0x64, 0x63, 0xf5, 0x4f, 0x2b, 0x91, 0xca, 0xb1, 0xe0, 0xd7, 0xf3, 0x73, 0x7e, 0xf3, 0xcf, 0x3d,
-	0x4d, 0x60, 0xe9, 0xc4, 0x5c, 0xf0, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x2d, 0xaa,
-	0x10, 0xc7, 0x02, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0xa9, 0x21, 0xe3, 0xc4, 0x49, 0x86, 0x10, 0x36, 0x41, 0xd8, 0xd6, 0x20, 0xc0,
+	0x55, 0xe9, 0x1a, 0x87, 0x43, 0xa5, 0x72, 0x40, 0x75, 0xa2, 0xb4, 0x41, 0x40, 0xc3, 0xa6, 0x02,
+	0xa9, 0x12, 0x5a, 0x8d, 0xbd, 0x13, 0x77, 0x65, 0xef, 0xcc, 0xb2, 0x33, 0x1b, 0x37, 0x57, 0xf8,
+	0x07, 0x90, 0xb8, 0xf4, 0x88, 0xc4, 0x0d, 0xfe, 0x91, 0x1e, 0x73, 0x42, 0x88, 0x83, 0x8b, 0x92,
+	0x03, 0x9c, 0x73, 0xe1, 0x5a, 0xcd, 0x8f, 0xb5, 0x77, 0x9d, 0xca, 0x8d, 0x4f, 0xc9, 0xbc, 0xf9,
+	0xde, 0xf7, 0xde, 0xfb, 0xde, 0x9b, 0xb7, 0x06, 0x37, 0x19, 0x0f, 0x19, 0x0f, 0x78, 0x33, 0x62,
+	0x6c, 0x10, 0x62, 0x8a, 0x7b, 0x24, 0x6e, 0x9e, 0xb4, 0x3a, 0x44, 0xe0, 0x56, 0xb3, 0x47, 0x28,
+	0xe1, 0x01, 0x77, 0xa2, 0x98, 0x09, 0x06, 0xdf, 0x35, 0x50, 0x27, 0x03, 0x75, 0x0c, 0x74, 0x7b,
+	0xa3, 0xc7, 0x7a, 0x4c, 0xe1, 0x9a, 0xf2, 0x3f, 0xed, 0xb2, 0xbd, 0xd5, 0x63, 0xac, 0x37, 0x20,
+	0x4d, 0x75, 0xea, 0x24, 0xc7, 0x4d, 0x4c, 0x4f, 0xd3, 0xab, 0xae, 0xa2, 0xf3, 0xb4, 0x8f, 0x3e,
+	0x98, 0xab, 0xea, 0xb4, 0x97, 0x9f, 0xc4, 0x58, 0x04, 0x8c, 0xa6, 0xf7, 0x1a, 0xdd, 0xec, 0x60,
+	0x4e, 0xc6, 0xb9, 0x76, 0x59, 0x90, 0xde, 0x3b, 0xb3, 0x6a, 0x0a, 0x99, 0x9f, 0x0c, 0x88, 0x17,
+	0xb3, 0x44, 0x10, 0x83, 0xff, 0x78, 0x16, 0x9e, 0x0f, 0x71, 0x94, 0x45, 0xa3, 0x3f, 0x8b, 0xa0,
+	0x74, 0x88, 0x63, 0x1c, 0x72, 0xf8, 0x8b, 0x05, 0xd6, 0xa5, 0x8f, 0xd7, 0x8d, 0x89, 0x4a, 0xd0,
+	0x3b, 0x26, 0xc4, 0xb6, 0xea, 0xc5, 0x46, 0x79, 0x67, 0xcb, 0x31, 0x35, 0xc9, 0x2c, 0x53, 0x99,
+	0x9c, 0x5d, 0x16, 0xd0, 0xf6, 0x97, 0xcf, 0x47, 0xb5, 0x85, 0xcb, 0x51, 0xcd, 0x3e, 0xc5, 0xe1,
+	0xe0, 0x2e, 0xba, 0xc2, 0x80, 0x7e, 0x7f, 0x51, 0x6b, 0xf4, 0x02, 0xf1, 0x24, 0xe9, 0x38, 0x5d,
+	0x16, 0x1a, 0x71, 0xcc, 0x9f, 0xdb, 0xdc, 0xef, 0x37, 0xc5, 0x69, 0x44, 0xb8, 0x22, 0xe3, 0xee,
+	0xaa, 0xf4, 0xdf, 0x35, 0xee, 0xfb, 0x84, 0xc0, 0x13, 0xb0, 0x26, 0x70, 0x9f, 0xc4, 0x92, 0xca,
+	0x8b, 0x54, 0xa6, 0x76, 0xa1, 0x6e, 0x35, 0xca, 0x3b, 0xb7, 0x9c, 0x19, 0x2d, 0x74, 0x1e, 0x49,
+	0xa7, 0x7d, 0x42, 0x74, 0x71, 0xed, 0x9a, 0xc9, 0xf2, 0x1d, 0x9d, 0xe5, 0x34, 0x25, 0x72, 0x2b,
+	0x22, 0xe7, 0x00, 0xbf, 0x06, 0x6f, 0xa9, 0x52, 0x9e, 0x30, 0xd6, 0xf7, 0xba, 0x8c, 0x8a, 0x18,
+	0x77, 0x05, 0xb7, 0x8b, 0xf5, 0x62, 0x63, 0xa9, 0x5d, 0xbd, 0x1c, 0xd5, 0xb6, 0x33, 0xf5, 0xe6,
+	0x41, 0xc8, 0x55, 0x3a, 0x3e, 0x60, 0xac, 0xbf, 0x9b, 0xda, 0x20, 0x05, 0xf0, 0x84, 0x0d, 0x92,
+	0x90, 0x78, 0x42, 0xf6, 0x60, 0x18, 0x50, 0x9f, 0x0d, 0xed, 0x45, 0x55, 0xc9, 0x96, 0xa3, 0x67,
+	0xc4, 0x49, 0x67, 0xc4, 0xd9, 0x33, 0x33, 0xd2, 0xfe, 0xc0, 0xe4, 0xbd, 0xa5, 0xa3, 0x5d, 0xa5,
+	0x40, 0xcf, 0x5e, 0xd4, 0x2c, 0x77, 0x4d, 0x5f, 0x3c, 0x1a, 0xe2, 0xe8, 0x3b, 0x6d, 0xfe, 0xad,
+	0x00, 0x2a, 0x79, 0x0d, 0xe0, 0x09, 0x58, 0xf7, 0xc9, 0x31, 0x4e, 0x06, 0xc2, 0x1b, 0xd7, 0x6f,
+	0x5b, 0x75, 0xab, 0xb1, 0xd4, 0xfe, 0x42, 0x86, 0xf9, 0x7b, 0x54, 0xfb, 0xf0, 0x1a, 0x8d, 0xda,
+	0x23, 0xdd, 0x49, 0xbb, 0xaf, 0x10, 0x22, 0x77, 0xd5, 0xd8, 0xd2, 0xe8, 0xf0, 0x99, 0x05, 0x36,
+	0x27, 0x82, 0xfb, 0x01, 0x17, 0x71, 0xd0, 0x49, 0x64, 0x79, 0xa6, 0x93, 0x9f, 0x5d, 0xab, 0x93,
+	0x7b, 0x19, 0xc7, 0x43, 0x12, 0x77, 0x09, 0x15, 0xb8, 0x47, 0xc6, 0x0a, 0xbd, 0x37, 0xdd, 0xd9,
+	0x6c, 0x20, 0xe4, 0x6e, 0x88, 0x57, 0xd0, 0xa0, 0x9f, 0x0a, 0xa0, 0x3a, 0x9b, 0x1f, 0xfe, 0x00,
+	0x56, 0xb9, 0xc0, 0xfd, 0x80, 0xf6, 0xbc, 0x98, 0x0c, 0x71, 0xec, 0x73, 0xa3, 0xd9, 0x83, 0xb9,
+	0x35, 0xdb, 0xd4, 0x29, 0x4e, 0xd1, 0x21, 0xb7, 0x62, 0x2c, 0xae, 0x36, 0x40, 0x0a, 0x2a, 0x5d,
+	0x16, 0x86, 0x09, 0x0d, 0xc4, 0xa9, 0x27, 0x25, 0x51, 0x3a, 0x2d, 0xb5, 0xef, 0xcf, 0x1d, 0xf1,
+	0x6d, 0x1d, 0x31, 0xcf, 0x86, 0xdc, 0x95, 0xb1, 0xe1, 0x50, 0x9e, 0xff, 0x2d, 0x82, 0xe5, 0xfb,
+	0x7a, 0x3b, 0x1e, 0x09, 0x2c, 0x08, 0xac, 0x83, 0x65, 0x4a, 0x9e, 0x0a, 0x85, 0xf6, 0x02, 0x5f,
+	0x15, 0xbc, 0xe8, 0x02, 0x69, 0x93, 0x0e, 0x07, 0x3e, 0xbc, 0x07, 0x4a, 0xb9, 0xc7, 0xf8, 0xfe,
+	0xcc, 0x16, 0x9a, 0x47, 0xb8, 0x28, 0xf3, 0x77, 0x8d, 0x23, 0x7c, 0x08, 0xca, 0x8a, 0x5f, 0xad,
+	0x23, 0xfd, 0xb2, 0xca, 0x3b, 0x8d, 0x99, 0x3c, 0x5f, 0xa9, 0x75, 0xe7, 0x4a, 0x07, 0x43, 0x06,
+	0x24, 0x4c, 0x19, 0x38, 0x8c, 0xc0, 0xb6, 0x4f, 0x28, 0x0b, 0xbd, 0x08, 0x07, 0xf1, 0x64, 0x22,
+	0x3d, 0x2e, 0x58, 0x4c, 0xec, 0x45, 0xc5, 0xef, 0xcc, 0xe4, 0xdf, 0x93, 0xee, 0x87, 0x38, 0x88,
+	0xd3, 0x99, 0x30, 0x51, 0x36, 0xfd, 0xe9, 0x8b, 0x23, 0xc9, 0x09, 0x0f, 0xc1, 0xb2, 0x2a, 0x41,
+	0xbf, 0x3e, 0x6e, 0xdf, 0x50, 0x31, 0x3e, 0x9a, 0xad, 0x05, 0x63, 0x83, 0x6f, 0x15, 0xde, 0x90,
+	0x97, 0xa3, 0xb1, 0x85, 0xc3, 0xef, 0xc1, 0x7a, 0xa6, 0x06, 0x23, 0x4d, 0xa9, 0x5e, 0x7c, 0xed,
+	0xbe, 0x1b, 0xa7, 0x9e, 0x55, 0x67, 0xd5, 0xcf, 0x59, 0x39, 0x3a, 0xb3, 0xc0, 0xfa, 0x95, 0x22,
+	0xe1, 0x4d, 0x50, 0x52, 0xc0, 0x4f, 0xcc, 0x64, 0xaf, 0x5f, 0x8e, 0x6a, 0x2b, 0xe9, 0xfb, 0x96,
+	0x76, 0xe4, 0x1a, 0xc0, 0x18, 0xda, 0xb2, 0x0b, 0xaf, 0x84, 0xb6, 0x52, 0x68, 0x0b, 0x7a, 0x60,
+	0x69, 0xb2, 0x66, 0x8a, 0x0a, 0xdd, 0x9e, 0x7b, 0x80, 0xd7, 0xa6, 0x5e, 0x35, 0x72, 0xdf, 0x4c,
+	0x1f, 0xf2, 0xdd, 0xc5, 0xff, 0x7e, 0xad, 0x59, 0xe8, 0x8f, 0x02, 0x00, 0x13, 0x4d, 0xe1, 0x2d,
+	0xf0, 0x46, 0x6e, 0x6a, 0xdb, 0xf0, 0x72, 0x54, 0xab, 0x64, 0x76, 0x75, 0xe0, 0x23, 0xb7, 0x14,
+	0xe9, 0x29, 0xfe, 0xd1, 0x02, 0xe5, 0x4c, 0x03, 0xed, 0xc2, 0xeb, 0x3e, 0x76, 0xfb, 0x66, 0xd9,
+	0xc0, 0x0c, 0xa1, 0xf6, 0x9d, 0xef, 0x33, 0x07, 0x26, 0x3d, 0x87, 0x04, 0x94, 0x25, 0x28, 0xcd,
+	0x41, 0x2b, 0xb5, 0x37, 0x87, 0x52, 0x07, 0x54, 0x4c, 0x52, 0xca, 0x50, 0x21, 0x17, 0xc8, 0x93,
+	0x0e, 0x63, 0xd4, 0xfa, 0xdf, 0x02, 0x95, 0xfc, 0xa8, 0xc0, 0xcf, 0x41, 0x45, 0xb0, 0x3e, 0xa1,
+	0x5e, 0x40, 0x3d, 0xd5, 0x3a, 0x33, 0x05, 0x5b, 0x93, 0xfd, 0x91, 0xbf, 0x47, 0xee, 0xb2, 0x32,
+	0x1c, 0x50, 0xc5, 0x04, 0xdb, 0x60, 0x55, 0x03, 0x58, 0x22, 0x0c, 0x83, 0x1e, 0x8e, 0xed, 0xc9,
+	0xce, 0x9b, 0x02, 0x20, 0x77, 0x45, 0x59, 0x1e, 0x26, 0x42, 0x73, 0x3c, 0x06, 0x37, 0xd4, 0xb0,
+	0xdb, 0xc5, 0x6b, 0x3c, 0xd3, 0xa3, 0x21, 0x8e, 0xee, 0x85, 0x2c, 0xa1, 0xe2, 0x80, 0xea, 0x71,
+	0xdf, 0x30, 0x7d, 0x59, 0xd6, 0xd1, 0x14, 0x15, 0x72, 0x35, 0xa5, 0xae, 0xbc, 0xfd, 0xcd, 0xf3,
+	0xf3, 0xaa, 0x75, 0x76, 0x5e, 0xb5, 0xfe, 0x39, 0xaf, 0x5a, 0x3f, 0x5f, 0x54, 0x17, 0xce, 0x2e,
+	0xaa, 0x0b, 0x7f, 0x5d, 0x54, 0x17, 0x1e, 0xdf, 0xc9, 0x68, 0x6c, 0xc2, 0xde, 0x1e, 0xe0, 0x0e,
+	0x4f, 0x0f, 0xcd, 0x93, 0xd6, 0x9d, 0xe6, 0xd3, 0xdc, 0xef, 0x29, 0x25, 0x7c, 0xa7, 0xa4, 0xbe,
+	0xd7, 0x9f, 0xbe, 0x1c, 0x00, 0x30, 0x2b, 0xd6, 0x0a, 0x77, 0x0a, 0x00, 0x00,
+}
+
+func (this *DenomPairTakerFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPairTakerFee)
+	if !ok {
+		that2, ok := that.(DenomPairTakerFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom0 != that1.Denom0 {
+		return false
+	}
+	if this.Denom1 != that1.Denom1 {
+		return false
+	}
+	if !this.TakerFee.Equal(that1.TakerFee) {
+		return false
+	}
+	return true
+}
+func (this *PoolVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolVolume)
+	if !ok {
+		that2, ok := that.(PoolVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if len(this.PoolVolume) != len(that1.PoolVolume) {
+		return false
+	}
+	for i := range this.PoolVolume {
+		if !this.PoolVolume[i].Equal(&that1.PoolVolume[i]) {
+			return false
+		}
+	}
+	if !this.OsmoVolume.Equal(that1.OsmoVolume) {
+		return false
+	}
+	return true
 }
+func (this *DenomPairRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*DenomPairRoute)
+	if !ok {
+		that2, ok := that.(DenomPairRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenInDenom != that1.TokenInDenom {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	if len(this.Route) != len(that1.Route) {
+		return false
+	}
+	for i := range this.Route {
+		if !this.Route[i].Equal(&that1.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -197,6 +677,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VolumeTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolumeTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolHookContracts) > 0 {
+		for iNdEx := len(m.PoolHookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PoolHookContracts[iNdEx])
+			copy(dAtA[i:], m.PoolHookContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PoolHookContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.TakerFeeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -214,7 +721,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *TakerFeeParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -224,101 +731,1298 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *TakerFeeParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolRoutes) > 0 {
-		for iNdEx := len(m.PoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.TakerFeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.DefaultTakerFee.Size()
+		i -= size
+		if _, err := m.DefaultTakerFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.NextPoolId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolId))
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeDistributionPercentage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDistributionPercentage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDistributionPercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakingRewards.Size()
+		i -= size
+		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairRoutes) > 0 {
+		for iNdEx := len(m.DenomPairRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomPairTakerFeeStore) > 0 {
+		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFeeStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolRoutes) > 0 {
+		for iNdEx := len(m.PoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NextPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoVolume.Size()
+		i -= size
+		if _, err := m.OsmoVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PoolVolume) > 0 {
+		for iNdEx := len(m.PoolVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TakerFeeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolHookContracts) > 0 {
+		for _, s := range m.PoolHookContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolumeTwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultTakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TakerFeeDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeDistributionPercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRoutes) > 0 {
+		for _, e := range m.PoolRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairTakerFeeStore) > 0 {
+		for _, e := range m.DenomPairTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairRoutes) > 0 {
+		for _, e := range m.DenomPairRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.PoolVolume) > 0 {
+		for _, e := range m.PoolVolume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.OsmoVolume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DenomPairRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolHookContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolHookContracts = append(m.PoolHookContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VolumeTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeDistributionPercentage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeDistributionPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+			}
+			m.NextPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
+			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFeeStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairTakerFeeStore = append(m.DenomPairTakerFeeStore, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFeeStore[len(m.DenomPairTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairRoutes = append(m.DenomPairRoutes, DenomPairRoute{})
+			if err := m.DenomPairRoutes[len(m.DenomPairRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NextPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolId))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PoolRoutes) > 0 {
-		for _, e := range m.PoolRoutes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,15 +2045,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -376,8 +2099,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolVolume = append(m.PoolVolume, types.Coin{})
+			if err := m.PoolVolume[len(m.PoolVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -402,7 +2159,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DenomPairRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -425,17 +2182,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			m.NextPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -445,16 +2202,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -464,28 +2234,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -512,8 +2281,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
-			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Route = append(m.Route, SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
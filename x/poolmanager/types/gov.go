@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeDenomPairTakerFee = "DenomPairTakerFee"
+	ProposalTypeDenomPairRoutes   = "DenomPairRoutes"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDenomPairTakerFee)
+	govtypes.RegisterProposalTypeCodec(&DenomPairTakerFeeProposal{}, "osmosis/DenomPairTakerFeeProposal")
+	govtypes.RegisterProposalType(ProposalTypeDenomPairRoutes)
+	govtypes.RegisterProposalTypeCodec(&DenomPairRoutesProposal{}, "osmosis/DenomPairRoutesProposal")
+}
+
+var (
+	_ govtypes.Content = &DenomPairTakerFeeProposal{}
+	_ govtypes.Content = &DenomPairRoutesProposal{}
+)
+
+// NewDenomPairTakerFeeProposal returns a new instance of a denom pair taker fee proposal struct.
+func NewDenomPairTakerFeeProposal(title, description string, records []DenomPairTakerFee) govtypes.Content {
+	return &DenomPairTakerFeeProposal{
+		Title:             title,
+		Description:       description,
+		DenomPairTakerFee: records,
+	}
+}
+
+func (p *DenomPairTakerFeeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *DenomPairTakerFeeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *DenomPairTakerFeeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DenomPairTakerFeeProposal) ProposalType() string {
+	return ProposalTypeDenomPairTakerFee
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *DenomPairTakerFeeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.DenomPairTakerFee) == 0 {
+		return fmt.Errorf("denom pair taker fee records cannot be empty")
+	}
+
+	return ValidateDenomPairTakerFees(p.DenomPairTakerFee)
+}
+
+// String returns a string containing the denom pair taker fee proposal.
+func (p DenomPairTakerFeeProposal) String() string {
+	var b strings.Builder
+	for _, record := range p.DenomPairTakerFee {
+		b.WriteString(fmt.Sprintf("(Denom0: %s, Denom1: %s, TakerFee: %s) ", record.Denom0, record.Denom1, record.TakerFee))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Denom Pair Taker Fee Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
+
+// NewDenomPairRoutesProposal returns a new instance of a denom pair routes proposal struct.
+func NewDenomPairRoutesProposal(title, description string, records []DenomPairRoute) govtypes.Content {
+	return &DenomPairRoutesProposal{
+		Title:           title,
+		Description:     description,
+		DenomPairRoutes: records,
+	}
+}
+
+func (p *DenomPairRoutesProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *DenomPairRoutesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *DenomPairRoutesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DenomPairRoutesProposal) ProposalType() string {
+	return ProposalTypeDenomPairRoutes
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *DenomPairRoutesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.DenomPairRoutes) == 0 {
+		return fmt.Errorf("denom pair route records cannot be empty")
+	}
+
+	return ValidateDenomPairRoutes(p.DenomPairRoutes, true)
+}
+
+// String returns a string containing the denom pair routes proposal.
+func (p DenomPairRoutesProposal) String() string {
+	var b strings.Builder
+	for _, record := range p.DenomPairRoutes {
+		b.WriteString(fmt.Sprintf("(TokenInDenom: %s, TokenOutDenom: %s, Route: %v) ", record.TokenInDenom, record.TokenOutDenom, record.Route))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Denom Pair Routes Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolmanager/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomPairTakerFeeProposal is a gov Content type for setting the taker fee
+// charged on swaps between specific denom pairs. Setting a denom pair's taker
+// fee overrides the default taker fee for that pair.
+type DenomPairTakerFeeProposal struct {
+	Title             string              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description       string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	DenomPairTakerFee []DenomPairTakerFee `protobuf:"bytes,3,rep,name=denom_pair_taker_fee,json=denomPairTakerFee,proto3" json:"denom_pair_taker_fee" yaml:"denom_pair_taker_fee"`
+}
+
+func (m *DenomPairTakerFeeProposal) Reset()      { *m = DenomPairTakerFeeProposal{} }
+func (*DenomPairTakerFeeProposal) ProtoMessage() {}
+func (*DenomPairTakerFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{0}
+}
+func (m *DenomPairTakerFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairTakerFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairTakerFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairTakerFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairTakerFeeProposal.Merge(m, src)
+}
+func (m *DenomPairTakerFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairTakerFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairTakerFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairTakerFeeProposal proto.InternalMessageInfo
+
+// DenomPairRoutesProposal is a gov Content type for registering the canonical
+// routes of denom pairs. A record with an empty route removes the route
+// registered for its denom pair.
+type DenomPairRoutesProposal struct {
+	Title           string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	DenomPairRoutes []DenomPairRoute `protobuf:"bytes,3,rep,name=denom_pair_routes,json=denomPairRoutes,proto3" json:"denom_pair_routes" yaml:"denom_pair_routes"`
+}
+
+func (m *DenomPairRoutesProposal) Reset()      { *m = DenomPairRoutesProposal{} }
+func (*DenomPairRoutesProposal) ProtoMessage() {}
+func (*DenomPairRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{1}
+}
+func (m *DenomPairRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairRoutesProposal.Merge(m, src)
+}
+func (m *DenomPairRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairRoutesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DenomPairTakerFeeProposal)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFeeProposal")
+	proto.RegisterType((*DenomPairRoutesProposal)(nil), "osmosis.poolmanager.v1beta1.DenomPairRoutesProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/gov.proto", fileDescriptor_c95b3c1cda2a8632)
+}
+
+var fileDescriptor_c95b3c1cda2a8632 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x93, 0x2e, 0x0a, 0x66, 0x05, 0x6d, 0x58, 0xb4, 0xdb, 0x85, 0xa4, 0x44, 0x94, 0x55,
+	0xc9, 0x0c, 0xd5, 0xc3, 0x4a, 0x8f, 0x55, 0x3c, 0xaf, 0xc1, 0x93, 0x20, 0x75, 0xda, 0xbe, 0x8d,
+	0x83, 0x49, 0x5e, 0x98, 0x99, 0x2d, 0xf6, 0xee, 0x41, 0x3c, 0x79, 0xf4, 0xd8, 0x8f, 0xe0, 0xc1,
+	0x0f, 0xb1, 0x78, 0xda, 0x8b, 0xe0, 0x29, 0x48, 0x0b, 0xea, 0xb9, 0x9f, 0x40, 0x32, 0x99, 0x2e,
+	0x59, 0x2b, 0xab, 0x5e, 0xf6, 0x12, 0x32, 0xef, 0xfd, 0xdf, 0xfb, 0xff, 0xf3, 0x0b, 0xe3, 0xdc,
+	0x44, 0x99, 0xa2, 0xe4, 0x92, 0xe6, 0x88, 0x49, 0xca, 0x32, 0x16, 0x83, 0xa0, 0x93, 0xee, 0x10,
+	0x14, 0xeb, 0xd2, 0x18, 0x27, 0x24, 0x17, 0xa8, 0xd0, 0xdd, 0x31, 0x32, 0x52, 0x93, 0x11, 0x23,
+	0x6b, 0x6f, 0xc5, 0x18, 0xa3, 0xd6, 0xd1, 0xf2, 0xad, 0x1a, 0x69, 0x6f, 0x8f, 0xf4, 0xcc, 0xa0,
+	0x6a, 0x54, 0x07, 0xd3, 0x6a, 0xb2, 0x94, 0x67, 0x48, 0xf5, 0xd3, 0x94, 0x6e, 0x9f, 0x99, 0x03,
+	0x32, 0xd0, 0xe6, 0xa5, 0x34, 0xf8, 0xde, 0x70, 0xb6, 0x1f, 0x41, 0x86, 0xe9, 0x3e, 0xe3, 0xe2,
+	0x29, 0x7b, 0x05, 0xe2, 0x31, 0xc0, 0xbe, 0xc0, 0x1c, 0x25, 0x4b, 0xdc, 0x5b, 0xce, 0x05, 0xc5,
+	0x55, 0x02, 0x2d, 0xbb, 0x63, 0xef, 0x5e, 0xea, 0x5f, 0x5d, 0x16, 0xfe, 0xe5, 0x29, 0x4b, 0x93,
+	0x5e, 0xa0, 0xcb, 0x41, 0x54, 0xb5, 0xdd, 0x07, 0xce, 0xe6, 0x18, 0xe4, 0x48, 0xf0, 0x5c, 0x71,
+	0xcc, 0x5a, 0x0d, 0xad, 0xbe, 0xb6, 0x2c, 0x7c, 0xb7, 0x52, 0xd7, 0x9a, 0x41, 0x54, 0x97, 0xba,
+	0x6f, 0x6c, 0x67, 0x6b, 0x5c, 0xfa, 0x0f, 0x72, 0xc6, 0xc5, 0x40, 0x95, 0x09, 0x06, 0x07, 0x00,
+	0xad, 0x8d, 0xce, 0xc6, 0xee, 0xe6, 0x3d, 0x42, 0xce, 0x60, 0x45, 0xd6, 0x82, 0xf7, 0x6f, 0x1c,
+	0x15, 0xbe, 0xb5, 0x2c, 0xfc, 0x9d, 0x95, 0xef, 0xfa, 0xe6, 0x20, 0x6a, 0x8e, 0x7f, 0x9f, 0xeb,
+	0xbd, 0x78, 0x3b, 0xf3, 0xad, 0x0f, 0x33, 0xdf, 0xfa, 0x39, 0xf3, 0xed, 0xcf, 0x9f, 0xc2, 0xb6,
+	0x41, 0x5c, 0xfe, 0xb4, 0x95, 0xdb, 0x43, 0xcc, 0x14, 0x64, 0xea, 0xdd, 0x8f, 0x8f, 0x77, 0xba,
+	0x7f, 0x02, 0xac, 0xb7, 0x86, 0xa5, 0x59, 0xa8, 0xcd, 0xc2, 0x03, 0x80, 0x30, 0x37, 0x28, 0x83,
+	0x2f, 0x0d, 0xe7, 0xfa, 0x49, 0xde, 0x08, 0x0f, 0x15, 0xc8, 0x73, 0xc4, 0x3c, 0x75, 0x9a, 0x35,
+	0x16, 0x42, 0xdb, 0x1b, 0xc4, 0x77, 0xff, 0x0d, 0xb1, 0x8e, 0xdc, 0xef, 0x18, 0xbe, 0xad, 0x35,
+	0xbe, 0xd5, 0xce, 0x20, 0xba, 0x32, 0x3e, 0xfd, 0x91, 0xbd, 0xe7, 0xff, 0x87, 0x96, 0xfc, 0x05,
+	0x6d, 0xe5, 0x73, 0xc2, 0xb5, 0xff, 0xe4, 0x68, 0xee, 0xd9, 0xc7, 0x73, 0xcf, 0xfe, 0x36, 0xf7,
+	0xec, 0xf7, 0x0b, 0xcf, 0x3a, 0x5e, 0x78, 0xd6, 0xd7, 0x85, 0x67, 0x3d, 0xdb, 0x8b, 0xb9, 0x7a,
+	0x79, 0x38, 0x24, 0x23, 0x4c, 0xa9, 0x59, 0x1a, 0x26, 0x6c, 0x28, 0x57, 0x07, 0x3a, 0xe9, 0xee,
+	0xd1, 0xd7, 0xa7, 0x7c, 0xd4, 0x34, 0x07, 0x39, 0xbc, 0xa8, 0xaf, 0xc6, 0xfd, 0x5f, 0x03, 0x00,
+	0x74, 0x57, 0x24, 0x17, 0xcf, 0x03, 0x00, 0x00,
+}
+
+func (this *DenomPairTakerFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPairTakerFeeProposal)
+	if !ok {
+		that2, ok := that.(DenomPairTakerFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.DenomPairTakerFee) != len(that1.DenomPairTakerFee) {
+		return false
+	}
+	for i := range this.DenomPairTakerFee {
+		if !this.DenomPairTakerFee[i].Equal(&that1.DenomPairTakerFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomPairRoutesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPairRoutesProposal)
+	if !ok {
+		that2, ok := that.(DenomPairRoutesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.DenomPairRoutes) != len(that1.DenomPairRoutes) {
+		return false
+	}
+	for i := range this.DenomPairRoutes {
+		if !this.DenomPairRoutes[i].Equal(&that1.DenomPairRoutes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *DenomPairTakerFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairTakerFee) > 0 {
+		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairRoutes) > 0 {
+		for iNdEx := len(m.DenomPairRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomPairTakerFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPairRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.DenomPairRoutes) > 0 {
+		for _, e := range m.DenomPairRoutes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomPairTakerFeeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairTakerFeeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairTakerFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPairRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairRoutes = append(m.DenomPairRoutes, DenomPairRoute{})
+			if err := m.DenomPairRoutes[len(m.DenomPairRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
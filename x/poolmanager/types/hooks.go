@@ -0,0 +1,107 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// PoolHookContractGasLimit is the maximum amount of gas that a pool hook contract
+// can consume on a single call.
+const PoolHookContractGasLimit uint64 = 500_000
+
+// PoolHooks defines the hooks called by the poolmanager for every pool type.
+type PoolHooks interface {
+	// AfterPoolCreated is called after a pool of any type is created.
+	AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolType PoolType)
+
+	// AfterSwap is called after a swap is routed through the poolmanager, with one hop
+	// per pool of the route in the order they were swapped through.
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, route []SwapHop)
+
+	// AfterJoin is called after liquidity is added to a pool.
+	AfterJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins)
+
+	// AfterExit is called after liquidity is removed from a pool.
+	AfterExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins)
+}
+
+// SwapHop is a single pool swapped through by a routed swap.
+type SwapHop struct {
+	PoolId   uint64   `json:"pool_id"`
+	TokenIn  sdk.Coin `json:"token_in"`
+	TokenOut sdk.Coin `json:"token_out"`
+}
+
+var _ PoolHooks = MultiPoolHooks{}
+
+// combine multiple pool hooks, all hook functions are run in array sequence.
+type MultiPoolHooks []PoolHooks
+
+// Creates hooks for the poolmanager module.
+func NewMultiPoolHooks(hooks ...PoolHooks) MultiPoolHooks {
+	return hooks
+}
+
+func (h MultiPoolHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolType PoolType) {
+	for i := range h {
+		h[i].AfterPoolCreated(ctx, sender, poolId, poolType)
+	}
+}
+
+func (h MultiPoolHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, route []SwapHop) {
+	for i := range h {
+		h[i].AfterSwap(ctx, sender, route)
+	}
+}
+
+func (h MultiPoolHooks) AfterJoin(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins) {
+	for i := range h {
+		h[i].AfterJoin(ctx, sender, poolId, tokensIn)
+	}
+}
+
+func (h MultiPoolHooks) AfterExit(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins) {
+	for i := range h {
+		h[i].AfterExit(ctx, sender, poolId, tokensOut)
+	}
+}
+
+// AfterPoolCreatedSudoMsg is the sudo message sent to the pool hook contracts after a pool is created.
+type AfterPoolCreatedSudoMsg struct {
+	AfterPoolCreated AfterPoolCreatedMsg `json:"after_pool_created"`
+}
+
+type AfterPoolCreatedMsg struct {
+	Sender   string `json:"sender"`
+	PoolId   uint64 `json:"pool_id"`
+	PoolType string `json:"pool_type"`
+}
+
+// AfterSwapSudoMsg is the sudo message sent to the pool hook contracts after a swap.
+type AfterSwapSudoMsg struct {
+	AfterSwap AfterSwapMsg `json:"after_swap"`
+}
+
+type AfterSwapMsg struct {
+	Sender string    `json:"sender"`
+	Route  []SwapHop `json:"route"`
+}
+
+// AfterJoinSudoMsg is the sudo message sent to the pool hook contracts after liquidity is added to a pool.
+type AfterJoinSudoMsg struct {
+	AfterJoin AfterJoinMsg `json:"after_join"`
+}
+
+type AfterJoinMsg struct {
+	Sender   string    `json:"sender"`
+	PoolId   uint64    `json:"pool_id"`
+	TokensIn sdk.Coins `json:"tokens_in"`
+}
+
+// AfterExitSudoMsg is the sudo message sent to the pool hook contracts after liquidity is removed from a pool.
+type AfterExitSudoMsg struct {
+	AfterExit AfterExitMsg `json:"after_exit"`
+}
+
+type AfterExitMsg struct {
+	Sender    string    `json:"sender"`
+	PoolId    uint64    `json:"pool_id"`
+	TokensOut sdk.Coins `json:"tokens_out"`
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -12,6 +14,8 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	KeySeparator = "|"
 )
 
 var (
@@ -20,6 +24,15 @@ var (
 
 	// SwapModuleRouterPrefix defines prefix to store pool id to swap module mappings.
 	SwapModuleRouterPrefix = []byte{0x02}
+
+	// DenomTradePairPrefix defines prefix to store the taker fee of a denom pair.
+	DenomTradePairPrefix = []byte{0x03}
+
+	// KeyPoolVolumePrefix defines prefix to store the cumulative swap volume of a pool.
+	KeyPoolVolumePrefix = []byte{0x04}
+
+	// DenomPairRoutePrefix defines prefix to store the canonical route of a denom pair.
+	DenomPairRoutePrefix = []byte{0x05}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -37,3 +50,32 @@ func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
 	}
 	return moduleRoute, err
 }
+
+// FormatDenomTradePairKey serializes the denom pair into the key under which its taker fee is stored.
+// The denoms are sorted so that the key is the same regardless of the swap direction.
+func FormatDenomTradePairKey(denom0, denom1 string) []byte {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return []byte(fmt.Sprintf("%s%s%s%s%s", DenomTradePairPrefix, KeySeparator, denom0, KeySeparator, denom1))
+}
+
+// ParseDenomTradePairKey parses the denom pair from the given taker fee store key.
+func ParseDenomTradePairKey(key []byte) (denom0, denom1 string, err error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("invalid denom trade pair key (%s)", string(key))
+	}
+	return parts[1], parts[2], nil
+}
+
+// FormatPoolVolumeKey returns the key under which the swap volume of the given pool is stored.
+func FormatPoolVolumeKey(poolId uint64) []byte {
+	return append(KeyPoolVolumePrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// FormatDenomPairRouteKey returns the key under which the canonical route for swapping
+// tokenInDenom for tokenOutDenom is stored. Unlike taker fee keys, the pair is ordered.
+func FormatDenomPairRouteKey(tokenInDenom, tokenOutDenom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", DenomPairRoutePrefix, KeySeparator, tokenInDenom, KeySeparator, tokenOutDenom))
+}
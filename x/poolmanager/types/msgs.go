@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +14,8 @@ const (
 	TypeMsgSwapExactAmountOut           = "swap_exact_amount_out"
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
+	TypeMsgSwapBestRoute                = "swap_best_route"
+	TypeMsgSwapExactAmountInByDenoms    = "swap_exact_amount_in_by_denoms"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -166,3 +170,79 @@ func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSwapBestRoute{}
+
+func (msg MsgSwapBestRoute) Route() string { return RouterKey }
+func (msg MsgSwapBestRoute) Type() string  { return TypeMsgSwapBestRoute }
+
+func (msg MsgSwapBestRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if err := ValidateBestRouteSearch(msg.TokenIn, msg.TokenOutDenom, msg.MaxHops, msg.MaxSplits); err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapBestRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapBestRoute) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountInByDenoms{}
+
+func (msg MsgSwapExactAmountInByDenoms) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInByDenoms) Type() string  { return TypeMsgSwapExactAmountInByDenoms }
+
+func (msg MsgSwapExactAmountInByDenoms) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return fmt.Errorf("token in denom and token out denom must be different, were both (%s)", msg.TokenOutDenom)
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountInByDenoms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountInByDenoms) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
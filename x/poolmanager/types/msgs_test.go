@@ -567,3 +567,187 @@ func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapBestRoute(t *testing.T) {
+	defaultValidMsg := types.MsgSwapBestRoute{
+		Sender:            addr1,
+		TokenIn:           sdk.NewCoin("udai", sdk.NewInt(100)),
+		TokenOutDenom:     "uatom",
+		TokenOutMinAmount: sdk.OneInt(),
+		MaxHops:           3,
+		MaxSplits:         2,
+	}
+
+	msg := createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSwapBestRoute)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSwapBestRoute
+		expectError bool
+	}{
+		"valid": {
+			msg: defaultValidMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.TokenIn.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.TokenOutDenom = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"token out denom same as token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.TokenOutDenom = msg.TokenIn.Denom
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out min amount": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.TokenOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero max hops": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.MaxHops = 0
+				return msg
+			}),
+			expectError: true,
+		},
+		"too many hops": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.MaxHops = types.MaxBestRouteHops + 1
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero max splits": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.MaxSplits = 0
+				return msg
+			}),
+			expectError: true,
+		},
+		"too many splits": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapBestRoute) types.MsgSwapBestRoute {
+				msg.MaxSplits = types.MaxBestRouteSplits + 1
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSwapExactAmountInByDenoms(t *testing.T) {
+	defaultValidMsg := types.MsgSwapExactAmountInByDenoms{
+		Sender:            addr1,
+		TokenIn:           sdk.NewCoin("udai", sdk.NewInt(100)),
+		TokenOutDenom:     "uatom",
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+
+	msg := createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSwapExactAmountInByDenoms)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSwapExactAmountInByDenoms
+		expectError bool
+	}{
+		"valid": {
+			msg: defaultValidMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+				msg.TokenIn.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+				msg.TokenOutDenom = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"token out denom same as token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+				msg.TokenOutDenom = msg.TokenIn.Denom
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out min amount": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSwapExactAmountInByDenoms) types.MsgSwapExactAmountInByDenoms {
+				msg.TokenOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
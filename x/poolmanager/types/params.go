@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	appparams "github.com/osmosis-labs/osmosis/v17/app/params"
 
@@ -12,23 +13,42 @@ import (
 // Parameter store keys.
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyTakerFeeParams  = []byte("TakerFeeParams")
+
+	KeyPoolHookContracts = []byte("PoolHookContracts")
+	KeyVolumeTwapWindow  = []byte("VolumeTwapWindow")
 )
 
+// DefaultVolumeTwapWindow is the default time window of the twap used to value pool volumes in uosmo.
+const DefaultVolumeTwapWindow = 5 * time.Minute
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns poolmanager params with the given pool creation fee. The params added
+// after the module was introduced in v15, such as the taker fee params and the pool hook
+// contracts, are set to their defaults, so that the v15 upgrade handler keeps working.
 func NewParams(poolCreationFee sdk.Coins) Params {
-	return Params{
-		PoolCreationFee: poolCreationFee,
-	}
+	params := DefaultParams()
+	params.PoolCreationFee = poolCreationFee
+	return params
 }
 
 // DefaultParams are the default poolmanager module parameters.
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakerFeeParams: TakerFeeParams{
+			DefaultTakerFee: sdk.ZeroDec(), // 0%
+			TakerFeeDistribution: TakerFeeDistributionPercentage{
+				StakingRewards: sdk.OneDec(), // 100%
+				CommunityPool:  sdk.ZeroDec(),
+			},
+		},
+		PoolHookContracts: []string{},
+		VolumeTwapWindow:  DefaultVolumeTwapWindow,
 	}
 }
 
@@ -37,6 +57,15 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateTakerFeeParams(p.TakerFeeParams); err != nil {
+		return err
+	}
+	if err := validatePoolHookContracts(p.PoolHookContracts); err != nil {
+		return err
+	}
+	if err := validateVolumeTwapWindow(p.VolumeTwapWindow); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +74,9 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakerFeeParams, &p.TakerFeeParams, validateTakerFeeParams),
+		paramtypes.NewParamSetPair(KeyPoolHookContracts, &p.PoolHookContracts, validatePoolHookContracts),
+		paramtypes.NewParamSetPair(KeyVolumeTwapWindow, &p.VolumeTwapWindow, validateVolumeTwapWindow),
 	}
 }
 
@@ -60,3 +92,71 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateTakerFeeParams(i interface{}) error {
+	takerFeeParams, ok := i.(TakerFeeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := ValidateTakerFee(takerFeeParams.DefaultTakerFee); err != nil {
+		return err
+	}
+
+	distribution := takerFeeParams.TakerFeeDistribution
+	if distribution.StakingRewards.IsNil() || distribution.CommunityPool.IsNil() {
+		return fmt.Errorf("taker fee distribution percentages must be set: %+v", distribution)
+	}
+	if distribution.StakingRewards.IsNegative() || distribution.CommunityPool.IsNegative() {
+		return fmt.Errorf("taker fee distribution percentages must be non-negative: %+v", distribution)
+	}
+	if !distribution.StakingRewards.Add(distribution.CommunityPool).Equal(sdk.OneDec()) {
+		return fmt.Errorf("taker fee distribution percentages must sum up to one: %+v", distribution)
+	}
+
+	return nil
+}
+
+func validatePoolHookContracts(i interface{}) error {
+	contracts, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(contracts))
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid pool hook contract address (%s): %w", contract, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate pool hook contract (%s)", contract)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
+
+func validateVolumeTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("volume twap window must be positive, got %s", v)
+	}
+
+	return nil
+}
+
+// ValidateTakerFee returns an error if the given taker fee is not in the [0, 1) range.
+func ValidateTakerFee(takerFee sdk.Dec) error {
+	if takerFee.IsNil() {
+		return fmt.Errorf("taker fee must be set")
+	}
+	if takerFee.IsNegative() || takerFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("taker fee must be in [0, 1) range, was (%s)", takerFee)
+	}
+	return nil
+}
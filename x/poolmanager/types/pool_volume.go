@@ -0,0 +1,37 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate returns an error if the pool volume has a zero pool id, invalid coins
+// or a negative OSMO volume.
+func (v PoolVolume) Validate() error {
+	if v.PoolId == 0 {
+		return errors.New("pool volume pool id cannot be 0")
+	}
+	if err := v.PoolVolume.Validate(); err != nil {
+		return fmt.Errorf("invalid volume for pool %d: %w", v.PoolId, err)
+	}
+	if v.OsmoVolume.IsNil() || v.OsmoVolume.IsNegative() {
+		return fmt.Errorf("osmo volume for pool %d must be non-negative, was (%s)", v.PoolId, v.OsmoVolume)
+	}
+	return nil
+}
+
+// ValidatePoolVolumes validates each of the given pool volumes and
+// returns an error if the same pool appears more than once.
+func ValidatePoolVolumes(poolVolumes []PoolVolume) error {
+	seen := make(map[uint64]struct{}, len(poolVolumes))
+	for _, poolVolume := range poolVolumes {
+		if err := poolVolume.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[poolVolume.PoolId]; ok {
+			return fmt.Errorf("duplicate volume for pool %d", poolVolume.PoolId)
+		}
+		seen[poolVolume.PoolId] = struct{}{}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -22,6 +24,7 @@ type AccountI interface {
 // creating a x/gamm keeper.
 type BankI interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 }
 
@@ -101,6 +104,23 @@ type PoolIncentivesKeeperI interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
 }
 
+// ProtorevKeeperI defines the protorev keeper methods used to find
+// the OSMO pool paired with a denom when valuing swap volume.
+type ProtorevKeeperI interface {
+	GetPoolForDenomPair(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
+}
+
+// TwapKeeperI defines the twap keeper methods used to value swap volume in OSMO.
+type TwapKeeperI interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
+// ContractKeeperI defines the contract keeper methods used to call
+// the pool hook contracts.
+type ContractKeeperI interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
 type MultihopRoute interface {
 	Length() int
 	PoolIds() []uint64
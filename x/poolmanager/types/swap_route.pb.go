@@ -230,37 +230,65 @@ func init() {
 }
 
 var fileDescriptor_cddd97a9a05492a8 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x8f, 0x93, 0x40,
-	0x18, 0xc7, 0x19, 0x5f, 0xd6, 0x38, 0xae, 0x55, 0xc9, 0xba, 0x5b, 0xd7, 0x04, 0x1a, 0x0e, 0xa6,
-	0x89, 0xee, 0x4c, 0xaa, 0x87, 0x26, 0x5e, 0x8c, 0xc4, 0x83, 0x9c, 0x1a, 0xe9, 0xad, 0x1e, 0xc8,
-	0x50, 0x08, 0x92, 0xc2, 0x0c, 0xe9, 0x0c, 0xad, 0xbd, 0x1a, 0x3f, 0x80, 0x1f, 0xab, 0xc7, 0x1e,
-	0x8d, 0x07, 0x62, 0xda, 0xc4, 0x8b, 0x37, 0x3e, 0x81, 0x61, 0x00, 0x0b, 0x35, 0x69, 0xd4, 0x13,
-	0xf3, 0xf2, 0xbc, 0xfc, 0xfe, 0x7f, 0x9e, 0x81, 0xcf, 0x18, 0x8f, 0x19, 0x0f, 0x39, 0x4e, 0x18,
-	0x8b, 0x62, 0x42, 0x49, 0xe0, 0xcf, 0xf1, 0x62, 0xe0, 0xfa, 0x82, 0x0c, 0x30, 0x5f, 0x92, 0xc4,
-	0x99, 0xb3, 0x54, 0xf8, 0x28, 0x99, 0x33, 0xc1, 0xd4, 0xc7, 0x55, 0x34, 0x6a, 0x44, 0xa3, 0x2a,
-	0xfa, 0xf2, 0x2c, 0x60, 0x01, 0x93, 0x71, 0xb8, 0x58, 0x95, 0x29, 0xc6, 0x67, 0x00, 0x1f, 0x8c,
-	0x97, 0x24, 0x79, 0x1d, 0xb3, 0x94, 0x0a, 0x8b, 0xda, 0x45, 0x39, 0xf5, 0x29, 0xbc, 0x55, 0x94,
-	0x70, 0x42, 0xaf, 0x0b, 0x7a, 0xa0, 0x7f, 0xc3, 0x54, 0xf3, 0x4c, 0xef, 0xac, 0x48, 0x1c, 0xbd,
-	0x34, 0xaa, 0x0b, 0xc3, 0x3e, 0x29, 0x56, 0x96, 0xa7, 0x9a, 0xf0, 0x9e, 0x60, 0x33, 0x9f, 0x3a,
-	0x2c, 0x15, 0x8e, 0xe7, 0x53, 0x16, 0x77, 0xaf, 0xf5, 0x40, 0xff, 0xb6, 0x79, 0x99, 0x67, 0xfa,
-	0x79, 0x99, 0x74, 0x10, 0x60, 0xd8, 0x77, 0xe5, 0xc9, 0x28, 0x15, 0x6f, 0xe4, 0xfe, 0x13, 0x80,
-	0xea, 0x1e, 0x63, 0x94, 0x8a, 0xff, 0xe0, 0x78, 0x05, 0x3b, 0x65, 0x9b, 0x90, 0xb6, 0x30, 0x1e,
-	0xe5, 0x99, 0xfe, 0xb0, 0x89, 0x51, 0xdf, 0x1b, 0xf6, 0xa9, 0x3c, 0xb0, 0x68, 0x09, 0xf1, 0x03,
-	0xc0, 0xf3, 0xa6, 0x17, 0xe3, 0x24, 0x0a, 0x2b, 0x90, 0x09, 0xbc, 0x59, 0x74, 0xe1, 0x5d, 0xd0,
-	0xbb, 0xde, 0xbf, 0xf3, 0x1c, 0xa1, 0x23, 0x4e, 0xa3, 0x3f, 0xfc, 0x34, 0xcf, 0xd6, 0x99, 0xae,
-	0xe4, 0x99, 0x7e, 0xba, 0x47, 0xe7, 0x86, 0x5d, 0x96, 0x54, 0x93, 0xda, 0xbf, 0x90, 0x3a, 0x44,
-	0xa6, 0x55, 0xe0, 0x6f, 0x8b, 0xac, 0x6f, 0x99, 0xfe, 0x24, 0x08, 0xc5, 0x87, 0xd4, 0x45, 0x53,
-	0x16, 0xe3, 0xa9, 0x6c, 0x5c, 0x7d, 0xae, 0xb8, 0x37, 0xc3, 0x62, 0x95, 0xf8, 0x1c, 0x59, 0x54,
-	0x1c, 0xba, 0xfd, 0xbb, 0x5c, 0xed, 0xb6, 0x45, 0x4b, 0x2a, 0xe3, 0x27, 0x80, 0x17, 0x2d, 0xb7,
-	0x1b, 0x4a, 0xdf, 0xb7, 0x95, 0xe2, 0xbf, 0x54, 0x5a, 0xff, 0xb2, 0xe3, 0x52, 0x39, 0xbc, 0xbf,
-	0x9f, 0x84, 0x96, 0x56, 0xeb, 0x9f, 0xb5, 0x5e, 0x1c, 0x4e, 0x56, 0x2d, 0xb6, 0x53, 0x8f, 0x56,
-	0x49, 0x66, 0xbe, 0x5b, 0x6f, 0x35, 0xb0, 0xd9, 0x6a, 0xe0, 0xfb, 0x56, 0x03, 0x5f, 0x76, 0x9a,
-	0xb2, 0xd9, 0x69, 0xca, 0xd7, 0x9d, 0xa6, 0x4c, 0x86, 0x8d, 0x66, 0x95, 0xcc, 0xab, 0x88, 0xb8,
-	0xbc, 0xde, 0xe0, 0xc5, 0x60, 0x88, 0x3f, 0xb6, 0xde, 0x9e, 0x24, 0x70, 0x4f, 0xe4, 0xe3, 0x79,
-	0xf1, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x4c, 0x0e, 0xec, 0x9f, 0x03, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xba, 0xae, 0x38, 0xae, 0x55, 0xc3, 0xba, 0x5b, 0x57, 0x48, 0xca, 0x1c, 0xa4,
+	0xa0, 0x3b, 0x43, 0xf5, 0xb0, 0xb0, 0x17, 0x31, 0x78, 0x30, 0xa7, 0xc5, 0xec, 0x6d, 0x3d, 0x84,
+	0xc9, 0x26, 0xc4, 0xb0, 0xc9, 0x4c, 0xe8, 0x4c, 0x5a, 0x7b, 0xf5, 0xe8, 0xc9, 0x8f, 0xe0, 0xc7,
+	0xe9, 0xb1, 0x47, 0xf1, 0x10, 0xa4, 0x05, 0x11, 0xbc, 0xe5, 0x13, 0x48, 0x26, 0x89, 0x4d, 0x2a,
+	0x14, 0xf5, 0x94, 0x79, 0x79, 0x5e, 0x7e, 0xff, 0x7f, 0x9e, 0x81, 0x4f, 0xb9, 0x48, 0xb8, 0x88,
+	0x04, 0x49, 0x39, 0x8f, 0x13, 0xca, 0x68, 0x18, 0x8c, 0xc9, 0x64, 0xe4, 0x05, 0x92, 0x8e, 0x88,
+	0x98, 0xd2, 0xd4, 0x1d, 0xf3, 0x4c, 0x06, 0x38, 0x1d, 0x73, 0xc9, 0xf5, 0x47, 0x75, 0x34, 0x6e,
+	0x45, 0xe3, 0x3a, 0xfa, 0x68, 0x3f, 0xe4, 0x21, 0x57, 0x71, 0xa4, 0x5c, 0x55, 0x29, 0xe8, 0x23,
+	0x80, 0xf7, 0xcf, 0xa7, 0x34, 0x7d, 0x99, 0xf0, 0x8c, 0x49, 0x9b, 0x39, 0x65, 0x39, 0xfd, 0x09,
+	0xbc, 0x59, 0x96, 0x70, 0x23, 0xbf, 0x0f, 0x06, 0x60, 0xb8, 0x63, 0xe9, 0x45, 0x6e, 0xf6, 0x66,
+	0x34, 0x89, 0x4f, 0x51, 0x7d, 0x81, 0x9c, 0xdd, 0x72, 0x65, 0xfb, 0xba, 0x05, 0xef, 0x4a, 0x7e,
+	0x15, 0x30, 0x97, 0x67, 0xd2, 0xf5, 0x03, 0xc6, 0x93, 0xfe, 0xb5, 0x01, 0x18, 0xde, 0xb2, 0x8e,
+	0x8a, 0xdc, 0x3c, 0xa8, 0x92, 0x36, 0x02, 0x90, 0x73, 0x47, 0x9d, 0x9c, 0x65, 0xf2, 0x55, 0xb9,
+	0x3f, 0xdd, 0xf9, 0xf1, 0xd9, 0x04, 0xe8, 0x03, 0x80, 0xfa, 0x1a, 0xe6, 0x2c, 0x93, 0xff, 0x41,
+	0xf3, 0x02, 0xf6, 0xaa, 0x66, 0x11, 0xeb, 0xc0, 0x3c, 0x2c, 0x72, 0xf3, 0x41, 0x1b, 0xa6, 0xb9,
+	0x47, 0xce, 0x9e, 0x3a, 0xb0, 0x99, 0x42, 0x41, 0xdf, 0x01, 0x3c, 0x68, 0x3b, 0x72, 0x9e, 0xc6,
+	0x51, 0x0d, 0x72, 0x01, 0x6f, 0x94, 0x5d, 0x44, 0x1f, 0x0c, 0xae, 0x0f, 0x6f, 0x3f, 0xc3, 0x78,
+	0x8b, 0xdf, 0xf8, 0x0f, 0x57, 0xad, 0xfd, 0x79, 0x6e, 0x6a, 0x45, 0x6e, 0xee, 0xad, 0xd1, 0x05,
+	0x72, 0xaa, 0x92, 0x7a, 0xda, 0xb8, 0x18, 0x31, 0x97, 0xaa, 0xb4, 0x1a, 0xfc, 0x75, 0x99, 0xf5,
+	0x35, 0x37, 0x1f, 0x87, 0x91, 0x7c, 0x97, 0x79, 0xf8, 0x92, 0x27, 0xe4, 0x52, 0x35, 0xae, 0x3f,
+	0xc7, 0xc2, 0xbf, 0x22, 0x72, 0x96, 0x06, 0x02, 0xdb, 0x4c, 0x6e, 0x7a, 0xfe, 0xbb, 0x5c, 0xe3,
+	0xb9, 0xcd, 0x2a, 0x2a, 0xf4, 0x13, 0xc0, 0xc3, 0x8e, 0xdb, 0x2d, 0xa5, 0x6f, 0xbb, 0x4a, 0xc9,
+	0x5f, 0x2a, 0x6d, 0x7e, 0xd9, 0x76, 0xa9, 0x02, 0xde, 0x5b, 0xcf, 0x43, 0x47, 0xab, 0xfd, 0xcf,
+	0x5a, 0x0f, 0x37, 0xe7, 0xab, 0x11, 0xdb, 0x6b, 0x06, 0xac, 0x22, 0xb3, 0xde, 0xcc, 0x97, 0x06,
+	0x58, 0x2c, 0x0d, 0xf0, 0x6d, 0x69, 0x80, 0x4f, 0x2b, 0x43, 0x5b, 0xac, 0x0c, 0xed, 0xcb, 0xca,
+	0xd0, 0x2e, 0x4e, 0x5a, 0xcd, 0x6a, 0x99, 0xc7, 0x31, 0xf5, 0x44, 0xb3, 0x21, 0x93, 0xd1, 0x09,
+	0x79, 0xdf, 0x79, 0x81, 0x8a, 0xc0, 0xdb, 0x55, 0x4f, 0xe8, 0xf9, 0xaf, 0x01, 0x00, 0x5a, 0x9e,
+	0x8b, 0xcd, 0xa5, 0x03, 0x00, 0x00,
 }
 
+func (this *SwapAmountInRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapAmountInRoute)
+	if !ok {
+		that2, ok := that.(SwapAmountInRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	return true
+}
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
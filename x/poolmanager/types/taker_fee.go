@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the denoms of the record are invalid, identical
+// or not sorted, or if the taker fee is not in the [0, 1) range.
+func (r DenomPairTakerFee) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.Denom1); err != nil {
+		return err
+	}
+	if r.Denom0 >= r.Denom1 {
+		return fmt.Errorf("denom0 (%s) must be lexicographically smaller than denom1 (%s)", r.Denom0, r.Denom1)
+	}
+	return ValidateTakerFee(r.TakerFee)
+}
+
+// ValidateDenomPairTakerFees validates each of the given records and
+// returns an error if the same denom pair appears more than once.
+func ValidateDenomPairTakerFees(records []DenomPairTakerFee) error {
+	seen := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := string(FormatDenomTradePairKey(record.Denom0, record.Denom1))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate taker fee for denom pair (%s, %s)", record.Denom0, record.Denom1)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -431,6 +431,228 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSwapBestRoute
+type MsgSwapBestRoute struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin                             `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                                 `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	MaxHops           uint64                                 `protobuf:"varint,5,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	MaxSplits         uint64                                 `protobuf:"varint,6,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty" yaml:"max_splits"`
+}
+
+func (m *MsgSwapBestRoute) Reset()         { *m = MsgSwapBestRoute{} }
+func (m *MsgSwapBestRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapBestRoute) ProtoMessage()    {}
+func (*MsgSwapBestRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgSwapBestRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapBestRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapBestRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapBestRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapBestRoute.Merge(m, src)
+}
+func (m *MsgSwapBestRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapBestRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapBestRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapBestRoute proto.InternalMessageInfo
+
+func (m *MsgSwapBestRoute) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSwapBestRoute) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapBestRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgSwapBestRoute) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *MsgSwapBestRoute) GetMaxSplits() uint64 {
+	if m != nil {
+		return m.MaxSplits
+	}
+	return 0
+}
+
+type MsgSwapBestRouteResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	Routes         []SwapAmountInSplitRoute               `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *MsgSwapBestRouteResponse) Reset()         { *m = MsgSwapBestRouteResponse{} }
+func (m *MsgSwapBestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapBestRouteResponse) ProtoMessage()    {}
+func (*MsgSwapBestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *MsgSwapBestRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapBestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapBestRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapBestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapBestRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapBestRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapBestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapBestRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapBestRouteResponse proto.InternalMessageInfo
+
+func (m *MsgSwapBestRouteResponse) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// ===================== MsgSwapExactAmountInByDenoms
+type MsgSwapExactAmountInByDenoms struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin                             `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                                 `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSwapExactAmountInByDenoms) Reset()         { *m = MsgSwapExactAmountInByDenoms{} }
+func (m *MsgSwapExactAmountInByDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInByDenoms) ProtoMessage()    {}
+func (*MsgSwapExactAmountInByDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{10}
+}
+func (m *MsgSwapExactAmountInByDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInByDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInByDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInByDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInByDenoms.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInByDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInByDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInByDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInByDenoms proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountInByDenoms) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSwapExactAmountInByDenoms) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapExactAmountInByDenoms) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type MsgSwapExactAmountInByDenomsResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSwapExactAmountInByDenomsResponse) Reset()         { *m = MsgSwapExactAmountInByDenomsResponse{} }
+func (m *MsgSwapExactAmountInByDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInByDenomsResponse) ProtoMessage()    {}
+func (*MsgSwapExactAmountInByDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *MsgSwapExactAmountInByDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInByDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInByDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInByDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInByDenomsResponse.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInByDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInByDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInByDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInByDenomsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
@@ -440,6 +662,10 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgSwapBestRoute)(nil), "osmosis.poolmanager.v1beta1.MsgSwapBestRoute")
+	proto.RegisterType((*MsgSwapBestRouteResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapBestRouteResponse")
+	proto.RegisterType((*MsgSwapExactAmountInByDenoms)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInByDenoms")
+	proto.RegisterType((*MsgSwapExactAmountInByDenomsResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInByDenomsResponse")
 }
 
 func init() {
@@ -447,59 +673,71 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 818 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x5f, 0x6b, 0xd3, 0x5e,
-	0x18, 0xc7, 0x9b, 0x5f, 0xcb, 0x7e, 0xdb, 0x99, 0xfb, 0xd3, 0xb8, 0xb9, 0xae, 0x9b, 0xe9, 0x88,
-	0x63, 0x76, 0x62, 0x13, 0xda, 0x09, 0xc3, 0x2a, 0x88, 0xdd, 0x04, 0x0b, 0x2b, 0x75, 0xf1, 0xce,
-	0x9b, 0x92, 0x76, 0xa1, 0x86, 0x35, 0xe7, 0x84, 0x9e, 0x93, 0xad, 0x43, 0x10, 0x04, 0x6f, 0x14,
-	0x85, 0x89, 0x97, 0x22, 0x82, 0xaf, 0xc0, 0x97, 0xb1, 0xcb, 0xdd, 0x29, 0x5e, 0x14, 0xd9, 0x2e,
-	0xbc, 0xef, 0x2b, 0x90, 0x93, 0x93, 0x64, 0x6d, 0xd6, 0x65, 0x8d, 0x03, 0x77, 0xb3, 0x25, 0x27,
-	0xcf, 0xbf, 0xef, 0xf3, 0x7c, 0xf2, 0xa4, 0x60, 0x11, 0x61, 0x03, 0x61, 0x1d, 0xcb, 0x26, 0x42,
-	0x0d, 0x43, 0x85, 0x6a, 0x5d, 0x6b, 0xca, 0x3b, 0xd9, 0xaa, 0x46, 0xd4, 0xac, 0x4c, 0x5a, 0x92,
-	0xd9, 0x44, 0x04, 0xf1, 0x73, 0x8e, 0x95, 0xd4, 0x65, 0x25, 0x39, 0x56, 0xc9, 0xa9, 0x3a, 0xaa,
-	0x23, 0xdb, 0x4e, 0xa6, 0x57, 0xcc, 0x25, 0x19, 0x57, 0x0d, 0x1d, 0x22, 0xd9, 0xfe, 0xeb, 0x1c,
-	0x09, 0x35, 0x3b, 0x8c, 0x5c, 0x55, 0xb1, 0xe6, 0xe5, 0xa8, 0x21, 0x1d, 0x3a, 0xcf, 0x6f, 0x07,
-	0xd5, 0x82, 0x77, 0x55, 0xb3, 0xd2, 0x44, 0x16, 0xd1, 0x98, 0xb5, 0xf8, 0x3e, 0x0a, 0xa6, 0x4a,
-	0xb8, 0xfe, 0x74, 0x57, 0x35, 0x1f, 0xb5, 0xd4, 0x1a, 0x79, 0x68, 0x20, 0x0b, 0x92, 0x22, 0xe4,
-	0x97, 0xc1, 0x10, 0xd6, 0xe0, 0x96, 0xd6, 0x4c, 0x70, 0x0b, 0x5c, 0x7a, 0xa4, 0x10, 0xef, 0xb4,
-	0x53, 0x63, 0x7b, 0xaa, 0xd1, 0xc8, 0x8b, 0xec, 0x5c, 0x54, 0x1c, 0x03, 0x7e, 0x03, 0x0c, 0xd9,
-	0x21, 0x71, 0xe2, 0xbf, 0x85, 0x68, 0x7a, 0x34, 0x27, 0x49, 0x01, 0x42, 0x25, 0x9a, 0xca, 0xcd,
-	0xa2, 0x50, 0xb7, 0x42, 0xec, 0xa0, 0x9d, 0x8a, 0x28, 0x4e, 0x0c, 0xbe, 0x04, 0x86, 0x09, 0xda,
-	0xd6, 0x60, 0x45, 0x87, 0x89, 0xe8, 0x02, 0x97, 0x1e, 0xcd, 0xcd, 0x4a, 0x4c, 0xb2, 0x44, 0x25,
-	0x7b, 0x71, 0xd6, 0x90, 0x0e, 0x0b, 0x33, 0xd4, 0xb5, 0xd3, 0x4e, 0x4d, 0xb0, 0xca, 0x5c, 0x47,
-	0x51, 0xf9, 0xdf, 0xbe, 0x2c, 0x42, 0xfe, 0x25, 0x98, 0x62, 0xa7, 0xc8, 0x22, 0x15, 0x43, 0x87,
-	0x15, 0xd5, 0xce, 0x9d, 0x88, 0xd9, 0xaa, 0x4a, 0xd4, 0xff, 0x67, 0x3b, 0xb5, 0x54, 0xd7, 0xc9,
-	0x73, 0xab, 0x2a, 0xd5, 0x90, 0x21, 0x3b, 0xfd, 0x65, 0xff, 0x32, 0x78, 0x6b, 0x5b, 0x26, 0x7b,
-	0xa6, 0x86, 0xa5, 0x22, 0x24, 0x9d, 0x76, 0x6a, 0xae, 0x3b, 0x53, 0x6f, 0x4c, 0x51, 0x89, 0xdb,
-	0xc7, 0x65, 0x8b, 0x94, 0x74, 0xc8, 0x34, 0xe6, 0x33, 0x6f, 0x7f, 0x7f, 0xbb, 0x95, 0xee, 0x37,
-	0x13, 0x3a, 0x8b, 0x8c, 0x46, 0x9b, 0x9e, 0x61, 0xfe, 0x19, 0x1d, 0x8a, 0x1f, 0x39, 0x30, 0xdf,
-	0x6f, 0x1e, 0x8a, 0x86, 0x4d, 0x04, 0xb1, 0xc6, 0x63, 0x30, 0x79, 0x92, 0xdb, 0xd1, 0xc2, 0x26,
-	0x54, 0x0c, 0xad, 0x65, 0xc6, 0xaf, 0xc5, 0xd5, 0x31, 0xee, 0xea, 0x60, 0xe9, 0xc5, 0x37, 0x51,
-	0x20, 0xd0, 0xaa, 0xcc, 0x86, 0x4e, 0xec, 0x99, 0x5d, 0x88, 0x97, 0x4d, 0x1f, 0x2f, 0x2b, 0x03,
-	0xf3, 0x72, 0x52, 0x80, 0x0f, 0x9a, 0x07, 0x60, 0xdc, 0x9d, 0x7d, 0x65, 0x4b, 0x83, 0xc8, 0xb0,
-	0xd1, 0x19, 0x29, 0xcc, 0x76, 0xda, 0xa9, 0xe9, 0x5e, 0x36, 0xd8, 0x73, 0x51, 0xb9, 0xe2, 0x10,
-	0xb2, 0x4e, 0x6f, 0x2f, 0x1d, 0x93, 0x34, 0xc5, 0xe4, 0x46, 0x5f, 0x4c, 0xa8, 0xe6, 0x2e, 0x42,
-	0x3e, 0x73, 0x60, 0x29, 0x78, 0x16, 0x97, 0xcb, 0xca, 0x7e, 0x14, 0x4c, 0x9f, 0x26, 0xb8, 0x6c,
-	0x91, 0x30, 0x88, 0x94, 0x7c, 0x88, 0xc8, 0x03, 0x22, 0x52, 0xb6, 0xfa, 0xe2, 0xf1, 0x02, 0x5c,
-	0xf5, 0xc6, 0x6f, 0xa8, 0x2d, 0xb7, 0x17, 0x8c, 0x91, 0x8d, 0xd0, 0xbd, 0x48, 0xfa, 0x88, 0x3a,
-	0x09, 0x29, 0x2a, 0x93, 0x0e, 0x56, 0x25, 0xb5, 0xc5, 0x4a, 0xe2, 0x9f, 0x80, 0x11, 0xaf, 0x6b,
-	0x36, 0x4f, 0x81, 0x1b, 0x2d, 0xe1, 0x6c, 0xb4, 0x49, 0x5f, 0xbf, 0x45, 0x65, 0xd8, 0x6d, 0x74,
-	0x5e, 0xa2, 0xb0, 0x2c, 0x0f, 0xb6, 0x53, 0xa8, 0xeb, 0x07, 0x0e, 0x5c, 0xef, 0x3b, 0x12, 0x8f,
-	0x14, 0x13, 0x4c, 0x78, 0x6a, 0x7a, 0x40, 0x79, 0x1c, 0xba, 0x39, 0xd7, 0x7c, 0xcd, 0x71, 0x1b,
-	0x33, 0xe6, 0x34, 0xc6, 0xc1, 0xe4, 0x5d, 0x14, 0xa4, 0x82, 0x30, 0x0e, 0x09, 0x8c, 0xe2, 0x03,
-	0xe6, 0xce, 0xe0, 0xc0, 0x9c, 0xb9, 0x54, 0x0a, 0x6e, 0x53, 0x28, 0xee, 0xdd, 0x5b, 0x25, 0xe9,
-	0x97, 0xe9, 0x19, 0xb8, 0x32, 0xcb, 0x16, 0x61, 0x7b, 0xe5, 0x0c, 0xf2, 0x62, 0xff, 0x82, 0xbc,
-	0xfc, 0x32, 0xe5, 0x64, 0xf1, 0xdc, 0xa5, 0x42, 0x11, 0xf9, 0xc4, 0x81, 0x9b, 0xe7, 0x8c, 0xe3,
-	0xf2, 0x60, 0xc9, 0x7d, 0x8f, 0x81, 0x68, 0x09, 0xd7, 0xf9, 0x57, 0x1c, 0x88, 0x9f, 0xfe, 0xf4,
-	0x64, 0x03, 0x67, 0xdd, 0xef, 0x6b, 0x9a, 0xbc, 0x1b, 0xda, 0xc5, 0x53, 0xff, 0x9a, 0x03, 0x7c,
-	0x1f, 0x56, 0x73, 0x21, 0x23, 0x96, 0x2d, 0x92, 0xcc, 0x87, 0xf7, 0xf1, 0xca, 0xf8, 0xc2, 0x81,
-	0xb9, 0xa0, 0xef, 0xf1, 0xbd, 0x73, 0x63, 0x9f, 0xed, 0x9c, 0x5c, 0xbb, 0x80, 0xb3, 0x57, 0xe1,
-	0x57, 0x0e, 0xcc, 0x07, 0xbe, 0xde, 0xf7, 0xff, 0x3a, 0x0b, 0x6d, 0xde, 0xfa, 0x45, 0xbc, 0xdd,
-	0x22, 0x0b, 0x9b, 0x07, 0x47, 0x02, 0x77, 0x78, 0x24, 0x70, 0xbf, 0x8e, 0x04, 0x6e, 0xff, 0x58,
-	0x88, 0x1c, 0x1e, 0x0b, 0x91, 0x1f, 0xc7, 0x42, 0xe4, 0xd9, 0x6a, 0x17, 0xc4, 0x4e, 0xa6, 0x4c,
-	0x43, 0xad, 0x62, 0xf7, 0x46, 0xde, 0xc9, 0xae, 0xca, 0xad, 0x9e, 0xb7, 0xca, 0x26, 0xbb, 0x3a,
-	0x64, 0xff, 0xb2, 0x5e, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x43, 0xa8, 0x39, 0xec, 0x15, 0x0c,
-	0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0x5d, 0x6f, 0xdb, 0x54,
+	0x18, 0xc7, 0x7b, 0xe6, 0x90, 0xb5, 0x67, 0x74, 0x6d, 0xbc, 0x96, 0xb9, 0xee, 0x48, 0x2a, 0x53,
+	0x8d, 0x14, 0x61, 0x5b, 0xcd, 0x86, 0x2a, 0x02, 0x12, 0x9a, 0x37, 0xa4, 0x55, 0x5a, 0x14, 0x66,
+	0xee, 0xb8, 0x89, 0x9c, 0xd6, 0xca, 0xac, 0xd5, 0x3e, 0x56, 0xcf, 0xf1, 0x96, 0x0a, 0x09, 0x09,
+	0x89, 0x1b, 0x10, 0x48, 0x43, 0x88, 0x0b, 0x84, 0x10, 0x12, 0x9f, 0x80, 0x8f, 0xb1, 0xcb, 0x5d,
+	0x22, 0x2e, 0xa2, 0xa9, 0xbd, 0x40, 0xe2, 0x32, 0x7c, 0x01, 0x74, 0x5e, 0xec, 0x24, 0x5e, 0xea,
+	0xc4, 0xcb, 0x44, 0x6e, 0xb8, 0x69, 0x93, 0xe3, 0xf3, 0xbc, 0xfc, 0x9f, 0xe7, 0xe7, 0xe7, 0x9c,
+	0x16, 0x6e, 0x23, 0xec, 0x23, 0xec, 0x61, 0x33, 0x44, 0xe8, 0xc8, 0x77, 0x02, 0xa7, 0xe3, 0x1e,
+	0x9b, 0x8f, 0x76, 0xdb, 0x2e, 0x71, 0x76, 0x4d, 0xd2, 0x35, 0xc2, 0x63, 0x44, 0x90, 0xbc, 0x29,
+	0x76, 0x19, 0x43, 0xbb, 0x0c, 0xb1, 0x4b, 0x5d, 0xeb, 0xa0, 0x0e, 0x62, 0xfb, 0x4c, 0xfa, 0x89,
+	0x9b, 0xa8, 0x25, 0xc7, 0xf7, 0x02, 0x64, 0xb2, 0x9f, 0x62, 0xa9, 0x7c, 0xc0, 0xdc, 0x98, 0x6d,
+	0x07, 0xbb, 0x49, 0x8c, 0x03, 0xe4, 0x05, 0xe2, 0xf9, 0xbb, 0x59, 0xb9, 0xe0, 0xc7, 0x4e, 0xd8,
+	0x3a, 0x46, 0x11, 0x71, 0xf9, 0x6e, 0xed, 0x3b, 0x09, 0xae, 0x35, 0x70, 0xe7, 0xd3, 0xc7, 0x4e,
+	0xf8, 0x71, 0xd7, 0x39, 0x20, 0xb7, 0x7c, 0x14, 0x05, 0x64, 0x3f, 0x90, 0x77, 0x60, 0x11, 0xbb,
+	0xc1, 0xa1, 0x7b, 0xac, 0x80, 0x2d, 0x50, 0x5d, 0xb2, 0x4a, 0xfd, 0x5e, 0x65, 0xf9, 0xc4, 0xf1,
+	0x8f, 0xea, 0x1a, 0x5f, 0xd7, 0x6c, 0xb1, 0x41, 0xbe, 0x07, 0x8b, 0xcc, 0x25, 0x56, 0x2e, 0x6c,
+	0x49, 0xd5, 0x4b, 0x35, 0xc3, 0xc8, 0x10, 0x6a, 0xd0, 0x50, 0x71, 0x14, 0x9b, 0x9a, 0x59, 0x85,
+	0xa7, 0xbd, 0xca, 0x82, 0x2d, 0x7c, 0xc8, 0x0d, 0xb8, 0x48, 0xd0, 0x43, 0x37, 0x68, 0x79, 0x81,
+	0x22, 0x6d, 0x81, 0xea, 0xa5, 0xda, 0x86, 0xc1, 0x25, 0x1b, 0x54, 0x72, 0xe2, 0xe7, 0x36, 0xf2,
+	0x02, 0xeb, 0x2a, 0x35, 0xed, 0xf7, 0x2a, 0x2b, 0x3c, 0xb3, 0xd8, 0x50, 0xb3, 0x2f, 0xb2, 0x8f,
+	0xfb, 0x81, 0xfc, 0x05, 0x5c, 0xe3, 0xab, 0x28, 0x22, 0x2d, 0xdf, 0x0b, 0x5a, 0x0e, 0x8b, 0xad,
+	0x14, 0x98, 0xaa, 0x06, 0xb5, 0xff, 0xb3, 0x57, 0xb9, 0xde, 0xf1, 0xc8, 0x83, 0xa8, 0x6d, 0x1c,
+	0x20, 0xdf, 0x14, 0xf5, 0xe5, 0xbf, 0x74, 0x7c, 0xf8, 0xd0, 0x24, 0x27, 0xa1, 0x8b, 0x8d, 0xfd,
+	0x80, 0xf4, 0x7b, 0x95, 0xcd, 0xe1, 0x48, 0xa3, 0x3e, 0x35, 0xbb, 0xc4, 0x96, 0x9b, 0x11, 0x69,
+	0x78, 0x01, 0xd7, 0x58, 0xd7, 0xbf, 0xf9, 0xeb, 0xf7, 0x77, 0xaa, 0xe3, 0x7a, 0x42, 0x7b, 0xa1,
+	0xbb, 0xb4, 0xe8, 0x3a, 0xb7, 0xd7, 0xbd, 0x40, 0xfb, 0x01, 0xc0, 0x6b, 0xe3, 0xfa, 0x61, 0xbb,
+	0x38, 0x44, 0x01, 0x76, 0x65, 0x0c, 0x57, 0x07, 0xb1, 0x85, 0x16, 0xde, 0xa1, 0xfd, 0xdc, 0x5a,
+	0xae, 0xa6, 0xb5, 0xc4, 0x3a, 0x2e, 0xc7, 0x3a, 0x78, 0x78, 0xed, 0x6b, 0x09, 0x96, 0x69, 0x56,
+	0xe1, 0x91, 0x47, 0x58, 0xcf, 0x66, 0xe2, 0xe5, 0x7e, 0x8a, 0x97, 0x1b, 0x53, 0xf3, 0x32, 0x48,
+	0x20, 0x05, 0xcd, 0x47, 0xf0, 0x72, 0xdc, 0xfb, 0xd6, 0xa1, 0x1b, 0x20, 0x9f, 0xa1, 0xb3, 0x64,
+	0x6d, 0xf4, 0x7b, 0x95, 0xf5, 0x51, 0x36, 0xf8, 0x73, 0xcd, 0x7e, 0x5d, 0x10, 0x72, 0x87, 0x7e,
+	0x9d, 0x3b, 0x26, 0x55, 0x8a, 0xc9, 0x5b, 0x63, 0x31, 0xa1, 0x9a, 0x87, 0x08, 0xf9, 0x05, 0xc0,
+	0xeb, 0xd9, 0xbd, 0x98, 0x2f, 0x2b, 0x4f, 0x24, 0xb8, 0xfe, 0x22, 0xc1, 0xcd, 0x88, 0xe4, 0x41,
+	0xa4, 0x91, 0x42, 0xc4, 0x9c, 0x12, 0x91, 0x66, 0x34, 0x16, 0x8f, 0xcf, 0xe1, 0x95, 0xa4, 0xfd,
+	0xbe, 0xd3, 0x8d, 0x6b, 0xc1, 0x19, 0xb9, 0x97, 0xbb, 0x16, 0x6a, 0x8a, 0xa8, 0x81, 0x4b, 0xcd,
+	0x5e, 0x15, 0x58, 0x35, 0x9c, 0x2e, 0x4f, 0x49, 0xfe, 0x04, 0x2e, 0x25, 0x55, 0x53, 0x0a, 0x93,
+	0x26, 0x9a, 0x22, 0x26, 0xda, 0x6a, 0xaa, 0xde, 0x9a, 0xbd, 0x18, 0x17, 0xba, 0x6e, 0x50, 0x58,
+	0x76, 0xa6, 0x9b, 0x29, 0xd4, 0xf4, 0x7b, 0x00, 0xdf, 0x1c, 0xdb, 0x92, 0x84, 0x94, 0x10, 0xae,
+	0x24, 0x6a, 0x46, 0x40, 0xb9, 0x9b, 0xbb, 0x38, 0x6f, 0xa4, 0x8a, 0x13, 0x17, 0x66, 0x59, 0x14,
+	0x46, 0x60, 0xf2, 0xad, 0x04, 0x2b, 0x59, 0x18, 0xe7, 0x04, 0xc6, 0x4e, 0x01, 0x73, 0x73, 0x7a,
+	0x60, 0xce, 0x1d, 0x2a, 0x16, 0x5c, 0x19, 0xe0, 0x3e, 0x3c, 0x55, 0xd4, 0xb4, 0xcc, 0x64, 0x43,
+	0x2c, 0xb3, 0x19, 0x11, 0x3e, 0x57, 0xce, 0x21, 0xaf, 0xf0, 0x5f, 0x90, 0x57, 0xdf, 0xa1, 0x9c,
+	0x6c, 0x4f, 0x1c, 0x2a, 0x14, 0x91, 0x9f, 0x01, 0x7c, 0x7b, 0x42, 0x3b, 0xe6, 0x08, 0xcb, 0x73,
+	0x09, 0xae, 0x0a, 0x80, 0x2d, 0x17, 0xf3, 0x04, 0xf3, 0x8d, 0x93, 0xc1, 0x9d, 0xe2, 0xc2, 0xec,
+	0x77, 0x8a, 0x57, 0x01, 0xc6, 0x9c, 0x0f, 0x1c, 0xd9, 0x80, 0x8b, 0x14, 0x9e, 0x07, 0x28, 0xc4,
+	0xca, 0x6b, 0x5b, 0xa0, 0x5a, 0xb0, 0xae, 0x0c, 0x34, 0xc7, 0x4f, 0x34, 0xfb, 0xa2, 0xef, 0x74,
+	0xef, 0xa2, 0x10, 0xcb, 0x37, 0x21, 0xa4, 0xab, 0x0c, 0x1c, 0xac, 0x14, 0x99, 0xc5, 0x7a, 0xbf,
+	0x57, 0x29, 0x0d, 0x2c, 0xf8, 0x33, 0xcd, 0x5e, 0xf2, 0x9d, 0x2e, 0x03, 0x09, 0x67, 0x1d, 0x6b,
+	0x74, 0x52, 0xb5, 0x5d, 0x4c, 0x74, 0xf6, 0xb6, 0x69, 0xff, 0x00, 0xa8, 0xa4, 0x5b, 0x3c, 0xd7,
+	0x83, 0x4c, 0x6e, 0xbf, 0x8a, 0x6b, 0xca, 0xba, 0x80, 0x49, 0x80, 0xc9, 0x1d, 0x6a, 0xf1, 0x88,
+	0xd1, 0x7e, 0x94, 0xc6, 0x5f, 0xf7, 0xac, 0x13, 0x46, 0x09, 0xfe, 0x1f, 0xf2, 0x99, 0x6e, 0x55,
+	0x7b, 0x14, 0xbf, 0xda, 0xb4, 0x97, 0x6f, 0xbd, 0x7d, 0xa2, 0x33, 0x1d, 0x98, 0x8e, 0xc3, 0xed,
+	0xac, 0xbe, 0xcc, 0x95, 0xcc, 0xda, 0xdf, 0x45, 0x28, 0x35, 0x70, 0x47, 0xfe, 0x12, 0xc0, 0xd2,
+	0x8b, 0x37, 0xf1, 0xdd, 0x4c, 0x4e, 0xc7, 0xa9, 0x52, 0xdf, 0xcf, 0x6d, 0x92, 0x14, 0xe0, 0x2b,
+	0x00, 0xe5, 0x31, 0x47, 0x77, 0x2d, 0xa7, 0xc7, 0x66, 0x44, 0xd4, 0x7a, 0x7e, 0x9b, 0x24, 0x8d,
+	0x5f, 0x01, 0xdc, 0xcc, 0xfa, 0xf3, 0xe4, 0x83, 0x89, 0xbe, 0xcf, 0x37, 0x56, 0x6f, 0xcf, 0x60,
+	0x9c, 0x64, 0xf8, 0x1b, 0x80, 0xd7, 0x32, 0x6f, 0x3b, 0x1f, 0xbe, 0x74, 0x14, 0x5a, 0xbc, 0x3b,
+	0xb3, 0x58, 0x27, 0x49, 0x46, 0x70, 0x79, 0xf4, 0x90, 0xd5, 0xa7, 0xe9, 0x49, 0xb2, 0x5d, 0x7d,
+	0x2f, 0xd7, 0xf6, 0x24, 0xec, 0x4f, 0x00, 0x6e, 0x9c, 0x3f, 0x03, 0xf3, 0xd3, 0x19, 0x9b, 0xaa,
+	0xb7, 0x5e, 0xda, 0x34, 0xce, 0xcd, 0xba, 0xff, 0xf4, 0xb4, 0x0c, 0x9e, 0x9d, 0x96, 0xc1, 0xf3,
+	0xd3, 0x32, 0x78, 0x72, 0x56, 0x5e, 0x78, 0x76, 0x56, 0x5e, 0xf8, 0xe3, 0xac, 0xbc, 0xf0, 0xd9,
+	0xde, 0xd0, 0x9b, 0x2d, 0xc2, 0xe8, 0x47, 0x4e, 0x1b, 0xc7, 0x5f, 0xcc, 0x47, 0xbb, 0x7b, 0x66,
+	0x77, 0x64, 0xec, 0xb0, 0xd7, 0xbd, 0x5d, 0x64, 0xff, 0x7b, 0xb9, 0xf1, 0xef, 0x00, 0xd4, 0x05,
+	0xd9, 0x9e, 0x37, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -518,6 +756,8 @@ type MsgClient interface {
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SwapBestRoute(ctx context.Context, in *MsgSwapBestRoute, opts ...grpc.CallOption) (*MsgSwapBestRouteResponse, error)
+	SwapExactAmountInByDenoms(ctx context.Context, in *MsgSwapExactAmountInByDenoms, opts ...grpc.CallOption) (*MsgSwapExactAmountInByDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -564,12 +804,32 @@ func (c *msgClient) SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSpl
 	return out, nil
 }
 
+func (c *msgClient) SwapBestRoute(ctx context.Context, in *MsgSwapBestRoute, opts ...grpc.CallOption) (*MsgSwapBestRouteResponse, error) {
+	out := new(MsgSwapBestRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapBestRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountInByDenoms(ctx context.Context, in *MsgSwapExactAmountInByDenoms, opts ...grpc.CallOption) (*MsgSwapExactAmountInByDenomsResponse, error) {
+	out := new(MsgSwapExactAmountInByDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInByDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SwapBestRoute(context.Context, *MsgSwapBestRoute) (*MsgSwapBestRouteResponse, error)
+	SwapExactAmountInByDenoms(context.Context, *MsgSwapExactAmountInByDenoms) (*MsgSwapExactAmountInByDenomsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -588,6 +848,12 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context, req *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) SwapBestRoute(ctx context.Context, req *MsgSwapBestRoute) (*MsgSwapBestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapBestRoute not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactAmountInByDenoms(ctx context.Context, req *MsgSwapExactAmountInByDenoms) (*MsgSwapExactAmountInByDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountInByDenoms not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -665,6 +931,42 @@ func _Msg_SplitRouteSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapBestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapBestRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapBestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SwapBestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapBestRoute(ctx, req.(*MsgSwapBestRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountInByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountInByDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountInByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInByDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountInByDenoms(ctx, req.(*MsgSwapExactAmountInByDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -685,6 +987,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitRouteSwapExactAmountOut",
 			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "SwapBestRoute",
+			Handler:    _Msg_SwapBestRoute_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountInByDenoms",
+			Handler:    _Msg_SwapExactAmountInByDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1072,69 +1382,273 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSwapBestRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSwapBestRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSwapBestRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxSplits != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.MaxHops != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapBestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapBestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapBestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInByDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInByDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInByDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInByDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInByDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInByDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.TokenOutMinAmount.Size()
@@ -1203,33 +1717,642 @@ func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapBestRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxHops != 0 {
+		n += 1 + sovTx(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovTx(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *MsgSwapBestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountInByDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountInByDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1252,10 +2375,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1319,16 +2442,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1338,30 +2461,31 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1371,23 +2495,22 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1412,7 +2535,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1435,15 +2558,15 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1471,7 +2594,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1496,7 +2619,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,10 +2642,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1586,14 +2709,14 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1621,11 +2744,11 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1653,7 +2776,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1678,7 +2801,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +2824,15 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,7 +2860,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1762,7 +2885,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapBestRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,10 +2908,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapBestRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapBestRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1825,7 +2948,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1852,14 +2975,13 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1887,15 +3009,13 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1905,25 +3025,64 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1945,7 +3104,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapBestRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1968,15 +3127,15 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapBestRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapBestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2004,7 +3163,41 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2029,7 +3222,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInByDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2052,10 +3245,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInByDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInByDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2092,7 +3285,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2119,8 +3312,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2158,7 +3350,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2186,7 +3378,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2211,7 +3403,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInByDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2234,15 +3426,15 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInByDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInByDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2270,7 +3462,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
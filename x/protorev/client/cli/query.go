@@ -30,6 +30,12 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolWeightsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMaxPromotedHotRoutesCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryHotRouteCandidatesCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryHotRouteOverridesCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEpochStatisticsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitDistributionsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProjectedProfitDistributionCmd)
 
 	return cmd
 }
@@ -166,3 +172,52 @@ func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, e
 	}
 	return route, osmocli.UsedArg, err
 }
+
+// NewQueryMaxPromotedHotRoutesCmd returns the command to query the max number of routes that can be automatically promoted to hot routes
+func NewQueryMaxPromotedHotRoutesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevMaxPromotedHotRoutesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "max-promoted-hot-routes",
+		Short: "Query the max number of routes that can be automatically promoted to hot routes",
+	}, &types.QueryGetProtoRevMaxPromotedHotRoutesRequest{}
+}
+
+// NewQueryHotRouteCandidatesCmd returns the command to query the hot route candidates
+func NewQueryHotRouteCandidatesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevHotRouteCandidatesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "hot-route-candidates",
+		Short: "Query the routes protorev has traded on that can be automatically promoted to hot routes",
+	}, &types.QueryGetProtoRevHotRouteCandidatesRequest{}
+}
+
+// NewQueryHotRouteOverridesCmd returns the command to query the hot route overrides
+func NewQueryHotRouteOverridesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevHotRouteOverridesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "hot-route-overrides",
+		Short: "Query the routes pinned as hot routes or banned from being automatically promoted",
+	}, &types.QueryGetProtoRevHotRouteOverridesRequest{}
+}
+
+// NewQueryEpochStatisticsCmd returns the command to query the statistics of the trades executed over the day epochs that started within a time range
+func NewQueryEpochStatisticsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevEpochStatisticsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "epoch-statistics [start_time] [end_time]",
+		Short: "Query the number of trades and profits of ProtoRev over the day epochs that started within a time range",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} epoch-statistics 1688000000 1688600000`,
+	}, &types.QueryGetProtoRevEpochStatisticsRequest{}
+}
+
+// NewQueryProfitDistributionsCmd returns the command to query the most recent distributions of the profits of ProtoRev
+func NewQueryProfitDistributionsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitDistributionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "profit-distributions",
+		Short: "Query the most recent distributions of the profits of ProtoRev",
+	}, &types.QueryGetProtoRevProfitDistributionsRequest{}
+}
+
+// NewQueryProjectedProfitDistributionCmd returns the command to query the distribution of the profits of ProtoRev that would happen at the end of the current epoch
+func NewQueryProjectedProfitDistributionCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProjectedProfitDistributionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "projected-profit-distribution",
+		Short: "Query the distribution of the profits of ProtoRev that would happen at the end of the current epoch",
+	}, &types.QueryGetProtoRevProjectedProfitDistributionRequest{}
+}
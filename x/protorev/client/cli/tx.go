@@ -15,6 +15,7 @@ import (
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
 
@@ -27,6 +28,8 @@ func NewCmdTx() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, CmdSetDeveloperAccount)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerTx)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerBlock)
+	osmocli.AddTxCmd(txCmd, CmdSetMaxPromotedHotRoutes)
+	osmocli.AddTxCmd(txCmd, CmdSetHotRouteOverride)
 	txCmd.AddCommand(
 		CmdSetDeveloperHotRoutes().BuildCommandCustomFn(),
 		CmdSetPoolWeights().BuildCommandCustomFn(),
@@ -141,6 +144,62 @@ func CmdSetMaxPoolPointsPerBlock() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPoin
 	}, &types.MsgSetMaxPoolPointsPerBlock{}
 }
 
+// CmdSetMaxPromotedHotRoutes implements the command to set the max number of routes that can be automatically promoted to hot routes
+func CmdSetMaxPromotedHotRoutes() (*osmocli.TxCliDesc, *types.MsgSetMaxPromotedHotRoutes) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-max-promoted-hot-routes [uint64]",
+		Short:   "set the max number of routes that can be automatically promoted to hot routes",
+		NumArgs: 1,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			maxPromotedHotRoutes, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.MsgSetMaxPromotedHotRoutes{
+				MaxPromotedHotRoutes: maxPromotedHotRoutes,
+				Admin:                clientCtx.GetFromAddress().String(),
+			}, nil
+		},
+	}, &types.MsgSetMaxPromotedHotRoutes{}
+}
+
+// hotRouteOverrideTypes maps the cli names of the hot route override types to the override types
+var hotRouteOverrideTypes = map[string]types.HotRouteOverrideType{
+	"none": types.HotRouteOverrideNone,
+	"pin":  types.HotRouteOverridePin,
+	"ban":  types.HotRouteOverrideBan,
+}
+
+// CmdSetHotRouteOverride implements the command to pin a route as a hot route or ban it from being automatically promoted
+func CmdSetHotRouteOverride() (*osmocli.TxCliDesc, *types.MsgSetHotRouteOverride) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-hot-route-override [route] [none|pin|ban]",
+		Short:   "pin a route (pool ids along the arbitrage route) as a hot route, ban it from being automatically promoted, or remove its override",
+		Example: fmt.Sprintf(`$ %s tx protorev set-hot-route-override 1,2,3 pin --from mykey`, version.AppName),
+		NumArgs: 2,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			route, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return nil, err
+			}
+
+			overrideType, ok := hotRouteOverrideTypes[args[1]]
+			if !ok {
+				return nil, fmt.Errorf("invalid hot route override %s, must be one of none, pin or ban", args[1])
+			}
+
+			return &types.MsgSetHotRouteOverride{
+				HotRouteOverride: types.HotRouteOverride{
+					Route:        route,
+					OverrideType: overrideType,
+				},
+				Admin: clientCtx.GetFromAddress().String(),
+			}, nil
+		},
+	}, &types.MsgSetHotRouteOverride{}
+}
+
 // CmdSetPoolWeights implements the command to set the pool weights used to estimate execution costs
 func CmdSetPoolWeights() *osmocli.TxCliDesc {
 	desc := osmocli.TxCliDesc{
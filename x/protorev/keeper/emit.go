@@ -33,3 +33,48 @@ func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, p
 	)
 	ctx.EventManager().EmitEvent(backrunEvent)
 }
+
+// EmitHotRoutePromotedEvent emits an event when a route is promoted to a hot route
+func EmitHotRoutePromotedEvent(ctx sdk.Context, candidate types.HotRouteCandidate, reason string) {
+	ctx.EventManager().EmitEvent(newHotRouteEvent(types.TypeEvtHotRoutePromoted, candidate, reason))
+}
+
+// EmitHotRouteDemotedEvent emits an event when a route that was promoted to a hot route is demoted
+func EmitHotRouteDemotedEvent(ctx sdk.Context, candidate types.HotRouteCandidate, reason string) {
+	ctx.EventManager().EmitEvent(newHotRouteEvent(types.TypeEvtHotRouteDemoted, candidate, reason))
+}
+
+// EmitHotRouteOverrideEvent emits an event when the admin account pins or bans a route, or removes its override
+func EmitHotRouteOverrideEvent(ctx sdk.Context, override types.HotRouteOverride) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtHotRouteOverride,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyRoute, string(types.CreateRouteKey(override.Route))),
+		sdk.NewAttribute(types.AttributeKeyOverrideType, override.OverrideType.String()),
+	))
+}
+
+func newHotRouteEvent(eventType string, candidate types.HotRouteCandidate, reason string) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, candidate.TokenIn),
+		sdk.NewAttribute(types.AttributeKeyTokenOut, candidate.TokenOut),
+		sdk.NewAttribute(types.AttributeKeyRoute, string(types.CreateRouteKey(candidate.LastEpochStatistics.Route))),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	)
+}
+
+// EmitProfitDistributionEvent emits an event when the profits of the module are distributed
+func EmitProfitDistributionEvent(ctx sdk.Context, distribution types.ProfitDistribution) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtProfitDistribution,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(distribution.EpochNumber, 10)),
+		sdk.NewAttribute(types.AttributeKeyProfits, distribution.Profits.String()),
+		sdk.NewAttribute(types.AttributeKeyToStakers, distribution.ToStakers.String()),
+		sdk.NewAttribute(types.AttributeKeyToCommunityPool, distribution.ToCommunityPool.String()),
+		sdk.NewAttribute(types.AttributeKeyBurned, distribution.Burned.String()),
+		sdk.NewAttribute(types.AttributeKeyRetained, distribution.Retained.String()),
+	))
+}
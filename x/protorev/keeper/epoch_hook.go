@@ -1,8 +1,15 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -39,8 +46,34 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 				h.k.SetDaysSinceModuleGenesis(ctx, daysSinceGenesis+1)
 			}
 
+			// Delete the statistics of the epochs that are no longer retained once the next epoch starts
+			h.k.PruneEpochStatistics(ctx, uint64(epochNumber)+1)
+
 			// Update the pools in the store
-			return h.k.UpdatePools(ctx)
+			if err := h.k.UpdatePools(ctx); err != nil {
+				return err
+			}
+
+			// Search the updated pools for the paths used to build the graph search routes. A failed search must not
+			// revert the other epoch updates, so the paths of the previous epoch are kept instead.
+			if err := osmoutils.ApplyFuncIfNoError(ctx, h.k.UpdateGraphSearchPaths); err != nil {
+				h.k.Logger(ctx).Error("failed to update protorev graph search paths", "epoch", epochNumber, "error", err)
+			}
+
+			// Distribute the profits accumulated over the epoch according to the profit distribution policy. A failed
+			// distribution must not revert the other epoch updates, so the profits are kept until the next epoch instead.
+			if err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				_, err := h.k.DistributeProfits(ctx, uint64(epochNumber))
+				return err
+			}); err != nil {
+				h.k.Logger(ctx).Error("failed to distribute protorev profits", "epoch", epochNumber, "error", err)
+			}
+
+			// Promote the routes that were the most profitable over the epoch to hot routes and demote stale ones. A failed
+			// update must not revert the other epoch updates, so the hot routes of the previous epoch are kept instead.
+			if err := osmoutils.ApplyFuncIfNoError(ctx, h.k.UpdateHotRoutes); err != nil {
+				h.k.Logger(ctx).Error("failed to update protorev hot routes", "epoch", epochNumber, "error", err)
+			}
 		}
 	}
 
@@ -78,6 +111,74 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 	return nil
 }
 
+// UpdateGraphSearchPaths first deletes all of the graph search paths in the store and then searches the highest liquidity pools
+// store as a graph, where the denoms are the nodes and the highest liquidity pools between them are the edges. For every base
+// denom and every denom paired with a base denom, it stores the shortest paths of up to types.MaxRouteLength-1 hops that swap the
+// base denom into the denom through other distinct base denoms. The graph search routes are built from these paths when backrunning
+// swaps, so the search is only run once per epoch instead of on every swap.
+func (k Keeper) UpdateGraphSearchPaths(ctx sdk.Context) error {
+	k.DeleteAllGraphSearchPaths(ctx)
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Load the highest liquidity pools of every base denom, along with all of the denoms they are paired with
+	baseDenomPools := make(map[string]map[string]uint64, len(baseDenoms))
+	denoms := make([]string, 0)
+	seenDenoms := make(map[string]bool)
+	for _, baseDenom := range baseDenoms {
+		pairedDenoms, pools := k.GetAllPoolsForBaseDenom(ctx, baseDenom.Denom)
+		baseDenomPools[baseDenom.Denom] = pools
+
+		for _, denom := range pairedDenoms {
+			if !seenDenoms[denom] {
+				seenDenoms[denom] = true
+				denoms = append(denoms, denom)
+			}
+		}
+	}
+
+	// Every hop of the paths swaps out of a base denom, so its pool is one of the highest liquidity pools of that base denom
+	lookupPool := func(denomA, denomB string) (uint64, bool) {
+		poolId, ok := baseDenomPools[denomA][denomB]
+		return poolId, ok
+	}
+
+	for _, swapDenom := range baseDenoms {
+		for _, denom := range denoms {
+			if denom == swapDenom.Denom {
+				continue
+			}
+
+			// The paths can go through the other base denoms
+			intermediateDenoms := make([]string, 0, len(baseDenoms))
+			for _, baseDenom := range baseDenoms {
+				if baseDenom.Denom != swapDenom.Denom && baseDenom.Denom != denom {
+					intermediateDenoms = append(intermediateDenoms, baseDenom.Denom)
+				}
+			}
+
+			// The swapped pool takes one hop of the routes, so the paths can take the rest
+			paths := findDenomPaths(swapDenom.Denom, denom, intermediateDenoms, types.MaxRouteLength-1, lookupPool)
+			if len(paths) == 0 {
+				continue
+			}
+
+			if err := k.SetGraphSearchPaths(ctx, types.TokenPairArbRoutes{
+				ArbRoutes: paths,
+				TokenIn:   swapDenom.Denom,
+				TokenOut:  denom,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // UpdateHighestLiquidityPools updates the baseDenomPools map (passed in by reference) with the
 // highest liquidity pools for each base denom by iterating through all pools, getting the
 // total liquidity for each pool, and updating the highest liquidity pools based upon comparing total liquidity.
@@ -126,3 +227,312 @@ func (k Keeper) compareAndStoreHighestLiquidityPool(denom string, pools map[stri
 		}
 	}
 }
+
+// rankedHotRouteCandidate is a hot route candidate along with the statistics of its route over the latest epoch
+type rankedHotRouteCandidate struct {
+	candidate    types.HotRouteCandidate
+	overrideType types.HotRouteOverrideType
+	epochTrades  sdk.Int
+	epochProfit  sdk.Int
+}
+
+// UpdateHotRoutes ranks the hot route candidates by the profits (in uosmo) and the number of trades of their routes
+// over the latest epoch, as tracked by the statistics stores. The top ranked candidates are promoted to hot routes
+// for the token pair of the swap they backran, up to the max number of promoted hot routes. Promoted routes that have
+// not been traded on for HotRouteStaleEpochs epochs, or that are displaced by higher ranked candidates, are demoted.
+// Routes pinned by the admin account are always promoted and routes banned by the admin account are never promoted.
+func (k Keeper) UpdateHotRoutes(ctx sdk.Context) error {
+	// Routes are not automatically promoted if the max has not been set
+	maxPromotedHotRoutes, err := k.GetMaxPromotedHotRoutes(ctx)
+	if err != nil {
+		maxPromotedHotRoutes = 0
+	}
+
+	candidates, err := k.GetAllHotRouteCandidates(ctx)
+	if err != nil {
+		return err
+	}
+
+	rankedCandidates := make([]rankedHotRouteCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		rankedCandidates = append(rankedCandidates, k.rankHotRouteCandidate(ctx, candidate))
+	}
+
+	// Sort the candidates by their profits over the epoch, then by their number of trades over the epoch and
+	// finally by their route so that the ranking is deterministic
+	sort.SliceStable(rankedCandidates, func(i, j int) bool {
+		if !rankedCandidates[i].epochProfit.Equal(rankedCandidates[j].epochProfit) {
+			return rankedCandidates[i].epochProfit.GT(rankedCandidates[j].epochProfit)
+		}
+		if !rankedCandidates[i].epochTrades.Equal(rankedCandidates[j].epochTrades) {
+			return rankedCandidates[i].epochTrades.GT(rankedCandidates[j].epochTrades)
+		}
+		return bytes.Compare(
+			types.CreateRouteKey(rankedCandidates[i].candidate.LastEpochStatistics.Route),
+			types.CreateRouteKey(rankedCandidates[j].candidate.LastEpochStatistics.Route),
+		) < 0
+	})
+
+	numPromoted := uint64(0)
+	for _, ranked := range rankedCandidates {
+		candidate := ranked.candidate
+		stale := candidate.StaleEpochs >= types.HotRouteStaleEpochs
+
+		// Determine whether the candidate should be a promoted hot route after this epoch
+		shouldPromote, reason := false, types.AttributeValueReasonDisplaced
+		switch {
+		case ranked.overrideType == types.HotRouteOverridePin:
+			shouldPromote, reason = true, types.AttributeValueReasonPinned
+		case ranked.overrideType == types.HotRouteOverrideBan:
+			reason = types.AttributeValueReasonBanned
+		case stale || (!candidate.Promoted && !ranked.epochTrades.IsPositive()):
+			reason = types.AttributeValueReasonStale
+		case !candidate.Promoted && k.hasHotRoute(ctx, candidate):
+			// The route was set as a hot route by the admin account
+		case numPromoted < maxPromotedHotRoutes:
+			shouldPromote, reason = true, types.AttributeValueReasonRanked
+		}
+
+		if shouldPromote && !candidate.Promoted && !k.hasHotRoute(ctx, candidate) {
+			if err := k.promoteHotRoute(ctx, &candidate, reason); err != nil {
+				k.Logger(ctx).Error("error promoting hot route", "route", candidate.LastEpochStatistics.Route, "error", err)
+			}
+		} else if !shouldPromote && candidate.Promoted {
+			if err := k.demoteHotRoute(ctx, &candidate, reason); err != nil {
+				return err
+			}
+		}
+
+		// Only the ranked candidates that are promoted count towards the max, so that a candidate that failed
+		// to be promoted leaves its place to the next ranked candidate
+		if reason == types.AttributeValueReasonRanked && candidate.Promoted {
+			numPromoted++
+		}
+
+		// Candidates that are stale and were not promoted are removed so that the number of candidates stays bounded
+		if stale && !candidate.Promoted && ranked.overrideType != types.HotRouteOverridePin {
+			k.DeleteHotRouteCandidate(ctx, candidate.LastEpochStatistics.Route)
+			continue
+		}
+
+		if err := k.SetHotRouteCandidate(ctx, candidate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rankHotRouteCandidate computes the statistics of the route of the candidate over the latest epoch, and updates
+// the candidate with the statistics of the route as of the end of the epoch.
+func (k Keeper) rankHotRouteCandidate(ctx sdk.Context, candidate types.HotRouteCandidate) rankedHotRouteCandidate {
+	route := candidate.LastEpochStatistics.Route
+
+	// The admin account may have replaced all of the hot routes since the route was promoted
+	if candidate.Promoted && !k.hasHotRoute(ctx, candidate) {
+		candidate.Promoted = false
+	}
+
+	trades, _ := k.GetTradesByRoute(ctx, route)
+	profits := sdk.NewCoins(k.GetAllProfitsByRoute(ctx, route)...)
+
+	epochTrades := trades.Sub(candidate.LastEpochStatistics.NumberOfTrades)
+	epochProfits, _ := profits.SafeSub(sdk.NewCoins(candidate.LastEpochStatistics.Profits...))
+
+	// Profits are compared in uosmo, in the same way as when selecting the optimal route to trade on
+	epochProfit := sdk.ZeroInt()
+	for _, profit := range epochProfits {
+		if profit.Denom == types.OsmosisDenomination {
+			epochProfit = epochProfit.Add(profit.Amount)
+			continue
+		}
+
+		uosmoProfit, err := k.ConvertProfits(ctx, profit, profit.Amount)
+		if err != nil {
+			k.Logger(ctx).Error("error converting profits", "route", route, "error", err)
+			continue
+		}
+		epochProfit = epochProfit.Add(uosmoProfit)
+	}
+
+	candidate.LastEpochStatistics.NumberOfTrades = trades
+	candidate.LastEpochStatistics.Profits = profits
+	if epochTrades.IsPositive() {
+		candidate.StaleEpochs = 0
+	} else {
+		candidate.StaleEpochs++
+	}
+
+	return rankedHotRouteCandidate{
+		candidate:    candidate,
+		overrideType: k.GetHotRouteOverrideType(ctx, route),
+		epochTrades:  epochTrades,
+		epochProfit:  epochProfit,
+	}
+}
+
+// ApplyHotRouteOverride sets the override of the admin account on the automatic promotion of a route and applies it
+// right away if the route is a hot route candidate: a banned route is demoted and a pinned route is promoted.
+func (k Keeper) ApplyHotRouteOverride(ctx sdk.Context, override types.HotRouteOverride) error {
+	if err := k.SetHotRouteOverride(ctx, override); err != nil {
+		return err
+	}
+	EmitHotRouteOverrideEvent(ctx, override)
+
+	candidate, err := k.GetHotRouteCandidate(ctx, override.Route)
+	if err != nil {
+		return nil
+	}
+
+	switch {
+	case override.OverrideType == types.HotRouteOverrideBan && candidate.Promoted:
+		if err := k.demoteHotRoute(ctx, &candidate, types.AttributeValueReasonBanned); err != nil {
+			return err
+		}
+	case override.OverrideType == types.HotRouteOverridePin && !candidate.Promoted && !k.hasHotRoute(ctx, candidate):
+		if err := k.promoteHotRoute(ctx, &candidate, types.AttributeValueReasonPinned); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return k.SetHotRouteCandidate(ctx, candidate)
+}
+
+// UpdateHotRouteCandidate records the route the module traded on after the given swap as a hot route candidate, if it
+// is not one already. The statistics of the route from before the trade are recorded as the statistics of the last
+// epoch, so that only trades from the current epoch onward count towards its ranking. Routes that cannot be stored as
+// a hot route for the token pair of the swap are not recorded.
+func (k Keeper) UpdateHotRouteCandidate(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputDenom string, swap SwapToBackrun) error {
+	poolIds := route.PoolIds()
+	if _, err := k.GetHotRouteCandidate(ctx, poolIds); err == nil {
+		return nil
+	}
+
+	hotRoute, err := k.buildHotRouteFromRoute(ctx, route, inputDenom, swap)
+	if err != nil {
+		return nil
+	}
+
+	trades, _ := k.GetTradesByRoute(ctx, poolIds)
+	candidate := types.HotRouteCandidate{
+		TokenIn:  swap.TokenInDenom,
+		TokenOut: swap.TokenOutDenom,
+		HotRoute: hotRoute,
+		LastEpochStatistics: types.RouteStatistics{
+			Profits:        k.GetAllProfitsByRoute(ctx, poolIds),
+			NumberOfTrades: trades,
+			Route:          poolIds,
+		},
+	}
+	if err := candidate.Validate(); err != nil {
+		return nil
+	}
+
+	return k.SetHotRouteCandidate(ctx, candidate)
+}
+
+// buildHotRouteFromRoute converts a route that was traded on after the given swap into a hot route, replacing the
+// pool of the swap with a placeholder. The step size of the hot route is the step size of the input denom, which
+// must be a base denom.
+func (k Keeper) buildHotRouteFromRoute(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputDenom string, swap SwapToBackrun) (types.Route, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return types.Route{}, err
+	}
+
+	var stepSize sdk.Int
+	for _, baseDenom := range baseDenoms {
+		if baseDenom.Denom == inputDenom {
+			stepSize = baseDenom.StepSize
+			break
+		}
+	}
+	if stepSize.IsNil() {
+		return types.Route{}, fmt.Errorf("input denom %s is not a base denom", inputDenom)
+	}
+
+	trades := make([]types.Trade, 0, len(route))
+	tokenIn := inputDenom
+	foundPlaceholder := false
+	for _, hop := range route {
+		poolId := hop.PoolId
+		// The first trade that swaps against the swap is the one the hot route places the pool of the swap into
+		if !foundPlaceholder && tokenIn == swap.TokenOutDenom && hop.TokenOutDenom == swap.TokenInDenom {
+			if poolId != swap.PoolId {
+				return types.Route{}, fmt.Errorf("route %v does not backrun the swap on pool %d", route.PoolIds(), swap.PoolId)
+			}
+			poolId = 0
+			foundPlaceholder = true
+		}
+
+		trades = append(trades, types.NewTrade(poolId, tokenIn, hop.TokenOutDenom))
+		tokenIn = hop.TokenOutDenom
+	}
+
+	return types.Route{Trades: trades, StepSize: stepSize}, nil
+}
+
+// promoteHotRoute adds the hot route of the candidate to the hot routes of its token pair
+func (k Keeper) promoteHotRoute(ctx sdk.Context, candidate *types.HotRouteCandidate, reason string) error {
+	tokenPairArbRoutes, err := k.GetTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut)
+	if err != nil {
+		tokenPairArbRoutes = types.NewTokenPairArbRoutes([]types.Route{}, candidate.TokenIn, candidate.TokenOut)
+	}
+
+	tokenPairArbRoutes.ArbRoutes = append(tokenPairArbRoutes.ArbRoutes, candidate.HotRoute)
+	if err := tokenPairArbRoutes.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.SetTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut, tokenPairArbRoutes); err != nil {
+		return err
+	}
+
+	candidate.Promoted = true
+	EmitHotRoutePromotedEvent(ctx, *candidate, reason)
+
+	return nil
+}
+
+// demoteHotRoute removes the hot route of the candidate from the hot routes of its token pair
+func (k Keeper) demoteHotRoute(ctx sdk.Context, candidate *types.HotRouteCandidate, reason string) error {
+	tokenPairArbRoutes, err := k.GetTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut)
+	if err == nil {
+		arbRoutes := make([]types.Route, 0, len(tokenPairArbRoutes.ArbRoutes))
+		for _, arbRoute := range tokenPairArbRoutes.ArbRoutes {
+			if !arbRoute.Equal(candidate.HotRoute) {
+				arbRoutes = append(arbRoutes, arbRoute)
+			}
+		}
+		tokenPairArbRoutes.ArbRoutes = arbRoutes
+
+		if len(arbRoutes) == 0 {
+			k.DeleteTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut)
+		} else if err := k.SetTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut, tokenPairArbRoutes); err != nil {
+			return err
+		}
+	}
+
+	candidate.Promoted = false
+	EmitHotRouteDemotedEvent(ctx, *candidate, reason)
+
+	return nil
+}
+
+// hasHotRoute returns whether the hot route of the candidate is one of the hot routes of its token pair
+func (k Keeper) hasHotRoute(ctx sdk.Context, candidate types.HotRouteCandidate) bool {
+	tokenPairArbRoutes, err := k.GetTokenPairArbRoutes(ctx, candidate.TokenIn, candidate.TokenOut)
+	if err != nil {
+		return false
+	}
+
+	for _, arbRoute := range tokenPairArbRoutes.ArbRoutes {
+		if arbRoute.Equal(candidate.HotRoute) {
+			return true
+		}
+	}
+
+	return false
+}
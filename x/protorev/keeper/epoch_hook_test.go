@@ -190,3 +190,175 @@ func contains(baseDenoms []types.BaseDenom, denomToMatch string) bool {
 	}
 	return false
 }
+
+// TestUpdateHotRoutes tests that the routes the module trades on are recorded as hot route candidates, that candidates
+// are promoted to hot routes up to the max number of promoted hot routes, and that promoted routes are demoted once stale.
+func (s *KeeperTestSuite) TestUpdateHotRoutes() {
+	swap := keeper.SwapToBackrun{
+		PoolId:        23,
+		TokenInDenom:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		TokenOutDenom: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+	}
+	expectedHotRoute := types.Route{
+		Trades: []types.Trade{
+			types.NewTrade(22, types.OsmosisDenomination, swap.TokenOutDenom),
+			types.NewTrade(0, swap.TokenOutDenom, swap.TokenInDenom),
+			types.NewTrade(24, swap.TokenInDenom, types.OsmosisDenomination),
+		},
+		StepSize: sdk.NewInt(1_000_000),
+	}
+
+	tests := []struct {
+		name                 string
+		maxPromotedHotRoutes uint64
+		overrideType         types.HotRouteOverrideType
+		expectPromoted       bool
+	}{
+		{
+			name:                 "route is promoted",
+			maxPromotedHotRoutes: 1,
+			overrideType:         types.HotRouteOverrideNone,
+			expectPromoted:       true,
+		},
+		{
+			name:                 "route is not promoted when no routes can be promoted",
+			maxPromotedHotRoutes: 0,
+			overrideType:         types.HotRouteOverrideNone,
+			expectPromoted:       false,
+		},
+		{
+			name:                 "pinned route is promoted even when no routes can be promoted",
+			maxPromotedHotRoutes: 0,
+			overrideType:         types.HotRouteOverridePin,
+			expectPromoted:       true,
+		},
+		{
+			name:                 "banned route is not promoted",
+			maxPromotedHotRoutes: 1,
+			overrideType:         types.HotRouteOverrideBan,
+			expectPromoted:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			k := s.App.ProtoRevKeeper
+
+			s.Require().NoError(k.SetMaxPromotedHotRoutes(s.Ctx, tc.maxPromotedHotRoutes))
+			s.Require().NoError(k.SetHotRouteOverride(s.Ctx, types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: tc.overrideType}))
+
+			// Trade on the route, which records it as a hot route candidate
+			err := k.ExecuteTrade(s.Ctx, routeTwoAssetSameWeight, sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10100000)), swap, 100, 100)
+			s.Require().NoError(err)
+
+			candidate, err := k.GetHotRouteCandidate(s.Ctx, routeTwoAssetSameWeight.PoolIds())
+			s.Require().NoError(err)
+			s.Require().Equal(expectedHotRoute, candidate.HotRoute)
+			s.Require().Equal(sdk.ZeroInt(), candidate.LastEpochStatistics.NumberOfTrades)
+			s.Require().False(candidate.Promoted)
+
+			// Rank the candidate at the end of the epoch
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(k.UpdateHotRoutes(s.Ctx))
+
+			candidate, err = k.GetHotRouteCandidate(s.Ctx, routeTwoAssetSameWeight.PoolIds())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectPromoted, candidate.Promoted)
+			s.Require().Equal(sdk.OneInt(), candidate.LastEpochStatistics.NumberOfTrades)
+			s.Require().Equal(tc.expectPromoted, s.hasEvent(types.TypeEvtHotRoutePromoted))
+
+			hotRoutes, err := k.BuildHotRoutes(s.Ctx, swap.TokenInDenom, swap.TokenOutDenom, swap.PoolId)
+			if tc.expectPromoted {
+				s.Require().NoError(err)
+				s.Require().Len(hotRoutes, 1)
+				s.Require().Equal(routeTwoAssetSameWeight, hotRoutes[0].Route)
+			} else {
+				s.Require().Error(err)
+			}
+
+			// The route is demoted once it has not been traded on for HotRouteStaleEpochs epochs, unless it is pinned
+			for epoch := uint64(1); epoch <= types.HotRouteStaleEpochs; epoch++ {
+				s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+				s.Require().NoError(k.UpdateHotRoutes(s.Ctx))
+			}
+
+			candidate, err = k.GetHotRouteCandidate(s.Ctx, routeTwoAssetSameWeight.PoolIds())
+			if tc.overrideType == types.HotRouteOverridePin {
+				s.Require().NoError(err)
+				s.Require().True(candidate.Promoted)
+				s.Require().Equal(types.HotRouteStaleEpochs, candidate.StaleEpochs)
+				return
+			}
+
+			s.Require().Error(err)
+			s.Require().Equal(tc.expectPromoted, s.hasEvent(types.TypeEvtHotRouteDemoted))
+			_, err = k.GetTokenPairArbRoutes(s.Ctx, swap.TokenInDenom, swap.TokenOutDenom)
+			s.Require().Error(err)
+		})
+	}
+}
+
+// TestUpdateHotRoutes_FailedPromotion tests that a candidate that fails to be promoted does not count towards the max
+// number of promoted hot routes, so that the next ranked candidate is promoted instead.
+func (s *KeeperTestSuite) TestUpdateHotRoutes_FailedPromotion() {
+	k := s.App.ProtoRevKeeper
+	swap := keeper.SwapToBackrun{
+		PoolId:        23,
+		TokenInDenom:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		TokenOutDenom: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+	}
+
+	s.Require().NoError(k.SetMaxPromotedHotRoutes(s.Ctx, 1))
+
+	// Trade on the route, which records it as a hot route candidate
+	err := k.ExecuteTrade(s.Ctx, routeTwoAssetSameWeight, sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10100000)), swap, 100, 100)
+	s.Require().NoError(err)
+
+	// Record a more profitable candidate whose hot route cannot be stored, as its step size is zero
+	invalidRoute := []uint64{1, 2, 3}
+	s.Require().NoError(k.SetHotRouteCandidate(s.Ctx, types.HotRouteCandidate{
+		TokenIn:  swap.TokenInDenom,
+		TokenOut: swap.TokenOutDenom,
+		HotRoute: types.Route{
+			Trades: []types.Trade{
+				types.NewTrade(1, types.OsmosisDenomination, swap.TokenOutDenom),
+				types.NewTrade(0, swap.TokenOutDenom, swap.TokenInDenom),
+				types.NewTrade(3, swap.TokenInDenom, types.OsmosisDenomination),
+			},
+			StepSize: sdk.ZeroInt(),
+		},
+		LastEpochStatistics: types.RouteStatistics{
+			Profits:        sdk.NewCoins(),
+			NumberOfTrades: sdk.ZeroInt(),
+			Route:          invalidRoute,
+		},
+	}))
+	s.Require().NoError(k.IncrementTradesByRoute(s.Ctx, invalidRoute))
+	s.Require().NoError(k.UpdateProfitsByRoute(s.Ctx, invalidRoute, types.OsmosisDenomination, sdk.NewInt(1_000_000_000)))
+
+	s.Require().NoError(k.UpdateHotRoutes(s.Ctx))
+
+	invalidCandidate, err := k.GetHotRouteCandidate(s.Ctx, invalidRoute)
+	s.Require().NoError(err)
+	s.Require().False(invalidCandidate.Promoted)
+
+	candidate, err := k.GetHotRouteCandidate(s.Ctx, routeTwoAssetSameWeight.PoolIds())
+	s.Require().NoError(err)
+	s.Require().True(candidate.Promoted)
+
+	hotRoutes, err := k.BuildHotRoutes(s.Ctx, swap.TokenInDenom, swap.TokenOutDenom, swap.PoolId)
+	s.Require().NoError(err)
+	s.Require().Len(hotRoutes, 1)
+	s.Require().Equal(routeTwoAssetSameWeight, hotRoutes[0].Route)
+}
+
+// hasEvent returns whether an event of the given type was emitted
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	// Search the pools for the graph search paths on genesis.
+	if err := k.UpdateGraphSearchPaths(ctx); err != nil {
+		panic(err)
+	}
+
 	// --------------- Developer set up ----------------- //
 	// Set the developer address if it exists.
 	if genState.DeveloperAddress != "" {
@@ -87,6 +92,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	// ------------- Hot route promotion set up ------------- //
+	// Configure the max number of routes that can be automatically promoted to hot routes.
+	if err := k.SetMaxPromotedHotRoutes(ctx, genState.MaxPromotedHotRoutes); err != nil {
+		panic(err)
+	}
+
+	// Set the routes that can be automatically promoted to hot routes.
+	for _, candidate := range genState.HotRouteCandidates {
+		if err := k.SetHotRouteCandidate(ctx, candidate); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the routes pinned or banned by the admin account.
+	for _, override := range genState.HotRouteOverrides {
+		if err := k.SetHotRouteOverride(ctx, override); err != nil {
+			panic(err)
+		}
+	}
+
+	// ------------- Epoch statistics set up ------------- //
+	// Set the statistics of the most recent epochs.
+	for _, stats := range genState.EpochStatistics {
+		if err := k.SetEpochStatistics(ctx, stats); err != nil {
+			panic(err)
+		}
+	}
+
+	// ------------- Profit distribution set up ------------- //
+	// Set the most recent profit distributions.
+	for _, distribution := range genState.ProfitDistributions {
+		if err := k.SetProfitDistribution(ctx, distribution); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the profits kept in the module account by the profit distributions.
+	if err := k.UpdateRetainedProfits(ctx, genState.RetainedProfits); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -156,5 +202,45 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// Export the profits that have been collected by Protorev.
 	genesis.Profits = k.GetAllProfits(ctx)
 
+	// Export the max promoted hot routes (ignore the error in case the max promoted hot routes was not set yet).
+	if maxPromotedHotRoutes, err := k.GetMaxPromotedHotRoutes(ctx); err == nil {
+		genesis.MaxPromotedHotRoutes = maxPromotedHotRoutes
+	}
+
+	// Export the hot route candidates.
+	candidates, err := k.GetAllHotRouteCandidates(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.HotRouteCandidates = candidates
+
+	// Export the hot route overrides.
+	overrides, err := k.GetAllHotRouteOverrides(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.HotRouteOverrides = overrides
+
+	// Export the statistics of the most recent epochs.
+	epochStatistics, err := k.GetAllEpochStatistics(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.EpochStatistics = epochStatistics
+
+	// Export the most recent profit distributions.
+	distributions, err := k.GetAllProfitDistributions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ProfitDistributions = distributions
+
+	// Export the profits kept in the module account by the profit distributions.
+	retainedProfits, err := k.GetAllRetainedProfits(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.RetainedProfits = retainedProfits
+
 	return genesis
 }
@@ -1,5 +1,9 @@
 package keeper_test
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // TestInitGenesis tests the initialization and export of the module's genesis state.
 func (s *KeeperTestSuite) TestInitGenesis() {
 	// Export the genesis state
@@ -60,4 +64,28 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	profits := s.App.ProtoRevKeeper.GetAllProfits(s.Ctx)
 	s.Require().Equal(len(profits), len(exportedGenesis.Profits))
 	s.Require().Equal(profits, exportedGenesis.Profits)
+
+	maxPromotedHotRoutes, err := s.App.ProtoRevKeeper.GetMaxPromotedHotRoutes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(maxPromotedHotRoutes, exportedGenesis.MaxPromotedHotRoutes)
+
+	hotRouteCandidates, err := s.App.ProtoRevKeeper.GetAllHotRouteCandidates(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(hotRouteCandidates, exportedGenesis.HotRouteCandidates)
+
+	hotRouteOverrides, err := s.App.ProtoRevKeeper.GetAllHotRouteOverrides(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(hotRouteOverrides, exportedGenesis.HotRouteOverrides)
+
+	epochStatistics, err := s.App.ProtoRevKeeper.GetAllEpochStatistics(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(epochStatistics, exportedGenesis.EpochStatistics)
+
+	profitDistributions, err := s.App.ProtoRevKeeper.GetAllProfitDistributions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(profitDistributions, exportedGenesis.ProfitDistributions)
+
+	retainedProfits, err := s.App.ProtoRevKeeper.GetAllRetainedProfits(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin(retainedProfits), exportedGenesis.RetainedProfits)
 }
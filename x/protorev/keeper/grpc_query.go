@@ -246,3 +246,94 @@ func (q Querier) GetProtoRevPool(c context.Context, req *types.QueryGetProtoRevP
 
 	return &types.QueryGetProtoRevPoolResponse{PoolId: poolId}, nil
 }
+
+// GetProtoRevMaxPromotedHotRoutes queries the maximum number of routes that can be automatically promoted to hot routes
+func (q Querier) GetProtoRevMaxPromotedHotRoutes(c context.Context, req *types.QueryGetProtoRevMaxPromotedHotRoutesRequest) (*types.QueryGetProtoRevMaxPromotedHotRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	maxPromotedHotRoutes, err := q.Keeper.GetMaxPromotedHotRoutes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevMaxPromotedHotRoutesResponse{MaxPromotedHotRoutes: maxPromotedHotRoutes}, nil
+}
+
+// GetProtoRevHotRouteCandidates queries the routes the module has traded on that can be automatically promoted to hot routes
+func (q Querier) GetProtoRevHotRouteCandidates(c context.Context, req *types.QueryGetProtoRevHotRouteCandidatesRequest) (*types.QueryGetProtoRevHotRouteCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	candidates, err := q.Keeper.GetAllHotRouteCandidates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevHotRouteCandidatesResponse{HotRouteCandidates: candidates}, nil
+}
+
+// GetProtoRevHotRouteOverrides queries the routes the admin account has pinned as hot routes or banned from being promoted
+func (q Querier) GetProtoRevHotRouteOverrides(c context.Context, req *types.QueryGetProtoRevHotRouteOverridesRequest) (*types.QueryGetProtoRevHotRouteOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	overrides, err := q.Keeper.GetAllHotRouteOverrides(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevHotRouteOverridesResponse{HotRouteOverrides: overrides}, nil
+}
+
+// GetProtoRevEpochStatistics queries the statistics of the trades the module has executed over the day epochs that started within a time range
+func (q Querier) GetProtoRevEpochStatistics(c context.Context, req *types.QueryGetProtoRevEpochStatisticsRequest) (*types.QueryGetProtoRevEpochStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	epochStatistics, err := q.Keeper.GetEpochStatisticsInTimeRange(ctx, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	numberOfTrades := sdk.ZeroInt()
+	profits := sdk.NewCoins()
+	for _, stats := range epochStatistics {
+		numberOfTrades = numberOfTrades.Add(stats.NumberOfTrades)
+		profits = profits.Add(stats.Profits...)
+	}
+
+	return &types.QueryGetProtoRevEpochStatisticsResponse{EpochStatistics: epochStatistics, NumberOfTrades: numberOfTrades, Profits: profits}, nil
+}
+
+// GetProtoRevProfitDistributions queries the most recent distributions of the profits of the module
+func (q Querier) GetProtoRevProfitDistributions(c context.Context, req *types.QueryGetProtoRevProfitDistributionsRequest) (*types.QueryGetProtoRevProfitDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	distributions, err := q.Keeper.GetAllProfitDistributions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitDistributionsResponse{ProfitDistributions: distributions}, nil
+}
+
+// GetProtoRevProjectedProfitDistribution queries the distribution of the profits of the module that would happen at the end of the current epoch
+func (q Querier) GetProtoRevProjectedProfitDistribution(c context.Context, req *types.QueryGetProtoRevProjectedProfitDistributionRequest) (*types.QueryGetProtoRevProjectedProfitDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	distribution, err := q.Keeper.GetProjectedProfitDistribution(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProjectedProfitDistributionResponse{ProfitDistribution: distribution}, nil
+}
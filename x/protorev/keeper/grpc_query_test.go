@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
@@ -376,3 +378,86 @@ func (s *KeeperTestSuite) TestGetProtoRevPool() {
 	s.Require().NoError(err)
 	s.Require().Equal(res.PoolId, uint64(1))
 }
+
+// TestGetProtoRevEpochStatistics tests the query for the statistics of the epochs that started within a time range
+func (s *KeeperTestSuite) TestGetProtoRevEpochStatistics() {
+	epochInfo := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	req := &types.QueryGetProtoRevEpochStatisticsRequest{
+		StartTime: epochInfo.CurrentEpochStartTime,
+		EndTime:   s.Ctx.BlockTime(),
+	}
+
+	// Initially there are no statistics
+	res, err := s.queryClient.GetProtoRevEpochStatistics(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.EpochStatistics)
+	s.Require().Equal(sdk.ZeroInt(), res.NumberOfTrades)
+
+	// Pseudo execute two trades
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}, types.OsmosisDenomination, sdk.NewInt(10000))
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 1}}, "Atom", sdk.NewInt(20000))
+	s.Require().NoError(err)
+
+	// Check the updated result
+	res, err = s.queryClient.GetProtoRevEpochStatistics(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Len(res.EpochStatistics, 1)
+	s.Require().Len(res.EpochStatistics[0].RouteStatistics, 2)
+	s.Require().Equal(sdk.NewInt(2), res.NumberOfTrades)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10000)), sdk.NewCoin("Atom", sdk.NewInt(20000))), res.Profits)
+
+	// The statistics are not returned if the epoch started before the time range
+	req.StartTime = epochInfo.CurrentEpochStartTime.Add(time.Second)
+	req.EndTime = req.StartTime
+	res, err = s.queryClient.GetProtoRevEpochStatistics(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.EpochStatistics)
+
+	// The start time cannot be after the end time
+	req.EndTime = epochInfo.CurrentEpochStartTime
+	_, err = s.queryClient.GetProtoRevEpochStatistics(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().Error(err)
+}
+
+// TestGetProtoRevProfitDistributions tests the queries for the recorded and the projected profit distributions
+func (s *KeeperTestSuite) TestGetProtoRevProfitDistributions() {
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	err := s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000))))
+	s.Require().NoError(err)
+
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitDistributionPolicy = types.ProfitDistributionPolicy{
+		StakerShare:        sdk.NewDecWithPrec(5, 1),
+		CommunityPoolShare: sdk.ZeroDec(),
+		BurnShare:          sdk.NewDecWithPrec(1, 1),
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// Initially there are no distributions
+	res, err := s.queryClient.GetProtoRevProfitDistributions(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevProfitDistributionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.ProfitDistributions)
+
+	// The projected distribution does not distribute the profits
+	projected, err := s.queryClient.GetProtoRevProjectedProfitDistribution(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevProjectedProfitDistributionRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(500))), projected.ProfitDistribution.ToStakers)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))), projected.ProfitDistribution.Burned)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(400))), projected.ProfitDistribution.Retained)
+	s.Require().Equal(sdk.NewInt(1000), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, types.OsmosisDenomination).Amount)
+
+	res, err = s.queryClient.GetProtoRevProfitDistributions(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevProfitDistributionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.ProfitDistributions)
+
+	// The distribution at the end of the epoch matches the projection
+	epochNumber := uint64(s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "day").CurrentEpoch)
+	distribution, err := s.App.ProtoRevKeeper.DistributeProfits(s.Ctx, epochNumber)
+	s.Require().NoError(err)
+	s.Require().Equal(projected.ProfitDistribution, distribution)
+
+	res, err = s.queryClient.GetProtoRevProfitDistributions(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevProfitDistributionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitDistribution{distribution}, res.ProfitDistributions)
+}
@@ -89,6 +89,14 @@ func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress,
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
 }
 
+// AfterLiquidityAdded is a noop.
+func (h Hooks) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensAdded sdk.Coins) {
+}
+
+// AfterLiquidityRemoved is a noop.
+func (h Hooks) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensRemoved sdk.Coins) {
+}
+
 // ----------------------------------------------------------------------------
 // HELPER METHODS
 // ----------------------------------------------------------------------------
@@ -21,9 +21,12 @@ type (
 
 		accountKeeper     types.AccountKeeper
 		bankKeeper        types.BankKeeper
+		distrKeeper       types.DistributionKeeper
 		gammKeeper        types.GAMMKeeper
 		epochKeeper       types.EpochKeeper
 		poolmanagerKeeper types.PoolManagerKeeper
+		clKeeper          types.ConcentratedLiquidityKeeper
+		twapKeeper        types.TwapKeeper
 	}
 )
 
@@ -33,9 +36,12 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	gammKeeper types.GAMMKeeper,
 	epochKeeper types.EpochKeeper,
 	poolmanagerKeeper types.PoolManagerKeeper,
+	clKeeper types.ConcentratedLiquidityKeeper,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -48,9 +54,12 @@ func NewKeeper(
 		paramstore:        ps,
 		accountKeeper:     accountKeeper,
 		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
 		gammKeeper:        gammKeeper,
 		epochKeeper:       epochKeeper,
 		poolmanagerKeeper: poolmanagerKeeper,
+		clKeeper:          clKeeper,
+		twapKeeper:        twapKeeper,
 	}
 }
 
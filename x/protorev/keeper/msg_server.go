@@ -162,9 +162,48 @@ func (m MsgServer) SetBaseDenoms(c context.Context, msg *types.MsgSetBaseDenoms)
 		return nil, err
 	}
 
+	// Search the pools for the paths of the new base denoms
+	if err := m.k.UpdateGraphSearchPaths(ctx); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetBaseDenomsResponse{}, nil
 }
 
+// SetMaxPromotedHotRoutes sets the maximum number of routes that can be automatically promoted to hot routes
+func (m MsgServer) SetMaxPromotedHotRoutes(c context.Context, msg *types.MsgSetMaxPromotedHotRoutes) (*types.MsgSetMaxPromotedHotRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	// Set the max promoted hot routes
+	if err := m.k.SetMaxPromotedHotRoutes(ctx, msg.MaxPromotedHotRoutes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxPromotedHotRoutesResponse{}, nil
+}
+
+// SetHotRouteOverride pins a route as a hot route or bans it from being automatically promoted to a hot route
+func (m MsgServer) SetHotRouteOverride(c context.Context, msg *types.MsgSetHotRouteOverride) (*types.MsgSetHotRouteOverrideResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	// Set the override and apply it to the route if it is a hot route candidate
+	if err := m.k.ApplyHotRouteOverride(ctx, msg.HotRouteOverride); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetHotRouteOverrideResponse{}, nil
+}
+
 // AdminCheck ensures that the sender is the admin account.
 func (m MsgServer) AdminCheck(ctx sdk.Context, admin string) error {
 	sender, err := sdk.AccAddressFromBech32(admin)
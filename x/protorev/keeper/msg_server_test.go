@@ -657,3 +657,196 @@ func (s *KeeperTestSuite) TestMsgSetBaseDenoms() {
 		})
 	}
 }
+
+// TestMsgSetMaxPromotedHotRoutes tests the MsgSetMaxPromotedHotRoutes message.
+func (s *KeeperTestSuite) TestMsgSetMaxPromotedHotRoutes() {
+	cases := []struct {
+		description          string
+		admin                string
+		maxPromotedHotRoutes uint64
+		passValidateBasic    bool
+		pass                 bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			1,
+			false,
+			false,
+		},
+		{
+			"Invalid message (wrong admin)",
+			apptesting.CreateRandomAccounts(1)[0].String(),
+			1,
+			true,
+			false,
+		},
+		{
+			"Valid message (correct admin, no routes can be promoted)",
+			s.adminAccount.String(),
+			0,
+			true,
+			true,
+		},
+		{
+			"Valid message (correct admin, valid max promoted hot routes)",
+			s.adminAccount.String(),
+			types.MaxPromotedHotRoutes,
+			true,
+			true,
+		},
+		{
+			"Invalid message (correct admin, too many max promoted hot routes)",
+			s.adminAccount.String(),
+			types.MaxPromotedHotRoutes + 1,
+			false,
+			false,
+		},
+	}
+
+	for _, testCase := range cases {
+		s.Run(testCase.description, func() {
+			msg := types.NewMsgSetMaxPromotedHotRoutes(testCase.admin, testCase.maxPromotedHotRoutes)
+
+			err := msg.ValidateBasic()
+			if testCase.passValidateBasic {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				return
+			}
+
+			server := keeper.NewMsgServer(*s.App.AppKeepers.ProtoRevKeeper)
+			wrappedCtx := sdk.WrapSDKContext(s.Ctx)
+			response, err := server.SetMaxPromotedHotRoutes(wrappedCtx, msg)
+			if testCase.pass {
+				s.Require().NoError(err)
+				s.Require().Equal(response, &types.MsgSetMaxPromotedHotRoutesResponse{})
+
+				maxPromotedHotRoutes, err := s.App.AppKeepers.ProtoRevKeeper.GetMaxPromotedHotRoutes(s.Ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(testCase.maxPromotedHotRoutes, maxPromotedHotRoutes)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestMsgSetHotRouteOverride tests the MsgSetHotRouteOverride message.
+func (s *KeeperTestSuite) TestMsgSetHotRouteOverride() {
+	swap := keeper.SwapToBackrun{
+		PoolId:        23,
+		TokenInDenom:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		TokenOutDenom: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+	}
+
+	cases := []struct {
+		description       string
+		admin             string
+		override          types.HotRouteOverride
+		passValidateBasic bool
+		pass              bool
+		expectPromoted    bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: types.HotRouteOverridePin},
+			false,
+			false,
+			false,
+		},
+		{
+			"Invalid message (route with a single pool)",
+			s.adminAccount.String(),
+			types.HotRouteOverride{Route: []uint64{22}, OverrideType: types.HotRouteOverridePin},
+			false,
+			false,
+			false,
+		},
+		{
+			"Invalid message (invalid override type)",
+			s.adminAccount.String(),
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: 3},
+			false,
+			false,
+			false,
+		},
+		{
+			"Invalid message (wrong admin)",
+			apptesting.CreateRandomAccounts(1)[0].String(),
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: types.HotRouteOverridePin},
+			true,
+			false,
+			false,
+		},
+		{
+			"Valid message (pin promotes the route)",
+			s.adminAccount.String(),
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: types.HotRouteOverridePin},
+			true,
+			true,
+			true,
+		},
+		{
+			"Valid message (ban demotes the route)",
+			s.adminAccount.String(),
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: types.HotRouteOverrideBan},
+			true,
+			true,
+			false,
+		},
+		{
+			"Valid message (removing the override leaves the route promoted)",
+			s.adminAccount.String(),
+			types.HotRouteOverride{Route: routeTwoAssetSameWeight.PoolIds(), OverrideType: types.HotRouteOverrideNone},
+			true,
+			true,
+			true,
+		},
+	}
+
+	for _, testCase := range cases {
+		s.Run(testCase.description, func() {
+			ctx, _ := s.Ctx.CacheContext()
+			k := s.App.AppKeepers.ProtoRevKeeper
+
+			// Trade on the route and promote it to a hot route
+			s.Require().NoError(k.SetMaxPromotedHotRoutes(ctx, 1))
+			err := k.ExecuteTrade(ctx, routeTwoAssetSameWeight, sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10100000)), swap, 100, 100)
+			s.Require().NoError(err)
+			if testCase.override.OverrideType != types.HotRouteOverridePin {
+				s.Require().NoError(k.UpdateHotRoutes(ctx))
+			}
+
+			msg := types.NewMsgSetHotRouteOverride(testCase.admin, testCase.override)
+
+			err = msg.ValidateBasic()
+			if testCase.passValidateBasic {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				return
+			}
+
+			server := keeper.NewMsgServer(*k)
+			wrappedCtx := sdk.WrapSDKContext(ctx)
+			response, err := server.SetHotRouteOverride(wrappedCtx, msg)
+			if testCase.pass {
+				s.Require().NoError(err)
+				s.Require().Equal(response, &types.MsgSetHotRouteOverrideResponse{})
+				s.Require().Equal(testCase.override.OverrideType, k.GetHotRouteOverrideType(ctx, testCase.override.Route))
+
+				candidate, err := k.GetHotRouteCandidate(ctx, routeTwoAssetSameWeight.PoolIds())
+				s.Require().NoError(err)
+				s.Require().Equal(testCase.expectPromoted, candidate.Promoted)
+
+				_, err = k.GetTokenPairArbRoutes(ctx, swap.TokenInDenom, swap.TokenOutDenom)
+				s.Require().Equal(testCase.expectPromoted, err == nil)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
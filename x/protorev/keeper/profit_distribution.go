@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// ----------------------- Profit Distribution Stores  ----------------------- //
+
+// GetProfitDistribution returns the distribution of the profits of the ProtoRev module at the end of the given epoch
+func (k Keeper) GetProfitDistribution(ctx sdk.Context, epochNumber uint64) (types.ProfitDistribution, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitDistributions)
+	key := types.GetKeyPrefixProfitDistribution(epochNumber)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.ProfitDistribution{}, fmt.Errorf("no profit distribution for epoch %d", epochNumber)
+	}
+
+	distribution := types.ProfitDistribution{}
+	if err := distribution.Unmarshal(bz); err != nil {
+		return types.ProfitDistribution{}, err
+	}
+
+	return distribution, nil
+}
+
+// GetAllProfitDistributions returns all of the profit distributions that are retained, ordered by epoch number
+func (k Keeper) GetAllProfitDistributions(ctx sdk.Context) ([]types.ProfitDistribution, error) {
+	distributions := make([]types.ProfitDistribution, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixProfitDistributions)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		distribution := types.ProfitDistribution{}
+		if err := distribution.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions, nil
+}
+
+// SetProfitDistribution sets the distribution of the profits of the ProtoRev module, keyed by its epoch number
+func (k Keeper) SetProfitDistribution(ctx sdk.Context, distribution types.ProfitDistribution) error {
+	if err := distribution.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitDistributions)
+	key := types.GetKeyPrefixProfitDistribution(distribution.EpochNumber)
+
+	bz, err := distribution.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// PruneProfitDistributions deletes the profit distributions of all of the epochs that are at least
+// ProfitDistributionRetention epochs older than the given epoch
+func (k Keeper) PruneProfitDistributions(ctx sdk.Context, epochNumber uint64) {
+	if epochNumber < types.ProfitDistributionRetention {
+		return
+	}
+
+	k.DeleteAllEntriesBeforeEpoch(ctx, types.KeyPrefixProfitDistributions, epochNumber+1-types.ProfitDistributionRetention)
+}
+
+// GetAllRetainedProfits returns the profits that were kept in the module account by the profit distributions
+func (k Keeper) GetAllRetainedProfits(ctx sdk.Context) (sdk.Coins, error) {
+	retainedProfits := sdk.NewCoins()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRetainedProfits)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		retainedProfit := sdk.Coin{}
+		if err := retainedProfit.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		retainedProfits = retainedProfits.Add(retainedProfit)
+	}
+
+	return retainedProfits, nil
+}
+
+// UpdateRetainedProfits adds the given profits to the profits that were kept in the module account by the profit
+// distributions
+func (k Keeper) UpdateRetainedProfits(ctx sdk.Context, profits sdk.Coins) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRetainedProfits)
+
+	retainedProfits, err := k.GetAllRetainedProfits(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, profit := range profits {
+		retainedProfit := sdk.NewCoin(profit.Denom, retainedProfits.AmountOf(profit.Denom).Add(profit.Amount))
+		bz, err := retainedProfit.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetKeyPrefixRetainedProfits(profit.Denom), bz)
+	}
+
+	return nil
+}
+
+// ----------------------- Profit Distribution  ----------------------- //
+
+// DistributeProfits distributes the profits the module has accumulated since the last distribution according to the
+// profit distribution policy, and records the distribution for the given epoch. The share of the profits that is burned
+// and the rest of the profits are swapped to OSMO, which is burned and kept in the module account respectively.
+// Profits in a denom that cannot be swapped to OSMO within the max profit swap slippage are not distributed, and are
+// kept in the module account until a later epoch. Does nothing if the policy does not distribute any of the profits.
+func (k Keeper) DistributeProfits(ctx sdk.Context, epochNumber uint64) (types.ProfitDistribution, error) {
+	distribution := types.ProfitDistribution{
+		EpochNumber:     epochNumber,
+		Time:            ctx.BlockTime(),
+		Profits:         sdk.NewCoins(),
+		ToStakers:       sdk.NewCoins(),
+		ToCommunityPool: sdk.NewCoins(),
+		Burned:          sdk.NewCoins(),
+		Retained:        sdk.NewCoins(),
+	}
+
+	policy := k.GetParams(ctx).ProfitDistributionPolicy
+	if !policy.IsEnabled() {
+		return distribution, nil
+	}
+
+	retainedProfits, err := k.GetAllRetainedProfits(ctx)
+	if err != nil {
+		return types.ProfitDistribution{}, err
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, moduleAddress) {
+		// Profits that were kept in the module account by previous distributions are not distributed again
+		profit := balance.Amount.Sub(retainedProfits.AmountOf(balance.Denom))
+		if !profit.IsPositive() {
+			continue
+		}
+
+		toStakers := policy.StakerShare.MulInt(profit).TruncateInt()
+		toCommunityPool := policy.CommunityPoolShare.MulInt(profit).TruncateInt()
+		burned := policy.BurnShare.MulInt(profit).TruncateInt()
+		retained := profit.Sub(toStakers).Sub(toCommunityPool).Sub(burned)
+
+		burnedCoin, retainedCoin := sdk.NewCoin(balance.Denom, burned), sdk.NewCoin(balance.Denom, retained)
+		if balance.Denom != types.OsmosisDenomination {
+			var err error
+			burnedCoin, retainedCoin, err = k.swapProfitsToOsmo(ctx, burnedCoin, retainedCoin)
+			if err != nil {
+				// The profits in the denom are kept in the module account and distributed at the end of a later epoch
+				k.Logger(ctx).Error("failed to swap profits to osmo, keeping them until the next epoch", "denom", balance.Denom, "error", err)
+				continue
+			}
+		}
+
+		distribution.Profits = distribution.Profits.Add(sdk.NewCoin(balance.Denom, profit))
+		distribution.ToStakers = distribution.ToStakers.Add(sdk.NewCoin(balance.Denom, toStakers))
+		distribution.ToCommunityPool = distribution.ToCommunityPool.Add(sdk.NewCoin(balance.Denom, toCommunityPool))
+		distribution.Burned = distribution.Burned.Add(burnedCoin)
+		distribution.Retained = distribution.Retained.Add(retainedCoin)
+	}
+
+	// The swaps made by the distribution must not be backrun
+	k.DeleteSwapsToBackrun(ctx)
+
+	if !distribution.ToStakers.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, distribution.ToStakers); err != nil {
+			return types.ProfitDistribution{}, err
+		}
+	}
+
+	if !distribution.ToCommunityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, distribution.ToCommunityPool, moduleAddress); err != nil {
+			return types.ProfitDistribution{}, err
+		}
+	}
+
+	if !distribution.Burned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, distribution.Burned); err != nil {
+			return types.ProfitDistribution{}, err
+		}
+	}
+
+	if err := k.UpdateRetainedProfits(ctx, distribution.Retained); err != nil {
+		return types.ProfitDistribution{}, err
+	}
+
+	if err := k.SetProfitDistribution(ctx, distribution); err != nil {
+		return types.ProfitDistribution{}, err
+	}
+	k.PruneProfitDistributions(ctx, epochNumber)
+
+	EmitProfitDistributionEvent(ctx, distribution)
+
+	return distribution, nil
+}
+
+// GetProjectedProfitDistribution returns the distribution of the profits that would happen at the end of the current
+// day epoch given the current state, without distributing them.
+func (k Keeper) GetProjectedProfitDistribution(ctx sdk.Context) (types.ProfitDistribution, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	epochNumber := uint64(k.epochKeeper.GetEpochInfo(ctx, "day").CurrentEpoch)
+
+	return k.DistributeProfits(cacheCtx, epochNumber)
+}
+
+// swapProfitsToOsmo swaps the share of the profits of a denom that is burned and the rest of the profits to OSMO,
+// returning the amounts of OSMO that are burned and retained. The swap must return at least the twap value of the
+// profits less the max profit swap slippage, which bounds the amount of OSMO that can be lost to a price manipulation
+// of the pools around the epoch. Errors if the profits cannot be priced or swapped within this bound.
+// As with the swaps of the non-OSMO tx fees, no taker fee is charged on the swaps of the profits.
+func (k Keeper) swapProfitsToOsmo(ctx sdk.Context, burned, retained sdk.Coin) (sdk.Coin, sdk.Coin, error) {
+	tokenIn := burned.Add(retained)
+	if !tokenIn.IsPositive() {
+		return burned, retained, nil
+	}
+
+	route, err := k.getOsmoSwapRoute(ctx, tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	minAmountOut, err := k.getMinProfitSwapAmountOut(ctx, route, tokenIn)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	var tokenOutAmount sdk.Int
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		tokenOutAmount, err = k.poolmanagerKeeper.RouteExactAmountInWithoutTakerFee(ctx, moduleAddress, route, tokenIn, minAmountOut)
+		return err
+	})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// The OSMO is split between the burned and retained profits in proportion to the amounts that were swapped
+	burnedAmount := tokenOutAmount.Mul(burned.Amount).Quo(tokenIn.Amount)
+	return sdk.NewCoin(types.OsmosisDenomination, burnedAmount), sdk.NewCoin(types.OsmosisDenomination, tokenOutAmount.Sub(burnedAmount)), nil
+}
+
+// getMinProfitSwapAmountOut returns the least amount of OSMO that swapping the given profits through the route must
+// return, which is their geometric twap value over the profit swap twap window less the max profit swap slippage.
+func (k Keeper) getMinProfitSwapAmountOut(ctx sdk.Context, route []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	twapRoutes := make([]twaptypes.TwapRoute, len(route))
+	for i, hop := range route {
+		twapRoutes[i] = twaptypes.TwapRoute{PoolId: hop.PoolId, QuoteAsset: hop.TokenOutDenom}
+	}
+
+	params := k.GetParams(ctx)
+	twapPrice, err := k.twapKeeper.GetGeometricTwapOverRoute(ctx, tokenIn.Denom, twapRoutes, ctx.BlockTime().Add(-params.ProfitSwapTwapWindow), ctx.BlockTime())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	minAmountOut := twapPrice.MulInt(tokenIn.Amount).Mul(sdk.OneDec().Sub(params.MaxProfitSwapSlippage)).TruncateInt()
+	if !minAmountOut.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("twap value of %s is too small to be swapped to %s", tokenIn, types.OsmosisDenomination)
+	}
+
+	return minAmountOut, nil
+}
+
+// getOsmoSwapRoute returns the route used to swap the given denom to OSMO. This is the route registered in the
+// poolmanager for the denom pair if there is one, or the highest liquidity pool between the denom and OSMO otherwise.
+func (k Keeper) getOsmoSwapRoute(ctx sdk.Context, denom string) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	if route, err := k.poolmanagerKeeper.GetDenomPairRoute(ctx, denom, types.OsmosisDenomination); err == nil {
+		return route, nil
+	}
+
+	poolId, err := k.GetPoolForDenomPair(ctx, types.OsmosisDenomination, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: types.OsmosisDenomination}}, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
+)
+
+// TestDistributeProfits tests DistributeProfits, GetAllRetainedProfits and GetProfitDistribution
+func (s *KeeperTestSuite) TestDistributeProfits() {
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	profits := sdk.NewCoins(
+		sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000)),
+		sdk.NewCoin("Atom", sdk.NewInt(1000)),
+		sdk.NewCoin("random", sdk.NewInt(1000)),
+	)
+	err := s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, profits)
+	s.Require().NoError(err)
+
+	// The profits are not distributed by default
+	distribution, err := s.App.ProtoRevKeeper.DistributeProfits(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(distribution.Profits.IsZero())
+	s.Require().Equal(profits, s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress))
+	_, err = s.App.ProtoRevKeeper.GetProfitDistribution(s.Ctx, 1)
+	s.Require().Error(err)
+
+	// Distribute 30% of the profits to stakers, 20% to the community pool and burn 10%
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitDistributionPolicy = types.ProfitDistributionPolicy{
+		StakerShare:        sdk.NewDecWithPrec(3, 1),
+		CommunityPoolShare: sdk.NewDecWithPrec(2, 1),
+		BurnShare:          sdk.NewDecWithPrec(1, 1),
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// The Atom profits cannot be priced with a twap window that starts before the pools were created, so they are
+	// kept in the module account along with the random denom profits, which have no pool to swap them through
+	params.ProfitSwapTwapWindow = 1000 * time.Hour
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.DefaultProfitSwapTwapWindow))
+
+	distribution, err = s.App.ProtoRevKeeper.DistributeProfits(s.Ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000))), distribution.Profits)
+	s.Require().Equal(sdk.NewInt(1000), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, "Atom").Amount)
+	s.Require().Equal(sdk.NewInt(1000), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, "random").Amount)
+
+	params.ProfitSwapTwapWindow = types.DefaultProfitSwapTwapWindow
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// No taker fee is charged on the swaps of the profits to OSMO
+	poolmanagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	poolmanagerParams.TakerFeeParams.DefaultTakerFee = sdk.NewDecWithPrec(1, 2)
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, poolmanagerParams)
+
+	feeCollectorBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddress)
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	osmoSupply := s.App.BankKeeper.GetSupply(s.Ctx, types.OsmosisDenomination)
+	retainedProfits, err := s.App.ProtoRevKeeper.GetAllRetainedProfits(s.Ctx)
+	s.Require().NoError(err)
+
+	distribution, err = s.App.ProtoRevKeeper.DistributeProfits(s.Ctx, 3)
+	s.Require().NoError(err)
+
+	// Check the distribution. The random denom profits are still kept in the module account.
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(1000))), distribution.Profits)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(300))), distribution.ToStakers)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(200))), distribution.ToCommunityPool)
+
+	// The Atom that is burned and retained is swapped to OSMO for at least its twap value less the max slippage
+	s.Require().True(distribution.Burned.AmountOf("Atom").IsZero())
+	s.Require().True(distribution.Retained.AmountOf("Atom").IsZero())
+	s.Require().True(distribution.Burned.AmountOf(types.OsmosisDenomination).IsPositive())
+	s.Require().True(distribution.Retained.AmountOf(types.OsmosisDenomination).GT(distribution.Burned.AmountOf(types.OsmosisDenomination)))
+
+	// Check that the profits were distributed, and that no taker fee was sent to the fee collector or the community pool
+	s.Require().Equal(feeCollectorBalances.Add(distribution.ToStakers...), s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddress))
+	s.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(distribution.ToCommunityPool...)...), s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx))
+	s.Require().Equal(osmoSupply.SubAmount(distribution.Burned.AmountOf(types.OsmosisDenomination)), s.App.BankKeeper.GetSupply(s.Ctx, types.OsmosisDenomination))
+
+	retainedProfits = retainedProfits.Add(distribution.Retained...)
+	s.Require().Equal(retainedProfits.Add(sdk.NewCoin("random", sdk.NewInt(1000))), s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress))
+	storedRetainedProfits, err := s.App.ProtoRevKeeper.GetAllRetainedProfits(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(retainedProfits, storedRetainedProfits)
+
+	storedDistribution, err := s.App.ProtoRevKeeper.GetProfitDistribution(s.Ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(distribution, storedDistribution)
+
+	// The retained profits are not distributed again
+	distribution, err = s.App.ProtoRevKeeper.DistributeProfits(s.Ctx, 4)
+	s.Require().NoError(err)
+	s.Require().True(distribution.Profits.IsZero())
+	s.Require().Equal(retainedProfits.Add(sdk.NewCoin("random", sdk.NewInt(1000))), s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress))
+
+	// Only the most recent distributions are retained
+	s.App.ProtoRevKeeper.PruneProfitDistributions(s.Ctx, types.ProfitDistributionRetention+3)
+	distributions, err := s.App.ProtoRevKeeper.GetAllProfitDistributions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(distributions, 1)
+	s.Require().Equal(uint64(4), distributions[0].EpochNumber)
+}
@@ -69,6 +69,14 @@ func (k Keeper) SetTokenPairArbRoutes(ctx sdk.Context, tokenA, tokenB string, to
 	return nil
 }
 
+// DeleteTokenPairArbRoutes deletes the token pair arb routes given two denoms
+func (k Keeper) DeleteTokenPairArbRoutes(ctx sdk.Context, tokenA, tokenB string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairRoutes)
+	key := types.GetKeyPrefixRouteForTokenPair(tokenA, tokenB)
+
+	store.Delete(key)
+}
+
 // DeleteAllTokenPairArbRoutes deletes all the token pair arb routes
 func (k Keeper) DeleteAllTokenPairArbRoutes(ctx sdk.Context) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixTokenPairRoutes)
@@ -146,6 +154,64 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// GetAllPoolsForBaseDenom returns the denoms paired with the base denom in the highest liquidity pools store, along with the
+// ids of their highest liquidity pools
+func (k Keeper) GetAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) ([]string, map[string]uint64) {
+	denoms := make([]string, 0)
+	pools := make(map[string]uint64)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixDenomPairToPool, types.GetKeyPrefixDenomPairToPool(baseDenom, "")...))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		denoms = append(denoms, denom)
+		pools[denom] = sdk.BigEndianToUint64(iterator.Value())
+	}
+
+	return denoms, pools
+}
+
+// GetGraphSearchPaths returns the paths found by the graph search that swap the base denom into the denom to match
+func (k Keeper) GetGraphSearchPaths(ctx sdk.Context, baseDenom, denomToMatch string) (types.TokenPairArbRoutes, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGraphSearchPaths)
+	key := types.GetKeyPrefixGraphSearchPaths(baseDenom, denomToMatch)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.TokenPairArbRoutes{}, fmt.Errorf("no graph search paths found between base %s and match denom %s", baseDenom, denomToMatch)
+	}
+
+	paths := types.TokenPairArbRoutes{}
+	err := paths.Unmarshal(bz)
+	if err != nil {
+		return types.TokenPairArbRoutes{}, err
+	}
+
+	return paths, nil
+}
+
+// SetGraphSearchPaths sets the paths found by the graph search that swap the base denom (TokenIn) into the denom to match (TokenOut)
+func (k Keeper) SetGraphSearchPaths(ctx sdk.Context, paths types.TokenPairArbRoutes) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGraphSearchPaths)
+	key := types.GetKeyPrefixGraphSearchPaths(paths.TokenIn, paths.TokenOut)
+
+	bz, err := paths.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	return nil
+}
+
+// DeleteAllGraphSearchPaths deletes all of the paths found by the graph search
+func (k Keeper) DeleteAllGraphSearchPaths(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixGraphSearchPaths)
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSwapsToBackrun)
@@ -208,6 +274,28 @@ func (k Keeper) DeleteAllEntriesForKeyPrefix(ctx sdk.Context, keyPrefix []byte)
 	}
 }
 
+// DeleteAllEntriesBeforeEpoch deletes all of the entries of a store keyed by epoch number whose epoch is before the given epoch
+func (k Keeper) DeleteAllEntriesBeforeEpoch(ctx sdk.Context, keyPrefix []byte, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	// The keys end with the big endian epoch number, so all of the entries to delete are at the start of the store
+	keysToDelete := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if sdk.BigEndianToUint64(key[len(key)-8:]) >= epochNumber {
+			break
+		}
+
+		keysToDelete = append(keysToDelete, key)
+	}
+	iterator.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
 // ---------------------- Config Stores  ---------------------- //
 
 // GetDaysSinceModuleGenesis returns the number of days since the module was initialized
@@ -462,3 +550,149 @@ func (k Keeper) SetPoolWeights(ctx sdk.Context, poolWeights types.PoolWeights) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolWeights)
 	osmoutils.MustSet(store, types.KeyPrefixPoolWeights, &poolWeights)
 }
+
+// ---------------------- Hot Route Promotion Stores  ---------------------- //
+
+// GetMaxPromotedHotRoutes returns the max number of routes that can be automatically promoted to hot routes
+func (k Keeper) GetMaxPromotedHotRoutes(ctx sdk.Context) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMaxPromotedHotRoutes)
+	bz := store.Get(types.KeyPrefixMaxPromotedHotRoutes)
+	if bz == nil {
+		return 0, fmt.Errorf("max promoted hot routes has not been set in state")
+	}
+
+	res := sdk.BigEndianToUint64(bz)
+	return res, nil
+}
+
+// SetMaxPromotedHotRoutes sets the max number of routes that can be automatically promoted to hot routes
+func (k Keeper) SetMaxPromotedHotRoutes(ctx sdk.Context, maxPromotedHotRoutes uint64) error {
+	if err := types.ValidateMaxPromotedHotRoutes(maxPromotedHotRoutes); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMaxPromotedHotRoutes)
+	bz := sdk.Uint64ToBigEndian(maxPromotedHotRoutes)
+	store.Set(types.KeyPrefixMaxPromotedHotRoutes, bz)
+
+	return nil
+}
+
+// GetHotRouteCandidate returns the hot route candidate for the given route
+func (k Keeper) GetHotRouteCandidate(ctx sdk.Context, route []uint64) (types.HotRouteCandidate, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHotRouteCandidates)
+	key := types.GetKeyPrefixHotRouteCandidate(route)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.HotRouteCandidate{}, fmt.Errorf("no hot route candidate for route %d", route)
+	}
+
+	candidate := types.HotRouteCandidate{}
+	if err := candidate.Unmarshal(bz); err != nil {
+		return types.HotRouteCandidate{}, err
+	}
+
+	return candidate, nil
+}
+
+// GetAllHotRouteCandidates returns all of the hot route candidates
+func (k Keeper) GetAllHotRouteCandidates(ctx sdk.Context) ([]types.HotRouteCandidate, error) {
+	candidates := make([]types.HotRouteCandidate, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixHotRouteCandidates)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		candidate := types.HotRouteCandidate{}
+		if err := candidate.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// SetHotRouteCandidate sets the hot route candidate, keyed by its route
+func (k Keeper) SetHotRouteCandidate(ctx sdk.Context, candidate types.HotRouteCandidate) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHotRouteCandidates)
+	key := types.GetKeyPrefixHotRouteCandidate(candidate.LastEpochStatistics.Route)
+
+	bz, err := candidate.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	return nil
+}
+
+// DeleteHotRouteCandidate deletes the hot route candidate for the given route
+func (k Keeper) DeleteHotRouteCandidate(ctx sdk.Context, route []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHotRouteCandidates)
+	store.Delete(types.GetKeyPrefixHotRouteCandidate(route))
+}
+
+// GetHotRouteOverrideType returns how the admin account overrides the automatic promotion of the given route.
+// Returns HotRouteOverrideNone if the route was neither pinned nor banned.
+func (k Keeper) GetHotRouteOverrideType(ctx sdk.Context, route []uint64) types.HotRouteOverrideType {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHotRouteOverrides)
+	key := types.GetKeyPrefixHotRouteOverride(route)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.HotRouteOverrideNone
+	}
+
+	override := types.HotRouteOverride{}
+	if err := override.Unmarshal(bz); err != nil {
+		return types.HotRouteOverrideNone
+	}
+
+	return override.OverrideType
+}
+
+// GetAllHotRouteOverrides returns all of the routes pinned or banned by the admin account
+func (k Keeper) GetAllHotRouteOverrides(ctx sdk.Context) ([]types.HotRouteOverride, error) {
+	overrides := make([]types.HotRouteOverride, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixHotRouteOverrides)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		override := types.HotRouteOverride{}
+		if err := override.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
+
+// SetHotRouteOverride sets the override of the admin account on the automatic promotion of a route. Setting
+// HotRouteOverrideNone removes the override of the route.
+func (k Keeper) SetHotRouteOverride(ctx sdk.Context, override types.HotRouteOverride) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHotRouteOverrides)
+	key := types.GetKeyPrefixHotRouteOverride(override.Route)
+
+	if override.OverrideType == types.HotRouteOverrideNone {
+		store.Delete(key)
+		return nil
+	}
+
+	bz, err := override.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	return nil
+}
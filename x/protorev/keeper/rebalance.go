@@ -1,12 +1,19 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
 )
 
+// errPoolPointsExhausted is returned when the remaining tx or block pool points cannot cover a search step.
+var errPoolPointsExhausted = errors.New("not enough pool points remaining")
+
 // IterateRoutes checks the profitability of every single route that is passed in
 // and returns the optimal route if there is one
 func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, sdk.Int, poolmanagertypes.SwapAmountInRoutes) {
@@ -17,7 +24,7 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 	// Iterate through the routes and find the optimal route for the given swap
 	for index := 0; index < len(routes) && *remainingTxPoolPoints > 0; index++ {
 		// If the route consumes more pool points than we have remaining then we skip it
-		if routes[index].PoolPoints > *remainingTxPoolPoints {
+		if routes[index].PoolPoints+routes[index].SearchPoolPoints > *remainingTxPoolPoints {
 			continue
 		}
 
@@ -53,7 +60,16 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 
 // ConvertProfits converts the profit denom to uosmo to allow for a fair comparison of profits
 func (k Keeper) ConvertProfits(ctx sdk.Context, inputCoin sdk.Coin, profit sdk.Int) (sdk.Int, error) {
-	// Get highest liquidity pool ID for the input coin and uosmo
+	// If the poolmanager has a canonical route registered from the input coin to uosmo, estimate the profit through it
+	if route, err := k.poolmanagerKeeper.GetDenomPairRoute(ctx, inputCoin.Denom, types.OsmosisDenomination); err == nil {
+		conversionTokenOut, err := k.poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, route, sdk.NewCoin(inputCoin.Denom, profit))
+		if err != nil {
+			return profit, err
+		}
+		return conversionTokenOut, nil
+	}
+
+	// Otherwise, get highest liquidity pool ID for the input coin and uosmo
 	conversionPoolID, err := k.GetPoolForDenomPair(ctx, types.OsmosisDenomination, inputCoin.Denom)
 	if err != nil {
 		return profit, err
@@ -113,6 +129,11 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	// Input denom used for cyclic arbitrage
 	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
 
+	// Charge the pool points consumed to check the profitability of the route, whether or not it is profitable
+	if err := k.chargePoolPoints(ctx, route.SearchPoolPoints, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
 	// If there is no profit, then we can return early and not run the binary search.
@@ -135,6 +156,15 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	// Extend the search range if the max input amount is too small
 	curLeft, curRight = k.ExtendSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
 
+	// Narrow the search range to the tick boundaries of the concentrated pools in the route around the max profit.
+	// If the pool points run out while stepping through the tick boundaries, the binary search runs over the current range.
+	narrowedLeft, narrowedRight, err := k.NarrowSearchRangeAtTickBoundaries(ctx, route, inputDenom, curLeft, curRight, remainingTxPoolPoints, remainingBlockPoolPoints)
+	if err != nil && !errors.Is(err, errPoolPointsExhausted) {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	} else if err == nil {
+		curLeft, curRight = narrowedLeft, narrowedRight
+	}
+
 	// Binary search to find the max profit
 	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
 		curMid := (curLeft.Add(curRight)).Quo(sdk.NewInt(2))
@@ -193,6 +223,142 @@ func (k Keeper) ExtendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData,
 	return curLeft, curRight
 }
 
+// NarrowSearchRangeAtTickBoundaries narrows the binary search range for routes that swap through concentrated pools. The amount out of a
+// concentrated pool is piecewise in the amount in, with pieces delimited by its initialized ticks, so the profit of the route is evaluated
+// at the amounts in that cross the next types.MaxTicksCrossed initialized ticks of each of its concentrated pools. Since the profit is
+// concave in the amount in, the max profit lies between the amounts surrounding the most profitable one, which becomes the new range.
+// Every simulation is charged types.ExtraSimulationPoolPoints, and an error is returned once the remaining pool points run out.
+// Panics, such as running out of gas, are returned as errors in the same way as the failed simulations of the route.
+func (k Keeper) NarrowSearchRangeAtTickBoundaries(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight sdk.Int, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (_ sdk.Int, _ sdk.Int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("function NarrowSearchRangeAtTickBoundaries failed due to internal reason: %v", r)
+		}
+	}()
+
+	tickBoundaries, err := k.GetTickBoundariesForRoute(ctx, route, inputDenom, curLeft, curRight, remainingTxPoolPoints, remainingBlockPoolPoints)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if len(tickBoundaries) == 0 {
+		return curLeft, curRight, nil
+	}
+
+	if err := k.chargePoolPoints(ctx, types.ExtraSimulationPoolPoints, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	_, prevProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Step through the tick boundaries while the profit increases
+	lower, prev := curLeft, curLeft
+	for _, tickBoundary := range tickBoundaries {
+		if err := k.chargePoolPoints(ctx, types.ExtraSimulationPoolPoints, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		_, profit, err := k.EstimateMultihopProfit(ctx, inputDenom, tickBoundary.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+
+		// The profit decreased, so the max profit is on either side of the previous tick boundary
+		if profit.LT(prevProfit) {
+			return lower, tickBoundary, nil
+		}
+
+		lower, prev, prevProfit = prev, tickBoundary, profit
+	}
+
+	return lower, curRight, nil
+}
+
+// GetTickBoundariesForRoute returns, in ascending order and in units of the step size of the route, the amounts in of the route that cross
+// the next types.MaxTicksCrossed initialized ticks of each of its concentrated pools, within the (curLeft, curRight) search range.
+// The amounts in of pools after the first hop are mapped to amounts in of the route by estimating the route up to the pool in reverse.
+// Every simulation is charged types.ExtraSimulationPoolPoints, and an error is returned once the remaining pool points run out.
+func (k Keeper) GetTickBoundariesForRoute(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight sdk.Int, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) ([]sdk.Int, error) {
+	tickBoundaries := make([]sdk.Int, 0)
+
+	// The route up to the current hop, used to estimate the amount in of the route given the amount in of the current hop
+	routeToHop := make([]poolmanagertypes.SwapAmountOutRoute, 0, len(route.Route))
+	hopTokenInDenom := inputDenom
+	for _, hop := range route.Route {
+		pool, err := k.poolmanagerKeeper.GetPool(ctx, hop.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		if pool.GetType() == poolmanagertypes.Concentrated {
+			if err := k.chargePoolPoints(ctx, types.ExtraSimulationPoolPoints, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+				return nil, err
+			}
+			hopAmountsIn, err := k.clKeeper.ComputeTickCrossingAmountsIn(ctx, hop.PoolId, hopTokenInDenom, types.MaxTicksCrossed)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, hopAmountIn := range hopAmountsIn {
+				amountIn := hopAmountIn
+				if len(routeToHop) > 0 {
+					if err := k.chargePoolPoints(ctx, types.ExtraSimulationPoolPoints, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+						return nil, err
+					}
+
+					// Amounts in that the previous pools cannot provide are not reachable, and neither are the larger ones
+					amountIn, err = k.poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(ctx, routeToHop, sdk.NewCoin(hopTokenInDenom, hopAmountIn))
+					if err != nil {
+						break
+					}
+				}
+
+				// Round up to the step size so that the tick is crossed
+				tickBoundary := amountIn.Add(route.StepSize).SubRaw(1).Quo(route.StepSize)
+				if tickBoundary.GT(curLeft) && tickBoundary.LT(curRight) {
+					tickBoundaries = append(tickBoundaries, tickBoundary)
+				}
+			}
+		}
+
+		routeToHop = append(routeToHop, poolmanagertypes.SwapAmountOutRoute{
+			PoolId:       hop.PoolId,
+			TokenInDenom: hopTokenInDenom,
+		})
+		hopTokenInDenom = hop.TokenOutDenom
+	}
+
+	sort.Slice(tickBoundaries, func(i, j int) bool {
+		return tickBoundaries[i].LT(tickBoundaries[j])
+	})
+
+	// Remove duplicates, which cost a simulation each to evaluate
+	uniqueTickBoundaries := make([]sdk.Int, 0, len(tickBoundaries))
+	for i, tickBoundary := range tickBoundaries {
+		if i == 0 || !tickBoundary.Equal(tickBoundaries[i-1]) {
+			uniqueTickBoundaries = append(uniqueTickBoundaries, tickBoundary)
+		}
+	}
+
+	return uniqueTickBoundaries, nil
+}
+
+// chargePoolPoints charges the given number of pool points against the remaining tx and block pool points and the pool point
+// count of the block. Returns errPoolPointsExhausted, without charging, if the remaining pool points cannot cover them.
+func (k Keeper) chargePoolPoints(ctx sdk.Context, poolPoints uint64, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) error {
+	if poolPoints == 0 {
+		return nil
+	}
+	if poolPoints > *remainingTxPoolPoints || poolPoints > *remainingBlockPoolPoints {
+		return errPoolPointsExhausted
+	}
+
+	*remainingTxPoolPoints -= poolPoints
+	*remainingBlockPoolPoints -= poolPoints
+
+	return k.IncrementPointCountForBlock(ctx, poolPoints)
+}
+
 // ExecuteTrade inputs a route, amount in, and rebalances the pool
 func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
 	// Get the module address which will execute the trade
@@ -217,6 +383,11 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	// Profit from the trade
 	profit := tokenOutAmount.Sub(inputCoin.Amount)
 
+	// Record the route as a hot route candidate before the statistics of the route are updated
+	if err = k.UpdateHotRouteCandidate(ctx, route, inputCoin.Denom, pool); err != nil {
+		return err
+	}
+
 	// Update the module statistics stores
 	if err = k.UpdateStatistics(ctx, route, inputCoin.Denom, profit); err != nil {
 		return err
@@ -298,9 +298,10 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 			remainingPoolPoints := uint64(1000)
 			remainingBlockPoolPoints := uint64(1000)
 			route := protorevtypes.RouteMetaData{
-				Route:      test.param.route,
-				PoolPoints: test.param.routePoolPoints,
-				StepSize:   sdk.NewInt(1_000_000),
+				Route:            test.param.route,
+				PoolPoints:       test.param.routePoolPoints,
+				SearchPoolPoints: protorevtypes.CalculateRouteSearchPoolPoints(test.param.route),
+				StepSize:         sdk.NewInt(1_000_000),
 			}
 
 			amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(
@@ -319,7 +320,7 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 			}
 
 			// check that the remaining pool points is correct
-			s.Require().Equal(uint64(1000), remainingPoolPoints+test.param.routePoolPoints)
+			s.Require().Equal(uint64(1000), remainingPoolPoints+test.param.routePoolPoints+protorevtypes.CalculateRouteSearchPoolPoints(test.param.route))
 		})
 	}
 }
@@ -603,6 +604,30 @@ func (s *KeeperTestSuite) TestConvertProfits() {
 	}
 }
 
+// TestConvertProfits_DenomPairRoute tests that profits are converted through the route registered
+// in the poolmanager for the profit denom, if any, instead of the highest liquidity pool.
+func (s *KeeperTestSuite) TestConvertProfits_DenomPairRoute() {
+	inputCoin := sdk.NewCoin("Atom", sdk.NewInt(100))
+	profit := sdk.NewInt(10)
+
+	// 1 Atom is worth 10 uosmo in the new pool, unlike in the highest liquidity pool.
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("Atom", sdk.NewInt(1_000_000)), sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10_000_000)))
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: types.OsmosisDenomination}}
+	s.App.PoolManagerKeeper.SetDenomPairRoute(s.Ctx, poolmanagertypes.DenomPairRoute{
+		TokenInDenom:  inputCoin.Denom,
+		TokenOutDenom: types.OsmosisDenomination,
+		Route:         route,
+	})
+
+	expectedUosmoProfit, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, sdk.NewCoin(inputCoin.Denom, profit))
+	s.Require().NoError(err)
+
+	uosmoProfit, err := s.App.ProtoRevKeeper.ConvertProfits(s.Ctx, inputCoin, profit)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedUosmoProfit, uosmoProfit)
+	s.Require().True(uosmoProfit.GT(profit))
+}
+
 // TestRemainingPoolPointsForTx tests the RemainingPoolPointsForTx function.
 func (s *KeeperTestSuite) TestRemainingPoolPointsForTx() {
 	cases := []struct {
@@ -667,3 +692,91 @@ func (s *KeeperTestSuite) TestRemainingPoolPointsForTx() {
 		})
 	}
 }
+
+// TestFindMaxProfitRoute_TickBoundaries tests that the optimal amount in of routes that swap through
+// a concentrated pool with several initialized ticks is found when stepping through its tick boundaries
+func (s *KeeperTestSuite) TestFindMaxProfitRoute_TickBoundaries() {
+	cases := []struct {
+		description string
+		// whether the concentrated pool is the first pool of the route
+		concentratedFirst bool
+	}{
+		{
+			description:       "Concentrated pool is the first pool of the route",
+			concentratedFirst: true,
+		},
+		{
+			description:       "Concentrated pool is the second pool of the route",
+			concentratedFirst: false,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			s.SetupTest()
+
+			// The concentrated pool trades at a price of 1 usdc per usdx, with narrow positions around the current tick
+			clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], "usdx", "usdc", 100, sdk.ZeroDec())
+			s.CreateFullRangePosition(clPool, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100_000_000_000)), sdk.NewCoin("usdc", sdk.NewInt(100_000_000_000))))
+			positionCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1_000_000_000)), sdk.NewCoin("usdc", sdk.NewInt(1_000_000_000)))
+			for _, tickRange := range []int64{1000, 2000, 3000} {
+				s.FundAcc(s.TestAccs[0], positionCoins)
+				_, _, _, _, _, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, clPool.GetId(), s.TestAccs[0], positionCoins, sdk.ZeroInt(), sdk.ZeroInt(), -tickRange, tickRange)
+				s.Require().NoError(err)
+			}
+
+			// The balancer pool trades at a price of 2 usdc per usdx
+			balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("usdx", sdk.NewInt(100_000_000_000)), sdk.NewCoin("usdc", sdk.NewInt(200_000_000_000)))
+
+			// The route buys usdc on the balancer pool and sells it on the concentrated pool
+			route := poolmanagertypes.SwapAmountInRoutes{
+				{PoolId: balancerPoolId, TokenOutDenom: "usdc"},
+				{PoolId: clPool.GetId(), TokenOutDenom: "usdx"},
+			}
+			inputDenom := "usdx"
+			if tc.concentratedFirst {
+				route = poolmanagertypes.SwapAmountInRoutes{
+					{PoolId: clPool.GetId(), TokenOutDenom: "usdx"},
+					{PoolId: balancerPoolId, TokenOutDenom: "usdc"},
+				}
+				inputDenom = "usdc"
+			}
+
+			routeMetaData := protorevtypes.RouteMetaData{
+				Route:      route,
+				PoolPoints: 4,
+				StepSize:   sdk.NewInt(1_000_000),
+			}
+
+			// The simulations used to compute the tick boundaries cannot run without pool points
+			noTxPoolPoints, noBlockPoolPoints := uint64(0), uint64(0)
+			_, err := s.App.ProtoRevKeeper.GetTickBoundariesForRoute(s.Ctx, routeMetaData, inputDenom, sdk.OneInt(), types.MaxInputAmount, &noTxPoolPoints, &noBlockPoolPoints)
+			s.Require().ErrorContains(err, "not enough pool points remaining")
+
+			// The tick boundaries of the concentrated pool are within the search range, and every simulation is charged
+			remainingTxPoolPoints, remainingBlockPoolPoints := uint64(100), uint64(100)
+			tickBoundaries, err := s.App.ProtoRevKeeper.GetTickBoundariesForRoute(s.Ctx, routeMetaData, inputDenom, sdk.OneInt(), types.MaxInputAmount, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+			s.Require().NoError(err)
+			s.Require().NotEmpty(tickBoundaries)
+			for i := 1; i < len(tickBoundaries); i++ {
+				s.Require().True(tickBoundaries[i].GT(tickBoundaries[i-1]))
+			}
+			s.Require().Less(remainingTxPoolPoints, uint64(100))
+			s.Require().Equal(remainingTxPoolPoints, remainingBlockPoolPoints)
+
+			// Stepping through the tick boundaries is charged on top of the pool points of the route
+			remainingTxPoolPoints, remainingBlockPoolPoints = uint64(100), uint64(100)
+			amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(s.Ctx, routeMetaData, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+			s.Require().NoError(err)
+			s.Require().True(profit.IsPositive())
+			s.Require().Less(remainingTxPoolPoints, uint64(100)-routeMetaData.PoolPoints)
+
+			// The profit is maximal on the step size grid
+			for _, neighbor := range []sdk.Int{amtIn.Amount.Sub(routeMetaData.StepSize), amtIn.Amount.Add(routeMetaData.StepSize)} {
+				_, neighborProfit, err := s.App.ProtoRevKeeper.EstimateMultihopProfit(s.Ctx, inputDenom, neighbor, route)
+				s.Require().NoError(err)
+				s.Require().True(profit.GTE(neighborProfit))
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ type RouteMetaData struct {
 	Route poolmanagertypes.SwapAmountInRoutes
 	// The number of pool points that were consumed to build the route
 	PoolPoints uint64
+	// The number of pool points that are consumed to check whether the route is profitable, whether or not it is
+	SearchPoolPoints uint64
 	// The step size that should be used in the binary search for the optimal swap amount
 	StepSize sdk.Int
 }
@@ -32,6 +34,18 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append graph search routes if they exist and were not already built as hot routes. They are appended last so that
+	// the routes above are checked first and the graph search routes only use the pool points that are left over.
+	graphSearchRoutes, err := k.BuildGraphSearchRoutes(ctx, tokenIn, tokenOut, poolId)
+	if err != nil {
+		k.Logger(ctx).Debug("Failed to build graph search routes", "error", err)
+	}
+	for _, graphSearchRoute := range graphSearchRoutes {
+		if !containsRoute(routes, graphSearchRoute.Route) {
+			routes = append(routes, graphSearchRoute)
+		}
+	}
+
 	return routes
 }
 
@@ -149,6 +163,203 @@ func (k Keeper) BuildHighestLiquidityRoute(ctx sdk.Context, swapDenom types.Base
 	}, nil
 }
 
+// BuildGraphSearchRoutes builds cyclic arbitrage routes of types.MinRouteLength to types.MaxRouteLength hops from the paths found by
+// the graph search over the base denoms, which is run once per epoch (see UpdateGraphSearchPaths). For every base denom (in priority
+// order), routes swap the base denom into tokenOut through a path of other distinct base denoms, swap tokenOut for tokenIn on the
+// swapped pool, and swap tokenIn back into the base denom the same way. Routes that are built with the highest liquidity method are
+// skipped, and at most types.MaxGraphSearchRoutes routes are built. Building the routes only reads the stored paths, so no pool
+// points are consumed.
+func (k Keeper) BuildGraphSearchRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	for _, swapDenom := range baseDenoms {
+		// The swapped pool takes one hop of the route, so the paths into and out of it take the rest
+		entryPaths, err := k.getGraphSearchPaths(ctx, swapDenom.Denom, tokenOut, false)
+		if err != nil {
+			continue
+		}
+		exitPaths, err := k.getGraphSearchPaths(ctx, swapDenom.Denom, tokenIn, true)
+		if err != nil {
+			continue
+		}
+
+		for _, entryPath := range entryPaths {
+			for _, exitPath := range exitPaths {
+				if len(routes) >= types.MaxGraphSearchRoutes {
+					return routes, nil
+				}
+
+				if newRoute, err := k.BuildGraphSearchRoute(ctx, swapDenom, entryPath, exitPath, tokenIn, poolId); err == nil {
+					routes = append(routes, newRoute)
+				}
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// getGraphSearchPaths returns the stored graph search paths that swap the base denom into the given denom, or the given denom
+// into the base denom if reverse is true. A single empty path is returned if the denoms are the same.
+func (k Keeper) getGraphSearchPaths(ctx sdk.Context, baseDenom, denom string, reverse bool) ([]poolmanagertypes.SwapAmountInRoutes, error) {
+	if baseDenom == denom {
+		return []poolmanagertypes.SwapAmountInRoutes{{}}, nil
+	}
+
+	storedPaths, err := k.GetGraphSearchPaths(ctx, baseDenom, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]poolmanagertypes.SwapAmountInRoutes, 0, len(storedPaths.ArbRoutes))
+	for _, storedPath := range storedPaths.ArbRoutes {
+		path := make(poolmanagertypes.SwapAmountInRoutes, len(storedPath.Trades))
+		for i, trade := range storedPath.Trades {
+			if reverse {
+				path[len(path)-1-i] = poolmanagertypes.SwapAmountInRoute{PoolId: trade.Pool, TokenOutDenom: trade.TokenIn}
+			} else {
+				path[i] = poolmanagertypes.SwapAmountInRoute{PoolId: trade.Pool, TokenOutDenom: trade.TokenOut}
+			}
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// BuildGraphSearchRoute constructs a cyclic arbitrage route that starts/ends with swapDenom given the path that swaps swapDenom into the
+// token out of the swap, the swapped pool and the path that swaps the token in of the swap back into swapDenom.
+func (k Keeper) BuildGraphSearchRoute(ctx sdk.Context, swapDenom types.BaseDenom, entryPath, exitPath poolmanagertypes.SwapAmountInRoutes, tokenIn string, poolId uint64) (RouteMetaData, error) {
+	// Routes with a single hop into and out of the swapped pool are built with the highest liquidity method
+	if len(entryPath) == 1 && len(exitPath) == 1 {
+		return RouteMetaData{}, fmt.Errorf("route is built with the highest liquidity method")
+	}
+
+	newRoute := make(poolmanagertypes.SwapAmountInRoutes, 0, len(entryPath)+len(exitPath)+1)
+	newRoute = append(newRoute, entryPath...)
+	newRoute = append(newRoute, poolmanagertypes.SwapAmountInRoute{
+		PoolId:        poolId,
+		TokenOutDenom: tokenIn,
+	})
+	newRoute = append(newRoute, exitPath...)
+
+	if len(newRoute) < types.MinRouteLength || len(newRoute) > types.MaxRouteLength {
+		return RouteMetaData{}, fmt.Errorf("route has %d hops but must have between %d and %d", len(newRoute), types.MinRouteLength, types.MaxRouteLength)
+	}
+
+	// Ensure that the route is a simple cycle, i.e. that it does not go through the same pool or denom twice
+	seenPools := make(map[uint64]bool, len(newRoute))
+	seenDenoms := make(map[string]bool, len(newRoute))
+	for _, hop := range newRoute {
+		if seenPools[hop.PoolId] || seenDenoms[hop.TokenOutDenom] {
+			return RouteMetaData{}, fmt.Errorf("route goes through pool %d or denom %s more than once", hop.PoolId, hop.TokenOutDenom)
+		}
+		seenPools[hop.PoolId] = true
+		seenDenoms[hop.TokenOutDenom] = true
+	}
+
+	// Check that the route is valid and update the number of pool points that this route will consume when simulating and executing trades
+	routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, newRoute)
+	if err != nil {
+		return RouteMetaData{}, err
+	}
+
+	return RouteMetaData{
+		Route:            newRoute,
+		PoolPoints:       routePoolPoints,
+		SearchPoolPoints: CalculateRouteSearchPoolPoints(newRoute),
+		StepSize:         swapDenom.StepSize,
+	}, nil
+}
+
+// findDenomPaths returns the paths of at most maxHops hops that swap fromDenom into toDenom through distinct intermediate denoms, shortest first.
+// At most types.MaxGraphSearchRoutes paths are returned. The pools of the hops are looked up with lookupPool.
+func findDenomPaths(fromDenom, toDenom string, intermediateDenoms []string, maxHops int, lookupPool func(denomA, denomB string) (uint64, bool)) []types.Route {
+	// Iteratively deepen the search so that shorter paths are found first
+	paths := make([]types.Route, 0)
+	visited := map[string]bool{fromDenom: true}
+	for hops := 1; hops <= maxHops && len(paths) < types.MaxGraphSearchRoutes; hops++ {
+		findDenomPathsWithHops(fromDenom, toDenom, intermediateDenoms, hops, []types.Trade{}, visited, &paths, lookupPool)
+	}
+
+	return paths
+}
+
+// findDenomPathsWithHops appends to paths all of the paths of exactly hops hops that extend path from curDenom into toDenom, using a depth first search.
+func findDenomPathsWithHops(
+	curDenom, toDenom string,
+	intermediateDenoms []string,
+	hops int,
+	path []types.Trade,
+	visited map[string]bool,
+	paths *[]types.Route,
+	lookupPool func(denomA, denomB string) (uint64, bool),
+) {
+	if len(*paths) >= types.MaxGraphSearchRoutes {
+		return
+	}
+
+	// The last hop must swap into toDenom
+	if hops == 1 {
+		if poolId, ok := lookupPool(curDenom, toDenom); ok {
+			newPath := make([]types.Trade, 0, len(path)+1)
+			newPath = append(newPath, path...)
+			*paths = append(*paths, types.Route{Trades: append(newPath, types.Trade{Pool: poolId, TokenIn: curDenom, TokenOut: toDenom})})
+		}
+		return
+	}
+
+	for _, nextDenom := range intermediateDenoms {
+		if visited[nextDenom] {
+			continue
+		}
+
+		poolId, ok := lookupPool(curDenom, nextDenom)
+		if !ok {
+			continue
+		}
+
+		visited[nextDenom] = true
+		findDenomPathsWithHops(nextDenom, toDenom, intermediateDenoms, hops-1, append(path, types.Trade{Pool: poolId, TokenIn: curDenom, TokenOut: nextDenom}), visited, paths, lookupPool)
+		visited[nextDenom] = false
+	}
+}
+
+// containsRoute returns true if the route is one of the given routes.
+func containsRoute(routes []RouteMetaData, route poolmanagertypes.SwapAmountInRoutes) bool {
+	for _, existingRoute := range routes {
+		if len(existingRoute.Route) != len(route) {
+			continue
+		}
+
+		equal := true
+		for i, hop := range existingRoute.Route {
+			if hop != route[i] {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return true
+		}
+	}
+	return false
+}
+
+// CalculateRouteSearchPoolPoints calculates the number of pool points that will be consumed by a graph search route when checking whether
+// it is profitable. This is added to the global pool point counter whether or not the route turns out to be profitable. Routes up to
+// types.DefaultRouteLength hops are checked for free, longer routes are charged one pool point per extra hop.
+func CalculateRouteSearchPoolPoints(route poolmanagertypes.SwapAmountInRoutes) uint64 {
+	if len(route) <= types.DefaultRouteLength {
+		return 0
+	}
+	return uint64(len(route) - types.DefaultRouteLength)
+}
+
 // CalculateRoutePoolPoints calculates the number of pool points that will be consumed by a route when simulating and executing trades. This
 // is only added to the global pool point counter if the route simulated is minimally profitable i.e. it will make a profit.
 func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (uint64, error) {
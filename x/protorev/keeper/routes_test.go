@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v17/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
 )
 
@@ -38,6 +39,11 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
 					{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
 				},
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+				},
 			},
 		},
 		{
@@ -51,6 +57,11 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 					{PoolId: 4, InputDenom: "Atom", OutputDenom: "bitcoin"},
 					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
 				},
+				{
+					{PoolId: 4, InputDenom: "Atom", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+				},
 			},
 		},
 		{
@@ -69,6 +80,30 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
 					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
 				},
+				{
+					{PoolId: 9, InputDenom: types.OsmosisDenomination, OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+					{PoolId: 25, InputDenom: "Atom", OutputDenom: types.OsmosisDenomination},
+				},
+				{
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+					{PoolId: 3, InputDenom: "Atom", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+				},
+				{
+					{PoolId: 3, InputDenom: "Atom", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 25, InputDenom: "Atom", OutputDenom: types.OsmosisDenomination},
+					{PoolId: 9, InputDenom: types.OsmosisDenomination, OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
 			},
 		},
 		{
@@ -93,6 +128,10 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 		},
 	}
 
+	// Search the pools for the paths of the graph search routes, as is done by the epoch hook
+	err := s.App.ProtoRevKeeper.UpdateGraphSearchPaths(s.Ctx)
+	s.Require().NoError(err)
+
 	for _, tc := range cases {
 		s.Run(tc.description, func() {
 			routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID)
@@ -309,6 +348,121 @@ func (s *KeeperTestSuite) TestBuildHotRoutes() {
 	}
 }
 
+// TestBuildGraphSearchRoute tests the BuildGraphSearchRoute function
+func (s *KeeperTestSuite) TestBuildGraphSearchRoute() {
+	cases := []struct {
+		description     string
+		swapDenom       types.BaseDenom
+		entryPath       poolmanagertypes.SwapAmountInRoutes
+		exitPath        poolmanagertypes.SwapAmountInRoutes
+		tokenIn         string
+		poolId          uint64
+		expectedPoolIds []uint64
+		expectPass      bool
+	}{
+		{
+			description:     "Route with no hops into the swapped pool",
+			swapDenom:       types.BaseDenom{Denom: "Atom", StepSize: sdk.NewInt(1_000_000)},
+			entryPath:       poolmanagertypes.SwapAmountInRoutes{},
+			exitPath:        poolmanagertypes.SwapAmountInRoutes{{PoolId: 7, TokenOutDenom: types.OsmosisDenomination}, {PoolId: 25, TokenOutDenom: "Atom"}},
+			tokenIn:         "akash",
+			poolId:          1,
+			expectedPoolIds: []uint64{1, 7, 25},
+			expectPass:      true,
+		},
+		{
+			description:     "Route with two hops into the swapped pool",
+			swapDenom:       types.BaseDenom{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)},
+			entryPath:       poolmanagertypes.SwapAmountInRoutes{{PoolId: 9, TokenOutDenom: "ethereum"}},
+			exitPath:        poolmanagertypes.SwapAmountInRoutes{{PoolId: 4, TokenOutDenom: "Atom"}, {PoolId: 25, TokenOutDenom: types.OsmosisDenomination}},
+			tokenIn:         "bitcoin",
+			poolId:          19,
+			expectedPoolIds: []uint64{9, 19, 4, 25},
+			expectPass:      true,
+		},
+		{
+			description: "Route built with the highest liquidity method",
+			swapDenom:   types.BaseDenom{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)},
+			entryPath:   poolmanagertypes.SwapAmountInRoutes{{PoolId: 25, TokenOutDenom: "Atom"}},
+			exitPath:    poolmanagertypes.SwapAmountInRoutes{{PoolId: 7, TokenOutDenom: types.OsmosisDenomination}},
+			tokenIn:     "akash",
+			poolId:      1,
+			expectPass:  false,
+		},
+		{
+			description: "Route going through the same pool twice",
+			swapDenom:   types.BaseDenom{Denom: "Atom", StepSize: sdk.NewInt(1_000_000)},
+			entryPath:   poolmanagertypes.SwapAmountInRoutes{},
+			exitPath:    poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: "Atom"}},
+			tokenIn:     "akash",
+			poolId:      1,
+			expectPass:  false,
+		},
+		{
+			description: "Route with more than the max number of hops",
+			swapDenom:   types.BaseDenom{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)},
+			entryPath:   poolmanagertypes.SwapAmountInRoutes{{PoolId: 25, TokenOutDenom: "Atom"}, {PoolId: 3, TokenOutDenom: "ethereum"}},
+			exitPath:    poolmanagertypes.SwapAmountInRoutes{{PoolId: 4, TokenOutDenom: "Atom"}, {PoolId: 2, TokenOutDenom: "juno"}, {PoolId: 8, TokenOutDenom: types.OsmosisDenomination}},
+			tokenIn:     "bitcoin",
+			poolId:      19,
+			expectPass:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			routeMetaData, err := s.App.ProtoRevKeeper.BuildGraphSearchRoute(s.Ctx, tc.swapDenom, tc.entryPath, tc.exitPath, tc.tokenIn, tc.poolId)
+			if tc.expectPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedPoolIds, routeMetaData.Route.PoolIds())
+				s.Require().Equal(tc.swapDenom.StepSize, routeMetaData.StepSize)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestBuildGraphSearchRoutes tests that the graph search routes are built from the paths stored by UpdateGraphSearchPaths
+func (s *KeeperTestSuite) TestBuildGraphSearchRoutes() {
+	// No routes are built before the paths are searched
+	routes, err := s.App.ProtoRevKeeper.BuildGraphSearchRoutes(s.Ctx, "bitcoin", "ethereum", 19)
+	s.Require().NoError(err)
+	s.Require().Empty(routes)
+
+	err = s.App.ProtoRevKeeper.UpdateGraphSearchPaths(s.Ctx)
+	s.Require().NoError(err)
+
+	// The paths are stored from the base denom and are reversed for the hops out of the swapped pool
+	paths, err := s.App.ProtoRevKeeper.GetGraphSearchPaths(s.Ctx, types.OsmosisDenomination, "bitcoin")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(paths.ArbRoutes)
+	s.Require().Equal(types.OsmosisDenomination, paths.ArbRoutes[0].Trades[0].TokenIn)
+	s.Require().Equal("bitcoin", paths.ArbRoutes[0].Trades[len(paths.ArbRoutes[0].Trades)-1].TokenOut)
+
+	routes, err = s.App.ProtoRevKeeper.BuildGraphSearchRoutes(s.Ctx, "bitcoin", "ethereum", 19)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(routes)
+	for _, route := range routes {
+		s.Require().Greater(len(route.Route), types.DefaultRouteLength)
+		s.Require().Contains(route.Route.PoolIds(), uint64(19))
+	}
+
+	// Building the routes does not consume any pool points
+	pointCount, err := s.App.ProtoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), pointCount)
+}
+
+// TestCalculateRouteSearchPoolPoints tests the CalculateRouteSearchPoolPoints function
+func (s *KeeperTestSuite) TestCalculateRouteSearchPoolPoints() {
+	threeHopRoute := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1}, {PoolId: 2}, {PoolId: 3}}
+	s.Require().Equal(uint64(0), keeper.CalculateRouteSearchPoolPoints(threeHopRoute))
+
+	fiveHopRoute := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1}, {PoolId: 2}, {PoolId: 3}, {PoolId: 4}, {PoolId: 5}}
+	s.Require().Equal(uint64(2), keeper.CalculateRouteSearchPoolPoints(fiveHopRoute))
+}
+
 // TestCalculateRoutePoolPoints tests the CalculateRoutePoolPoints function
 func (s *KeeperTestSuite) TestCalculateRoutePoolPoints() {
 	cases := []struct {
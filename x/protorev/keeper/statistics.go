@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 
@@ -231,5 +232,275 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 		return err
 	}
 
+	// Update the number of trades and profits of the current epoch
+	if err := k.UpdateEpochStatistics(ctx, route.PoolIds(), denom, profit); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// ----------------------- Epoch Statistics Stores  ----------------------- //
+
+// GetEpochStatistics returns the statistics of the trades executed by the ProtoRev module over the given epoch
+func (k Keeper) GetEpochStatistics(ctx sdk.Context, epochNumber uint64) (types.EpochStatistics, error) {
+	stats, err := k.getEpochStatisticsTotals(ctx, epochNumber)
+	if err != nil {
+		return types.EpochStatistics{}, err
+	}
+
+	stats.RouteStatistics, err = k.getAllEpochRouteStatistics(ctx, epochNumber)
+	if err != nil {
+		return types.EpochStatistics{}, err
+	}
+
+	return stats, nil
+}
+
+// GetAllEpochStatistics returns the statistics of all of the epochs that are retained, ordered by epoch number
+func (k Keeper) GetAllEpochStatistics(ctx sdk.Context) ([]types.EpochStatistics, error) {
+	epochStatistics := make([]types.EpochStatistics, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEpochStatistics)
+
+	for ; iterator.Valid(); iterator.Next() {
+		stats := types.EpochStatistics{}
+		if err := stats.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return nil, err
+		}
+
+		epochStatistics = append(epochStatistics, stats)
+	}
+	iterator.Close()
+
+	// The statistics of the routes are stored separately from the totals of each epoch
+	for i, stats := range epochStatistics {
+		routeStatistics, err := k.getAllEpochRouteStatistics(ctx, stats.EpochNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		epochStatistics[i].RouteStatistics = routeStatistics
+	}
+
+	return epochStatistics, nil
+}
+
+// GetEpochStatisticsInTimeRange returns the statistics of the retained epochs that started within [startTime, endTime],
+// ordered by epoch number
+func (k Keeper) GetEpochStatisticsInTimeRange(ctx sdk.Context, startTime, endTime time.Time) ([]types.EpochStatistics, error) {
+	if startTime.After(endTime) {
+		return nil, fmt.Errorf("start time %s is after end time %s", startTime, endTime)
+	}
+
+	epochStatistics, err := k.GetAllEpochStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inTimeRange := make([]types.EpochStatistics, 0)
+	for _, stats := range epochStatistics {
+		if !stats.StartTime.Before(startTime) && !stats.StartTime.After(endTime) {
+			inTimeRange = append(inTimeRange, stats)
+		}
+	}
+
+	return inTimeRange, nil
+}
+
+// SetEpochStatistics sets the statistics of the trades executed by the ProtoRev module over an epoch, keyed by its
+// epoch number. The statistics of each route are stored under their own key, replacing those of the epoch.
+func (k Keeper) SetEpochStatistics(ctx sdk.Context, stats types.EpochStatistics) error {
+	if err := stats.Validate(); err != nil {
+		return err
+	}
+
+	k.deleteAllEpochRouteStatistics(ctx, stats.EpochNumber)
+	for _, routeStats := range stats.RouteStatistics {
+		if err := k.setEpochRouteStatistics(ctx, stats.EpochNumber, routeStats); err != nil {
+			return err
+		}
+	}
+
+	totals := stats
+	totals.RouteStatistics = nil
+	return k.setEpochStatisticsTotals(ctx, totals)
+}
+
+// DeleteEpochStatistics deletes the statistics of the given epoch
+func (k Keeper) DeleteEpochStatistics(ctx sdk.Context, epochNumber uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochStatistics)
+	store.Delete(types.GetKeyPrefixEpochStatistics(epochNumber))
+
+	k.deleteAllEpochRouteStatistics(ctx, epochNumber)
+}
+
+// UpdateEpochStatistics adds a trade executed by the ProtoRev module on the given route to the statistics of the
+// current day epoch
+func (k Keeper) UpdateEpochStatistics(ctx sdk.Context, route []uint64, denom string, profit sdk.Int) error {
+	epochInfo := k.epochKeeper.GetEpochInfo(ctx, "day")
+	epochNumber := uint64(epochInfo.CurrentEpoch)
+
+	stats, err := k.getEpochStatisticsTotals(ctx, epochNumber)
+	if err != nil {
+		stats = types.EpochStatistics{
+			EpochNumber:    epochNumber,
+			StartTime:      epochInfo.CurrentEpochStartTime,
+			StartHeight:    epochInfo.CurrentEpochStartHeight,
+			NumberOfTrades: sdk.ZeroInt(),
+			Profits:        sdk.NewCoins(),
+		}
+	}
+
+	profitCoins := sdk.NewCoins(sdk.NewCoin(denom, profit))
+	stats.NumberOfTrades = stats.NumberOfTrades.Add(sdk.OneInt())
+	stats.Profits = stats.Profits.Add(profitCoins...)
+	if err := k.setEpochStatisticsTotals(ctx, stats); err != nil {
+		return err
+	}
+
+	// Add the trade to the statistics of the route, which are only read and written for the route that was traded on
+	routeStats, err := k.getEpochRouteStatistics(ctx, epochNumber, route)
+	if err != nil {
+		routeStats = types.RouteStatistics{
+			Profits:        sdk.NewCoins(),
+			NumberOfTrades: sdk.ZeroInt(),
+			Route:          route,
+		}
+	}
+
+	routeStats.NumberOfTrades = routeStats.NumberOfTrades.Add(sdk.OneInt())
+	routeStats.Profits = sdk.Coins(routeStats.Profits).Add(profitCoins...)
+
+	return k.setEpochRouteStatistics(ctx, epochNumber, routeStats)
+}
+
+// PruneEpochStatistics deletes the statistics of all of the epochs that are at least EpochStatisticsRetention epochs
+// older than the given epoch
+func (k Keeper) PruneEpochStatistics(ctx sdk.Context, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEpochStatistics)
+
+	// The keys are ordered by epoch number, so all of the epochs to delete are at the start of the store
+	prunedEpochs := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		prunedEpoch := sdk.BigEndianToUint64(key[len(key)-8:])
+		if prunedEpoch+types.EpochStatisticsRetention > epochNumber {
+			break
+		}
+
+		prunedEpochs = append(prunedEpochs, prunedEpoch)
+	}
+	iterator.Close()
+
+	for _, prunedEpoch := range prunedEpochs {
+		k.DeleteEpochStatistics(ctx, prunedEpoch)
+	}
+}
+
+// getEpochStatisticsTotals returns the number of trades executed and profits made by the ProtoRev module over the given
+// epoch, without the statistics of the routes
+func (k Keeper) getEpochStatisticsTotals(ctx sdk.Context, epochNumber uint64) (types.EpochStatistics, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochStatistics)
+	key := types.GetKeyPrefixEpochStatistics(epochNumber)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.EpochStatistics{}, fmt.Errorf("no statistics for epoch %d", epochNumber)
+	}
+
+	stats := types.EpochStatistics{}
+	if err := stats.Unmarshal(bz); err != nil {
+		return types.EpochStatistics{}, err
+	}
+
+	return stats, nil
+}
+
+// setEpochStatisticsTotals sets the number of trades executed and profits made by the ProtoRev module over an epoch,
+// keyed by its epoch number
+func (k Keeper) setEpochStatisticsTotals(ctx sdk.Context, stats types.EpochStatistics) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochStatistics)
+	key := types.GetKeyPrefixEpochStatistics(stats.EpochNumber)
+
+	bz, err := stats.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// getEpochRouteStatistics returns the statistics of the trades executed on the given route over the given epoch
+func (k Keeper) getEpochRouteStatistics(ctx sdk.Context, epochNumber uint64, route []uint64) (types.RouteStatistics, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRouteStatistics)
+	key := types.GetKeyPrefixEpochRouteStatistic(epochNumber, route)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.RouteStatistics{}, fmt.Errorf("no statistics for route %v in epoch %d", route, epochNumber)
+	}
+
+	routeStats := types.RouteStatistics{}
+	if err := routeStats.Unmarshal(bz); err != nil {
+		return types.RouteStatistics{}, err
+	}
+
+	return routeStats, nil
+}
+
+// getAllEpochRouteStatistics returns the statistics of all of the routes traded on over the given epoch, ordered by
+// route key
+func (k Keeper) getAllEpochRouteStatistics(ctx sdk.Context, epochNumber uint64) ([]types.RouteStatistics, error) {
+	routeStatistics := make([]types.RouteStatistics, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRouteStatistics)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixEpochRouteStatistics(epochNumber))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		routeStats := types.RouteStatistics{}
+		if err := routeStats.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		routeStatistics = append(routeStatistics, routeStats)
+	}
+
+	return routeStatistics, nil
+}
+
+// setEpochRouteStatistics sets the statistics of the trades executed on a route over the given epoch, keyed by the
+// epoch number and the route
+func (k Keeper) setEpochRouteStatistics(ctx sdk.Context, epochNumber uint64, routeStats types.RouteStatistics) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRouteStatistics)
+	key := types.GetKeyPrefixEpochRouteStatistic(epochNumber, routeStats.Route)
+
+	bz, err := routeStats.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// deleteAllEpochRouteStatistics deletes the statistics of all of the routes traded on over the given epoch
+func (k Keeper) deleteAllEpochRouteStatistics(ctx sdk.Context, epochNumber uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRouteStatistics)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixEpochRouteStatistics(epochNumber))
+
+	keysToDelete := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
@@ -192,3 +194,105 @@ func (s *KeeperTestSuite) TestUpdateStatistics() {
 	s.Require().NoError(err)
 	s.Require().Equal(2, len(routes))
 }
+
+// TestUpdateEpochStatistics tests UpdateEpochStatistics and GetEpochStatistics
+func (s *KeeperTestSuite) TestUpdateEpochStatistics() {
+	epochInfo := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	epochNumber := uint64(epochInfo.CurrentEpoch)
+
+	// Should be unset by default
+	_, err := s.App.ProtoRevKeeper.GetEpochStatistics(s.Ctx, epochNumber)
+	s.Require().Error(err)
+
+	// Pseudo execute two trades on the same route and one trade on another route
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}},
+		types.OsmosisDenomination, sdk.NewInt(1000),
+	)
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}},
+		"Atom", sdk.NewInt(500),
+	)
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}, {TokenOutDenom: "", PoolId: 4}},
+		types.OsmosisDenomination, sdk.NewInt(1100),
+	)
+	s.Require().NoError(err)
+
+	// Check the statistics of the current epoch
+	stats, err := s.App.ProtoRevKeeper.GetEpochStatistics(s.Ctx, epochNumber)
+	s.Require().NoError(err)
+	s.Require().Equal(types.EpochStatistics{
+		EpochNumber:    epochNumber,
+		StartTime:      epochInfo.CurrentEpochStartTime,
+		StartHeight:    epochInfo.CurrentEpochStartHeight,
+		NumberOfTrades: sdk.NewInt(3),
+		Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(2100)), sdk.NewCoin("Atom", sdk.NewInt(500))),
+		RouteStatistics: []types.RouteStatistics{
+			{
+				Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000)), sdk.NewCoin("Atom", sdk.NewInt(500))),
+				NumberOfTrades: sdk.NewInt(2),
+				Route:          []uint64{1, 2, 3},
+			},
+			{
+				Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1100))),
+				NumberOfTrades: sdk.NewInt(1),
+				Route:          []uint64{2, 3, 4},
+			},
+		},
+	}, stats)
+}
+
+// TestGetEpochStatisticsInTimeRange tests GetEpochStatisticsInTimeRange and PruneEpochStatistics
+func (s *KeeperTestSuite) TestGetEpochStatisticsInTimeRange() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	for epochNumber := uint64(1); epochNumber <= 5; epochNumber++ {
+		err := s.App.ProtoRevKeeper.SetEpochStatistics(s.Ctx, types.EpochStatistics{
+			EpochNumber:    epochNumber,
+			StartTime:      startTime.Add(time.Duration(epochNumber) * 24 * time.Hour),
+			NumberOfTrades: sdk.NewIntFromUint64(epochNumber),
+			Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewIntFromUint64(epochNumber))),
+			RouteStatistics: []types.RouteStatistics{
+				{
+					Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewIntFromUint64(epochNumber))),
+					NumberOfTrades: sdk.NewIntFromUint64(epochNumber),
+					Route:          []uint64{epochNumber, epochNumber + 1},
+				},
+			},
+		})
+		s.Require().NoError(err)
+	}
+
+	// Only the epochs that started within the time range are returned
+	epochStatistics, err := s.App.ProtoRevKeeper.GetEpochStatisticsInTimeRange(s.Ctx, startTime.Add(48*time.Hour), startTime.Add(96*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(epochStatistics, 3)
+	for i, stats := range epochStatistics {
+		s.Require().Equal(uint64(i+2), stats.EpochNumber)
+		s.Require().Len(stats.RouteStatistics, 1)
+		s.Require().Equal([]uint64{uint64(i + 2), uint64(i + 3)}, stats.RouteStatistics[0].Route)
+	}
+
+	// The start time cannot be after the end time
+	_, err = s.App.ProtoRevKeeper.GetEpochStatisticsInTimeRange(s.Ctx, startTime.Add(96*time.Hour), startTime.Add(48*time.Hour))
+	s.Require().Error(err)
+
+	// Only the most recent epochs are retained
+	s.App.ProtoRevKeeper.PruneEpochStatistics(s.Ctx, types.EpochStatisticsRetention+3)
+	epochStatistics, err = s.App.ProtoRevKeeper.GetAllEpochStatistics(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(epochStatistics, 2)
+	s.Require().Equal(uint64(4), epochStatistics[0].EpochNumber)
+	s.Require().Equal(uint64(5), epochStatistics[1].EpochNumber)
+
+	// The statistics of the routes of the pruned epochs are deleted as well
+	iterator := sdk.KVStorePrefixIterator(s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey)), types.KeyPrefixEpochRouteStatistics)
+	defer iterator.Close()
+	numberOfRouteStatistics := 0
+	for ; iterator.Valid(); iterator.Next() {
+		numberOfRouteStatistics++
+	}
+	s.Require().Equal(2, numberOfRouteStatistics)
+}
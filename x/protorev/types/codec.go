@@ -22,6 +22,8 @@ const (
 	setMaxPoolPointsPerBlock = "osmosis/MsgSetMaxPoolPointsPerBlock"
 	setPoolWeights           = "osmosis/MsgSetPoolWeights"
 	setBaseDenoms            = "osmosis/MsgSetBaseDenoms"
+	setMaxPromotedHotRoutes  = "osmosis/MsgSetMaxPromotedHotRoutes"
+	setHotRouteOverride      = "osmosis/MsgSetHotRouteOverride"
 
 	// proposals
 	setProtoRevEnabledProposal      = "osmosis/SetProtoRevEnabledProposal"
@@ -42,6 +44,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerBlock{}, setMaxPoolPointsPerBlock, nil)
 	cdc.RegisterConcrete(&MsgSetPoolWeights{}, setPoolWeights, nil)
 	cdc.RegisterConcrete(&MsgSetBaseDenoms{}, setBaseDenoms, nil)
+	cdc.RegisterConcrete(&MsgSetMaxPromotedHotRoutes{}, setMaxPromotedHotRoutes, nil)
+	cdc.RegisterConcrete(&MsgSetHotRouteOverride{}, setHotRouteOverride, nil)
 
 	// proposals
 	cdc.RegisterConcrete(&SetProtoRevEnabledProposal{}, setProtoRevEnabledProposal, nil)
@@ -57,6 +61,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetMaxPoolPointsPerBlock{},
 		&MsgSetPoolWeights{},
 		&MsgSetBaseDenoms{},
+		&MsgSetMaxPromotedHotRoutes{},
+		&MsgSetHotRouteOverride{},
 	)
 
 	// proposals
@@ -27,6 +27,45 @@ const MaxPoolPointsPerTx uint64 = 50
 // to the maximum execution time (in ms) of protorev per block
 const MaxPoolPointsPerBlock uint64 = 200
 
+// ----------------- Module Route Building Constants ----------------- //
+
+// MinRouteLength and MaxRouteLength bound the number of hops of the routes built with the graph search method
+const (
+	MinRouteLength = 2
+	MaxRouteLength = 5
+)
+
+// DefaultRouteLength is the number of hops of the routes built with the highest liquidity method. Graph search routes that
+// are longer are charged one pool point per extra hop for checking their profitability, even if they turn out to not be profitable
+const DefaultRouteLength = 3
+
+// MaxGraphSearchRoutes is the max number of routes built with the graph search method for a single swap
+const MaxGraphSearchRoutes = 10
+
+// MaxTicksCrossed is the max number of initialized ticks of each concentrated pool in a route whose boundaries
+// are stepped through when determining route profitability
+const MaxTicksCrossed uint64 = 5
+
+// ExtraSimulationPoolPoints is the number of pool points charged for every simulation run to search a route beyond its binary
+// search, such as the simulations used to step through the tick boundaries of its concentrated pools
+const ExtraSimulationPoolPoints uint64 = 1
+
+// ---------------- Module Hot Route Promotion Constants ---------------- //
+
+// MaxPromotedHotRoutes is the max number of routes that can be automatically promoted to hot routes
+const MaxPromotedHotRoutes uint64 = 100
+
+// HotRouteStaleEpochs is the number of consecutive epochs without a trade after which a promoted route is demoted
+const HotRouteStaleEpochs uint64 = 7
+
+// ---------------- Module Statistics Constants ---------------- //
+
+// EpochStatisticsRetention is the number of most recent day epochs whose statistics are kept
+const EpochStatisticsRetention uint64 = 30
+
+// ProfitDistributionRetention is the number of most recent profit distributions that are kept
+const ProfitDistributionRetention uint64 = 30
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
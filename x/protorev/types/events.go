@@ -1,7 +1,11 @@
 package types
 
 const (
-	TypeEvtBackrun = "protorev_backrun"
+	TypeEvtBackrun            = "protorev_backrun"
+	TypeEvtHotRoutePromoted   = "protorev_hot_route_promoted"
+	TypeEvtHotRouteDemoted    = "protorev_hot_route_demoted"
+	TypeEvtHotRouteOverride   = "protorev_hot_route_override"
+	TypeEvtProfitDistribution = "protorev_profit_distribution"
 
 	AttributeValueCategory               = ModuleName
 	AttributeKeyTxHash                   = "tx_hash"
@@ -14,4 +18,21 @@ const (
 	AttributeKeyProtorevAmountIn         = "amount_in"
 	AttributeKeyProtorevAmountOut        = "amount_out"
 	AttributeKeyProtorevArbDenom         = "arb_denom"
+	AttributeKeyTokenIn                  = "token_in"
+	AttributeKeyTokenOut                 = "token_out"
+	AttributeKeyRoute                    = "route"
+	AttributeKeyReason                   = "reason"
+	AttributeKeyOverrideType             = "override_type"
+	AttributeKeyEpochNumber              = "epoch_number"
+	AttributeKeyProfits                  = "profits"
+	AttributeKeyToStakers                = "to_stakers"
+	AttributeKeyToCommunityPool          = "to_community_pool"
+	AttributeKeyBurned                   = "burned"
+	AttributeKeyRetained                 = "retained"
+
+	AttributeValueReasonRanked    = "ranked"
+	AttributeValueReasonPinned    = "pinned"
+	AttributeValueReasonBanned    = "banned"
+	AttributeValueReasonStale     = "stale"
+	AttributeValueReasonDisplaced = "displaced"
 )
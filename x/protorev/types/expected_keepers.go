@@ -1,10 +1,13 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v17/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -17,11 +20,19 @@ type AccountKeeper interface {
 // BankKeeper defines the banking contract that must be fulfilled when
 // creating a x/protorev keeper.
 type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the Distribution contract that must be fulfilled when
+// creating a x/protorev keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // GAMMKeeper defines the Gamm contract that must be fulfilled when
 // creating a x/protorev keeper.
 type GAMMKeeper interface {
@@ -38,12 +49,25 @@ type PoolManagerKeeper interface {
 		tokenIn sdk.Coin,
 		tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
 
+	RouteExactAmountInWithoutTakerFee(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []poolmanagertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+
 	MultihopEstimateOutGivenExactAmountIn(
 		ctx sdk.Context,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
 	) (tokenOutAmount sdk.Int, err error)
 
+	MultihopEstimateInGivenExactAmountOut(
+		ctx sdk.Context,
+		routes []poolmanagertypes.SwapAmountOutRoute,
+		tokenOut sdk.Coin,
+	) (tokenInAmount sdk.Int, err error)
+
 	AllPools(
 		ctx sdk.Context,
 	) ([]poolmanagertypes.PoolI, error)
@@ -54,6 +78,19 @@ type PoolManagerKeeper interface {
 	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolModuleI, error)
 	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	GetDenomPairRoute(ctx sdk.Context, tokenInDenom, tokenOutDenom string) ([]poolmanagertypes.SwapAmountInRoute, error)
+}
+
+// ConcentratedLiquidityKeeper defines the ConcentratedLiquidity contract that must be fulfilled when
+// creating a x/protorev keeper.
+type ConcentratedLiquidityKeeper interface {
+	ComputeTickCrossingAmountsIn(ctx sdk.Context, poolId uint64, tokenInDenom string, maxTicksCrossed uint64) ([]sdk.Int, error)
+}
+
+// TwapKeeper defines the Twap contract that must be fulfilled when
+// creating a x/protorev keeper.
+type TwapKeeper interface {
+	GetGeometricTwapOverRoute(ctx sdk.Context, baseAssetDenom string, routes []twaptypes.TwapRoute, startTime time.Time, endTime time.Time) (sdk.Dec, error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
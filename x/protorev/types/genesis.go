@@ -29,6 +29,12 @@ var (
 	DefaultMaxPoolPointsPerTx        = uint64(18)
 	DefaultPoolPointsConsumedInBlock = uint64(0)
 	DefaultProfits                   = []sdk.Coin{}
+	DefaultMaxPromotedHotRoutes      = uint64(10)
+	DefaultHotRouteCandidates        = []HotRouteCandidate{}
+	DefaultHotRouteOverrides         = []HotRouteOverride{}
+	DefaultEpochStatistics           = []EpochStatistics{}
+	DefaultProfitDistributions       = []ProfitDistribution{}
+	DefaultRetainedProfits           = []sdk.Coin{}
 )
 
 // DefaultGenesis returns the default genesis state
@@ -46,6 +52,12 @@ func DefaultGenesis() *GenesisState {
 		MaxPoolPointsPerTx:     DefaultMaxPoolPointsPerTx,
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
+		MaxPromotedHotRoutes:   DefaultMaxPromotedHotRoutes,
+		HotRouteCandidates:     DefaultHotRouteCandidates,
+		HotRouteOverrides:      DefaultHotRouteOverrides,
+		EpochStatistics:        DefaultEpochStatistics,
+		ProfitDistributions:    DefaultProfitDistributions,
+		RetainedProfits:        DefaultRetainedProfits,
 	}
 }
 
@@ -88,6 +100,36 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the max promoted hot routes
+	if err := ValidateMaxPromotedHotRoutes(gs.MaxPromotedHotRoutes); err != nil {
+		return err
+	}
+
+	// Validate the hot route candidates
+	if err := ValidateHotRouteCandidates(gs.HotRouteCandidates); err != nil {
+		return err
+	}
+
+	// Validate the hot route overrides
+	if err := ValidateHotRouteOverrides(gs.HotRouteOverrides); err != nil {
+		return err
+	}
+
+	// Validate the epoch statistics
+	if err := ValidateEpochStatistics(gs.EpochStatistics); err != nil {
+		return err
+	}
+
+	// Validate the profit distributions
+	if err := ValidateProfitDistributions(gs.ProfitDistributions); err != nil {
+		return err
+	}
+
+	// Validate the retained profits
+	if err := sdk.Coins(gs.RetainedProfits).Validate(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
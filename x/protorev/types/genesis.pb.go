@@ -55,6 +55,23 @@ type GenesisState struct {
 	PointCountForBlock uint64 `protobuf:"varint,11,opt,name=point_count_for_block,json=pointCountForBlock,proto3" json:"point_count_for_block,omitempty" yaml:"point_count_for_block"`
 	// All of the profits that have been accumulated by the module.
 	Profits []types.Coin `protobuf:"bytes,12,rep,name=profits,proto3" json:"profits" yaml:"profits"`
+	// The maximum number of routes that can be automatically promoted to hot
+	// routes.
+	MaxPromotedHotRoutes uint64 `protobuf:"varint,13,opt,name=max_promoted_hot_routes,json=maxPromotedHotRoutes,proto3" json:"max_promoted_hot_routes,omitempty" yaml:"max_promoted_hot_routes"`
+	// The routes the module has traded on that can be automatically promoted to
+	// hot routes.
+	HotRouteCandidates []HotRouteCandidate `protobuf:"bytes,14,rep,name=hot_route_candidates,json=hotRouteCandidates,proto3" json:"hot_route_candidates" yaml:"hot_route_candidates"`
+	// The overrides set by the admin account on the automatic promotion of
+	// routes to hot routes.
+	HotRouteOverrides []HotRouteOverride `protobuf:"bytes,15,rep,name=hot_route_overrides,json=hotRouteOverrides,proto3" json:"hot_route_overrides" yaml:"hot_route_overrides"`
+	// The statistics of the trades the module has executed over the most recent
+	// day epochs.
+	EpochStatistics []EpochStatistics `protobuf:"bytes,16,rep,name=epoch_statistics,json=epochStatistics,proto3" json:"epoch_statistics" yaml:"epoch_statistics"`
+	// The most recent distributions of the profits of the module.
+	ProfitDistributions []ProfitDistribution `protobuf:"bytes,17,rep,name=profit_distributions,json=profitDistributions,proto3" json:"profit_distributions" yaml:"profit_distributions"`
+	// The profits that were kept in the module account by the profit
+	// distributions and are not distributed again.
+	RetainedProfits []types.Coin `protobuf:"bytes,18,rep,name=retained_profits,json=retainedProfits,proto3" json:"retained_profits" yaml:"retained_profits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -174,6 +191,48 @@ func (m *GenesisState) GetProfits() []types.Coin {
 	return nil
 }
 
+func (m *GenesisState) GetMaxPromotedHotRoutes() uint64 {
+	if m != nil {
+		return m.MaxPromotedHotRoutes
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHotRouteCandidates() []HotRouteCandidate {
+	if m != nil {
+		return m.HotRouteCandidates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHotRouteOverrides() []HotRouteOverride {
+	if m != nil {
+		return m.HotRouteOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochStatistics() []EpochStatistics {
+	if m != nil {
+		return m.EpochStatistics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProfitDistributions() []ProfitDistribution {
+	if m != nil {
+		return m.ProfitDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetainedProfits() []types.Coin {
+	if m != nil {
+		return m.RetainedProfits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -183,50 +242,64 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xdb, 0xde, 0xfe, 0x99, 0xf4, 0x56, 0xb7, 0xd3, 0x9b, 0xca, 0xc9, 0xa5, 0x8e,
-	0x31, 0x2d, 0x64, 0x41, 0x6d, 0xb5, 0x20, 0x21, 0xb1, 0x40, 0xaa, 0x8b, 0x0a, 0x12, 0xa2, 0x8a,
-	0xa6, 0x45, 0x48, 0x20, 0x31, 0x8c, 0x93, 0x69, 0x6a, 0xd5, 0xf6, 0x58, 0x9e, 0x49, 0x48, 0x1f,
-	0x80, 0x3d, 0x0f, 0xc3, 0x43, 0x74, 0x59, 0xb1, 0x62, 0x15, 0xa1, 0x76, 0xc1, 0x3e, 0x4f, 0x80,
-	0x3c, 0x33, 0x49, 0x4a, 0x89, 0x61, 0x97, 0x39, 0xe7, 0x77, 0xbe, 0xef, 0x9c, 0x99, 0xe3, 0x80,
-	0xbb, 0x8c, 0xc7, 0x8c, 0x87, 0xdc, 0x4b, 0x33, 0x26, 0x58, 0x46, 0x7b, 0x5e, 0x6f, 0x3b, 0xa0,
-	0x82, 0x6c, 0x7b, 0x1d, 0x9a, 0x50, 0x1e, 0x72, 0x57, 0x26, 0xa0, 0xa9, 0x39, 0x77, 0xc4, 0xb9,
-	0x9a, 0xab, 0xfd, 0xd7, 0x61, 0x1d, 0x26, 0xa3, 0x5e, 0xfe, 0x4b, 0x01, 0xb5, 0x7b, 0x85, 0xba,
-	0x63, 0x01, 0x05, 0x6e, 0x16, 0x83, 0x24, 0x23, 0xb1, 0x36, 0xac, 0x55, 0x5b, 0x92, 0xc3, 0xca,
-	0x48, 0x1d, 0x74, 0xca, 0x52, 0x27, 0x2f, 0x20, 0x9c, 0x8e, 0x8b, 0x5b, 0x2c, 0x4c, 0x54, 0xde,
-	0xf9, 0xbe, 0x00, 0x96, 0x9e, 0xa9, 0x61, 0x0e, 0x05, 0x11, 0x14, 0x3e, 0x01, 0x73, 0x4a, 0xdb,
-	0x34, 0x6c, 0xa3, 0x51, 0xde, 0xb1, 0xdd, 0xa2, 0xe1, 0xdc, 0xa6, 0xe4, 0xfc, 0xd9, 0xf3, 0x41,
-	0xbd, 0x84, 0x74, 0x15, 0xfc, 0x68, 0x80, 0x8a, 0x60, 0xa7, 0x34, 0xc1, 0x29, 0x09, 0x33, 0x4c,
-	0xb2, 0x00, 0x67, 0xac, 0x2b, 0x28, 0x37, 0xff, 0xb2, 0x67, 0x1a, 0xe5, 0x9d, 0xfb, 0xc5, 0x7a,
-	0x47, 0x79, 0x59, 0x93, 0x84, 0xd9, 0x6e, 0x16, 0x20, 0x59, 0xe3, 0x6f, 0xe4, 0xda, 0xc3, 0x41,
-	0xfd, 0xd6, 0x19, 0x89, 0xa3, 0xc7, 0xce, 0x54, 0x61, 0x07, 0x41, 0xf1, 0x4b, 0x25, 0x7c, 0x0f,
-	0xca, 0xf9, 0xcc, 0xb8, 0x4d, 0x13, 0x16, 0x73, 0x73, 0x46, 0x9a, 0xdf, 0x29, 0x36, 0xf7, 0x09,
-	0xa7, 0x4f, 0x73, 0xd6, 0xaf, 0x69, 0x4f, 0xa8, 0x3c, 0xaf, 0xa9, 0x38, 0x08, 0x04, 0x23, 0x8c,
-	0x43, 0x0a, 0x96, 0x52, 0xc6, 0x22, 0xfc, 0x81, 0x86, 0x9d, 0x13, 0xc1, 0xcd, 0x59, 0x79, 0x5f,
-	0x9b, 0xbf, 0xb9, 0x2f, 0xc6, 0xa2, 0xd7, 0x0a, 0xf6, 0xff, 0xd7, 0x26, 0xab, 0xca, 0xe4, 0xba,
-	0x90, 0x83, 0xca, 0xe9, 0x84, 0x84, 0x18, 0x54, 0xdb, 0xe4, 0x8c, 0x63, 0x1e, 0x26, 0x2d, 0x8a,
-	0x63, 0xd6, 0xee, 0x46, 0x14, 0xeb, 0xfd, 0x33, 0xff, 0xb6, 0x8d, 0xc6, 0xac, 0xbf, 0x31, 0x1c,
-	0xd4, 0x6d, 0x25, 0x54, 0x88, 0x3a, 0x68, 0x2d, 0xcf, 0x1d, 0xe6, 0xa9, 0x97, 0x32, 0xa3, 0x9f,
-	0x1d, 0x62, 0xb0, 0xdc, 0xa6, 0x3d, 0x1a, 0xb1, 0x94, 0x66, 0xf8, 0x98, 0x52, 0x6e, 0xce, 0xc9,
-	0xcb, 0xaa, 0xba, 0x7a, 0x93, 0xf2, 0x99, 0xc7, 0x43, 0xec, 0xb1, 0x30, 0xf1, 0xd7, 0x75, 0xf7,
-	0x15, 0x6d, 0xfa, 0x53, 0xb9, 0x83, 0xfe, 0x19, 0x07, 0xf6, 0x29, 0xe5, 0xf0, 0x00, 0xac, 0x46,
-	0x44, 0x50, 0x2e, 0x70, 0x10, 0xb1, 0xd6, 0x29, 0x3e, 0x91, 0x93, 0x99, 0xf3, 0xb2, 0x77, 0x6b,
-	0x38, 0xa8, 0xd7, 0x94, 0xcc, 0x14, 0xc8, 0x41, 0x2b, 0x2a, 0xea, 0xe7, 0xc1, 0xe7, 0x32, 0x06,
-	0xdf, 0x82, 0x95, 0x89, 0x23, 0x69, 0xb7, 0x33, 0xca, 0xb9, 0xb9, 0x60, 0x1b, 0x8d, 0x45, 0xdf,
-	0x1d, 0x0e, 0xea, 0xe6, 0xcd, 0xa6, 0x34, 0xe2, 0x7c, 0xf9, 0xbc, 0xb5, 0xac, 0x47, 0xda, 0x55,
-	0x21, 0xf4, 0xef, 0x98, 0xd2, 0x11, 0xf8, 0x0e, 0x54, 0x63, 0xd2, 0xc7, 0xf2, 0x41, 0x52, 0x16,
-	0x26, 0x82, 0xe3, 0x5c, 0x43, 0x36, 0x65, 0x2e, 0xde, 0xbc, 0xee, 0x42, 0xd4, 0x41, 0x95, 0x98,
-	0xf4, 0xf3, 0x17, 0x6f, 0xca, 0x4c, 0x93, 0x66, 0x72, 0x04, 0xf8, 0x0a, 0xac, 0x4d, 0x2b, 0x12,
-	0x7d, 0x13, 0x48, 0xf1, 0xdb, 0xc3, 0x41, 0x7d, 0xbd, 0x58, 0x5c, 0xf4, 0x1d, 0x04, 0x6f, 0x2a,
-	0x1f, 0xf5, 0xe1, 0x21, 0xa8, 0x48, 0x0a, 0xb7, 0x58, 0x37, 0x11, 0xf8, 0x98, 0x8d, 0x5a, 0x2e,
-	0x4b, 0x55, 0x7b, 0xf2, 0x0d, 0x4d, 0xc5, 0x1c, 0x04, 0x65, 0x7c, 0x2f, 0x0f, 0xef, 0x33, 0xdd,
-	0xeb, 0x0b, 0x30, 0x9f, 0x66, 0xec, 0x38, 0x14, 0xdc, 0x5c, 0xfa, 0xd3, 0x4a, 0xac, 0xe9, 0x95,
-	0x58, 0xd6, 0x2e, 0xaa, 0xce, 0x41, 0x23, 0x05, 0xff, 0xe0, 0xfc, 0xd2, 0x32, 0x2e, 0x2e, 0x2d,
-	0xe3, 0xdb, 0xa5, 0x65, 0x7c, 0xba, 0xb2, 0x4a, 0x17, 0x57, 0x56, 0xe9, 0xeb, 0x95, 0x55, 0x7a,
-	0xf3, 0xb0, 0x13, 0x8a, 0x93, 0x6e, 0xe0, 0xb6, 0x58, 0xec, 0xe9, 0x8f, 0x67, 0x2b, 0x22, 0x01,
-	0x1f, 0x1d, 0xbc, 0xde, 0xf6, 0x23, 0xaf, 0x3f, 0xf9, 0x0f, 0x14, 0x67, 0x29, 0xe5, 0xc1, 0x9c,
-	0x3c, 0x3f, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x2a, 0x10, 0x89, 0xa5, 0x05, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xd2, 0x90, 0xd2, 0x71, 0xea, 0x24, 0x93, 0x8f, 0x6e, 0x5c, 0xba, 0x36, 0xd3, 0x16,
+	0xc2, 0x47, 0x6d, 0xa5, 0x20, 0x21, 0x71, 0x40, 0xea, 0xa6, 0x94, 0x4a, 0x88, 0x62, 0x4d, 0x8a,
+	0x10, 0x20, 0x31, 0xcc, 0xee, 0x4e, 0xec, 0x51, 0xbd, 0x3b, 0xab, 0x9d, 0xb1, 0x71, 0x2e, 0x1c,
+	0x10, 0xdc, 0xf9, 0x21, 0x1c, 0xf9, 0x11, 0x3d, 0x56, 0x9c, 0x38, 0x59, 0x28, 0xf9, 0x07, 0xfe,
+	0x05, 0x68, 0x67, 0x66, 0xed, 0x64, 0xe3, 0xad, 0x6f, 0xde, 0xf7, 0x7d, 0x3e, 0xde, 0x67, 0xe6,
+	0xdd, 0x35, 0x78, 0x57, 0xc8, 0x58, 0x48, 0x2e, 0x3b, 0x69, 0x26, 0x94, 0xc8, 0xd8, 0xa8, 0x33,
+	0x3a, 0x0c, 0x98, 0xa2, 0x87, 0x9d, 0x1e, 0x4b, 0x98, 0xe4, 0xb2, 0xad, 0x1b, 0xd0, 0xb5, 0xb8,
+	0x76, 0x81, 0x6b, 0x5b, 0x5c, 0x63, 0xa7, 0x27, 0x7a, 0x42, 0x57, 0x3b, 0xf9, 0x2f, 0x03, 0x68,
+	0xbc, 0x57, 0xa9, 0x3b, 0x13, 0x30, 0xc0, 0xfb, 0xd5, 0x40, 0x9a, 0xd1, 0xd8, 0x1a, 0x36, 0xf6,
+	0x43, 0x8d, 0x23, 0xc6, 0xc8, 0x3c, 0xd8, 0x96, 0x67, 0x9e, 0x3a, 0x01, 0x95, 0x6c, 0x46, 0x0e,
+	0x05, 0x4f, 0x4c, 0x1f, 0xfd, 0x55, 0x07, 0xeb, 0x5f, 0x9a, 0x30, 0xc7, 0x8a, 0x2a, 0x06, 0x3f,
+	0x07, 0x6b, 0x46, 0xdb, 0x75, 0x5a, 0xce, 0x41, 0xed, 0x61, 0xab, 0x5d, 0x15, 0xae, 0xdd, 0xd5,
+	0x38, 0x7f, 0xf5, 0xe5, 0xa4, 0xb9, 0x82, 0x2d, 0x0b, 0xfe, 0xe1, 0x80, 0x5d, 0x25, 0x5e, 0xb0,
+	0x84, 0xa4, 0x94, 0x67, 0x84, 0x66, 0x01, 0xc9, 0xc4, 0x50, 0x31, 0xe9, 0xbe, 0xd1, 0xba, 0x76,
+	0x50, 0x7b, 0xf8, 0x51, 0xb5, 0xde, 0xf3, 0x9c, 0xd6, 0xa5, 0x3c, 0x7b, 0x94, 0x05, 0x58, 0x73,
+	0xfc, 0x7b, 0xb9, 0xf6, 0x74, 0xd2, 0x7c, 0xfb, 0x94, 0xc6, 0x83, 0xcf, 0xd0, 0x42, 0x61, 0x84,
+	0xa1, 0xba, 0xc2, 0x84, 0x3f, 0x83, 0x5a, 0x9e, 0x99, 0x44, 0x2c, 0x11, 0xb1, 0x74, 0xaf, 0x69,
+	0xf3, 0xbb, 0xd5, 0xe6, 0x3e, 0x95, 0xec, 0x71, 0x8e, 0xf5, 0x1b, 0xd6, 0x13, 0x1a, 0xcf, 0x0b,
+	0x2a, 0x08, 0x83, 0xa0, 0x80, 0x49, 0xc8, 0xc0, 0x7a, 0x2a, 0xc4, 0x80, 0xfc, 0xc2, 0x78, 0xaf,
+	0xaf, 0xa4, 0xbb, 0xaa, 0xcf, 0xeb, 0xfe, 0x6b, 0xce, 0x4b, 0x88, 0xc1, 0x77, 0x06, 0xec, 0xdf,
+	0xb6, 0x26, 0xdb, 0xc6, 0xe4, 0xa2, 0x10, 0xc2, 0xb5, 0x74, 0x8e, 0x84, 0x04, 0xec, 0x47, 0xf4,
+	0x54, 0x12, 0xc9, 0x93, 0x90, 0x91, 0x58, 0x44, 0xc3, 0x01, 0x23, 0x76, 0xff, 0xdc, 0x37, 0x5b,
+	0xce, 0xc1, 0xaa, 0x7f, 0x6f, 0x3a, 0x69, 0xb6, 0x8c, 0x50, 0x25, 0x14, 0xe1, 0xbd, 0xbc, 0x77,
+	0x9c, 0xb7, 0xbe, 0xd6, 0x1d, 0x7b, 0xed, 0x90, 0x80, 0x7a, 0xc4, 0x46, 0x6c, 0x20, 0x52, 0x96,
+	0x91, 0x13, 0xc6, 0xa4, 0xbb, 0xa6, 0x0f, 0x6b, 0xbf, 0x6d, 0x37, 0x29, 0xcf, 0x3c, 0x0b, 0x71,
+	0x24, 0x78, 0xe2, 0xdf, 0xb1, 0xd3, 0xef, 0x5a, 0xd3, 0x4b, 0x74, 0x84, 0x6f, 0xce, 0x0a, 0x4f,
+	0x18, 0x93, 0xf0, 0x19, 0xd8, 0x1e, 0x50, 0xc5, 0xa4, 0x22, 0xc1, 0x40, 0x84, 0x2f, 0x48, 0x5f,
+	0x27, 0x73, 0xaf, 0xeb, 0xd9, 0xbd, 0xe9, 0xa4, 0xd9, 0x30, 0x32, 0x0b, 0x40, 0x08, 0x6f, 0x99,
+	0xaa, 0x9f, 0x17, 0x9f, 0xea, 0x1a, 0xfc, 0x11, 0x6c, 0xcd, 0x1d, 0x69, 0x14, 0x65, 0x4c, 0x4a,
+	0xf7, 0xad, 0x96, 0x73, 0x70, 0xc3, 0x6f, 0x4f, 0x27, 0x4d, 0xb7, 0x3c, 0x94, 0x85, 0xa0, 0x7f,
+	0xfe, 0x7e, 0x50, 0xb7, 0x91, 0x1e, 0x99, 0x12, 0xde, 0x9c, 0xa1, 0x6c, 0x05, 0xfe, 0x04, 0xf6,
+	0x63, 0x3a, 0x26, 0xfa, 0x42, 0x52, 0xc1, 0x13, 0x25, 0x49, 0xae, 0xa1, 0x87, 0x72, 0x6f, 0x94,
+	0x8f, 0xbb, 0x12, 0x8a, 0xf0, 0x6e, 0x4c, 0xc7, 0xf9, 0x8d, 0x77, 0x75, 0xa7, 0xcb, 0x32, 0x1d,
+	0x01, 0x7e, 0x0b, 0xf6, 0x16, 0x91, 0xd4, 0xd8, 0x05, 0x5a, 0xfc, 0x9d, 0xe9, 0xa4, 0x79, 0xa7,
+	0x5a, 0x5c, 0x8d, 0x11, 0x86, 0x65, 0xe5, 0xe7, 0x63, 0x78, 0x0c, 0x76, 0x35, 0x8a, 0x84, 0x62,
+	0x98, 0x28, 0x72, 0x22, 0x8a, 0x91, 0x6b, 0x5a, 0xb5, 0x35, 0x7f, 0x87, 0x16, 0xc2, 0x10, 0x86,
+	0xba, 0x7e, 0x94, 0x97, 0x9f, 0x08, 0x3b, 0xeb, 0x57, 0xe0, 0x7a, 0x9a, 0x89, 0x13, 0xae, 0xa4,
+	0xbb, 0xbe, 0x6c, 0x25, 0xf6, 0xec, 0x4a, 0xd4, 0xad, 0x8b, 0xe1, 0x21, 0x5c, 0x28, 0xc0, 0xef,
+	0xc1, 0x2d, 0x1d, 0x28, 0x13, 0xb1, 0x50, 0x2c, 0x22, 0x7d, 0xa1, 0x8a, 0x2f, 0xc3, 0x4d, 0x3d,
+	0x23, 0x9a, 0x4e, 0x9a, 0xde, 0x85, 0xe4, 0x57, 0x81, 0x08, 0xef, 0xe4, 0xd1, 0x6d, 0xe3, 0xa9,
+	0x50, 0xf6, 0x5d, 0xff, 0xcd, 0x01, 0x3b, 0x33, 0x14, 0x09, 0x69, 0x12, 0xf1, 0x28, 0xdf, 0x1a,
+	0xb7, 0xae, 0xa7, 0xfe, 0xb0, 0xfa, 0x95, 0x2c, 0x34, 0x8e, 0x0a, 0x8e, 0x7f, 0xd7, 0xe6, 0xb8,
+	0x6d, 0x26, 0x59, 0x24, 0x8b, 0x30, 0xec, 0x97, 0x79, 0x12, 0xfe, 0x0a, 0xb6, 0xe7, 0x60, 0x31,
+	0x62, 0x59, 0xc6, 0x23, 0x26, 0xdd, 0x0d, 0x3d, 0xc2, 0x07, 0xcb, 0x47, 0xf8, 0xc6, 0x52, 0x7c,
+	0x64, 0x27, 0x68, 0x94, 0x27, 0x98, 0x89, 0x22, 0xbc, 0xd5, 0x2f, 0xb1, 0x24, 0x1c, 0x82, 0x4d,
+	0x96, 0x8a, 0xb0, 0x4f, 0xa4, 0xa2, 0x8a, 0x4b, 0xc5, 0x43, 0xe9, 0x6e, 0x6a, 0xf3, 0xf7, 0xab,
+	0xcd, 0xbf, 0xc8, 0x19, 0xc7, 0x33, 0x82, 0xdf, 0xb4, 0xde, 0xb7, 0x8c, 0x77, 0x59, 0x10, 0xe1,
+	0x0d, 0x76, 0x99, 0x01, 0x7f, 0x77, 0xc0, 0x8e, 0xb9, 0x62, 0x12, 0x71, 0xa9, 0x32, 0x1e, 0x0c,
+	0x15, 0x17, 0x89, 0x74, 0xb7, 0x96, 0x7d, 0xee, 0xbb, 0x9a, 0xf5, 0xf8, 0x02, 0xa9, 0x7c, 0xf8,
+	0x8b, 0x74, 0x11, 0xde, 0x4e, 0xaf, 0x10, 0xf3, 0x8f, 0xf1, 0x66, 0xc6, 0x14, 0xe5, 0x09, 0x8b,
+	0x48, 0xb1, 0xb3, 0x70, 0xd9, 0xce, 0x96, 0xd2, 0x96, 0x05, 0x10, 0xde, 0x28, 0x4a, 0x66, 0x56,
+	0xe9, 0x3f, 0x7b, 0x79, 0xe6, 0x39, 0xaf, 0xce, 0x3c, 0xe7, 0xbf, 0x33, 0xcf, 0xf9, 0xf3, 0xdc,
+	0x5b, 0x79, 0x75, 0xee, 0xad, 0xfc, 0x7b, 0xee, 0xad, 0xfc, 0xf0, 0x49, 0x8f, 0xab, 0xfe, 0x30,
+	0x68, 0x87, 0x22, 0xee, 0xd8, 0xc8, 0x0f, 0x06, 0x34, 0x90, 0xc5, 0x43, 0x67, 0x74, 0xf8, 0x69,
+	0x67, 0x3c, 0xff, 0x23, 0x57, 0xa7, 0x29, 0x93, 0xc1, 0x9a, 0x7e, 0xfe, 0xf8, 0xff, 0x01, 0x00,
+	0xba, 0x4d, 0x44, 0x1e, 0x6a, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -249,6 +322,87 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetainedProfits) > 0 {
+		for iNdEx := len(m.RetainedProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetainedProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ProfitDistributions) > 0 {
+		for iNdEx := len(m.ProfitDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EpochStatistics) > 0 {
+		for iNdEx := len(m.EpochStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.HotRouteOverrides) > 0 {
+		for iNdEx := len(m.HotRouteOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRouteOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.HotRouteCandidates) > 0 {
+		for iNdEx := len(m.HotRouteCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRouteCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxPromotedHotRoutes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPromotedHotRoutes))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.Profits) > 0 {
 		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -424,6 +578,39 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MaxPromotedHotRoutes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPromotedHotRoutes))
+	}
+	if len(m.HotRouteCandidates) > 0 {
+		for _, e := range m.HotRouteCandidates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HotRouteOverrides) > 0 {
+		for _, e := range m.HotRouteOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochStatistics) > 0 {
+		for _, e := range m.EpochStatistics {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProfitDistributions) > 0 {
+		for _, e := range m.ProfitDistributions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetainedProfits) > 0 {
+		for _, e := range m.RetainedProfits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -791,6 +978,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPromotedHotRoutes", wireType)
+			}
+			m.MaxPromotedHotRoutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPromotedHotRoutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRouteCandidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRouteCandidates = append(m.HotRouteCandidates, HotRouteCandidate{})
+			if err := m.HotRouteCandidates[len(m.HotRouteCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRouteOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRouteOverrides = append(m.HotRouteOverrides, HotRouteOverride{})
+			if err := m.HotRouteOverrides[len(m.HotRouteOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStatistics = append(m.EpochStatistics, EpochStatistics{})
+			if err := m.EpochStatistics[len(m.EpochStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitDistributions = append(m.ProfitDistributions, ProfitDistribution{})
+			if err := m.ProfitDistributions[len(m.ProfitDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedProfits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetainedProfits = append(m.RetainedProfits, types.Coin{})
+			if err := m.RetainedProfits[len(m.RetainedProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,54 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v17/x/protorev/types"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
+	hotRouteCandidate := types.HotRouteCandidate{
+		TokenIn:  "Atom",
+		TokenOut: "uosmo",
+		HotRoute: types.Route{
+			Trades: []types.Trade{
+				types.NewTrade(1, "Atom", "uosmo"),
+				types.NewTrade(0, "uosmo", "Atom"),
+			},
+			StepSize: sdk.NewInt(1_000_000),
+		},
+		LastEpochStatistics: types.RouteStatistics{
+			NumberOfTrades: sdk.ZeroInt(),
+			Route:          []uint64{1, 2},
+		},
+	}
+	epochStatistics := types.EpochStatistics{
+		EpochNumber:    1,
+		NumberOfTrades: sdk.OneInt(),
+		Profits:        sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))),
+		RouteStatistics: []types.RouteStatistics{
+			{
+				Profits:        sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))),
+				NumberOfTrades: sdk.OneInt(),
+				Route:          []uint64{1, 2},
+			},
+		},
+	}
+	profitDistribution := types.ProfitDistribution{
+		EpochNumber:     1,
+		Profits:         sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))),
+		ToStakers:       sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(50))),
+		ToCommunityPool: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(20))),
+		Burned:          sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(10))),
+		Retained:        sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(20))),
+	}
+	withGenesis := func(update func(genState *types.GenesisState)) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		update(genState)
+		return genState
+	}
+
 	cases := []struct {
 		description string
 		genState    *types.GenesisState
@@ -19,6 +61,123 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Hot route candidates and overrides",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.HotRouteCandidates = []types.HotRouteCandidate{hotRouteCandidate}
+				genState.HotRouteOverrides = []types.HotRouteOverride{{Route: []uint64{1, 2}, OverrideType: types.HotRouteOverrideBan}}
+			}),
+			valid: true,
+		},
+		{
+			description: "Too many max promoted hot routes",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.MaxPromotedHotRoutes = types.MaxPromotedHotRoutes + 1
+			}),
+			valid: false,
+		},
+		{
+			description: "Duplicate hot route candidates",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.HotRouteCandidates = []types.HotRouteCandidate{hotRouteCandidate, hotRouteCandidate}
+			}),
+			valid: false,
+		},
+		{
+			description: "Hot route candidate without a placeholder",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				candidate := hotRouteCandidate
+				candidate.HotRoute.Trades = []types.Trade{types.NewTrade(1, "Atom", "uosmo"), types.NewTrade(2, "uosmo", "Atom")}
+				genState.HotRouteCandidates = []types.HotRouteCandidate{candidate}
+			}),
+			valid: false,
+		},
+		{
+			description: "Hot route override without an override type",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.HotRouteOverrides = []types.HotRouteOverride{{Route: []uint64{1, 2}, OverrideType: types.HotRouteOverrideNone}}
+			}),
+			valid: false,
+		},
+		{
+			description: "Valid epoch statistics",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.EpochStatistics = []types.EpochStatistics{epochStatistics}
+			}),
+			valid: true,
+		},
+		{
+			description: "Duplicate epoch statistics",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.EpochStatistics = []types.EpochStatistics{epochStatistics, epochStatistics}
+			}),
+			valid: false,
+		},
+		{
+			description: "Epoch statistics with duplicate route statistics",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				stats := epochStatistics
+				stats.RouteStatistics = []types.RouteStatistics{epochStatistics.RouteStatistics[0], epochStatistics.RouteStatistics[0]}
+				genState.EpochStatistics = []types.EpochStatistics{stats}
+			}),
+			valid: false,
+		},
+		{
+			description: "Epoch statistics with a negative number of trades",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				stats := epochStatistics
+				stats.NumberOfTrades = sdk.NewInt(-1)
+				genState.EpochStatistics = []types.EpochStatistics{stats}
+			}),
+			valid: false,
+		},
+		{
+			description: "Valid profit distribution policy and distributions",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.Params.ProfitDistributionPolicy = types.ProfitDistributionPolicy{
+					StakerShare:        sdk.NewDecWithPrec(5, 1),
+					CommunityPoolShare: sdk.NewDecWithPrec(2, 1),
+					BurnShare:          sdk.NewDecWithPrec(1, 1),
+				}
+				genState.ProfitDistributions = []types.ProfitDistribution{profitDistribution}
+				genState.RetainedProfits = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(20)))
+			}),
+			valid: true,
+		},
+		{
+			description: "Profit distribution policy that distributes more than all of the profits",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.Params.ProfitDistributionPolicy = types.ProfitDistributionPolicy{
+					StakerShare:        sdk.NewDecWithPrec(5, 1),
+					CommunityPoolShare: sdk.NewDecWithPrec(5, 1),
+					BurnShare:          sdk.NewDecWithPrec(1, 1),
+				}
+			}),
+			valid: false,
+		},
+		{
+			description: "Profit distribution policy with a negative share",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.Params.ProfitDistributionPolicy.BurnShare = sdk.NewDec(-1)
+			}),
+			valid: false,
+		},
+		{
+			description: "Duplicate profit distributions",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				genState.ProfitDistributions = []types.ProfitDistribution{profitDistribution, profitDistribution}
+			}),
+			valid: false,
+		},
+		{
+			description: "Profit distribution with invalid profits",
+			genState: withGenesis(func(genState *types.GenesisState) {
+				distribution := profitDistribution
+				distribution.Profits = sdk.Coins{sdk.Coin{Denom: "uosmo", Amount: sdk.NewInt(-1)}}
+				genState.ProfitDistributions = []types.ProfitDistribution{distribution}
+			}),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -36,6 +36,14 @@ const (
 	prefixLatestBlockHeight
 	prefixPoolWeights
 	prefixSwapsToBackrun
+	prefixMaxPromotedHotRoutes
+	prefixHotRouteCandidates
+	prefixHotRouteOverrides
+	prefixEpochStatistics
+	prefixEpochRouteStatistics
+	prefixProfitDistributions
+	prefixRetainedProfits
+	prefixGraphSearchPaths
 )
 
 var (
@@ -49,6 +57,9 @@ var (
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
 
+	// KeyPrefixGraphSearchPaths is the prefix that is used to store the paths found by the graph search for a given denom pair (baseDenom, otherDenom)
+	KeyPrefixGraphSearchPaths = []byte{prefixGraphSearchPaths}
+
 	// -------------- Keys for statistics stores -------------- //
 	// KeyPrefixNumberOfTrades is the prefix for the store that keeps track of the number of trades executed
 	KeyPrefixNumberOfTrades = []byte{prefixNumberOfTrades}
@@ -89,6 +100,30 @@ var (
 
 	// KeyPrefixSwapsToBackrun is the prefix for store that keeps track of the swaps that need to be backrun for a given tx
 	KeyPrefixSwapsToBackrun = []byte{prefixSwapsToBackrun}
+
+	// -------------- Keys for hot route promotion stores -------------- //
+	// KeyPrefixMaxPromotedHotRoutes is the prefix for store that keeps track of the max number of routes that can be automatically promoted to hot routes
+	KeyPrefixMaxPromotedHotRoutes = []byte{prefixMaxPromotedHotRoutes}
+
+	// KeyPrefixHotRouteCandidates is the prefix for store that keeps track of the routes that can be automatically promoted to hot routes
+	KeyPrefixHotRouteCandidates = []byte{prefixHotRouteCandidates}
+
+	// KeyPrefixHotRouteOverrides is the prefix for store that keeps track of the routes pinned or banned by the admin account
+	KeyPrefixHotRouteOverrides = []byte{prefixHotRouteOverrides}
+
+	// -------------- Keys for epoch statistics stores -------------- //
+	// KeyPrefixEpochStatistics is the prefix for the store that keeps track of the number of trades executed and profits made by epoch
+	KeyPrefixEpochStatistics = []byte{prefixEpochStatistics}
+
+	// KeyPrefixEpochRouteStatistics is the prefix for the store that keeps track of the number of trades executed and profits made by route by epoch
+	KeyPrefixEpochRouteStatistics = []byte{prefixEpochRouteStatistics}
+
+	// -------------- Keys for profit distribution stores -------------- //
+	// KeyPrefixProfitDistributions is the prefix for the store that keeps track of the profit distributions by epoch
+	KeyPrefixProfitDistributions = []byte{prefixProfitDistributions}
+
+	// KeyPrefixRetainedProfits is the prefix for the store that keeps track of the profits kept in the module account by the profit distributions
+	KeyPrefixRetainedProfits = []byte{prefixRetainedProfits}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -96,6 +131,11 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key needed to fetch the graph search paths for a given denom pair
+func GetKeyPrefixGraphSearchPaths(baseDenom, matchDenom string) []byte {
+	return append(KeyPrefixGraphSearchPaths, []byte(baseDenom+"|"+matchDenom)...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func GetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
@@ -121,6 +161,41 @@ func GetKeyPrefixProfitsByRoute(route []uint64, denom string) []byte {
 	return append(append(KeyPrefixProfitsByRoute, CreateRouteKey(route)...), []byte(denom)...)
 }
 
+// Returns the key needed to fetch the hot route candidate by route
+func GetKeyPrefixHotRouteCandidate(route []uint64) []byte {
+	return append(KeyPrefixHotRouteCandidates, CreateRouteKey(route)...)
+}
+
+// Returns the key needed to fetch the hot route override by route
+func GetKeyPrefixHotRouteOverride(route []uint64) []byte {
+	return append(KeyPrefixHotRouteOverrides, CreateRouteKey(route)...)
+}
+
+// Returns the key needed to fetch the statistics of a given epoch
+func GetKeyPrefixEpochStatistics(epochNumber uint64) []byte {
+	return append(KeyPrefixEpochStatistics, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// Returns the key prefix needed to fetch the statistics of all of the routes traded on over a given epoch
+func GetKeyPrefixEpochRouteStatistics(epochNumber uint64) []byte {
+	return append(KeyPrefixEpochRouteStatistics, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// Returns the key needed to fetch the statistics of a given route over a given epoch
+func GetKeyPrefixEpochRouteStatistic(epochNumber uint64, route []uint64) []byte {
+	return append(GetKeyPrefixEpochRouteStatistics(epochNumber), CreateRouteKey(route)...)
+}
+
+// Returns the key needed to fetch the profit distribution of a given epoch
+func GetKeyPrefixProfitDistribution(epochNumber uint64) []byte {
+	return append(KeyPrefixProfitDistributions, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// Returns the key needed to fetch the retained profits by denom
+func GetKeyPrefixRetainedProfits(denom string) []byte {
+	return append(KeyPrefixRetainedProfits, []byte(denom)...)
+}
+
 // createRouteKey creates a key for the given route. converts a slice of uint64 to a string separated by a pipe
 // {1,2,3,4} -> []byte("1|2|3|4")
 func CreateRouteKey(route []uint64) []byte {
@@ -12,6 +12,8 @@ var (
 	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
 	_ sdk.Msg = &MsgSetPoolWeights{}
 	_ sdk.Msg = &MsgSetBaseDenoms{}
+	_ sdk.Msg = &MsgSetMaxPromotedHotRoutes{}
+	_ sdk.Msg = &MsgSetHotRouteOverride{}
 )
 
 const (
@@ -21,6 +23,8 @@ const (
 	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
 	TypeMsgSetPoolWeights           = "set_pool_weights"
 	TypeMsgSetBaseDenoms            = "set_base_denoms"
+	TypeMsgSetMaxPromotedHotRoutes  = "set_max_promoted_hot_routes"
+	TypeMsgSetHotRouteOverride      = "set_hot_route_override"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -293,3 +297,93 @@ func (msg MsgSetBaseDenoms) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetMaxPromotedHotRoutes ---------------------- //
+// NewMsgSetMaxPromotedHotRoutes creates a new MsgSetMaxPromotedHotRoutes instance
+func NewMsgSetMaxPromotedHotRoutes(admin string, maxPromotedHotRoutes uint64) *MsgSetMaxPromotedHotRoutes {
+	return &MsgSetMaxPromotedHotRoutes{
+		Admin:                admin,
+		MaxPromotedHotRoutes: maxPromotedHotRoutes,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetMaxPromotedHotRoutes) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetMaxPromotedHotRoutes) Type() string {
+	return TypeMsgSetMaxPromotedHotRoutes
+}
+
+// ValidateBasic validates the MsgSetMaxPromotedHotRoutes
+func (msg MsgSetMaxPromotedHotRoutes) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	// Max promoted hot routes must be in the valid range
+	if err := ValidateMaxPromotedHotRoutes(msg.MaxPromotedHotRoutes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMaxPromotedHotRoutes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMaxPromotedHotRoutes) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgSetHotRouteOverride ---------------------- //
+// NewMsgSetHotRouteOverride creates a new MsgSetHotRouteOverride instance
+func NewMsgSetHotRouteOverride(admin string, hotRouteOverride HotRouteOverride) *MsgSetHotRouteOverride {
+	return &MsgSetHotRouteOverride{
+		Admin:            admin,
+		HotRouteOverride: hotRouteOverride,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetHotRouteOverride) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetHotRouteOverride) Type() string {
+	return TypeMsgSetHotRouteOverride
+}
+
+// ValidateBasic validates the MsgSetHotRouteOverride
+func (msg MsgSetHotRouteOverride) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	// Validate the hot route override
+	if err := msg.HotRouteOverride.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetHotRouteOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetHotRouteOverride) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -14,9 +15,22 @@ var (
 	// Note that governance has full ability to change this live on-chain, and this admin can at most prevent protorev from working.
 	// All the settings manager's controls have limits, so it can't lead to a chain halt, excess processing time or prevention of swaps.
 	DefaultAdminAccount = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
+	// The profits of protorev are not distributed until governance sets the profit distribution policy.
+	DefaultProfitDistributionPolicy = ProfitDistributionPolicy{
+		StakerShare:        sdk.ZeroDec(),
+		CommunityPoolShare: sdk.ZeroDec(),
+		BurnShare:          sdk.ZeroDec(),
+	}
+	// The profits swapped to OSMO are priced with a 5 minute geometric twap.
+	DefaultProfitSwapTwapWindow = 5 * time.Minute
+	// The profits can be swapped to OSMO for at most 5% less than their twap value.
+	DefaultMaxProfitSwapSlippage = sdk.NewDecWithPrec(5, 2)
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	ParamStoreKeyEnableModule             = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount             = []byte("AdminAccount")
+	ParamStoreKeyProfitDistributionPolicy = []byte("ProfitDistributionPolicy")
+	ParamStoreKeyProfitSwapTwapWindow     = []byte("ProfitSwapTwapWindow")
+	ParamStoreKeyMaxProfitSwapSlippage    = []byte("MaxProfitSwapSlippage")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -25,16 +39,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, profitDistributionPolicy ProfitDistributionPolicy, profitSwapTwapWindow time.Duration, maxProfitSwapSlippage sdk.Dec) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:                  enable,
+		Admin:                    admin,
+		ProfitDistributionPolicy: profitDistributionPolicy,
+		ProfitSwapTwapWindow:     profitSwapTwapWindow,
+		MaxProfitSwapSlippage:    maxProfitSwapSlippage,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultProfitDistributionPolicy, DefaultProfitSwapTwapWindow, DefaultMaxProfitSwapSlippage)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -42,6 +59,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitDistributionPolicy, &p.ProfitDistributionPolicy, ValidateProfitDistributionPolicy),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitSwapTwapWindow, &p.ProfitSwapTwapWindow, ValidateProfitSwapTwapWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxProfitSwapSlippage, &p.MaxProfitSwapSlippage, ValidateMaxProfitSwapSlippage),
 	}
 }
 
@@ -51,7 +71,15 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
-	return nil
+	if err := p.ProfitDistributionPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateProfitSwapTwapWindow(p.ProfitSwapTwapWindow); err != nil {
+		return err
+	}
+
+	return ValidateMaxProfitSwapSlippage(p.MaxProfitSwapSlippage)
 }
 
 func ValidateAccount(i interface{}) error {
@@ -74,3 +102,61 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+func ValidateProfitDistributionPolicy(i interface{}) error {
+	v, ok := i.(ProfitDistributionPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func ValidateProfitSwapTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("profit swap twap window must be positive, got %s", v)
+	}
+
+	return nil
+}
+
+func ValidateMaxProfitSwapSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max profit swap slippage must be between 0 and 1, got %s", v)
+	}
+
+	return nil
+}
+
+// Validate validates that each share of the profit distribution policy is between 0 and 1, and that
+// the shares add up to at most 1.
+func (policy ProfitDistributionPolicy) Validate() error {
+	totalShare := sdk.ZeroDec()
+	for _, share := range []sdk.Dec{policy.StakerShare, policy.CommunityPoolShare, policy.BurnShare} {
+		if share.IsNil() || share.IsNegative() || share.GT(sdk.OneDec()) {
+			return fmt.Errorf("profit distribution shares must be between 0 and 1, got %s", share)
+		}
+		totalShare = totalShare.Add(share)
+	}
+
+	if totalShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("profit distribution shares must add up to at most 1, got %s", totalShare)
+	}
+
+	return nil
+}
+
+// IsEnabled returns whether any of the profits are distributed by the policy.
+func (policy ProfitDistributionPolicy) IsEnabled() bool {
+	return policy.StakerShare.IsPositive() || policy.CommunityPoolShare.IsPositive() || policy.BurnShare.IsPositive()
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +35,17 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The policy used to distribute the profits of the protorev module every day
+	// epoch.
+	ProfitDistributionPolicy ProfitDistributionPolicy `protobuf:"bytes,3,opt,name=profit_distribution_policy,json=profitDistributionPolicy,proto3" json:"profit_distribution_policy" yaml:"profit_distribution_policy"`
+	// profit_swap_twap_window is the time window of the geometric twap used to
+	// price the profits that are swapped to OSMO by the profit distribution.
+	ProfitSwapTwapWindow time.Duration `protobuf:"bytes,4,opt,name=profit_swap_twap_window,json=profitSwapTwapWindow,proto3,stdduration" json:"profit_swap_twap_window" yaml:"profit_swap_twap_window"`
+	// max_profit_swap_slippage is the largest fraction of the twap value of the
+	// profits that can be lost when swapping them to OSMO at the end of a day
+	// epoch. Profits that cannot be swapped within this bound are kept until the
+	// next epoch.
+	MaxProfitSwapSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_profit_swap_slippage,json=maxProfitSwapSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_profit_swap_slippage" yaml:"max_profit_swap_slippage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +95,72 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetProfitDistributionPolicy() ProfitDistributionPolicy {
+	if m != nil {
+		return m.ProfitDistributionPolicy
+	}
+	return ProfitDistributionPolicy{}
+}
+
+func (m *Params) GetProfitSwapTwapWindow() time.Duration {
+	if m != nil {
+		return m.ProfitSwapTwapWindow
+	}
+	return 0
+}
+
+// ProfitDistributionPolicy defines the shares of the profits of the protorev
+// module that are distributed every day epoch. The rest of the profits is
+// swapped to OSMO and kept in the module account. Setting all of the shares to
+// zero disables the distribution.
+type ProfitDistributionPolicy struct {
+	// staker_share is the share of the profits sent to the fee collector to be
+	// distributed to stakers.
+	StakerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staker_share,json=stakerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staker_share" yaml:"staker_share"`
+	// community_pool_share is the share of the profits sent to the community
+	// pool.
+	CommunityPoolShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_share" yaml:"community_pool_share"`
+	// burn_share is the share of the profits burned. Profits in other denoms
+	// than OSMO are used to buy back OSMO, which is burned.
+	BurnShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn_share,json=burnShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_share" yaml:"burn_share"`
+}
+
+func (m *ProfitDistributionPolicy) Reset()         { *m = ProfitDistributionPolicy{} }
+func (m *ProfitDistributionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ProfitDistributionPolicy) ProtoMessage()    {}
+func (*ProfitDistributionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72168e5a5a65ae7e, []int{1}
+}
+func (m *ProfitDistributionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitDistributionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitDistributionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitDistributionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitDistributionPolicy.Merge(m, src)
+}
+func (m *ProfitDistributionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitDistributionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitDistributionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitDistributionPolicy proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
+	proto.RegisterType((*ProfitDistributionPolicy)(nil), "osmosis.protorev.v1beta1.ProfitDistributionPolicy")
 }
 
 func init() {
@@ -88,22 +168,42 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x0c, 0xcd, 0xf5, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xf0, 0x2b, 0x62, 0xcf, 0x2a, 0x01, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xfd, 0xa5, 0x9d, 0x5d, 0x44, 0x63, 0xc5, 0x6c, 0x85, 0xa4, 0x06, 0x5c, 0xaa,
+	0xb8, 0x09, 0x5d, 0x05, 0xc1, 0x63, 0xac, 0x47, 0xa5, 0xa6, 0x82, 0xe0, 0x25, 0x4c, 0x9a, 0xd9,
+	0x74, 0xd8, 0x24, 0x33, 0x64, 0x26, 0xfd, 0x01, 0x82, 0x47, 0xc1, 0x93, 0x47, 0xc1, 0x7f, 0x68,
+	0x8f, 0x7b, 0x14, 0x0f, 0x51, 0xda, 0x93, 0xd7, 0xfe, 0x05, 0x92, 0x99, 0xe9, 0x6e, 0x15, 0x7b,
+	0xe8, 0xa5, 0xcd, 0x7b, 0xdf, 0xf7, 0xde, 0xe7, 0x9b, 0x97, 0x49, 0xc0, 0x03, 0xc2, 0x52, 0xc2,
+	0x30, 0x73, 0x69, 0x4e, 0x38, 0xc9, 0xd1, 0xc8, 0x1d, 0x75, 0x42, 0xc4, 0x61, 0xc7, 0xa5, 0x30,
+	0x87, 0x29, 0x73, 0x44, 0x5e, 0x37, 0x54, 0x99, 0xb3, 0x2c, 0x73, 0x54, 0x59, 0xb3, 0x11, 0x93,
+	0x98, 0x88, 0xac, 0x5b, 0x5d, 0xc9, 0x82, 0xe6, 0xe1, 0x40, 0x34, 0x04, 0x52, 0x90, 0x81, 0x92,
+	0xcc, 0x98, 0x90, 0x38, 0x41, 0x12, 0x18, 0x16, 0xa7, 0x6e, 0x54, 0xe4, 0x90, 0x63, 0x92, 0x49,
+	0xdd, 0xfe, 0xb4, 0x03, 0xf6, 0x7a, 0x82, 0xad, 0x3f, 0x06, 0xd7, 0x50, 0x06, 0xc3, 0x04, 0x45,
+	0x86, 0xd6, 0xd2, 0xda, 0xd7, 0x3d, 0x7d, 0x51, 0x5a, 0x37, 0xa6, 0x30, 0x4d, 0x9e, 0xdb, 0x4a,
+	0xb0, 0xfd, 0x65, 0x89, 0x7e, 0x04, 0x76, 0x61, 0x94, 0xe2, 0xcc, 0xd8, 0x6a, 0x69, 0xed, 0xba,
+	0x77, 0x73, 0x51, 0x5a, 0x07, 0xb2, 0x56, 0xa4, 0x6d, 0x5f, 0xca, 0xfa, 0x37, 0x0d, 0x34, 0x69,
+	0x4e, 0x4e, 0x31, 0x0f, 0x22, 0xcc, 0x78, 0x8e, 0xc3, 0xa2, 0xc2, 0x07, 0x94, 0x24, 0x78, 0x30,
+	0x35, 0xb6, 0x5b, 0x5a, 0x7b, 0xff, 0xe4, 0xc4, 0x59, 0x77, 0xc7, 0x4e, 0x4f, 0xf4, 0x76, 0x57,
+	0x5a, 0x7b, 0xa2, 0xd3, 0x7b, 0x78, 0x5e, 0x5a, 0xb5, 0x45, 0x69, 0xdd, 0x97, 0xd4, 0xf5, 0x0c,
+	0xdb, 0x37, 0xe8, 0x9a, 0x21, 0xfa, 0x07, 0x70, 0x57, 0x35, 0xb2, 0x31, 0xa4, 0x01, 0xaf, 0x7e,
+	0xc6, 0x38, 0x8b, 0xc8, 0xd8, 0xd8, 0x11, 0xce, 0x0e, 0x1d, 0xb9, 0x40, 0x67, 0xb9, 0x40, 0xa7,
+	0xab, 0x16, 0xe8, 0x3d, 0x52, 0x06, 0xcc, 0xbf, 0x0c, 0xfc, 0x3b, 0xc7, 0xfe, 0xfa, 0xd3, 0xd2,
+	0xfc, 0x86, 0x54, 0xfb, 0x63, 0x48, 0xdf, 0x8e, 0x21, 0x7d, 0x27, 0x24, 0xfd, 0xb3, 0x06, 0x8c,
+	0x14, 0x4e, 0x82, 0xd5, 0x56, 0x96, 0x60, 0x4a, 0x61, 0x8c, 0x8c, 0x5d, 0xb1, 0xd7, 0x37, 0x15,
+	0xe4, 0x47, 0x69, 0x1d, 0xc5, 0x98, 0x0f, 0x8b, 0xd0, 0x19, 0x90, 0x54, 0x3d, 0x60, 0xf5, 0x77,
+	0xcc, 0xa2, 0x33, 0x97, 0x4f, 0x29, 0x62, 0x4e, 0x17, 0x0d, 0x16, 0xa5, 0x65, 0x49, 0x3b, 0xeb,
+	0xe6, 0xda, 0xfe, 0x9d, 0x14, 0x4e, 0x7a, 0x97, 0x76, 0xfa, 0xcb, 0xfc, 0xef, 0x2d, 0x60, 0xac,
+	0x5b, 0xb6, 0x3e, 0x04, 0x07, 0x8c, 0xc3, 0x33, 0x94, 0x07, 0x6c, 0x08, 0x73, 0x24, 0x0e, 0x48,
+	0xdd, 0x7b, 0xb9, 0xb1, 0xb9, 0xdb, 0xd2, 0xdc, 0xea, 0x2c, 0xdb, 0xdf, 0x97, 0x61, 0xbf, 0x8a,
+	0xf4, 0x8f, 0xa0, 0x31, 0x20, 0x69, 0x5a, 0x64, 0x98, 0x4f, 0x03, 0x4a, 0x48, 0xa2, 0x88, 0xf2,
+	0x98, 0xbd, 0xda, 0x98, 0x78, 0x4f, 0x12, 0xff, 0x37, 0xd3, 0xf6, 0xf5, 0xcb, 0x74, 0x8f, 0x90,
+	0x44, 0x1a, 0x08, 0x01, 0x08, 0x8b, 0x3c, 0x53, 0xd8, 0x6d, 0x81, 0x7d, 0xb1, 0x31, 0xf6, 0x96,
+	0xc4, 0x5e, 0x4d, 0xb2, 0xfd, 0x7a, 0x15, 0x08, 0x86, 0xf7, 0xfa, 0x7c, 0x66, 0x6a, 0x17, 0x33,
+	0x53, 0xfb, 0x35, 0x33, 0xb5, 0x2f, 0x73, 0xb3, 0x76, 0x31, 0x37, 0x6b, 0xdf, 0xe7, 0x66, 0xed,
+	0xfd, 0xd3, 0x15, 0x82, 0x7a, 0x27, 0x8e, 0x13, 0x18, 0xb2, 0x65, 0xe0, 0x8e, 0x3a, 0xcf, 0xdc,
+	0xc9, 0xd5, 0xf7, 0x43, 0x30, 0xc3, 0x3d, 0x11, 0x3f, 0xf9, 0x33, 0x00, 0x9b, 0x8c, 0x26, 0x68,
+	0x60, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +226,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxProfitSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxProfitSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProfitSwapTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProfitSwapTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProfitDistributionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -146,6 +274,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfitDistributionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitDistributionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitDistributionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnShare.Size()
+		i -= size
+		if _, err := m.BurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakerShare.Size()
+		i -= size
+		if _, err := m.StakerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -170,6 +351,27 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = m.ProfitDistributionPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProfitSwapTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxProfitSwapSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ProfitDistributionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BurnShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -260,6 +462,258 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitDistributionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitSwapTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ProfitSwapTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProfitSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxProfitSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitDistributionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitDistributionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitDistributionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HotRouteOverrideType is how the admin account overrides the automatic
+// promotion of a route to a hot route.
+type HotRouteOverrideType int32
+
+const (
+	// HotRouteOverrideNone leaves the route to be promoted and demoted by its
+	// statistics.
+	HotRouteOverrideNone HotRouteOverrideType = 0
+	// HotRouteOverridePin keeps the route promoted regardless of its statistics.
+	HotRouteOverridePin HotRouteOverrideType = 1
+	// HotRouteOverrideBan keeps the route from being promoted.
+	HotRouteOverrideBan HotRouteOverrideType = 2
+)
+
+var HotRouteOverrideType_name = map[int32]string{
+	0: "HotRouteOverrideNone",
+	1: "HotRouteOverridePin",
+	2: "HotRouteOverrideBan",
+}
+
+var HotRouteOverrideType_value = map[string]int32{
+	"HotRouteOverrideNone": 0,
+	"HotRouteOverridePin":  1,
+	"HotRouteOverrideBan":  2,
+}
+
+func (x HotRouteOverrideType) String() string {
+	return proto.EnumName(HotRouteOverrideType_name, int32(x))
+}
+
+func (HotRouteOverrideType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{0}
+}
+
 // TokenPairArbRoutes tracks all of the hot routes for a given pair of tokens
 type TokenPairArbRoutes struct {
 	// Stores all of the possible hot paths for a given pair of tokens
@@ -382,13 +420,363 @@ func (m *BaseDenom) GetDenom() string {
 	return ""
 }
 
+// HotRouteCandidate is a route the module has executed a trade on, which can be
+// automatically promoted to a hot route. Candidates are ranked every epoch by
+// the statistics of their route over the epoch.
+type HotRouteCandidate struct {
+	// token_in is the token in denom of the swap that was backrun, and the token
+	// in denom of the token pair the route is promoted as a hot route for
+	TokenIn string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// token_out is the token out denom of the swap that was backrun, and the
+	// token out denom of the token pair the route is promoted as a hot route for
+	TokenOut string `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+	// hot_route is the route with a placeholder (pool id 0) for the pool of the
+	// swap that was backrun
+	HotRoute Route `protobuf:"bytes,3,opt,name=hot_route,json=hotRoute,proto3" json:"hot_route" yaml:"hot_route"`
+	// last_epoch_statistics are the statistics of the route (pool ids along the
+	// arbitrage route) as of the end of the last epoch
+	LastEpochStatistics RouteStatistics `protobuf:"bytes,4,opt,name=last_epoch_statistics,json=lastEpochStatistics,proto3" json:"last_epoch_statistics" yaml:"last_epoch_statistics"`
+	// promoted is whether the route was promoted to a hot route by the module
+	Promoted bool `protobuf:"varint,5,opt,name=promoted,proto3" json:"promoted,omitempty" yaml:"promoted"`
+	// stale_epochs is the number of consecutive epochs without a trade on the
+	// route
+	StaleEpochs uint64 `protobuf:"varint,6,opt,name=stale_epochs,json=staleEpochs,proto3" json:"stale_epochs,omitempty" yaml:"stale_epochs"`
+}
+
+func (m *HotRouteCandidate) Reset()         { *m = HotRouteCandidate{} }
+func (m *HotRouteCandidate) String() string { return proto.CompactTextString(m) }
+func (*HotRouteCandidate) ProtoMessage()    {}
+func (*HotRouteCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{6}
+}
+func (m *HotRouteCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotRouteCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotRouteCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotRouteCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotRouteCandidate.Merge(m, src)
+}
+func (m *HotRouteCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotRouteCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotRouteCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotRouteCandidate proto.InternalMessageInfo
+
+func (m *HotRouteCandidate) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *HotRouteCandidate) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+func (m *HotRouteCandidate) GetHotRoute() Route {
+	if m != nil {
+		return m.HotRoute
+	}
+	return Route{}
+}
+
+func (m *HotRouteCandidate) GetLastEpochStatistics() RouteStatistics {
+	if m != nil {
+		return m.LastEpochStatistics
+	}
+	return RouteStatistics{}
+}
+
+func (m *HotRouteCandidate) GetPromoted() bool {
+	if m != nil {
+		return m.Promoted
+	}
+	return false
+}
+
+func (m *HotRouteCandidate) GetStaleEpochs() uint64 {
+	if m != nil {
+		return m.StaleEpochs
+	}
+	return 0
+}
+
+// HotRouteOverride is an override set by the admin account on the automatic
+// promotion of a route (pool ids along the arbitrage route) to a hot route.
+type HotRouteOverride struct {
+	// route is the pool ids along the arbitrage route
+	Route []uint64 `protobuf:"varint,1,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// override_type is how the promotion of the route is overridden
+	OverrideType HotRouteOverrideType `protobuf:"varint,2,opt,name=override_type,json=overrideType,proto3,enum=osmosis.protorev.v1beta1.HotRouteOverrideType" json:"override_type,omitempty" yaml:"override_type"`
+}
+
+func (m *HotRouteOverride) Reset()         { *m = HotRouteOverride{} }
+func (m *HotRouteOverride) String() string { return proto.CompactTextString(m) }
+func (*HotRouteOverride) ProtoMessage()    {}
+func (*HotRouteOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{7}
+}
+func (m *HotRouteOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotRouteOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotRouteOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotRouteOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotRouteOverride.Merge(m, src)
+}
+func (m *HotRouteOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotRouteOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotRouteOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotRouteOverride proto.InternalMessageInfo
+
+func (m *HotRouteOverride) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *HotRouteOverride) GetOverrideType() HotRouteOverrideType {
+	if m != nil {
+		return m.OverrideType
+	}
+	return HotRouteOverrideNone
+}
+
+// EpochStatistics contains the number of trades the module has executed and
+// the profits from the trades over a single day epoch
+type EpochStatistics struct {
+	// epoch_number is the number of the day epoch the statistics were recorded in
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// start_time is the time at which the epoch started
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// start_height is the block height at which the epoch started
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// number_of_trades is the number of trades the module has executed over the
+	// epoch
+	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
+	// profits is the profit from all trades over the epoch by denom
+	Profits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=profits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"profits" yaml:"profits"`
+	// route_statistics is the number of trades and profits over the epoch by
+	// route
+	RouteStatistics []RouteStatistics `protobuf:"bytes,6,rep,name=route_statistics,json=routeStatistics,proto3" json:"route_statistics" yaml:"route_statistics"`
+}
+
+func (m *EpochStatistics) Reset()         { *m = EpochStatistics{} }
+func (m *EpochStatistics) String() string { return proto.CompactTextString(m) }
+func (*EpochStatistics) ProtoMessage()    {}
+func (*EpochStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{8}
+}
+func (m *EpochStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochStatistics.Merge(m, src)
+}
+func (m *EpochStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochStatistics proto.InternalMessageInfo
+
+func (m *EpochStatistics) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochStatistics) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochStatistics) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochStatistics) GetProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Profits
+	}
+	return nil
+}
+
+func (m *EpochStatistics) GetRouteStatistics() []RouteStatistics {
+	if m != nil {
+		return m.RouteStatistics
+	}
+	return nil
+}
+
+// ProfitDistribution contains the profits of the module distributed at the end
+// of a day epoch and where they were distributed to
+type ProfitDistribution struct {
+	// epoch_number is the number of the day epoch at the end of which the
+	// profits were distributed
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// time is the time at which the profits were distributed
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// profits is the profits that were distributed
+	Profits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=profits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"profits" yaml:"profits"`
+	// to_stakers is the profits sent to the fee collector
+	ToStakers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=to_stakers,json=toStakers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_stakers" yaml:"to_stakers"`
+	// to_community_pool is the profits sent to the community pool
+	ToCommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=to_community_pool,json=toCommunityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_community_pool" yaml:"to_community_pool"`
+	// burned is the OSMO bought back and burned, and the profits burned
+	// directly if they could not be swapped to OSMO
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned" yaml:"burned"`
+	// retained is the rest of the profits, swapped to OSMO if possible, that
+	// is kept in the module account
+	Retained github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=retained,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"retained" yaml:"retained"`
+}
+
+func (m *ProfitDistribution) Reset()         { *m = ProfitDistribution{} }
+func (m *ProfitDistribution) String() string { return proto.CompactTextString(m) }
+func (*ProfitDistribution) ProtoMessage()    {}
+func (*ProfitDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{9}
+}
+func (m *ProfitDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitDistribution.Merge(m, src)
+}
+func (m *ProfitDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitDistribution proto.InternalMessageInfo
+
+func (m *ProfitDistribution) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *ProfitDistribution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ProfitDistribution) GetProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Profits
+	}
+	return nil
+}
+
+func (m *ProfitDistribution) GetToStakers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToStakers
+	}
+	return nil
+}
+
+func (m *ProfitDistribution) GetToCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToCommunityPool
+	}
+	return nil
+}
+
+func (m *ProfitDistribution) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *ProfitDistribution) GetRetained() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Retained
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.protorev.v1beta1.HotRouteOverrideType", HotRouteOverrideType_name, HotRouteOverrideType_value)
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
 	proto.RegisterType((*RouteStatistics)(nil), "osmosis.protorev.v1beta1.RouteStatistics")
 	proto.RegisterType((*PoolWeights)(nil), "osmosis.protorev.v1beta1.PoolWeights")
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
+	proto.RegisterType((*HotRouteCandidate)(nil), "osmosis.protorev.v1beta1.HotRouteCandidate")
+	proto.RegisterType((*HotRouteOverride)(nil), "osmosis.protorev.v1beta1.HotRouteOverride")
+	proto.RegisterType((*EpochStatistics)(nil), "osmosis.protorev.v1beta1.EpochStatistics")
+	proto.RegisterType((*ProfitDistribution)(nil), "osmosis.protorev.v1beta1.ProfitDistribution")
 }
 
 func init() {
@@ -396,49 +784,83 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x35, 0xe9, 0x8f, 0x5c, 0x7f, 0xa4, 0xb8, 0xa5, 0xb8, 0x19, 0xec, 0xe8, 0x90, 0x4a,
-	0x96, 0xda, 0x0a, 0x20, 0x21, 0x55, 0x62, 0xc0, 0x65, 0xa9, 0x90, 0xda, 0xea, 0x5a, 0x09, 0xc1,
-	0x62, 0x9d, 0x9d, 0x6b, 0x6a, 0x35, 0xf1, 0x45, 0xbe, 0x4b, 0xa1, 0xfd, 0x2b, 0x18, 0x60, 0xe7,
-	0x2f, 0x61, 0xee, 0xd8, 0xb1, 0x62, 0xb0, 0x50, 0xba, 0x30, 0x7b, 0x65, 0x41, 0xbe, 0x3b, 0x3b,
-	0x51, 0x05, 0x12, 0x0c, 0x30, 0xf9, 0xbd, 0xef, 0xbd, 0xef, 0x7b, 0xf7, 0xde, 0xbb, 0x33, 0x7c,
-	0xc4, 0xf8, 0x80, 0xf1, 0x88, 0xbb, 0xc3, 0x84, 0x09, 0x96, 0xd0, 0x73, 0xf7, 0xbc, 0x13, 0x50,
-	0x41, 0x3a, 0x25, 0xe0, 0x48, 0xc3, 0x30, 0x75, 0xa2, 0x53, 0xe2, 0x3a, 0xb1, 0xb9, 0x19, 0xca,
-	0x90, 0x2f, 0x03, 0xae, 0x72, 0x54, 0x56, 0x73, 0xbd, 0xc7, 0x7a, 0x4c, 0xe1, 0xb9, 0xa5, 0x51,
-	0x4b, 0xe5, 0xb8, 0x01, 0xe1, 0xb4, 0x2c, 0x17, 0xb2, 0x28, 0x56, 0x71, 0x74, 0x03, 0xa0, 0x71,
-	0xcc, 0xce, 0x68, 0x7c, 0x48, 0xa2, 0xe4, 0x45, 0x12, 0x60, 0x36, 0x12, 0x94, 0x1b, 0x6f, 0x20,
-	0x24, 0x49, 0xe0, 0x27, 0xd2, 0x33, 0x41, 0xab, 0xda, 0x5e, 0x7c, 0x6c, 0x3b, 0xbf, 0x3b, 0x96,
-	0x23, 0x59, 0xde, 0xe6, 0x55, 0x6a, 0x57, 0xb2, 0xd4, 0xbe, 0x77, 0x41, 0x06, 0xfd, 0x1d, 0x34,
-	0x11, 0x40, 0xb8, 0x4e, 0x4a, 0x69, 0x07, 0x2e, 0x88, 0xbc, 0xa0, 0x1f, 0xc5, 0xe6, 0x4c, 0x0b,
-	0xb4, 0xeb, 0xde, 0x5a, 0x96, 0xda, 0x0d, 0xc5, 0x29, 0x22, 0x08, 0xcf, 0x4b, 0x73, 0x2f, 0x36,
-	0x3a, 0xb0, 0xae, 0x50, 0x36, 0x12, 0x66, 0x55, 0x12, 0xd6, 0xb3, 0xd4, 0x5e, 0x9d, 0x26, 0xb0,
-	0x91, 0x40, 0x58, 0xc9, 0x1e, 0x8c, 0xc4, 0x4e, 0xed, 0xfb, 0x67, 0x1b, 0xa0, 0x2f, 0x00, 0xce,
-	0xca, 0x9a, 0xc6, 0x3e, 0x9c, 0x13, 0x09, 0xe9, 0xfe, 0x49, 0x27, 0xc7, 0x79, 0x9e, 0x77, 0x5f,
-	0x77, 0xb2, 0xac, 0x8b, 0x48, 0x32, 0xc2, 0x5a, 0xc5, 0xf0, 0x61, 0x9d, 0x0b, 0x3a, 0xf4, 0x79,
-	0x74, 0x49, 0x75, 0x0f, 0x5e, 0xce, 0xf8, 0x9a, 0xda, 0x5b, 0xbd, 0x48, 0x9c, 0x8e, 0x02, 0x27,
-	0x64, 0x03, 0xbd, 0x1e, 0xfd, 0xd9, 0xe6, 0xdd, 0x33, 0x57, 0x5c, 0x0c, 0x29, 0x77, 0xf6, 0x62,
-	0x31, 0x69, 0xa0, 0x14, 0x42, 0x78, 0x21, 0xb7, 0x8f, 0xa2, 0x4b, 0xaa, 0x1b, 0xf8, 0x04, 0xe0,
-	0xac, 0x3c, 0x8f, 0xf1, 0x10, 0xd6, 0x86, 0x8c, 0xf5, 0x4d, 0xd0, 0x02, 0xed, 0x9a, 0xd7, 0xc8,
-	0x52, 0x7b, 0x51, 0xb1, 0x73, 0x14, 0x61, 0x19, 0xfc, 0x7f, 0x83, 0xfd, 0x01, 0x60, 0x43, 0x0e,
-	0xf6, 0x48, 0x10, 0x11, 0x71, 0x11, 0x85, 0xdc, 0x78, 0x05, 0xe7, 0x87, 0x09, 0x3b, 0x89, 0x44,
-	0x31, 0xe3, 0x4d, 0x47, 0xdf, 0xce, 0xfc, 0xe6, 0x95, 0xe3, 0xdd, 0x65, 0x51, 0xec, 0x6d, 0xe8,
-	0xe9, 0xae, 0xe8, 0x1e, 0x14, 0x0f, 0xe1, 0x42, 0xc1, 0xe0, 0x70, 0x35, 0x1e, 0x0d, 0x02, 0x9a,
-	0xf8, 0xec, 0xc4, 0xd7, 0x9b, 0x53, 0x1d, 0xed, 0xfd, 0xf5, 0x98, 0x1f, 0xa8, 0x22, 0x77, 0xf5,
-	0x10, 0x5e, 0x51, 0xd0, 0xc1, 0xc9, 0xb1, 0x5a, 0xea, 0x16, 0x9c, 0x95, 0xb7, 0xd5, 0xac, 0xb6,
-	0xaa, 0xed, 0x9a, 0xb7, 0x9a, 0xa5, 0xf6, 0x92, 0xe2, 0x4a, 0x18, 0x61, 0x15, 0x46, 0x63, 0x00,
-	0x17, 0x0f, 0x19, 0xeb, 0xbf, 0xa6, 0x51, 0xef, 0x54, 0x70, 0xe3, 0x39, 0x5c, 0xe6, 0x82, 0x04,
-	0x7d, 0xea, 0xbf, 0x93, 0x88, 0x5e, 0x92, 0x99, 0xa5, 0xf6, 0x7a, 0xb1, 0xe2, 0xa9, 0x30, 0xc2,
-	0x4b, 0xca, 0x57, 0x7c, 0x63, 0x17, 0x36, 0x02, 0xd2, 0x27, 0x71, 0x48, 0x93, 0x42, 0x60, 0x46,
-	0x0a, 0x34, 0xb3, 0xd4, 0xde, 0x50, 0x02, 0x77, 0x12, 0x10, 0x5e, 0x29, 0x10, 0x2d, 0x72, 0x00,
-	0xd7, 0x42, 0x16, 0x87, 0x34, 0x16, 0x09, 0x11, 0xb4, 0x5b, 0x08, 0x55, 0xa5, 0x90, 0x95, 0xa5,
-	0x76, 0x53, 0x09, 0xfd, 0x22, 0x09, 0x61, 0x63, 0x1a, 0x55, 0x82, 0xe8, 0x23, 0x80, 0x75, 0x8f,
-	0x70, 0xfa, 0x92, 0xc6, 0x6c, 0x90, 0x8f, 0xa6, 0x9b, 0x1b, 0xb2, 0xb5, 0xfa, 0xf4, 0x68, 0x24,
-	0x8c, 0xb0, 0x0a, 0xff, 0xf3, 0x77, 0xe1, 0xed, 0x5f, 0x8d, 0x2d, 0x70, 0x3d, 0xb6, 0xc0, 0xb7,
-	0xb1, 0x05, 0x3e, 0xdc, 0x5a, 0x95, 0xeb, 0x5b, 0xab, 0x72, 0x73, 0x6b, 0x55, 0xde, 0x3e, 0x9d,
-	0xd2, 0xd7, 0x8f, 0x7b, 0xbb, 0x4f, 0x02, 0x5e, 0x38, 0xee, 0x79, 0xe7, 0x99, 0xfb, 0x7e, 0xf2,
-	0xe7, 0x95, 0x15, 0x83, 0x39, 0xe9, 0x3f, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0x83, 0x56, 0xe1,
-	0xea, 0x9a, 0x05, 0x00, 0x00,
+	// 1212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xbf, 0x6f, 0x23, 0xc5,
+	0x17, 0xf7, 0xc4, 0x8e, 0xcf, 0x1e, 0x27, 0xb1, 0x33, 0xc9, 0x5d, 0x36, 0xd6, 0xf7, 0xeb, 0xb5,
+	0x06, 0x74, 0x18, 0xa4, 0x5b, 0x2b, 0x01, 0x09, 0x29, 0x12, 0xc5, 0x6d, 0x2e, 0xe2, 0x22, 0x50,
+	0x12, 0x6d, 0x22, 0x7e, 0x35, 0xab, 0x5d, 0x7b, 0x62, 0xaf, 0x62, 0xef, 0x58, 0x3b, 0xe3, 0x1c,
+	0x49, 0x43, 0x41, 0x43, 0x79, 0x12, 0x50, 0xd1, 0x20, 0x51, 0xc1, 0x1f, 0x81, 0x44, 0x97, 0xf2,
+	0xca, 0x13, 0x85, 0x0f, 0x25, 0x0d, 0xb5, 0x5b, 0x1a, 0xb4, 0x33, 0xb3, 0xeb, 0xb5, 0xf1, 0xe1,
+	0x58, 0xe8, 0xae, 0xf2, 0xcc, 0x7b, 0xf3, 0x3e, 0xef, 0xd7, 0xe7, 0xcd, 0xac, 0xe1, 0x5b, 0x94,
+	0x75, 0x29, 0xf3, 0x58, 0xbd, 0x17, 0x50, 0x4e, 0x03, 0x72, 0x5e, 0x3f, 0xdf, 0x72, 0x09, 0x77,
+	0xb6, 0x62, 0x81, 0x21, 0x16, 0x48, 0x53, 0x07, 0x8d, 0x58, 0xae, 0x0e, 0x96, 0x37, 0x1b, 0x42,
+	0x65, 0x0b, 0x45, 0x5d, 0x6e, 0xe4, 0xa9, 0xf2, 0x7a, 0x8b, 0xb6, 0xa8, 0x94, 0x87, 0x2b, 0x25,
+	0xd5, 0x5b, 0x94, 0xb6, 0x3a, 0x44, 0x7a, 0x70, 0xfb, 0xa7, 0x75, 0xee, 0x75, 0x09, 0xe3, 0x4e,
+	0xb7, 0xa7, 0x0e, 0x54, 0x24, 0x48, 0xdd, 0x75, 0x18, 0x89, 0xe3, 0x69, 0x50, 0xcf, 0x97, 0x7a,
+	0xfc, 0x1c, 0x40, 0x74, 0x42, 0xcf, 0x88, 0x7f, 0xe4, 0x78, 0xc1, 0xc3, 0xc0, 0xb5, 0x68, 0x9f,
+	0x13, 0x86, 0x3e, 0x87, 0xd0, 0x09, 0x5c, 0x3b, 0x10, 0x3b, 0x0d, 0x54, 0xd3, 0xb5, 0xc2, 0xb6,
+	0x6e, 0xbc, 0x2c, 0x6e, 0x43, 0x58, 0x99, 0x9b, 0x57, 0x03, 0x3d, 0x35, 0x1c, 0xe8, 0xab, 0x17,
+	0x4e, 0xb7, 0xb3, 0x83, 0x47, 0x00, 0xd8, 0xca, 0x3b, 0x31, 0xb4, 0x01, 0x73, 0x3c, 0x74, 0x68,
+	0x7b, 0xbe, 0xb6, 0x50, 0x05, 0xb5, 0xbc, 0xb9, 0x36, 0x1c, 0xe8, 0x45, 0x69, 0x13, 0x69, 0xb0,
+	0x75, 0x47, 0x2c, 0xf7, 0x7d, 0xb4, 0x05, 0xf3, 0x52, 0x4a, 0xfb, 0x5c, 0x4b, 0x0b, 0x83, 0xf5,
+	0xe1, 0x40, 0x2f, 0x25, 0x0d, 0x68, 0x9f, 0x63, 0x4b, 0xc2, 0x1e, 0xf6, 0xf9, 0x4e, 0xe6, 0xcf,
+	0x1f, 0x75, 0x80, 0x7f, 0x05, 0x70, 0x51, 0xf8, 0x44, 0x07, 0x30, 0xcb, 0x03, 0xa7, 0x79, 0x9b,
+	0x4c, 0x4e, 0xc2, 0x73, 0xe6, 0x5d, 0x95, 0xc9, 0xb2, 0x72, 0x22, 0x8c, 0xb1, 0xa5, 0x50, 0x90,
+	0x0d, 0xf3, 0x8c, 0x93, 0x9e, 0xcd, 0xbc, 0x4b, 0xa2, 0x72, 0x30, 0x43, 0x8b, 0xdf, 0x07, 0xfa,
+	0xfd, 0x96, 0xc7, 0xdb, 0x7d, 0xd7, 0x68, 0xd0, 0xae, 0xea, 0x9f, 0xfa, 0x79, 0xc0, 0x9a, 0x67,
+	0x75, 0x7e, 0xd1, 0x23, 0xcc, 0xd8, 0xf7, 0xf9, 0x28, 0x81, 0x18, 0x08, 0x5b, 0xb9, 0x70, 0x7d,
+	0xec, 0x5d, 0x12, 0x95, 0xc0, 0xf7, 0x00, 0x2e, 0x8a, 0x78, 0xd0, 0x1b, 0x30, 0xd3, 0xa3, 0xb4,
+	0xa3, 0x81, 0x2a, 0xa8, 0x65, 0xcc, 0xe2, 0x70, 0xa0, 0x17, 0xa4, 0x75, 0x28, 0xc5, 0x96, 0x50,
+	0xbe, 0xbe, 0xc2, 0xfe, 0x05, 0x60, 0x51, 0x14, 0xf6, 0x98, 0x3b, 0xdc, 0x63, 0xdc, 0x6b, 0x30,
+	0xf4, 0x11, 0xbc, 0xd3, 0x0b, 0xe8, 0xa9, 0xc7, 0xa3, 0x1a, 0x6f, 0x1a, 0x8a, 0xbe, 0x21, 0xf3,
+	0xe2, 0xf2, 0xee, 0x52, 0xcf, 0x37, 0xef, 0xa9, 0xea, 0xae, 0xa8, 0x1c, 0xa4, 0x1d, 0xb6, 0x22,
+	0x04, 0xc4, 0x60, 0xc9, 0xef, 0x77, 0x5d, 0x12, 0xd8, 0xf4, 0xd4, 0x56, 0x9d, 0x93, 0x19, 0xed,
+	0xcf, 0x5d, 0xe6, 0x0d, 0xe9, 0x64, 0x12, 0x0f, 0x5b, 0x2b, 0x52, 0x74, 0x78, 0x7a, 0x22, 0x9b,
+	0x7a, 0x1f, 0x2e, 0x0a, 0xb6, 0x6a, 0xe9, 0x6a, 0xba, 0x96, 0x31, 0x4b, 0xc3, 0x81, 0xbe, 0x24,
+	0x6d, 0x85, 0x18, 0x5b, 0x52, 0x8d, 0xaf, 0x01, 0x2c, 0x1c, 0x51, 0xda, 0xf9, 0x94, 0x78, 0xad,
+	0x36, 0x67, 0xe8, 0x03, 0xb8, 0xcc, 0xb8, 0xe3, 0x76, 0x88, 0xfd, 0x44, 0x48, 0x54, 0x93, 0xb4,
+	0xe1, 0x40, 0x5f, 0x8f, 0x5a, 0x9c, 0x50, 0x63, 0x6b, 0x49, 0xee, 0xa5, 0x3d, 0xda, 0x85, 0x45,
+	0xd7, 0xe9, 0x38, 0x7e, 0x83, 0x04, 0x11, 0xc0, 0x82, 0x00, 0x28, 0x0f, 0x07, 0xfa, 0x3d, 0x09,
+	0x30, 0x71, 0x00, 0x5b, 0x2b, 0x91, 0x44, 0x81, 0x1c, 0xc2, 0xb5, 0x06, 0xf5, 0x1b, 0xc4, 0xe7,
+	0x81, 0xc3, 0x49, 0x33, 0x02, 0x4a, 0x0b, 0xa0, 0xca, 0x70, 0xa0, 0x97, 0x25, 0xd0, 0x94, 0x43,
+	0xd8, 0x42, 0x49, 0xa9, 0x04, 0xc4, 0xdf, 0x01, 0x98, 0x37, 0x1d, 0x46, 0x1e, 0x11, 0x9f, 0x76,
+	0xc3, 0xd2, 0x34, 0xc3, 0x85, 0x48, 0x2d, 0x9f, 0x2c, 0x8d, 0x10, 0x63, 0x4b, 0xaa, 0x5f, 0xf9,
+	0x5c, 0xe0, 0xdf, 0xd2, 0x70, 0xf5, 0x31, 0xe5, 0x82, 0x7c, 0xbb, 0x8e, 0xdf, 0xf4, 0x9a, 0x0e,
+	0x27, 0x63, 0xc4, 0x07, 0xf3, 0x12, 0x7f, 0xe1, 0x36, 0xc4, 0x47, 0x9f, 0xc0, 0x7c, 0x9b, 0x72,
+	0x3b, 0x22, 0x08, 0xb8, 0xcd, 0x75, 0xa8, 0x29, 0x9a, 0x2b, 0xdc, 0xd8, 0x1e, 0x5b, 0xb9, 0xb6,
+	0xca, 0x01, 0x7d, 0x0d, 0xe0, 0xdd, 0x8e, 0xc3, 0xb8, 0x4d, 0x7a, 0xb4, 0xd1, 0xb6, 0x59, 0x3c,
+	0x50, 0x5a, 0x46, 0x38, 0x79, 0x7b, 0x86, 0x93, 0xd1, 0x04, 0x9a, 0x6f, 0x2a, 0x77, 0xff, 0x93,
+	0xee, 0xa6, 0xa2, 0x62, 0x6b, 0x2d, 0x94, 0xef, 0x85, 0xe2, 0xc4, 0xf0, 0xd6, 0x61, 0xae, 0x17,
+	0xd0, 0x2e, 0xe5, 0xa4, 0xa9, 0x2d, 0x56, 0x41, 0x2d, 0x97, 0x2c, 0x60, 0xa4, 0xc1, 0x56, 0x7c,
+	0x08, 0xed, 0xc0, 0x90, 0xc4, 0x1d, 0x22, 0x1d, 0x30, 0x2d, 0x2b, 0x88, 0xb6, 0x31, 0x1c, 0xe8,
+	0x6b, 0x31, 0xe5, 0x63, 0x2d, 0xb6, 0x0a, 0x62, 0xbb, 0x27, 0x77, 0x3f, 0x03, 0x58, 0x8a, 0x7a,
+	0x78, 0x78, 0x4e, 0x82, 0xc0, 0x6b, 0x92, 0xd1, 0xf0, 0x81, 0x7f, 0x1d, 0x3e, 0xd4, 0x85, 0xcb,
+	0x54, 0xd9, 0xd8, 0x21, 0x69, 0x44, 0xfb, 0x56, 0xb6, 0x8d, 0x97, 0x97, 0x69, 0xd2, 0xd5, 0xc9,
+	0x45, 0x8f, 0x24, 0x87, 0x73, 0x0c, 0x0e, 0x5b, 0x4b, 0x34, 0x71, 0x0e, 0x5f, 0x65, 0x60, 0x71,
+	0xb2, 0x58, 0x3b, 0x70, 0x49, 0x96, 0x55, 0xde, 0x1f, 0x1a, 0x98, 0xcc, 0x3d, 0xa9, 0xc5, 0x56,
+	0x41, 0x6c, 0x0f, 0xc4, 0x0e, 0x7d, 0x06, 0x21, 0xe3, 0x4e, 0xc0, 0xed, 0xf0, 0x99, 0x16, 0xb1,
+	0x17, 0xb6, 0xcb, 0x86, 0x7c, 0xc3, 0x8d, 0xe8, 0x0d, 0x37, 0x4e, 0xa2, 0x37, 0xdc, 0xfc, 0xff,
+	0xf8, 0x8b, 0x3a, 0xb2, 0xc5, 0x4f, 0x5f, 0xe8, 0xc0, 0xca, 0x0b, 0x41, 0x78, 0x5c, 0x75, 0x24,
+	0xe0, 0x76, 0x7b, 0x34, 0xfa, 0xe9, 0x89, 0x8e, 0xc4, 0x5a, 0xd9, 0x91, 0x80, 0x3f, 0x16, 0xbb,
+	0xa9, 0xd7, 0x6d, 0xe6, 0x55, 0x5f, 0xb7, 0x4f, 0x46, 0x0f, 0xc6, 0xe2, 0xac, 0x07, 0xc3, 0x9c,
+	0xfe, 0x60, 0xfc, 0xf2, 0x42, 0xaf, 0xdd, 0x22, 0xb0, 0x10, 0x82, 0x8d, 0x1e, 0x97, 0x3e, 0x2c,
+	0x09, 0x2e, 0x25, 0x87, 0x2d, 0x5b, 0x4d, 0xcf, 0x37, 0x6c, 0xba, 0x8a, 0x68, 0x23, 0x41, 0xd2,
+	0xb1, 0x39, 0x2b, 0x06, 0xe3, 0x16, 0xf8, 0x87, 0x2c, 0x44, 0x47, 0x22, 0x84, 0x47, 0x1e, 0xe3,
+	0x81, 0xe7, 0xf6, 0xb9, 0x47, 0xfd, 0xff, 0xc4, 0xa6, 0x0f, 0x61, 0xe6, 0x96, 0x3c, 0xda, 0x50,
+	0xe1, 0xaa, 0xaf, 0x86, 0x11, 0x83, 0x04, 0x40, 0xb2, 0x17, 0xe9, 0xd7, 0xda, 0x8b, 0xaf, 0x20,
+	0xe4, 0x34, 0xac, 0xdb, 0x19, 0x09, 0x42, 0xce, 0xcd, 0xf0, 0xbd, 0x37, 0x3e, 0x0e, 0x23, 0xd3,
+	0xf9, 0xdc, 0xe7, 0x39, 0x3d, 0x96, 0x76, 0xe8, 0x5b, 0x00, 0x57, 0x39, 0xb5, 0x1b, 0xb4, 0xdb,
+	0xed, 0xfb, 0x1e, 0xbf, 0xb0, 0xc5, 0x67, 0xd6, 0x4c, 0x42, 0x7e, 0xac, 0x02, 0xd1, 0xe2, 0x40,
+	0xc6, 0x11, 0xe6, 0x8b, 0xa7, 0xc8, 0xe9, 0x6e, 0x64, 0x1e, 0x7e, 0x59, 0x20, 0x0e, 0xb3, 0x6e,
+	0x3f, 0xf0, 0x49, 0x53, 0xcb, 0xce, 0x8a, 0xe4, 0xe1, 0xf8, 0x97, 0xaa, 0x34, 0x9b, 0xcf, 0xbd,
+	0xf2, 0x85, 0x2e, 0x61, 0x2e, 0x20, 0xdc, 0xf1, 0x42, 0xbf, 0x77, 0x66, 0xf9, 0xdd, 0x55, 0x7e,
+	0xd5, 0x23, 0x11, 0x19, 0xce, 0xe7, 0x39, 0xf6, 0xf7, 0x4e, 0x1b, 0xae, 0x4f, 0xbb, 0xa8, 0x91,
+	0xf6, 0x4f, 0xf9, 0x01, 0xf5, 0x49, 0x29, 0x85, 0x36, 0xe0, 0xda, 0xa4, 0xe6, 0xc8, 0xf3, 0x4b,
+	0x60, 0x9a, 0xc2, 0x74, 0xfc, 0xd2, 0x42, 0x39, 0xf3, 0xcd, 0x4f, 0x95, 0x94, 0x79, 0x70, 0x75,
+	0x5d, 0x01, 0xcf, 0xae, 0x2b, 0xe0, 0x8f, 0xeb, 0x0a, 0x78, 0x7a, 0x53, 0x49, 0x3d, 0xbb, 0xa9,
+	0xa4, 0x9e, 0xdf, 0x54, 0x52, 0x5f, 0xbc, 0x97, 0x88, 0x5b, 0x5d, 0x04, 0x0f, 0x3a, 0x8e, 0xcb,
+	0xa2, 0x4d, 0xfd, 0x7c, 0xeb, 0xfd, 0xfa, 0x97, 0xa3, 0x7f, 0x77, 0x22, 0x13, 0x37, 0x2b, 0xf6,
+	0xef, 0xfe, 0x3d, 0x00, 0x96, 0xc2, 0x90, 0x7e, 0xfe, 0x0d, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -821,136 +1243,560 @@ func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtorev(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *TokenPairArbRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ArbRoutes) > 0 {
-		for _, e := range m.ArbRoutes {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
-		}
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+func (m *HotRouteCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Route) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
-		}
-	}
-	l = m.StepSize.Size()
-	n += 1 + l + sovProtorev(uint64(l))
-	return n
+func (m *HotRouteCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Trade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HotRouteCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != 0 {
-		n += 1 + sovProtorev(uint64(m.Pool))
+	if m.StaleEpochs != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.StaleEpochs))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	if m.Promoted {
+		i--
+		if m.Promoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	{
+		size, err := m.LastEpochStatistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.HotRoute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RouteStatistics) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HotRouteOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotRouteOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotRouteOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverrideType != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.OverrideType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		dAtA6 := make([]byte, len(m.Route)*10)
+		var j5 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintProtorev(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.RouteStatistics) > 0 {
+		for iNdEx := len(m.RouteStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Profits) > 0 {
-		for _, e := range m.Profits {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovProtorev(uint64(l))
-	if len(m.Route) > 0 {
-		l = 0
-		for _, e := range m.Route {
-			l += sovProtorev(uint64(e))
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovProtorev(uint64(l)) + l
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if m.StartHeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintProtorev(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolWeights) Size() (n int) {
+func (m *ProfitDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Retained) > 0 {
+		for iNdEx := len(m.Retained) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retained[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ToCommunityPool) > 0 {
+		for iNdEx := len(m.ToCommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToCommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ToStakers) > 0 {
+		for iNdEx := len(m.ToStakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToStakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintProtorev(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPairArbRoutes) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StableWeight != 0 {
-		n += 1 + sovProtorev(uint64(m.StableWeight))
+	if len(m.ArbRoutes) > 0 {
+		for _, e := range m.ArbRoutes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
 	}
-	if m.BalancerWeight != 0 {
-		n += 1 + sovProtorev(uint64(m.BalancerWeight))
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
 	}
-	if m.ConcentratedWeight != 0 {
-		n += 1 + sovProtorev(uint64(m.ConcentratedWeight))
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
 	}
 	return n
 }
 
-func (m *BaseDenom) Size() (n int) {
+func (m *Route) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
 	}
 	l = m.StepSize.Size()
 	n += 1 + l + sovProtorev(uint64(l))
 	return n
 }
 
-func sovProtorev(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != 0 {
+		n += 1 + sovProtorev(uint64(m.Pool))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	return n
+}
+
+func (m *RouteStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovProtorev(uint64(e))
+		}
+		n += 1 + sovProtorev(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PoolWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.StableWeight))
+	}
+	if m.BalancerWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.BalancerWeight))
+	}
+	if m.ConcentratedWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.ConcentratedWeight))
+	}
+	return n
+}
+
+func (m *BaseDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = m.StepSize.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *HotRouteCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = m.HotRoute.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.LastEpochStatistics.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.Promoted {
+		n += 2
+	}
+	if m.StaleEpochs != 0 {
+		n += 1 + sovProtorev(uint64(m.StaleEpochs))
+	}
+	return n
+}
+
+func (m *HotRouteOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovProtorev(uint64(e))
+		}
+		n += 1 + sovProtorev(uint64(l)) + l
+	}
+	if m.OverrideType != 0 {
+		n += 1 + sovProtorev(uint64(m.OverrideType))
+	}
+	return n
+}
+
+func (m *EpochStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovProtorev(uint64(m.EpochNumber))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovProtorev(uint64(m.StartHeight))
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.RouteStatistics) > 0 {
+		for _, e := range m.RouteStatistics {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProfitDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovProtorev(uint64(m.EpochNumber))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProtorev(uint64(l))
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.ToStakers) > 0 {
+		for _, e := range m.ToStakers {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.ToCommunityPool) > 0 {
+		for _, e := range m.ToCommunityPool {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.Retained) > 0 {
+		for _, e := range m.Retained {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProtorev(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozProtorev(x uint64) (n int) {
 	return sovProtorev(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -986,7 +1832,920 @@ func (m *TokenPairArbRoutes) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArbRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArbRoutes = append(m.ArbRoutes, Route{})
+			if err := m.ArbRoutes[len(m.ArbRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			m.Pool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtorev
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableWeight", wireType)
+			}
+			m.StableWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancerWeight", wireType)
+			}
+			m.BalancerWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancerWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedWeight", wireType)
+			}
+			m.ConcentratedWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentratedWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotRouteCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotRouteCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotRouteCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HotRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochStatistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1013,16 +2772,15 @@ func (m *TokenPairArbRoutes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ArbRoutes = append(m.ArbRoutes, Route{})
-			if err := m.ArbRoutes[len(m.ArbRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastEpochStatistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promoted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1032,29 +2790,17 @@ func (m *TokenPairArbRoutes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			m.Promoted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleEpochs", wireType)
 			}
-			var stringLen uint64
+			m.StaleEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1064,24 +2810,11 @@ func (m *TokenPairArbRoutes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StaleEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -1103,7 +2836,7 @@ func (m *TokenPairArbRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Route) Unmarshal(dAtA []byte) error {
+func (m *HotRouteOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,51 +2859,93 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+			return fmt.Errorf("proto: HotRouteOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HotRouteOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtorev
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtorev
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Trades = append(m.Trades, Trade{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideType", wireType)
 			}
-			var stringLen uint64
+			m.OverrideType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1180,26 +2955,11 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OverrideType |= HotRouteOverrideType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -1221,7 +2981,7 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trade) Unmarshal(dAtA []byte) error {
+func (m *EpochStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,17 +3004,17 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.Pool = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1264,16 +3024,16 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pool |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1283,27 +3043,47 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1331,59 +3111,11 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtorev(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProtorev
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProtorev
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RouteStatistics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
 			}
@@ -1417,11 +3149,11 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteStatistics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1431,102 +3163,26 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RouteStatistics = append(m.RouteStatistics, RouteStatistics{})
+			if err := m.RouteStatistics[len(m.RouteStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProtorev
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Route = append(m.Route, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProtorev
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProtorev
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProtorev
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Route) == 0 {
-					m.Route = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProtorev
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Route = append(m.Route, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -1548,7 +3204,7 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolWeights) Unmarshal(dAtA []byte) error {
+func (m *ProfitDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,17 +3227,17 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolWeights: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfitDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfitDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.StableWeight = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1591,16 +3247,16 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StableWeight |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalancerWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.BalancerWeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1610,16 +3266,30 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalancerWeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
 			}
-			m.ConcentratedWeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1629,66 +3299,99 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedWeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtorev(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BaseDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProtorev
+			m.ToStakers = append(m.ToStakers, types.Coin{})
+			if err := m.ToStakers[len(m.ToStakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ToCommunityPool = append(m.ToCommunityPool, types.Coin{})
+			if err := m.ToCommunityPool[len(m.ToCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BaseDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1698,29 +3401,31 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retained", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1730,23 +3435,23 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Retained = append(m.Retained, types.Coin{})
+			if err := m.Retained[len(m.Retained)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
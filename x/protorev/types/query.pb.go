@@ -12,6 +12,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1386,1496 +1390,1653 @@ func (m *QueryGetProtoRevPoolResponse) GetPoolId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
-	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
-	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+// QueryGetProtoRevMaxPromotedHotRoutesRequest is request type for the
+// Query/GetProtoRevMaxPromotedHotRoutes RPC method.
+type QueryGetProtoRevMaxPromotedHotRoutesRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) Reset() {
+	*m = QueryGetProtoRevMaxPromotedHotRoutesRequest{}
 }
-
-var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8e, 0x7b, 0x49, 0x7e, 0x9d, 0xb4, 0xfd, 0xb5, 0xd3, 0x26, 0x4d, 0xdc, 0x74, 0x37, 0x9d,
-	0xdc, 0x9a, 0xdb, 0xae, 0xd2, 0x06, 0xca, 0xad, 0x40, 0xdc, 0x40, 0x15, 0x55, 0x34, 0x8b, 0x29,
-	0x42, 0x02, 0x89, 0xc5, 0xbb, 0x3b, 0xd9, 0x5a, 0xf5, 0x7a, 0x5c, 0xdb, 0x1b, 0xb2, 0xaf, 0x20,
-	0x21, 0x21, 0x90, 0xb8, 0x3d, 0xf3, 0xc6, 0x1f, 0xc0, 0x3f, 0xc0, 0x03, 0x0f, 0x48, 0x7d, 0x42,
-	0x95, 0x10, 0x12, 0xea, 0xc3, 0x52, 0xb5, 0x48, 0xbc, 0xf0, 0xb4, 0x7f, 0x01, 0xf2, 0xcc, 0xf1,
-	0xda, 0xeb, 0xcb, 0x66, 0x9d, 0x48, 0x3c, 0x65, 0xed, 0x39, 0xe7, 0x3b, 0xdf, 0x37, 0x33, 0x67,
-	0xe6, 0x73, 0xd0, 0x2c, 0x73, 0x1a, 0xcc, 0xd1, 0x9d, 0xa2, 0x65, 0x33, 0x97, 0xd9, 0x74, 0xb7,
-	0xb8, 0xbb, 0x56, 0xa1, 0xae, 0xb6, 0x56, 0x7c, 0xd0, 0xa4, 0x76, 0xab, 0xc0, 0x5f, 0xe3, 0x09,
-	0x88, 0x2a, 0xf8, 0x51, 0x05, 0x88, 0x92, 0xcf, 0xd7, 0x59, 0x9d, 0xf1, 0xb7, 0x45, 0xef, 0x97,
-	0x08, 0x90, 0xa7, 0xea, 0x8c, 0xd5, 0x0d, 0x5a, 0xd4, 0x2c, 0xbd, 0xa8, 0x99, 0x26, 0x73, 0x35,
-	0x57, 0x67, 0x26, 0xa4, 0xcb, 0x4b, 0x55, 0x0e, 0x57, 0xac, 0x68, 0x0e, 0x15, 0x65, 0xba, 0x45,
-	0x2d, 0xad, 0xae, 0x9b, 0x3c, 0x18, 0x62, 0xe7, 0x52, 0xf9, 0x59, 0x9a, 0xad, 0x35, 0x7c, 0xc8,
-	0x85, 0xf4, 0x30, 0x9f, 0xb1, 0x08, 0xcc, 0x85, 0x6b, 0xfb, 0x31, 0x55, 0xa6, 0x43, 0x3d, 0x72,
-	0x1e, 0xe1, 0xb7, 0x3d, 0x46, 0x25, 0x8e, 0xae, 0xd2, 0x07, 0x4d, 0xea, 0xb8, 0x64, 0x07, 0x9d,
-	0xeb, 0x79, 0xeb, 0x58, 0xcc, 0x74, 0x28, 0xde, 0x46, 0xc3, 0x82, 0xc5, 0x84, 0x34, 0x2d, 0x5d,
-	0x19, 0xbd, 0x3a, 0x5d, 0x48, 0x9b, 0xa7, 0x82, 0xc8, 0x54, 0xc6, 0x1e, 0xb6, 0xf3, 0x43, 0x9d,
-	0x76, 0xfe, 0x54, 0x4b, 0x6b, 0x18, 0x2f, 0x11, 0x91, 0x4d, 0x54, 0x80, 0x21, 0x0b, 0x68, 0x8e,
-	0xd7, 0xb9, 0x45, 0xdd, 0x92, 0x87, 0xa0, 0xd2, 0xdd, 0x3b, 0xcd, 0x46, 0x85, 0xda, 0xdb, 0x3b,
-	0x77, 0x6d, 0xad, 0x46, 0xbb, 0x84, 0xbe, 0x97, 0xd0, 0xfc, 0x7e, 0x91, 0x40, 0xd2, 0x41, 0x67,
-	0x4c, 0x3e, 0x52, 0x66, 0x3b, 0x65, 0x97, 0x8f, 0x71, 0xba, 0x27, 0x94, 0x2d, 0x8f, 0xcc, 0xe3,
-	0x76, 0x7e, 0xbe, 0xae, 0xbb, 0xf7, 0x9a, 0x95, 0x42, 0x95, 0x35, 0x8a, 0x30, 0x3d, 0xe2, 0xcf,
-	0xaa, 0x53, 0xbb, 0x5f, 0x74, 0x5b, 0x16, 0x75, 0x0a, 0x5b, 0xa6, 0xdb, 0x69, 0xe7, 0x2f, 0x08,
-	0xda, 0x51, 0x3c, 0xa2, 0x9e, 0x36, 0x7b, 0x8a, 0x93, 0xed, 0xb8, 0x90, 0x92, 0xcd, 0x76, 0x74,
-	0xd7, 0x51, 0x5a, 0x9b, 0xd4, 0x64, 0x0d, 0x10, 0x82, 0xe7, 0xd1, 0xf1, 0x9a, 0xf7, 0x0c, 0x94,
-	0xce, 0x74, 0xda, 0xf9, 0x93, 0xa2, 0x08, 0x7f, 0x4d, 0x54, 0x31, 0x4c, 0xcc, 0xb8, 0xde, 0x28,
-	0x20, 0xe8, 0xdd, 0x44, 0xc3, 0x16, 0x1f, 0x81, 0x45, 0x99, 0x2c, 0x08, 0x31, 0x05, 0x6f, 0xc9,
-	0xbb, 0xeb, 0x71, 0x93, 0xe9, 0xa6, 0x72, 0x36, 0xb4, 0x12, 0x3c, 0xc5, 0x5b, 0x09, 0xf1, 0x63,
-	0x06, 0x5d, 0x8e, 0xd6, 0xdb, 0x30, 0x0c, 0x28, 0xe9, 0xaf, 0xc2, 0x03, 0x44, 0xfa, 0x05, 0x01,
-	0xa1, 0xdb, 0x68, 0x44, 0x80, 0x7a, 0xf3, 0x7e, 0xb4, 0x3f, 0xa3, 0x71, 0xd8, 0x1f, 0xa7, 0xc3,
-	0xac, 0x1c, 0xa2, 0x8e, 0x74, 0x7f, 0xa1, 0x2b, 0xd1, 0x92, 0xef, 0x78, 0xdd, 0xe5, 0xb8, 0x7a,
-	0xd5, 0x51, 0x5a, 0x2a, 0x6b, 0xba, 0x34, 0x34, 0xb7, 0xb6, 0xf7, 0xcc, 0xcb, 0x1e, 0x0b, 0xcf,
-	0x2d, 0x7f, 0x4d, 0x54, 0x31, 0x4c, 0xbe, 0x91, 0xd0, 0xe2, 0x00, 0xa0, 0x20, 0xa7, 0x86, 0x90,
-	0xd3, 0x1d, 0x84, 0x39, 0x5e, 0x4c, 0xdf, 0xf8, 0x3c, 0x39, 0x84, 0x36, 0x09, 0x0a, 0xcf, 0x0a,
-	0x26, 0x01, 0x14, 0x51, 0x43, 0xb8, 0x64, 0x39, 0x4e, 0x69, 0xc3, 0x30, 0x22, 0x60, 0xfe, 0x3a,
-	0x7c, 0x2b, 0xa1, 0xa5, 0x41, 0xa2, 0x53, 0x14, 0x1c, 0xfd, 0xaf, 0x14, 0xdc, 0x65, 0xf7, 0xa9,
-	0x59, 0xd2, 0x74, 0x7b, 0xc3, 0xae, 0x70, 0xd4, 0xae, 0x82, 0xcf, 0x13, 0x14, 0x24, 0x45, 0x83,
-	0x82, 0x0f, 0xd0, 0x30, 0x5f, 0x3a, 0x9f, 0xfd, 0x4a, 0x3a, 0xfb, 0x38, 0x4a, 0xf4, 0x10, 0x12,
-	0x48, 0x44, 0x05, 0x48, 0x32, 0x87, 0x66, 0x62, 0x93, 0x59, 0x6b, 0xe8, 0xe6, 0x46, 0xb5, 0xca,
-	0x9a, 0xa6, 0xeb, 0x53, 0xa6, 0x68, 0xb6, 0x7f, 0x18, 0x70, 0xbd, 0x81, 0x4e, 0x69, 0xde, 0xfb,
-	0xb2, 0x26, 0x06, 0xa0, 0xd3, 0x27, 0x3a, 0xed, 0xfc, 0x79, 0x41, 0xa0, 0x67, 0x98, 0xa8, 0x27,
-	0xb5, 0x10, 0x0c, 0x59, 0x44, 0x0b, 0xd1, 0x32, 0x9b, 0x74, 0x97, 0x1a, 0xcc, 0xa2, 0x76, 0x84,
-	0x51, 0x33, 0xde, 0x1b, 0xf1, 0x50, 0x60, 0xb5, 0x85, 0xce, 0xd6, 0xfc, 0xb1, 0x08, 0xb3, 0xa9,
-	0x4e, 0x3b, 0x3f, 0xe1, 0x9f, 0x41, 0x91, 0x10, 0xa2, 0x9e, 0xa9, 0x45, 0x20, 0xc9, 0x6c, 0xfc,
-	0x14, 0x28, 0x31, 0x66, 0xbc, 0x47, 0xf5, 0xfa, 0xbd, 0xe0, 0xac, 0xf8, 0x52, 0x8a, 0x4f, 0x6b,
-	0x4f, 0x18, 0x10, 0xa3, 0xe8, 0xa4, 0xc5, 0x98, 0x51, 0xfe, 0x58, 0xbc, 0x87, 0x06, 0x9b, 0xeb,
-	0x73, 0xb3, 0x04, 0x20, 0xca, 0x45, 0x58, 0xd9, 0x73, 0x70, 0x7c, 0x84, 0x80, 0x88, 0x3a, 0x6a,
-	0x05, 0x91, 0xa4, 0x80, 0x56, 0xa2, 0x6c, 0xde, 0xd2, 0xf6, 0x3c, 0xac, 0x12, 0xd3, 0x4d, 0xd7,
-	0x29, 0x51, 0x5b, 0x31, 0x58, 0xf5, 0xbe, 0x4f, 0xff, 0x2b, 0x09, 0xad, 0x0e, 0x98, 0x00, 0x42,
-	0x3e, 0x44, 0x93, 0x0d, 0x6d, 0xaf, 0xcc, 0x39, 0x58, 0x3c, 0xa4, 0xec, 0x4d, 0x64, 0xc5, 0x0b,
-	0xe2, 0xaa, 0x8e, 0x29, 0xb3, 0x9d, 0x76, 0x7e, 0x5a, 0x50, 0x4d, 0x0d, 0x25, 0xea, 0x58, 0x23,
-	0xa9, 0x4e, 0x52, 0x7f, 0x45, 0x09, 0xdd, 0xdd, 0xf3, 0xe9, 0x7f, 0x9a, 0xd0, 0x5f, 0x49, 0xd1,
-	0xc0, 0xfd, 0x5d, 0x34, 0x9e, 0x44, 0xc8, 0xdd, 0x03, 0xe2, 0x97, 0x3b, 0xed, 0xfc, 0xa5, 0x74,
-	0xe2, 0xee, 0x1e, 0x51, 0x71, 0x23, 0x06, 0x9f, 0x74, 0xa9, 0x28, 0x9a, 0x43, 0xf9, 0xfd, 0xd5,
-	0xdd, 0x28, 0x9f, 0x49, 0xf1, 0xfd, 0x14, 0x8e, 0x02, 0x8a, 0x1f, 0xa1, 0x51, 0xef, 0xfa, 0x28,
-	0xf3, 0xeb, 0xd1, 0x3f, 0x07, 0x66, 0xd2, 0xb7, 0x49, 0x17, 0x42, 0x91, 0x61, 0x93, 0x60, 0x21,
-	0x20, 0x84, 0x42, 0x54, 0x54, 0xe9, 0x56, 0x22, 0xd3, 0x28, 0x17, 0xe5, 0xf1, 0x86, 0xa9, 0x55,
-	0x0c, 0x5a, 0xf3, 0xa9, 0x6e, 0xa3, 0x7c, 0x6a, 0x04, 0xd0, 0x5c, 0x41, 0x23, 0x54, 0xbc, 0xe2,
-	0x53, 0xf7, 0x3f, 0x05, 0x07, 0xb7, 0x1b, 0x0c, 0x10, 0xd5, 0x0f, 0xf1, 0x9a, 0xe4, 0x62, 0x52,
-	0x93, 0xf8, 0x37, 0xda, 0x3a, 0x42, 0x01, 0x5d, 0x68, 0xd7, 0xb1, 0xe0, 0x28, 0x0e, 0xc6, 0x88,
-	0x7a, 0xa2, 0xab, 0x04, 0x5f, 0x47, 0xa3, 0xcc, 0xbd, 0x47, 0x6d, 0x48, 0x3b, 0xc2, 0xd3, 0xc6,
-	0x83, 0x19, 0x08, 0x0d, 0x12, 0x15, 0xf1, 0x27, 0x9e, 0x48, 0x6e, 0xa3, 0xa9, 0x64, 0x36, 0x20,
-	0x6e, 0x19, 0x8d, 0xf0, 0xa5, 0xd7, 0x6b, 0xb0, 0x2f, 0x42, 0xe2, 0x60, 0xc0, 0x73, 0x14, 0x8c,
-	0x19, 0x5b, 0xb5, 0xab, 0x7f, 0x5f, 0x40, 0xc7, 0x39, 0x1a, 0xfe, 0x42, 0x42, 0xc3, 0xc2, 0x0f,
-	0xe2, 0x3e, 0x07, 0x77, 0xdc, 0x86, 0xca, 0xab, 0x03, 0x46, 0x0b, 0x7a, 0x64, 0xf6, 0x93, 0xdf,
-	0xfe, 0xfa, 0xee, 0x48, 0x0e, 0x4f, 0x15, 0x7d, 0x77, 0xbc, 0xbb, 0xb6, 0x1e, 0x38, 0x64, 0xe1,
-	0x39, 0xf1, 0xaf, 0x12, 0x9a, 0x4c, 0x75, 0x91, 0xf8, 0xb5, 0x7d, 0x4a, 0xee, 0xe7, 0x54, 0xe5,
-	0xd7, 0x0f, 0x0e, 0x00, 0x32, 0x0a, 0x5c, 0xc6, 0x15, 0x3c, 0x9f, 0x2c, 0x23, 0x6a, 0x46, 0xa3,
-	0x82, 0x7a, 0x6d, 0x62, 0x16, 0x41, 0x89, 0x8e, 0x35, 0x8b, 0xa0, 0x64, 0x87, 0xba, 0x9f, 0x20,
-	0xb0, 0x7a, 0xe5, 0x4a, 0x4b, 0xec, 0x49, 0xfc, 0x93, 0x84, 0xc6, 0x12, 0x2d, 0x26, 0x7e, 0x79,
-	0x70, 0x2e, 0x31, 0xf7, 0x2a, 0xbf, 0x72, 0xb0, 0x64, 0x10, 0xb1, 0xc8, 0x45, 0xcc, 0xe0, 0xcb,
-	0xc9, 0x22, 0x34, 0xc3, 0x28, 0x83, 0x10, 0xfc, 0x58, 0x42, 0x53, 0xfd, 0xac, 0x25, 0x56, 0x06,
-	0x67, 0x92, 0x66, 0x76, 0xe5, 0x9b, 0x87, 0xc2, 0x00, 0x51, 0x6b, 0x5c, 0xd4, 0x32, 0x5e, 0x4c,
-	0x16, 0x15, 0xb8, 0x3b, 0x6f, 0x71, 0xb8, 0x5d, 0xc2, 0x6d, 0x09, 0x5d, 0xea, 0x6b, 0x3b, 0xf1,
-	0xcd, 0x4c, 0xf3, 0x9c, 0x6c, 0x71, 0xe5, 0xcd, 0xc3, 0x81, 0x80, 0xbe, 0xab, 0x5c, 0xdf, 0x0a,
-	0x5e, 0x4a, 0x5f, 0x34, 0xae, 0xaa, 0x1c, 0x28, 0xc5, 0x7f, 0xf6, 0x0a, 0x8c, 0xfb, 0xc9, 0x2c,
-	0x02, 0x53, 0x1d, 0x70, 0x16, 0x81, 0xe9, 0xc6, 0x98, 0x5c, 0xe3, 0x02, 0x57, 0xf1, 0x72, 0xb2,
-	0x40, 0xd7, 0xcb, 0x2c, 0x5b, 0x9a, 0x6e, 0x97, 0x35, 0xbb, 0x22, 0xb4, 0x3a, 0xf8, 0x17, 0x09,
-	0x5d, 0x48, 0x71, 0xb1, 0xf8, 0x46, 0x86, 0x79, 0x8f, 0x9b, 0x64, 0xf9, 0xd5, 0x83, 0xa6, 0x83,
-	0x9e, 0x65, 0xae, 0x67, 0x0e, 0xcf, 0xa4, 0x2c, 0x58, 0xd8, 0x39, 0xe3, 0xdf, 0x25, 0x74, 0xb1,
-	0x8f, 0xf7, 0xc5, 0x1b, 0x83, 0x93, 0x49, 0xb1, 0xd8, 0xb2, 0x72, 0x18, 0x08, 0xd0, 0x54, 0xe4,
-	0x9a, 0x16, 0xf1, 0x42, 0xb2, 0xa6, 0x98, 0xe7, 0xc6, 0x3f, 0x4b, 0x68, 0x3c, 0xd9, 0x35, 0xe3,
-	0x0c, 0x67, 0x58, 0xdc, 0x93, 0xcb, 0x37, 0x0e, 0x98, 0x0d, 0x42, 0x96, 0xb8, 0x90, 0x59, 0x4c,
-	0x52, 0xce, 0xf1, 0x90, 0xfb, 0xc6, 0x4f, 0x7a, 0xbb, 0x28, 0xee, 0x3d, 0xb3, 0x74, 0x51, 0xaa,
-	0xcf, 0xcd, 0xd2, 0x45, 0xe9, 0xf6, 0x97, 0xac, 0x73, 0x61, 0x05, 0xbc, 0x92, 0x2c, 0x2c, 0xd9,
-	0xf2, 0xe2, 0x7f, 0x24, 0x34, 0xbd, 0xdf, 0xd7, 0x01, 0x7e, 0xf3, 0xe0, 0x04, 0xc3, 0xdf, 0x23,
-	0xf2, 0xad, 0x43, 0xe3, 0x80, 0xd6, 0xeb, 0x5c, 0xeb, 0x1a, 0x2e, 0x0e, 0xae, 0x95, 0x7f, 0x97,
-	0x44, 0x6f, 0xe5, 0xc0, 0xa2, 0x67, 0xb9, 0x95, 0x63, 0xf6, 0x3f, 0xcb, 0xad, 0x1c, 0xff, 0x2a,
-	0xd8, 0xef, 0x56, 0x0e, 0x79, 0x7d, 0xfc, 0xa3, 0x84, 0x70, 0xdc, 0xb8, 0xe3, 0x17, 0x06, 0xaf,
-	0xdf, 0xfb, 0x35, 0x20, 0xbf, 0x78, 0x80, 0x4c, 0xa0, 0x3d, 0xc7, 0x69, 0xe7, 0xf1, 0xa5, 0x64,
-	0xda, 0xf0, 0x79, 0x80, 0x7f, 0x90, 0xd0, 0xff, 0x23, 0x3d, 0x89, 0x9f, 0xcb, 0xd6, 0xc3, 0x3e,
-	0xd9, 0xe7, 0xb3, 0xa6, 0x01, 0x53, 0xc2, 0x99, 0x4e, 0x61, 0x39, 0xbd, 0xe7, 0x95, 0x3b, 0x0f,
-	0x9f, 0xe6, 0xa4, 0x47, 0x4f, 0x73, 0xd2, 0x93, 0xa7, 0x39, 0xe9, 0xeb, 0x67, 0xb9, 0xa1, 0x47,
-	0xcf, 0x72, 0x43, 0x7f, 0x3c, 0xcb, 0x0d, 0xbd, 0xbf, 0x1e, 0xfa, 0x4f, 0x2b, 0xe4, 0xaf, 0x1a,
-	0x5a, 0xc5, 0x09, 0x81, 0x5d, 0x2f, 0xee, 0x85, 0xee, 0xab, 0x96, 0x45, 0x9d, 0xca, 0x30, 0x7f,
-	0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x55, 0x7f, 0x61, 0xac, 0x17, 0x00, 0x00,
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevMaxPromotedHotRoutesRequest) ProtoMessage() {}
+func (*QueryGetProtoRevMaxPromotedHotRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{30}
+}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+// QueryGetProtoRevMaxPromotedHotRoutesResponse is response type for the
+// Query/GetProtoRevMaxPromotedHotRoutes RPC method.
+type QueryGetProtoRevMaxPromotedHotRoutesResponse struct {
+	// max_promoted_hot_routes is the maximum number of routes that can be
+	// automatically promoted to hot routes
+	MaxPromotedHotRoutes uint64 `protobuf:"varint,1,opt,name=max_promoted_hot_routes,json=maxPromotedHotRoutes,proto3" json:"max_promoted_hot_routes,omitempty" yaml:"max_promoted_hot_routes"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) Reset() {
+	*m = QueryGetProtoRevMaxPromotedHotRoutesResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*QueryGetProtoRevMaxPromotedHotRoutesResponse) ProtoMessage() {}
+func (*QueryGetProtoRevMaxPromotedHotRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{31}
 }
-
-func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	out := new(QueryGetProtoRevNumberOfTradesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	out := new(QueryGetProtoRevProfitsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
-	out := new(QueryGetProtoRevAllProfitsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	out := new(QueryGetProtoRevStatisticsByRouteResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevMaxPromotedHotRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) GetMaxPromotedHotRoutes() uint64 {
+	if m != nil {
+		return m.MaxPromotedHotRoutes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
-	out := new(QueryGetProtoRevAdminAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevHotRouteCandidatesRequest is request type for the
+// Query/GetProtoRevHotRouteCandidates RPC method.
+type QueryGetProtoRevHotRouteCandidatesRequest struct {
 }
 
-func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	out := new(QueryGetProtoRevDeveloperAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) Reset() {
+	*m = QueryGetProtoRevHotRouteCandidatesRequest{}
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevHotRouteCandidatesRequest) ProtoMessage() {}
+func (*QueryGetProtoRevHotRouteCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	out := new(QueryGetProtoRevPoolWeightsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevHotRouteCandidatesResponse is response type for the
+// Query/GetProtoRevHotRouteCandidates RPC method.
+type QueryGetProtoRevHotRouteCandidatesResponse struct {
+	// hot_route_candidates is a list of all of the hot route candidates
+	HotRouteCandidates []HotRouteCandidate `protobuf:"bytes,1,rep,name=hot_route_candidates,json=hotRouteCandidates,proto3" json:"hot_route_candidates" yaml:"hot_route_candidates"`
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) Reset() {
+	*m = QueryGetProtoRevHotRouteCandidatesResponse{}
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevHotRouteCandidatesResponse) ProtoMessage() {}
+func (*QueryGetProtoRevHotRouteCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevHotRouteCandidatesResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) GetHotRouteCandidates() []HotRouteCandidate {
+	if m != nil {
+		return m.HotRouteCandidates
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	out := new(QueryGetProtoRevBaseDenomsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevHotRouteOverridesRequest is request type for the
+// Query/GetProtoRevHotRouteOverrides RPC method.
+type QueryGetProtoRevHotRouteOverridesRequest struct {
 }
 
-func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
-	out := new(QueryGetProtoRevEnabledResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevHotRouteOverridesRequest) Reset() {
+	*m = QueryGetProtoRevHotRouteOverridesRequest{}
 }
-
-func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
-	out := new(QueryGetProtoRevPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevHotRouteOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevHotRouteOverridesRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevHotRouteOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevHotRouteOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevHotRouteOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevHotRouteOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+func (m *QueryGetProtoRevHotRouteOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevHotRouteOverridesRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevHotRouteOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevHotRouteOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevHotRouteOverridesRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryGetProtoRevHotRouteOverridesRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevHotRouteOverridesResponse is response type for the
+// Query/GetProtoRevHotRouteOverrides RPC method.
+type QueryGetProtoRevHotRouteOverridesResponse struct {
+	// hot_route_overrides is a list of all of the hot route overrides
+	HotRouteOverrides []HotRouteOverride `protobuf:"bytes,1,rep,name=hot_route_overrides,json=hotRouteOverrides,proto3" json:"hot_route_overrides" yaml:"hot_route_overrides"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) Reset() {
+	*m = QueryGetProtoRevHotRouteOverridesResponse{}
 }
-func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
+func (*QueryGetProtoRevHotRouteOverridesResponse) ProtoMessage() {}
+func (*QueryGetProtoRevHotRouteOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
 }
-func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevHotRouteOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevHotRouteOverridesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+func (m *QueryGetProtoRevHotRouteOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevHotRouteOverridesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+
+var xxx_messageInfo_QueryGetProtoRevHotRouteOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevHotRouteOverridesResponse) GetHotRouteOverrides() []HotRouteOverride {
+	if m != nil {
+		return m.HotRouteOverrides
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
+
+// QueryGetProtoRevEpochStatisticsRequest is request type for the
+// Query/GetProtoRevEpochStatistics RPC method.
+type QueryGetProtoRevEpochStatisticsRequest struct {
+	// start_time is the earliest start time of the epochs to query
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time is the latest start time of the epochs to query
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsRequest{}
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+func (m *QueryGetProtoRevEpochStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevEpochStatisticsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevEpochStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
 }
-func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+func (m *QueryGetProtoRevEpochStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+func (m *QueryGetProtoRevEpochStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
+func (m *QueryGetProtoRevEpochStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetProtoRevEpochStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevEpochStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevNumberOfTradesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+func (m *QueryGetProtoRevEpochStatisticsRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevProfitsByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevEpochStatisticsResponse is response type for the
+// Query/GetProtoRevEpochStatistics RPC method.
+type QueryGetProtoRevEpochStatisticsResponse struct {
+	// epoch_statistics is the statistics of each epoch in the time range
+	EpochStatistics []EpochStatistics `protobuf:"bytes,1,rep,name=epoch_statistics,json=epochStatistics,proto3" json:"epoch_statistics" yaml:"epoch_statistics"`
+	// number_of_trades is the number of trades the module has executed over all
+	// of the epochs in the time range
+	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
+	// profits is the profit from all trades over all of the epochs in the time
+	// range by denom
+	Profits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=profits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"profits" yaml:"profits"`
 }
 
-func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllProfitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+func (m *QueryGetProtoRevEpochStatisticsResponse) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsResponse{}
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevEpochStatisticsResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevEpochStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevEpochStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevStatisticsByRouteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsResponse) GetEpochStatistics() []EpochStatistics {
+	if m != nil {
+		return m.EpochStatistics
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+func (m *QueryGetProtoRevEpochStatisticsResponse) GetProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Profits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevProfitDistributionsRequest is request type for the
+// Query/GetProtoRevProfitDistributions RPC method.
+type QueryGetProtoRevProfitDistributionsRequest struct {
 }
 
-func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAdminAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+func (m *QueryGetProtoRevProfitDistributionsRequest) Reset() {
+	*m = QueryGetProtoRevProfitDistributionsRequest{}
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitDistributionsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProfitDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{38}
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevDeveloperAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevProfitDistributionsResponse is response type for the
+// Query/GetProtoRevProfitDistributions RPC method.
+type QueryGetProtoRevProfitDistributionsResponse struct {
+	// profit_distributions is the most recent distributions of the profits of
+	// the module
+	ProfitDistributions []ProfitDistribution `protobuf:"bytes,1,rep,name=profit_distributions,json=profitDistributions,proto3" json:"profit_distributions" yaml:"profit_distributions"`
 }
 
-func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolWeightsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
+func (m *QueryGetProtoRevProfitDistributionsResponse) Reset() {
+	*m = QueryGetProtoRevProfitDistributionsResponse{}
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitDistributionsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProfitDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{39}
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+var xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) GetProfitDistributions() []ProfitDistribution {
+	if m != nil {
+		return m.ProfitDistributions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevProjectedProfitDistributionRequest is request type for the
+// Query/GetProtoRevProjectedProfitDistribution RPC method.
+type QueryGetProtoRevProjectedProfitDistributionRequest struct {
 }
 
-func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevBaseDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) Reset() {
+	*m = QueryGetProtoRevProjectedProfitDistributionRequest{}
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProjectedProfitDistributionRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProjectedProfitDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{40}
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionRequest.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevEnabledRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevProjectedProfitDistributionResponse is response type for the
+// Query/GetProtoRevProjectedProfitDistribution RPC method.
+type QueryGetProtoRevProjectedProfitDistributionResponse struct {
+	// profit_distribution is the projected distribution of the profits of the
+	// module
+	ProfitDistribution ProfitDistribution `protobuf:"bytes,1,opt,name=profit_distribution,json=profitDistribution,proto3" json:"profit_distribution" yaml:"profit_distribution"`
 }
 
-func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) Reset() {
+	*m = QueryGetProtoRevProjectedProfitDistributionResponse{}
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProjectedProfitDistributionResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProjectedProfitDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{41}
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevProjectedProfitDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) GetProfitDistribution() ProfitDistribution {
+	if m != nil {
+		return m.ProfitDistribution
 	}
-	return interceptor(ctx, in, info, handler)
+	return ProfitDistribution{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.protorev.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetProtoRevNumberOfTrades",
-			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
-		},
-		{
-			MethodName: "GetProtoRevProfitsByDenom",
-			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllProfits",
-			Handler:    _Query_GetProtoRevAllProfits_Handler,
-		},
-		{
-			MethodName: "GetProtoRevStatisticsByRoute",
-			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllRouteStatistics",
-			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
-		},
-		{
-			MethodName: "GetProtoRevTokenPairArbRoutes",
-			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAdminAccount",
-			Handler:    _Query_GetProtoRevAdminAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevDeveloperAccount",
-			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPoolWeights",
-			Handler:    _Query_GetProtoRevPoolWeights_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerTx",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
-		},
-		{
-			MethodName: "GetProtoRevBaseDenoms",
-			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
-		},
-		{
-			MethodName: "GetProtoRevEnabled",
-			Handler:    _Query_GetProtoRevEnabled_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPool",
-			Handler:    _Query_GetProtoRevPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/protorev/v1beta1/query.proto",
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
+	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
+	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPromotedHotRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPromotedHotRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPromotedHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPromotedHotRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevHotRouteCandidatesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevHotRouteCandidatesRequest")
+	proto.RegisterType((*QueryGetProtoRevHotRouteCandidatesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevHotRouteCandidatesResponse")
+	proto.RegisterType((*QueryGetProtoRevHotRouteOverridesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevHotRouteOverridesRequest")
+	proto.RegisterType((*QueryGetProtoRevHotRouteOverridesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevHotRouteOverridesResponse")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsRequest")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitDistributionsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitDistributionsRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitDistributionsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitDistributionsResponse")
+	proto.RegisterType((*QueryGetProtoRevProjectedProfitDistributionRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProjectedProfitDistributionRequest")
+	proto.RegisterType((*QueryGetProtoRevProjectedProfitDistributionResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProjectedProfitDistributionResponse")
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_f5e7ac9973cce389 = []byte{
+	// 2090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x4f, 0x3b, 0xbb, 0xf6, 0xe6, 0x4b, 0x76, 0x93, 0x94, 0x9d, 0x57, 0xc7, 0x99, 0x71, 0xca,
+	0xef, 0xd7, 0x8c, 0x9c, 0x38, 0x04, 0xd8, 0x0d, 0xac, 0xdb, 0x93, 0x5d, 0xa2, 0x65, 0xd7, 0xa6,
+	0x09, 0xe2, 0x25, 0x31, 0xf4, 0x4c, 0x97, 0xed, 0x26, 0x33, 0x5d, 0x93, 0xee, 0x1e, 0xaf, 0x7d,
+	0x41, 0x22, 0x20, 0x24, 0x04, 0x82, 0x05, 0xce, 0xdc, 0x38, 0xc1, 0x05, 0x24, 0x2e, 0x1c, 0x38,
+	0x70, 0x40, 0xda, 0x03, 0x42, 0x8b, 0x10, 0x08, 0xed, 0x61, 0xb2, 0x24, 0x48, 0x5c, 0x38, 0xf9,
+	0x2f, 0x40, 0x5d, 0xfd, 0xf5, 0x4c, 0xbb, 0xbb, 0x7a, 0xa6, 0xdb, 0x03, 0x9c, 0xec, 0xe9, 0xfa,
+	0xea, 0xf7, 0xfd, 0x7e, 0x55, 0xf5, 0xd5, 0xe3, 0x07, 0x33, 0xdc, 0x6d, 0x72, 0xd7, 0x72, 0xcb,
+	0x2d, 0x87, 0x7b, 0xdc, 0x61, 0xfb, 0xe5, 0xfd, 0xb5, 0x1a, 0xf3, 0x8c, 0xb5, 0xf2, 0xe3, 0x36,
+	0x73, 0x0e, 0x4b, 0xe2, 0x33, 0xb9, 0x8a, 0x51, 0xa5, 0x30, 0xaa, 0x84, 0x51, 0xea, 0xc4, 0x2e,
+	0xdf, 0xe5, 0xe2, 0x6b, 0xd9, 0xff, 0x2f, 0x08, 0x50, 0x27, 0x77, 0x39, 0xdf, 0x6d, 0xb0, 0xb2,
+	0xd1, 0xb2, 0xca, 0x86, 0x6d, 0x73, 0xcf, 0xf0, 0x2c, 0x6e, 0x63, 0x77, 0xb5, 0x88, 0xad, 0xe2,
+	0x57, 0xad, 0xbd, 0x53, 0xf6, 0xac, 0x26, 0x73, 0x3d, 0xa3, 0xd9, 0xc2, 0x80, 0xa5, 0xba, 0xc8,
+	0x57, 0xae, 0x19, 0x2e, 0x0b, 0x78, 0x74, 0x59, 0xb5, 0x8c, 0x5d, 0xcb, 0x16, 0x68, 0x18, 0x3b,
+	0x9b, 0x2a, 0xa0, 0x65, 0x38, 0x46, 0x33, 0xcc, 0x39, 0x9f, 0x1e, 0x16, 0x4a, 0x0a, 0x02, 0x0b,
+	0xd1, 0xdc, 0x61, 0x4c, 0x9d, 0x5b, 0x98, 0x8f, 0x4e, 0x00, 0xf9, 0x9c, 0xcf, 0x68, 0x5b, 0xa0,
+	0xeb, 0xec, 0x71, 0x9b, 0xb9, 0x1e, 0xdd, 0x81, 0xf1, 0x63, 0x5f, 0xdd, 0x16, 0xb7, 0x5d, 0x46,
+	0xb6, 0x60, 0x34, 0x60, 0x71, 0x55, 0x99, 0x52, 0x16, 0xce, 0xde, 0x9a, 0x2a, 0xa5, 0x0d, 0x64,
+	0x29, 0xe8, 0xa9, 0x5d, 0x7a, 0xbf, 0x53, 0x3c, 0x75, 0xd4, 0x29, 0xbe, 0x7c, 0x68, 0x34, 0x1b,
+	0x9f, 0xa4, 0x41, 0x6f, 0xaa, 0x23, 0x0c, 0x9d, 0x87, 0x59, 0x91, 0xe7, 0x4d, 0xe6, 0x6d, 0xfb,
+	0x08, 0x3a, 0xdb, 0x7f, 0xa7, 0xdd, 0xac, 0x31, 0x67, 0x6b, 0xe7, 0xa1, 0x63, 0x98, 0xac, 0x4b,
+	0xe8, 0x67, 0x0a, 0xcc, 0x0d, 0x8a, 0x44, 0x92, 0x2e, 0x5c, 0xb0, 0x45, 0x4b, 0x95, 0xef, 0x54,
+	0x3d, 0xd1, 0x26, 0xe8, 0x9e, 0xd1, 0x1e, 0xf8, 0x64, 0x3e, 0xec, 0x14, 0xe7, 0x76, 0x2d, 0x6f,
+	0xaf, 0x5d, 0x2b, 0xd5, 0x79, 0xb3, 0x8c, 0xc3, 0x13, 0xfc, 0x59, 0x75, 0xcd, 0x47, 0x65, 0xef,
+	0xb0, 0xc5, 0xdc, 0xd2, 0x03, 0xdb, 0x3b, 0xea, 0x14, 0xaf, 0x04, 0xb4, 0xe3, 0x78, 0x54, 0x7f,
+	0xc5, 0x3e, 0x96, 0x9c, 0x6e, 0x25, 0x85, 0x6c, 0x3b, 0x7c, 0xc7, 0xf2, 0x5c, 0xed, 0xb0, 0xc2,
+	0x6c, 0xde, 0x44, 0x21, 0x64, 0x0e, 0x5e, 0x34, 0xfd, 0xdf, 0x48, 0xe9, 0xc2, 0x51, 0xa7, 0x78,
+	0x2e, 0x48, 0x22, 0x3e, 0x53, 0x3d, 0x68, 0xa6, 0x36, 0xcc, 0x0d, 0x02, 0x44, 0xbd, 0x15, 0x18,
+	0x6d, 0x89, 0x16, 0x9c, 0x94, 0x6b, 0xa5, 0x40, 0x4c, 0xc9, 0x9f, 0xf2, 0xee, 0x7c, 0x6c, 0x72,
+	0xcb, 0xd6, 0x2e, 0x46, 0x66, 0x42, 0x74, 0xf1, 0x67, 0x22, 0xf8, 0x67, 0x1a, 0x6e, 0xc6, 0xf3,
+	0x6d, 0x34, 0x1a, 0x98, 0x32, 0x9c, 0x85, 0xc7, 0x40, 0xfb, 0x05, 0x21, 0xa1, 0xb7, 0x60, 0x2c,
+	0x00, 0xf5, 0xc7, 0xfd, 0x74, 0x7f, 0x46, 0x97, 0x71, 0x7d, 0xbc, 0x12, 0x65, 0xe5, 0x52, 0x7d,
+	0xac, 0xfb, 0x1f, 0x2c, 0xc4, 0x53, 0x7e, 0xde, 0x2f, 0x3f, 0xd7, 0xb3, 0xea, 0xae, 0x76, 0xa8,
+	0xf3, 0xb6, 0xc7, 0x22, 0x63, 0xeb, 0xf8, 0xbf, 0x45, 0xda, 0x17, 0xa2, 0x63, 0x2b, 0x3e, 0x53,
+	0x3d, 0x68, 0xa6, 0x3f, 0x56, 0x60, 0x31, 0x03, 0x28, 0xca, 0x31, 0x01, 0xdc, 0x6e, 0x23, 0x8e,
+	0xf1, 0x62, 0xfa, 0xc2, 0x17, 0x9d, 0x23, 0x68, 0xd7, 0x50, 0xe1, 0xc5, 0x80, 0x49, 0x0f, 0x8a,
+	0xea, 0x11, 0x5c, 0xba, 0x9c, 0xa4, 0xb4, 0xd1, 0x68, 0xc4, 0xc0, 0xc2, 0x79, 0xf8, 0x89, 0x02,
+	0x4b, 0x59, 0xa2, 0x53, 0x14, 0x9c, 0xfe, 0x7f, 0x29, 0x78, 0xc8, 0x1f, 0x31, 0x7b, 0xdb, 0xb0,
+	0x9c, 0x0d, 0xa7, 0x26, 0x50, 0xbb, 0x0a, 0xbe, 0x27, 0x51, 0x20, 0x8b, 0x46, 0x05, 0x5f, 0x85,
+	0x51, 0x31, 0x75, 0x21, 0xfb, 0x95, 0x74, 0xf6, 0x49, 0x94, 0xf8, 0x26, 0x14, 0x20, 0x51, 0x1d,
+	0x21, 0xe9, 0x2c, 0x4c, 0x27, 0x06, 0xd3, 0x6c, 0x5a, 0xf6, 0x46, 0xbd, 0xce, 0xdb, 0xb6, 0x17,
+	0x52, 0x66, 0x30, 0xd3, 0x3f, 0x0c, 0xb9, 0xde, 0x83, 0x97, 0x0d, 0xff, 0x7b, 0xd5, 0x08, 0x1a,
+	0xb0, 0xd2, 0xaf, 0x1e, 0x75, 0x8a, 0x13, 0x01, 0x81, 0x63, 0xcd, 0x54, 0x3f, 0x67, 0x44, 0x60,
+	0xe8, 0x22, 0xcc, 0xc7, 0xd3, 0x54, 0xd8, 0x3e, 0x6b, 0xf0, 0x16, 0x73, 0x62, 0x8c, 0xda, 0xb0,
+	0x30, 0x38, 0x14, 0x59, 0x3d, 0x80, 0x8b, 0x66, 0xd8, 0x16, 0x63, 0x36, 0x79, 0xd4, 0x29, 0x5e,
+	0x0d, 0xf7, 0xa0, 0x58, 0x08, 0xd5, 0x2f, 0x98, 0x31, 0x48, 0x3a, 0x93, 0xdc, 0x05, 0xb6, 0x39,
+	0x6f, 0x7c, 0x91, 0x59, 0xbb, 0x7b, 0xbd, 0xbd, 0xe2, 0x07, 0x0a, 0x4c, 0xf7, 0x0d, 0x43, 0x62,
+	0x0c, 0xce, 0xb5, 0x38, 0x6f, 0x54, 0xdf, 0x0d, 0xbe, 0x63, 0x81, 0xcd, 0xf6, 0x39, 0x59, 0x7a,
+	0x20, 0xda, 0x75, 0x9c, 0xd9, 0x71, 0xdc, 0x3e, 0x22, 0x40, 0x54, 0x3f, 0xdb, 0xea, 0x45, 0xd2,
+	0x12, 0xac, 0xc4, 0xd9, 0xbc, 0x6d, 0x1c, 0xf8, 0x58, 0xdb, 0xdc, 0xb2, 0x3d, 0x77, 0x9b, 0x39,
+	0x5a, 0x83, 0xd7, 0x1f, 0x85, 0xf4, 0x7f, 0xa4, 0xc0, 0x6a, 0xc6, 0x0e, 0x28, 0xe4, 0x6b, 0x70,
+	0xad, 0x69, 0x1c, 0x54, 0x05, 0x87, 0x96, 0x08, 0xa9, 0xfa, 0x03, 0x59, 0xf3, 0x83, 0x84, 0xaa,
+	0x17, 0xb4, 0x99, 0xa3, 0x4e, 0x71, 0x2a, 0xa0, 0x9a, 0x1a, 0x4a, 0xf5, 0x4b, 0x4d, 0x59, 0x1e,
+	0x59, 0x7d, 0xc5, 0x09, 0x3d, 0x3c, 0x08, 0xe9, 0x7f, 0x5b, 0x52, 0x5f, 0xb2, 0x68, 0xe4, 0xfe,
+	0x05, 0xb8, 0x2c, 0x23, 0xe4, 0x1d, 0x20, 0xf1, 0x9b, 0x47, 0x9d, 0xe2, 0x8d, 0x74, 0xe2, 0xde,
+	0x01, 0xd5, 0x49, 0x33, 0x01, 0x2f, 0x3b, 0x54, 0x34, 0xc3, 0x65, 0xe2, 0xfc, 0xea, 0x2e, 0x94,
+	0xef, 0x2a, 0x40, 0xfb, 0x45, 0x21, 0xc5, 0xaf, 0xc3, 0x59, 0xff, 0xf8, 0xa8, 0x8a, 0xe3, 0x31,
+	0xdc, 0x07, 0xa6, 0xd3, 0x97, 0x49, 0x17, 0x42, 0x53, 0x71, 0x91, 0x90, 0x40, 0x40, 0x04, 0x85,
+	0xea, 0x50, 0xeb, 0x66, 0xa2, 0x53, 0x50, 0x88, 0xf3, 0xb8, 0x6f, 0x1b, 0xb5, 0x06, 0x33, 0x43,
+	0xaa, 0x5b, 0x50, 0x4c, 0x8d, 0x40, 0x9a, 0x2b, 0x30, 0xc6, 0x82, 0x4f, 0x62, 0xe8, 0x5e, 0xd2,
+	0x48, 0xef, 0x74, 0xc3, 0x06, 0xaa, 0x87, 0x21, 0x7e, 0x91, 0x5c, 0x97, 0x15, 0x49, 0x78, 0xa2,
+	0xad, 0x03, 0xf4, 0xe8, 0x62, 0xb9, 0x5e, 0xea, 0x6d, 0xc5, 0xbd, 0x36, 0xaa, 0x9f, 0xe9, 0x2a,
+	0x21, 0x77, 0xe1, 0x2c, 0xf7, 0xf6, 0x98, 0x83, 0xdd, 0x46, 0x44, 0xb7, 0xcb, 0xbd, 0x11, 0x88,
+	0x34, 0x52, 0x1d, 0xc4, 0x2f, 0xd1, 0x91, 0xbe, 0x05, 0x93, 0x72, 0x36, 0x28, 0x6e, 0x19, 0xc6,
+	0xc4, 0xd4, 0x5b, 0x26, 0xae, 0x8b, 0x88, 0x38, 0x6c, 0xf0, 0x6f, 0x14, 0x9c, 0x37, 0x1e, 0x98,
+	0x74, 0x15, 0x96, 0x65, 0x2b, 0xd0, 0xe1, 0x4d, 0xee, 0x31, 0xf3, 0x33, 0xdc, 0x4b, 0x9c, 0x08,
+	0x2b, 0xd9, 0xe2, 0x91, 0xcc, 0x97, 0xe1, 0x8a, 0x58, 0x8b, 0x18, 0x50, 0xdd, 0xe3, 0x5e, 0xb5,
+	0x7b, 0x48, 0xf8, 0xe4, 0xe8, 0x51, 0xa7, 0x58, 0x88, 0x2c, 0xda, 0x64, 0x20, 0xd5, 0x27, 0x9a,
+	0x92, 0x14, 0xb2, 0x52, 0x0b, 0x1b, 0x37, 0x0d, 0xdb, 0xb4, 0x4c, 0x23, 0x42, 0xfc, 0xd7, 0x92,
+	0x52, 0x93, 0x45, 0x23, 0xed, 0x27, 0x0a, 0x4c, 0x74, 0x19, 0x54, 0xeb, 0xdd, 0x00, 0x5c, 0xd1,
+	0xcb, 0xe9, 0x2b, 0x3a, 0x01, 0xaa, 0x4d, 0xe3, 0xca, 0xbe, 0x1e, 0xa8, 0x94, 0xc1, 0x52, 0x9d,
+	0xec, 0x25, 0xc8, 0xd0, 0xa5, 0xe4, 0xc9, 0x11, 0xa2, 0x6f, 0xed, 0x33, 0xc7, 0xb1, 0x22, 0x57,
+	0xef, 0x5f, 0x2a, 0xb0, 0x98, 0x21, 0x18, 0xe5, 0x7d, 0x13, 0xc6, 0x7b, 0x34, 0x78, 0xd8, 0x8c,
+	0xe2, 0x96, 0x06, 0x8b, 0x0b, 0x11, 0x35, 0x8a, 0xda, 0xd4, 0xb8, 0xb6, 0x2e, 0x28, 0xd5, 0x2f,
+	0xee, 0xc5, 0x79, 0xd0, 0xbf, 0x49, 0x1e, 0x0a, 0xf7, 0x5b, 0xbc, 0xbe, 0x97, 0xb8, 0x45, 0x91,
+	0x2f, 0x89, 0x6b, 0x91, 0xe3, 0x55, 0xfd, 0xf7, 0x1a, 0x9e, 0x3b, 0x6a, 0x29, 0x78, 0xcc, 0x95,
+	0xc2, 0xc7, 0x5c, 0xe9, 0x61, 0xf8, 0x98, 0xd3, 0x6e, 0x24, 0xee, 0x41, 0xd8, 0x97, 0xbe, 0xf7,
+	0xb4, 0xa8, 0xe8, 0x67, 0xc4, 0x07, 0x3f, 0x9c, 0xe8, 0xf0, 0x12, 0xb3, 0xcd, 0x00, 0x77, 0x64,
+	0x20, 0x6e, 0x78, 0x88, 0x9d, 0x0f, 0x77, 0x09, 0x33, 0x82, 0x3a, 0xc6, 0x6c, 0xd3, 0x0f, 0xa5,
+	0x3f, 0x3c, 0x0d, 0xf3, 0x03, 0x85, 0xe1, 0x24, 0xb4, 0xe1, 0x02, 0xf3, 0x9b, 0xaa, 0x79, 0xae,
+	0x7d, 0x31, 0x30, 0xad, 0x88, 0xb4, 0xf0, 0x0d, 0x14, 0x07, 0xa4, 0xfa, 0x79, 0x76, 0xbc, 0x87,
+	0xf4, 0xe5, 0x35, 0xf2, 0x3f, 0x7e, 0x79, 0x91, 0x77, 0x7b, 0xaf, 0x8d, 0xd3, 0x83, 0x5e, 0x1b,
+	0x9a, 0xfc, 0xb5, 0xf1, 0x8b, 0xa7, 0xc5, 0x85, 0x0c, 0xc4, 0x7c, 0x08, 0xb7, 0xf7, 0x32, 0x59,
+	0x49, 0x96, 0x7d, 0xf0, 0x12, 0xaa, 0x58, 0xae, 0xe7, 0x58, 0xb5, 0xb6, 0xf0, 0x08, 0xc2, 0x2a,
+	0xfa, 0x8d, 0x02, 0xcb, 0x99, 0xc2, 0x71, 0x0a, 0xbf, 0xa3, 0xc0, 0x44, 0x90, 0xa9, 0x6a, 0x46,
+	0x03, 0x06, 0x5f, 0x80, 0x93, 0xa8, 0xf1, 0x7d, 0x42, 0x86, 0x4b, 0xf5, 0xf1, 0x56, 0x92, 0x0e,
+	0x5d, 0x87, 0x5b, 0x12, 0xd6, 0xdf, 0x60, 0x75, 0x8f, 0x99, 0xc9, 0x44, 0xa1, 0xd8, 0xdf, 0x2a,
+	0x70, 0x3b, 0x57, 0x37, 0x14, 0xfd, 0x2d, 0x05, 0xc6, 0x25, 0xe4, 0xb0, 0x36, 0xf3, 0x69, 0x8e,
+	0xed, 0x1f, 0x12, 0x58, 0xaa, 0x93, 0xa4, 0xe4, 0x5b, 0x7f, 0xa4, 0xf0, 0xa2, 0xe0, 0x4e, 0xbe,
+	0xaf, 0xc0, 0x68, 0x60, 0x63, 0x90, 0x3e, 0xa9, 0x93, 0xee, 0x89, 0xba, 0x9a, 0x31, 0x3a, 0x50,
+	0x4d, 0x67, 0x9e, 0xfc, 0xe5, 0x9f, 0x3f, 0x1d, 0x29, 0x90, 0xc9, 0x32, 0x76, 0x2b, 0xef, 0xaf,
+	0xad, 0xf7, 0x8c, 0x9d, 0xc0, 0x2a, 0x21, 0x7f, 0x52, 0xe0, 0x5a, 0xaa, 0xf9, 0x41, 0x3e, 0x3d,
+	0x20, 0xe5, 0x20, 0x83, 0x45, 0x7d, 0xfd, 0xe4, 0x00, 0x28, 0xa3, 0x24, 0x64, 0x2c, 0x90, 0x39,
+	0xb9, 0x8c, 0x78, 0x25, 0xc7, 0x05, 0x1d, 0x77, 0x37, 0xf2, 0x08, 0x92, 0x1a, 0x2d, 0xea, 0xeb,
+	0x27, 0x07, 0xc8, 0x26, 0x08, 0xf7, 0x81, 0x6a, 0xed, 0x30, 0xb8, 0x4a, 0x91, 0xdf, 0x29, 0x70,
+	0x49, 0xea, 0x8c, 0x90, 0x57, 0xb3, 0x73, 0x49, 0x98, 0x2e, 0xea, 0x6b, 0x27, 0xeb, 0x8c, 0x22,
+	0x16, 0x85, 0x88, 0x69, 0x72, 0x53, 0x2e, 0xc2, 0x68, 0x34, 0xaa, 0x28, 0x84, 0x7c, 0xa8, 0xc0,
+	0x64, 0x3f, 0x47, 0x84, 0x68, 0xd9, 0x99, 0xa4, 0x79, 0x34, 0xea, 0xe6, 0x50, 0x18, 0x28, 0x6a,
+	0x4d, 0x88, 0x5a, 0x26, 0x8b, 0x72, 0x51, 0xbd, 0x43, 0xca, 0x9f, 0x1c, 0x71, 0x6b, 0x20, 0x1d,
+	0x05, 0x6e, 0xf4, 0x75, 0x4b, 0xc8, 0x66, 0xae, 0x71, 0x96, 0x3b, 0x33, 0x6a, 0x65, 0x38, 0x10,
+	0xd4, 0x77, 0x4b, 0xe8, 0x5b, 0x21, 0x4b, 0xe9, 0x93, 0x16, 0xdc, 0x85, 0x7a, 0x4a, 0xc9, 0xd3,
+	0xe3, 0x02, 0x93, 0x36, 0x48, 0x1e, 0x81, 0xa9, 0xc6, 0x8d, 0x5a, 0x19, 0x0e, 0x04, 0x05, 0xde,
+	0x16, 0x02, 0x57, 0xc9, 0xb2, 0x5c, 0xa0, 0xe7, 0xf7, 0xac, 0xb6, 0x0c, 0xcb, 0xa9, 0x1a, 0x4e,
+	0x2d, 0xd0, 0xea, 0x92, 0x3f, 0x28, 0x70, 0x25, 0xc5, 0x7c, 0x21, 0xf7, 0x72, 0x8c, 0x7b, 0xd2,
+	0xdb, 0x51, 0x3f, 0x75, 0xd2, 0xee, 0xa8, 0x67, 0x59, 0xe8, 0x99, 0x25, 0xd3, 0x29, 0x13, 0x16,
+	0x35, 0x7c, 0xc8, 0x5f, 0x15, 0xb8, 0xde, 0xc7, 0xb2, 0x21, 0x1b, 0xd9, 0xc9, 0xa4, 0x38, 0x43,
+	0xaa, 0x36, 0x0c, 0x04, 0x6a, 0x2a, 0x0b, 0x4d, 0x8b, 0x64, 0x5e, 0xae, 0x29, 0x61, 0x15, 0x91,
+	0xdf, 0x2b, 0x70, 0x59, 0x6e, 0xf6, 0x90, 0x1c, 0x7b, 0x58, 0xd2, 0x4a, 0x52, 0xef, 0x9d, 0xb0,
+	0x37, 0x0a, 0x59, 0x12, 0x42, 0x66, 0x08, 0x4d, 0xd9, 0xc7, 0x23, 0xa6, 0x11, 0xf9, 0xe8, 0x78,
+	0x15, 0x25, 0x2d, 0x93, 0x3c, 0x55, 0x94, 0x6a, 0xcf, 0xa8, 0x95, 0xe1, 0x40, 0x50, 0xd8, 0xba,
+	0x10, 0x56, 0x22, 0x2b, 0x72, 0x61, 0x72, 0xa7, 0x86, 0xfc, 0x5b, 0x81, 0xa9, 0x41, 0xa6, 0x16,
+	0x79, 0xe3, 0xe4, 0x04, 0xa3, 0x36, 0x9a, 0xfa, 0xe6, 0xd0, 0x38, 0xa8, 0xf5, 0xae, 0xd0, 0xba,
+	0x46, 0xca, 0xd9, 0xb5, 0x0a, 0x3b, 0x2d, 0x7e, 0x2a, 0xf7, 0x9c, 0xa5, 0x3c, 0xa7, 0x72, 0xc2,
+	0xb5, 0x52, 0x5f, 0x3b, 0x59, 0xe7, 0x6c, 0xa7, 0x72, 0xc4, 0xa2, 0x22, 0xbf, 0x52, 0x80, 0x24,
+	0xfd, 0x26, 0xf2, 0xf1, 0xec, 0xf9, 0x8f, 0x9b, 0x58, 0xea, 0x27, 0x4e, 0xd0, 0x13, 0x69, 0xcf,
+	0x0a, 0xda, 0x45, 0x72, 0x43, 0x4e, 0x1b, 0x5d, 0x2d, 0xf2, 0x73, 0x05, 0xce, 0xc7, 0x6a, 0x92,
+	0xdc, 0xc9, 0x57, 0xc3, 0x21, 0xd9, 0x8f, 0xe5, 0xed, 0x86, 0x4c, 0xa9, 0x60, 0x3a, 0x49, 0xd4,
+	0xf4, 0x9a, 0x27, 0xff, 0x52, 0xa0, 0x38, 0xc0, 0x6c, 0x22, 0xf7, 0xf3, 0xad, 0xdf, 0x14, 0x73,
+	0x4b, 0x7d, 0x63, 0x58, 0x18, 0x94, 0x75, 0x47, 0xc8, 0x2a, 0x93, 0xd5, 0x3e, 0x55, 0x90, 0xb4,
+	0xb9, 0xe2, 0x97, 0x9f, 0xa4, 0x3b, 0x95, 0x67, 0x57, 0x4b, 0x75, 0xc2, 0xd4, 0xca, 0x70, 0x20,
+	0xd9, 0x2e, 0x3f, 0x32, 0x93, 0x2b, 0x7e, 0x75, 0x4d, 0xd8, 0x53, 0x79, 0xae, 0xae, 0x69, 0x46,
+	0x98, 0xba, 0x39, 0x14, 0x46, 0xb6, 0xab, 0xab, 0xc4, 0xe6, 0x22, 0x7f, 0x56, 0x40, 0x4d, 0x37,
+	0x7d, 0x48, 0x8e, 0x87, 0x8e, 0xdc, 0x08, 0x53, 0x37, 0x86, 0x40, 0xc8, 0xf6, 0x56, 0x8a, 0x9b,
+	0x47, 0xe4, 0x1f, 0x0a, 0x14, 0xfa, 0x3b, 0x21, 0xa4, 0x92, 0xf7, 0x01, 0x27, 0xf3, 0x5d, 0xd4,
+	0xfb, 0x43, 0xa2, 0x64, 0x5b, 0x94, 0x32, 0x47, 0x85, 0x3c, 0x19, 0x81, 0xb9, 0x6c, 0x06, 0x08,
+	0xf9, 0x6c, 0x2e, 0x96, 0x03, 0xec, 0x17, 0xf5, 0xed, 0xff, 0x12, 0x1a, 0x6a, 0x7f, 0x55, 0x68,
+	0xbf, 0x43, 0x6e, 0xa7, 0x6a, 0x0f, 0x20, 0xaa, 0x92, 0x51, 0xd0, 0xde, 0x79, 0xff, 0x59, 0x41,
+	0xf9, 0xe0, 0x59, 0x41, 0xf9, 0xe8, 0x59, 0x41, 0x79, 0xef, 0x79, 0xe1, 0xd4, 0x07, 0xcf, 0x0b,
+	0xa7, 0xfe, 0xfe, 0xbc, 0x70, 0xea, 0x2b, 0xeb, 0x11, 0xcb, 0x0d, 0x81, 0x57, 0x1b, 0x46, 0xcd,
+	0x8d, 0x64, 0xb9, 0x5b, 0x3e, 0xe8, 0xe5, 0x11, 0x26, 0x5c, 0x6d, 0x54, 0xfc, 0xbe, 0xfd, 0x9f,
+	0x01, 0x00, 0x98, 0xac, 0x1f, 0x6b, 0xe9, 0x23, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevMaxPromotedHotRoutes queries the maximum number of routes that
+	// can be automatically promoted to hot routes
+	GetProtoRevMaxPromotedHotRoutes(ctx context.Context, in *QueryGetProtoRevMaxPromotedHotRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPromotedHotRoutesResponse, error)
+	// GetProtoRevHotRouteCandidates queries the routes the module has traded on
+	// that can be automatically promoted to hot routes
+	GetProtoRevHotRouteCandidates(ctx context.Context, in *QueryGetProtoRevHotRouteCandidatesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevHotRouteCandidatesResponse, error)
+	// GetProtoRevHotRouteOverrides queries the routes the admin account has
+	// pinned as hot routes or banned from being promoted to hot routes
+	GetProtoRevHotRouteOverrides(ctx context.Context, in *QueryGetProtoRevHotRouteOverridesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevHotRouteOverridesResponse, error)
+	// GetProtoRevEpochStatistics queries the statistics of the trades the module
+	// has executed over the day epochs that started within a time range
+	GetProtoRevEpochStatistics(ctx context.Context, in *QueryGetProtoRevEpochStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsResponse, error)
+	// GetProtoRevProfitDistributions queries the most recent distributions of the
+	// profits of the module
+	GetProtoRevProfitDistributions(ctx context.Context, in *QueryGetProtoRevProfitDistributionsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitDistributionsResponse, error)
+	// GetProtoRevProjectedProfitDistribution queries the distribution of the
+	// profits of the module that would happen at the end of the current epoch
+	// given the current state
+	GetProtoRevProjectedProfitDistribution(ctx context.Context, in *QueryGetProtoRevProjectedProfitDistributionRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProjectedProfitDistributionResponse, error)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	out := new(QueryGetProtoRevNumberOfTradesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	out := new(QueryGetProtoRevProfitsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
+	out := new(QueryGetProtoRevAllProfitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	out := new(QueryGetProtoRevStatisticsByRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NumberOfTrades.Size()
-		i -= size
-		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
+	out := new(QueryGetProtoRevAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	out := new(QueryGetProtoRevDeveloperAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	out := new(QueryGetProtoRevPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Profit != nil {
-		{
-			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Profits) > 0 {
-		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	out := new(QueryGetProtoRevBaseDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
+	out := new(QueryGetProtoRevEnabledResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		dAtA4 := make([]byte, len(m.Route)*10)
-		var j3 int
-		for _, num := range m.Route {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
+	out := new(QueryGetProtoRevPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPromotedHotRoutes(ctx context.Context, in *QueryGetProtoRevMaxPromotedHotRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPromotedHotRoutesResponse, error) {
+	out := new(QueryGetProtoRevMaxPromotedHotRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPromotedHotRoutes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevHotRouteCandidates(ctx context.Context, in *QueryGetProtoRevHotRouteCandidatesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevHotRouteCandidatesResponse, error) {
+	out := new(QueryGetProtoRevHotRouteCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevHotRouteCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevHotRouteOverrides(ctx context.Context, in *QueryGetProtoRevHotRouteOverridesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevHotRouteOverridesResponse, error) {
+	out := new(QueryGetProtoRevHotRouteOverridesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevHotRouteOverrides", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEpochStatistics(ctx context.Context, in *QueryGetProtoRevEpochStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsResponse, error) {
+	out := new(QueryGetProtoRevEpochStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatistics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetProtoRevProfitDistributions(ctx context.Context, in *QueryGetProtoRevProfitDistributionsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitDistributionsResponse, error) {
+	out := new(QueryGetProtoRevProfitDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevProjectedProfitDistribution(ctx context.Context, in *QueryGetProtoRevProjectedProfitDistributionRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProjectedProfitDistributionResponse, error) {
+	out := new(QueryGetProtoRevProjectedProfitDistributionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProjectedProfitDistribution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevMaxPromotedHotRoutes queries the maximum number of routes that
+	// can be automatically promoted to hot routes
+	GetProtoRevMaxPromotedHotRoutes(context.Context, *QueryGetProtoRevMaxPromotedHotRoutesRequest) (*QueryGetProtoRevMaxPromotedHotRoutesResponse, error)
+	// GetProtoRevHotRouteCandidates queries the routes the module has traded on
+	// that can be automatically promoted to hot routes
+	GetProtoRevHotRouteCandidates(context.Context, *QueryGetProtoRevHotRouteCandidatesRequest) (*QueryGetProtoRevHotRouteCandidatesResponse, error)
+	// GetProtoRevHotRouteOverrides queries the routes the admin account has
+	// pinned as hot routes or banned from being promoted to hot routes
+	GetProtoRevHotRouteOverrides(context.Context, *QueryGetProtoRevHotRouteOverridesRequest) (*QueryGetProtoRevHotRouteOverridesResponse, error)
+	// GetProtoRevEpochStatistics queries the statistics of the trades the module
+	// has executed over the day epochs that started within a time range
+	GetProtoRevEpochStatistics(context.Context, *QueryGetProtoRevEpochStatisticsRequest) (*QueryGetProtoRevEpochStatisticsResponse, error)
+	// GetProtoRevProfitDistributions queries the most recent distributions of the
+	// profits of the module
+	GetProtoRevProfitDistributions(context.Context, *QueryGetProtoRevProfitDistributionsRequest) (*QueryGetProtoRevProfitDistributionsResponse, error)
+	// GetProtoRevProjectedProfitDistribution queries the distribution of the
+	// profits of the module that would happen at the end of the current epoch
+	// given the current state
+	GetProtoRevProjectedProfitDistribution(context.Context, *QueryGetProtoRevProjectedProfitDistributionRequest) (*QueryGetProtoRevProjectedProfitDistributionResponse, error)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminAccount) > 0 {
-		i -= len(m.AdminAccount)
-		copy(dAtA[i:], m.AdminAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeveloperAccount) > 0 {
-		i -= len(m.DeveloperAccount)
-		copy(dAtA[i:], m.DeveloperAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
 }
-
-func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
 }
-
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxPromotedHotRoutes(ctx context.Context, req *QueryGetProtoRevMaxPromotedHotRoutesRequest) (*QueryGetProtoRevMaxPromotedHotRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPromotedHotRoutes not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevHotRouteCandidates(ctx context.Context, req *QueryGetProtoRevHotRouteCandidatesRequest) (*QueryGetProtoRevHotRouteCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevHotRouteCandidates not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevHotRouteOverrides(ctx context.Context, req *QueryGetProtoRevHotRouteOverridesRequest) (*QueryGetProtoRevHotRouteOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevHotRouteOverrides not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevEpochStatistics(ctx context.Context, req *QueryGetProtoRevEpochStatisticsRequest) (*QueryGetProtoRevEpochStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEpochStatistics not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitDistributions(ctx context.Context, req *QueryGetProtoRevProfitDistributionsRequest) (*QueryGetProtoRevProfitDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitDistributions not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProjectedProfitDistribution(ctx context.Context, req *QueryGetProtoRevProjectedProfitDistributionRequest) (*QueryGetProtoRevProjectedProfitDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProjectedProfitDistribution not implemented")
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevNumberOfTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitsByDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllProfitsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevStatisticsByRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAdminAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBaseDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMaxPromotedHotRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPromotedHotRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPromotedHotRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPromotedHotRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPromotedHotRoutes(ctx, req.(*QueryGetProtoRevMaxPromotedHotRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevHotRouteCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevHotRouteCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevHotRouteCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevHotRouteCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevHotRouteCandidates(ctx, req.(*QueryGetProtoRevHotRouteCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevHotRouteOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevHotRouteOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevHotRouteOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevHotRouteOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevHotRouteOverrides(ctx, req.(*QueryGetProtoRevHotRouteOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevEpochStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEpochStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEpochStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEpochStatistics(ctx, req.(*QueryGetProtoRevEpochStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProfitDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitDistributions(ctx, req.(*QueryGetProtoRevProfitDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProjectedProfitDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProjectedProfitDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProjectedProfitDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProjectedProfitDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProjectedProfitDistribution(ctx, req.(*QueryGetProtoRevProjectedProfitDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.protorev.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetProtoRevNumberOfTrades",
+			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitsByDenom",
+			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllProfits",
+			Handler:    _Query_GetProtoRevAllProfits_Handler,
+		},
+		{
+			MethodName: "GetProtoRevStatisticsByRoute",
+			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllRouteStatistics",
+			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTokenPairArbRoutes",
+			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAdminAccount",
+			Handler:    _Query_GetProtoRevAdminAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDeveloperAccount",
+			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPoolWeights",
+			Handler:    _Query_GetProtoRevPoolWeights_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerTx",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBaseDenoms",
+			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEnabled",
+			Handler:    _Query_GetProtoRevEnabled_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPool",
+			Handler:    _Query_GetProtoRevPool_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPromotedHotRoutes",
+			Handler:    _Query_GetProtoRevMaxPromotedHotRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevHotRouteCandidates",
+			Handler:    _Query_GetProtoRevHotRouteCandidates_Handler,
+		},
+		{
+			MethodName: "GetProtoRevHotRouteOverrides",
+			Handler:    _Query_GetProtoRevHotRouteOverrides_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEpochStatistics",
+			Handler:    _Query_GetProtoRevEpochStatistics_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitDistributions",
+			Handler:    _Query_GetProtoRevProfitDistributions_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProjectedProfitDistribution",
+			Handler:    _Query_GetProtoRevProjectedProfitDistribution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/protorev/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2885,34 +3046,20 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2922,20 +3069,30 @@ func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2945,30 +3102,20 @@ func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2978,34 +3125,30 @@ func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OtherDenom) > 0 {
-		i -= len(m.OtherDenom)
-		copy(dAtA[i:], m.OtherDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3015,387 +3158,2571 @@ func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Profit != nil {
+		{
+			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Profit != nil {
-		l = m.Profit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Profits) > 0 {
-		for _, e := range m.Profits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Route) > 0 {
-		l = 0
-		for _, e := range m.Route {
-			l += sovQuery(uint64(e))
+		dAtA4 := make([]byte, len(m.Route)*10)
+		var j3 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	l = m.Statistics.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Statistics) > 0 {
-		for _, e := range m.Statistics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AdminAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DeveloperAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PoolWeights.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for _, e := range m.BaseDenoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OtherDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OtherDenom) > 0 {
+		i -= len(m.OtherDenom)
+		copy(dAtA[i:], m.OtherDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPromotedHotRoutes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPromotedHotRoutes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HotRouteCandidates) > 0 {
+		for iNdEx := len(m.HotRouteCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRouteCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HotRouteOverrides) > 0 {
+		for iNdEx := len(m.HotRouteOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRouteOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EpochStatistics) > 0 {
+		for iNdEx := len(m.EpochStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfitDistributions) > 0 {
+		for iNdEx := len(m.ProfitDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProfitDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profit != nil {
+		l = m.Profit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolWeights.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for _, e := range m.BaseDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OtherDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPromotedHotRoutes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPromotedHotRoutes))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HotRouteCandidates) > 0 {
+		for _, e := range m.HotRouteCandidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevHotRouteOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HotRouteOverrides) > 0 {
+		for _, e := range m.HotRouteOverrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochStatistics) > 0 {
+		for _, e := range m.EpochStatistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProfitDistributions) > 0 {
+		for _, e := range m.ProfitDistributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProfitDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profit == nil {
+				m.Profit = &types.Coin{}
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, RouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3418,10 +5745,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3445,7 +5772,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3468,15 +5795,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3503,7 +5830,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3528,7 +5856,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3551,10 +5879,10 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3578,7 +5906,7 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3601,15 +5929,15 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,9 +5965,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3662,7 +5988,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3685,44 +6011,12 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3744,7 +6038,7 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3767,17 +6061,17 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3787,27 +6081,23 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profit == nil {
-				m.Profit = &types.Coin{}
-			}
-			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3830,7 +6120,7 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3853,10 +6143,10 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3880,7 +6170,7 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3903,15 +6193,15 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3938,8 +6228,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profits = append(m.Profits, types.Coin{})
-			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3964,7 +6253,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3987,88 +6276,12 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Route = append(m.Route, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Route) == 0 {
-					m.Route = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Route = append(m.Route, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4090,7 +6303,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4113,17 +6326,17 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4133,25 +6346,11 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4173,7 +6372,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4196,10 +6395,10 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4223,7 +6422,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4246,17 +6445,17 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4266,26 +6465,11 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Statistics = append(m.Statistics, RouteStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4307,7 +6491,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4330,10 +6514,10 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4357,7 +6541,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4380,15 +6564,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4415,8 +6599,8 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4441,7 +6625,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4464,10 +6648,10 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4491,7 +6675,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4514,17 +6698,17 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4534,24 +6718,12 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdminAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4573,7 +6745,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4596,12 +6768,76 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4623,7 +6859,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4646,17 +6882,17 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4666,24 +6902,11 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4705,7 +6928,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPromotedHotRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4728,10 +6951,10 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPromotedHotRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPromotedHotRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4755,7 +6978,7 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPromotedHotRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4778,17 +7001,17 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPromotedHotRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPromotedHotRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPromotedHotRoutes", wireType)
 			}
-			var msglen int
+			m.MaxPromotedHotRoutes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4798,25 +7021,11 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPromotedHotRoutes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4838,7 +7047,7 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevHotRouteCandidatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4861,10 +7070,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteCandidatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4888,7 +7097,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevHotRouteCandidatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4911,17 +7120,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteCandidatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRouteCandidates", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4931,11 +7140,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRouteCandidates = append(m.HotRouteCandidates, HotRouteCandidate{})
+			if err := m.HotRouteCandidates[len(m.HotRouteCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4957,7 +7181,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevHotRouteOverridesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4980,10 +7204,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteOverridesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5007,7 +7231,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevHotRouteOverridesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5030,17 +7254,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteOverridesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevHotRouteOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRouteOverrides", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5050,11 +7274,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRouteOverrides = append(m.HotRouteOverrides, HotRouteOverride{})
+			if err := m.HotRouteOverrides[len(m.HotRouteOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5076,7 +7315,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5099,12 +7338,78 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5126,7 +7431,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5149,15 +7454,15 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStatistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5184,8 +7489,76 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochStatistics = append(m.EpochStatistics, EpochStatistics{})
+			if err := m.EpochStatistics[len(m.EpochStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5210,7 +7583,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitDistributionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5233,10 +7606,10 @@ func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5260,7 +7633,7 @@ func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitDistributionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5283,17 +7656,17 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5303,12 +7676,26 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitDistributions = append(m.ProfitDistributions, ProfitDistribution{})
+			if err := m.ProfitDistributions[len(m.ProfitDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5330,7 +7717,7 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProjectedProfitDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5353,76 +7740,12 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OtherDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5444,7 +7767,7 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProjectedProfitDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5467,17 +7790,17 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistribution", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5487,11 +7810,25 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
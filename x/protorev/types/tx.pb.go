@@ -591,6 +591,194 @@ func (m *MsgSetBaseDenomsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBaseDenomsResponse proto.InternalMessageInfo
 
+// MsgSetMaxPromotedHotRoutes defines the Msg/SetMaxPromotedHotRoutes request
+// type.
+type MsgSetMaxPromotedHotRoutes struct {
+	// admin is the account that is authorized to set the max promoted hot routes.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// max_promoted_hot_routes is the maximum number of routes that can be
+	// automatically promoted to hot routes.
+	MaxPromotedHotRoutes uint64 `protobuf:"varint,2,opt,name=max_promoted_hot_routes,json=maxPromotedHotRoutes,proto3" json:"max_promoted_hot_routes,omitempty" yaml:"max_promoted_hot_routes"`
+}
+
+func (m *MsgSetMaxPromotedHotRoutes) Reset()         { *m = MsgSetMaxPromotedHotRoutes{} }
+func (m *MsgSetMaxPromotedHotRoutes) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPromotedHotRoutes) ProtoMessage()    {}
+func (*MsgSetMaxPromotedHotRoutes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{12}
+}
+func (m *MsgSetMaxPromotedHotRoutes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPromotedHotRoutes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPromotedHotRoutes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPromotedHotRoutes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPromotedHotRoutes.Merge(m, src)
+}
+func (m *MsgSetMaxPromotedHotRoutes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPromotedHotRoutes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPromotedHotRoutes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPromotedHotRoutes proto.InternalMessageInfo
+
+func (m *MsgSetMaxPromotedHotRoutes) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetMaxPromotedHotRoutes) GetMaxPromotedHotRoutes() uint64 {
+	if m != nil {
+		return m.MaxPromotedHotRoutes
+	}
+	return 0
+}
+
+// MsgSetMaxPromotedHotRoutesResponse defines the Msg/SetMaxPromotedHotRoutes
+// response type.
+type MsgSetMaxPromotedHotRoutesResponse struct {
+}
+
+func (m *MsgSetMaxPromotedHotRoutesResponse) Reset()         { *m = MsgSetMaxPromotedHotRoutesResponse{} }
+func (m *MsgSetMaxPromotedHotRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPromotedHotRoutesResponse) ProtoMessage()    {}
+func (*MsgSetMaxPromotedHotRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{13}
+}
+func (m *MsgSetMaxPromotedHotRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPromotedHotRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPromotedHotRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPromotedHotRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPromotedHotRoutesResponse.Merge(m, src)
+}
+func (m *MsgSetMaxPromotedHotRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPromotedHotRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPromotedHotRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPromotedHotRoutesResponse proto.InternalMessageInfo
+
+// MsgSetHotRouteOverride defines the Msg/SetHotRouteOverride request type.
+type MsgSetHotRouteOverride struct {
+	// admin is the account that is authorized to set the hot route override.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// hot_route_override is the override to set on the route.
+	HotRouteOverride HotRouteOverride `protobuf:"bytes,2,opt,name=hot_route_override,json=hotRouteOverride,proto3" json:"hot_route_override" yaml:"hot_route_override"`
+}
+
+func (m *MsgSetHotRouteOverride) Reset()         { *m = MsgSetHotRouteOverride{} }
+func (m *MsgSetHotRouteOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHotRouteOverride) ProtoMessage()    {}
+func (*MsgSetHotRouteOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{14}
+}
+func (m *MsgSetHotRouteOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHotRouteOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHotRouteOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHotRouteOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHotRouteOverride.Merge(m, src)
+}
+func (m *MsgSetHotRouteOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHotRouteOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHotRouteOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHotRouteOverride proto.InternalMessageInfo
+
+func (m *MsgSetHotRouteOverride) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetHotRouteOverride) GetHotRouteOverride() HotRouteOverride {
+	if m != nil {
+		return m.HotRouteOverride
+	}
+	return HotRouteOverride{}
+}
+
+// MsgSetHotRouteOverrideResponse defines the Msg/SetHotRouteOverride response
+// type.
+type MsgSetHotRouteOverrideResponse struct {
+}
+
+func (m *MsgSetHotRouteOverrideResponse) Reset()         { *m = MsgSetHotRouteOverrideResponse{} }
+func (m *MsgSetHotRouteOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHotRouteOverrideResponse) ProtoMessage()    {}
+func (*MsgSetHotRouteOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{15}
+}
+func (m *MsgSetHotRouteOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHotRouteOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHotRouteOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHotRouteOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHotRouteOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetHotRouteOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHotRouteOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHotRouteOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHotRouteOverrideResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetHotRoutes)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutes")
 	proto.RegisterType((*MsgSetHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutesResponse")
@@ -604,68 +792,82 @@ func init() {
 	proto.RegisterType((*MsgSetMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPoolPointsPerBlockResponse")
 	proto.RegisterType((*MsgSetBaseDenoms)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenoms")
 	proto.RegisterType((*MsgSetBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenomsResponse")
+	proto.RegisterType((*MsgSetMaxPromotedHotRoutes)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPromotedHotRoutes")
+	proto.RegisterType((*MsgSetMaxPromotedHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPromotedHotRoutesResponse")
+	proto.RegisterType((*MsgSetHotRouteOverride)(nil), "osmosis.protorev.v1beta1.MsgSetHotRouteOverride")
+	proto.RegisterType((*MsgSetHotRouteOverrideResponse)(nil), "osmosis.protorev.v1beta1.MsgSetHotRouteOverrideResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/protorev/v1beta1/tx.proto", fileDescriptor_2783dce032fc6954) }
 
 var fileDescriptor_2783dce032fc6954 = []byte{
-	// 883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x8f, 0xdb, 0x44,
-	0x18, 0xc6, 0x77, 0xb6, 0x80, 0xe8, 0xec, 0x02, 0x5d, 0xf7, 0x9f, 0xe3, 0xdd, 0x3a, 0xe9, 0x6c,
-	0xb7, 0x5d, 0xda, 0x6e, 0xdc, 0x4d, 0x5a, 0x51, 0x05, 0x81, 0xb4, 0x56, 0x0f, 0x70, 0x58, 0x14,
-	0xb9, 0x45, 0x48, 0x1c, 0x30, 0x76, 0x32, 0x38, 0x56, 0x63, 0x8f, 0xe5, 0x99, 0x0d, 0xe9, 0x95,
-	0x23, 0x27, 0x24, 0x6e, 0x1c, 0x38, 0xf0, 0x09, 0x38, 0x00, 0x77, 0x6e, 0x7b, 0x41, 0xaa, 0xe8,
-	0x81, 0x0a, 0x89, 0x08, 0xed, 0x22, 0x71, 0xcf, 0x27, 0x40, 0x9e, 0xf1, 0xbf, 0x24, 0x36, 0x69,
-	0x9a, 0xcb, 0x6a, 0x33, 0xf3, 0xcc, 0xf3, 0xfe, 0x9e, 0x77, 0x46, 0xaf, 0xe1, 0x55, 0x42, 0x3d,
-	0x42, 0x5d, 0xaa, 0x05, 0x21, 0x61, 0x24, 0xc4, 0x03, 0x6d, 0xb0, 0x6f, 0x63, 0x66, 0xed, 0x6b,
-	0x6c, 0x58, 0xe7, 0x6b, 0x92, 0x1c, 0x4b, 0xea, 0x89, 0xa4, 0x1e, 0x4b, 0x94, 0x0b, 0x0e, 0x71,
-	0x08, 0x5f, 0xd5, 0xa2, 0xff, 0x84, 0x40, 0xd9, 0xb0, 0x3c, 0xd7, 0x27, 0x1a, 0xff, 0x1b, 0x2f,
-	0x6d, 0x39, 0x84, 0x38, 0x7d, 0xac, 0x59, 0x81, 0xab, 0x59, 0xbe, 0x4f, 0x98, 0xc5, 0x5c, 0xe2,
-	0xc7, 0x8e, 0xca, 0x8d, 0x52, 0x86, 0xb4, 0xa2, 0x10, 0x56, 0x3a, 0x5c, 0x69, 0x8a, 0x92, 0xe2,
-	0x87, 0xd8, 0x42, 0x7f, 0x00, 0xf8, 0xd6, 0x21, 0x75, 0x1e, 0x62, 0xf6, 0x01, 0x61, 0x06, 0x39,
-	0x62, 0x98, 0x4a, 0xef, 0xc3, 0x57, 0xad, 0xae, 0xe7, 0xfa, 0x32, 0xa8, 0x81, 0xdd, 0xb3, 0xfa,
-	0xee, 0x78, 0x54, 0x5d, 0x7f, 0x62, 0x79, 0xfd, 0x16, 0xe2, 0xcb, 0xe8, 0xf7, 0x9f, 0xf6, 0x2e,
-	0xc4, 0x26, 0x07, 0xdd, 0x6e, 0x88, 0x29, 0x7d, 0xc8, 0x42, 0xd7, 0x77, 0x0c, 0x71, 0x4c, 0xfa,
-	0x02, 0xc2, 0x1e, 0x61, 0x66, 0xc8, 0xdd, 0xe4, 0xd5, 0xda, 0x99, 0xdd, 0xb5, 0xc6, 0xed, 0x7a,
-	0x59, 0x37, 0xea, 0x8f, 0xc8, 0x63, 0xec, 0xb7, 0x2d, 0x37, 0x3c, 0x08, 0x6d, 0x41, 0xa0, 0x57,
-	0x8e, 0x47, 0xd5, 0x95, 0xf1, 0xa8, 0xba, 0x21, 0xca, 0x66, 0x6e, 0xc8, 0x38, 0xdb, 0x4b, 0x38,
-	0x5b, 0x5b, 0x5f, 0xff, 0xfb, 0xe3, 0xcd, 0xcb, 0x49, 0x13, 0xa6, 0x52, 0xa0, 0x0a, 0xbc, 0x3c,
-	0xb5, 0x64, 0x60, 0x1a, 0x10, 0x9f, 0x62, 0x74, 0x0c, 0xe0, 0x25, 0xb1, 0xf7, 0x00, 0x0f, 0x70,
-	0x9f, 0x04, 0x38, 0x3c, 0xe8, 0x74, 0xc8, 0x91, 0xcf, 0x96, 0xce, 0xfe, 0x21, 0xdc, 0xe8, 0x26,
-	0x9e, 0xa6, 0x25, 0x4c, 0xe5, 0x55, 0xee, 0xb5, 0x35, 0x1e, 0x55, 0x65, 0xe1, 0x35, 0x23, 0x41,
-	0xc6, 0xb9, 0xee, 0x14, 0x4a, 0x6b, 0x3b, 0x8a, 0xa7, 0x4e, 0xc6, 0x9b, 0xe6, 0x45, 0x35, 0xa8,
-	0x16, 0xef, 0xa4, 0x61, 0xff, 0x04, 0x70, 0x43, 0x48, 0xda, 0x84, 0xf4, 0x3f, 0xc1, 0xae, 0xd3,
-	0x63, 0xcb, 0xdf, 0x31, 0x86, 0xeb, 0x01, 0x21, 0x7d, 0xf3, 0x4b, 0xe1, 0xc7, 0x23, 0xae, 0x35,
-	0x76, 0xca, 0x6f, 0x39, 0x57, 0x5c, 0xdf, 0x8c, 0xaf, 0xf7, 0xbc, 0xa8, 0x98, 0x37, 0x42, 0xc6,
-	0x5a, 0x90, 0x29, 0x5b, 0x6a, 0xd4, 0x83, 0xca, 0x64, 0x0f, 0x72, 0x4e, 0x68, 0x13, 0x56, 0x66,
-	0x16, 0xd3, 0xe4, 0xcf, 0x01, 0x94, 0xc5, 0xee, 0xa1, 0x35, 0x8c, 0x04, 0x6d, 0xe2, 0xfa, 0x8c,
-	0xb6, 0x71, 0xf8, 0x68, 0xb8, 0x74, 0x03, 0x3e, 0x86, 0x97, 0x3c, 0x6b, 0x68, 0x72, 0xf6, 0x80,
-	0xfb, 0x9a, 0xd1, 0x7d, 0xb2, 0x21, 0x6f, 0xc5, 0x2b, 0xfa, 0xd5, 0xf1, 0xa8, 0x7a, 0x45, 0x18,
-	0x16, 0xeb, 0x90, 0x21, 0x79, 0x33, 0x58, 0xad, 0x9d, 0x28, 0x70, 0x6d, 0x32, 0xf0, 0x2c, 0x3d,
-	0x42, 0xb0, 0x56, 0xb6, 0x97, 0xc6, 0xff, 0x0b, 0xc0, 0xcd, 0x62, 0x91, 0xde, 0x27, 0x9d, 0xc7,
-	0x4b, 0x77, 0xe0, 0x33, 0x58, 0x29, 0x4a, 0x66, 0x47, 0xe6, 0x71, 0x13, 0xae, 0x8d, 0x47, 0xd5,
-	0x5a, 0x79, 0x13, 0xb8, 0x14, 0x19, 0x17, 0xbd, 0x22, 0xbe, 0xb9, 0x77, 0xbf, 0x03, 0xb7, 0xff,
-	0x27, 0x5e, 0xda, 0x86, 0x67, 0x00, 0x9e, 0x13, 0x3a, 0xdd, 0xa2, 0xf8, 0x01, 0xf6, 0x89, 0xb7,
-	0xfc, 0xf3, 0xff, 0x1c, 0xae, 0xd9, 0x16, 0xc5, 0x66, 0x97, 0xdb, 0xc5, 0x33, 0x6e, 0xbb, 0xfc,
-	0xf5, 0xa7, 0xa5, 0x75, 0x25, 0x7e, 0xfb, 0x92, 0x28, 0x97, 0x73, 0x41, 0x06, 0xb4, 0x53, 0xc2,
-	0xd6, 0x95, 0x28, 0xbd, 0x3c, 0x99, 0x3e, 0x0b, 0x80, 0x94, 0xe4, 0x69, 0x67, 0x6b, 0x49, 0xe2,
-	0xc6, 0xcf, 0xaf, 0xc3, 0x33, 0x87, 0xd4, 0x91, 0xbe, 0x03, 0x70, 0x7d, 0x62, 0xb0, 0xbf, 0x5d,
-	0x0e, 0x38, 0x35, 0x2a, 0x95, 0xfd, 0x17, 0x96, 0xa6, 0x8d, 0xbe, 0xfd, 0xd5, 0xb3, 0x7f, 0xbe,
-	0x5d, 0xbd, 0x8e, 0xae, 0x69, 0x09, 0xf5, 0x60, 0xff, 0x6e, 0xf6, 0x6d, 0xa2, 0x98, 0x99, 0xd9,
-	0x20, 0x97, 0x7e, 0x01, 0xf0, 0x7c, 0xd1, 0x00, 0xbe, 0x33, 0xaf, 0xf0, 0xf4, 0x09, 0xe5, 0xfe,
-	0xa2, 0x27, 0x52, 0xe2, 0x26, 0x27, 0xde, 0x43, 0xb7, 0xca, 0x89, 0x67, 0x26, 0xb5, 0xf4, 0x2b,
-	0x80, 0x17, 0x8b, 0x47, 0x4a, 0x63, 0x1e, 0xc8, 0xec, 0x19, 0xa5, 0xb5, 0xf8, 0x99, 0x14, 0xff,
-	0x3e, 0xc7, 0x6f, 0xa0, 0x3b, 0xe5, 0xf8, 0xc5, 0xa3, 0x47, 0xfa, 0x0d, 0x40, 0xb9, 0x74, 0x2e,
-	0xdc, 0x5b, 0x14, 0x89, 0x1f, 0x53, 0xde, 0x7b, 0xa9, 0x63, 0x69, 0x98, 0x77, 0x79, 0x98, 0x7b,
-	0xa8, 0xb9, 0x58, 0x18, 0x3e, 0x42, 0xa4, 0x1f, 0x00, 0x7c, 0x73, 0xea, 0x03, 0x77, 0x6b, 0x1e,
-	0x4e, 0x4e, 0xac, 0x34, 0x17, 0x10, 0xa7, 0xc4, 0x75, 0x4e, 0xbc, 0x8b, 0xae, 0x97, 0x13, 0xe7,
-	0xbf, 0x6c, 0xd2, 0xf7, 0x00, 0xbe, 0x31, 0x39, 0x85, 0x6e, 0xce, 0x2b, 0x9b, 0x69, 0x95, 0xc6,
-	0x8b, 0x6b, 0x53, 0xc2, 0x3d, 0x4e, 0x78, 0x03, 0xed, 0x94, 0x13, 0xe6, 0xe6, 0x8f, 0xfe, 0xd1,
-	0xf1, 0x89, 0x0a, 0x9e, 0x9e, 0xa8, 0xe0, 0xef, 0x13, 0x15, 0x7c, 0x73, 0xaa, 0xae, 0x3c, 0x3d,
-	0x55, 0x57, 0x9e, 0x9f, 0xaa, 0x2b, 0x9f, 0xde, 0x75, 0x5c, 0xd6, 0x3b, 0xb2, 0xeb, 0x1d, 0xe2,
-	0x25, 0x56, 0x7b, 0x7d, 0xcb, 0xa6, 0x39, 0xdf, 0x77, 0xb4, 0x61, 0xe6, 0xcc, 0x9e, 0x04, 0x98,
-	0xda, 0xaf, 0xf1, 0xdf, 0xcd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x2b, 0xd8, 0x70, 0x2c,
-	0x0b, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa4, 0x80, 0xd4, 0x71, 0x80, 0x64, 0x9b, 0x36, 0xf6, 0x26, 0x5d, 0x3b, 0x93, 0xa4,
+	0x09, 0x69, 0x62, 0xc7, 0x76, 0x2a, 0x82, 0xf9, 0x21, 0xc5, 0xea, 0x01, 0x0e, 0x81, 0x68, 0x5b,
+	0x84, 0xe0, 0x80, 0x59, 0xdb, 0xc3, 0x7a, 0x55, 0xef, 0x8e, 0xb5, 0x3b, 0x31, 0xae, 0xb8, 0x71,
+	0xe4, 0x84, 0xc4, 0x8d, 0x03, 0x07, 0xfe, 0x02, 0x0e, 0x08, 0x24, 0x6e, 0xdc, 0x72, 0x41, 0xaa,
+	0xe8, 0x81, 0x0a, 0x09, 0xab, 0x4a, 0x90, 0xb8, 0xfb, 0xc8, 0x09, 0xed, 0xcc, 0xee, 0x78, 0xbd,
+	0x3f, 0xb0, 0x5d, 0x73, 0x89, 0xe2, 0x99, 0xef, 0x7d, 0xef, 0xfb, 0xde, 0x1b, 0xbd, 0xb7, 0x70,
+	0x9d, 0x38, 0x26, 0x71, 0x0c, 0xa7, 0xd0, 0xb1, 0x09, 0x25, 0x36, 0xee, 0x16, 0xba, 0xc5, 0x3a,
+	0xa6, 0x5a, 0xb1, 0x40, 0x7b, 0x79, 0x76, 0x26, 0xa5, 0x3d, 0x48, 0xde, 0x87, 0xe4, 0x3d, 0x88,
+	0xbc, 0xac, 0x13, 0x9d, 0xb0, 0xd3, 0x82, 0xfb, 0x1f, 0x07, 0xc8, 0x4b, 0x9a, 0x69, 0x58, 0xa4,
+	0xc0, 0xfe, 0x7a, 0x47, 0x6b, 0x3a, 0x21, 0x7a, 0x1b, 0x17, 0xb4, 0x8e, 0x51, 0xd0, 0x2c, 0x8b,
+	0x50, 0x8d, 0x1a, 0xc4, 0xf2, 0x18, 0xe5, 0xed, 0x44, 0x0d, 0x22, 0x23, 0x07, 0x66, 0x1a, 0x0c,
+	0x59, 0xe3, 0x29, 0xf9, 0x0f, 0x7e, 0x85, 0x7e, 0x07, 0xf0, 0xe5, 0x13, 0x47, 0xbf, 0x87, 0xe9,
+	0xdb, 0x84, 0xaa, 0xe4, 0x8c, 0x62, 0x47, 0x7a, 0x0b, 0x3e, 0xaf, 0x35, 0x4d, 0xc3, 0x4a, 0x83,
+	0x1c, 0xd8, 0xb9, 0x5a, 0xdd, 0x19, 0xf4, 0xb3, 0x0b, 0x0f, 0x35, 0xb3, 0x5d, 0x41, 0xec, 0x18,
+	0xfd, 0xf6, 0xc3, 0xfe, 0xb2, 0x47, 0x72, 0xdc, 0x6c, 0xda, 0xd8, 0x71, 0xee, 0x51, 0xdb, 0xb0,
+	0x74, 0x95, 0x87, 0x49, 0x9f, 0x42, 0xd8, 0x22, 0xb4, 0x66, 0x33, 0xb6, 0xf4, 0x7c, 0xee, 0xca,
+	0x4e, 0xaa, 0xb4, 0x97, 0x4f, 0xaa, 0x46, 0xfe, 0x3e, 0x79, 0x80, 0xad, 0x53, 0xcd, 0xb0, 0x8f,
+	0xed, 0x3a, 0x57, 0x50, 0xcd, 0x9c, 0xf7, 0xb3, 0x73, 0x83, 0x7e, 0x76, 0x89, 0xa7, 0x1d, 0xb2,
+	0x21, 0xf5, 0x6a, 0xcb, 0xd7, 0x59, 0x59, 0xfb, 0xf2, 0xef, 0xef, 0x77, 0x57, 0xfc, 0x22, 0x84,
+	0x5c, 0xa0, 0x0c, 0x5c, 0x09, 0x1d, 0xa9, 0xd8, 0xe9, 0x10, 0xcb, 0xc1, 0xe8, 0x1c, 0xc0, 0x1b,
+	0xfc, 0xee, 0x2e, 0xee, 0xe2, 0x36, 0xe9, 0x60, 0xfb, 0xb8, 0xd1, 0x20, 0x67, 0x16, 0x9d, 0xd9,
+	0xfb, 0x3b, 0x70, 0xa9, 0xe9, 0x73, 0xd6, 0x34, 0x4e, 0x9a, 0x9e, 0x67, 0x5c, 0x6b, 0x83, 0x7e,
+	0x36, 0xcd, 0xb9, 0x22, 0x10, 0xa4, 0x2e, 0x36, 0x43, 0x52, 0x2a, 0x1b, 0xae, 0x3d, 0x65, 0xd4,
+	0x5e, 0x58, 0x2f, 0xca, 0x41, 0x25, 0xfe, 0x46, 0x98, 0xfd, 0x03, 0xc0, 0x25, 0x0e, 0x39, 0x25,
+	0xa4, 0xfd, 0x01, 0x36, 0xf4, 0x16, 0x9d, 0xbd, 0xc7, 0x18, 0x2e, 0x74, 0x08, 0x69, 0xd7, 0x3e,
+	0xe3, 0x7c, 0xcc, 0x62, 0xaa, 0xb4, 0x95, 0xdc, 0xe5, 0x40, 0xf2, 0xea, 0xaa, 0xd7, 0xde, 0x6b,
+	0x3c, 0x63, 0x90, 0x08, 0xa9, 0xa9, 0xce, 0x10, 0x59, 0x51, 0xdc, 0x1a, 0x64, 0x46, 0x6b, 0x10,
+	0x60, 0x42, 0xab, 0x30, 0x13, 0x39, 0x14, 0xce, 0x9f, 0x00, 0x98, 0xe6, 0xb7, 0x27, 0x5a, 0xcf,
+	0x05, 0x9c, 0x12, 0xc3, 0xa2, 0xce, 0x29, 0xb6, 0xef, 0xf7, 0x66, 0x2e, 0xc0, 0xfb, 0xf0, 0x86,
+	0xa9, 0xf5, 0x6a, 0x4c, 0x7b, 0x87, 0xf1, 0xd6, 0xdc, 0x7e, 0xd2, 0x1e, 0x2b, 0xc5, 0x73, 0xd5,
+	0xf5, 0x41, 0x3f, 0x7b, 0x93, 0x13, 0xc6, 0xe3, 0x90, 0x2a, 0x99, 0x11, 0x59, 0x95, 0x2d, 0xd7,
+	0x70, 0x6e, 0xd4, 0x70, 0x54, 0x3d, 0x42, 0x30, 0x97, 0x74, 0x27, 0xec, 0xff, 0x09, 0xe0, 0x6a,
+	0x3c, 0xa8, 0xda, 0x26, 0x8d, 0x07, 0x33, 0x57, 0xe0, 0x63, 0x98, 0x89, 0x73, 0x56, 0x77, 0xc9,
+	0xbd, 0x22, 0x6c, 0x0e, 0xfa, 0xd9, 0x5c, 0x72, 0x11, 0x18, 0x14, 0xa9, 0xd7, 0xcd, 0x38, 0x7d,
+	0x63, 0x7b, 0xbf, 0x05, 0x37, 0xfe, 0xc3, 0x9e, 0x28, 0xc3, 0x63, 0x00, 0x17, 0x39, 0xae, 0xaa,
+	0x39, 0xf8, 0x2e, 0xb6, 0x88, 0x39, 0xfb, 0xf3, 0xff, 0x04, 0xa6, 0xea, 0x9a, 0x83, 0x6b, 0x4d,
+	0x46, 0xe7, 0xcd, 0xb8, 0x8d, 0xe4, 0xd7, 0x2f, 0x52, 0x57, 0x65, 0xef, 0xed, 0x4b, 0x3c, 0x5d,
+	0x80, 0x05, 0xa9, 0xb0, 0x2e, 0x14, 0x56, 0x6e, 0xba, 0xee, 0xd3, 0xa3, 0xee, 0x87, 0x06, 0x90,
+	0x0c, 0xd3, 0xe1, 0x33, 0xe1, 0xf8, 0x29, 0x80, 0xf2, 0xb0, 0x32, 0x36, 0x31, 0x09, 0xc5, 0xcd,
+	0xff, 0x6f, 0xbc, 0x7f, 0x08, 0x57, 0x58, 0x33, 0x3d, 0xe2, 0xda, 0xc8, 0xac, 0x77, 0xbb, 0x8e,
+	0x06, 0xfd, 0xac, 0x12, 0xe8, 0x7a, 0x14, 0x88, 0xd4, 0x65, 0x33, 0x46, 0x5a, 0x65, 0xdb, 0x35,
+	0x8d, 0xa2, 0xaf, 0x3f, 0x0c, 0x44, 0x9b, 0x10, 0x25, 0xdf, 0x8a, 0x42, 0xfc, 0x23, 0xe6, 0xbc,
+	0x7f, 0xf7, 0x5e, 0x17, 0xdb, 0xb6, 0xd1, 0xc4, 0x33, 0x17, 0xe1, 0x73, 0x28, 0x09, 0x3b, 0x35,
+	0xe2, 0xb1, 0x7a, 0x53, 0x70, 0x37, 0xf9, 0x1d, 0x84, 0x75, 0x54, 0xd7, 0xbd, 0xe7, 0x90, 0x09,
+	0x6d, 0x3a, 0xc1, 0x89, 0xd4, 0xc5, 0x56, 0x28, 0x28, 0x76, 0x33, 0x84, 0x99, 0x87, 0x9b, 0x21,
+	0x7c, 0xe3, 0x97, 0xa7, 0xf4, 0x73, 0x0a, 0x5e, 0x39, 0x71, 0x74, 0xe9, 0x1b, 0x00, 0x17, 0x46,
+	0x3e, 0x00, 0x5e, 0x49, 0x36, 0x30, 0x4a, 0xe9, 0xc8, 0xc5, 0x89, 0xa1, 0xa2, 0x2b, 0x7b, 0x5f,
+	0x3c, 0xfe, 0xeb, 0xeb, 0xf9, 0x5b, 0x68, 0xb3, 0xe0, 0x3b, 0xe8, 0x16, 0x0f, 0x87, 0xdf, 0x30,
+	0x0e, 0xa6, 0x81, 0x97, 0x22, 0xfd, 0x08, 0xe0, 0xb5, 0xb8, 0x45, 0x7d, 0x30, 0x2e, 0x71, 0x38,
+	0x42, 0x3e, 0x9a, 0x36, 0x42, 0x28, 0x2e, 0x33, 0xc5, 0xfb, 0xe8, 0x76, 0xb2, 0xe2, 0xc8, 0x46,
+	0x97, 0x7e, 0x01, 0xf0, 0x7a, 0xfc, 0xea, 0x29, 0x8d, 0x13, 0x12, 0x8d, 0x91, 0x2b, 0xd3, 0xc7,
+	0x08, 0xf9, 0x47, 0x4c, 0x7e, 0x09, 0x1d, 0x24, 0xcb, 0x8f, 0x5f, 0x51, 0xd2, 0xaf, 0x00, 0xa6,
+	0x13, 0xf7, 0xc7, 0x9d, 0x69, 0x25, 0xb1, 0x30, 0xf9, 0xcd, 0x67, 0x0a, 0x13, 0x66, 0x5e, 0x67,
+	0x66, 0xee, 0xa0, 0xf2, 0x74, 0x66, 0xd8, 0xaa, 0x91, 0xbe, 0x03, 0xf0, 0xa5, 0xd0, 0x87, 0xd0,
+	0xed, 0x71, 0x72, 0x02, 0x60, 0xb9, 0x3c, 0x05, 0x58, 0x28, 0xce, 0x33, 0xc5, 0x3b, 0xe8, 0x56,
+	0xb2, 0xe2, 0xe0, 0x17, 0x90, 0xf4, 0x2d, 0x80, 0x2f, 0x8e, 0x6e, 0xab, 0xdd, 0x71, 0x69, 0x87,
+	0x58, 0xb9, 0x34, 0x39, 0x56, 0x28, 0xdc, 0x67, 0x0a, 0xb7, 0xd1, 0x56, 0xb2, 0xc2, 0xc0, 0x9e,
+	0x92, 0xce, 0x01, 0x5c, 0x49, 0x5a, 0x2e, 0x87, 0x93, 0x74, 0x37, 0x1c, 0x25, 0xbf, 0xf1, 0x2c,
+	0x51, 0x42, 0xfe, 0x6b, 0x4c, 0x7e, 0x19, 0x15, 0xc7, 0x3c, 0x89, 0xe8, 0x1e, 0x92, 0x7e, 0xe2,
+	0xd3, 0x25, 0xb2, 0x1e, 0x0e, 0x26, 0x1d, 0x6b, 0x7e, 0x84, 0x7c, 0x34, 0x6d, 0x84, 0x90, 0x7f,
+	0xc8, 0xe4, 0xe7, 0xd1, 0xde, 0x04, 0xf3, 0x50, 0xac, 0x85, 0xea, 0xbb, 0xe7, 0x17, 0x0a, 0x78,
+	0x74, 0xa1, 0x80, 0xa7, 0x17, 0x0a, 0xf8, 0xea, 0x52, 0x99, 0x7b, 0x74, 0xa9, 0xcc, 0x3d, 0xb9,
+	0x54, 0xe6, 0x3e, 0x3a, 0xd4, 0x0d, 0xda, 0x3a, 0xab, 0xe7, 0x1b, 0xc4, 0xf4, 0x19, 0xf7, 0xdb,
+	0x5a, 0xdd, 0x09, 0xd0, 0xbf, 0x5a, 0xe8, 0x0d, 0x13, 0xd0, 0x87, 0x1d, 0xec, 0xd4, 0x5f, 0x60,
+	0xbf, 0xcb, 0xff, 0x0e, 0x00, 0x51, 0xe4, 0x34, 0x81, 0xd9, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -698,6 +900,14 @@ type MsgClient interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opts ...grpc.CallOption) (*MsgSetBaseDenomsResponse, error)
+	// SetMaxPromotedHotRoutes sets the maximum number of routes that can be
+	// automatically promoted to hot routes. Can only be called by the admin
+	// account.
+	SetMaxPromotedHotRoutes(ctx context.Context, in *MsgSetMaxPromotedHotRoutes, opts ...grpc.CallOption) (*MsgSetMaxPromotedHotRoutesResponse, error)
+	// SetHotRouteOverride pins a route as a hot route or bans it from being
+	// automatically promoted to a hot route. Can only be called by the admin
+	// account.
+	SetHotRouteOverride(ctx context.Context, in *MsgSetHotRouteOverride, opts ...grpc.CallOption) (*MsgSetHotRouteOverrideResponse, error)
 }
 
 type msgClient struct {
@@ -762,6 +972,24 @@ func (c *msgClient) SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opt
 	return out, nil
 }
 
+func (c *msgClient) SetMaxPromotedHotRoutes(ctx context.Context, in *MsgSetMaxPromotedHotRoutes, opts ...grpc.CallOption) (*MsgSetMaxPromotedHotRoutesResponse, error) {
+	out := new(MsgSetMaxPromotedHotRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetMaxPromotedHotRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHotRouteOverride(ctx context.Context, in *MsgSetHotRouteOverride, opts ...grpc.CallOption) (*MsgSetHotRouteOverrideResponse, error) {
+	out := new(MsgSetHotRouteOverrideResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetHotRouteOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetHotRoutes sets the hot routes that will be explored when creating
@@ -782,6 +1010,14 @@ type MsgServer interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(context.Context, *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error)
+	// SetMaxPromotedHotRoutes sets the maximum number of routes that can be
+	// automatically promoted to hot routes. Can only be called by the admin
+	// account.
+	SetMaxPromotedHotRoutes(context.Context, *MsgSetMaxPromotedHotRoutes) (*MsgSetMaxPromotedHotRoutesResponse, error)
+	// SetHotRouteOverride pins a route as a hot route or bans it from being
+	// automatically promoted to a hot route. Can only be called by the admin
+	// account.
+	SetHotRouteOverride(context.Context, *MsgSetHotRouteOverride) (*MsgSetHotRouteOverrideResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -806,6 +1042,12 @@ func (*UnimplementedMsgServer) SetPoolWeights(ctx context.Context, req *MsgSetPo
 func (*UnimplementedMsgServer) SetBaseDenoms(ctx context.Context, req *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBaseDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetMaxPromotedHotRoutes(ctx context.Context, req *MsgSetMaxPromotedHotRoutes) (*MsgSetMaxPromotedHotRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPromotedHotRoutes not implemented")
+}
+func (*UnimplementedMsgServer) SetHotRouteOverride(ctx context.Context, req *MsgSetHotRouteOverride) (*MsgSetHotRouteOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHotRouteOverride not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -919,6 +1161,42 @@ func _Msg_SetBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMaxPromotedHotRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPromotedHotRoutes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPromotedHotRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetMaxPromotedHotRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPromotedHotRoutes(ctx, req.(*MsgSetMaxPromotedHotRoutes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHotRouteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHotRouteOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHotRouteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetHotRouteOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHotRouteOverride(ctx, req.(*MsgSetHotRouteOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -947,6 +1225,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBaseDenoms",
 			Handler:    _Msg_SetBaseDenoms_Handler,
 		},
+		{
+			MethodName: "SetMaxPromotedHotRoutes",
+			Handler:    _Msg_SetMaxPromotedHotRoutes_Handler,
+		},
+		{
+			MethodName: "SetHotRouteOverride",
+			Handler:    _Msg_SetHotRouteOverride_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/tx.proto",
@@ -1325,98 +1611,219 @@ func (m *MsgSetBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSetHotRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.HotRoutes) > 0 {
-		for _, e := range m.HotRoutes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetMaxPromotedHotRoutes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetHotRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetMaxPromotedHotRoutes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDeveloperAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMaxPromotedHotRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxPromotedHotRoutes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPromotedHotRoutes))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.DeveloperAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDeveloperAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetMaxPromotedHotRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetPoolWeights) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.PoolWeights.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetMaxPromotedHotRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetPoolWeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMaxPromotedHotRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetMaxPoolPointsPerTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetHotRouteOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHotRouteOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHotRouteOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HotRouteOverride.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHotRouteOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHotRouteOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHotRouteOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetHotRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.HotRoutes) > 0 {
+		for _, e := range m.HotRoutes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetHotRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDeveloperAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPoolWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PoolWeights.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxPoolPointsPerTx) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1452,53 +1859,432 @@ func (m *MsgSetMaxPoolPointsPerBlock) Size() (n int) {
 	if m.MaxPoolPointsPerBlock != 0 {
 		n += 1 + sovTx(uint64(m.MaxPoolPointsPerBlock))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSetMaxPoolPointsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBaseDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BaseDenoms) > 0 {
+		for _, e := range m.BaseDenoms {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxPromotedHotRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPromotedHotRoutes != 0 {
+		n += 1 + sovTx(uint64(m.MaxPromotedHotRoutes))
+	}
+	return n
+}
+
+func (m *MsgSetMaxPromotedHotRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHotRouteOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HotRouteOverride.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetHotRouteOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHotRoutes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHotRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRoutes = append(m.HotRoutes, TokenPairArbRoutes{})
+			if err := m.HotRoutes[len(m.HotRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHotRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHotRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHotRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeveloperAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeveloperAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetMaxPoolPointsPerBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetBaseDenoms) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.BaseDenoms) > 0 {
-		for _, e := range m.BaseDenoms {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeveloperAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetBaseDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,10 +2307,10 @@ func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetHotRoutes: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolWeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetHotRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1561,7 +2347,7 @@ func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HotRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1588,8 +2374,7 @@ func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HotRoutes = append(m.HotRoutes, TokenPairArbRoutes{})
-			if err := m.HotRoutes[len(m.HotRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1614,7 +2399,7 @@ func (m *MsgSetHotRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetHotRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,10 +2422,10 @@ func (m *MsgSetHotRoutesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetHotRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetHotRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1664,7 +2449,7 @@ func (m *MsgSetHotRoutesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,10 +2472,10 @@ func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDeveloperAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDeveloperAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1726,10 +2511,10 @@ func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var stringLen uint64
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1739,24 +2524,11 @@ func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1778,7 +2550,7 @@ func (m *MsgSetDeveloperAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,10 +2573,10 @@ func (m *MsgSetDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDeveloperAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1828,7 +2600,7 @@ func (m *MsgSetDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1851,10 +2623,10 @@ func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPoolWeights: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1890,10 +2662,10 @@ func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1903,25 +2675,11 @@ func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1943,7 +2701,7 @@ func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1966,10 +2724,10 @@ func (m *MsgSetPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPoolWeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1993,7 +2751,7 @@ func (m *MsgSetPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2016,10 +2774,10 @@ func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBaseDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBaseDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2055,10 +2813,10 @@ func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2068,11 +2826,26 @@ func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2094,7 +2867,7 @@ func (m *MsgSetMaxPoolPointsPerTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2117,10 +2890,10 @@ func (m *MsgSetMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2144,7 +2917,7 @@ func (m *MsgSetMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPromotedHotRoutes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,10 +2940,10 @@ func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPromotedHotRoutes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPromotedHotRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2207,9 +2980,9 @@ func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPromotedHotRoutes", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			m.MaxPromotedHotRoutes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2219,7 +2992,7 @@ func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				m.MaxPromotedHotRoutes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2245,7 +3018,7 @@ func (m *MsgSetMaxPoolPointsPerBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPromotedHotRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2268,10 +3041,10 @@ func (m *MsgSetMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPromotedHotRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPromotedHotRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2295,7 +3068,7 @@ func (m *MsgSetMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
+func (m *MsgSetHotRouteOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2318,10 +3091,10 @@ func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBaseDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetHotRouteOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBaseDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetHotRouteOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2358,7 +3131,7 @@ func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRouteOverride", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2385,8 +3158,7 @@ func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HotRouteOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2411,7 +3183,7 @@ func (m *MsgSetBaseDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetHotRouteOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2434,10 +3206,10 @@ func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetHotRouteOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetHotRouteOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
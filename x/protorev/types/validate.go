@@ -92,3 +92,171 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ---------------------- Hot Route Promotion Validation ---------------------- //
+// ValidateMaxPromotedHotRoutes validates the max number of routes that can be automatically promoted to hot routes.
+// A max of 0 disables the automatic promotion of routes.
+func ValidateMaxPromotedHotRoutes(maxPromotedHotRoutes uint64) error {
+	if maxPromotedHotRoutes > MaxPromotedHotRoutes {
+		return fmt.Errorf("max promoted hot routes must be between 0 and %d", MaxPromotedHotRoutes)
+	}
+
+	return nil
+}
+
+// Validates that the hot route candidate can be promoted to a hot route for its token pair.
+func (candidate *HotRouteCandidate) Validate() error {
+	if len(candidate.LastEpochStatistics.Route) != len(candidate.HotRoute.Trades) {
+		return fmt.Errorf("the route of the statistics must have a pool for each trade of the hot route")
+	}
+
+	tokenPairArbRoutes := NewTokenPairArbRoutes([]Route{candidate.HotRoute}, candidate.TokenIn, candidate.TokenOut)
+	if err := tokenPairArbRoutes.Validate(); err != nil {
+		return err
+	}
+
+	if candidate.LastEpochStatistics.NumberOfTrades.IsNil() || candidate.LastEpochStatistics.NumberOfTrades.IsNegative() {
+		return fmt.Errorf("number of trades cannot be negative")
+	}
+
+	return sdk.NewCoins(candidate.LastEpochStatistics.Profits...).Validate()
+}
+
+// ValidateHotRouteCandidates validates the hot route candidates passed into the module genesis.
+func ValidateHotRouteCandidates(candidates []HotRouteCandidate) error {
+	seenRoutes := make(map[string]bool)
+	for _, candidate := range candidates {
+		if err := candidate.Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the route is unique
+		routeKey := string(CreateRouteKey(candidate.LastEpochStatistics.Route))
+		if seenRoutes[routeKey] {
+			return fmt.Errorf("duplicate hot route candidate %s", routeKey)
+		}
+		seenRoutes[routeKey] = true
+	}
+	return nil
+}
+
+// Validates the hot route override set by the admin account.
+func (override *HotRouteOverride) Validate() error {
+	if len(override.Route) < MinRouteLength {
+		return fmt.Errorf("there must be at least %d pools per route", MinRouteLength)
+	}
+
+	if _, ok := HotRouteOverrideType_name[int32(override.OverrideType)]; !ok {
+		return fmt.Errorf("invalid hot route override type %d", override.OverrideType)
+	}
+
+	return nil
+}
+
+// ValidateHotRouteOverrides validates the hot route overrides passed into the module genesis.
+func ValidateHotRouteOverrides(overrides []HotRouteOverride) error {
+	seenRoutes := make(map[string]bool)
+	for _, override := range overrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+
+		if override.OverrideType == HotRouteOverrideNone {
+			return fmt.Errorf("hot route override of route %v must pin or ban the route", override.Route)
+		}
+
+		// Ensure that the route is unique
+		routeKey := string(CreateRouteKey(override.Route))
+		if seenRoutes[routeKey] {
+			return fmt.Errorf("duplicate hot route override %s", routeKey)
+		}
+		seenRoutes[routeKey] = true
+	}
+	return nil
+}
+
+// ---------------------- Epoch Statistics Validation ---------------------- //
+// Validates the statistics of the trades executed over an epoch.
+func (stats *EpochStatistics) Validate() error {
+	if stats.NumberOfTrades.IsNil() || stats.NumberOfTrades.IsNegative() {
+		return fmt.Errorf("number of trades cannot be negative")
+	}
+
+	if err := stats.Profits.Validate(); err != nil {
+		return err
+	}
+
+	seenRoutes := make(map[string]bool)
+	for _, routeStats := range stats.RouteStatistics {
+		if routeStats.NumberOfTrades.IsNil() || routeStats.NumberOfTrades.IsNegative() {
+			return fmt.Errorf("number of trades cannot be negative")
+		}
+
+		if err := sdk.Coins(routeStats.Profits).Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the route is unique
+		routeKey := string(CreateRouteKey(routeStats.Route))
+		if seenRoutes[routeKey] {
+			return fmt.Errorf("duplicate route statistics %s in epoch %d", routeKey, stats.EpochNumber)
+		}
+		seenRoutes[routeKey] = true
+	}
+
+	return nil
+}
+
+// ValidateEpochStatistics validates the epoch statistics passed into the module genesis.
+func ValidateEpochStatistics(epochStatistics []EpochStatistics) error {
+	if uint64(len(epochStatistics)) > EpochStatisticsRetention {
+		return fmt.Errorf("there can be at most %d epoch statistics, got %d", EpochStatisticsRetention, len(epochStatistics))
+	}
+
+	seenEpochs := make(map[uint64]bool)
+	for _, stats := range epochStatistics {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the epoch is unique
+		if seenEpochs[stats.EpochNumber] {
+			return fmt.Errorf("duplicate epoch statistics for epoch %d", stats.EpochNumber)
+		}
+		seenEpochs[stats.EpochNumber] = true
+	}
+	return nil
+}
+
+// ---------------------- Profit Distribution Validation ---------------------- //
+// Validates the distribution of the profits of the module.
+func (distribution *ProfitDistribution) Validate() error {
+	for _, coins := range []sdk.Coins{distribution.Profits, distribution.ToStakers, distribution.ToCommunityPool, distribution.Burned, distribution.Retained} {
+		if err := coins.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateProfitDistributions validates the profit distributions passed into the module genesis.
+func ValidateProfitDistributions(distributions []ProfitDistribution) error {
+	if uint64(len(distributions)) > ProfitDistributionRetention {
+		return fmt.Errorf("there can be at most %d profit distributions, got %d", ProfitDistributionRetention, len(distributions))
+	}
+
+	seenEpochs := make(map[uint64]bool)
+	for _, distribution := range distributions {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the epoch is unique
+		if seenEpochs[distribution.EpochNumber] {
+			return fmt.Errorf("duplicate profit distribution for epoch %d", distribution.EpochNumber)
+		}
+		seenEpochs[distribution.EpochNumber] = true
+	}
+	return nil
+}
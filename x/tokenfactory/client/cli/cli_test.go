@@ -33,3 +33,16 @@ func TestGetCmdDenomsFromCreator(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdDenomMintLimits(t *testing.T) {
+	desc, _ := cli.GetCmdDenomMintLimits()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryDenomMintLimitsRequest]{
+		"basic test": {
+			Cmd: "factory/osmo1test/bitcoin",
+			ExpectedQuery: &types.QueryDenomMintLimitsRequest{
+				Denom: "factory/osmo1test/bitcoin",
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomAuthorityMetadata)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomsFromCreator)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomMintLimits)
 
 	cmd.AddCommand(
 		osmocli.GetParams[*types.QueryParamsRequest](
@@ -48,6 +49,15 @@ func GetCmdDenomsFromCreator() (*osmocli.QueryDescriptor, *types.QueryDenomsFrom
 	}, &types.QueryDenomsFromCreatorRequest{}
 }
 
+func GetCmdDenomMintLimits() (*osmocli.QueryDescriptor, *types.QueryDenomMintLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-mint-limits [denom] [flags]",
+		Short: "Get the max supply, mint rate limit and remaining mint allowance for a specific denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<address>/<subdenom>`,
+	}, &types.QueryDenomMintLimitsRequest{}
+}
+
 // GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
 func GetCmdDenomBeforeSendHook() *cobra.Command {
 	cmd := &cobra.Command{
@@ -50,6 +50,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryDenomsFromCreatorRequest{Creator: s.TestAccs[0].String()},
 			&types.QueryDenomsFromCreatorResponse{},
 		},
+		{
+			"Query denom mint limits",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomMintLimits",
+			&types.QueryDenomMintLimitsRequest{Denom: "tokenfactory"},
+			&types.QueryDenomMintLimitsResponse{},
+		},
 		{
 			"Query params",
 			"/osmosis.tokenfactory.v1beta1.Query/Params",
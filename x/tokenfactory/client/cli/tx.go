@@ -21,6 +21,8 @@ func GetTxCmd() *cobra.Command {
 		// NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetMintRateLimitCmd(),
 	)
 
 	return cmd
@@ -54,6 +56,20 @@ func NewChangeAdminCmd() *cobra.Command {
 	})
 }
 
+func NewSetMaxSupplyCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetMaxSupply](&osmocli.TxCliDesc{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Caps the supply of a factory-created denom. Once set, the max supply can only be decreased. Must have admin authority to do so.",
+	})
+}
+
+func NewSetMintRateLimitCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetMintRateLimit](&osmocli.TxCliDesc{
+		Use:   "set-mint-rate-limit [denom] [amount] [period] [flags]",
+		Short: "Limits how much of a factory-created denom can be minted per period, e.g. 1000000 24h. Once set, the limit can only be tightened. Must have admin authority to do so.",
+	})
+}
+
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewSetBeforeSendHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
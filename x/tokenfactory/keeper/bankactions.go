@@ -13,6 +13,12 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	// verify that the mint stays within the max supply and mint rate limit of the denom
+	err = k.trackMint(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -29,6 +29,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.MintLimits != nil {
+			err = k.setMintLimits(ctx, genDenom.GetDenom(), *genDenom.MintLimits)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -45,10 +51,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+
+		mintLimits, found, err := k.GetMintLimits(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if found {
+			genDenom.MintLimits = &mintLimits
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
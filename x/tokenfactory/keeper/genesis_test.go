@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -27,6 +29,13 @@ func (s *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
 				},
+				MintLimits: &types.DenomMintLimits{
+					MaxSupply:             sdk.NewInt(1000000),
+					MintRateLimit:         sdk.NewInt(1000),
+					MintRateLimitPeriod:   time.Hour,
+					CurrentPeriodStart:    time.Unix(1690000000, 0).UTC(),
+					MintedInCurrentPeriod: sdk.NewInt(10),
+				},
 			},
 		},
 	}
@@ -41,3 +41,23 @@ func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBefor
 
 	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
 }
+
+func (k Keeper) DenomMintLimits(ctx context.Context, req *types.QueryDenomMintLimitsRequest) (*types.QueryDenomMintLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	limits, _, err := k.GetMintLimits(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	remainingMintAllowance, unlimited, err := k.GetRemainingMintAllowance(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomMintLimitsResponse{
+		MintLimits:             limits,
+		Unlimited:              unlimited,
+		RemainingMintAllowance: remainingMintAllowance,
+	}, nil
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v17/x/tokenfactory/types"
+)
+
+// GetMintLimits returns the mint limits of a specific denom, and whether the denom has any.
+// A denom without mint limits gets limits without a max supply or a mint rate limit.
+func (k Keeper) GetMintLimits(ctx sdk.Context, denom string) (types.DenomMintLimits, bool, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMintLimitsKey))
+	if bz == nil {
+		return types.NewDenomMintLimits(), false, nil
+	}
+
+	limits := types.DenomMintLimits{}
+	err := proto.Unmarshal(bz, &limits)
+	if err != nil {
+		return types.DenomMintLimits{}, false, err
+	}
+	return limits, true, nil
+}
+
+// setMintLimits stores the mint limits of a specific denom
+func (k Keeper) setMintLimits(ctx sdk.Context, denom string, limits types.DenomMintLimits) error {
+	err := limits.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if limits.IsUnlimited() {
+		store.Delete([]byte(types.DenomMintLimitsKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&limits)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomMintLimitsKey), bz)
+	return nil
+}
+
+// setMaxSupply caps the supply of a denom. A max supply cannot be removed or increased once set,
+// and cannot be lower than the current supply of the denom.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdk.Int) error {
+	if !maxSupply.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidMintLimits, "max supply must be positive, got %s", maxSupply)
+	}
+
+	limits, _, err := k.GetMintLimits(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if limits.HasMaxSupply() && maxSupply.GT(limits.MaxSupply) {
+		return errorsmod.Wrapf(types.ErrInvalidMintLimits, "max supply can only be decreased, from %s to %s", limits.MaxSupply, maxSupply)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if maxSupply.LT(supply.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidMintLimits, "max supply %s is below the current supply %s", maxSupply, supply.Amount)
+	}
+
+	limits.MaxSupply = maxSupply
+	return k.setMintLimits(ctx, denom, limits)
+}
+
+// setMintRateLimit limits how much of a denom can be minted per period. Like the max supply, a mint rate limit
+// cannot be removed or loosened once set, so it can only be changed to a lower or equal amount over a longer or
+// equal period. The amount already minted in the current period still counts towards the new limit.
+func (k Keeper) setMintRateLimit(ctx sdk.Context, denom string, amount sdk.Int, period time.Duration) error {
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidMintLimits, "mint rate limit must be positive, got %s", amount)
+	}
+	if period <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidMintLimits, "mint rate limit period must be positive, got %s", period)
+	}
+
+	limits, _, err := k.GetMintLimits(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if limits.HasMintRateLimit() {
+		if amount.GT(limits.MintRateLimit) {
+			return errorsmod.Wrapf(types.ErrInvalidMintLimits, "mint rate limit can only be decreased, from %s to %s", limits.MintRateLimit, amount)
+		}
+		if period < limits.MintRateLimitPeriod {
+			return errorsmod.Wrapf(types.ErrInvalidMintLimits, "mint rate limit period can only be increased, from %s to %s", limits.MintRateLimitPeriod, period)
+		}
+	}
+
+	mintedInPeriod := limits.MintedInPeriodAt(ctx.BlockTime())
+	if !limits.HasMintRateLimit() || mintedInPeriod.IsZero() {
+		limits.CurrentPeriodStart = ctx.BlockTime()
+	}
+
+	limits.MintRateLimit = amount
+	limits.MintRateLimitPeriod = period
+	limits.MintedInCurrentPeriod = mintedInPeriod
+	return k.setMintLimits(ctx, denom, limits)
+}
+
+// GetRemainingMintAllowance returns how much of a denom can be minted now without exceeding its max supply
+// or its mint rate limit, and whether the denom is unlimited, in which case the returned allowance is zero.
+func (k Keeper) GetRemainingMintAllowance(ctx sdk.Context, denom string) (sdk.Int, bool, error) {
+	limits, _, err := k.GetMintLimits(ctx, denom)
+	if err != nil {
+		return sdk.Int{}, false, err
+	}
+
+	if limits.IsUnlimited() {
+		return sdk.ZeroInt(), true, nil
+	}
+
+	var allowance *sdk.Int
+	if limits.HasMaxSupply() {
+		supplyAllowance := sdk.MaxInt(limits.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, denom).Amount), sdk.ZeroInt())
+		allowance = &supplyAllowance
+	}
+	if limits.HasMintRateLimit() {
+		rateAllowance := sdk.MaxInt(limits.MintRateLimit.Sub(limits.MintedInPeriodAt(ctx.BlockTime())), sdk.ZeroInt())
+		if allowance == nil || rateAllowance.LT(*allowance) {
+			allowance = &rateAllowance
+		}
+	}
+
+	return *allowance, false, nil
+}
+
+// trackMint checks that minting the given amount does not exceed the max supply or the mint rate limit of its denom,
+// and counts the amount towards the current rate limit period, starting a new period if the last one has ended.
+func (k Keeper) trackMint(ctx sdk.Context, amount sdk.Coin) error {
+	limits, found, err := k.GetMintLimits(ctx, amount.Denom)
+	if err != nil || !found {
+		return err
+	}
+
+	if limits.HasMaxSupply() {
+		newSupply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount.Add(amount.Amount)
+		if newSupply.GT(limits.MaxSupply) {
+			return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "minting %s would take the supply to %s, above the max supply %s", amount, newSupply, limits.MaxSupply)
+		}
+	}
+
+	if limits.HasMintRateLimit() {
+		mintedInPeriod := limits.MintedInPeriodAt(ctx.BlockTime())
+		if mintedInPeriod.IsZero() {
+			limits.CurrentPeriodStart = ctx.BlockTime()
+		}
+
+		mintedInPeriod = mintedInPeriod.Add(amount.Amount)
+		if mintedInPeriod.GT(limits.MintRateLimit) {
+			return errorsmod.Wrapf(types.ErrMintRateLimitExceeded, "minting %s would take the amount minted in the current period to %s, above the rate limit %s per %s", amount, mintedInPeriod, limits.MintRateLimit, limits.MintRateLimitPeriod)
+		}
+		limits.MintedInCurrentPeriod = mintedInPeriod
+	}
+
+	return k.setMintLimits(ctx, amount.Denom, limits)
+}
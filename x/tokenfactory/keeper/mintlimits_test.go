@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetMaxSupply() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc        string
+		sender      string
+		maxSupply   int64
+		expectedErr error
+	}{
+		{
+			desc:        "only the admin can set the max supply",
+			sender:      s.TestAccs[1].String(),
+			maxSupply:   1000,
+			expectedErr: types.ErrUnauthorized,
+		},
+		{
+			desc:        "max supply cannot be below the current supply",
+			sender:      admin,
+			maxSupply:   99,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:      "success case",
+			sender:    admin,
+			maxSupply: 1000,
+		},
+		{
+			desc:        "max supply cannot be increased",
+			sender:      admin,
+			maxSupply:   1001,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:      "max supply can be decreased",
+			sender:    admin,
+			maxSupply: 500,
+		},
+		{
+			desc:      "max supply can be decreased down to the current supply",
+			sender:    admin,
+			maxSupply: 100,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxSupply(tc.sender, s.defaultDenom, sdk.NewInt(tc.maxSupply)))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, 1)
+
+			limits, found, err := s.App.TokenFactoryKeeper.GetMintLimits(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(sdk.NewInt(tc.maxSupply), limits.MaxSupply)
+		})
+	}
+
+	// Nothing can be minted at the max supply, but burning makes room to mint again
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(s.defaultDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(s.defaultDenom, 40)))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.DenomMintLimits(sdk.WrapSDKContext(s.Ctx), &types.QueryDenomMintLimitsRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().False(res.Unlimited)
+	s.Require().Equal(sdk.NewInt(100), res.MintLimits.MaxSupply)
+	s.Require().Equal(sdk.NewInt(40), res.RemainingMintAllowance)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(s.defaultDenom, 40)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(100), s.App.BankKeeper.GetSupply(s.Ctx, s.defaultDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestSetMintRateLimit() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	mint := func(amount int64) error {
+		_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(s.defaultDenom, amount)))
+		return err
+	}
+	remainingMintAllowance := func() (sdk.Int, bool) {
+		s.QueryHelper.Ctx = s.Ctx
+		res, err := s.queryClient.DenomMintLimits(sdk.WrapSDKContext(s.Ctx), &types.QueryDenomMintLimitsRequest{Denom: s.defaultDenom})
+		s.Require().NoError(err)
+		return res.RemainingMintAllowance, res.Unlimited
+	}
+
+	// Denoms are unlimited by default
+	_, unlimited := remainingMintAllowance()
+	s.Require().True(unlimited)
+
+	// Only the admin can set the rate limit, and the period must be positive
+	_, err := s.msgServer.SetMintRateLimit(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMintRateLimit(s.TestAccs[1].String(), s.defaultDenom, sdk.NewInt(100), time.Hour))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetMintRateLimit(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMintRateLimit(admin, s.defaultDenom, sdk.NewInt(100), 0))
+	s.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+
+	// Allow minting 100 per hour
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetMintRateLimit(admin, s.defaultDenom, sdk.NewInt(100), time.Hour))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, types.TypeMsgSetMintRateLimit, 1)
+
+	s.Require().NoError(mint(60))
+	s.Require().ErrorIs(mint(50), types.ErrMintRateLimitExceeded)
+	allowance, unlimited := remainingMintAllowance()
+	s.Require().False(unlimited)
+	s.Require().Equal(sdk.NewInt(40), allowance)
+
+	// Lowering the rate limit keeps the amount minted in the current period
+	_, err = s.msgServer.SetMintRateLimit(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMintRateLimit(admin, s.defaultDenom, sdk.NewInt(70), time.Hour))
+	s.Require().NoError(err)
+	allowance, _ = remainingMintAllowance()
+	s.Require().Equal(sdk.NewInt(10), allowance)
+
+	// The full rate limit is available again once the period has ended
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	allowance, _ = remainingMintAllowance()
+	s.Require().Equal(sdk.NewInt(70), allowance)
+	s.Require().NoError(mint(70))
+	s.Require().ErrorIs(mint(1), types.ErrMintRateLimitExceeded)
+
+	// The remaining allowance is the lowest of the max supply and rate limit allowances
+	_, err = s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(admin, s.defaultDenom, sdk.NewInt(150)))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	allowance, _ = remainingMintAllowance()
+	s.Require().Equal(sdk.NewInt(20), allowance)
+
+	s.Require().ErrorIs(mint(21), types.ErrMaxSupplyExceeded)
+	s.Require().NoError(mint(20))
+}
+
+func (s *KeeperTestSuite) TestSetMintRateLimit_OnlyTightens() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+
+	for _, tc := range []struct {
+		desc        string
+		amount      int64
+		period      time.Duration
+		expectedErr error
+	}{
+		{
+			desc:   "success case",
+			amount: 100,
+			period: time.Hour,
+		},
+		{
+			desc:        "rate limit cannot be removed",
+			amount:      0,
+			period:      0,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:        "rate limit cannot be increased",
+			amount:      101,
+			period:      time.Hour,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:        "rate limit period cannot be decreased",
+			amount:      100,
+			period:      30 * time.Minute,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:        "rate limit cannot be increased over a longer period",
+			amount:      200,
+			period:      2 * time.Hour,
+			expectedErr: types.ErrInvalidMintLimits,
+		},
+		{
+			desc:   "rate limit can be decreased",
+			amount: 50,
+			period: time.Hour,
+		},
+		{
+			desc:   "rate limit period can be increased",
+			amount: 50,
+			period: 2 * time.Hour,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetMintRateLimit(admin, s.defaultDenom, sdk.NewInt(tc.amount), tc.period))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetMintRateLimit, 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, types.TypeMsgSetMintRateLimit, 1)
+
+			limits, found, err := s.App.TokenFactoryKeeper.GetMintLimits(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(sdk.NewInt(tc.amount), limits.MintRateLimit)
+			s.Require().Equal(tc.period, limits.MintRateLimitPeriod)
+		})
+	}
+}
@@ -232,3 +232,60 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) SetMintRateLimit(goCtx context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMintRateLimit(ctx, msg.Denom, msg.Amount, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMintRateLimit,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMintRateLimit, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeMintRateLimitPeriod, msg.Period.String()),
+		),
+	})
+
+	return &types.MsgSetMintRateLimitResponse{}, nil
+}
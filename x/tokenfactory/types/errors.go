@@ -20,4 +20,7 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
+	ErrInvalidMintLimits        = errorsmod.Register(ModuleName, 12, "invalid mint limits")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 13, "max supply exceeded")
+	ErrMintRateLimitExceeded    = errorsmod.Register(ModuleName, 14, "mint rate limit exceeded")
 )
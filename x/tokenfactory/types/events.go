@@ -14,4 +14,7 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeMaxSupply             = "max_supply"
+	AttributeMintRateLimit         = "mint_rate_limit"
+	AttributeMintRateLimitPeriod   = "mint_rate_limit_period"
 )
@@ -45,6 +45,13 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.MintLimits != nil {
+			err = denom.MintLimits.Validate()
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
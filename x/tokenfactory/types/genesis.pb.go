@@ -83,6 +83,8 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// mint_limits are the limits on minting the denom, if it has any.
+	MintLimits *DenomMintLimits `protobuf:"bytes,3,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits,omitempty" yaml:"mint_limits"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +134,13 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetMintLimits() *DenomMintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +151,34 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1,
-	0xb9, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x86, 0xb1, 0xa7, 0xac, 0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0xbd, 0xd7, 0x0b, 0x4e, 0xaf, 0xa2, 0x83, 0x4a, 0x2c, 0x9a, 0xd4, 0x20, 0x52,
+	0x0b, 0x4d, 0x68, 0x2d, 0x28, 0xdd, 0x19, 0x0a, 0x6e, 0x2c, 0x48, 0xdc, 0xb9, 0x29, 0x93, 0x76,
+	0x9a, 0x06, 0x3b, 0x99, 0x90, 0x99, 0x16, 0xf3, 0x02, 0xae, 0x7d, 0x04, 0x5f, 0xc0, 0xb7, 0x70,
+	0xd1, 0x65, 0x97, 0xae, 0x82, 0xb4, 0x1b, 0xd7, 0x7d, 0x02, 0xc9, 0xcc, 0x58, 0x5b, 0x0b, 0xc1,
+	0x5d, 0x66, 0xf2, 0x9d, 0xff, 0xfc, 0x67, 0xfe, 0x03, 0xdb, 0x8c, 0x53, 0xc6, 0x63, 0xee, 0x09,
+	0xf6, 0x91, 0x24, 0x33, 0x3c, 0x11, 0x2c, 0xcb, 0xbd, 0x55, 0x37, 0x24, 0x02, 0x77, 0xbd, 0x88,
+	0x24, 0x84, 0xc7, 0xdc, 0x4d, 0x33, 0x26, 0x18, 0x7a, 0xa4, 0x59, 0xf7, 0x98, 0x75, 0x35, 0xdb,
+	0xb8, 0x17, 0xb1, 0x88, 0x49, 0xd0, 0x2b, 0xbf, 0x54, 0x4d, 0xa3, 0x5f, 0xa9, 0x8f, 0x97, 0x62,
+	0xce, 0xb2, 0x58, 0xe4, 0x23, 0x22, 0xf0, 0x14, 0x0b, 0xac, 0xab, 0x3a, 0x95, 0x55, 0x34, 0x4e,
+	0xc4, 0xdb, 0x98, 0xc6, 0x42, 0x1b, 0x6b, 0x3c, 0xaf, 0xc4, 0x53, 0x9c, 0x61, 0xaa, 0x51, 0xe7,
+	0x3b, 0x80, 0xd7, 0x6f, 0xd4, 0x54, 0xef, 0x05, 0x16, 0x04, 0xf9, 0xf0, 0x4a, 0x01, 0x26, 0x68,
+	0x82, 0x56, 0xbd, 0xf7, 0xd4, 0xad, 0x9a, 0xd2, 0x7d, 0x27, 0x59, 0xff, 0x72, 0x5d, 0xd8, 0x46,
+	0xa0, 0x2b, 0x51, 0x0a, 0x6f, 0x6b, 0x6e, 0x3c, 0x25, 0x09, 0xa3, 0xdc, 0xac, 0x35, 0x2f, 0x5a,
+	0xf5, 0x5e, 0xbb, 0x5a, 0x4b, 0xfb, 0x18, 0x96, 0x25, 0xfe, 0xe3, 0x52, 0x71, 0x5f, 0xd8, 0xf7,
+	0x73, 0x4c, 0x17, 0x03, 0xe7, 0x54, 0xcf, 0x09, 0x6e, 0xe9, 0x8b, 0xa1, 0x3a, 0x7f, 0xab, 0x1d,
+	0xc6, 0x90, 0x37, 0xe8, 0x19, 0xbc, 0x21, 0x51, 0x39, 0xc5, 0x4d, 0xff, 0xce, 0xbe, 0xb0, 0xaf,
+	0x95, 0x92, 0xbc, 0x76, 0x02, 0xf5, 0x1b, 0x7d, 0x06, 0x10, 0x1d, 0x5e, 0x7d, 0x4c, 0xf5, 0xb3,
+	0x9b, 0x35, 0x39, 0x7b, 0xbf, 0xda, 0xaf, 0xec, 0xf4, 0xfa, 0xdf, 0xc8, 0xfc, 0x27, 0xda, 0xf9,
+	0x43, 0xd5, 0xef, 0x5c, 0xdd, 0x09, 0xee, 0x9e, 0x05, 0x8d, 0x66, 0xb0, 0x5e, 0xe6, 0x38, 0x5e,
+	0xc8, 0x20, 0xcd, 0x0b, 0x69, 0xa0, 0xf3, 0x1f, 0x06, 0x46, 0x87, 0xf4, 0xfd, 0x07, 0xfb, 0xc2,
+	0x46, 0xaa, 0xeb, 0x91, 0x96, 0x13, 0xc0, 0xbf, 0x1b, 0x32, 0xb8, 0xfc, 0xf5, 0xd5, 0x06, 0x7e,
+	0xb0, 0xde, 0x5a, 0x60, 0xb3, 0xb5, 0xc0, 0xcf, 0xad, 0x05, 0xbe, 0xec, 0x2c, 0x63, 0xb3, 0xb3,
+	0x8c, 0x1f, 0x3b, 0xcb, 0xf8, 0xf0, 0x2a, 0x8a, 0xc5, 0x7c, 0x19, 0xba, 0x13, 0x46, 0x3d, 0xdd,
+	0xbc, 0xb3, 0xc0, 0x21, 0xff, 0x73, 0xf0, 0x56, 0xdd, 0x97, 0xde, 0xa7, 0xd3, 0xcd, 0x12, 0x79,
+	0x4a, 0x78, 0x78, 0x25, 0x37, 0xea, 0xc5, 0xef, 0x01, 0x00, 0xe6, 0xd2, 0x97, 0xfa, 0x43, 0x03,
+	0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +206,9 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MintLimits.Equal(that1.MintLimits) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +278,18 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintLimits != nil {
+		{
+			size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +350,10 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MintLimits != nil {
+		l = m.MintLimits.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +574,42 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintLimits == nil {
+				m.MintLimits = &DenomMintLimits{}
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomMintLimits returns mint limits without a max supply or a mint rate limit.
+func NewDenomMintLimits() DenomMintLimits {
+	return DenomMintLimits{
+		MaxSupply:             sdk.ZeroInt(),
+		MintRateLimit:         sdk.ZeroInt(),
+		MintedInCurrentPeriod: sdk.ZeroInt(),
+	}
+}
+
+// HasMaxSupply returns true if the supply of the denom is capped.
+func (limits DenomMintLimits) HasMaxSupply() bool {
+	return limits.MaxSupply.IsPositive()
+}
+
+// HasMintRateLimit returns true if the amount of the denom minted per period is limited.
+func (limits DenomMintLimits) HasMintRateLimit() bool {
+	return limits.MintRateLimit.IsPositive()
+}
+
+// IsUnlimited returns true if the denom has neither a max supply nor a mint rate limit.
+func (limits DenomMintLimits) IsUnlimited() bool {
+	return !limits.HasMaxSupply() && !limits.HasMintRateLimit()
+}
+
+// MintedInPeriodAt returns the amount minted in the rate limit period that is current at the given time,
+// which is zero if the stored period has ended by then.
+func (limits DenomMintLimits) MintedInPeriodAt(blockTime time.Time) sdk.Int {
+	if !limits.HasMintRateLimit() || !limits.CurrentPeriodStart.Add(limits.MintRateLimitPeriod).After(blockTime) {
+		return sdk.ZeroInt()
+	}
+	return limits.MintedInCurrentPeriod
+}
+
+func (limits DenomMintLimits) Validate() error {
+	if limits.MaxSupply.IsNil() || limits.MaxSupply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply must not be negative, got %s", limits.MaxSupply)
+	}
+	if limits.MintRateLimit.IsNil() || limits.MintRateLimit.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "mint rate limit must not be negative, got %s", limits.MintRateLimit)
+	}
+	if limits.HasMintRateLimit() && limits.MintRateLimitPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "mint rate limit period must be positive, got %s", limits.MintRateLimitPeriod)
+	}
+	if limits.MintedInCurrentPeriod.IsNil() || limits.MintedInCurrentPeriod.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "minted in current period must not be negative, got %s", limits.MintedInCurrentPeriod)
+	}
+	return nil
+}
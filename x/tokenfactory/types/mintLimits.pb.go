@@ -0,0 +1,581 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/mintLimits.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomMintLimits specifies the limits on how much of a token factory denom
+// its admin can mint, along with the amount minted in the current rate limit
+// period.
+type DenomMintLimits struct {
+	// max_supply is the most of the denom that can ever be in supply. Once set,
+	// it can only be decreased. Zero if the denom has no max supply.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// mint_rate_limit is the most of the denom that can be minted in each
+	// period of mint_rate_limit_period. Zero if the denom has no rate limit.
+	MintRateLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=mint_rate_limit,json=mintRateLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mint_rate_limit" yaml:"mint_rate_limit"`
+	// mint_rate_limit_period is the length of a rate limit period.
+	MintRateLimitPeriod time.Duration `protobuf:"bytes,3,opt,name=mint_rate_limit_period,json=mintRateLimitPeriod,proto3,stdduration" json:"mint_rate_limit_period" yaml:"mint_rate_limit_period"`
+	// current_period_start is the time at which the current rate limit period
+	// started.
+	CurrentPeriodStart time.Time `protobuf:"bytes,4,opt,name=current_period_start,json=currentPeriodStart,proto3,stdtime" json:"current_period_start" yaml:"current_period_start"`
+	// minted_in_current_period is the amount of the denom minted since the
+	// start of the current rate limit period.
+	MintedInCurrentPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=minted_in_current_period,json=mintedInCurrentPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted_in_current_period" yaml:"minted_in_current_period"`
+}
+
+func (m *DenomMintLimits) Reset()         { *m = DenomMintLimits{} }
+func (m *DenomMintLimits) String() string { return proto.CompactTextString(m) }
+func (*DenomMintLimits) ProtoMessage()    {}
+func (*DenomMintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a4d3c24b1ae14d, []int{0}
+}
+func (m *DenomMintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMintLimits.Merge(m, src)
+}
+func (m *DenomMintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMintLimits proto.InternalMessageInfo
+
+func (m *DenomMintLimits) GetMintRateLimitPeriod() time.Duration {
+	if m != nil {
+		return m.MintRateLimitPeriod
+	}
+	return 0
+}
+
+func (m *DenomMintLimits) GetCurrentPeriodStart() time.Time {
+	if m != nil {
+		return m.CurrentPeriodStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DenomMintLimits)(nil), "osmosis.tokenfactory.v1beta1.DenomMintLimits")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/mintLimits.proto", fileDescriptor_44a4d3c24b1ae14d)
+}
+
+var fileDescriptor_44a4d3c24b1ae14d = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x73, 0x10, 0x90, 0x6a, 0x84, 0x2a, 0x4c, 0xa9, 0x4c, 0x00, 0xbb, 0xf2, 0x00, 0x65,
+	0x88, 0x4f, 0x81, 0x01, 0xd4, 0x31, 0xed, 0x40, 0x25, 0x90, 0xc0, 0x65, 0x62, 0xb1, 0xce, 0xc9,
+	0xd5, 0x9c, 0xea, 0xf3, 0x59, 0x77, 0x7f, 0x57, 0xf1, 0x57, 0x60, 0xea, 0xc8, 0xc8, 0xc7, 0xe9,
+	0xd8, 0x11, 0x31, 0x04, 0x94, 0x2c, 0x0c, 0x4c, 0xfd, 0x04, 0xe8, 0xce, 0x17, 0x1a, 0x97, 0x2c,
+	0x99, 0x7c, 0xe7, 0xf7, 0xee, 0xff, 0x7b, 0x7e, 0xd6, 0x39, 0x7d, 0xa1, 0xb8, 0x50, 0x4c, 0x61,
+	0x10, 0x27, 0xb4, 0x38, 0x26, 0x23, 0x10, 0xb2, 0xc6, 0xa7, 0x83, 0x94, 0x02, 0x19, 0x60, 0xce,
+	0x0a, 0x78, 0xcb, 0x38, 0x03, 0x15, 0x95, 0x52, 0x80, 0x70, 0x1f, 0x5b, 0x7b, 0xb4, 0x6c, 0x8f,
+	0xac, 0xbd, 0xb7, 0x95, 0x89, 0x4c, 0x18, 0x23, 0xd6, 0xab, 0xe6, 0x4c, 0xcf, 0xcf, 0x84, 0xc8,
+	0x72, 0x8a, 0xcd, 0x2e, 0xad, 0x8e, 0xf1, 0xb8, 0x92, 0x04, 0x98, 0x28, 0xac, 0x1e, 0x5c, 0xd7,
+	0x81, 0x71, 0xaa, 0x80, 0xf0, 0xb2, 0x31, 0x84, 0x7f, 0xba, 0xce, 0xe6, 0x01, 0x2d, 0x04, 0x7f,
+	0xf7, 0x2f, 0x8e, 0x9b, 0x3a, 0x0e, 0x27, 0x93, 0x44, 0x55, 0x65, 0x99, 0xd7, 0x1e, 0xda, 0x41,
+	0xbb, 0x1b, 0xc3, 0xfd, 0xf3, 0x69, 0xd0, 0xf9, 0x31, 0x0d, 0x9e, 0x66, 0x0c, 0x3e, 0x57, 0x69,
+	0x34, 0x12, 0x1c, 0x8f, 0x4c, 0x60, 0xfb, 0xe8, 0xab, 0xf1, 0x09, 0x86, 0xba, 0xa4, 0x2a, 0x3a,
+	0x2c, 0xe0, 0x72, 0x1a, 0xdc, 0xab, 0x09, 0xcf, 0xf7, 0xc2, 0xab, 0x49, 0x61, 0xbc, 0xc1, 0xc9,
+	0xe4, 0xc8, 0xac, 0xdd, 0xd2, 0xd9, 0xd4, 0x05, 0x24, 0x92, 0x00, 0x4d, 0x72, 0xcd, 0xf5, 0x6e,
+	0x18, 0xd0, 0x9b, 0xb5, 0x41, 0xdb, 0x16, 0xd4, 0x1e, 0x17, 0xc6, 0x77, 0xf5, 0x9b, 0x98, 0x00,
+	0x35, 0x9f, 0xe5, 0xd6, 0xce, 0xf6, 0x35, 0x4b, 0x52, 0x52, 0xc9, 0xc4, 0xd8, 0xbb, 0xb9, 0x83,
+	0x76, 0xef, 0xbc, 0x78, 0x18, 0x35, 0x5d, 0x45, 0x8b, 0xae, 0xa2, 0x03, 0xdb, 0xe5, 0xf0, 0xb9,
+	0xce, 0x74, 0x39, 0x0d, 0x9e, 0xac, 0x24, 0xd9, 0x31, 0xe1, 0xd7, 0x9f, 0x01, 0x8a, 0xef, 0xb7,
+	0xa0, 0xef, 0x8d, 0xe2, 0x56, 0xce, 0xd6, 0xa8, 0x92, 0x92, 0x16, 0x0b, 0x6f, 0xa2, 0x80, 0x48,
+	0xf0, 0xba, 0x06, 0xdc, 0xfb, 0x0f, 0xfc, 0x71, 0xf1, 0x93, 0x86, 0xcf, 0x2c, 0xf9, 0x51, 0x43,
+	0x5e, 0x35, 0x25, 0x3c, 0xd3, 0x5c, 0xd7, 0x4a, 0x0d, 0xf1, 0x48, 0x0b, 0xee, 0x17, 0xe4, 0x78,
+	0x3a, 0x0e, 0x1d, 0x27, 0xac, 0x48, 0xda, 0x67, 0xbd, 0x5b, 0xa6, 0xed, 0x0f, 0x6b, 0xb7, 0x1d,
+	0x5c, 0x75, 0xb0, 0x6a, 0x6e, 0x18, 0x3f, 0x68, 0xa4, 0xc3, 0x62, 0x7f, 0x39, 0xd1, 0x5e, 0xf7,
+	0xf7, 0xb7, 0x00, 0x0d, 0xe3, 0xf3, 0x99, 0x8f, 0x2e, 0x66, 0x3e, 0xfa, 0x35, 0xf3, 0xd1, 0xd9,
+	0xdc, 0xef, 0x5c, 0xcc, 0xfd, 0xce, 0xf7, 0xb9, 0xdf, 0xf9, 0xf4, 0x7a, 0x29, 0x81, 0xbd, 0x08,
+	0xfd, 0x9c, 0xa4, 0x6a, 0xb1, 0xc1, 0xa7, 0x83, 0x57, 0x78, 0xd2, 0xbe, 0x4a, 0x26, 0x57, 0x7a,
+	0xdb, 0xf4, 0xf6, 0xf2, 0xef, 0x00, 0x0c, 0xd4, 0xc1, 0x99, 0x6f, 0x03, 0x00, 0x00,
+}
+
+func (this *DenomMintLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMintLimits)
+	if !ok {
+		that2, ok := that.(DenomMintLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.MintRateLimit.Equal(that1.MintRateLimit) {
+		return false
+	}
+	if this.MintRateLimitPeriod != that1.MintRateLimitPeriod {
+		return false
+	}
+	if !this.CurrentPeriodStart.Equal(that1.CurrentPeriodStart) {
+		return false
+	}
+	if !this.MintedInCurrentPeriod.Equal(that1.MintedInCurrentPeriod) {
+		return false
+	}
+	return true
+}
+func (m *DenomMintLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMintLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintedInCurrentPeriod.Size()
+		i -= size
+		if _, err := m.MintedInCurrentPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentPeriodStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentPeriodStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintLimits(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MintRateLimitPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MintRateLimitPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintLimits(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MintRateLimit.Size()
+		i -= size
+		if _, err := m.MintRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintLimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintLimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomMintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = m.MintRateLimit.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MintRateLimitPeriod)
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentPeriodStart)
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = m.MintedInCurrentPeriod.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	return n
+}
+
+func sovMintLimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintLimits(x uint64) (n int) {
+	return sovMintLimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomMintLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMintLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MintRateLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CurrentPeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedInCurrentPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedInCurrentPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintLimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintLimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintLimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintLimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintLimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintLimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintLimits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,6 +18,8 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetMaxSupply      = "set_max_supply"
+	TypeMsgSetMintRateLimit  = "set_mint_rate_limit"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -306,3 +310,88 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to cap the supply of a denom
+func NewMsgSetMaxSupply(sender string, denom string, maxSupply sdk.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply must be positive, got %s", m.MaxSupply)
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMintRateLimit{}
+
+// NewMsgSetMintRateLimit creates a message to limit how much of a denom can be minted per period
+func NewMsgSetMintRateLimit(sender string, denom string, amount sdk.Int, period time.Duration) *MsgSetMintRateLimit {
+	return &MsgSetMintRateLimit{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+		Period: period,
+	}
+}
+
+func (m MsgSetMintRateLimit) Route() string { return RouterKey }
+func (m MsgSetMintRateLimit) Type() string  { return TypeMsgSetMintRateLimit }
+func (m MsgSetMintRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "mint rate limit must be positive, got %s", m.Amount)
+	}
+
+	if m.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "mint rate limit period must be positive, got %s", m.Period)
+	}
+
+	return nil
+}
+
+func (m MsgSetMintRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMintRateLimit) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
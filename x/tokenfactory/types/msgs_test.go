@@ -3,6 +3,7 @@ package types_test
 import (
 	fmt "fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -448,3 +449,157 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetMaxSupply tests if valid/invalid set max supply messages are properly validated/invalidated
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(addr1.String(), tokenFactoryDenom, sdk.NewInt(1000))
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMintRateLimit tests if valid/invalid set mint rate limit messages are properly validated/invalidated
+func TestMsgSetMintRateLimit(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMintRateLimit message
+	baseMsg := types.NewMsgSetMintRateLimit(addr1.String(), tokenFactoryDenom, sdk.NewInt(1000), time.Hour)
+
+	// validate setMintRateLimit message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_mint_rate_limit")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMintRateLimit
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				msg.Amount = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero period",
+			msg: func() *types.MsgSetMintRateLimit {
+				msg := *baseMsg
+				msg.Period = 0
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
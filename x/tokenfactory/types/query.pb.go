@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -387,6 +388,111 @@ func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
 	return ""
 }
 
+// QueryDenomMintLimitsRequest defines the request structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomMintLimitsRequest) Reset()         { *m = QueryDenomMintLimitsRequest{} }
+func (m *QueryDenomMintLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsRequest) ProtoMessage()    {}
+func (*QueryDenomMintLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{8}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMintLimitsResponse defines the response structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsResponse struct {
+	MintLimits DenomMintLimits `protobuf:"bytes,1,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits" yaml:"mint_limits"`
+	// unlimited is true if the denom has neither a max supply nor a mint rate
+	// limit, in which case remaining_mint_allowance is zero.
+	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3" json:"unlimited,omitempty" yaml:"unlimited"`
+	// remaining_mint_allowance is how much of the denom can be minted now
+	// without exceeding its max supply or mint rate limit.
+	RemainingMintAllowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_mint_allowance,json=remainingMintAllowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_mint_allowance" yaml:"remaining_mint_allowance"`
+}
+
+func (m *QueryDenomMintLimitsResponse) Reset()         { *m = QueryDenomMintLimitsResponse{} }
+func (m *QueryDenomMintLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsResponse) ProtoMessage()    {}
+func (*QueryDenomMintLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{9}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsResponse) GetMintLimits() DenomMintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return DenomMintLimits{}
+}
+
+func (m *QueryDenomMintLimitsResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -396,6 +502,8 @@ func init() {
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
 	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
+	proto.RegisterType((*QueryDenomMintLimitsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintLimitsRequest")
+	proto.RegisterType((*QueryDenomMintLimitsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintLimitsResponse")
 }
 
 func init() {
@@ -403,50 +511,61 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4e, 0x13, 0x5f,
-	0x14, 0xee, 0xfc, 0x7e, 0x52, 0xc3, 0xf5, 0x1f, 0x5c, 0xf1, 0x5f, 0xc5, 0xa9, 0x5c, 0x09, 0x01,
-	0x83, 0x1d, 0x8b, 0x24, 0x1a, 0x91, 0x40, 0x07, 0x45, 0x13, 0x24, 0xd1, 0x71, 0xa5, 0x9b, 0xe6,
-	0xb6, 0xbd, 0x94, 0x86, 0xce, 0x9c, 0x32, 0xf7, 0x16, 0x6d, 0x08, 0x1b, 0x17, 0xae, 0x4d, 0x5c,
-	0xfa, 0x0e, 0x3e, 0x07, 0x4b, 0x12, 0x36, 0xae, 0x1a, 0x05, 0xe3, 0x03, 0xf4, 0x09, 0x4c, 0xef,
-	0x3d, 0x45, 0xa0, 0x65, 0xd2, 0xe2, 0xaa, 0x93, 0x73, 0xbe, 0xf3, 0x9d, 0xef, 0xbb, 0xe7, 0x9c,
-	0x94, 0x8c, 0x83, 0xf4, 0x41, 0x96, 0xa4, 0xa3, 0x60, 0x4d, 0x04, 0x2b, 0x3c, 0xaf, 0x20, 0xac,
-	0x39, 0x1b, 0xe9, 0x9c, 0x50, 0x3c, 0xed, 0xac, 0x57, 0x45, 0x58, 0x4b, 0x55, 0x42, 0x50, 0x40,
-	0x87, 0x11, 0x99, 0x3a, 0x8c, 0x4c, 0x21, 0x32, 0x31, 0x54, 0x84, 0x22, 0x68, 0xa0, 0xd3, 0xfc,
-	0x32, 0x35, 0x89, 0xe1, 0x22, 0x40, 0xb1, 0x2c, 0x1c, 0x5e, 0x29, 0x39, 0x3c, 0x08, 0x40, 0x71,
-	0x55, 0x82, 0x40, 0x62, 0xf6, 0x6e, 0x5e, 0x53, 0x3a, 0x39, 0x2e, 0x85, 0x69, 0x75, 0xd0, 0xb8,
-	0xc2, 0x8b, 0xa5, 0x40, 0x83, 0x11, 0x3b, 0x1d, 0xa9, 0x93, 0x57, 0xd5, 0x2a, 0x84, 0x25, 0x55,
-	0x5b, 0x16, 0x8a, 0x17, 0xb8, 0xe2, 0x58, 0x35, 0x11, 0x59, 0x55, 0xe1, 0x21, 0xf7, 0x51, 0x0c,
-	0x1b, 0x22, 0xf4, 0x75, 0x53, 0xc2, 0x2b, 0x1d, 0xf4, 0xc4, 0x7a, 0x55, 0x48, 0xc5, 0xde, 0x92,
-	0xcb, 0x47, 0xa2, 0xb2, 0x02, 0x81, 0x14, 0xd4, 0x25, 0x71, 0x53, 0x7c, 0xdd, 0xba, 0x6d, 0x8d,
-	0x9f, 0x9b, 0x1a, 0x4d, 0x45, 0x3d, 0x4e, 0xca, 0x54, 0xbb, 0x67, 0xb6, 0xeb, 0xc9, 0x98, 0x87,
-	0x95, 0xec, 0x25, 0x61, 0x9a, 0xfa, 0xa9, 0x08, 0xc0, 0xcf, 0x1c, 0x37, 0x80, 0x02, 0xe8, 0x18,
-	0xe9, 0x2b, 0x34, 0x01, 0xba, 0x51, 0xbf, 0x3b, 0xd0, 0xa8, 0x27, 0xcf, 0xd7, 0xb8, 0x5f, 0x7e,
-	0xcc, 0x74, 0x98, 0x79, 0x26, 0xcd, 0xbe, 0x59, 0xe4, 0x4e, 0x24, 0x1d, 0x2a, 0xff, 0x64, 0x11,
-	0x7a, 0xf0, 0x5a, 0x59, 0x1f, 0xd3, 0x68, 0x63, 0x3a, 0xda, 0x46, 0x67, 0x6a, 0x77, 0xa4, 0x69,
-	0xab, 0x51, 0x4f, 0xde, 0x30, 0xba, 0xda, 0xd9, 0x99, 0x37, 0xd8, 0x36, 0x20, 0xb6, 0x4c, 0x6e,
-	0xfd, 0xd5, 0x2b, 0x17, 0x43, 0xf0, 0x17, 0x42, 0xc1, 0x15, 0x84, 0x2d, 0xe7, 0x93, 0xe4, 0x6c,
-	0xde, 0x44, 0xd0, 0x3b, 0x6d, 0xd4, 0x93, 0x17, 0x4d, 0x0f, 0x4c, 0x30, 0xaf, 0x05, 0x61, 0x4b,
-	0xc4, 0x3e, 0x89, 0x0e, 0x9d, 0x4f, 0x90, 0xb8, 0x7e, 0xaa, 0xe6, 0xcc, 0xfe, 0x1f, 0xef, 0x77,
-	0x07, 0x1b, 0xf5, 0xe4, 0x85, 0x43, 0x4f, 0x29, 0x99, 0x87, 0x00, 0xb6, 0x44, 0x46, 0x34, 0x99,
-	0x2b, 0x56, 0x20, 0x14, 0x6f, 0x44, 0x50, 0x78, 0x01, 0xb0, 0x96, 0x29, 0x14, 0x42, 0x21, 0x65,
-	0xaf, 0x93, 0x29, 0xe3, 0x9c, 0x4f, 0x20, 0x43, 0x75, 0x8b, 0x64, 0xa0, 0x79, 0x0d, 0xef, 0xb9,
-	0xf4, 0xb3, 0xdc, 0xe4, 0x90, 0xf8, 0x66, 0xa3, 0x9e, 0xbc, 0x86, 0xb6, 0x8f, 0x21, 0x98, 0x77,
-	0xa9, 0x15, 0x42, 0xbe, 0xa9, 0xed, 0x38, 0xe9, 0xd3, 0xed, 0xe8, 0x57, 0x8b, 0xc4, 0xcd, 0xe2,
-	0xd1, 0xfb, 0xd1, 0x73, 0x6d, 0xdf, 0xfb, 0x44, 0xba, 0x87, 0x0a, 0xe3, 0x80, 0x4d, 0x7e, 0xdc,
-	0xfd, 0xf5, 0xe5, 0xbf, 0x31, 0x3a, 0xea, 0x74, 0x71, 0x74, 0xf4, 0xb7, 0x45, 0xae, 0x76, 0xde,
-	0x27, 0x3a, 0xdf, 0x45, 0xef, 0xc8, 0xa3, 0x49, 0x64, 0xfe, 0x81, 0x01, 0xdd, 0x3c, 0xd7, 0x6e,
-	0x32, 0x74, 0x2e, 0xda, 0x8d, 0x59, 0x18, 0x67, 0x53, 0xff, 0x6e, 0x39, 0xed, 0xbb, 0x4f, 0x77,
-	0x2d, 0x32, 0xd8, 0xb6, 0x94, 0x74, 0xa6, 0x5b, 0x85, 0x1d, 0x2e, 0x23, 0xf1, 0xe4, 0x74, 0xc5,
-	0xe8, 0x6c, 0x41, 0x3b, 0x9b, 0xa5, 0x33, 0xdd, 0x38, 0xcb, 0xae, 0x84, 0xe0, 0x67, 0xf1, 0xc8,
-	0x9c, 0x4d, 0xfc, 0xd8, 0xa2, 0x3f, 0x2d, 0x72, 0xa5, 0xe3, 0x42, 0xd3, 0xb9, 0x2e, 0xc4, 0x45,
-	0xdd, 0x55, 0x62, 0xfe, 0xf4, 0x04, 0xe8, 0xf0, 0x99, 0x76, 0x38, 0x47, 0x67, 0x7b, 0x9a, 0x5d,
-	0x4e, 0x73, 0x66, 0xa5, 0x08, 0x0a, 0xd9, 0x55, 0x80, 0x35, 0xd7, 0xdb, 0xde, 0xb3, 0xad, 0x9d,
-	0x3d, 0xdb, 0xfa, 0xb1, 0x67, 0x5b, 0x9f, 0xf7, 0xed, 0xd8, 0xce, 0xbe, 0x1d, 0xfb, 0xbe, 0x6f,
-	0xc7, 0xde, 0x3d, 0x2a, 0x96, 0xd4, 0x6a, 0x35, 0x97, 0xca, 0x83, 0xdf, 0x6a, 0x71, 0xaf, 0xcc,
-	0x73, 0xf2, 0xa0, 0xdf, 0x46, 0xfa, 0xa1, 0xf3, 0xe1, 0x68, 0x57, 0x55, 0xab, 0x08, 0x99, 0x8b,
-	0xeb, 0x3f, 0x9b, 0x07, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x38, 0x22, 0xde, 0x2a, 0x77, 0x07,
-	0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5f, 0x4f, 0x2b, 0x45,
+	0x14, 0xef, 0x72, 0xbd, 0xf5, 0x32, 0x57, 0xbd, 0x30, 0xe2, 0xb5, 0xee, 0xc5, 0xee, 0xbd, 0xe3,
+	0x0d, 0xe9, 0x35, 0xb4, 0x6b, 0x91, 0x44, 0x04, 0x09, 0x74, 0x11, 0xd4, 0x00, 0x89, 0xac, 0x4f,
+	0xfa, 0xb2, 0x99, 0x76, 0x87, 0xb2, 0xb6, 0xbb, 0x53, 0x76, 0xa6, 0x60, 0x43, 0x78, 0xf1, 0xc1,
+	0x17, 0x5f, 0x4c, 0x8c, 0x4f, 0x7e, 0x07, 0x3f, 0x07, 0x31, 0x3e, 0x90, 0xf0, 0x62, 0x7c, 0xd8,
+	0x28, 0x18, 0x3f, 0x40, 0x3f, 0x81, 0xd9, 0x99, 0xe9, 0x1f, 0x68, 0xd9, 0xb4, 0xdc, 0xa7, 0x6e,
+	0xe6, 0x9c, 0xf3, 0x3b, 0xbf, 0xdf, 0x99, 0x39, 0xbf, 0x14, 0xe4, 0x28, 0xf3, 0x29, 0xf3, 0x98,
+	0xc9, 0x69, 0x8d, 0x04, 0xfb, 0xb8, 0xc2, 0x69, 0xd8, 0x32, 0x8f, 0x8a, 0x65, 0xc2, 0x71, 0xd1,
+	0x3c, 0x6c, 0x92, 0xb0, 0x55, 0x68, 0x84, 0x94, 0x53, 0x38, 0xab, 0x32, 0x0b, 0xfd, 0x99, 0x05,
+	0x95, 0xa9, 0xcf, 0x54, 0x69, 0x95, 0x8a, 0x44, 0x33, 0xfe, 0x92, 0x35, 0xfa, 0x6c, 0x95, 0xd2,
+	0x6a, 0x9d, 0x98, 0xb8, 0xe1, 0x99, 0x38, 0x08, 0x28, 0xc7, 0xdc, 0xa3, 0x01, 0x53, 0xd1, 0xf7,
+	0x2b, 0x02, 0xd2, 0x2c, 0x63, 0x46, 0x64, 0xab, 0x6e, 0xe3, 0x06, 0xae, 0x7a, 0x81, 0x48, 0x56,
+	0xb9, 0x8b, 0x89, 0x3c, 0x71, 0x93, 0x1f, 0xd0, 0xd0, 0xe3, 0xad, 0x5d, 0xc2, 0xb1, 0x8b, 0x39,
+	0x56, 0x55, 0xf9, 0xc4, 0x2a, 0xdf, 0x0b, 0xf8, 0x8e, 0xe7, 0x7b, 0xbc, 0x43, 0xe8, 0x45, 0x62,
+	0x7a, 0x03, 0x87, 0xd8, 0x57, 0xa9, 0x68, 0x06, 0xc0, 0xbd, 0x98, 0xf1, 0x97, 0xe2, 0xd0, 0x26,
+	0x87, 0x4d, 0xc2, 0x38, 0xfa, 0x1a, 0xbc, 0x79, 0xed, 0x94, 0x35, 0x68, 0xc0, 0x08, 0xb4, 0x40,
+	0x5a, 0x16, 0x67, 0xb4, 0xa7, 0x5a, 0xee, 0xe1, 0xc2, 0xf3, 0x42, 0xd2, 0x2c, 0x0b, 0xb2, 0xda,
+	0x7a, 0xe5, 0x2c, 0x32, 0x52, 0xb6, 0xaa, 0x44, 0x3b, 0x00, 0x09, 0xe8, 0x4f, 0x49, 0x40, 0xfd,
+	0xd2, 0x4d, 0xbd, 0x8a, 0x00, 0x9c, 0x03, 0xf7, 0xdd, 0x38, 0x41, 0x34, 0x9a, 0xb4, 0xa6, 0xda,
+	0x91, 0xf1, 0x5a, 0x0b, 0xfb, 0xf5, 0x65, 0x24, 0x8e, 0x91, 0x2d, 0xc3, 0xe8, 0x37, 0x0d, 0xbc,
+	0x97, 0x08, 0xa7, 0x98, 0xff, 0xa0, 0x01, 0xd8, 0x1d, 0xae, 0xe3, 0xab, 0xb0, 0x92, 0xb1, 0x98,
+	0x2c, 0x63, 0x38, 0xb4, 0xf5, 0x2c, 0x96, 0xd5, 0x8e, 0x8c, 0x77, 0x24, 0xaf, 0x41, 0x74, 0x64,
+	0x4f, 0x0f, 0xdc, 0x27, 0xda, 0x05, 0xef, 0xf6, 0xf8, 0xb2, 0xad, 0x90, 0xfa, 0x1b, 0x21, 0xc1,
+	0x9c, 0x86, 0x1d, 0xe5, 0xf3, 0xe0, 0xd5, 0x8a, 0x3c, 0x51, 0xda, 0x61, 0x3b, 0x32, 0xde, 0x90,
+	0x3d, 0x54, 0x00, 0xd9, 0x9d, 0x14, 0xb4, 0x0d, 0xb2, 0xb7, 0xc1, 0x29, 0xe5, 0x2f, 0x40, 0x5a,
+	0x8c, 0x2a, 0xbe, 0xb3, 0x7b, 0xb9, 0x49, 0x6b, 0xba, 0x1d, 0x19, 0xaf, 0xf7, 0x8d, 0x92, 0x21,
+	0x5b, 0x25, 0xa0, 0x6d, 0xf0, 0x4c, 0x80, 0x59, 0x64, 0x9f, 0x86, 0xe4, 0x2b, 0x12, 0xb8, 0x9f,
+	0x53, 0x5a, 0x2b, 0xb9, 0x6e, 0x48, 0x18, 0x1b, 0xf7, 0x66, 0xea, 0x00, 0x25, 0x81, 0x29, 0x76,
+	0x5b, 0x60, 0x2a, 0x5e, 0x9e, 0x63, 0xcc, 0x7c, 0x07, 0xcb, 0x98, 0x02, 0x7e, 0xd2, 0x8e, 0x8c,
+	0xb7, 0x95, 0xec, 0x1b, 0x19, 0xc8, 0x7e, 0xd4, 0x39, 0x52, 0x78, 0x68, 0x13, 0x3c, 0xe9, 0xcd,
+	0x61, 0xb7, 0xbb, 0x0f, 0xe3, 0x92, 0xfe, 0x63, 0x02, 0xcc, 0x0e, 0xc7, 0x51, 0x7c, 0xbf, 0x05,
+	0x0f, 0xe3, 0x6d, 0x73, 0xea, 0xe2, 0x58, 0xbd, 0x9f, 0xfc, 0x08, 0xef, 0xa7, 0x87, 0x65, 0xe9,
+	0xea, 0xe1, 0x40, 0xc9, 0xa0, 0x0f, 0x0f, 0xd9, 0xa0, 0xb7, 0xcb, 0x70, 0x01, 0x4c, 0x36, 0x03,
+	0x11, 0x20, 0x6e, 0x66, 0xe2, 0xa9, 0x96, 0x7b, 0x60, 0xcd, 0xb4, 0x23, 0x63, 0x4a, 0x96, 0x75,
+	0x43, 0xc8, 0xee, 0xa5, 0xc1, 0x1f, 0x35, 0x90, 0x09, 0x89, 0x8f, 0xbd, 0xc0, 0x0b, 0xaa, 0x8e,
+	0x80, 0xc6, 0xf5, 0x3a, 0x3d, 0xc6, 0x41, 0x85, 0x64, 0xee, 0x09, 0xf1, 0x7b, 0x71, 0xfb, 0xbf,
+	0x22, 0x63, 0xae, 0xea, 0xf1, 0x83, 0x66, 0xb9, 0x50, 0xa1, 0xbe, 0xa9, 0x0c, 0x4c, 0xfe, 0xe4,
+	0x99, 0x5b, 0x33, 0x79, 0xab, 0x41, 0x58, 0xe1, 0x8b, 0x80, 0xb7, 0x23, 0xc3, 0x90, 0x1d, 0x6f,
+	0xc3, 0x45, 0xf6, 0xe3, 0x6e, 0x28, 0x96, 0x59, 0xea, 0x04, 0x16, 0x7e, 0x79, 0x00, 0xee, 0x8b,
+	0x71, 0xc2, 0x5f, 0x35, 0x90, 0x96, 0x76, 0x00, 0x3f, 0x48, 0x9e, 0xd6, 0xa0, 0x1b, 0xe9, 0xc5,
+	0x31, 0x2a, 0xe4, 0x3d, 0xa1, 0xf9, 0xef, 0x2f, 0xfe, 0xfd, 0x79, 0x62, 0x0e, 0x3e, 0x37, 0x47,
+	0xb0, 0x42, 0xf8, 0x9f, 0x06, 0x1e, 0x0f, 0xdf, 0x72, 0xb8, 0x3e, 0x42, 0xef, 0x44, 0x2b, 0xd3,
+	0x4b, 0x2f, 0x81, 0xa0, 0xd4, 0x7c, 0x26, 0xd4, 0x94, 0xe0, 0x5a, 0xb2, 0x1a, 0xb9, 0xc6, 0xe6,
+	0x89, 0xf8, 0x3d, 0x35, 0x07, 0x1d, 0x09, 0x5e, 0x68, 0x60, 0x7a, 0xc0, 0x2a, 0xe0, 0xca, 0xa8,
+	0x0c, 0x87, 0xf8, 0x95, 0xfe, 0xc9, 0xdd, 0x8a, 0x95, 0xb2, 0x0d, 0xa1, 0x6c, 0x15, 0xae, 0x8c,
+	0xa2, 0xcc, 0xd9, 0x0f, 0xa9, 0xef, 0x28, 0xeb, 0x33, 0x4f, 0xd4, 0xc7, 0x29, 0xfc, 0x47, 0x03,
+	0x6f, 0x0d, 0xb5, 0x19, 0xb8, 0x36, 0x02, 0xb9, 0x24, 0xb7, 0xd3, 0xd7, 0xef, 0x0e, 0xa0, 0x14,
+	0x6e, 0x0a, 0x85, 0x6b, 0x70, 0x75, 0xac, 0xbb, 0x2b, 0x0b, 0x4c, 0x87, 0x91, 0xc0, 0x75, 0x0e,
+	0x28, 0xad, 0xc1, 0xdf, 0x35, 0xf0, 0xe8, 0x86, 0x91, 0xc0, 0x8f, 0x47, 0x1d, 0xfd, 0x80, 0x21,
+	0xea, 0xcb, 0x77, 0x29, 0x55, 0x8a, 0xd6, 0x85, 0xa2, 0x65, 0xb8, 0x34, 0x96, 0xa2, 0x3e, 0x9b,
+	0xb3, 0xec, 0xb3, 0xcb, 0xac, 0x76, 0x7e, 0x99, 0xd5, 0xfe, 0xbe, 0xcc, 0x6a, 0x3f, 0x5d, 0x65,
+	0x53, 0xe7, 0x57, 0xd9, 0xd4, 0x9f, 0x57, 0xd9, 0xd4, 0x37, 0x4b, 0x7d, 0xa6, 0xa4, 0xd0, 0xf3,
+	0x75, 0x5c, 0x66, 0xdd, 0x56, 0x47, 0xc5, 0x8f, 0xcc, 0xef, 0xae, 0x37, 0x14, 0x56, 0x55, 0x4e,
+	0x8b, 0xff, 0x33, 0x1f, 0xfe, 0x3f, 0x00, 0xab, 0x2b, 0xd4, 0xff, 0x09, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +592,10 @@ type QueryClient interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and mint rate limit of a denom, and how much of it can currently be
+	// minted.
+	DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -519,6 +642,15 @@ func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBefore
 	return out, nil
 }
 
+func (c *queryClient) DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error) {
+	out := new(QueryDenomMintLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomMintLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -533,6 +665,10 @@ type QueryServer interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and mint rate limit of a denom, and how much of it can currently be
+	// minted.
+	DenomMintLimits(context.Context, *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -551,6 +687,9 @@ func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
 }
+func (*UnimplementedQueryServer) DenomMintLimits(ctx context.Context, req *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -628,6 +767,24 @@ func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomMintLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomMintLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintLimits(ctx, req.(*QueryDenomMintLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -648,6 +805,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeforeSendHookAddress",
 			Handler:    _Query_BeforeSendHookAddress_Handler,
 		},
+		{
+			MethodName: "DenomMintLimits",
+			Handler:    _Query_DenomMintLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -894,6 +1055,89 @@ func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomMintLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingMintAllowance.Size()
+		i -= size
+		if _, err := m.RemainingMintAllowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1003,6 +1247,35 @@ func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomMintLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintLimits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	l = m.RemainingMintAllowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1635,6 +1908,225 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomMintLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingMintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
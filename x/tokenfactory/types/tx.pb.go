@@ -6,24 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -731,6 +736,203 @@ func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
 
+// MsgSetMaxSupply is the sdk.Msg type for allowing an admin account to cap
+// the supply of a denom. Once a denom has a max supply, it cannot be removed
+// or increased, only decreased down to the current supply.
+type MsgSetMaxSupply struct {
+	Sender    string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+}
+
+func (m *MsgSetMaxSupply) Reset()         { *m = MsgSetMaxSupply{} }
+func (m *MsgSetMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxSupply) ProtoMessage()    {}
+func (*MsgSetMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
+}
+func (m *MsgSetMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxSupply.Merge(m, src)
+}
+func (m *MsgSetMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxSupply proto.InternalMessageInfo
+
+func (m *MsgSetMaxSupply) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetMaxSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetMaxSupplyResponse defines the response structure for an executed
+// MsgSetMaxSupply message.
+type MsgSetMaxSupplyResponse struct {
+}
+
+func (m *MsgSetMaxSupplyResponse) Reset()         { *m = MsgSetMaxSupplyResponse{} }
+func (m *MsgSetMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxSupplyResponse) ProtoMessage()    {}
+func (*MsgSetMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxSupplyResponse.Merge(m, src)
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxSupplyResponse proto.InternalMessageInfo
+
+// MsgSetMintRateLimit is the sdk.Msg type for allowing an admin account to
+// limit how much of a denom can be minted per period. Once set, the rate limit
+// cannot be removed and can only be changed to a lower or equal amount over a
+// longer or equal period.
+type MsgSetMintRateLimit struct {
+	Sender string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	Period time.Duration                          `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period" yaml:"period"`
+}
+
+func (m *MsgSetMintRateLimit) Reset()         { *m = MsgSetMintRateLimit{} }
+func (m *MsgSetMintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintRateLimit) ProtoMessage()    {}
+func (*MsgSetMintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
+}
+func (m *MsgSetMintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintRateLimit.Merge(m, src)
+}
+func (m *MsgSetMintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetMintRateLimit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetMintRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetMintRateLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// MsgSetMintRateLimitResponse defines the response structure for an executed
+// MsgSetMintRateLimit message.
+type MsgSetMintRateLimitResponse struct {
+}
+
+func (m *MsgSetMintRateLimitResponse) Reset()         { *m = MsgSetMintRateLimitResponse{} }
+func (m *MsgSetMintRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetMintRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetMintRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintRateLimitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -746,6 +948,10 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
 	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
+	proto.RegisterType((*MsgSetMaxSupply)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMaxSupply")
+	proto.RegisterType((*MsgSetMaxSupplyResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMaxSupplyResponse")
+	proto.RegisterType((*MsgSetMintRateLimit)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMintRateLimit")
+	proto.RegisterType((*MsgSetMintRateLimitResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMintRateLimitResponse")
 }
 
 func init() {
@@ -753,63 +959,77 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0x93, 0xd4, 0x75, 0x2e, 0x75, 0x25, 0xd1, 0x6e, 0xa2, 0x30, 0x0e, 0x99, 0x5e, 0x91,
-	0xc0, 0x2d, 0x2a, 0x12, 0x72, 0x8b, 0xfe, 0xd0, 0xd4, 0x28, 0x85, 0x91, 0xa1, 0x5a, 0x18, 0x4f,
-	0x45, 0x00, 0xe1, 0x28, 0x9d, 0x68, 0x42, 0xe1, 0x9d, 0xcb, 0x3b, 0x45, 0xf1, 0x56, 0xa0, 0x5b,
-	0xa7, 0x0e, 0xf9, 0x27, 0xba, 0xf5, 0x2f, 0xe8, 0x9c, 0x31, 0x40, 0x97, 0x4e, 0x84, 0x61, 0x03,
-	0xed, 0xce, 0xbf, 0xa0, 0xb8, 0x1f, 0xa4, 0x44, 0x4a, 0xb0, 0xa5, 0xa1, 0xc8, 0x62, 0x98, 0x77,
-	0xdf, 0xf7, 0xee, 0x7d, 0xdf, 0xbd, 0xf7, 0x4e, 0xe0, 0x21, 0x65, 0x31, 0x65, 0x11, 0xf3, 0x38,
-	0x1d, 0x63, 0x32, 0x42, 0x03, 0x4e, 0x93, 0x53, 0xef, 0x65, 0x3b, 0xc0, 0x1c, 0xb5, 0x3d, 0xfe,
-	0xca, 0x3d, 0x49, 0x28, 0xa7, 0xe6, 0x9e, 0x86, 0xb9, 0xf3, 0x30, 0x57, 0xc3, 0xac, 0xdd, 0x90,
-	0x86, 0x54, 0x02, 0x3d, 0xf1, 0x9f, 0xe2, 0x58, 0x0d, 0x14, 0x47, 0x84, 0x7a, 0xf2, 0xaf, 0x5e,
-	0xb2, 0x07, 0x32, 0x8e, 0x17, 0x20, 0x86, 0x8b, 0x43, 0x06, 0x34, 0x22, 0x0b, 0xfb, 0x64, 0x5c,
-	0xec, 0x8b, 0x0f, 0xb5, 0x0f, 0x5f, 0x1b, 0xe0, 0xc3, 0x1e, 0x0b, 0x9f, 0x24, 0x18, 0x71, 0xfc,
-	0x3d, 0x26, 0x34, 0x36, 0x3f, 0x05, 0x9b, 0x0c, 0x93, 0x21, 0x4e, 0x9a, 0xc6, 0x03, 0x63, 0xff,
-	0x66, 0xb7, 0x91, 0xa5, 0xce, 0xf6, 0x29, 0x8a, 0x5f, 0x74, 0xa0, 0x5a, 0x87, 0xbe, 0x06, 0x98,
-	0x1e, 0xd8, 0x62, 0x93, 0x60, 0x28, 0x68, 0xcd, 0x6b, 0x12, 0xbc, 0x93, 0xa5, 0x4e, 0x4d, 0x83,
-	0xf5, 0x0e, 0xf4, 0x0b, 0x50, 0xe7, 0xd1, 0xaf, 0xff, 0xfe, 0xf1, 0xd9, 0xc7, 0x4b, 0x1d, 0x1a,
-	0xc8, 0x14, 0x5a, 0x8a, 0xf2, 0x1c, 0xdc, 0x2e, 0x67, 0xe5, 0x63, 0x76, 0x42, 0x09, 0xc3, 0x66,
-	0x17, 0xd4, 0x08, 0x9e, 0xf6, 0x25, 0xb5, 0xaf, 0x4e, 0x56, 0x69, 0x5a, 0x59, 0xea, 0xdc, 0x56,
-	0x27, 0x57, 0x00, 0xd0, 0xdf, 0x26, 0x78, 0x7a, 0x24, 0x16, 0x64, 0x2c, 0x78, 0x66, 0x80, 0xf7,
-	0x7b, 0x2c, 0xec, 0x45, 0x84, 0xaf, 0xa3, 0xf6, 0x29, 0xd8, 0x44, 0x31, 0x9d, 0x10, 0x2e, 0xb5,
-	0xde, 0x3a, 0xb8, 0xeb, 0x2a, 0x73, 0x5d, 0x61, 0x7e, 0x7e, 0x75, 0xee, 0x13, 0x1a, 0x91, 0xee,
-	0x47, 0x6f, 0x52, 0x67, 0x63, 0x16, 0x49, 0xd1, 0xa0, 0xaf, 0xf9, 0xe6, 0x77, 0x60, 0x3b, 0x8e,
-	0x08, 0x3f, 0xa2, 0x8f, 0x87, 0xc3, 0x04, 0x33, 0xd6, 0xbc, 0x5e, 0x95, 0x20, 0xb6, 0xfb, 0x9c,
-	0xf6, 0x91, 0x02, 0x40, 0xbf, 0x4c, 0xe8, 0xd8, 0xc2, 0xc8, 0xbb, 0x4b, 0x8d, 0x14, 0x40, 0xd8,
-	0x00, 0x35, 0xad, 0x30, 0x77, 0x0e, 0xfe, 0xa3, 0x54, 0x77, 0x27, 0x09, 0x79, 0x37, 0xaa, 0x0f,
-	0x41, 0x2d, 0x98, 0x24, 0xe4, 0x30, 0xa1, 0x71, 0x59, 0xf7, 0x5e, 0x96, 0x3a, 0x4d, 0xc5, 0x11,
-	0x80, 0xfe, 0x28, 0xa1, 0xf1, 0x4c, 0x79, 0x95, 0x74, 0x99, 0x76, 0x01, 0xd5, 0xda, 0x85, 0xce,
-	0x42, 0xfb, 0x9f, 0xba, 0xcc, 0x8f, 0x11, 0x09, 0xf1, 0xe3, 0x61, 0x1c, 0xad, 0x65, 0xc1, 0x23,
-	0xf0, 0xde, 0x7c, 0x8d, 0xd7, 0xb3, 0xd4, 0xf9, 0x40, 0x21, 0x75, 0x7d, 0xa9, 0x6d, 0xb3, 0x0d,
-	0x6e, 0x8a, 0xd2, 0x43, 0x22, 0xbe, 0x96, 0xb6, 0x9b, 0xa5, 0x4e, 0x7d, 0x56, 0x95, 0x72, 0x0b,
-	0xfa, 0x5b, 0x04, 0x4f, 0x65, 0x16, 0x97, 0x36, 0x84, 0x4c, 0xb6, 0xa5, 0x28, 0x4d, 0xd5, 0x10,
-	0xb3, 0xfc, 0x0b, 0x69, 0x67, 0x06, 0xd8, 0xed, 0xb1, 0xf0, 0x19, 0xe6, 0x5d, 0x3c, 0xa2, 0x09,
-	0x7e, 0x86, 0xc9, 0xf0, 0x29, 0xa5, 0xe3, 0xff, 0x43, 0xe0, 0x21, 0xa8, 0x8b, 0xcb, 0x9f, 0x22,
-	0x56, 0xdc, 0x8f, 0xd6, 0x79, 0x2f, 0x4b, 0x9d, 0x3b, 0x8a, 0x52, 0x45, 0x40, 0xbf, 0x96, 0x2f,
-	0xe5, 0x37, 0xd8, 0x12, 0xaa, 0xf7, 0x97, 0xaa, 0x66, 0x98, 0xb7, 0x02, 0x29, 0x44, 0xe4, 0xd6,
-	0x3a, 0xa6, 0x74, 0x0c, 0x6d, 0xb0, 0xb7, 0x4c, 0x61, 0x61, 0xc1, 0x6b, 0x03, 0xec, 0x28, 0x80,
-	0xec, 0xef, 0x1e, 0xe6, 0x68, 0x88, 0x38, 0x5a, 0xc7, 0x01, 0x1f, 0x6c, 0xc5, 0x9a, 0xa6, 0xeb,
-	0xfc, 0xfe, 0xac, 0xce, 0xc9, 0xb8, 0xa8, 0xf3, 0x3c, 0x76, 0xf7, 0x8e, 0xae, 0x75, 0x3d, 0xec,
-	0x72, 0x32, 0xf4, 0x8b, 0x38, 0xf0, 0x3e, 0xb8, 0xb7, 0x24, 0xab, 0x22, 0xeb, 0xbf, 0xae, 0x81,
-	0x7a, 0x8f, 0x85, 0x87, 0x34, 0x19, 0xe0, 0xa3, 0x04, 0x11, 0x36, 0xc2, 0xc9, 0xbb, 0x69, 0x4c,
-	0x1f, 0xec, 0x70, 0x9d, 0xc0, 0x62, 0x73, 0x3e, 0xc8, 0x52, 0x67, 0x4f, 0xf1, 0x72, 0x50, 0xa5,
-	0x41, 0x97, 0x91, 0xcd, 0x1f, 0x40, 0x23, 0x5f, 0x9e, 0x8d, 0xb9, 0x1b, 0x32, 0xa2, 0x9d, 0xa5,
-	0x8e, 0x55, 0x89, 0x38, 0x3f, 0xea, 0x16, 0x89, 0x9d, 0x7d, 0x51, 0x30, 0x9f, 0x2c, 0x2d, 0x98,
-	0x91, 0xf0, 0xaf, 0x95, 0x53, 0xa0, 0x05, 0x9a, 0x55, 0x53, 0x73, 0xc7, 0x0f, 0x7e, 0xdf, 0x04,
-	0xd7, 0x7b, 0x2c, 0x34, 0x7f, 0x02, 0xb7, 0xe6, 0x1f, 0xbc, 0xcf, 0xdd, 0xcb, 0xde, 0x62, 0xb7,
-	0xfc, 0x10, 0x59, 0x5f, 0xae, 0x83, 0x2e, 0x9e, 0xad, 0xe7, 0xe0, 0x86, 0x7c, 0x6e, 0x1e, 0x5e,
-	0xc9, 0x16, 0x30, 0xab, 0xb5, 0x12, 0x6c, 0x3e, 0xba, 0x1c, 0xeb, 0x57, 0x47, 0x17, 0xb0, 0x15,
-	0xa2, 0xcf, 0x0f, 0x4f, 0x69, 0xd7, 0xdc, 0xe0, 0x5c, 0xc1, 0xae, 0x19, 0x7a, 0x15, 0xbb, 0x16,
-	0x87, 0x9a, 0xf9, 0xb3, 0x01, 0xea, 0x0b, 0xed, 0xdc, 0xbe, 0x32, 0x54, 0x95, 0x62, 0x7d, 0xbb,
-	0x36, 0xa5, 0x48, 0xe1, 0x17, 0x03, 0x34, 0x16, 0x87, 0xea, 0xc1, 0x2a, 0x01, 0xcb, 0x1c, 0xab,
-	0xb3, 0x3e, 0xa7, 0xc8, 0x62, 0x0a, 0xb6, 0xcb, 0x03, 0xc2, 0xbd, 0x32, 0x58, 0x09, 0x6f, 0x7d,
-	0xb5, 0x1e, 0x3e, 0x3f, 0xb8, 0xeb, 0xbf, 0x39, 0xb7, 0x8d, 0xb7, 0xe7, 0xb6, 0x71, 0x76, 0x6e,
-	0x1b, 0xbf, 0x5d, 0xd8, 0x1b, 0x6f, 0x2f, 0xec, 0x8d, 0xbf, 0x2f, 0xec, 0x8d, 0x1f, 0xbf, 0x09,
-	0x23, 0x7e, 0x3c, 0x09, 0xdc, 0x01, 0x8d, 0x3d, 0x1d, 0xbb, 0xf5, 0x02, 0x05, 0x2c, 0xff, 0xf0,
-	0x5e, 0xb6, 0xbf, 0xf6, 0x5e, 0x95, 0x9b, 0x94, 0x9f, 0x9e, 0x60, 0x16, 0x6c, 0xca, 0xdf, 0x9c,
-	0x5f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0xba, 0xea, 0x73, 0xcf, 0x23, 0x0b, 0x00, 0x00,
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0x13, 0x4a, 0x61, 0x12, 0x0a, 0x36, 0x34, 0x98, 0x0d, 0xf1, 0xa6, 0x5b, 0x05, 0xd1,
+	0xaa, 0xbb, 0x2b, 0x68, 0xfa, 0x23, 0x5c, 0xda, 0x98, 0x08, 0xa5, 0x12, 0xbe, 0x2c, 0x48, 0x95,
+	0xaa, 0x48, 0xd6, 0xd8, 0x1e, 0x2f, 0x2b, 0xb3, 0x33, 0xee, 0xce, 0x38, 0xc0, 0xad, 0x52, 0x6f,
+	0x3d, 0xf5, 0x90, 0x43, 0xcf, 0xfd, 0x0b, 0xfa, 0x17, 0xf4, 0x9c, 0x63, 0xa4, 0x5e, 0xaa, 0x1e,
+	0xb6, 0x08, 0xa4, 0xf6, 0xbe, 0x7f, 0x41, 0x35, 0x3f, 0x76, 0xec, 0x35, 0x2e, 0x78, 0x0f, 0x28,
+	0x97, 0x04, 0xef, 0x7c, 0xdf, 0x9b, 0xf7, 0x7d, 0xf3, 0xde, 0x9b, 0x01, 0x8f, 0x08, 0x8d, 0x08,
+	0x0d, 0xa9, 0xc7, 0x48, 0x17, 0xe1, 0x0e, 0x6c, 0x31, 0x12, 0x9f, 0x7a, 0x2f, 0x37, 0x9b, 0x88,
+	0xc1, 0x4d, 0x8f, 0x9d, 0xb8, 0xbd, 0x98, 0x30, 0x52, 0x5e, 0x53, 0x30, 0x77, 0x18, 0xe6, 0x2a,
+	0x98, 0xb9, 0x1c, 0x90, 0x80, 0x08, 0xa0, 0xc7, 0xff, 0x92, 0x1c, 0xb3, 0x04, 0xa3, 0x10, 0x13,
+	0x4f, 0xfc, 0xab, 0x3e, 0x55, 0x5b, 0x22, 0x8e, 0xd7, 0x84, 0x14, 0xe9, 0x4d, 0x5a, 0x24, 0xc4,
+	0x97, 0xd6, 0x71, 0x57, 0xaf, 0xf3, 0x1f, 0xd9, 0x7a, 0x40, 0x48, 0x70, 0x84, 0x3c, 0xf1, 0xab,
+	0xd9, 0xef, 0x78, 0xed, 0x7e, 0x0c, 0x59, 0x48, 0x14, 0xdf, 0x7e, 0x65, 0x80, 0xf7, 0xea, 0x34,
+	0xd8, 0x89, 0x11, 0x64, 0xe8, 0x19, 0xc2, 0x24, 0x2a, 0x7f, 0x04, 0x66, 0x28, 0xc2, 0x6d, 0x14,
+	0x57, 0x8c, 0x87, 0xc6, 0xc6, 0x5c, 0xad, 0x94, 0x26, 0xd6, 0xfc, 0x29, 0x8c, 0x8e, 0xb6, 0x6d,
+	0xf9, 0xdd, 0xf6, 0x15, 0xa0, 0xec, 0x81, 0x59, 0xda, 0x6f, 0xb6, 0x39, 0xad, 0x72, 0x4b, 0x80,
+	0x97, 0xd2, 0xc4, 0x5a, 0x50, 0x60, 0xb5, 0x62, 0xfb, 0x1a, 0xb4, 0xbd, 0xfe, 0xd3, 0xbf, 0xbf,
+	0x7d, 0xfc, 0xc1, 0x58, 0x07, 0x5b, 0x22, 0x05, 0x47, 0x52, 0x5e, 0x80, 0x7b, 0xf9, 0xac, 0x7c,
+	0x44, 0x7b, 0x04, 0x53, 0x54, 0xae, 0x81, 0x05, 0x8c, 0x8e, 0x1b, 0x82, 0xda, 0x90, 0x3b, 0xcb,
+	0x34, 0xcd, 0x34, 0xb1, 0xee, 0xc9, 0x9d, 0x47, 0x00, 0xb6, 0x3f, 0x8f, 0xd1, 0xf1, 0x01, 0xff,
+	0x20, 0x62, 0xd9, 0x67, 0x06, 0x78, 0xb7, 0x4e, 0x83, 0x7a, 0x88, 0x59, 0x11, 0xb5, 0xcf, 0xc1,
+	0x0c, 0x8c, 0x48, 0x1f, 0x33, 0xa1, 0xf5, 0xce, 0xd6, 0xaa, 0x2b, 0xcd, 0x77, 0xf9, 0xe1, 0x64,
+	0x47, 0xeb, 0xee, 0x90, 0x10, 0xd7, 0xde, 0x7f, 0x9d, 0x58, 0x53, 0x83, 0x48, 0x92, 0x66, 0xfb,
+	0x8a, 0x5f, 0xfe, 0x1a, 0xcc, 0x47, 0x21, 0x66, 0x07, 0xe4, 0x69, 0xbb, 0x1d, 0x23, 0x4a, 0x2b,
+	0xb7, 0x47, 0x25, 0xf0, 0xe5, 0x06, 0x23, 0x0d, 0x28, 0x01, 0xb6, 0x9f, 0x27, 0x6c, 0x57, 0xb9,
+	0x91, 0xab, 0x63, 0x8d, 0xe4, 0x40, 0xbb, 0x04, 0x16, 0x94, 0xc2, 0xcc, 0x39, 0xfb, 0x1f, 0xa9,
+	0xba, 0xd6, 0x8f, 0xf1, 0xdb, 0x51, 0xbd, 0x0b, 0x16, 0x9a, 0xfd, 0x18, 0xef, 0xc6, 0x24, 0xca,
+	0xeb, 0x5e, 0x4b, 0x13, 0xab, 0x22, 0x39, 0x1c, 0xd0, 0xe8, 0xc4, 0x24, 0x1a, 0x28, 0x1f, 0x25,
+	0x5d, 0xa5, 0x9d, 0x43, 0x95, 0x76, 0xae, 0x53, 0x6b, 0xff, 0x5d, 0x95, 0xf9, 0x21, 0xc4, 0x01,
+	0x7a, 0xda, 0x8e, 0xc2, 0x42, 0x16, 0xac, 0x83, 0x77, 0x86, 0x6b, 0x7c, 0x31, 0x4d, 0xac, 0xbb,
+	0x12, 0xa9, 0xea, 0x4b, 0x2e, 0x97, 0x37, 0xc1, 0x1c, 0x2f, 0x3d, 0xc8, 0xe3, 0x2b, 0x69, 0xcb,
+	0x69, 0x62, 0x2d, 0x0e, 0xaa, 0x52, 0x2c, 0xd9, 0xfe, 0x2c, 0x46, 0xc7, 0x22, 0x8b, 0x2b, 0x1b,
+	0x42, 0x24, 0xeb, 0x48, 0x4a, 0x45, 0x36, 0xc4, 0x20, 0x7f, 0x2d, 0xed, 0xcc, 0x00, 0xcb, 0x75,
+	0x1a, 0xec, 0x23, 0x56, 0x43, 0x1d, 0x12, 0xa3, 0x7d, 0x84, 0xdb, 0xcf, 0x09, 0xe9, 0xde, 0x84,
+	0xc0, 0x5d, 0xb0, 0xc8, 0x0f, 0xff, 0x18, 0x52, 0x7d, 0x3e, 0x4a, 0xe7, 0xfd, 0x34, 0xb1, 0x56,
+	0x24, 0x65, 0x14, 0x61, 0xfb, 0x0b, 0xd9, 0xa7, 0xec, 0x04, 0x1d, 0xae, 0x7a, 0x63, 0xac, 0x6a,
+	0x8a, 0x98, 0xd3, 0x14, 0x42, 0x78, 0x6e, 0xce, 0x21, 0x21, 0x5d, 0xbb, 0x0a, 0xd6, 0xc6, 0x29,
+	0xd4, 0x16, 0xbc, 0x32, 0xc0, 0x92, 0x04, 0x88, 0xfe, 0xae, 0x23, 0x06, 0xdb, 0x90, 0xc1, 0x22,
+	0x0e, 0xf8, 0x60, 0x36, 0x52, 0x34, 0x55, 0xe7, 0x0f, 0x06, 0x75, 0x8e, 0xbb, 0xba, 0xce, 0xb3,
+	0xd8, 0xb5, 0x15, 0x55, 0xeb, 0x6a, 0xd8, 0x65, 0x64, 0xdb, 0xd7, 0x71, 0xec, 0x07, 0xe0, 0xfe,
+	0x98, 0xac, 0x74, 0xd6, 0x7f, 0xdc, 0x02, 0x8b, 0x75, 0x1a, 0xec, 0x92, 0xb8, 0x85, 0x0e, 0x62,
+	0x88, 0x69, 0x07, 0xc5, 0x6f, 0xa7, 0x31, 0x7d, 0xb0, 0xc4, 0x54, 0x02, 0x97, 0x9b, 0xf3, 0x61,
+	0x9a, 0x58, 0x6b, 0x92, 0x97, 0x81, 0x46, 0x1a, 0x74, 0x1c, 0xb9, 0xbc, 0x07, 0x4a, 0xd9, 0xe7,
+	0xc1, 0x98, 0x9b, 0x16, 0x11, 0xab, 0x69, 0x62, 0x99, 0x23, 0x11, 0x87, 0x47, 0xdd, 0x65, 0xe2,
+	0xf6, 0x06, 0x2f, 0x98, 0x0f, 0xc7, 0x16, 0x4c, 0x87, 0xfb, 0xe7, 0x64, 0x14, 0xdb, 0x04, 0x95,
+	0x51, 0x53, 0xb5, 0xe3, 0xa9, 0x21, 0x26, 0xc3, 0x3e, 0x62, 0x75, 0x78, 0xb2, 0xdf, 0xef, 0xf5,
+	0x8e, 0x4e, 0x6f, 0xa2, 0x4b, 0x9a, 0x00, 0x44, 0xf0, 0xa4, 0x41, 0xc5, 0x06, 0xca, 0xc5, 0x1d,
+	0x7e, 0x02, 0x7f, 0x25, 0xd6, 0x7a, 0x10, 0xb2, 0xc3, 0x7e, 0xd3, 0x6d, 0x91, 0xc8, 0x53, 0x57,
+	0xb7, 0xfc, 0xcf, 0xa1, 0xed, 0xae, 0xc7, 0x4e, 0x7b, 0x88, 0xba, 0xdf, 0x60, 0x96, 0x26, 0x56,
+	0x49, 0x15, 0x96, 0x8e, 0x64, 0xfb, 0x73, 0x51, 0x96, 0xf6, 0x55, 0x86, 0xf0, 0x0e, 0x8a, 0xe0,
+	0x89, 0xa3, 0x58, 0xab, 0x60, 0x65, 0x44, 0xf3, 0x60, 0x2a, 0xde, 0xca, 0xfa, 0x46, 0x5c, 0x14,
+	0x90, 0xa1, 0xbd, 0x30, 0x0a, 0xd9, 0x4d, 0x78, 0xf2, 0xad, 0x2e, 0x56, 0xe9, 0xc7, 0x57, 0x85,
+	0xfd, 0xf8, 0x9f, 0xda, 0xdd, 0x03, 0x33, 0x3d, 0x14, 0x87, 0xa4, 0x5d, 0x99, 0x56, 0x5d, 0x20,
+	0x5f, 0x3c, 0x6e, 0xf6, 0xe2, 0x71, 0x9f, 0xa9, 0x17, 0x4f, 0x6d, 0x35, 0xdf, 0x05, 0x92, 0x66,
+	0xff, 0xf2, 0xb7, 0x65, 0xf8, 0x2a, 0xc6, 0x75, 0x83, 0x89, 0x5f, 0xad, 0x4e, 0xcc, 0x9f, 0x29,
+	0x47, 0xdc, 0xa8, 0x41, 0x87, 0xe7, 0xfc, 0xcb, 0xfc, 0xdd, 0xfa, 0x75, 0x16, 0xdc, 0xae, 0xd3,
+	0xa0, 0xfc, 0x3d, 0xb8, 0x33, 0xfc, 0xc0, 0xfa, 0xc4, 0xbd, 0xea, 0x6d, 0xe8, 0xe6, 0x1f, 0x3e,
+	0xe6, 0xe3, 0x22, 0x68, 0xfd, 0x4c, 0x7a, 0x01, 0xa6, 0xc5, 0xf3, 0xe6, 0xd1, 0xb5, 0x6c, 0x0e,
+	0x33, 0x9d, 0x89, 0x60, 0xc3, 0xd1, 0xc5, 0x33, 0xe2, 0xfa, 0xe8, 0x1c, 0x66, 0x3a, 0x13, 0xc1,
+	0x74, 0x74, 0x6e, 0xd7, 0xd0, 0x45, 0x3d, 0x81, 0x5d, 0x03, 0xb4, 0xf9, 0xb8, 0x08, 0x5a, 0x6f,
+	0xf9, 0x83, 0x01, 0x16, 0x2f, 0x5d, 0x1f, 0x9b, 0xd7, 0x86, 0x1a, 0xa5, 0x98, 0x4f, 0x0a, 0x53,
+	0x74, 0x0a, 0x3f, 0x1a, 0xa0, 0x74, 0xf9, 0x12, 0xdf, 0x9a, 0x24, 0x60, 0x9e, 0x63, 0x6e, 0x17,
+	0xe7, 0xe8, 0x2c, 0x8e, 0xc1, 0x7c, 0xfe, 0x42, 0x72, 0xaf, 0x0d, 0x96, 0xc3, 0x9b, 0x9f, 0x17,
+	0xc3, 0xeb, 0x8d, 0x19, 0xb8, 0x9b, 0x9b, 0xcb, 0xce, 0x24, 0x22, 0x34, 0xdc, 0xfc, 0xac, 0x10,
+	0x7c, 0xf4, 0xdc, 0xf3, 0xe3, 0x6f, 0xa2, 0x73, 0xcf, 0x51, 0xcc, 0x27, 0x85, 0x29, 0x59, 0x0a,
+	0x35, 0xff, 0xf5, 0x79, 0xd5, 0x78, 0x73, 0x5e, 0x35, 0xce, 0xce, 0xab, 0xc6, 0xcf, 0x17, 0xd5,
+	0xa9, 0x37, 0x17, 0xd5, 0xa9, 0x3f, 0x2f, 0xaa, 0x53, 0xdf, 0x7d, 0x39, 0x34, 0x1b, 0x55, 0x78,
+	0xe7, 0x08, 0x36, 0x69, 0xf6, 0xc3, 0x7b, 0xb9, 0xf9, 0x85, 0x77, 0x92, 0x9f, 0x52, 0x62, 0x62,
+	0x36, 0x67, 0xc4, 0xf0, 0xfb, 0xf4, 0xbf, 0x01, 0x00, 0xac, 0x82, 0xe2, 0xca, 0xac, 0x0e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -831,6 +1051,8 @@ type MsgClient interface {
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error)
+	SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error)
 }
 
 type msgClient struct {
@@ -904,6 +1126,24 @@ func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opt
 	return out, nil
 }
 
+func (c *msgClient) SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error) {
+	out := new(MsgSetMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error) {
+	out := new(MsgSetMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetMintRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -913,6 +1153,8 @@ type MsgServer interface {
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	SetMaxSupply(context.Context, *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error)
+	SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -940,6 +1182,12 @@ func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
 }
+func (*UnimplementedMsgServer) SetMaxSupply(ctx context.Context, req *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxSupply not implemented")
+}
+func (*UnimplementedMsgServer) SetMintRateLimit(ctx context.Context, req *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintRateLimit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1071,6 +1319,42 @@ func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxSupply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetMaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxSupply(ctx, req.(*MsgSetMaxSupply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetMintRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintRateLimit(ctx, req.(*MsgSetMintRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1103,6 +1387,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransfer",
 			Handler:    _Msg_ForceTransfer_Handler,
 		},
+		{
+			MethodName: "SetMaxSupply",
+			Handler:    _Msg_SetMaxSupply_Handler,
+		},
+		{
+			MethodName: "SetMintRateLimit",
+			Handler:    _Msg_SetMintRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
@@ -1589,79 +1881,227 @@ func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.MintToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.MintToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Sender)
@@ -1802,6 +2242,64 @@ func (m *MsgForceTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMintRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2069,7 +2567,401 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2079,28 +2971,27 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2128,7 +3019,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2151,7 +3042,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2174,10 +3065,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2201,7 +3092,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2224,10 +3115,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2264,9 +3155,9 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2276,28 +3167,27 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2325,7 +3215,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2348,7 +3238,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2371,10 +3261,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2398,7 +3288,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2421,10 +3311,10 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2461,9 +3351,9 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2473,55 +3363,24 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2544,7 +3403,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2567,10 +3426,10 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2594,7 +3453,7 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,10 +3476,10 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2657,7 +3516,40 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2685,11 +3577,11 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2717,7 +3609,7 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2740,7 +3632,7 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2763,10 +3655,10 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2790,7 +3682,7 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2813,10 +3705,10 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2853,9 +3745,9 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2865,22 +3757,55 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2905,7 +3830,7 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2928,10 +3853,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2955,7 +3880,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2978,10 +3903,10 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3018,9 +3943,9 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3030,28 +3955,27 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3079,13 +4003,15 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3095,23 +4021,24 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3134,7 +4061,7 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3157,10 +4084,10 @@ func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
 )
 
@@ -78,8 +79,122 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetGeometricTwapOverRoute returns the geometric twap of the base asset from (startTime, endTime),
+// in units of the quote asset of the last hop of the given route of pools.
+// The base asset of every hop is the quote asset of the previous hop, or baseAssetDenom for the first hop.
+//
+// Since the geometric mean of a product of prices is the product of their geometric means, the geometric
+// twap over the route is the product of the geometric twaps of its hops over the same time range.
+// The geometric twap of a hop whose price was exactly one over the whole time range is zero, so its arithmetic twap is used instead.
+//
+// This function will error if:
+// * the route is empty
+// * the geometric twap of any hop errors, see GetGeometricTwap
+// If there were spot price errors within the time range of any hop, the twap is returned along with the first one.
+func (k Keeper) GetGeometricTwapOverRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []types.TwapRoute,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if len(routes) == 0 {
+		return sdk.Dec{}, types.EmptyTwapRouteError{}
+	}
+
+	twap := osmomath.OneDec()
+	var spotPriceErr error
+	hopBaseAssetDenom := baseAssetDenom
+	for _, route := range routes {
+		hopTwap, err := k.GetGeometricTwap(ctx, route.PoolId, hopBaseAssetDenom, route.QuoteAsset, startTime, endTime)
+		if hopTwap.IsNil() {
+			return sdk.Dec{}, err
+		}
+		// The geometric twap is zero when the geometric accumulator did not change over the time range, which happens
+		// when the price was exactly one over the whole range. The arithmetic twap is well defined in that case.
+		if hopTwap.IsZero() {
+			hopTwap, err = k.GetArithmeticTwap(ctx, route.PoolId, hopBaseAssetDenom, route.QuoteAsset, startTime, endTime)
+			if hopTwap.IsNil() {
+				return sdk.Dec{}, err
+			}
+		}
+		if err != nil && spotPriceErr == nil {
+			spotPriceErr = err
+		}
+		// The product is accumulated with BigDec precision so that chaining small prices does not truncate to zero.
+		twap = twap.Mul(osmomath.BigDecFromSDKDec(hopTwap))
+		hopBaseAssetDenom = route.QuoteAsset
+	}
+	return twap.SDKDec(), spotPriceErr
+}
+
+// GetLogPriceVariance returns the time weighted variance of the natural logarithm of the spot price
+// of the base asset, in units of the quote asset, from (startTime, endTime),
+// as determined by prices from AMM pool `poolId`.
+// It measures the realized volatility of the price over the time range,
+// and does not depend on which of the assets is the quote asset.
+//
+// The time range has the same constraints, and the function errors in the same cases, as GetArithmeticTwap.
+func (k Keeper) GetLogPriceVariance(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVarianceStrategy())
+}
+
+// GetLogPriceVarianceToNow returns the time weighted variance of the natural logarithm of the spot price
+// from start time until the current block time for quote and base assets in a given pool.
+func (k Keeper) GetLogPriceVarianceToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetVarianceStrategy())
+}
+
+// GetLogPriceStdDev returns the time weighted standard deviation of the natural logarithm of the spot price,
+// the square root of GetLogPriceVariance over the same time range.
+func (k Keeper) GetLogPriceStdDev(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return stdDevFromVariance(k.GetLogPriceVariance(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime))
+}
+
+// GetLogPriceStdDevToNow returns the time weighted standard deviation of the natural logarithm of the spot price
+// from start time until the current block time, the square root of GetLogPriceVarianceToNow.
+func (k Keeper) GetLogPriceStdDevToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return stdDevFromVariance(k.GetLogPriceVarianceToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime))
+}
+
+// stdDevFromVariance returns the square root of the given variance, along with the error it was computed with.
+// A variance is returned together with an error if there was a spot price error within its time range,
+// in which case its square root is still returned.
+func stdDevFromVariance(variance sdk.Dec, err error) (sdk.Dec, error) {
+	if variance.IsNil() {
+		return sdk.Dec{}, err
+	}
+	return osmomath.MustMonotonicSqrt(variance), err
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or variance.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -110,7 +225,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or variance.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
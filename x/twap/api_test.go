@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	sdkrand "github.com/osmosis-labs/osmosis/v17/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v17/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v17/x/twap"
@@ -112,7 +113,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec, sdk.ZeroDec()), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			withSecondMomentAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), secondMomentTenSecAccum),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -905,3 +906,178 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetLogPriceVariance() {
+	// The spot price of asset 0 is 2 for 10 seconds and 8 afterwards.
+	sp2Record := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	sp8Record := recordWithUpdatedSpotPrice(twap.RecordWithUpdatedAccumulators(sp2Record, baseTime.Add(10*time.Second)), sdk.NewDec(8), sdk.OneDec().QuoInt64(8))
+	ctxTime := baseTime.Add(20 * time.Second)
+
+	tests := map[string]struct {
+		input          getTwapInput
+		expVariance    sdk.Dec
+		expectedErrStr string
+	}{
+		"price of 2 only: zero variance": {
+			input:       makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expVariance: sdk.ZeroDec(),
+		},
+		// log_{2}{P} is 1 for 10s and 3 for 10s, Var(log_{2}{P}) = 1
+		"price of 2 then 8 for the same time": {
+			input:       makeSimpleTwapInput(baseTime, ctxTime, baseQuoteBA),
+			expVariance: ln2Squared,
+		},
+		"price of 2 then 8 for the same time, other quote asset": {
+			input:       makeSimpleTwapInput(baseTime, ctxTime, baseQuoteAB),
+			expVariance: ln2Squared,
+		},
+		// log_{2}{P} is 1 for 5s and 3 for 10s, Var(log_{2}{P}) = 19/3 - (7/3)^2 = 8/9
+		"price of 2 then 8 for twice the time": {
+			input:       makeSimpleTwapInput(baseTime.Add(5*time.Second), ctxTime, baseQuoteBA),
+			expVariance: ln2Squared.MulInt64(8).QuoInt64(9),
+		},
+		"same start and end time: zero variance": {
+			input:       makeSimpleTwapInput(ctxTime, ctxTime, baseQuoteBA),
+			expVariance: sdk.ZeroDec(),
+		},
+		"start time after end time": {
+			input:          makeSimpleTwapInput(ctxTime, baseTime, baseQuoteBA),
+			expectedErrStr: types.StartTimeAfterEndTimeError{StartTime: ctxTime, EndTime: baseTime}.Error(),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{sp2Record, sp8Record})
+			s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+			variance, err := s.twapkeeper.GetLogPriceVariance(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+			stdDev, stdDevErr := s.twapkeeper.GetLogPriceStdDev(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectedErrStr != "" {
+				s.Require().EqualError(err, test.expectedErrStr)
+				s.Require().EqualError(stdDevErr, test.expectedErrStr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(stdDevErr)
+			osmoassert.DecApproxEq(s.T(), test.expVariance, variance, sdk.MustNewDecFromStr("0.000000000000001"))
+			osmoassert.DecApproxEq(s.T(), osmomath.MustMonotonicSqrt(variance), stdDev, sdk.ZeroDec())
+
+			// The to now variants return the same results when the end time is the block time.
+			if test.input.endTime.Equal(ctxTime) {
+				varianceToNow, err := s.twapkeeper.GetLogPriceVarianceToNow(s.Ctx, test.input.poolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(variance, varianceToNow)
+
+				stdDevToNow, err := s.twapkeeper.GetLogPriceStdDevToNow(s.Ctx, test.input.poolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom, test.input.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(stdDev, stdDevToNow)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetGeometricTwapOverRoute() {
+	// The spot price of token/A is 2 token/B, and the spot price of token/B is 4 token/C.
+	abRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	bcRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	bcRecord = withPoolId(bcRecord, 2)
+	bcRecord.Asset0Denom, bcRecord.Asset1Denom = denom1, denom2
+	// The spot price of token/C is constantly 1 token/A, so the geometric accumulator of the pool does not change.
+	acRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	acRecord = withPoolId(acRecord, 3)
+	acRecord.Asset0Denom, acRecord.Asset1Denom = denom0, denom2
+	ctxTime := baseTime.Add(20 * time.Second)
+
+	tests := map[string]struct {
+		baseAssetDenom string
+		routes         []types.TwapRoute
+		startTime      time.Time
+		expTwap        sdk.Dec
+		expectedErrStr string
+		expectErr      bool
+	}{
+		"single hop: same as the geometric twap of the pool": {
+			baseAssetDenom: denom1,
+			routes:         []types.TwapRoute{{PoolId: 1, QuoteAsset: denom0}},
+			startTime:      baseTime,
+			expTwap:        sdk.NewDec(2),
+		},
+		"two hops: token/C in units of token/A": {
+			baseAssetDenom: denom2,
+			routes:         []types.TwapRoute{{PoolId: 2, QuoteAsset: denom1}, {PoolId: 1, QuoteAsset: denom0}},
+			startTime:      baseTime,
+			expTwap:        sdk.NewDec(8),
+		},
+		"two hops: token/A in units of token/C": {
+			baseAssetDenom: denom0,
+			routes:         []types.TwapRoute{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 2, QuoteAsset: denom2}},
+			startTime:      baseTime,
+			expTwap:        sdk.OneDec().QuoInt64(8),
+		},
+		"round trip": {
+			baseAssetDenom: denom0,
+			routes:         []types.TwapRoute{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 2, QuoteAsset: denom2}, {PoolId: 2, QuoteAsset: denom1}, {PoolId: 1, QuoteAsset: denom0}},
+			startTime:      baseTime,
+			expTwap:        sdk.OneDec(),
+		},
+		"hop with a constant price of one: uses the arithmetic twap of the hop": {
+			baseAssetDenom: denom1,
+			routes:         []types.TwapRoute{{PoolId: 2, QuoteAsset: denom2}, {PoolId: 3, QuoteAsset: denom0}},
+			startTime:      baseTime,
+			expTwap:        sdk.OneDec().QuoInt64(4),
+		},
+		"empty route": {
+			baseAssetDenom: denom0,
+			routes:         []types.TwapRoute{},
+			startTime:      baseTime,
+			expectedErrStr: types.EmptyTwapRouteError{}.Error(),
+		},
+		"hop quote asset not in pool": {
+			baseAssetDenom: denom0,
+			routes:         []types.TwapRoute{{PoolId: 1, QuoteAsset: denom1}, {PoolId: 1, QuoteAsset: denom2}},
+			startTime:      baseTime,
+			expectErr:      true,
+		},
+		"start time after end time": {
+			baseAssetDenom: denom0,
+			routes:         []types.TwapRoute{{PoolId: 1, QuoteAsset: denom1}},
+			startTime:      ctxTime.Add(time.Second),
+			expectedErrStr: types.StartTimeAfterEndTimeError{StartTime: ctxTime.Add(time.Second), EndTime: ctxTime}.Error(),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{abRecord, bcRecord, acRecord})
+			s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+			twap, err := s.twapkeeper.GetGeometricTwapOverRoute(s.Ctx, test.baseAssetDenom, test.routes, test.startTime, ctxTime)
+
+			if test.expectedErrStr != "" {
+				s.Require().EqualError(err, test.expectedErrStr)
+				return
+			}
+			if test.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twap, sdk.MustNewDecFromStr("0.000000000000001"))
+
+			// A single hop route returns the geometric twap of its pool.
+			if len(test.routes) == 1 {
+				poolTwap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, test.routes[0].PoolId, test.baseAssetDenom, test.routes[0].QuoteAsset, test.startTime, ctxTime)
+				s.Require().NoError(err)
+				s.Require().Equal(poolTwap, twap)
+			}
+		})
+	}
+}
@@ -21,6 +21,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryGeometricOverRouteCommand())
+	cmd.AddCommand(GetQueryLogPriceVarianceCommand())
+	cmd.AddCommand(GetQueryLogPriceStdDevCommand())
 
 	return cmd
 }
@@ -125,6 +128,155 @@ Example:
 	return cmd
 }
 
+// GetQueryGeometricOverRouteCommand returns a geometric twap over a route of pools query command.
+func GetQueryGeometricOverRouteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometric-over-route [base denom] [pool ids] [quote denoms] [start time] [end time]",
+		Short: "Query geometric twap over a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap over a route of pools. The base denom of every hop is the quote denom of the previous hop.
+Pool ids and quote denoms are comma separated, one per hop. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} geometric-over-route uatom 1,678 uosmo,uusdc 1667088000 24h
+{{.CommandPrefix}} geometric-over-route uatom 1,678 uosmo,uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom := strings.TrimSpace(args[0])
+			routes, err := twapRoutesParseArgs(args[1], args[2])
+			if err != nil {
+				return err
+			}
+			startTime, err := osmocli.ParseUnixTime(args[3], "start time")
+			if err != nil {
+				return err
+			}
+			endTime, err := parseEndTime(args[4], startTime)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.GeometricTwapOverRoute(cmd.Context(), &queryproto.GeometricTwapOverRouteRequest{
+				BaseAsset: baseDenom,
+				Routes:    routes,
+				StartTime: startTime,
+				EndTime:   &endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryLogPriceVarianceCommand returns a log price variance query command.
+func GetQueryLogPriceVarianceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-price-variance [poolid] [base denom] [start time] [end time]",
+		Short: "Query the time weighted variance of the log price",
+		Long: osmocli.FormatLongDescDirect(`Query the time weighted variance of the natural logarithm of the price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} log-price-variance 1 uosmo 1667088000 24h
+{{.CommandPrefix}} log-price-variance 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.LogPriceVariance(cmd.Context(), &queryproto.LogPriceVarianceRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryLogPriceStdDevCommand returns a log price standard deviation query command.
+func GetQueryLogPriceStdDevCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "log-price-stddev [poolid] [base denom] [start time] [end time]",
+		Short:   "Query the time weighted standard deviation of the log price",
+		Aliases: []string{"volatility"},
+		Long: osmocli.FormatLongDescDirect(`Query the time weighted standard deviation of the natural logarithm of the price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} log-price-stddev 1 uosmo 1667088000 24h
+{{.CommandPrefix}} log-price-stddev 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.LogPriceStdDev(cmd.Context(), &queryproto.LogPriceStdDevRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -166,18 +318,45 @@ func twapQueryParseArgs(args []string) (poolId uint64, baseDenom string, startTi
 		return
 	}
 
+	endTime, err = parseEndTime(args[3], startTime)
+	if err != nil {
+		return
+	}
+	return poolId, baseDenom, startTime, endTime, nil
+}
+
+// parseEndTime parses the end time of a twap query, which is either a unix time or a duration after the start time.
+func parseEndTime(arg string, startTime time.Time) (time.Time, error) {
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err = osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err := osmocli.ParseUnixTime(arg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(arg)
 		if err2 != nil {
-			err = err2
-			return
+			return time.Time{}, err2
 		}
 		endTime = startTime.Add(duration)
 	}
-	return poolId, baseDenom, startTime, endTime, nil
+	return endTime, nil
+}
+
+// twapRoutesParseArgs parses comma separated pool ids and quote denoms into a twap route with one hop per pool.
+func twapRoutesParseArgs(poolIdsArg, quoteDenomsArg string) ([]types.TwapRoute, error) {
+	poolIdStrs := strings.Split(poolIdsArg, ",")
+	quoteDenoms := strings.Split(quoteDenomsArg, ",")
+	if len(poolIdStrs) != len(quoteDenoms) {
+		return nil, fmt.Errorf("number of pool ids (%d) and quote denoms (%d) must be equal", len(poolIdStrs), len(quoteDenoms))
+	}
+
+	routes := make([]types.TwapRoute, 0, len(poolIdStrs))
+	for i, poolIdStr := range poolIdStrs {
+		poolId, err := osmocli.ParseUint(strings.TrimSpace(poolIdStr), "poolId")
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, types.TwapRoute{PoolId: poolId, QuoteAsset: strings.TrimSpace(quoteDenoms[i])})
+	}
+	return routes, nil
 }
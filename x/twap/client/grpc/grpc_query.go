@@ -30,6 +30,46 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) LogPriceVarianceToNow(grpcCtx context.Context,
+	req *queryproto.LogPriceVarianceToNowRequest,
+) (*queryproto.LogPriceVarianceToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LogPriceVarianceToNow(ctx, *req)
+}
+
+func (q Querier) LogPriceVariance(grpcCtx context.Context,
+	req *queryproto.LogPriceVarianceRequest,
+) (*queryproto.LogPriceVarianceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LogPriceVariance(ctx, *req)
+}
+
+func (q Querier) LogPriceStdDevToNow(grpcCtx context.Context,
+	req *queryproto.LogPriceStdDevToNowRequest,
+) (*queryproto.LogPriceStdDevToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LogPriceStdDevToNow(ctx, *req)
+}
+
+func (q Querier) LogPriceStdDev(grpcCtx context.Context,
+	req *queryproto.LogPriceStdDevRequest,
+) (*queryproto.LogPriceStdDevResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LogPriceStdDev(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -40,6 +80,16 @@ func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	return q.Q.GeometricTwapToNow(ctx, *req)
 }
 
+func (q Querier) GeometricTwapOverRoute(grpcCtx context.Context,
+	req *queryproto.GeometricTwapOverRouteRequest,
+) (*queryproto.GeometricTwapOverRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapOverRoute(ctx, *req)
+}
+
 func (q Querier) GeometricTwap(grpcCtx context.Context,
 	req *queryproto.GeometricTwapRequest,
 ) (*queryproto.GeometricTwapResponse, error) {
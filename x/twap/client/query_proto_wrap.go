@@ -61,6 +61,67 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) GeometricTwapOverRoute(ctx sdk.Context,
+	req queryproto.GeometricTwapOverRouteRequest,
+) (*queryproto.GeometricTwapOverRouteResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetGeometricTwapOverRoute(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.GeometricTwapOverRouteResponse{GeometricTwap: twap}, err
+}
+
+func (q Querier) LogPriceVariance(ctx sdk.Context,
+	req queryproto.LogPriceVarianceRequest,
+) (*queryproto.LogPriceVarianceResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	variance, err := q.K.GetLogPriceVariance(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.LogPriceVarianceResponse{Variance: variance}, err
+}
+
+func (q Querier) LogPriceVarianceToNow(ctx sdk.Context,
+	req queryproto.LogPriceVarianceToNowRequest,
+) (*queryproto.LogPriceVarianceToNowResponse, error) {
+	variance, err := q.K.GetLogPriceVarianceToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.LogPriceVarianceToNowResponse{Variance: variance}, err
+}
+
+func (q Querier) LogPriceStdDev(ctx sdk.Context,
+	req queryproto.LogPriceStdDevRequest,
+) (*queryproto.LogPriceStdDevResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	stdDev, err := q.K.GetLogPriceStdDev(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.LogPriceStdDevResponse{StandardDeviation: stdDev}, err
+}
+
+func (q Querier) LogPriceStdDevToNow(ctx sdk.Context,
+	req queryproto.LogPriceStdDevToNowRequest,
+) (*queryproto.LogPriceStdDevToNowResponse, error) {
+	stdDev, err := q.K.GetLogPriceStdDevToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.LogPriceStdDevToNowResponse{StandardDeviation: stdDev}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -14,6 +14,7 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	VarianceTwapStrategy   = variance
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -72,6 +73,10 @@ func (gs geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	return gs.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (vs variance) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return vs.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -93,3 +93,8 @@ func (k Keeper) GetGeometricStrategy() *geometric {
 func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
+
+// GetVarianceStrategy gets log price variance TWAP keeper.
+func (k Keeper) GetVarianceStrategy() *variance {
+	return &variance{k}
+}
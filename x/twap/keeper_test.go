@@ -52,16 +52,17 @@ var (
 	basicParams = types.NewParams("week", 48*time.Hour)
 
 	mostRecentRecordPoolOne = types.TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      3,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             sdk.OneDec(),
-		P1LastSpotPrice:             sdk.OneDec(),
-		P0ArithmeticTwapAccumulator: sdk.OneDec(),
-		P1ArithmeticTwapAccumulator: sdk.OneDec(),
-		GeometricTwapAccumulator:    sdk.OneDec(),
+		PoolId:                           basePoolId,
+		Asset0Denom:                      denom0,
+		Asset1Denom:                      denom1,
+		Height:                           3,
+		Time:                             tPlusOne.Add(time.Second),
+		P0LastSpotPrice:                  sdk.OneDec(),
+		P1LastSpotPrice:                  sdk.OneDec(),
+		P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+		P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+		GeometricTwapAccumulator:         sdk.OneDec(),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -74,43 +75,46 @@ var (
 		basicParams,
 		[]types.TwapRecord{
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom1,
-				Height:                      1,
-				Time:                        baseTime,
-				P0LastSpotPrice:             sdk.OneDec(),
-				P1LastSpotPrice:             sdk.OneDec(),
-				P0ArithmeticTwapAccumulator: sdk.OneDec(),
-				P1ArithmeticTwapAccumulator: sdk.OneDec(),
-				GeometricTwapAccumulator:    sdk.OneDec(),
+				PoolId:                           basePoolId,
+				Asset0Denom:                      denom0,
+				Asset1Denom:                      denom1,
+				Height:                           1,
+				Time:                             baseTime,
+				P0LastSpotPrice:                  sdk.OneDec(),
+				P1LastSpotPrice:                  sdk.OneDec(),
+				P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+				P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+				GeometricTwapAccumulator:         sdk.OneDec(),
+				GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 			},
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom1,
-				Height:                      2,
-				Time:                        tPlusOne,
-				P0LastSpotPrice:             sdk.OneDec(),
-				P1LastSpotPrice:             sdk.OneDec(),
-				P0ArithmeticTwapAccumulator: sdk.OneDec(),
-				P1ArithmeticTwapAccumulator: sdk.OneDec(),
-				GeometricTwapAccumulator:    sdk.OneDec(),
+				PoolId:                           basePoolId,
+				Asset0Denom:                      denom0,
+				Asset1Denom:                      denom1,
+				Height:                           2,
+				Time:                             tPlusOne,
+				P0LastSpotPrice:                  sdk.OneDec(),
+				P1LastSpotPrice:                  sdk.OneDec(),
+				P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+				P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+				GeometricTwapAccumulator:         sdk.OneDec(),
+				GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
 
 	mostRecentRecordPoolTwo = types.TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom2,
-		Height:                      1,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             sdk.OneDec(),
-		P1LastSpotPrice:             sdk.OneDec(),
-		P0ArithmeticTwapAccumulator: sdk.OneDec(),
-		P1ArithmeticTwapAccumulator: sdk.OneDec(),
-		GeometricTwapAccumulator:    sdk.OneDec(),
+		PoolId:                           basePoolId,
+		Asset0Denom:                      denom0,
+		Asset1Denom:                      denom2,
+		Height:                           1,
+		Time:                             tPlusOne.Add(time.Second),
+		P0LastSpotPrice:                  sdk.OneDec(),
+		P1LastSpotPrice:                  sdk.OneDec(),
+		P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+		P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+		GeometricTwapAccumulator:         sdk.OneDec(),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -118,28 +122,30 @@ var (
 		[]types.TwapRecord{
 			mostRecentRecordPoolTwo,
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom2,
-				Height:                      2,
-				Time:                        tPlusOne,
-				P0LastSpotPrice:             sdk.OneDec(),
-				P1LastSpotPrice:             sdk.OneDec(),
-				P0ArithmeticTwapAccumulator: sdk.OneDec(),
-				P1ArithmeticTwapAccumulator: sdk.OneDec(),
-				GeometricTwapAccumulator:    sdk.OneDec(),
+				PoolId:                           basePoolId,
+				Asset0Denom:                      denom0,
+				Asset1Denom:                      denom2,
+				Height:                           2,
+				Time:                             tPlusOne,
+				P0LastSpotPrice:                  sdk.OneDec(),
+				P1LastSpotPrice:                  sdk.OneDec(),
+				P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+				P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+				GeometricTwapAccumulator:         sdk.OneDec(),
+				GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 			},
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom2,
-				Height:                      3,
-				Time:                        baseTime,
-				P0LastSpotPrice:             sdk.OneDec(),
-				P1LastSpotPrice:             sdk.OneDec(),
-				P0ArithmeticTwapAccumulator: sdk.OneDec(),
-				P1ArithmeticTwapAccumulator: sdk.OneDec(),
-				GeometricTwapAccumulator:    sdk.OneDec(),
+				PoolId:                           basePoolId,
+				Asset0Denom:                      denom0,
+				Asset1Denom:                      denom2,
+				Height:                           3,
+				Time:                             baseTime,
+				P0LastSpotPrice:                  sdk.OneDec(),
+				P1LastSpotPrice:                  sdk.OneDec(),
+				P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+				P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+				GeometricTwapAccumulator:         sdk.OneDec(),
+				GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 			},
 		})
 
@@ -159,6 +165,11 @@ func withLastErrTime(twap types.TwapRecord, lastErrorTime time.Time) types.TwapR
 	return twap
 }
 
+func withSecondMomentAccum(twap types.TwapRecord, secondMomentAccum sdk.Dec) types.TwapRecord {
+	twap.GeometricSecondMomentAccumulator = secondMomentAccum
+	return twap
+}
+
 func withSp0(twap types.TwapRecord, sp sdk.Dec) types.TwapRecord {
 	twap.P0LastSpotPrice = sp
 	return twap
@@ -216,16 +227,17 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 				types.NewParams("week", 48*time.Hour),
 				[]types.TwapRecord{
 					{
-						PoolId:                      0, // invalid
-						Asset0Denom:                 "test1",
-						Asset1Denom:                 "test2",
-						Height:                      1,
-						Time:                        baseTime,
-						P0LastSpotPrice:             sdk.OneDec(),
-						P1LastSpotPrice:             sdk.OneDec(),
-						P0ArithmeticTwapAccumulator: sdk.OneDec(),
-						P1ArithmeticTwapAccumulator: sdk.OneDec(),
-						GeometricTwapAccumulator:    sdk.OneDec(),
+						PoolId:                           0, // invalid
+						Asset0Denom:                      "test1",
+						Asset1Denom:                      "test2",
+						Height:                           1,
+						Time:                             baseTime,
+						P0LastSpotPrice:                  sdk.OneDec(),
+						P1LastSpotPrice:                  sdk.OneDec(),
+						P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+						P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+						GeometricTwapAccumulator:         sdk.OneDec(),
+						GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 					},
 				}),
 
@@ -418,11 +430,12 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		Asset0Denom: denom0,
 		Asset1Denom: denom1,
 
-		P0LastSpotPrice:             sp0,
-		P1LastSpotPrice:             sdk.OneDec().Quo(sp0),
-		P0ArithmeticTwapAccumulator: accum0,
-		P1ArithmeticTwapAccumulator: accum1,
-		GeometricTwapAccumulator:    geomAccum,
+		P0LastSpotPrice:                  sp0,
+		P1LastSpotPrice:                  sdk.OneDec().Quo(sp0),
+		P0ArithmeticTwapAccumulator:      accum0,
+		P1ArithmeticTwapAccumulator:      accum1,
+		GeometricTwapAccumulator:         geomAccum,
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 }
 
@@ -439,11 +452,12 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		Asset0Denom: denom0,
 		Asset1Denom: denom1,
 
-		P0LastSpotPrice:             spA,
-		P1LastSpotPrice:             spB,
-		P0ArithmeticTwapAccumulator: accumA,
-		P1ArithmeticTwapAccumulator: accumB,
-		GeometricTwapAccumulator:    geomAccumAB,
+		P0LastSpotPrice:                  spA,
+		P1LastSpotPrice:                  spB,
+		P0ArithmeticTwapAccumulator:      accumA,
+		P1ArithmeticTwapAccumulator:      accumB,
+		GeometricTwapAccumulator:         geomAccumAB,
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -466,11 +480,12 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		Asset0Denom: asset0,
 		Asset1Denom: asset1,
 
-		P0LastSpotPrice:             sdk.ZeroDec(),
-		P1LastSpotPrice:             sdk.ZeroDec(),
-		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
-		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
-		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		P0LastSpotPrice:                  sdk.ZeroDec(),
+		P1LastSpotPrice:                  sdk.ZeroDec(),
+		P0ArithmeticTwapAccumulator:      sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator:      sdk.ZeroDec(),
+		GeometricTwapAccumulator:         sdk.ZeroDec(),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 }
 
@@ -498,9 +513,10 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum sdk.De
 		P0LastSpotPrice: sp0,
 		P1LastSpotPrice: sdk.OneDec().Quo(sp0),
 		// make new copies
-		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		P0ArithmeticTwapAccumulator:      accum0.Add(sdk.ZeroDec()),
+		P1ArithmeticTwapAccumulator:      accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:         geomAccum.Add(sdk.ZeroDec()),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 }
 
@@ -510,9 +526,10 @@ func newExpRecord(accum0, accum1, geomAccum sdk.Dec) types.TwapRecord {
 		Asset0Denom: defaultTwoAssetCoins[0].Denom,
 		Asset1Denom: defaultTwoAssetCoins[1].Denom,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		P0ArithmeticTwapAccumulator:      accum0.Add(sdk.ZeroDec()),
+		P1ArithmeticTwapAccumulator:      accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:         geomAccum.Add(sdk.ZeroDec()),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 }
 
@@ -528,9 +545,10 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0LastSpotPrice: spA,
 		P1LastSpotPrice: spB,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		P0ArithmeticTwapAccumulator:      accumA.Add(sdk.ZeroDec()),
+		P1ArithmeticTwapAccumulator:      accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:         geomAccumAB.Add(sdk.ZeroDec()),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -552,9 +570,10 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		Asset0Denom: defaultThreeAssetCoins[0].Denom,
 		Asset1Denom: defaultThreeAssetCoins[1].Denom,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		P0ArithmeticTwapAccumulator:      accumA.Add(sdk.ZeroDec()),
+		P1ArithmeticTwapAccumulator:      accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:         geomAccumAB.Add(sdk.ZeroDec()),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -586,6 +605,14 @@ func newOneSidedGeometricRecord(time time.Time, accum sdk.Dec) types.TwapRecord
 	return record
 }
 
+func newOneSidedVarianceRecord(time time.Time, geomAccum, secondMomentAccum sdk.Dec) types.TwapRecord {
+	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
+	record.GeometricTwapAccumulator = geomAccum
+	record.GeometricSecondMomentAccumulator = secondMomentAccum
+	record.P0LastSpotPrice = sdk.NewDec(10)
+	return record
+}
+
 func newThreeAssetOneSidedRecord(time time.Time, accum sdk.Dec, useP0 bool) []types.TwapRecord {
 	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
 	if useP0 {
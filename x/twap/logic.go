@@ -30,6 +30,8 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}, nil
 }
 
@@ -240,6 +242,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = newRecord.GeometricTwapAccumulator.Add(p0NewGeomAccum)
 
+	// p0NewSecondMomentAccum = (log_{2}{P_0})^2 * timeDelta
+	p0NewSecondMomentAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+	newRecord.GeometricSecondMomentAccumulator = newRecord.GeometricSecondMomentAccumulator.Add(p0NewSecondMomentAccum)
+
 	return newRecord
 }
 
@@ -270,7 +276,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 }
 
 // computeTwap computes and returns a TWAP of a given
-// type - arithmetic or geometric.
+// type - arithmetic, geometric or variance.
 // Between two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
@@ -288,7 +294,11 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	}
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
+	// The variance of a single spot price is zero.
 	if timeDelta == time.Duration(0) {
+		if _, isVariance := strategy.(*variance); isVariance {
+			return sdk.ZeroDec(), err
+		}
 		if quoteAsset == startRecord.Asset0Denom {
 			return endRecord.P0LastSpotPrice, err
 		}
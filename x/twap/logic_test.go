@@ -29,6 +29,10 @@ var (
 	logOneOverTen        = twap.TwapLog(sdk.OneDec().QuoInt64(10))
 	tenSecAccum          = OneSec.MulInt64(10)
 	geometricTenSecAccum = OneSec.Mul(logTen)
+	// secondMomentTenSecAccum is the second moment accumulated over one second at a spot price of 10.
+	secondMomentTenSecAccum = OneSec.Mul(logTen.Mul(logTen))
+	// ln2Squared is ln(2)^2, the variance of the natural logarithm of a price whose base 2 logarithm has a variance of 1.
+	ln2Squared = sdk.MustNewDecFromStr("0.480453013918201425")
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -178,7 +182,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	sp10OneTimeUnitAccumRecord := withSecondMomentAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), secondMomentTenSecAccum)
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -264,17 +268,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			expRecord: withSecondMomentAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), secondMomentTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withSecondMomentAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), secondMomentTenSecAccum),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), oneDec, twoDec, pointFiveDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))),
+			expRecord: withSecondMomentAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))), secondMomentTenSecAccum.MulInt64(44)),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -289,7 +293,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom accum updated correctly": {
 			record:    withPrice1Set(defaultRecord, sdk.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withSecondMomentAccum(newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)), secondMomentTenSecAccum),
 		},
 		"both sp - zero spot price - accum0 unchange, accum1 unchanged, geom accum unchanged": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, sdk.ZeroDec()), sdk.ZeroDec()),
@@ -305,7 +309,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"nanoseconds in time of the original record do not affect final result": {
 			record:    withTime(defaultRecord, defaultRecord.Time.Add(oneHundredNanoseconds)),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withSecondMomentAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), secondMomentTenSecAccum),
 		},
 	}
 
@@ -331,21 +335,26 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		record          []types.TwapRecord
 		interpolateTime time.Time
 		expRecord       []types.TwapRecord
+		// expected second moment accumulators of the AB, AC and BC records.
+		expSecondMomentAccums []sdk.Dec
 	}{
 		"accum with zero value": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+			record:                newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec),
+			interpolateTime:       time.Unix(2, 0),
+			expRecord:             newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+			expSecondMomentAccums: []sdk.Dec{secondMomentTenSecAccum, secondMomentTenSecAccum, OneSec.Mul(logOneOverTen.Mul(logOneOverTen))},
 		},
 		"small starting accumulators": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+			record:                newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
+			interpolateTime:       time.Unix(2, 0),
+			expRecord:             newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+			expSecondMomentAccums: []sdk.Dec{secondMomentTenSecAccum, secondMomentTenSecAccum, OneSec.Mul(logOneOverTen.Mul(logOneOverTen))},
 		},
 		"larger time interval": {
-			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
-			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+			record:                newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
+			interpolateTime:       time.Unix(55, 0),
+			expRecord:             newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+			expSecondMomentAccums: []sdk.Dec{secondMomentTenSecAccum.MulInt64(44), secondMomentTenSecAccum.MulInt64(44), OneSec.MulInt64(44).Mul(logOneOverTen.Mul(logOneOverTen))},
 		},
 	}
 
@@ -356,6 +365,7 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 				test.expRecord[i].Time = test.interpolateTime
 				test.expRecord[i].P0LastSpotPrice = test.record[i].P0LastSpotPrice
 				test.expRecord[i].P1LastSpotPrice = test.record[i].P1LastSpotPrice
+				test.expRecord[i].GeometricSecondMomentAccumulator = test.expSecondMomentAccums[i]
 
 				gotRecord := twap.RecordWithUpdatedAccumulators(test.record[i], test.interpolateTime)
 				require.Equal(t, test.expRecord[i], gotRecord)
@@ -2,6 +2,9 @@ package twap
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +17,50 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// MigrateGeometricSecondMomentAccumulators backfills the geometric second moment accumulator
+// of all historical and most recent twap records, which were stored before it was introduced.
+// For every (pool id, asset 0, asset 1) triplet, the accumulator starts at zero at the oldest
+// historical record and is accumulated from the last spot prices of the records, exactly as if
+// it had been tracked since then. As a result, the variance can be computed over the whole
+// record history kept in state right after the migration.
+func (k Keeper) MigrateGeometricSecondMomentAccumulators(ctx sdk.Context) error {
+	// Records indexed by pool are ordered by time within each (pool id, asset 0, asset 1) triplet.
+	historicalRecords, err := k.getAllHistoricalPoolIndexedTWAPs(ctx)
+	if err != nil {
+		return err
+	}
+
+	type uniqueTriplet struct {
+		poolId uint64
+		asset0 string
+		asset1 string
+	}
+	lastMigratedRecords := map[uniqueTriplet]types.TwapRecord{}
+	// Triplets are stored in the order they were first seen, so that the iteration below is deterministic.
+	triplets := []uniqueTriplet{}
+
+	for _, record := range historicalRecords {
+		triplet := uniqueTriplet{poolId: record.PoolId, asset0: record.Asset0Denom, asset1: record.Asset1Denom}
+		previousRecord, found := lastMigratedRecords[triplet]
+		if found {
+			record.GeometricSecondMomentAccumulator = recordWithUpdatedAccumulators(previousRecord, record.Time).GeometricSecondMomentAccumulator
+		} else {
+			record.GeometricSecondMomentAccumulator = sdk.ZeroDec()
+			triplets = append(triplets, triplet)
+		}
+		k.storeHistoricalTWAP(ctx, record)
+		lastMigratedRecords[triplet] = record
+	}
+
+	for _, triplet := range triplets {
+		mostRecentRecord, err := k.getMostRecentRecordStoreRepresentation(ctx, triplet.poolId, triplet.asset0, triplet.asset1)
+		if err != nil {
+			return err
+		}
+		mostRecentRecord.GeometricSecondMomentAccumulator = recordWithUpdatedAccumulators(lastMigratedRecords[triplet], mostRecentRecord.Time).GeometricSecondMomentAccumulator
+		store := ctx.KVStore(k.storeKey)
+		osmoutils.MustSet(store, types.FormatMostRecentTWAPKey(triplet.poolId, triplet.asset0, triplet.asset1), &mostRecentRecord)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v17/x/twap"
 	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
 )
 
@@ -94,3 +96,42 @@ func (suite *TestSuite) TestTwapRecord_GeometricTwap_MarshalUnmarshal() {
 	suite.Require().False(originalRecord.GeometricTwapAccumulator.IsNil())
 	suite.Require().Equal(sdk.ZeroDec(), originalRecord.GeometricTwapAccumulator)
 }
+
+func (s *TestSuite) TestMigrateGeometricSecondMomentAccumulators() {
+	// The spot price of asset 0 is 2 for 10 seconds, 8 for 20 seconds and 1 afterwards.
+	sp2Record := newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	sp8Record := recordWithUpdatedSpotPrice(twap.RecordWithUpdatedAccumulators(sp2Record, baseTime.Add(10*time.Second)), sdk.NewDec(8), sdk.OneDec().QuoInt64(8))
+	sp1Record := recordWithUpdatedSpotPrice(twap.RecordWithUpdatedAccumulators(sp8Record, baseTime.Add(30*time.Second)), sdk.OneDec(), sdk.OneDec())
+	otherPoolRecord := withPoolId(sp8Record, 2)
+	s.preSetRecords([]types.TwapRecord{
+		withSecondMomentAccum(sp2Record, sdk.ZeroDec()),
+		withSecondMomentAccum(sp8Record, sdk.ZeroDec()),
+		withSecondMomentAccum(sp1Record, sdk.ZeroDec()),
+		withSecondMomentAccum(otherPoolRecord, sdk.ZeroDec()),
+	})
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(30 * time.Second))
+
+	// System under test.
+	err := s.twapkeeper.MigrateGeometricSecondMomentAccumulators(s.Ctx)
+	s.Require().NoError(err)
+
+	// The accumulators start at zero at the oldest record of each pool, and accumulate
+	// (log_{2}{2})^2 = 1 for 10 seconds, then (log_{2}{8})^2 = 9 for 20 seconds.
+	expectedRecords := []types.TwapRecord{
+		withSecondMomentAccum(sp2Record, sdk.ZeroDec()),
+		withSecondMomentAccum(sp8Record, OneSec.MulInt64(10)),
+		withSecondMomentAccum(sp1Record, OneSec.MulInt64(10+9*20)),
+	}
+	s.Require().Equal(expectedRecords, s.getAllHistoricalRecordsForPool(1))
+	s.Require().Equal([]types.TwapRecord{withSecondMomentAccum(otherPoolRecord, sdk.ZeroDec())}, s.getAllHistoricalRecordsForPool(2))
+
+	mostRecentRecord, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, 1, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecords[2], mostRecentRecord)
+
+	// The variance over the migrated records is the same as if the accumulator had always been tracked:
+	// log_{2}{P} is 1 for 10s and 3 for 20s, Var(log_{2}{P}) = 19/3 - (7/3)^2 = 8/9
+	variance, err := s.twapkeeper.GetLogPriceVarianceToNow(s.Ctx, 1, denom0, denom1, baseTime)
+	s.Require().NoError(err)
+	osmoassert.DecApproxEq(s.T(), ln2Squared.MulInt64(8).QuoInt64(9), variance, sdk.MustNewDecFromStr("0.000000000000001"))
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and variance.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -25,6 +25,13 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type variance struct {
+	TwapKeeper Keeper
+}
+
+// ln2Squared is ln(2)^2, used to convert variances of base 2 logarithms to variances of natural logarithms.
+var ln2Squared = sdk.MustNewDecFromStr("0.480453013918201425")
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
@@ -72,3 +79,26 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.SDKDec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns the time weighted variance of the natural
+// logarithm of the spot price between two records. Since ln(P_1) = -ln(P_0),
+// the variance is the same for either quote asset.
+func (s *variance) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+
+	// mean = E[log_{2}{P_0}]
+	mean := types.AccumDiffDivDuration(endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator), timeDelta)
+	// secondMoment = E[(log_{2}{P_0})^2]
+	secondMoment := types.AccumDiffDivDuration(endRecord.GeometricSecondMomentAccumulator.Sub(startRecord.GeometricSecondMomentAccumulator), timeDelta)
+
+	// Var(log_{2}{P_0}) = E[(log_{2}{P_0})^2] - E[log_{2}{P_0}]^2
+	// N.B. truncation can make the difference slightly negative for a constant price,
+	// so it is floored at zero.
+	log2Variance := secondMoment.Sub(mean.Mul(mean))
+	if log2Variance.IsNegative() {
+		return sdk.ZeroDec()
+	}
+
+	// Var(ln{P_0}) = ln(2)^2 * Var(log_{2}{P_0})
+	return log2Variance.Mul(ln2Squared)
+}
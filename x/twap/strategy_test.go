@@ -82,6 +82,15 @@ func (s *TestSuite) TestComputeTwap() {
 		"geometric only: accumulator = log(10)*OneSec, t=5s. 0 base accum": geometricTestCaseFromDeltas0(
 			s, sdk.ZeroDec(), geometricTenSecAccum, 5*time.Second, twap.TwapPow(geometricTenSecAccum.QuoInt64(5*1000))),
 		"geometric only: accumulator = log(10)*OneSec, t=100s. 0 base accum (asset 1)": geometricTestCaseFromDeltas1(s, sdk.ZeroDec(), geometricTenSecAccum, 100*time.Second, sdk.OneDec().Quo(twap.TwapPow(geometricTenSecAccum.QuoInt64(100*1000)))),
+		"variance only, same record: zero variance": {
+			startRecord: newOneSidedVarianceRecord(baseTime, geometricTenSecAccum, secondMomentTenSecAccum),
+			endRecord:   newOneSidedVarianceRecord(baseTime, geometricTenSecAccum, secondMomentTenSecAccum),
+			quoteAsset:  denom0,
+			twapStrategies: []twap.TwapStrategy{
+				&twap.VarianceTwapStrategy{TwapKeeper: *s.App.TwapKeeper},
+			},
+			expTwap: sdk.ZeroDec(),
+		},
 		"three asset same record: asset1, end spot price = 1": {
 			startRecord: newThreeAssetOneSidedRecord(baseTime, sdk.ZeroDec(), true)[1],
 			endRecord:   newThreeAssetOneSidedRecord(baseTime, sdk.ZeroDec(), true)[1],
@@ -377,3 +386,52 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeVarianceStrategyTwap tests variance strategy's computeTwap.
+// The accumulators of the test records are set as if log_{2}{P_0} took the given values for the given durations.
+func (s *TestSuite) TestComputeVarianceStrategyTwap() {
+	// accumulatorsFor returns the geometric and second moment accumulators of log_{2}{P_0}
+	// taking each of the given values for one second.
+	accumulatorsFor := func(log2Prices ...int64) (sdk.Dec, sdk.Dec) {
+		geomAccum, secondMomentAccum := sdk.ZeroDec(), sdk.ZeroDec()
+		for _, log2Price := range log2Prices {
+			geomAccum = geomAccum.Add(OneSec.MulInt64(log2Price))
+			secondMomentAccum = secondMomentAccum.Add(OneSec.MulInt64(log2Price * log2Price))
+		}
+		return geomAccum, secondMomentAccum
+	}
+	testCaseFromLog2Prices := func(startGeomAccum, startSecondMomentAccum sdk.Dec, quoteAsset string, expTwap sdk.Dec, log2Prices ...int64) computeTwapTestCase {
+		geomAccum, secondMomentAccum := accumulatorsFor(log2Prices...)
+		return computeTwapTestCase{
+			startRecord: newOneSidedVarianceRecord(baseTime, startGeomAccum, startSecondMomentAccum),
+			endRecord:   newOneSidedVarianceRecord(baseTime.Add(time.Duration(len(log2Prices))*time.Second), startGeomAccum.Add(geomAccum), startSecondMomentAccum.Add(secondMomentAccum)),
+			quoteAsset:  quoteAsset,
+			expTwap:     expTwap,
+		}
+	}
+
+	tests := map[string]computeTwapTestCase{
+		"constant price: zero variance": testCaseFromLog2Prices(sdk.ZeroDec(), sdk.ZeroDec(), denom0, sdk.ZeroDec(), 3, 3, 3),
+		"constant price of 10 for one second: zero variance": {
+			startRecord: newOneSidedVarianceRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newOneSidedVarianceRecord(tPlusOne, geometricTenSecAccum, secondMomentTenSecAccum),
+			quoteAsset:  denom0,
+			expTwap:     sdk.ZeroDec(),
+		},
+		// E[x] = 2, E[x^2] = 5, Var(x) = 1
+		"price of 2 then 8: variance of log_2 of 1":       testCaseFromLog2Prices(sdk.ZeroDec(), sdk.ZeroDec(), denom0, ln2Squared, 1, 3),
+		"price of 2 then 8, quote asset 1: same variance": testCaseFromLog2Prices(sdk.ZeroDec(), sdk.ZeroDec(), denom1, ln2Squared, 1, 3),
+		"price of 1/2 then 1/8: same variance":            testCaseFromLog2Prices(sdk.ZeroDec(), sdk.ZeroDec(), denom0, ln2Squared, -1, -3),
+		// E[x] = 1, E[x^2] = 4, Var(x) = 3
+		"price of 1 for 3s then 16 for 1s: variance of log_2 of 3": testCaseFromLog2Prices(sdk.ZeroDec(), sdk.ZeroDec(), denom0, ln2Squared.MulInt64(3), 0, 0, 0, 4),
+		"non-zero start accumulators have no impact":               testCaseFromLog2Prices(geometricTenSecAccum, secondMomentTenSecAccum, denom0, ln2Squared.MulInt64(3), 0, 0, 0, 4),
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			varianceStrategy := &twap.VarianceTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := varianceStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, actualTwap, sdk.MustNewDecFromStr("0.000000000000001"))
+			s.Require().False(actualTwap.IsNegative())
+		})
+	}
+}
@@ -73,3 +73,9 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type EmptyTwapRouteError struct{}
+
+func (e EmptyTwapRouteError) Error() string {
+	return "twap route must contain at least one pool"
+}
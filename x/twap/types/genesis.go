@@ -84,5 +84,9 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	if t.GeometricSecondMomentAccumulator.IsNil() || t.GeometricSecondMomentAccumulator.IsNegative() {
+		return fmt.Errorf("twap record geometric second moment accumulator cannot be negative, was (%s)", t.GeometricSecondMomentAccumulator)
+	}
 	return nil
 }
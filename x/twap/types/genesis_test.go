@@ -19,16 +19,17 @@ var (
 	baseTime   = time.Unix(1257894000, 0).UTC()
 	tPlusOne   = baseTime.Add(time.Second)
 	baseRecord = TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      3,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             sdk.OneDec(),
-		P1LastSpotPrice:             sdk.OneDec(),
-		P0ArithmeticTwapAccumulator: sdk.OneDec(),
-		P1ArithmeticTwapAccumulator: sdk.OneDec(),
-		GeometricTwapAccumulator:    sdk.OneDec(),
+		PoolId:                           basePoolId,
+		Asset0Denom:                      denom0,
+		Asset1Denom:                      denom1,
+		Height:                           3,
+		Time:                             tPlusOne.Add(time.Second),
+		P0LastSpotPrice:                  sdk.OneDec(),
+		P1LastSpotPrice:                  sdk.OneDec(),
+		P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+		P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+		GeometricTwapAccumulator:         sdk.OneDec(),
+		GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 	}
 )
 
@@ -47,28 +48,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			[]TwapRecord{
 				baseRecord,
 				{
-					PoolId:                      basePoolId,
-					Asset0Denom:                 denom0,
-					Asset1Denom:                 denom1,
-					Height:                      2,
-					Time:                        tPlusOne,
-					P0LastSpotPrice:             sdk.OneDec(),
-					P1LastSpotPrice:             sdk.OneDec(),
-					P0ArithmeticTwapAccumulator: sdk.OneDec(),
-					P1ArithmeticTwapAccumulator: sdk.OneDec(),
-					GeometricTwapAccumulator:    sdk.OneDec(),
+					PoolId:                           basePoolId,
+					Asset0Denom:                      denom0,
+					Asset1Denom:                      denom1,
+					Height:                           2,
+					Time:                             tPlusOne,
+					P0LastSpotPrice:                  sdk.OneDec(),
+					P1LastSpotPrice:                  sdk.OneDec(),
+					P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+					P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+					GeometricTwapAccumulator:         sdk.OneDec(),
+					GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 				},
 				{
-					PoolId:                      basePoolId,
-					Asset0Denom:                 denom0,
-					Asset1Denom:                 denom1,
-					Height:                      3,
-					Time:                        tPlusOne.Add(time.Second),
-					P0LastSpotPrice:             sdk.OneDec(),
-					P1LastSpotPrice:             sdk.OneDec(),
-					P0ArithmeticTwapAccumulator: sdk.OneDec(),
-					P1ArithmeticTwapAccumulator: sdk.OneDec(),
-					GeometricTwapAccumulator:    sdk.OneDec(),
+					PoolId:                           basePoolId,
+					Asset0Denom:                      denom0,
+					Asset1Denom:                      denom1,
+					Height:                           3,
+					Time:                             tPlusOne.Add(time.Second),
+					P0LastSpotPrice:                  sdk.OneDec(),
+					P1LastSpotPrice:                  sdk.OneDec(),
+					P0ArithmeticTwapAccumulator:      sdk.OneDec(),
+					P1ArithmeticTwapAccumulator:      sdk.OneDec(),
+					GeometricTwapAccumulator:         sdk.OneDec(),
+					GeometricSecondMomentAccumulator: sdk.ZeroDec(),
 				},
 			})
 	)
@@ -78,6 +81,11 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withSecondMomentAcc := func(record TwapRecord, secondMomentAcc sdk.Dec) TwapRecord {
+		record.GeometricSecondMomentAccumulator = secondMomentAcc
+		return record
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -102,6 +110,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, sdk.Dec{})}),
 			expectedErr: true,
 		},
+		"valid geometric second moment acc is positive": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withSecondMomentAcc(baseRecord, sdk.OneDec())}),
+		},
+		"invalid geometric second moment acc is negative": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withSecondMomentAcc(baseRecord, sdk.NewDec(-1))}),
+			expectedErr: true,
+		},
+		"invalid geometric second moment acc is nil": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withSecondMomentAcc(baseRecord, sdk.Dec{})}),
+			expectedErr: true,
+		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
 				NewParams("week", 48*time.Hour),
@@ -90,5 +90,8 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = sdk.ZeroDec()
 	}
+	if twap.GeometricSecondMomentAccumulator.IsNil() {
+		twap.GeometricSecondMomentAccumulator = sdk.ZeroDec()
+	}
 	return twap, err
 }
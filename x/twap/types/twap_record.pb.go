@@ -60,6 +60,11 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// The second moment accumulator accumulates the square of the base 2
+	// logarithm of the spot price of asset 0 over time. Together with the
+	// geometric twap accumulator, it is used to compute the variance of
+	// the logarithm of the spot price over a time range.
+	GeometricSecondMomentAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=geometric_second_moment_accumulator,json=geometricSecondMomentAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_second_moment_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -146,41 +151,43 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
+	// 570 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x1a, 0x52, 0x7a, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x87, 0x0c, 0x55,
-	0x18, 0xea, 0x1f, 0x30, 0x20, 0xb1, 0x25, 0x2a, 0x03, 0x08, 0x21, 0x64, 0x3a, 0xc1, 0x70, 0x3a,
-	0xdb, 0x57, 0xc7, 0xc2, 0xce, 0x9d, 0xee, 0x2e, 0x2d, 0xf9, 0x2f, 0xfa, 0x67, 0x75, 0xec, 0x88,
-	0x18, 0x0c, 0x4a, 0x36, 0xc6, 0x4e, 0x8c, 0xe8, 0x7e, 0x24, 0x34, 0xe1, 0x97, 0x94, 0x29, 0x79,
-	0xef, 0x7d, 0xdf, 0xe7, 0xfb, 0x9e, 0xfd, 0x64, 0x70, 0x48, 0x78, 0x45, 0x78, 0xc1, 0x03, 0x71,
-	0x8e, 0x68, 0x70, 0x16, 0x25, 0x58, 0xa0, 0x48, 0x05, 0x90, 0xe1, 0x94, 0xb0, 0xcc, 0xa7, 0x8c,
-	0x08, 0x62, 0x77, 0x8c, 0xce, 0x97, 0x25, 0xdf, 0xe8, 0xba, 0x9d, 0x9c, 0xe4, 0x44, 0x09, 0x02,
-	0xf9, 0x4f, 0x6b, 0xbb, 0x0f, 0x72, 0x42, 0xf2, 0x12, 0x07, 0x2a, 0x4a, 0xa6, 0xa7, 0x01, 0x9a,
-	0xcc, 0x96, 0xa5, 0x54, 0x71, 0xa0, 0xee, 0xd1, 0x81, 0x29, 0xb9, 0x3a, 0x0a, 0x12, 0xc4, 0xf1,
-	0x6a, 0x90, 0x94, 0x14, 0x13, 0x53, 0xf7, 0x36, 0xa9, 0xa2, 0xa8, 0x30, 0x17, 0xa8, 0xa2, 0x5a,
-	0xd0, 0xff, 0xd1, 0x02, 0xe0, 0xe4, 0x1c, 0xd1, 0x58, 0xcd, 0x6d, 0xdf, 0x07, 0xbb, 0x94, 0x90,
-	0x12, 0x16, 0x99, 0x63, 0xf5, 0xac, 0x41, 0x33, 0x6e, 0xc9, 0xf0, 0x65, 0x66, 0x3f, 0x02, 0x77,
-	0x11, 0xe7, 0x58, 0x84, 0x30, 0xc3, 0x13, 0x52, 0x39, 0xb7, 0x7a, 0xd6, 0x60, 0x2f, 0x6e, 0xeb,
-	0xdc, 0xb1, 0x4c, 0xad, 0x24, 0x91, 0x91, 0xec, 0xdc, 0x90, 0x44, 0x5a, 0x32, 0x04, 0xad, 0x31,
-	0x2e, 0xf2, 0xb1, 0x70, 0x9a, 0x3d, 0x6b, 0xb0, 0x33, 0x7a, 0xfc, 0xbd, 0xf6, 0xf6, 0xf5, 0x23,
-	0x83, 0xba, 0x70, 0x5d, 0x7b, 0x9d, 0x19, 0xaa, 0xca, 0xe7, 0xfd, 0xb5, 0x74, 0x3f, 0x36, 0x8d,
-	0xf6, 0x1b, 0xd0, 0x94, 0x3b, 0x38, 0xb7, 0x7b, 0xd6, 0xa0, 0xfd, 0xa4, 0xeb, 0xeb, 0x05, 0xfd,
-	0xe5, 0x82, 0xfe, 0xc9, 0x72, 0xc1, 0x91, 0x7b, 0x59, 0x7b, 0x8d, 0xeb, 0xda, 0xb3, 0xd7, 0x78,
-	0xb2, 0xb9, 0x7f, 0xf1, 0xd5, 0xb3, 0x62, 0xc5, 0xb1, 0x3f, 0x00, 0x9b, 0x86, 0xb0, 0x44, 0x5c,
-	0x40, 0x4e, 0x89, 0x80, 0x94, 0x15, 0x29, 0x76, 0x5a, 0x72, 0xf6, 0x91, 0x2f, 0x09, 0x5f, 0x6a,
-	0xef, 0x30, 0x2f, 0xc4, 0x78, 0x9a, 0xf8, 0x29, 0xa9, 0xcc, 0xe3, 0x37, 0x3f, 0x47, 0x3c, 0xfb,
-	0x18, 0x88, 0x19, 0xc5, 0xdc, 0x3f, 0xc6, 0x69, 0x7c, 0x40, 0xc3, 0xd7, 0x88, 0x8b, 0x77, 0x94,
-	0x88, 0xb7, 0x12, 0xa3, 0xe0, 0xd1, 0x6f, 0xf0, 0xdd, 0x2d, 0xe1, 0xd1, 0x3a, 0x9c, 0x03, 0x97,
-	0x86, 0x10, 0xb1, 0x42, 0x8c, 0x2b, 0x2c, 0x8a, 0x14, 0xaa, 0x03, 0x44, 0x69, 0x3a, 0xad, 0xa6,
-	0x25, 0x12, 0x84, 0x39, 0x77, 0xb6, 0x32, 0x7a, 0x48, 0xc3, 0xe1, 0x0a, 0x2a, 0x6f, 0x63, 0xf8,
-	0x0b, 0xa9, 0x4c, 0xa3, 0x7f, 0x9a, 0xee, 0x6d, 0x69, 0x1a, 0xfd, 0xdd, 0xb4, 0x04, 0xdd, 0x1c,
-	0x93, 0x0a, 0x0b, 0xf6, 0x27, 0x43, 0xb0, 0x95, 0xa1, 0xb3, 0x22, 0x6e, 0xba, 0x9d, 0x82, 0x03,
-	0xf5, 0xc6, 0x30, 0x63, 0x84, 0xa9, 0x7b, 0x71, 0xda, 0xff, 0x3d, 0xb6, 0xbe, 0x39, 0xb6, 0x7b,
-	0xfa, 0xd8, 0x36, 0x00, 0xfa, 0xe0, 0xf6, 0x65, 0xf6, 0x85, 0x4c, 0xca, 0xbe, 0xd1, 0xab, 0xcb,
-	0xb9, 0x6b, 0x5d, 0xcd, 0x5d, 0xeb, 0xdb, 0xdc, 0xb5, 0x2e, 0x16, 0x6e, 0xe3, 0x6a, 0xe1, 0x36,
-	0x3e, 0x2f, 0xdc, 0xc6, 0xfb, 0xf0, 0xc6, 0x0e, 0xe6, 0x13, 0x72, 0x54, 0xa2, 0x84, 0x2f, 0x83,
-	0xe0, 0x2c, 0x7a, 0x16, 0x7c, 0xd2, 0x5f, 0x1f, 0xb5, 0x51, 0xd2, 0x52, 0x23, 0x3d, 0xfd, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0xa4, 0x80, 0x45, 0x93, 0x9a, 0x04, 0x00, 0x00,
+	0x14, 0xc7, 0x63, 0x5a, 0x12, 0x7a, 0x69, 0x55, 0xc9, 0x8a, 0xc0, 0x04, 0xc9, 0x0e, 0x41, 0xaa,
+	0xc2, 0x50, 0xff, 0x80, 0x01, 0x89, 0x2d, 0x51, 0x19, 0x40, 0x80, 0x90, 0xdb, 0x09, 0x86, 0xd3,
+	0xd9, 0xbe, 0x3a, 0x16, 0xbe, 0xdc, 0xc9, 0x77, 0x69, 0xc9, 0xc0, 0xff, 0xd0, 0x3f, 0xab, 0x63,
+	0x47, 0xc4, 0x10, 0x50, 0xb2, 0x31, 0x76, 0x61, 0x45, 0x77, 0xe7, 0xa4, 0x49, 0xf8, 0x25, 0x79,
+	0x4a, 0xde, 0x7b, 0xdf, 0xf7, 0xf9, 0xde, 0x3b, 0x3f, 0x1d, 0x38, 0xa0, 0x9c, 0x50, 0x9e, 0x71,
+	0x4f, 0x9c, 0x23, 0xe6, 0x9d, 0x05, 0x11, 0x16, 0x28, 0x50, 0x01, 0x2c, 0x70, 0x4c, 0x8b, 0xc4,
+	0x65, 0x05, 0x15, 0xd4, 0x6c, 0x95, 0x3a, 0x57, 0x96, 0xdc, 0x52, 0xd7, 0x6e, 0xa5, 0x34, 0xa5,
+	0x4a, 0xe0, 0xc9, 0x7f, 0x5a, 0xdb, 0xbe, 0x9f, 0x52, 0x9a, 0xe6, 0xd8, 0x53, 0x51, 0x34, 0x3e,
+	0xf5, 0xd0, 0x68, 0xb2, 0x28, 0xc5, 0x8a, 0x03, 0x75, 0x8f, 0x0e, 0xca, 0x92, 0xad, 0x23, 0x2f,
+	0x42, 0x1c, 0x2f, 0x0f, 0x12, 0xd3, 0x6c, 0x54, 0xd6, 0x9d, 0x4d, 0xaa, 0xc8, 0x08, 0xe6, 0x02,
+	0x11, 0xa6, 0x05, 0xdd, 0x9f, 0x0d, 0x00, 0x4e, 0xce, 0x11, 0x0b, 0xd5, 0xb9, 0xcd, 0x7b, 0xa0,
+	0xc1, 0x28, 0xcd, 0x61, 0x96, 0x58, 0x46, 0xc7, 0xe8, 0x6d, 0x87, 0x75, 0x19, 0xbe, 0x4c, 0xcc,
+	0x87, 0x60, 0x17, 0x71, 0x8e, 0x85, 0x0f, 0x13, 0x3c, 0xa2, 0xc4, 0xba, 0xd5, 0x31, 0x7a, 0x3b,
+	0x61, 0x53, 0xe7, 0x8e, 0x64, 0x6a, 0x29, 0x09, 0x4a, 0xc9, 0xd6, 0x8a, 0x24, 0xd0, 0x92, 0x3e,
+	0xa8, 0x0f, 0x71, 0x96, 0x0e, 0x85, 0xb5, 0xdd, 0x31, 0x7a, 0x5b, 0x83, 0xc7, 0x3f, 0xa6, 0xce,
+	0x9e, 0xbe, 0x32, 0xa8, 0x0b, 0xd7, 0x53, 0xa7, 0x35, 0x41, 0x24, 0x7f, 0xde, 0x5d, 0x4b, 0x77,
+	0xc3, 0xb2, 0xd1, 0x7c, 0x0b, 0xb6, 0xe5, 0x0c, 0xd6, 0xed, 0x8e, 0xd1, 0x6b, 0x3e, 0x69, 0xbb,
+	0x7a, 0x40, 0x77, 0x31, 0xa0, 0x7b, 0xb2, 0x18, 0x70, 0x60, 0x5f, 0x4e, 0x9d, 0xda, 0xf5, 0xd4,
+	0x31, 0xd7, 0x78, 0xb2, 0xb9, 0x7b, 0xf1, 0xcd, 0x31, 0x42, 0xc5, 0x31, 0x3f, 0x00, 0x93, 0xf9,
+	0x30, 0x47, 0x5c, 0x40, 0xce, 0xa8, 0x80, 0xac, 0xc8, 0x62, 0x6c, 0xd5, 0xe5, 0xd9, 0x07, 0xae,
+	0x24, 0x7c, 0x9d, 0x3a, 0x07, 0x69, 0x26, 0x86, 0xe3, 0xc8, 0x8d, 0x29, 0x29, 0xaf, 0xbf, 0xfc,
+	0x39, 0xe4, 0xc9, 0x47, 0x4f, 0x4c, 0x18, 0xe6, 0xee, 0x11, 0x8e, 0xc3, 0x7d, 0xe6, 0xbf, 0x46,
+	0x5c, 0x1c, 0x33, 0x2a, 0xde, 0x49, 0x8c, 0x82, 0x07, 0xbf, 0xc1, 0x1b, 0x15, 0xe1, 0xc1, 0x3a,
+	0x9c, 0x03, 0x9b, 0xf9, 0x10, 0x15, 0x99, 0x18, 0x12, 0x2c, 0xb2, 0x18, 0xaa, 0x05, 0x44, 0x71,
+	0x3c, 0x26, 0xe3, 0x1c, 0x09, 0x5a, 0x58, 0x77, 0x2a, 0x19, 0x3d, 0x60, 0x7e, 0x7f, 0x09, 0x95,
+	0xbb, 0xd1, 0xbf, 0x41, 0x2a, 0xd3, 0xe0, 0x9f, 0xa6, 0x3b, 0x15, 0x4d, 0x83, 0xbf, 0x9b, 0xe6,
+	0xa0, 0x9d, 0x62, 0x4a, 0xb0, 0x28, 0xfe, 0x64, 0x08, 0x2a, 0x19, 0x5a, 0x4b, 0xe2, 0xa6, 0xdb,
+	0x29, 0xd8, 0x57, 0x5f, 0x0c, 0x17, 0x05, 0x2d, 0xd4, 0xbe, 0x58, 0xcd, 0xff, 0x2e, 0x5b, 0xb7,
+	0x5c, 0xb6, 0xbb, 0x7a, 0xd9, 0x36, 0x00, 0x7a, 0xe1, 0xf6, 0x64, 0xf6, 0x85, 0x4c, 0xca, 0x3e,
+	0xf3, 0x33, 0x78, 0x74, 0x33, 0x15, 0xc7, 0x31, 0x1d, 0x25, 0x90, 0x50, 0x82, 0x47, 0x62, 0x6d,
+	0xbc, 0xdd, 0x4a, 0xe3, 0x75, 0x96, 0xe8, 0x63, 0x45, 0x7e, 0xa3, 0xc0, 0x2b, 0x63, 0x0e, 0x5e,
+	0x5d, 0xce, 0x6c, 0xe3, 0x6a, 0x66, 0x1b, 0xdf, 0x67, 0xb6, 0x71, 0x31, 0xb7, 0x6b, 0x57, 0x73,
+	0xbb, 0xf6, 0x65, 0x6e, 0xd7, 0xde, 0xfb, 0x2b, 0x1e, 0xe5, 0x0b, 0x76, 0x98, 0xa3, 0x88, 0x2f,
+	0x02, 0xef, 0x2c, 0x78, 0xe6, 0x7d, 0xd2, 0x8f, 0x9f, 0x72, 0x8c, 0xea, 0xea, 0x46, 0x9e, 0xfe,
+	0x1a, 0x00, 0x4a, 0x4c, 0xe0, 0xf1, 0x19, 0x05, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +210,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GeometricSecondMomentAccumulator.Size()
+		i -= size
+		if _, err := m.GeometricSecondMomentAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -341,6 +358,8 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.GeometricSecondMomentAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -717,6 +736,40 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricSecondMomentAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricSecondMomentAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
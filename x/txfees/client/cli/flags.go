@@ -0,0 +1,40 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// flags for txfees module queries.
+const (
+	FlagDenom = "denom"
+)
+
+// flags for txfees module tx commands.
+const (
+	FlagAllowedMessages  = "allowed-messages"
+	FlagAllowedContracts = "allowed-contracts"
+)
+
+// FlagSetDenom returns flags for denominating the current base fee in a fee token.
+func FlagSetDenom() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDenom, "", "The fee token to denominate the base fee in. Defaults to the base denom")
+	return fs
+}
+
+// FlagSetAllowedMessages returns flags for the message types a fee sponsor pays fees for.
+func FlagSetAllowedMessages() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringSlice(FlagAllowedMessages, []string{}, "Comma separated type URLs of the messages the sponsor pays fees for")
+	return fs
+}
+
+// FlagSetAllowedContracts returns flags for the contracts a fee sponsor pays execution fees for.
+func FlagSetAllowedContracts() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringSlice(FlagAllowedContracts, []string{}, "Comma separated addresses of the contracts the sponsor pays execution fees for. Defaults to all contracts")
+	return fs
+}
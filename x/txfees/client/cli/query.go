@@ -2,6 +2,7 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
@@ -15,7 +16,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdParams(),
+		GetCmdSponsorshipPolicy(),
+		GetCmdSponsorshipPolicies(),
+		GetCmdSponsoredFees(),
 	)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCurrentBaseFee)
 
 	return cmd
 }
@@ -52,3 +58,61 @@ func GetCmdBaseDenom() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+func GetCmdParams() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryParamsRequest](
+		"params",
+		"Query the txfees module params",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} params
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdSponsorshipPolicy() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QuerySponsorshipPolicyRequest](
+		"sponsorship-policy",
+		"Query the sponsorship policy and remaining budget of a fee sponsor",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} sponsorship-policy [sponsor]
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdSponsorshipPolicies() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QuerySponsorshipPoliciesRequest](
+		"sponsorship-policies",
+		"Query the sponsorship policies of all fee sponsors",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} sponsorship-policies
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdSponsoredFees() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QuerySponsoredFeesRequest](
+		"sponsored-fees",
+		"Query the fees a fee sponsor has paid for a user in the current day epoch",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} sponsored-fees [sponsor] [user]
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdCurrentBaseFee() (*osmocli.QueryDescriptor, *types.QueryCurrentBaseFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "current-base-fee",
+		Short: "Query the base fee per unit of gas that transactions currently need to pay",
+		Long: osmocli.FormatLongDescDirect(`{{.Short}}, in the base denom or in a whitelisted fee token.{{.ExampleHeader}}
+{{.CommandPrefix}} current-base-fee
+{{.CommandPrefix}} current-base-fee --denom=uion`, types.ModuleName),
+		CustomFlagOverrides: map[string]string{
+			"denom": FlagDenom,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetDenom()}},
+	}, &types.QueryCurrentBaseFeeRequest{}
+}
@@ -21,6 +21,11 @@ func (s *QueryTestSuite) SetupSuite() {
 	s.Setup()
 	s.queryClient = types.NewQueryClient(s.QueryHelper)
 
+	// The pools created by the tests hold much less than the default min fee token pool liquidity
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MinFeeTokenPoolLiquidity = sdk.ZeroInt()
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
 	// set up pool
 	poolAssets := []sdk.Coin{
 		sdk.NewInt64Coin("uosmo", 1000000),
@@ -76,6 +81,30 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryFeeTokensRequest{},
 			&types.QueryFeeTokensResponse{},
 		},
+		{
+			"Query params",
+			"/osmosis.txfees.v1beta1.Query/Params",
+			&types.QueryParamsRequest{},
+			&types.QueryParamsResponse{},
+		},
+		{
+			"Query current base fee",
+			"/osmosis.txfees.v1beta1.Query/CurrentBaseFee",
+			&types.QueryCurrentBaseFeeRequest{},
+			&types.QueryCurrentBaseFeeResponse{},
+		},
+		{
+			"Query sponsorship policies",
+			"/osmosis.txfees.v1beta1.Query/SponsorshipPolicies",
+			&types.QuerySponsorshipPoliciesRequest{},
+			&types.QuerySponsorshipPoliciesResponse{},
+		},
+		{
+			"Query sponsored fees",
+			"/osmosis.txfees.v1beta1.Query/SponsoredFees",
+			&types.QuerySponsoredFeesRequest{Sponsor: s.TestAccs[0].String(), User: s.TestAccs[1].String()},
+			&types.QuerySponsoredFeesResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
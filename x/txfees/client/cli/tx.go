@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
 )
 
@@ -22,9 +24,56 @@ const FlagFeeTokens = "fee-tokens"
 
 func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewSetSponsorshipPolicyCmd)
+	osmocli.AddTxCmd(txCmd, NewFundSponsorshipCmd)
+	osmocli.AddTxCmd(txCmd, NewRemoveSponsorshipCmd)
 	return txCmd
 }
 
+func NewSetSponsorshipPolicyCmd() (*osmocli.TxCliDesc, *types.MsgSetSponsorshipPolicy) {
+	return &osmocli.TxCliDesc{
+		Use:   "set-sponsorship-policy [user-quota]",
+		Short: "register the sender as a fee sponsor, or update its sponsorship policy",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} set-sponsorship-policy 1000000uosmo --allowed-messages=/cosmwasm.wasm.v1.MsgExecuteContract --allowed-contracts=osmo1...
+
+Txs that set the sponsor as their fee granter have their fees paid from the sponsor's budget,
+as long as their messages are allowed and the fees paid for their signer stay within the user quota.`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"AllowedMessages":  osmocli.FlagOnlyParser(allowedMessagesParser),
+			"AllowedContracts": osmocli.FlagOnlyParser(allowedContractsParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetAllowedMessages()},
+			OptionalFlags: []*flag.FlagSet{FlagSetAllowedContracts()},
+		},
+	}, &types.MsgSetSponsorshipPolicy{}
+}
+
+func NewFundSponsorshipCmd() (*osmocli.TxCliDesc, *types.MsgFundSponsorship) {
+	return &osmocli.TxCliDesc{
+		Use:   "fund-sponsorship [sponsor] [amount]",
+		Short: "add to the budget from which a fee sponsor pays fees",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fund-sponsorship osmo1... 1000000uosmo`,
+	}, &types.MsgFundSponsorship{}
+}
+
+func NewRemoveSponsorshipCmd() (*osmocli.TxCliDesc, *types.MsgRemoveSponsorship) {
+	return &osmocli.TxCliDesc{
+		Use:   "remove-sponsorship",
+		Short: "remove the sponsorship policy of the sender and refund its remaining budget",
+	}, &types.MsgRemoveSponsorship{}
+}
+
+func allowedMessagesParser(fs *flag.FlagSet) ([]string, error) {
+	return fs.GetStringSlice(FlagAllowedMessages)
+}
+
+func allowedContractsParser(fs *flag.FlagSet) ([]string, error) {
+	return fs.GetStringSlice(FlagAllowedContracts)
+}
+
 func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "update-fee-token [flags]",
@@ -36,6 +85,10 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 Passing in denom,poolID pairs separated by commas would be parsed automatically to pairs of fee token records.
 Ex) uosmo,1,uion,2,ufoo,0 -> [Adds uosmo<>pool1, uion<>pool2, Removes ufoo as a fee token]
 
+A fee token that is not paired directly with the base denom can be given a route instead of a poolID,
+as poolID>tokenOutDenom hops separated by '>'.
+Ex) ubar,3>uatom>1>uosmo -> [Adds ubar, priced and swapped through pool3 to uatom and then through pool1 to uosmo]
+
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -97,14 +150,15 @@ func parseFeeTokenRecords(cmd *cobra.Command) ([]types.FeeToken, error) {
 	i := 0
 	for i < len(feeTokens) {
 		denom := feeTokens[i]
-		poolId, err := strconv.Atoi(feeTokens[i+1])
+		poolId, route, err := parseFeeTokenRoute(feeTokens[i+1])
 		if err != nil {
 			return nil, err
 		}
 
 		feeTokenRecords = append(feeTokenRecords, types.FeeToken{
 			Denom:  denom,
-			PoolID: uint64(poolId),
+			PoolID: poolId,
+			Route:  route,
 		})
 
 		// increase counter by the next 2
@@ -114,6 +168,35 @@ func parseFeeTokenRecords(cmd *cobra.Command) ([]types.FeeToken, error) {
 	return feeTokenRecords, nil
 }
 
+// parseFeeTokenRoute parses either a single pool ID, or a route of poolID>tokenOutDenom hops separated by '>'.
+// It returns the pool ID of the fee token record, which is the first pool of the route if there is one.
+func parseFeeTokenRoute(routeStr string) (uint64, []poolmanagertypes.SwapAmountInRoute, error) {
+	routeParts := strings.Split(routeStr, ">")
+	if len(routeParts) == 1 {
+		poolId, err := strconv.ParseUint(routeParts[0], 10, 64)
+		return poolId, nil, err
+	}
+
+	if len(routeParts)%2 != 0 {
+		return 0, nil, errors.New("fee token routes should be a '>' separated list of poolId and token out denom pairs")
+	}
+
+	route := []poolmanagertypes.SwapAmountInRoute{}
+	for i := 0; i < len(routeParts); i += 2 {
+		poolId, err := strconv.ParseUint(routeParts[i], 10, 64)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		route = append(route, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: routeParts[i+1],
+		})
+	}
+
+	return route[0].PoolId, route, nil
+}
+
 func parseFeeTokenRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
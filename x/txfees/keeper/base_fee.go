@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
+)
+
+// GetCurrentBaseFee returns the base fee, in base denom per unit of gas, that transactions in the current block need to pay.
+// The base fee is kept within the min and max base fee parameters, so that changes to them take effect immediately.
+// If the base fee has not been set yet, the min base fee is returned.
+func (k Keeper) GetCurrentBaseFee(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+
+	baseFee, err := osmoutils.GetDec(ctx.KVStore(k.storeKey), types.BaseFeeKey)
+	if err != nil {
+		return params.MinBaseFee
+	}
+
+	return sdk.MinDec(sdk.MaxDec(baseFee, params.MinBaseFee), params.MaxBaseFee)
+}
+
+// SetCurrentBaseFee sets the base fee, in base denom per unit of gas, that transactions need to pay.
+func (k Keeper) SetCurrentBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.BaseFeeKey, baseFee)
+}
+
+// GetCurrentBaseFeeInDenom returns the current base fee per unit of gas in the given denom, which is either the base denom
+// or a whitelisted fee token. The base fee is converted to a fee token at the twap price of its pool, which is the same
+// price used to check whether a fee paid in that fee token is sufficient.
+func (k Keeper) GetCurrentBaseFeeInDenom(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	baseFee := k.GetCurrentBaseFee(ctx)
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if denom == baseDenom {
+		return baseFee, nil
+	}
+
+	twapPrice, err := k.CalcFeeTwapPrice(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if !twapPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("twap price of fee token %s is not positive", denom)
+	}
+
+	return baseFee.Quo(twapPrice), nil
+}
+
+// UpdateBaseFee adjusts the base fee at the end of a block from the amount of gas the block consumed. The base fee
+// increases when the block consumed more than the target gas per block and decreases when it consumed less,
+// proportionally to the distance from the target:
+//
+// newBaseFee = baseFee * (1 + maxChangeRate * (gasUsed - targetGas) / targetGas)
+//
+// The relative distance from the target is capped at 1, so the base fee changes by at most maxChangeRate per block,
+// and the new base fee is kept within the min and max base fee.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if params.TargetGasPerBlock == 0 {
+		return errors.New("target gas per block must be positive")
+	}
+
+	targetGas := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetGasPerBlock))
+	gasUsedDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+
+	distanceFromTarget := gasUsedDec.Sub(targetGas).Quo(targetGas)
+	distanceFromTarget = sdk.MinDec(distanceFromTarget, sdk.OneDec())
+
+	baseFee := k.GetCurrentBaseFee(ctx)
+	newBaseFee := baseFee.Mul(sdk.OneDec().Add(params.MaxChangeRate.Mul(distanceFromTarget)))
+	newBaseFee = sdk.MinDec(sdk.MaxDec(newBaseFee, params.MinBaseFee), params.MaxBaseFee)
+
+	k.SetCurrentBaseFee(ctx, newBaseFee)
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	targetGas := types.DefaultTargetGasPerBlock
+
+	tests := []struct {
+		name            string
+		baseFee         sdk.Dec
+		gasUsed         uint64
+		expectedBaseFee sdk.Dec
+	}{
+		{
+			name:            "block at the target keeps the base fee",
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			gasUsed:         targetGas,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:            "block at twice the target increases the base fee by the max change rate",
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			gasUsed:         2 * targetGas,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.011"),
+		},
+		{
+			name:            "block above twice the target is capped at the max change rate",
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			gasUsed:         10 * targetGas,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.011"),
+		},
+		{
+			name:            "block at half the target decreases the base fee by half the max change rate",
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			gasUsed:         targetGas / 2,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.0095"),
+		},
+		{
+			name:            "empty block decreases the base fee by the max change rate",
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			gasUsed:         0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.009"),
+		},
+		{
+			name:            "base fee does not go below the min base fee",
+			baseFee:         types.ConsensusMinFee,
+			gasUsed:         0,
+			expectedBaseFee: types.ConsensusMinFee,
+		},
+		{
+			name:            "base fee does not go above the max base fee",
+			baseFee:         types.DefaultMaxBaseFee,
+			gasUsed:         2 * targetGas,
+			expectedBaseFee: types.DefaultMaxBaseFee,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest(false)
+			s.App.TxFeesKeeper.SetCurrentBaseFee(s.Ctx, tc.baseFee)
+
+			err := s.App.TxFeesKeeper.UpdateBaseFee(s.Ctx, tc.gasUsed)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedBaseFee, s.App.TxFeesKeeper.GetCurrentBaseFee(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetCurrentBaseFee() {
+	s.SetupTest(false)
+
+	// The base fee starts at the min base fee
+	s.Require().Equal(types.ConsensusMinFee, s.App.TxFeesKeeper.GetCurrentBaseFee(s.Ctx))
+
+	// Changes to the base fee bounds take effect immediately
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MinBaseFee = sdk.MustNewDecFromStr("0.01")
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+	s.Require().Equal(params.MinBaseFee, s.App.TxFeesKeeper.GetCurrentBaseFee(s.Ctx))
+
+	s.App.TxFeesKeeper.SetCurrentBaseFee(s.Ctx, sdk.NewDec(10))
+	s.Require().Equal(params.MaxBaseFee, s.App.TxFeesKeeper.GetCurrentBaseFee(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestCurrentBaseFeeQuery() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	baseFee := sdk.MustNewDecFromStr("0.01")
+	s.App.TxFeesKeeper.SetCurrentBaseFee(s.Ctx, baseFee)
+
+	// 2 foo for 1 base denom
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 100), sdk.NewInt64Coin("foo", 200))
+	err := s.ExecuteUpgradeFeeTokenProposal("foo", poolId)
+	s.Require().NoError(err)
+
+	// The base fee defaults to the base denom
+	res, err := s.queryClient.CurrentBaseFee(sdk.WrapSDKContext(s.Ctx), &types.QueryCurrentBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(baseDenom, res.Denom)
+	s.Require().Equal(baseFee, res.BaseFee)
+
+	// The base fee is converted to fee tokens at the twap price of their pool
+	s.QueryHelper.Ctx = s.Ctx
+	res, err = s.queryClient.CurrentBaseFee(sdk.WrapSDKContext(s.Ctx), &types.QueryCurrentBaseFeeRequest{Denom: "foo"})
+	s.Require().NoError(err)
+	s.Require().Equal("foo", res.Denom)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.02"), res.BaseFee)
+
+	// A fee of the base fee in foo is sufficient
+	gas := uint64(100000)
+	feeCoin := sdk.NewCoin("foo", res.BaseFee.MulInt64(int64(gas)).Ceil().TruncateInt())
+	err = s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, s.App.TxFeesKeeper.GetCurrentBaseFee(s.Ctx), gas, feeCoin)
+	s.Require().NoError(err)
+
+	// Denoms that are not fee tokens cannot be queried
+	_, err = s.queryClient.CurrentBaseFee(sdk.WrapSDKContext(s.Ctx), &types.QueryCurrentBaseFeeRequest{Denom: "bar"})
+	s.Require().Error(err)
+}
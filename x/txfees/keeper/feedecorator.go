@@ -96,8 +96,9 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 }
 
 func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, simulate bool, feeTx sdk.FeeTx) sdk.Dec {
-	// In block execution (DeliverTx), its set to the governance decided upon consensus min fee.
-	minBaseGasPrice := types.ConsensusMinFee
+	// In block execution (DeliverTx), its set to the current base fee, which is adjusted every block
+	// from the gas consumed by the previous block within governance set bounds.
+	minBaseGasPrice := mfd.TxFeesKeeper.GetCurrentBaseFee(ctx)
 	// If we are in CheckTx, a separate function is ran locally to ensure sufficient fees for entering our mempool.
 	// So we ensure that the provided fees meet a minimum threshold for the validator
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
@@ -110,8 +111,8 @@ func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom str
 	return minBaseGasPrice
 }
 
-// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough osmo at current spot prices
-// to pay the gas cost of this tx.
+// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough osmo to pay the gas cost of this tx.
+// Fee tokens are valued at the geometric twap price of the pools along their route (see CalcFeeTwapPrice).
 func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasRequested uint64, feeCoin sdk.Coin) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -192,6 +193,20 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	// set the fee payer as the default address to deduct fees from
 	deductFeesFrom := feePayer
 
+	// If the fee granter is a fee sponsor, pay the fees from its budget under its sponsorship policy.
+	if feeGranter != nil && !feeGranter.Equals(feePayer) && dfd.txFeesKeeper.HasSponsorshipPolicy(ctx, feeGranter) {
+		err := dfd.txFeesKeeper.DeductSponsoredFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s not allowed to sponsor fees of %s", feeGranter, feePayer)
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
+		)})
+
+		return next(ctx, tx, simulate)
+	}
+
 	// If a fee granter was set, deduct fee from the fee granter's account.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
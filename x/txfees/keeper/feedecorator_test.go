@@ -23,6 +23,8 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
 	baseGas := uint64(10000)
 	consensusMinFeeAmt := int64(25)
+	raisedBaseFee := sdk.MustNewDecFromStr("0.01")
+	raisedBaseFeeAmt := int64(100)
 	point1BaseDenomMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
 		sdk.MustNewDecFromStr("0.1")))
 
@@ -33,6 +35,7 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		name         string
 		txFee        sdk.Coins
 		minGasPrices sdk.DecCoins // if blank, set to 0
+		baseFee      sdk.Dec      // if blank, left at the consensus minimum
 		gasRequested uint64       // if blank, set to base gas
 		isCheckTx    bool
 		isSimulate   bool // if blank, is false
@@ -96,6 +99,34 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 				isCheckTx:    isCheckTx == 1,
 				expectPass:   isCheckTx != 1,
 			},
+			{
+				name:       fmt.Sprintf("LT raised base fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, raisedBaseFeeAmt-1)),
+				baseFee:    raisedBaseFee,
+				isCheckTx:  isCheckTx == 1,
+				expectPass: false,
+			},
+			{
+				name:       fmt.Sprintf("raised base fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, raisedBaseFeeAmt)),
+				baseFee:    raisedBaseFee,
+				isCheckTx:  isCheckTx == 1,
+				expectPass: true,
+			},
+			{
+				name:       fmt.Sprintf("LT raised base fee with converted fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(uion, raisedBaseFeeAmt-1)),
+				baseFee:    raisedBaseFee,
+				isCheckTx:  isCheckTx == 1,
+				expectPass: false,
+			},
+			{
+				name:       fmt.Sprintf("raised base fee with converted fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(uion, raisedBaseFeeAmt)),
+				baseFee:    raisedBaseFee,
+				isCheckTx:  isCheckTx == 1,
+				expectPass: true,
+			},
 			{
 				name:       "invalid fee denom",
 				txFee:      sdk.NewCoins(sdk.NewInt64Coin("moooooo", 1000)),
@@ -167,6 +198,9 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 			if tc.gasRequested == 0 {
 				tc.gasRequested = baseGas
 			}
+			if !tc.baseFee.IsNil() {
+				s.App.TxFeesKeeper.SetCurrentBaseFee(s.Ctx, tc.baseFee)
+			}
 			s.Ctx = s.Ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(tc.minGasPrices)
 
 			// TODO: Cleanup this code.
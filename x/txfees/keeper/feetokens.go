@@ -5,6 +5,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,17 +28,44 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	twapPrice, err := k.CalcFeeTwapPrice(ctx, feeToken.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	return sdk.NewCoin(baseDenom, twapPrice.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// CalcFeeTwapPrice returns the price of the provided fee token in the base denomination, as the product of the
+// geometric twaps of the pools along the fee token's route over the fee token twap window ending at the current
+// block time. Unlike the spot price, the twap cannot be moved significantly by a swap within the current block.
+// This function will error if a pool of the route does not have twap records over the whole window, or if there
+// were spot price errors within the window.
+func (k Keeper) CalcFeeTwapPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	feeToken, err := k.GetFeeToken(ctx, inputDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// The quote asset of every hop of the route is the denom swapped out of its pool
+	swapRoute := feeToken.SwapRoute(baseDenom)
+	twapRoutes := make([]twaptypes.TwapRoute, 0, len(swapRoute))
+	for _, hop := range swapRoute {
+		twapRoutes = append(twapRoutes, twaptypes.TwapRoute{PoolId: hop.PoolId, QuoteAsset: hop.TokenOutDenom})
+	}
+
+	startTime := ctx.BlockTime().Add(-k.GetParams(ctx).FeeTokenTwapWindow)
+	return k.twapKeeper.GetGeometricTwapOverRoute(ctx, feeToken.Denom, twapRoutes, startTime, ctx.BlockTime())
 }
 
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
-// Spot Price Calculation: spotPrice / (1 - spreadFactor),
-// where spotPrice is defined as:
+// The spot price is the product of the spot prices of the pools along the fee token's route, as calculated
+// by the poolmanager for each pool type, where the spot price of a balancer pool is:
 // (tokenBalanceIn / tokenWeightIn) / (tokenBalanceOut / tokenWeightOut)
 func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
@@ -49,11 +78,19 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 		return sdk.Dec{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
-	if err != nil {
-		return sdk.Dec{}, err
+	spotPrice := osmomath.OneDec()
+	hopTokenInDenom := feeToken.Denom
+	for _, hop := range feeToken.SwapRoute(baseDenom) {
+		hopSpotPrice, err := k.poolManager.RouteCalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, hopTokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		spotPrice = spotPrice.Mul(osmomath.BigDecFromSDKDec(hopSpotPrice))
+		hopTokenInDenom = hop.TokenOutDenom
 	}
-	return spotPrice, nil
+
+	return spotPrice.SDKDec(), nil
 }
 
 // GetFeeToken returns the fee token record for a specific denom,
@@ -85,11 +122,19 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 
 // ValidateFeeToken validates that a fee token record is valid
 // It checks:
-// - The denom exists
+// - The record passes stateless validation
 // - The denom is not the base denom
-// - The gamm pool exists
-// - The gamm pool includes the base token and fee token.
+// - The route of the fee token ends in the base denom
+// - Every pool of the route exists, of any pool type
+// - Every pool of the route has liquidity of the denom swapped in and the denom swapped out of it
+// - The spot price of every pool of the route can be calculated
+// - Every pool of the route has at least MinFeeTokenPoolLiquidity of the denom swapped out of it, valued in the
+// base denom at the spot prices of the rest of the route.
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
+	if err := feeToken.Validate(); err != nil {
+		return err
+	}
+
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
 		return err
@@ -97,13 +142,48 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	if baseDenom == feeToken.Denom {
 		return errorsmod.Wrap(types.ErrInvalidFeeToken, "cannot add basedenom as a whitelisted fee token")
 	}
-	// This not returning an error implies that:
-	// - feeToken.Denom exists
-	// - feeToken.PoolID exists
-	// - feeToken.PoolID has both feeToken.Denom and baseDenom
-	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
 
-	return err
+	route := feeToken.SwapRoute(baseDenom)
+	if lastHop := route[len(route)-1]; lastHop.TokenOutDenom != baseDenom {
+		return errorsmod.Wrapf(types.ErrInvalidFeeToken, "route of %s must end in %s, ends in %s", feeToken.Denom, baseDenom, lastHop.TokenOutDenom)
+	}
+
+	hopLiquidity := make([]sdk.Int, len(route))
+	hopSpotPrices := make([]osmomath.BigDec, len(route))
+	hopTokenInDenom := feeToken.Denom
+	for i, hop := range route {
+		liquidity, err := k.poolManager.GetTotalPoolLiquidity(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+		if !liquidity.AmountOf(hopTokenInDenom).IsPositive() || !liquidity.AmountOf(hop.TokenOutDenom).IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidFeeToken, "pool %d does not have liquidity of both %s and %s", hop.PoolId, hopTokenInDenom, hop.TokenOutDenom)
+		}
+
+		spotPrice, err := k.poolManager.RouteCalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, hopTokenInDenom)
+		if err != nil {
+			return err
+		}
+
+		hopLiquidity[i] = liquidity.AmountOf(hop.TokenOutDenom)
+		hopSpotPrices[i] = osmomath.BigDecFromSDKDec(spotPrice)
+		hopTokenInDenom = hop.TokenOutDenom
+	}
+
+	// Walk the route backwards from the base denom, so that the price of the denom swapped out of every hop
+	// is the product of the spot prices of the hops after it.
+	minLiquidity := osmomath.BigDecFromSDKDec(k.GetParams(ctx).MinFeeTokenPoolLiquidity.ToDec())
+	tokenOutPrice := osmomath.OneDec()
+	for i := len(route) - 1; i >= 0; i-- {
+		liquidityValue := osmomath.BigDecFromSDKDec(hopLiquidity[i].ToDec()).Mul(tokenOutPrice)
+		if liquidityValue.LT(minLiquidity) {
+			return errorsmod.Wrapf(types.ErrInvalidFeeToken, "pool %d has %s %s worth of %s liquidity, less than the minimum of %s", route[i].PoolId, liquidityValue.SDKDec().TruncateInt(), baseDenom, route[i].TokenOutDenom, minLiquidity.SDKDec().TruncateInt())
+		}
+
+		tokenOutPrice = tokenOutPrice.Mul(hopSpotPrices[i])
+	}
+
+	return nil
 }
 
 // GetFeeToken returns a unique fee token record for a specific denom.
@@ -125,7 +205,8 @@ func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, erro
 }
 
 // setFeeToken sets a new fee token record for a specific denom.
-// PoolID is just the pool to swap rate between alt fee token and native fee token.
+// PoolID is just the pool to swap rate between alt fee token and native fee token,
+// or the first pool of the route if the record has one.
 // If the feeToken pool ID is 0, deletes the fee Token entry.
 func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	prefixStore := k.GetFeeTokensStore(ctx)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -225,3 +226,134 @@ func (s *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestFeeTokenRoutes() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	// 2 foo for 1 atom, and 1 atom for 2 base denom
+	fooAtomPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 2_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+	atomBasePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin(baseDenom, 2_000_000))
+	atomBaseCLPool := s.PrepareConcentratedPoolWithCoins("uatom", baseDenom)
+	s.CreateFullRangePosition(atomBaseCLPool, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin(baseDenom, 2_000_000)))
+	atomBaseCLPoolId := atomBaseCLPool.GetId()
+	// A pool with 100,000 atom, which is worth 200,000 base denom
+	barAtomSmallPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 200_000), sdk.NewInt64Coin("uatom", 100_000))
+
+	fooRoute := []poolmanagertypes.SwapAmountInRoute{
+		{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+		{PoolId: atomBasePoolId, TokenOutDenom: baseDenom},
+	}
+
+	tests := []struct {
+		name             string
+		feeToken         types.FeeToken
+		minPoolLiquidity int64
+		expectPass       bool
+	}{
+		{
+			name:       "route that does not start at the pool ID",
+			feeToken:   types.FeeToken{Denom: "foo", PoolID: atomBasePoolId, Route: fooRoute},
+			expectPass: false,
+		},
+		{
+			name: "route that does not end in the base denom",
+			feeToken: types.FeeToken{Denom: "foo", PoolID: fooAtomPoolId, Route: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route through a pool without the denom swapped in",
+			feeToken: types.FeeToken{Denom: "foo", PoolID: fooAtomPoolId, Route: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+				{PoolId: fooAtomPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route that goes back through the fee token",
+			feeToken: types.FeeToken{Denom: "foo", PoolID: fooAtomPoolId, Route: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+				{PoolId: fooAtomPoolId, TokenOutDenom: "foo"},
+				{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+			}},
+			expectPass: false,
+		},
+		{
+			name:       "concentrated liquidity pool with the base denom",
+			feeToken:   types.FeeToken{Denom: "uatom", PoolID: atomBaseCLPoolId},
+			expectPass: true,
+		},
+		{
+			name: "route through a balancer and a concentrated liquidity pool",
+			feeToken: types.FeeToken{Denom: "foo", PoolID: fooAtomPoolId, Route: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: fooAtomPoolId, TokenOutDenom: "uatom"},
+				{PoolId: atomBaseCLPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: true,
+		},
+		{
+			name: "route with a pool that has less than the min liquidity of the denom swapped out of it",
+			feeToken: types.FeeToken{Denom: "bar", PoolID: barAtomSmallPoolId, Route: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: barAtomSmallPoolId, TokenOutDenom: "uatom"},
+				{PoolId: atomBasePoolId, TokenOutDenom: baseDenom},
+			}},
+			minPoolLiquidity: 1_000_000,
+			expectPass:       false,
+		},
+		{
+			name:             "proposal to replace the route of a fee token",
+			feeToken:         types.FeeToken{Denom: "foo", PoolID: fooAtomPoolId, Route: fooRoute},
+			minPoolLiquidity: 2_000_000,
+			expectPass:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			params.MinFeeTokenPoolLiquidity = sdk.NewInt(tc.minPoolLiquidity)
+			s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+			err := s.ExecuteUpgradeFeeTokenRecordProposal(tc.feeToken)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				_, err = s.App.TxFeesKeeper.GetFeeToken(s.Ctx, tc.feeToken.Denom)
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			feeToken, err := s.App.TxFeesKeeper.GetFeeToken(s.Ctx, tc.feeToken.Denom)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.feeToken, feeToken)
+		})
+	}
+
+	// 1 foo is worth 0.5 atom, which is worth 1 base denom
+	spotPrice, err := s.App.TxFeesKeeper.CalcFeeSpotPrice(s.Ctx, "foo")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), spotPrice)
+
+	converted, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, sdk.NewInt64Coin("foo", 1000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000), converted)
+
+	// The fees collected in foo are swapped through the route at the end of the epoch, without paying the
+	// taker fee, which would be more than the max fee swap slippage
+	poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	poolManagerParams.TakerFeeParams.DefaultTakerFee = sdk.NewDecWithPrec(1, 1)
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+	s.FundModuleAcc(types.NonNativeFeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)))
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, params.DistrEpochIdentifier, int64(1))
+	s.Require().NoError(err)
+
+	moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	s.Require().Empty(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, baseDenom).Amount.GTE(sdk.NewInt(950)))
+}
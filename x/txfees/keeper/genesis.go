@@ -13,10 +13,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	// The params are set before the fee tokens, which are validated against them
+	k.SetParams(ctx, genState.Params)
 	err = k.SetFeeTokens(ctx, genState.Feetokens)
 	if err != nil {
 		panic(err)
 	}
+	k.SetCurrentBaseFee(ctx, genState.BaseFee)
+	for _, policy := range genState.SponsorshipPolicies {
+		err = k.setSponsorshipPolicy(ctx, policy)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, sponsoredFees := range genState.SponsoredFees {
+		err = k.setSponsoredFees(ctx, sponsoredFees)
+		if err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -24,5 +39,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.BaseFee = k.GetCurrentBaseFee(ctx)
+	genesis.SponsorshipPolicies = k.GetAllSponsorshipPolicies(ctx)
+	genesis.SponsoredFees = k.GetAllSponsoredFees(ctx)
 	return genesis
 }
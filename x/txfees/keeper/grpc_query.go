@@ -82,3 +82,78 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+func (q Querier) CurrentBaseFee(ctx context.Context, req *types.QueryCurrentBaseFeeRequest) (*types.QueryCurrentBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := req.Denom
+	if len(denom) == 0 {
+		baseDenom, err := q.Keeper.GetBaseDenom(sdkCtx)
+		if err != nil {
+			return nil, err
+		}
+		denom = baseDenom
+	}
+
+	baseFee, err := q.Keeper.GetCurrentBaseFeeInDenom(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrentBaseFeeResponse{Denom: denom, BaseFee: baseFee}, nil
+}
+
+func (q Querier) SponsorshipPolicy(ctx context.Context, req *types.QuerySponsorshipPolicyRequest) (*types.QuerySponsorshipPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	policy, err := q.Keeper.GetSponsorshipPolicy(sdkCtx, sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorshipPolicyResponse{Policy: policy}, nil
+}
+
+func (q Querier) SponsorshipPolicies(ctx context.Context, _ *types.QuerySponsorshipPoliciesRequest) (*types.QuerySponsorshipPoliciesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QuerySponsorshipPoliciesResponse{Policies: q.Keeper.GetAllSponsorshipPolicies(sdkCtx)}, nil
+}
+
+func (q Querier) SponsoredFees(ctx context.Context, req *types.QuerySponsoredFeesRequest) (*types.QuerySponsoredFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QuerySponsoredFeesResponse{Fees: q.Keeper.GetSponsoredFees(sdkCtx, sponsor, user)}, nil
+}
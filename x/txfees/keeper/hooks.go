@@ -17,6 +17,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
 	feeTokens := k.GetFeeTokens(ctx)
+	maxSlippage := k.GetParams(ctx).MaxFeeSwapSlippage
 
 	for _, feetoken := range feeTokens {
 		if feetoken.Denom == baseDenom {
@@ -27,14 +28,28 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			continue
 		}
 
+		// The swap must return at least the twap value of the fee tokens, less the max fee swap slippage.
+		// This bounds the amount of osmo that can be lost to a price manipulation of the fee token's pool
+		// around the epoch. If the fee tokens cannot be priced or swapped within the bound, they are kept
+		// in the module account and swapped at the end of a later epoch.
+		twapPrice, err := k.CalcFeeTwapPrice(ctx, feetoken.Denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to price fee token, keeping it until the next epoch", "denom", feetoken.Denom, "error", err)
+			continue
+		}
+		minAmountOut := twapPrice.MulInt(coinBalance.Amount).Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+
 		// Do the swap of this fee token denom to base denom.
 		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.poolManager.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
+			// If the poolmanager has a canonical route registered from the fee token to the base denom, swap through it.
+			// Otherwise, swap through the fee token's route.
+			route, err := k.poolManager.GetDenomPairRoute(cacheCtx, feetoken.Denom, baseDenom)
+			if err != nil {
+				route = feetoken.SwapRoute(baseDenom)
+			}
+
+			// The fee tokens are protocol revenue converted to the base denom, so the swap does not pay the taker fee.
+			_, err = k.poolManager.RouteExactAmountInWithoutTakerFee(cacheCtx, nonNativeFeeAddr, route, coinBalance, minAmountOut)
 			return err
 		})
 	}
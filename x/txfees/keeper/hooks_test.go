@@ -101,7 +101,7 @@ func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 
 			// End of epoch, so all the non-osmo fee amount should be swapped to osmo and transfer to fee module account
 			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
-			futureCtx := s.Ctx.WithBlockTime(time.Now().Add(time.Minute))
+			futureCtx := s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
 			err := s.App.TxFeesKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
 			s.NoError(err)
 
@@ -116,3 +116,84 @@ func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 		})
 	}
 }
+
+// TestTxFeesAfterEpochEnd_DenomPairRoute tests that non-native fees are swapped to the base denom
+// through the route registered in the poolmanager for the fee token, rather than its fee token pool.
+func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd_DenomPairRoute() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	uion, atom := "uion", "atom"
+	feeTokenPoolId, _ := s.preparePool(uion)
+	uionAtomPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(uion, defaultPooledAssetAmount), sdk.NewInt64Coin(atom, defaultPooledAssetAmount))
+	atomBasePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(atom, defaultPooledAssetAmount), sdk.NewInt64Coin(baseDenom, defaultPooledAssetAmount))
+
+	route := []poolmanagertypes.SwapAmountInRoute{
+		{PoolId: uionAtomPoolId, TokenOutDenom: atom},
+		{PoolId: atomBasePoolId, TokenOutDenom: baseDenom},
+	}
+	s.App.PoolManagerKeeper.SetDenomPairRoute(s.Ctx, poolmanagertypes.DenomPairRoute{TokenInDenom: uion, TokenOutDenom: baseDenom, Route: route})
+
+	fee := sdk.NewInt64Coin(uion, 100)
+	expectedOutput, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, fee)
+	s.Require().NoError(err)
+	s.Require().True(expectedOutput.IsPositive())
+
+	// The route goes through two small pools, so allow the swap to have any slippage
+	txFeesParams := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	txFeesParams.MaxFeeSwapSlippage = sdk.OneDec()
+	s.App.TxFeesKeeper.SetParams(s.Ctx, txFeesParams)
+
+	s.FundModuleAcc(types.NonNativeFeeCollectorName, sdk.NewCoins(fee))
+	feeTokenPoolLiquidityBefore, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, feeTokenPoolId)
+	s.Require().NoError(err)
+
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, params.DistrEpochIdentifier, int64(1))
+	s.Require().NoError(err)
+
+	moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	s.Require().Empty(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+
+	moduleAddrFee := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	s.Require().Equal(expectedOutput, s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, baseDenom).Amount)
+
+	// The fee token pool was not swapped through.
+	feeTokenPoolLiquidityAfter, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, feeTokenPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(feeTokenPoolLiquidityBefore, feeTokenPoolLiquidityAfter)
+}
+
+// TestTxFeesAfterEpochEnd_MaxFeeSwapSlippage tests that non-native fees whose swap to the base denom would
+// exceed the max fee swap slippage are kept in the non-native fee collector until a later epoch.
+func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd_MaxFeeSwapSlippage() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	uion := "uion"
+	s.preparePool(uion)
+
+	// Swapping 100 uion in a pool of 500 uion and 500 base denom has more than the default 5% slippage
+	fee := sdk.NewInt64Coin(uion, 100)
+	s.FundModuleAcc(types.NonNativeFeeCollectorName, sdk.NewCoins(fee))
+
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	err := s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, params.DistrEpochIdentifier, int64(1))
+	s.Require().NoError(err)
+
+	moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	s.Require().Equal(sdk.NewCoins(fee), s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, baseDenom).IsZero())
+
+	// Once the slippage bound allows it, the kept fee is swapped at the end of the next epoch
+	txFeesParams := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	txFeesParams.MaxFeeSwapSlippage = sdk.MustNewDecFromStr("0.2")
+	s.App.TxFeesKeeper.SetParams(s.Ctx, txFeesParams)
+
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, params.DistrEpochIdentifier, int64(2))
+	s.Require().NoError(err)
+
+	s.Require().Empty(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+	s.Require().Equal(sdk.NewInt(83), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, baseDenom).Amount)
+}
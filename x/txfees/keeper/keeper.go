@@ -7,17 +7,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
-	accountKeeper       types.AccountKeeper
-	bankKeeper          types.BankKeeper
-	poolManager         types.PoolManager
-	spotPriceCalculator types.SpotPriceCalculator
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	poolManager   types.PoolManager
+	twapKeeper    types.TwapKeeper
+	epochKeeper   types.EpochKeeper
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -26,15 +29,24 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	poolManager types.PoolManager,
-	spotPriceCalculator types.SpotPriceCalculator,
+	twapKeeper types.TwapKeeper,
+	epochKeeper types.EpochKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		accountKeeper:       accountKeeper,
-		bankKeeper:          bankKeeper,
-		storeKey:            storeKey,
-		poolManager:         poolManager,
-		spotPriceCalculator: spotPriceCalculator,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		poolManager:   poolManager,
+		twapKeeper:    twapKeeper,
+		epochKeeper:   epochKeeper,
 	}
 }
 
@@ -46,3 +58,14 @@ func (k Keeper) GetFeeTokensStore(ctx sdk.Context) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.FeeTokensStorePrefix)
 }
+
+// GetParams returns the total set of txfees parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of txfees parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -29,6 +29,11 @@ func (s *KeeperTestSuite) SetupTest(isCheckTx bool) {
 	s.Setup()
 	s.queryClient = types.NewQueryClient(s.QueryHelper)
 
+	// The pools created by the tests hold much less than the default min fee token pool liquidity
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MinFeeTokenPoolLiquidity = sdk.ZeroInt()
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
 	encodingConfig := osmosisapp.MakeEncodingConfig()
 	s.clientCtx = client.Context{}.
 		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
@@ -52,15 +57,24 @@ func (s *KeeperTestSuite) SetupTest(isCheckTx bool) {
 }
 
 func (s *KeeperTestSuite) ExecuteUpgradeFeeTokenProposal(feeToken string, poolId uint64) error {
+	return s.ExecuteUpgradeFeeTokenRecordProposal(types.FeeToken{
+		Denom:  feeToken,
+		PoolID: poolId,
+	})
+}
+
+func (s *KeeperTestSuite) ExecuteUpgradeFeeTokenRecordProposal(feeToken types.FeeToken) error {
 	upgradeProp := types.NewUpdateFeeTokenProposal(
 		"Test Proposal",
 		"test",
-		[]types.FeeToken{
-			{
-				Denom:  feeToken,
-				PoolID: poolId,
-			},
-		},
+		[]types.FeeToken{feeToken},
 	)
-	return s.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(s.Ctx, &upgradeProp)
+	err := upgradeProp.ValidateBasic()
+	if err == nil {
+		err = s.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(s.Ctx, &upgradeProp)
+	}
+
+	// Fee tokens are priced with a twap, so move past the twap window for the pool to have a twap over all of it.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.DefaultFeeTokenTwapWindow))
+	return err
 }
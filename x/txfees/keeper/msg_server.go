@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
+)
+
+type MsgServer struct {
+	k Keeper
+}
+
+// NewMsgServer returns an implementation of the MsgServer interface for the provided Keeper.
+func NewMsgServer(keeper Keeper) types.MsgServer {
+	return MsgServer{k: keeper}
+}
+
+var _ types.MsgServer = MsgServer{}
+
+// SetSponsorshipPolicy registers the sender as a fee sponsor or updates its policy
+func (m MsgServer) SetSponsorshipPolicy(c context.Context, msg *types.MsgSetSponsorshipPolicy) (*types.MsgSetSponsorshipPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.k.SetSponsorshipPolicy(ctx, sponsor, msg.AllowedMessages, msg.AllowedContracts, msg.UserQuota); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSponsorshipPolicyResponse{}, nil
+}
+
+// FundSponsorship adds to the budget of a fee sponsor
+func (m MsgServer) FundSponsorship(c context.Context, msg *types.MsgFundSponsorship) (*types.MsgFundSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.k.FundSponsorship(ctx, sender, sponsor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundSponsorshipResponse{}, nil
+}
+
+// RemoveSponsorship removes the sponsorship policy of the sender and refunds its remaining budget
+func (m MsgServer) RemoveSponsorship(c context.Context, msg *types.MsgRemoveSponsorship) (*types.MsgRemoveSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := m.k.RemoveSponsorship(ctx, sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveSponsorshipResponse{Refunded: refunded}, nil
+}
@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
+)
+
+func (k Keeper) getSponsorshipPoliciesStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsorshipPoliciesPrefix)
+}
+
+func (k Keeper) getSponsoredFeesStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsoredFeesStorePrefix)
+}
+
+// HasSponsorshipPolicy returns true if the given address has registered a sponsorship policy.
+func (k Keeper) HasSponsorshipPolicy(ctx sdk.Context, sponsor sdk.AccAddress) bool {
+	return k.getSponsorshipPoliciesStore(ctx).Has(types.GetSponsorshipPolicyKey(sponsor))
+}
+
+// GetSponsorshipPolicy returns the sponsorship policy of a sponsor.
+// If the sponsor has not registered a policy, returns an error.
+func (k Keeper) GetSponsorshipPolicy(ctx sdk.Context, sponsor sdk.AccAddress) (types.SponsorshipPolicy, error) {
+	bz := k.getSponsorshipPoliciesStore(ctx).Get(types.GetSponsorshipPolicyKey(sponsor))
+	if bz == nil {
+		return types.SponsorshipPolicy{}, errorsmod.Wrapf(types.ErrNoSponsorshipPolicy, "%s", sponsor)
+	}
+
+	policy := types.SponsorshipPolicy{}
+	err := proto.Unmarshal(bz, &policy)
+	if err != nil {
+		return types.SponsorshipPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// setSponsorshipPolicy stores the sponsorship policy of a sponsor, including its budget.
+func (k Keeper) setSponsorshipPolicy(ctx sdk.Context, policy types.SponsorshipPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(policy.Sponsor)
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+
+	k.getSponsorshipPoliciesStore(ctx).Set(types.GetSponsorshipPolicyKey(sponsor), bz)
+	return nil
+}
+
+// GetAllSponsorshipPolicies returns the sponsorship policies of all sponsors.
+func (k Keeper) GetAllSponsorshipPolicies(ctx sdk.Context) []types.SponsorshipPolicy {
+	iterator := k.getSponsorshipPoliciesStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	policies := []types.SponsorshipPolicy{}
+	for ; iterator.Valid(); iterator.Next() {
+		policy := types.SponsorshipPolicy{}
+		err := proto.Unmarshal(iterator.Value(), &policy)
+		if err != nil {
+			panic(err)
+		}
+
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// getSponsorshipQuotaEpoch returns the number of the current epoch of the user quotas.
+func (k Keeper) getSponsorshipQuotaEpoch(ctx sdk.Context) int64 {
+	return k.epochKeeper.GetEpochInfo(ctx, types.SponsorshipQuotaEpochIdentifier).CurrentEpoch
+}
+
+// GetSponsoredFees returns the fees that a sponsor has paid for a user in the current quota epoch.
+// Fees paid in earlier epochs are not returned, as they no longer count towards the user quota.
+func (k Keeper) GetSponsoredFees(ctx sdk.Context, sponsor, user sdk.AccAddress) sdk.Coins {
+	bz := k.getSponsoredFeesStore(ctx).Get(types.GetSponsoredFeesKey(sponsor, user))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	sponsoredFees := types.SponsoredFees{}
+	err := proto.Unmarshal(bz, &sponsoredFees)
+	if err != nil {
+		panic(err)
+	}
+
+	if sponsoredFees.EpochNumber != k.getSponsorshipQuotaEpoch(ctx) {
+		return sdk.NewCoins()
+	}
+
+	return sponsoredFees.Fees
+}
+
+// setSponsoredFees stores the fees that a sponsor has paid for a user in the quota epoch of the record.
+func (k Keeper) setSponsoredFees(ctx sdk.Context, sponsoredFees types.SponsoredFees) error {
+	if err := sponsoredFees.Validate(); err != nil {
+		return err
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(sponsoredFees.Sponsor)
+	if err != nil {
+		return err
+	}
+	user, err := sdk.AccAddressFromBech32(sponsoredFees.User)
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&sponsoredFees)
+	if err != nil {
+		return err
+	}
+
+	k.getSponsoredFeesStore(ctx).Set(types.GetSponsoredFeesKey(sponsor, user), bz)
+	return nil
+}
+
+// GetAllSponsoredFees returns the fees that every sponsor has paid for each of its users, along with the quota
+// epoch in which they were paid.
+func (k Keeper) GetAllSponsoredFees(ctx sdk.Context) []types.SponsoredFees {
+	iterator := k.getSponsoredFeesStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	allSponsoredFees := []types.SponsoredFees{}
+	for ; iterator.Valid(); iterator.Next() {
+		sponsoredFees := types.SponsoredFees{}
+		err := proto.Unmarshal(iterator.Value(), &sponsoredFees)
+		if err != nil {
+			panic(err)
+		}
+
+		allSponsoredFees = append(allSponsoredFees, sponsoredFees)
+	}
+	return allSponsoredFees
+}
+
+// deleteSponsoredFees deletes the fees that a sponsor has paid for all of its users.
+func (k Keeper) deleteSponsoredFees(ctx sdk.Context, sponsor sdk.AccAddress) {
+	store := prefix.NewStore(k.getSponsoredFeesStore(ctx), types.GetSponsoredFeesSponsorPrefix(sponsor))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetSponsorshipPolicy registers the sponsor's policy, or updates it if the sponsor already has one.
+// Updating a policy keeps its budget and the fees already paid for each user.
+func (k Keeper) SetSponsorshipPolicy(ctx sdk.Context, sponsor sdk.AccAddress, allowedMessages, allowedContracts []string, userQuota sdk.Coins) error {
+	policy := types.NewSponsorshipPolicy(sponsor.String(), allowedMessages, allowedContracts, userQuota)
+
+	existingPolicy, err := k.GetSponsorshipPolicy(ctx, sponsor)
+	if err == nil {
+		policy.Budget = existingPolicy.Budget
+	}
+
+	if err := k.setSponsorshipPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetSponsorshipPolicy,
+		sdk.NewAttribute(types.AttributeKeySponsor, policy.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyAllowedMessages, strings.Join(policy.AllowedMessages, ",")),
+		sdk.NewAttribute(types.AttributeKeyAllowedContracts, strings.Join(policy.AllowedContracts, ",")),
+		sdk.NewAttribute(types.AttributeKeyUserQuota, policy.UserQuota.String()),
+	))
+
+	return nil
+}
+
+// FundSponsorship adds the given amount, sent by the sender, to the budget of a sponsor.
+// Anyone can fund a sponsor, but the sponsor must have registered a policy.
+func (k Keeper) FundSponsorship(ctx sdk.Context, sender, sponsor sdk.AccAddress, amount sdk.Coins) error {
+	policy, err := k.GetSponsorshipPolicy(ctx, sponsor)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	policy.Budget = policy.Budget.Add(amount...)
+	if err := k.setSponsorshipPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFundSponsorship,
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeySponsor, policy.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyBudget, policy.Budget.String()),
+	))
+
+	return nil
+}
+
+// RemoveSponsorship deletes the policy of a sponsor and the fees it has paid for each user,
+// and refunds the remaining budget to the sponsor. Returns the refunded amount.
+func (k Keeper) RemoveSponsorship(ctx sdk.Context, sponsor sdk.AccAddress) (sdk.Coins, error) {
+	policy, err := k.GetSponsorshipPolicy(ctx, sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !policy.Budget.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsor, policy.Budget)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.getSponsorshipPoliciesStore(ctx).Delete(types.GetSponsorshipPolicyKey(sponsor))
+	k.deleteSponsoredFees(ctx, sponsor)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRemoveSponsorship,
+		sdk.NewAttribute(types.AttributeKeySponsor, policy.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyAmount, policy.Budget.String()),
+	))
+
+	return policy.Budget, nil
+}
+
+// DeductSponsoredFees pays the fees of a user's tx from the budget of a sponsor, and transfers them to the fee collector
+// for base denom fees or to the non native fee collector otherwise. It returns an error if:
+// - The sponsor has not registered a policy
+// - Any of the tx messages is not allowed by the policy
+// - The fees would take the fees paid by the sponsor for the user in the current day epoch above the policy's user quota
+// - The sponsor's budget does not cover the fees.
+func (k Keeper) DeductSponsoredFees(ctx sdk.Context, sponsor, user sdk.AccAddress, fees sdk.Coins, msgs []sdk.Msg) error {
+	policy, err := k.GetSponsorshipPolicy(ctx, sponsor)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.AllowsMsgs(msgs); err != nil {
+		return err
+	}
+
+	if fees.IsZero() {
+		return nil
+	}
+
+	// Checks the validity of the fee tokens (sorted, have positive amount, valid and unique denomination)
+	if !fees.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+
+	sponsoredFees := k.GetSponsoredFees(ctx, sponsor, user).Add(fees...)
+	if !sponsoredFees.IsAllLTE(policy.UserQuota) {
+		return errorsmod.Wrapf(types.ErrSponsorshipQuotaExceeded, "fees of %s sponsored for %s would exceed the user quota %s", sponsoredFees, user, policy.UserQuota)
+	}
+
+	budget, isNegative := policy.Budget.SafeSub(fees)
+	if isNegative {
+		return errorsmod.Wrapf(types.ErrSponsorshipBudgetExceeded, "budget %s does not cover fees of %s", policy.Budget, fees)
+	}
+	policy.Budget = budget
+
+	if err := k.setSponsorshipPolicy(ctx, policy); err != nil {
+		return err
+	}
+	if err := k.setSponsoredFees(ctx, types.SponsoredFees{
+		Sponsor:     policy.Sponsor,
+		User:        user.String(),
+		Fees:        sponsoredFees,
+		EpochNumber: k.getSponsorshipQuotaEpoch(ctx),
+	}); err != nil {
+		return err
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	// checks if input fee is the base denom (assumes only one fee token exists in the fees array, as in DeductFees)
+	feeCollectorName := types.NonNativeFeeCollectorName
+	if fees[0].Denom == baseDenom {
+		feeCollectorName = types.FeeCollectorName
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, feeCollectorName, fees)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSponsoredFee,
+		sdk.NewAttribute(types.AttributeKeySponsor, policy.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, fees.String()),
+		sdk.NewAttribute(types.AttributeKeyBudget, policy.Budget.String()),
+	))
+
+	return nil
+}
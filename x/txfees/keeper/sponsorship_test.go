@@ -0,0 +1,319 @@
+package keeper_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v17/x/txfees/types"
+)
+
+var testMsgTypeURL = sdk.MsgTypeURL(&testdata.TestMsg{})
+
+func (s *KeeperTestSuite) TestSponsorshipPolicy() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	sponsor, funder := s.TestAccs[0], s.TestAccs[1]
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	userQuota := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))
+	budget := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 5000))
+
+	// A sponsor without a policy cannot be funded
+	err := s.App.TxFeesKeeper.FundSponsorship(s.Ctx, funder, sponsor, budget)
+	s.Require().ErrorIs(err, types.ErrNoSponsorshipPolicy)
+
+	// Register a policy
+	err = s.App.TxFeesKeeper.SetSponsorshipPolicy(s.Ctx, sponsor, []string{testMsgTypeURL}, nil, userQuota)
+	s.Require().NoError(err)
+	s.Require().True(s.App.TxFeesKeeper.HasSponsorshipPolicy(s.Ctx, sponsor))
+
+	// Anyone can fund the sponsor, the budget is escrowed in the module account
+	err = s.App.TxFeesKeeper.FundSponsorship(s.Ctx, funder, sponsor, budget)
+	s.Require().NoError(err)
+	s.Require().Equal(budget, s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddr))
+
+	policy, err := s.App.TxFeesKeeper.GetSponsorshipPolicy(s.Ctx, sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(budget, policy.Budget)
+
+	// Updating the policy keeps its budget
+	newUserQuota := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2000))
+	err = s.App.TxFeesKeeper.SetSponsorshipPolicy(s.Ctx, sponsor, []string{testMsgTypeURL}, []string{funder.String()}, newUserQuota)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.SponsorshipPolicy(sdk.WrapSDKContext(s.Ctx), &types.QuerySponsorshipPolicyRequest{Sponsor: sponsor.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.SponsorshipPolicy{
+		Sponsor:          sponsor.String(),
+		AllowedMessages:  []string{testMsgTypeURL},
+		AllowedContracts: []string{funder.String()},
+		UserQuota:        newUserQuota,
+		Budget:           budget,
+	}, res.Policy)
+
+	// Invalid policies are rejected
+	err = s.App.TxFeesKeeper.SetSponsorshipPolicy(s.Ctx, sponsor, []string{}, nil, userQuota)
+	s.Require().ErrorIs(err, types.ErrInvalidSponsorshipPolicy)
+
+	// Removing the policy refunds the budget to the sponsor
+	sponsorBalance := s.App.BankKeeper.GetBalance(s.Ctx, sponsor, baseDenom)
+	refunded, err := s.App.TxFeesKeeper.RemoveSponsorship(s.Ctx, sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(budget, refunded)
+	s.Require().Equal(sponsorBalance.Add(budget[0]), s.App.BankKeeper.GetBalance(s.Ctx, sponsor, baseDenom))
+	s.Require().False(s.App.TxFeesKeeper.HasSponsorshipPolicy(s.Ctx, sponsor))
+	s.Require().Empty(s.App.TxFeesKeeper.GetAllSponsorshipPolicies(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestDeductSponsoredFees() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	tests := []struct {
+		name             string
+		allowedMessages  []string
+		userQuota        sdk.Coins
+		budget           sdk.Coins
+		alreadySponsored sdk.Coins
+		txFee            sdk.Coins
+		expectedErr      error
+	}{
+		{
+			name:            "fees within the user quota and budget are sponsored",
+			allowedMessages: []string{testMsgTypeURL},
+			userQuota:       sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 5000)),
+			txFee:           sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+		},
+		{
+			name:            "zero fees are sponsored",
+			allowedMessages: []string{testMsgTypeURL},
+			userQuota:       sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:          sdk.NewCoins(),
+			txFee:           sdk.NewCoins(),
+		},
+		{
+			name:            "messages that are not allowed are not sponsored",
+			allowedMessages: []string{sdk.MsgTypeURL(&types.MsgFundSponsorship{})},
+			userQuota:       sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 5000)),
+			txFee:           sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			expectedErr:     types.ErrSponsorshipNotAllowed,
+		},
+		{
+			name:             "fees above the user quota are not sponsored",
+			allowedMessages:  []string{testMsgTypeURL},
+			userQuota:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:           sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 5000)),
+			alreadySponsored: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 950)),
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			expectedErr:      types.ErrSponsorshipQuotaExceeded,
+		},
+		{
+			name:            "fees in a denom without a user quota are not sponsored",
+			allowedMessages: []string{testMsgTypeURL},
+			userQuota:       sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:          sdk.NewCoins(sdk.NewInt64Coin("foo", 5000)),
+			txFee:           sdk.NewCoins(sdk.NewInt64Coin("foo", 100)),
+			expectedErr:     types.ErrSponsorshipQuotaExceeded,
+		},
+		{
+			name:            "fees above the budget are not sponsored",
+			allowedMessages: []string{testMsgTypeURL},
+			userQuota:       sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			budget:          sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 50)),
+			txFee:           sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			expectedErr:     types.ErrSponsorshipBudgetExceeded,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest(false)
+			sponsor := s.TestAccs[0]
+			feeCollectorAddr := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+
+			err := s.App.TxFeesKeeper.SetSponsorshipPolicy(s.Ctx, sponsor, tc.allowedMessages, nil, tc.userQuota)
+			s.Require().NoError(err)
+			if !tc.budget.IsZero() {
+				err = simapp.FundAccount(s.App.BankKeeper, s.Ctx, sponsor, tc.budget)
+				s.Require().NoError(err)
+				err = s.App.TxFeesKeeper.FundSponsorship(s.Ctx, sponsor, sponsor, tc.budget)
+				s.Require().NoError(err)
+			}
+
+			// The user pays its fees through a tx that names the sponsor as fee granter
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			priv0, _, user := testdata.KeyTestPubAddr()
+			s.App.AccountKeeper.SetAccount(s.Ctx, s.App.AccountKeeper.NewAccountWithAddress(s.Ctx, user))
+			if !tc.alreadySponsored.IsZero() {
+				err = s.App.TxFeesKeeper.DeductSponsoredFees(s.Ctx, sponsor, user, tc.alreadySponsored, []sdk.Msg{testdata.NewTestMsg(user)})
+				s.Require().NoError(err)
+			}
+			policy, err := s.App.TxFeesKeeper.GetSponsorshipPolicy(s.Ctx, sponsor)
+			s.Require().NoError(err)
+			feeCollectorBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr)
+
+			signerData := authsigning.SignerData{ChainID: s.Ctx.ChainID()}
+			sigV2, _ := clienttx.SignWithPrivKey(1, signerData, txBuilder, priv0, s.clientCtx.TxConfig, 0)
+			txBuilder.SetFeeGranter(sponsor)
+			tx := s.BuildTx(txBuilder, []sdk.Msg{testdata.NewTestMsg(user)}, sigV2, "", tc.txFee, 10000)
+
+			dfd := keeper.NewDeductFeeDecorator(*s.App.TxFeesKeeper, *s.App.AccountKeeper, *s.App.BankKeeper, nil)
+			_, err = sdk.ChainAnteDecorators(dfd)(s.Ctx, tx, false)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The fees are paid from the budget and are counted towards the user quota
+			newPolicy, err := s.App.TxFeesKeeper.GetSponsorshipPolicy(s.Ctx, sponsor)
+			s.Require().NoError(err)
+			s.Require().Equal(policy.Budget.Sub(tc.txFee).String(), newPolicy.Budget.String())
+			s.Require().Equal(tc.alreadySponsored.Add(tc.txFee...).String(), s.App.TxFeesKeeper.GetSponsoredFees(s.Ctx, sponsor, user).String())
+			s.Require().Equal(feeCollectorBalances.Add(tc.txFee...).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, feeCollectorAddr).String())
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, user).IsZero())
+
+			res, err := s.queryClient.SponsoredFees(sdk.WrapSDKContext(s.Ctx), &types.QuerySponsoredFeesRequest{Sponsor: sponsor.String(), User: user.String()})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.alreadySponsored.Add(tc.txFee...).String(), res.Fees.String())
+
+			// Removing the sponsorship resets the fees sponsored for its users
+			_, err = s.App.TxFeesKeeper.RemoveSponsorship(s.Ctx, sponsor)
+			s.Require().NoError(err)
+			s.Require().True(s.App.TxFeesKeeper.GetSponsoredFees(s.Ctx, sponsor, user).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSponsoredFeesQuotaEpoch() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	sponsor, user := s.TestAccs[0], s.TestAccs[1]
+	userQuota := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))
+	budget := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 5000))
+	msgs := []sdk.Msg{testdata.NewTestMsg(user)}
+
+	err := s.App.TxFeesKeeper.SetSponsorshipPolicy(s.Ctx, sponsor, []string{testMsgTypeURL}, nil, userQuota)
+	s.Require().NoError(err)
+	err = s.App.TxFeesKeeper.FundSponsorship(s.Ctx, sponsor, sponsor, budget)
+	s.Require().NoError(err)
+
+	// The user uses up its quota for the current day epoch
+	err = s.App.TxFeesKeeper.DeductSponsoredFees(s.Ctx, sponsor, user, userQuota, msgs)
+	s.Require().NoError(err)
+	err = s.App.TxFeesKeeper.DeductSponsoredFees(s.Ctx, sponsor, user, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)), msgs)
+	s.Require().ErrorIs(err, types.ErrSponsorshipQuotaExceeded)
+
+	// Once the day epoch ends, the fees already paid no longer count towards the user quota
+	epochInfo := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.SponsorshipQuotaEpochIdentifier)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, types.SponsorshipQuotaEpochIdentifier)
+	epochInfo.CurrentEpoch++
+	err = s.App.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo)
+	s.Require().NoError(err)
+	s.Require().True(s.App.TxFeesKeeper.GetSponsoredFees(s.Ctx, sponsor, user).IsZero())
+
+	err = s.App.TxFeesKeeper.DeductSponsoredFees(s.Ctx, sponsor, user, userQuota, msgs)
+	s.Require().NoError(err)
+	s.Require().Equal(userQuota, s.App.TxFeesKeeper.GetSponsoredFees(s.Ctx, sponsor, user))
+	s.Require().Equal([]types.SponsoredFees{{
+		Sponsor:     sponsor.String(),
+		User:        user.String(),
+		Fees:        userQuota,
+		EpochNumber: epochInfo.CurrentEpoch,
+	}}, s.App.TxFeesKeeper.GetAllSponsoredFees(s.Ctx))
+
+	// The budget is not reset with the user quota
+	policy, err := s.App.TxFeesKeeper.GetSponsorshipPolicy(s.Ctx, sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(budget.Sub(userQuota).Sub(userQuota), policy.Budget)
+}
+
+func (s *KeeperTestSuite) TestSponsorshipPolicyAllowsMsgs() {
+	sender, allowedContract, otherContract := s.TestAccs[0].String(), s.TestAccs[1].String(), s.TestAccs[2].String()
+	wasmMsgTypeURLs := []string{
+		sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}),
+		sdk.MsgTypeURL(&wasmtypes.MsgMigrateContract{}),
+		sdk.MsgTypeURL(&wasmtypes.MsgUpdateAdmin{}),
+		sdk.MsgTypeURL(&wasmtypes.MsgClearAdmin{}),
+		sdk.MsgTypeURL(&wasmtypes.MsgInstantiateContract{}),
+	}
+
+	tests := []struct {
+		name             string
+		allowedContracts []string
+		msg              sdk.Msg
+		expectedErr      error
+	}{
+		{
+			name:             "executing an allowed contract",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgExecuteContract{Sender: sender, Contract: allowedContract},
+		},
+		{
+			name:             "executing a contract that is not allowed",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgExecuteContract{Sender: sender, Contract: otherContract},
+			expectedErr:      types.ErrSponsorshipNotAllowed,
+		},
+		{
+			name:             "migrating a contract that is not allowed",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgMigrateContract{Sender: sender, Contract: otherContract},
+			expectedErr:      types.ErrSponsorshipNotAllowed,
+		},
+		{
+			name:             "updating the admin of a contract that is not allowed",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgUpdateAdmin{Sender: sender, NewAdmin: sender, Contract: otherContract},
+			expectedErr:      types.ErrSponsorshipNotAllowed,
+		},
+		{
+			name:             "clearing the admin of an allowed contract",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgClearAdmin{Sender: sender, Contract: allowedContract},
+		},
+		{
+			name:             "instantiating a contract when only some contracts are allowed",
+			allowedContracts: []string{allowedContract},
+			msg:              &wasmtypes.MsgInstantiateContract{Sender: sender, CodeID: 1},
+			expectedErr:      types.ErrSponsorshipNotAllowed,
+		},
+		{
+			name: "instantiating a contract when any contract is allowed",
+			msg:  &wasmtypes.MsgInstantiateContract{Sender: sender, CodeID: 1},
+		},
+		{
+			name: "migrating any contract when any contract is allowed",
+			msg:  &wasmtypes.MsgMigrateContract{Sender: sender, Contract: otherContract},
+		},
+		{
+			name:             "non wasm messages are not checked against the allowed contracts",
+			allowedContracts: []string{allowedContract},
+			msg:              testdata.NewTestMsg(s.TestAccs[0]),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			policy := types.NewSponsorshipPolicy(sender, append([]string{testMsgTypeURL}, wasmMsgTypeURLs...), tc.allowedContracts, nil)
+
+			err := policy.AllowsMsgs([]sdk.Msg{tc.msg})
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
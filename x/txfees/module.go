@@ -136,6 +136,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 }
 
@@ -167,8 +168,17 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// adjusts the base fee from the gas consumed by the block and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	var gasUsed uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		gasUsed = blockGasMeter.GasConsumedToLimit()
+	}
+
+	if err := am.keeper.UpdateBaseFee(ctx, gasUsed); err != nil {
+		am.keeper.Logger(ctx).Error("failed to update the base fee", "error", err)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
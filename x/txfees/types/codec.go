@@ -3,11 +3,17 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&UpdateFeeTokenProposal{}, "osmosis/UpdateFeeTokenProposal", nil)
+	cdc.RegisterConcrete(&MsgSetSponsorshipPolicy{}, "osmosis/txfees/set-sponsorship-policy", nil)
+	cdc.RegisterConcrete(&MsgFundSponsorship{}, "osmosis/txfees/fund-sponsorship", nil)
+	cdc.RegisterConcrete(&MsgRemoveSponsorship{}, "osmosis/txfees/remove-sponsorship", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -15,9 +21,28 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&UpdateFeeTokenProposal{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetSponsorshipPolicy{},
+		&MsgFundSponsorship{},
+		&MsgRemoveSponsorship{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
 )
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+
+	amino.Seal()
+}
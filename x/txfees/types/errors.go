@@ -11,4 +11,10 @@ var (
 	ErrNoBaseDenom     = errorsmod.Register(ModuleName, 1, "no base denom was set")
 	ErrTooManyFeeCoins = errorsmod.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
 	ErrInvalidFeeToken = errorsmod.Register(ModuleName, 3, "invalid fee token")
+
+	ErrNoSponsorshipPolicy       = errorsmod.Register(ModuleName, 4, "no sponsorship policy")
+	ErrInvalidSponsorshipPolicy  = errorsmod.Register(ModuleName, 5, "invalid sponsorship policy")
+	ErrSponsorshipNotAllowed     = errorsmod.Register(ModuleName, 6, "tx is not allowed by the sponsorship policy")
+	ErrSponsorshipQuotaExceeded  = errorsmod.Register(ModuleName, 7, "sponsorship user quota exceeded")
+	ErrSponsorshipBudgetExceeded = errorsmod.Register(ModuleName, 8, "sponsorship budget exceeded")
 )
@@ -0,0 +1,18 @@
+package types
+
+// event types
+const (
+	TypeEvtSetSponsorshipPolicy = "set_sponsorship_policy"
+	TypeEvtFundSponsorship      = "fund_sponsorship"
+	TypeEvtRemoveSponsorship    = "remove_sponsorship"
+	TypeEvtSponsoredFee         = "sponsored_fee"
+
+	AttributeKeySponsor          = "sponsor"
+	AttributeKeyUser             = "user"
+	AttributeKeySender           = "sender"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyBudget           = "budget"
+	AttributeKeyAllowedMessages  = "allowed_messages"
+	AttributeKeyAllowedContracts = "allowed_contracts"
+	AttributeKeyUserQuota        = "user_quota"
+)
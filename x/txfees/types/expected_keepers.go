@@ -1,10 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v17/x/twap/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
@@ -13,23 +17,30 @@ type SpotPriceCalculator interface {
 	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
 }
 
+// TwapKeeper defines the contract needed to price fee tokens with twaps.
+type TwapKeeper interface {
+	GetGeometricTwapOverRoute(ctx sdk.Context, baseAssetDenom string, routes []twaptypes.TwapRoute, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+}
+
 // PoolManager defines the contract needed for swap related APIs.
 type PoolManager interface {
-	RouteExactAmountIn(
+	RouteExactAmountInWithoutTakerFee(
 		ctx sdk.Context,
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
 		tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
 
-	SwapExactAmountIn(
-		ctx sdk.Context,
-		sender sdk.AccAddress,
-		poolId uint64,
-		tokenIn sdk.Coin,
-		tokenOutDenom string,
-		tokenOutMinAmount sdk.Int,
-	) (sdk.Int, error)
+	RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error)
+
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+
+	GetDenomPairRoute(ctx sdk.Context, tokenInDenom, tokenOutDenom string) ([]poolmanagertypes.SwapAmountInRoute, error)
+}
+
+// EpochKeeper defines the contract needed to retrieve epoch info.
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 }
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
@@ -49,6 +60,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // TxFeesKeeper defines the expected transaction fee keeper
@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// Validate performs stateless validation of a fee token record. It checks:
+// - The denom is valid
+// - If the record has a route, the route starts at the record's pool ID
+// - Every hop of the route has a pool ID and a valid token out denom
+// - The route does not go through the same denom twice, including the fee token denom.
+// It does not check that the pools exist or that the route ends in the base denom. This is done when the record is set.
+func (f FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	if len(f.Route) == 0 {
+		return nil
+	}
+
+	if err := poolmanagertypes.SwapAmountInRoutes(f.Route).Validate(); err != nil {
+		return err
+	}
+
+	if f.Route[0].PoolId != f.PoolID {
+		return errorsmod.Wrapf(ErrInvalidFeeToken, "route of %s must start at pool %d, starts at pool %d", f.Denom, f.PoolID, f.Route[0].PoolId)
+	}
+
+	seenDenoms := map[string]struct{}{f.Denom: {}}
+	for _, hop := range f.Route {
+		if hop.PoolId == 0 {
+			return errorsmod.Wrapf(ErrInvalidFeeToken, "route of %s has a hop without a pool ID", f.Denom)
+		}
+		if _, ok := seenDenoms[hop.TokenOutDenom]; ok {
+			return errorsmod.Wrapf(ErrInvalidFeeToken, "route of %s goes through %s more than once", f.Denom, hop.TokenOutDenom)
+		}
+		seenDenoms[hop.TokenOutDenom] = struct{}{}
+	}
+
+	return nil
+}
+
+// SwapRoute returns the route through which the fee token is priced in and swapped to the base denom.
+// This is the record's route if it has one, or a single hop through its pool ID otherwise.
+func (f FeeToken) SwapRoute(baseDenom string) []poolmanagertypes.SwapAmountInRoute {
+	if len(f.Route) > 0 {
+		return f.Route
+	}
+
+	return []poolmanagertypes.SwapAmountInRoute{{PoolId: f.PoolID, TokenOutDenom: baseDenom}}
+}
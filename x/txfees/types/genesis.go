@@ -1,12 +1,21 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
+		Params:    DefaultParams(),
+		BaseFee:   ConsensusMinFee.Clone(),
+
+		SponsorshipPolicies: []SponsorshipPolicy{},
+		SponsoredFees:       []SponsoredFees{},
 	}
 }
 
@@ -20,11 +29,39 @@ func (gs GenesisState) Validate() error {
 	}
 
 	for _, feeToken := range gs.Feetokens {
-		err := sdk.ValidateDenom(feeToken.Denom)
+		err := feeToken.Validate()
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.LT(gs.Params.MinBaseFee) || gs.BaseFee.GT(gs.Params.MaxBaseFee) {
+		return fmt.Errorf("base fee %s must be between the min base fee %s and the max base fee %s", gs.BaseFee, gs.Params.MinBaseFee, gs.Params.MaxBaseFee)
+	}
+
+	sponsors := map[string]struct{}{}
+	for _, policy := range gs.SponsorshipPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if _, ok := sponsors[policy.Sponsor]; ok {
+			return fmt.Errorf("duplicate sponsorship policy for sponsor %s", policy.Sponsor)
+		}
+		sponsors[policy.Sponsor] = struct{}{}
+	}
+
+	for _, sponsoredFees := range gs.SponsoredFees {
+		if err := sponsoredFees.Validate(); err != nil {
+			return err
+		}
+		if _, ok := sponsors[sponsoredFees.Sponsor]; !ok {
+			return fmt.Errorf("sponsored fees of %s for %s have no sponsorship policy", sponsoredFees.Sponsor, sponsoredFees.User)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	// params are all the parameters of the module
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// base_fee is the current base fee, in base denom per unit of gas
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	// sponsorship_policies are the policies of all the fee sponsors
+	SponsorshipPolicies []SponsorshipPolicy `protobuf:"bytes,5,rep,name=sponsorship_policies,json=sponsorshipPolicies,proto3" json:"sponsorship_policies" yaml:"sponsorship_policies"`
+	// sponsored_fees are the fees that each sponsor has paid for each user
+	SponsoredFees []SponsoredFees `protobuf:"bytes,6,rep,name=sponsored_fees,json=sponsoredFees,proto3" json:"sponsored_fees" yaml:"sponsored_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +85,27 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSponsorshipPolicies() []SponsorshipPolicy {
+	if m != nil {
+		return m.SponsorshipPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredFees() []SponsoredFees {
+	if m != nil {
+		return m.SponsoredFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
@@ -85,22 +115,34 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0, 0x5c,
-	0xbf, 0x02, 0xe6, 0xa5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x47, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xe2, 0x64, 0x3b, 0xfc, 0x45, 0x01, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0xbb, 0x56, 0x3b, 0xeb, 0x0b, 0xc4, 0x55, 0x42, 0xd5, 0x24, 0x64, 0x5d, 0x89,
+	0x87, 0xcd, 0xd0, 0x7a, 0x10, 0xc4, 0x8b, 0x61, 0xa9, 0x17, 0x0f, 0x4b, 0xd6, 0x93, 0x08, 0x4b,
+	0x5e, 0x9e, 0x66, 0x43, 0x9b, 0x4c, 0xe8, 0x33, 0x2e, 0xdb, 0xa3, 0xdf, 0xc0, 0x8f, 0xb5, 0xc7,
+	0x3d, 0x8a, 0x87, 0x20, 0xed, 0x37, 0xe8, 0x17, 0x50, 0x26, 0x99, 0xd0, 0x56, 0x1a, 0xf6, 0x94,
+	0xc9, 0xcc, 0xef, 0xff, 0x32, 0x0f, 0x43, 0x5e, 0x31, 0xcc, 0x18, 0xa6, 0x48, 0xf9, 0xd5, 0x18,
+	0x00, 0xe9, 0xe5, 0x20, 0x04, 0x1e, 0x0c, 0x68, 0x02, 0x39, 0x60, 0x8a, 0x6e, 0x31, 0x63, 0x9c,
+	0x69, 0xcf, 0x24, 0xe5, 0xd6, 0x94, 0x2b, 0xa9, 0xfe, 0x41, 0xc2, 0x12, 0x56, 0x21, 0x54, 0xac,
+	0x6a, 0xba, 0x7f, 0xd4, 0xe2, 0x39, 0x06, 0xe0, 0x6c, 0x02, 0xb9, 0xc4, 0x0e, 0x5b, 0xb0, 0x22,
+	0x98, 0x05, 0x99, 0x4c, 0xee, 0x3b, 0x2d, 0x10, 0x16, 0x2c, 0x47, 0x36, 0xc3, 0x8b, 0xb4, 0xa8,
+	0x49, 0xfb, 0x6f, 0x87, 0x3c, 0xf8, 0x54, 0xb7, 0x3e, 0xe3, 0x01, 0x07, 0xed, 0x05, 0xe9, 0x85,
+	0x01, 0x42, 0x0c, 0x39, 0xcb, 0x74, 0xd5, 0x52, 0x9d, 0x9e, 0xbf, 0xde, 0xd0, 0x4e, 0x48, 0xaf,
+	0xe9, 0x83, 0xfa, 0x1d, 0xab, 0xe3, 0xec, 0x0f, 0x2d, 0x77, 0xf7, 0x35, 0xdd, 0x11, 0xc0, 0x17,
+	0x01, 0x7a, 0x7b, 0xd7, 0xa5, 0xa9, 0xf8, 0x6b, 0xa1, 0xf6, 0x81, 0x74, 0xeb, 0xba, 0x7a, 0xc7,
+	0x52, 0x9d, 0xfd, 0xa1, 0xd1, 0x66, 0x71, 0x5a, 0x51, 0xd2, 0x40, 0x6a, 0xb4, 0x6f, 0xe4, 0xbe,
+	0x28, 0x74, 0x3e, 0x06, 0xd0, 0xf7, 0x44, 0x41, 0xef, 0xa3, 0x38, 0xff, 0x5d, 0x9a, 0xaf, 0x93,
+	0x94, 0x5f, 0x7c, 0x0f, 0xdd, 0x88, 0x65, 0x34, 0xaa, 0x2c, 0xe5, 0xe7, 0x18, 0xe3, 0x09, 0xe5,
+	0xf3, 0x02, 0xd0, 0x3d, 0x81, 0x68, 0x55, 0x9a, 0x8f, 0xe7, 0x41, 0x36, 0x7d, 0x6f, 0x37, 0x3e,
+	0xb6, 0x7f, 0x4f, 0x2c, 0x47, 0x00, 0xda, 0x0f, 0x95, 0x1c, 0x6c, 0x8c, 0xe9, 0xbc, 0x60, 0xd3,
+	0x34, 0x4a, 0x01, 0xf5, 0xbb, 0xd5, 0x6d, 0xdf, 0xb4, 0x55, 0x3d, 0x5b, 0x6b, 0x4e, 0x85, 0x64,
+	0xee, 0x1d, 0x8a, 0x56, 0xab, 0xd2, 0x7c, 0x5e, 0x67, 0xed, 0x32, 0xb5, 0xfd, 0x27, 0xf8, 0x9f,
+	0x2e, 0x05, 0xd4, 0x26, 0xe4, 0x91, 0xdc, 0x86, 0x58, 0xd4, 0x43, 0xbd, 0x5b, 0x85, 0x1f, 0xdd,
+	0x12, 0x0e, 0xf1, 0x08, 0x00, 0xbd, 0x97, 0x32, 0xf8, 0xe9, 0x56, 0xb0, 0xb4, 0xb2, 0xfd, 0x87,
+	0xb8, 0x45, 0x7f, 0xbe, 0x5e, 0x18, 0xea, 0xcd, 0xc2, 0x50, 0xff, 0x2c, 0x0c, 0xf5, 0xe7, 0xd2,
+	0x50, 0x6e, 0x96, 0x86, 0xf2, 0x6b, 0x69, 0x28, 0x5f, 0x87, 0x1b, 0xe3, 0x94, 0xc1, 0xc7, 0xd3,
+	0x20, 0xc4, 0xe6, 0x87, 0x5e, 0x0e, 0xde, 0xd1, 0xab, 0xe6, 0x8d, 0x55, 0xe3, 0x0d, 0xbb, 0xd5,
+	0xb3, 0x7a, 0xfb, 0x6f, 0x00, 0x03, 0x50, 0xee, 0x88, 0x22, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +165,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsoredFees) > 0 {
+		for iNdEx := len(m.SponsoredFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SponsorshipPolicies) > 0 {
+		for iNdEx := len(m.SponsorshipPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorshipPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Feetokens) > 0 {
 		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -174,6 +264,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SponsorshipPolicies) > 0 {
+		for _, e := range m.SponsorshipPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredFees) > 0 {
+		for _, e := range m.SponsoredFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -278,6 +384,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorshipPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorshipPolicies = append(m.SponsorshipPolicies, SponsorshipPolicy{})
+			if err := m.SponsorshipPolicies[len(m.SponsorshipPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredFees = append(m.SponsoredFees, SponsoredFees{})
+			if err := m.SponsoredFees[len(m.SponsoredFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
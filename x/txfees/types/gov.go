@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -44,7 +43,7 @@ func (p *UpdateFeeTokenProposal) ValidateBasic() error {
 	}
 
 	for _, feeToken := range p.Feetokens {
-		if err := sdk.ValidateDenom(feeToken.Denom); err != nil {
+		if err := feeToken.Validate(); err != nil {
 			return err
 		}
 	}
@@ -54,6 +53,10 @@ func (p *UpdateFeeTokenProposal) ValidateBasic() error {
 func (p UpdateFeeTokenProposal) String() string {
 	var b strings.Builder
 	for _, feeToken := range p.Feetokens {
+		if len(feeToken.Route) > 0 {
+			b.WriteString(fmt.Sprintf("(Denom: %s, PoolID: %d, Route: %v) ", feeToken.Denom, feeToken.PoolID, feeToken.Route))
+			continue
+		}
 		b.WriteString(fmt.Sprintf("(Denom: %s, PoolID: %d) ", feeToken.Denom, feeToken.PoolID))
 	}
 
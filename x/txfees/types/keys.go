@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "txfees"
@@ -18,9 +23,31 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// SponsorshipQuotaEpochIdentifier is the epoch over which the fees paid by a sponsor for a user are
+	// counted towards the user quota. The count starts over when the epoch ends.
+	SponsorshipQuotaEpochIdentifier = "day"
 )
 
 var (
-	BaseDenomKey         = []byte("base_denom")
-	FeeTokensStorePrefix = []byte("fee_tokens")
+	BaseDenomKey              = []byte("base_denom")
+	FeeTokensStorePrefix      = []byte("fee_tokens")
+	BaseFeeKey                = []byte("base_fee")
+	SponsorshipPoliciesPrefix = []byte("sponsorship_policies")
+	SponsoredFeesStorePrefix  = []byte("sponsored_fees")
 )
+
+// GetSponsorshipPolicyKey returns the key of the sponsorship policy of a sponsor, within SponsorshipPoliciesPrefix.
+func GetSponsorshipPolicyKey(sponsor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(sponsor)
+}
+
+// GetSponsoredFeesSponsorPrefix returns the prefix of the fees paid by a sponsor for all users, within SponsoredFeesStorePrefix.
+func GetSponsoredFeesSponsorPrefix(sponsor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(sponsor)
+}
+
+// GetSponsoredFeesKey returns the key of the fees paid by a sponsor for a user, within SponsoredFeesStorePrefix.
+func GetSponsoredFeesKey(sponsor, user sdk.AccAddress) []byte {
+	return append(GetSponsoredFeesSponsorPrefix(sponsor), address.MustLengthPrefix(user)...)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgSetSponsorshipPolicy = "set_sponsorship_policy"
+	TypeMsgFundSponsorship      = "fund_sponsorship"
+	TypeMsgRemoveSponsorship    = "remove_sponsorship"
+)
+
+var _ sdk.Msg = &MsgSetSponsorshipPolicy{}
+
+// NewMsgSetSponsorshipPolicy creates a msg to register the sender as a fee sponsor or update its policy
+func NewMsgSetSponsorshipPolicy(sender string, allowedMessages, allowedContracts []string, userQuota sdk.Coins) *MsgSetSponsorshipPolicy {
+	return &MsgSetSponsorshipPolicy{
+		Sender:           sender,
+		AllowedMessages:  allowedMessages,
+		AllowedContracts: allowedContracts,
+		UserQuota:        userQuota,
+	}
+}
+
+func (m MsgSetSponsorshipPolicy) Route() string { return RouterKey }
+func (m MsgSetSponsorshipPolicy) Type() string  { return TypeMsgSetSponsorshipPolicy }
+func (m MsgSetSponsorshipPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return NewSponsorshipPolicy(m.Sender, m.AllowedMessages, m.AllowedContracts, m.UserQuota).Validate()
+}
+
+func (m MsgSetSponsorshipPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetSponsorshipPolicy) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFundSponsorship{}
+
+// NewMsgFundSponsorship creates a msg to add to the budget of a fee sponsor
+func NewMsgFundSponsorship(sender, sponsor string, amount sdk.Coins) *MsgFundSponsorship {
+	return &MsgFundSponsorship{
+		Sender:  sender,
+		Sponsor: sponsor,
+		Amount:  amount,
+	}
+}
+
+func (m MsgFundSponsorship) Route() string { return RouterKey }
+func (m MsgFundSponsorship) Type() string  { return TypeMsgFundSponsorship }
+func (m MsgFundSponsorship) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Sponsor)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sponsor address (%s)", err)
+	}
+
+	if m.Amount.Empty() || !m.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgFundSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFundSponsorship) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRemoveSponsorship{}
+
+// NewMsgRemoveSponsorship creates a msg to remove the sponsorship policy of the sender
+func NewMsgRemoveSponsorship(sender string) *MsgRemoveSponsorship {
+	return &MsgRemoveSponsorship{
+		Sender: sender,
+	}
+}
+
+func (m MsgRemoveSponsorship) Route() string { return RouterKey }
+func (m MsgRemoveSponsorship) Type() string  { return TypeMsgRemoveSponsorship }
+func (m MsgRemoveSponsorship) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRemoveSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveSponsorship) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
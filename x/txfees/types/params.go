@@ -0,0 +1,182 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyMaxBaseFee               = []byte("MaxBaseFee")
+	KeyTargetGasPerBlock        = []byte("TargetGasPerBlock")
+	KeyMaxChangeRate            = []byte("MaxChangeRate")
+	KeyFeeTokenTwapWindow       = []byte("FeeTokenTwapWindow")
+	KeyMaxFeeSwapSlippage       = []byte("MaxFeeSwapSlippage")
+	KeyMinFeeTokenPoolLiquidity = []byte("MinFeeTokenPoolLiquidity")
+)
+
+var (
+	// DefaultMaxBaseFee is 5 uosmo / gas.
+	DefaultMaxBaseFee = sdk.NewDec(5)
+	// DefaultTargetGasPerBlock is slightly above half of the 120M block gas limit.
+	DefaultTargetGasPerBlock = uint64(70_000_000)
+	// DefaultMaxChangeRate lets the base fee change by at most 10% per block.
+	DefaultMaxChangeRate = sdk.NewDecWithPrec(1, 1)
+	// DefaultFeeTokenTwapWindow prices fee tokens with a 5 minute geometric twap.
+	DefaultFeeTokenTwapWindow = 5 * time.Minute
+	// DefaultMaxFeeSwapSlippage lets the collected fee tokens be swapped for at most 5% less than their twap value.
+	DefaultMaxFeeSwapSlippage = sdk.NewDecWithPrec(5, 2)
+	// DefaultMinFeeTokenPoolLiquidity requires every pool of the route of a fee token to hold at least 10,000 OSMO
+	// worth of the denom it swaps into.
+	DefaultMinFeeTokenPoolLiquidity = sdk.NewInt(10_000_000_000)
+)
+
+// ParamKeyTable for the txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(minBaseFee, maxBaseFee sdk.Dec, targetGasPerBlock uint64, maxChangeRate sdk.Dec, feeTokenTwapWindow time.Duration, maxFeeSwapSlippage sdk.Dec, minFeeTokenPoolLiquidity sdk.Int) Params {
+	return Params{
+		MinBaseFee:               minBaseFee,
+		MaxBaseFee:               maxBaseFee,
+		TargetGasPerBlock:        targetGasPerBlock,
+		MaxChangeRate:            maxChangeRate,
+		FeeTokenTwapWindow:       feeTokenTwapWindow,
+		MaxFeeSwapSlippage:       maxFeeSwapSlippage,
+		MinFeeTokenPoolLiquidity: minFeeTokenPoolLiquidity,
+	}
+}
+
+// DefaultParams returns the default txfees module parameters.
+// The min base fee is the consensus min fee, so that the base fee never goes below the fee that was required
+// before the base fee was introduced. It is read when the params are created, since tests override it.
+func DefaultParams() Params {
+	return NewParams(ConsensusMinFee.Clone(), DefaultMaxBaseFee.Clone(), DefaultTargetGasPerBlock, DefaultMaxChangeRate.Clone(), DefaultFeeTokenTwapWindow, DefaultMaxFeeSwapSlippage.Clone(), DefaultMinFeeTokenPoolLiquidity)
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := validateBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+	if p.MinBaseFee.GT(p.MaxBaseFee) {
+		return fmt.Errorf("min base fee %s must not be greater than max base fee %s", p.MinBaseFee, p.MaxBaseFee)
+	}
+	if err := validateTargetGasPerBlock(p.TargetGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+	if err := validateFeeTokenTwapWindow(p.FeeTokenTwapWindow); err != nil {
+		return err
+	}
+	if err := validateMaxFeeSwapSlippage(p.MaxFeeSwapSlippage); err != nil {
+		return err
+	}
+	if err := validateMinFeeTokenPoolLiquidity(p.MinFeeTokenPoolLiquidity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetGasPerBlock, &p.TargetGasPerBlock, validateTargetGasPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxChangeRate, &p.MaxChangeRate, validateMaxChangeRate),
+		paramtypes.NewParamSetPair(KeyFeeTokenTwapWindow, &p.FeeTokenTwapWindow, validateFeeTokenTwapWindow),
+		paramtypes.NewParamSetPair(KeyMaxFeeSwapSlippage, &p.MaxFeeSwapSlippage, validateMaxFeeSwapSlippage),
+		paramtypes.NewParamSetPair(KeyMinFeeTokenPoolLiquidity, &p.MinFeeTokenPoolLiquidity, validateMinFeeTokenPoolLiquidity),
+	}
+}
+
+func validateBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative, got %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetGasPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("target gas per block must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max change rate must be in [0, 1), got %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeTokenTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("fee token twap window must be positive, got %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxFeeSwapSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max fee swap slippage must be in [0, 1], got %s", v)
+	}
+
+	return nil
+}
+
+func validateMinFeeTokenPoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min fee token pool liquidity must be non-negative, got %s", v)
+	}
+
+	return nil
+}
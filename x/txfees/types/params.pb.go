@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the txfees module
+type Params struct {
+	// min_base_fee is the lowest value the base fee, in base denom per unit of
+	// gas, can be adjusted to.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the highest value the base fee, in base denom per unit of
+	// gas, can be adjusted to.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee" yaml:"max_base_fee"`
+	// target_gas_per_block is the amount of gas consumed by a block at which the
+	// base fee stays the same. The base fee increases when more gas than the
+	// target is consumed and decreases when less is consumed.
+	TargetGasPerBlock uint64 `protobuf:"varint,3,opt,name=target_gas_per_block,json=targetGasPerBlock,proto3" json:"target_gas_per_block,omitempty" yaml:"target_gas_per_block"`
+	// max_change_rate is the largest relative change of the base fee from one
+	// block to the next, reached when a block consumes no gas or at least twice
+	// the target.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+	// fee_token_twap_window is the time window of the geometric twap used to
+	// price fee tokens in the base denom.
+	FeeTokenTwapWindow time.Duration `protobuf:"bytes,5,opt,name=fee_token_twap_window,json=feeTokenTwapWindow,proto3,stdduration" json:"fee_token_twap_window" yaml:"fee_token_twap_window"`
+	// max_fee_swap_slippage is the largest fraction of the twap value of the
+	// collected fee tokens that can be lost when swapping them to the base
+	// denom at the end of an epoch. Fee tokens that cannot be swapped within
+	// this bound are kept until the next epoch.
+	MaxFeeSwapSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_fee_swap_slippage,json=maxFeeSwapSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_swap_slippage" yaml:"max_fee_swap_slippage"`
+	// min_fee_token_pool_liquidity is the least liquidity, valued in the base
+	// denom, of the denom that every pool of the route of a fee token swaps
+	// into. Fee tokens whose route has a pool with less liquidity cannot be
+	// whitelisted.
+	MinFeeTokenPoolLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_fee_token_pool_liquidity,json=minFeeTokenPoolLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_token_pool_liquidity" yaml:"min_fee_token_pool_liquidity"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTargetGasPerBlock() uint64 {
+	if m != nil {
+		return m.TargetGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTokenTwapWindow() time.Duration {
+	if m != nil {
+		return m.FeeTokenTwapWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/params.proto", fileDescriptor_fcbfbe8e37bb08e6)
+}
+
+var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xff, 0x2f, 0x41, 0x18, 0x10, 0xc2, 0xb4, 0x95, 0x29, 0x91, 0x1d, 0xb9, 0x12,
+	0xca, 0xa6, 0xb6, 0x52, 0x16, 0x48, 0x2c, 0x4d, 0x09, 0x20, 0x55, 0x28, 0x72, 0x8b, 0x90, 0xd8,
+	0x58, 0xd7, 0xc9, 0x8d, 0x3b, 0x8a, 0xed, 0x19, 0x3c, 0x93, 0xc4, 0x59, 0xf2, 0x06, 0x6c, 0x90,
+	0x78, 0x0e, 0x9e, 0xa2, 0xcb, 0x2e, 0x11, 0x0b, 0x83, 0x92, 0x37, 0xc8, 0x13, 0x20, 0x8f, 0x1d,
+	0x39, 0x88, 0xb2, 0x88, 0x58, 0x25, 0x73, 0xef, 0x99, 0x73, 0xbe, 0xf1, 0x9d, 0x51, 0x0f, 0x29,
+	0x8f, 0x29, 0x27, 0xdc, 0x11, 0xd9, 0x08, 0x91, 0x3b, 0xd3, 0x6e, 0x80, 0x02, 0xba, 0x0e, 0x83,
+	0x14, 0x62, 0x6e, 0xb3, 0x94, 0x0a, 0xaa, 0xed, 0x57, 0x22, 0xbb, 0x14, 0xd9, 0x95, 0xe8, 0x60,
+	0x37, 0xa4, 0x21, 0x95, 0x12, 0xa7, 0xf8, 0x57, 0xaa, 0x0f, 0x8c, 0x90, 0xd2, 0x30, 0x42, 0x47,
+	0xae, 0x82, 0xc9, 0xc8, 0x19, 0x4e, 0x52, 0x10, 0x84, 0x26, 0x65, 0xdf, 0xfa, 0xda, 0x54, 0x9b,
+	0x7d, 0x69, 0xaf, 0x85, 0xea, 0x9d, 0x98, 0x24, 0x7e, 0x00, 0x1c, 0xfd, 0x11, 0xa2, 0xae, 0xb4,
+	0x95, 0xce, 0x2d, 0xf7, 0xc5, 0x65, 0x6e, 0x36, 0xbe, 0xe7, 0xe6, 0xe3, 0x90, 0x88, 0x8b, 0x49,
+	0x60, 0x0f, 0x68, 0xec, 0x0c, 0x24, 0x42, 0xf5, 0x73, 0xc4, 0x87, 0x63, 0x47, 0xcc, 0x19, 0x72,
+	0xfb, 0x04, 0x07, 0xab, 0xdc, 0x7c, 0x30, 0x87, 0x38, 0x7a, 0x66, 0x6d, 0x7a, 0x59, 0x9e, 0x1a,
+	0x93, 0xc4, 0x05, 0x8e, 0x3d, 0x44, 0x19, 0x04, 0x59, 0x1d, 0xf4, 0xdf, 0x3f, 0x06, 0x41, 0xf6,
+	0x5b, 0x10, 0x64, 0xeb, 0xa0, 0xbe, 0xba, 0x2b, 0x20, 0x0d, 0x51, 0xf8, 0x21, 0x70, 0x9f, 0x61,
+	0xea, 0x07, 0x11, 0x1d, 0x8c, 0xf5, 0xff, 0xdb, 0x4a, 0x67, 0xc7, 0x35, 0x57, 0xb9, 0xf9, 0xa8,
+	0xb4, 0xb8, 0x4e, 0x65, 0x79, 0xf7, 0xcb, 0xf2, 0x4b, 0xe0, 0x7d, 0x4c, 0xdd, 0xa2, 0xa6, 0x31,
+	0xf5, 0x5e, 0x11, 0x37, 0xb8, 0x80, 0x24, 0x44, 0x3f, 0x05, 0x81, 0xfa, 0x8e, 0xa4, 0x7f, 0xb5,
+	0x35, 0xfd, 0x7e, 0x4d, 0xbf, 0x61, 0x67, 0x79, 0x77, 0x63, 0xc8, 0x9e, 0xcb, 0x82, 0x07, 0x02,
+	0xb5, 0xa9, 0xba, 0x37, 0x42, 0xf4, 0x05, 0x1d, 0x63, 0xe2, 0x8b, 0x19, 0x30, 0x7f, 0x46, 0x92,
+	0x21, 0x9d, 0xe9, 0x37, 0xda, 0x4a, 0xe7, 0xf6, 0xf1, 0x43, 0xbb, 0x1c, 0xb0, 0xbd, 0x1e, 0xb0,
+	0x7d, 0x52, 0x0d, 0xd8, 0xed, 0x14, 0x48, 0xab, 0xdc, 0x6c, 0x95, 0x41, 0xd7, 0xba, 0x58, 0x5f,
+	0x7e, 0x98, 0x8a, 0xa7, 0x8d, 0x10, 0xcf, 0x8b, 0xd6, 0xf9, 0x0c, 0xd8, 0x3b, 0xd9, 0xd0, 0x3e,
+	0x2a, 0xea, 0x5e, 0xc1, 0x56, 0x6c, 0xe3, 0xc5, 0x06, 0x1e, 0x11, 0xc6, 0x20, 0x44, 0xbd, 0x29,
+	0x0f, 0xfc, 0x66, 0xeb, 0x03, 0xb7, 0xea, 0x03, 0xff, 0x61, 0x6a, 0x79, 0x5a, 0x0c, 0x59, 0x0f,
+	0xf1, 0x6c, 0x06, 0xec, 0xac, 0x2a, 0x6a, 0x9f, 0x15, 0xb5, 0x55, 0x5c, 0xa3, 0x1a, 0x9d, 0x51,
+	0x1a, 0xf9, 0x11, 0xf9, 0x30, 0x21, 0x43, 0x22, 0xe6, 0xfa, 0x4d, 0x89, 0xf2, 0x76, 0x0b, 0x94,
+	0xd7, 0x89, 0x58, 0xe5, 0xe6, 0x61, 0x7d, 0x45, 0xff, 0xe6, 0x6d, 0x79, 0x7a, 0x4c, 0x92, 0x5e,
+	0xf5, 0x61, 0xfa, 0x94, 0x46, 0xa7, 0xeb, 0x96, 0x7b, 0x7a, 0xb9, 0x30, 0x94, 0xab, 0x85, 0xa1,
+	0xfc, 0x5c, 0x18, 0xca, 0xa7, 0xa5, 0xd1, 0xb8, 0x5a, 0x1a, 0x8d, 0x6f, 0x4b, 0xa3, 0xf1, 0xfe,
+	0x78, 0x03, 0xa1, 0x7a, 0xa7, 0x47, 0x11, 0x04, 0x7c, 0xbd, 0x70, 0xa6, 0xdd, 0xa7, 0x4e, 0xb6,
+	0x7e, 0xdf, 0x12, 0x29, 0x68, 0xca, 0xd1, 0x3d, 0xf9, 0x35, 0x00, 0xd7, 0xfa, 0xd4, 0xea, 0xfe,
+	0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFeeTokenPoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinFeeTokenPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxFeeSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxFeeSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FeeTokenTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetGasPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TargetGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.TargetGasPerBlock))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFeeSwapSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinFeeTokenPoolLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGasPerBlock", wireType)
+			}
+			m.TargetGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FeeTokenTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeTokenPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeeTokenPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -372,6 +373,444 @@ func (m *QueryBaseDenomResponse) GetBaseDenom() string {
 	return ""
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryCurrentBaseFeeRequest defines grpc request structure for querying the
+// current base fee. The base fee is returned in the base denom if no denom is
+// specified.
+type QueryCurrentBaseFeeRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryCurrentBaseFeeRequest) Reset()         { *m = QueryCurrentBaseFeeRequest{} }
+func (m *QueryCurrentBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeRequest) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeRequest proto.InternalMessageInfo
+
+func (m *QueryCurrentBaseFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryCurrentBaseFeeResponse defines grpc response structure for querying the
+// current base fee
+type QueryCurrentBaseFeeResponse struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *QueryCurrentBaseFeeResponse) Reset()         { *m = QueryCurrentBaseFeeResponse{} }
+func (m *QueryCurrentBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBaseFeeResponse) ProtoMessage()    {}
+func (*QueryCurrentBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentBaseFeeResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QuerySponsorshipPolicyRequest struct {
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+}
+
+func (m *QuerySponsorshipPolicyRequest) Reset()         { *m = QuerySponsorshipPolicyRequest{} }
+func (m *QuerySponsorshipPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPolicyRequest) ProtoMessage()    {}
+func (*QuerySponsorshipPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *QuerySponsorshipPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPolicyRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPolicyRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPolicyRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+type QuerySponsorshipPolicyResponse struct {
+	Policy SponsorshipPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *QuerySponsorshipPolicyResponse) Reset()         { *m = QuerySponsorshipPolicyResponse{} }
+func (m *QuerySponsorshipPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPolicyResponse) ProtoMessage()    {}
+func (*QuerySponsorshipPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QuerySponsorshipPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPolicyResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPolicyResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPolicyResponse) GetPolicy() SponsorshipPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorshipPolicy{}
+}
+
+type QuerySponsorshipPoliciesRequest struct {
+}
+
+func (m *QuerySponsorshipPoliciesRequest) Reset()         { *m = QuerySponsorshipPoliciesRequest{} }
+func (m *QuerySponsorshipPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoliciesRequest) ProtoMessage()    {}
+func (*QuerySponsorshipPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{14}
+}
+func (m *QuerySponsorshipPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoliciesRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoliciesRequest proto.InternalMessageInfo
+
+type QuerySponsorshipPoliciesResponse struct {
+	Policies []SponsorshipPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies" yaml:"policies"`
+}
+
+func (m *QuerySponsorshipPoliciesResponse) Reset()         { *m = QuerySponsorshipPoliciesResponse{} }
+func (m *QuerySponsorshipPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoliciesResponse) ProtoMessage()    {}
+func (*QuerySponsorshipPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{15}
+}
+func (m *QuerySponsorshipPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoliciesResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoliciesResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPoliciesResponse) GetPolicies() []SponsorshipPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type QuerySponsoredFeesRequest struct {
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+}
+
+func (m *QuerySponsoredFeesRequest) Reset()         { *m = QuerySponsoredFeesRequest{} }
+func (m *QuerySponsoredFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredFeesRequest) ProtoMessage()    {}
+func (*QuerySponsoredFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{16}
+}
+func (m *QuerySponsoredFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredFeesRequest.Merge(m, src)
+}
+func (m *QuerySponsoredFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredFeesRequest proto.InternalMessageInfo
+
+func (m *QuerySponsoredFeesRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *QuerySponsoredFeesRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+type QuerySponsoredFeesResponse struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees" yaml:"fees"`
+}
+
+func (m *QuerySponsoredFeesResponse) Reset()         { *m = QuerySponsoredFeesResponse{} }
+func (m *QuerySponsoredFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredFeesResponse) ProtoMessage()    {}
+func (*QuerySponsoredFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{17}
+}
+func (m *QuerySponsoredFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredFeesResponse.Merge(m, src)
+}
+func (m *QuerySponsoredFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredFeesResponse proto.InternalMessageInfo
+
+func (m *QuerySponsoredFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -381,6 +820,16 @@ func init() {
 	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
 	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.txfees.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.txfees.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryCurrentBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeRequest")
+	proto.RegisterType((*QueryCurrentBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryCurrentBaseFeeResponse")
+	proto.RegisterType((*QuerySponsorshipPolicyRequest)(nil), "osmosis.txfees.v1beta1.QuerySponsorshipPolicyRequest")
+	proto.RegisterType((*QuerySponsorshipPolicyResponse)(nil), "osmosis.txfees.v1beta1.QuerySponsorshipPolicyResponse")
+	proto.RegisterType((*QuerySponsorshipPoliciesRequest)(nil), "osmosis.txfees.v1beta1.QuerySponsorshipPoliciesRequest")
+	proto.RegisterType((*QuerySponsorshipPoliciesResponse)(nil), "osmosis.txfees.v1beta1.QuerySponsorshipPoliciesResponse")
+	proto.RegisterType((*QuerySponsoredFeesRequest)(nil), "osmosis.txfees.v1beta1.QuerySponsoredFeesRequest")
+	proto.RegisterType((*QuerySponsoredFeesResponse)(nil), "osmosis.txfees.v1beta1.QuerySponsoredFeesResponse")
 }
 
 func init() {
@@ -388,46 +837,75 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x33, 0xfd, 0xff, 0x53, 0xc8, 0x54, 0x8a, 0x0e, 0xb6, 0x8d, 0xab, 0x6c, 0xc2, 0xa0,
-	0xa5, 0x54, 0xb2, 0x63, 0x12, 0x45, 0xf0, 0x66, 0x0c, 0x05, 0x41, 0xa4, 0xae, 0x9e, 0x7a, 0x59,
-	0x76, 0x93, 0x37, 0x71, 0x69, 0x92, 0xd9, 0x66, 0x26, 0xa5, 0x41, 0xbc, 0xf8, 0x09, 0x04, 0xc5,
-	0xaf, 0xe0, 0x49, 0x3f, 0x47, 0x8f, 0x05, 0x2f, 0xe2, 0x21, 0x48, 0xe2, 0x27, 0xc8, 0x27, 0x90,
-	0x9d, 0x9d, 0xcd, 0xa6, 0x6d, 0x62, 0x9a, 0x53, 0xb2, 0xf3, 0x3e, 0xf3, 0xbc, 0xcf, 0xbb, 0xf3,
-	0x9b, 0xc5, 0x94, 0x8b, 0x36, 0x17, 0xbe, 0x60, 0xf2, 0xa4, 0x01, 0x20, 0xd8, 0x71, 0xd1, 0x03,
-	0xe9, 0x16, 0xd9, 0x51, 0x0f, 0xba, 0x7d, 0x2b, 0xe8, 0x72, 0xc9, 0xc9, 0xa6, 0xd6, 0x58, 0x91,
-	0xc6, 0xd2, 0x1a, 0xe3, 0x66, 0x93, 0x37, 0xb9, 0x92, 0xb0, 0xf0, 0x5f, 0xa4, 0x36, 0xee, 0x34,
-	0x39, 0x6f, 0xb6, 0x80, 0xb9, 0x81, 0xcf, 0xdc, 0x4e, 0x87, 0x4b, 0x57, 0xfa, 0xbc, 0x23, 0x74,
-	0xd5, 0xd4, 0x55, 0xf5, 0xe4, 0xf5, 0x1a, 0xac, 0xde, 0xeb, 0x2a, 0x81, 0xae, 0xdf, 0x9b, 0x93,
-	0xa7, 0x01, 0x20, 0xf9, 0x21, 0x68, 0x19, 0xdd, 0xc2, 0x1b, 0xaf, 0xc2, 0x84, 0x7b, 0x00, 0x6f,
-	0xc2, 0x65, 0x61, 0xc3, 0x51, 0x0f, 0x84, 0xa4, 0x12, 0x6f, 0x5e, 0x2c, 0x88, 0x80, 0x77, 0x04,
-	0x90, 0x03, 0x8c, 0x1b, 0x00, 0x8e, 0x72, 0x11, 0x59, 0x94, 0xff, 0x6f, 0x67, 0xad, 0x94, 0xb7,
-	0x66, 0x8f, 0x66, 0xc5, 0xdb, 0x2b, 0xb7, 0x4e, 0x07, 0xb9, 0xd4, 0x78, 0x90, 0xbb, 0xd1, 0x77,
-	0xdb, 0xad, 0x27, 0x34, 0x71, 0xa0, 0x76, 0xa6, 0x11, 0xf7, 0xa0, 0x55, 0x6c, 0xa8, 0xae, 0x55,
-	0xe8, 0xf0, 0xf6, 0xeb, 0x80, 0xcb, 0xfd, 0xae, 0x5f, 0x03, 0x9d, 0x89, 0x6c, 0xe3, 0x74, 0x3d,
-	0x2c, 0x64, 0x51, 0x1e, 0xed, 0x64, 0x2a, 0xd7, 0xc7, 0x83, 0xdc, 0xb5, 0xc8, 0x4e, 0x2d, 0x53,
-	0x3b, 0x2a, 0xd3, 0x6f, 0x08, 0xdf, 0x9e, 0x69, 0xa3, 0x27, 0xd8, 0xc5, 0xab, 0x01, 0xe7, 0xad,
-	0xe7, 0x55, 0x65, 0xf4, 0x7f, 0x85, 0x8c, 0x07, 0xb9, 0xf5, 0xc8, 0x28, 0x5c, 0x77, 0xfc, 0x3a,
-	0xb5, 0xb5, 0x82, 0x78, 0x18, 0x8b, 0x80, 0x4b, 0x27, 0x08, 0x1d, 0xb2, 0x2b, 0xaa, 0xf1, 0xb3,
-	0x70, 0x96, 0x5f, 0x83, 0xdc, 0x76, 0xd3, 0x97, 0x6f, 0x7b, 0x9e, 0x55, 0xe3, 0x6d, 0x56, 0x53,
-	0x2f, 0x40, 0xff, 0x14, 0x44, 0xfd, 0x90, 0xc9, 0x7e, 0x00, 0xc2, 0xaa, 0x42, 0x2d, 0x99, 0x3a,
-	0x71, 0xa2, 0x76, 0x46, 0xc4, 0xb9, 0xe8, 0x53, 0xbc, 0x95, 0xc4, 0xdd, 0x0f, 0xfb, 0xd6, 0x97,
-	0x1d, 0x79, 0x0f, 0x67, 0x2f, 0x5b, 0x2c, 0x3f, 0xee, 0x84, 0x87, 0x8a, 0x2b, 0x40, 0x79, 0xc5,
-	0x3c, 0xbc, 0xd4, 0x3c, 0x4c, 0x15, 0xb4, 0xfd, 0x43, 0x8c, 0x3d, 0x57, 0x80, 0x33, 0x9d, 0x73,
-	0x23, 0x99, 0x39, 0xa9, 0x51, 0x3b, 0xe3, 0xc5, 0xbb, 0x4b, 0x9f, 0xd3, 0x38, 0xad, 0x0c, 0xc9,
-	0x17, 0x84, 0x33, 0x13, 0xca, 0x48, 0x61, 0x1e, 0x49, 0x33, 0x31, 0x35, 0xac, 0xab, 0xca, 0xa3,
-	0xb0, 0x74, 0xf7, 0xc3, 0x8f, 0x3f, 0x9f, 0x56, 0xee, 0x12, 0xca, 0xe6, 0xdf, 0x0f, 0x0d, 0x26,
-	0xf9, 0x8e, 0xf0, 0xfa, 0x79, 0x82, 0x48, 0xe9, 0x9f, 0xed, 0x66, 0x52, 0x6b, 0x94, 0x97, 0xda,
-	0xa3, 0x73, 0x96, 0x55, 0xce, 0x02, 0xb9, 0x3f, 0x2f, 0x67, 0x82, 0x92, 0xe3, 0xf5, 0xa3, 0xf7,
-	0x4b, 0xbe, 0x22, 0xbc, 0x36, 0x05, 0x00, 0x61, 0x8b, 0x3b, 0x9f, 0xa3, 0xcd, 0x78, 0x70, 0xf5,
-	0x0d, 0x3a, 0xe7, 0x23, 0x95, 0x93, 0x91, 0xc2, 0xbc, 0x9c, 0x2a, 0x99, 0xa3, 0x39, 0x63, 0xef,
-	0xd4, 0xe3, 0x7b, 0x75, 0xe6, 0x13, 0x92, 0x16, 0x9c, 0xf9, 0x45, 0x14, 0x17, 0x9c, 0xf9, 0x25,
-	0x40, 0x17, 0x9f, 0x79, 0x82, 0x68, 0xe5, 0xc5, 0xe9, 0xd0, 0x44, 0x67, 0x43, 0x13, 0xfd, 0x1e,
-	0x9a, 0xe8, 0xe3, 0xc8, 0x4c, 0x9d, 0x8d, 0xcc, 0xd4, 0xcf, 0x91, 0x99, 0x3a, 0x28, 0x4d, 0x5d,
-	0x76, 0xed, 0x53, 0x68, 0xb9, 0x9e, 0x98, 0x98, 0x1e, 0x17, 0x1f, 0xb3, 0x93, 0xd8, 0x5a, 0x5d,
-	0x7e, 0x6f, 0x55, 0x7d, 0x64, 0xcb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xe6, 0x6d, 0xca,
-	0x1d, 0x06, 0x00, 0x00,
+	// 1084 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x96, 0x34, 0x6d, 0x9e, 0xdb, 0x86, 0x4e, 0x9b, 0x5f, 0x0b, 0xac, 0xcd, 0x14, 0x22,
+	0x93, 0xd6, 0x3b, 0x8d, 0xd3, 0x36, 0x05, 0x21, 0xaa, 0x3a, 0x51, 0x24, 0x24, 0x40, 0x61, 0xc3,
+	0x01, 0x55, 0x08, 0x6b, 0xd7, 0x1e, 0xbb, 0xab, 0xda, 0x9e, 0xad, 0x67, 0x5d, 0xd5, 0x44, 0xb9,
+	0xf4, 0x86, 0x84, 0x10, 0x12, 0x12, 0xff, 0x00, 0x07, 0x24, 0x10, 0x5c, 0xb9, 0x71, 0xe0, 0xd4,
+	0x63, 0x25, 0x2e, 0x88, 0x83, 0x41, 0x09, 0x7f, 0x41, 0xfe, 0x02, 0xb4, 0xb3, 0x6f, 0xd7, 0x76,
+	0xe2, 0x8d, 0xbd, 0x9c, 0x6a, 0xcf, 0x7b, 0xef, 0x7b, 0xdf, 0x9b, 0xf9, 0xfa, 0x3e, 0x07, 0xa8,
+	0x90, 0x4d, 0x21, 0x5d, 0xc9, 0xfc, 0xa7, 0x35, 0xce, 0x25, 0x7b, 0xb2, 0xe6, 0x70, 0xdf, 0x5e,
+	0x63, 0x8f, 0x3b, 0xbc, 0xdd, 0x35, 0xbd, 0xb6, 0xf0, 0x05, 0x59, 0xc0, 0x1c, 0x33, 0xcc, 0x31,
+	0x31, 0x47, 0xbf, 0x5a, 0x17, 0x75, 0xa1, 0x52, 0x58, 0xf0, 0x29, 0xcc, 0xd6, 0x5f, 0xad, 0x0b,
+	0x51, 0x6f, 0x70, 0x66, 0x7b, 0x2e, 0xb3, 0x5b, 0x2d, 0xe1, 0xdb, 0xbe, 0x2b, 0x5a, 0x12, 0xa3,
+	0x06, 0x46, 0xd5, 0x37, 0xa7, 0x53, 0x63, 0xd5, 0x4e, 0x5b, 0x25, 0x44, 0xf1, 0x8a, 0x6a, 0xc6,
+	0x1c, 0x5b, 0xf2, 0x98, 0x4c, 0x45, 0xb8, 0x51, 0xfc, 0xcd, 0x04, 0xbe, 0x35, 0xce, 0x7d, 0xf1,
+	0x88, 0x47, 0x69, 0xd7, 0x12, 0xd2, 0x3c, 0xbb, 0x6d, 0x37, 0x23, 0x2e, 0xf9, 0x84, 0x24, 0xe9,
+	0x89, 0x96, 0x14, 0x6d, 0xf9, 0xd0, 0xf5, 0xc2, 0x4c, 0xba, 0x08, 0xf3, 0x1f, 0x07, 0x17, 0xb2,
+	0xcd, 0xf9, 0x27, 0x41, 0x17, 0x69, 0xf1, 0xc7, 0x1d, 0x2e, 0x7d, 0xea, 0xc3, 0xc2, 0xf1, 0x80,
+	0xaa, 0xe6, 0xe4, 0x01, 0x40, 0x8d, 0xf3, 0xb2, 0x22, 0x25, 0x97, 0xb4, 0xdc, 0x4b, 0xf9, 0x4c,
+	0x31, 0x67, 0x8e, 0xbe, 0x49, 0x33, 0x2a, 0x2f, 0x2d, 0x3f, 0xef, 0x65, 0xa7, 0x8e, 0x7a, 0xd9,
+	0xcb, 0x5d, 0xbb, 0xd9, 0x78, 0x87, 0xf6, 0x11, 0xa8, 0x35, 0x5b, 0x8b, 0x7a, 0xd0, 0x2d, 0xd0,
+	0x55, 0xd7, 0x2d, 0xde, 0x12, 0xcd, 0x5d, 0x4f, 0xf8, 0x3b, 0x6d, 0xb7, 0xc2, 0x91, 0x13, 0x59,
+	0x81, 0xb3, 0xd5, 0x20, 0xb0, 0xa4, 0xe5, 0xb4, 0xfc, 0x6c, 0xe9, 0xe5, 0xa3, 0x5e, 0xf6, 0x42,
+	0x08, 0xa7, 0x8e, 0xa9, 0x15, 0x86, 0xe9, 0xcf, 0x1a, 0xbc, 0x32, 0x12, 0x06, 0x27, 0x58, 0x85,
+	0x19, 0x4f, 0x88, 0xc6, 0xfb, 0x5b, 0x0a, 0x68, 0xba, 0x44, 0x8e, 0x7a, 0xd9, 0x4b, 0x21, 0x50,
+	0x70, 0x5e, 0x76, 0xab, 0xd4, 0xc2, 0x0c, 0xe2, 0x00, 0x48, 0x4f, 0xf8, 0x65, 0x2f, 0x40, 0x58,
+	0x3a, 0xa3, 0x1a, 0x6f, 0x06, 0xb3, 0xfc, 0xd5, 0xcb, 0xae, 0xd4, 0x5d, 0xff, 0x61, 0xc7, 0x31,
+	0x2b, 0xa2, 0xc9, 0xf0, 0x75, 0xc3, 0x7f, 0x0a, 0xb2, 0xfa, 0x88, 0xf9, 0x5d, 0x8f, 0x4b, 0x73,
+	0x8b, 0x57, 0xfa, 0x53, 0xf7, 0x91, 0xa8, 0x35, 0x2b, 0x23, 0x5e, 0xf4, 0x3e, 0x2c, 0xf6, 0xe9,
+	0xee, 0x04, 0x7d, 0xab, 0x69, 0x47, 0xde, 0x86, 0xa5, 0x93, 0x10, 0xe9, 0xc7, 0x8d, 0xf5, 0x50,
+	0xb2, 0x25, 0x57, 0x58, 0x91, 0x1e, 0x3e, 0x82, 0x85, 0xe3, 0x01, 0x84, 0xbf, 0x05, 0x10, 0x68,
+	0xba, 0x3c, 0xc8, 0x73, 0xbe, 0x3f, 0x73, 0x3f, 0x46, 0xad, 0x59, 0x27, 0xaa, 0xa6, 0x57, 0x81,
+	0x28, 0xbc, 0x1d, 0xa5, 0xdb, 0xa8, 0xcb, 0x2e, 0x5c, 0x19, 0x3a, 0xc5, 0x16, 0xef, 0xc2, 0x4c,
+	0xa8, 0x6f, 0x05, 0x9f, 0x29, 0x1a, 0x49, 0x72, 0x0b, 0xeb, 0x4a, 0xd3, 0xc1, 0x03, 0x59, 0x58,
+	0x13, 0x8b, 0x6a, 0xb3, 0xd3, 0x6e, 0xf3, 0x96, 0x1f, 0x4c, 0xb0, 0xcd, 0x53, 0x8b, 0xea, 0xfb,
+	0x48, 0x54, 0xc7, 0x61, 0x90, 0xe3, 0x84, 0x38, 0xe4, 0x33, 0x38, 0xaf, 0xae, 0xa4, 0xc6, 0x23,
+	0x39, 0xdd, 0x4f, 0x2d, 0xa7, 0xb9, 0x81, 0xab, 0xad, 0x71, 0x4e, 0xad, 0x73, 0x4e, 0xc8, 0x86,
+	0x7e, 0x08, 0xaf, 0x29, 0x92, 0xbb, 0xfd, 0xff, 0xe9, 0x3b, 0xa2, 0xe1, 0x56, 0xba, 0xd1, 0xb8,
+	0x37, 0xe0, 0x1c, 0x6e, 0x01, 0x24, 0x3a, 0xa0, 0x06, 0x0c, 0x50, 0x2b, 0x4a, 0xa1, 0x5f, 0x80,
+	0x91, 0x04, 0x87, 0x63, 0x7f, 0x1a, 0x88, 0x2b, 0x38, 0xc1, 0xa7, 0x79, 0x2b, 0xe9, 0x69, 0x4e,
+	0x40, 0x94, 0xe6, 0x71, 0x25, 0x5c, 0x8c, 0xb4, 0x18, 0x9c, 0x2a, 0x29, 0xaa, 0x0f, 0xaf, 0x43,
+	0x76, 0x64, 0x6f, 0x97, 0xc7, 0x72, 0x79, 0xa6, 0x41, 0x2e, 0x39, 0x07, 0x19, 0x7e, 0x0e, 0xe7,
+	0x3d, 0x3c, 0xc3, 0x6d, 0x95, 0x82, 0xe3, 0x22, 0x72, 0x9c, 0x1b, 0xe0, 0xe8, 0x72, 0x49, 0xad,
+	0x18, 0x93, 0xb6, 0x60, 0x79, 0x90, 0x03, 0xaf, 0x6e, 0x73, 0x2e, 0xff, 0xd7, 0x75, 0x93, 0x6b,
+	0x30, 0xdd, 0x91, 0xbc, 0x8d, 0xba, 0x98, 0x3b, 0xea, 0x65, 0x33, 0x61, 0x6a, 0x70, 0x4a, 0x2d,
+	0x15, 0xa4, 0x5f, 0x69, 0xa0, 0x8f, 0x6a, 0x88, 0xe3, 0xb6, 0x60, 0xba, 0xc6, 0xe3, 0x51, 0x97,
+	0xcd, 0x50, 0x42, 0x66, 0x20, 0x90, 0x78, 0xce, 0x4d, 0xe1, 0xb6, 0x4a, 0xf7, 0x70, 0xb4, 0x4c,
+	0xbc, 0x91, 0x25, 0xfd, 0xf1, 0xef, 0x6c, 0x7e, 0x02, 0x15, 0x06, 0xf5, 0xd2, 0x52, 0x7d, 0x8a,
+	0x5f, 0x5f, 0x80, 0xb3, 0x8a, 0x0e, 0xf9, 0x4e, 0x83, 0xd9, 0xd8, 0x2e, 0x48, 0x21, 0xe9, 0x92,
+	0x47, 0xfa, 0x8d, 0x6e, 0x4e, 0x9a, 0x1e, 0x8e, 0x49, 0x57, 0x9f, 0xfd, 0xf1, 0xef, 0xb7, 0x67,
+	0xde, 0x20, 0x94, 0x25, 0xfb, 0x26, 0x3a, 0x0c, 0xf9, 0x45, 0x83, 0x4b, 0xc3, 0x56, 0x40, 0x8a,
+	0xa7, 0xb6, 0x1b, 0x69, 0x3f, 0xfa, 0x7a, 0xaa, 0x1a, 0xe4, 0xb9, 0xae, 0x78, 0x16, 0xc8, 0x75,
+	0x96, 0xec, 0xc9, 0xe8, 0x09, 0x65, 0xa7, 0x1b, 0x2e, 0x4a, 0xf2, 0x83, 0x06, 0x99, 0x81, 0x4d,
+	0x4e, 0xd8, 0xf8, 0xce, 0x43, 0xb6, 0xa1, 0xdf, 0x9c, 0xbc, 0x00, 0x79, 0xde, 0x56, 0x3c, 0x19,
+	0x29, 0x24, 0xf1, 0x54, 0xcc, 0xca, 0x68, 0x18, 0x6c, 0x4f, 0x7d, 0xdd, 0x57, 0x6f, 0x1e, 0x5b,
+	0xc2, 0x98, 0x37, 0x3f, 0xee, 0x29, 0xba, 0x39, 0x69, 0xfa, 0xa4, 0x6f, 0xde, 0xf7, 0x1a, 0xf2,
+	0xa5, 0x06, 0x33, 0xa1, 0x1b, 0x90, 0xd5, 0x53, 0xdb, 0x0c, 0x19, 0x90, 0x7e, 0x7d, 0xa2, 0x5c,
+	0xe4, 0xb3, 0xa2, 0xf8, 0xe4, 0x88, 0xc1, 0x4e, 0xfd, 0x51, 0x46, 0x7e, 0xd2, 0xe0, 0xd2, 0xb0,
+	0x6b, 0x8c, 0xd1, 0xdf, 0x48, 0xa7, 0xd2, 0xd7, 0x53, 0xd5, 0x20, 0xc7, 0x9b, 0x8a, 0xe3, 0x2a,
+	0xc9, 0x27, 0x71, 0xac, 0x84, 0x75, 0xe5, 0xc8, 0x4c, 0xc8, 0xef, 0x1a, 0x5c, 0x3e, 0xb1, 0x08,
+	0xc9, 0xed, 0x53, 0x9b, 0x27, 0xd9, 0x8d, 0x7e, 0x27, 0x6d, 0x19, 0xd2, 0x7e, 0x4f, 0xd1, 0xbe,
+	0x4b, 0xee, 0xb0, 0xf1, 0x3f, 0x65, 0xcb, 0xd1, 0x2a, 0x66, 0x7b, 0x78, 0xba, 0x4f, 0x7e, 0xd3,
+	0xe0, 0xca, 0x08, 0x53, 0x20, 0x1b, 0xa9, 0xf8, 0xf4, 0xad, 0x46, 0xbf, 0x9b, 0xbe, 0x10, 0x47,
+	0xb9, 0xa5, 0x46, 0x31, 0xc9, 0x8d, 0x34, 0xa3, 0x90, 0x5f, 0x35, 0xb8, 0x38, 0xb4, 0xe0, 0xc9,
+	0xda, 0x24, 0x0c, 0x86, 0xdc, 0x47, 0x2f, 0xa6, 0x29, 0x41, 0xba, 0xf7, 0x14, 0xdd, 0xb7, 0xc9,
+	0xc6, 0x18, 0xba, 0xbc, 0x5a, 0xae, 0xf1, 0xc1, 0x3b, 0x67, 0x7b, 0x81, 0x3d, 0xed, 0x97, 0x3e,
+	0x78, 0x7e, 0x60, 0x68, 0x2f, 0x0e, 0x0c, 0xed, 0x9f, 0x03, 0x43, 0xfb, 0xe6, 0xd0, 0x98, 0x7a,
+	0x71, 0x68, 0x4c, 0xfd, 0x79, 0x68, 0x4c, 0x3d, 0x28, 0x0e, 0x58, 0x0b, 0x82, 0x17, 0x1a, 0xb6,
+	0x23, 0xe3, 0x4e, 0x4f, 0xd6, 0x36, 0xd8, 0xd3, 0xa8, 0x9f, 0xb2, 0x1a, 0x67, 0x46, 0xfd, 0x9d,
+	0xb2, 0xfe, 0xdf, 0x00, 0x43, 0xe6, 0xfb, 0x95, 0xcf, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +930,20 @@ type QueryClient interface {
 	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+	// Params returns the parameters of the txfees module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CurrentBaseFee returns the base fee per unit of gas that transactions
+	// currently need to pay, in the base denom or in the given fee token.
+	CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error)
+	// SponsorshipPolicy returns the sponsorship policy of a fee sponsor,
+	// including its remaining budget.
+	SponsorshipPolicy(ctx context.Context, in *QuerySponsorshipPolicyRequest, opts ...grpc.CallOption) (*QuerySponsorshipPolicyResponse, error)
+	// SponsorshipPolicies returns the sponsorship policies of all the fee
+	// sponsors.
+	SponsorshipPolicies(ctx context.Context, in *QuerySponsorshipPoliciesRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoliciesResponse, error)
+	// SponsoredFees returns the fees that a fee sponsor has paid for the txs of
+	// a user in the current day epoch.
+	SponsoredFees(ctx context.Context, in *QuerySponsoredFeesRequest, opts ...grpc.CallOption) (*QuerySponsoredFeesResponse, error)
 }
 
 type queryClient struct {
@@ -498,6 +990,51 @@ func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest,
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentBaseFee(ctx context.Context, in *QueryCurrentBaseFeeRequest, opts ...grpc.CallOption) (*QueryCurrentBaseFeeResponse, error) {
+	out := new(QueryCurrentBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/CurrentBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipPolicy(ctx context.Context, in *QuerySponsorshipPolicyRequest, opts ...grpc.CallOption) (*QuerySponsorshipPolicyResponse, error) {
+	out := new(QuerySponsorshipPolicyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/SponsorshipPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipPolicies(ctx context.Context, in *QuerySponsorshipPoliciesRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoliciesResponse, error) {
+	out := new(QuerySponsorshipPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/SponsorshipPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsoredFees(ctx context.Context, in *QuerySponsoredFeesRequest, opts ...grpc.CallOption) (*QuerySponsoredFeesResponse, error) {
+	out := new(QuerySponsoredFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/SponsoredFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -510,6 +1047,20 @@ type QueryServer interface {
 	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+	// Params returns the parameters of the txfees module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CurrentBaseFee returns the base fee per unit of gas that transactions
+	// currently need to pay, in the base denom or in the given fee token.
+	CurrentBaseFee(context.Context, *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error)
+	// SponsorshipPolicy returns the sponsorship policy of a fee sponsor,
+	// including its remaining budget.
+	SponsorshipPolicy(context.Context, *QuerySponsorshipPolicyRequest) (*QuerySponsorshipPolicyResponse, error)
+	// SponsorshipPolicies returns the sponsorship policies of all the fee
+	// sponsors.
+	SponsorshipPolicies(context.Context, *QuerySponsorshipPoliciesRequest) (*QuerySponsorshipPoliciesResponse, error)
+	// SponsoredFees returns the fees that a fee sponsor has paid for the txs of
+	// a user in the current day epoch.
+	SponsoredFees(context.Context, *QuerySponsoredFeesRequest) (*QuerySponsoredFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -528,6 +1079,21 @@ func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CurrentBaseFee(ctx context.Context, req *QueryCurrentBaseFeeRequest) (*QueryCurrentBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) SponsorshipPolicy(ctx context.Context, req *QuerySponsorshipPolicyRequest) (*QuerySponsorshipPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPolicy not implemented")
+}
+func (*UnimplementedQueryServer) SponsorshipPolicies(ctx context.Context, req *QuerySponsorshipPoliciesRequest) (*QuerySponsorshipPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPolicies not implemented")
+}
+func (*UnimplementedQueryServer) SponsoredFees(ctx context.Context, req *QuerySponsoredFeesRequest) (*QuerySponsoredFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsoredFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -605,6 +1171,96 @@ func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/CurrentBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentBaseFee(ctx, req.(*QueryCurrentBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/SponsorshipPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPolicy(ctx, req.(*QuerySponsorshipPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/SponsorshipPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPolicies(ctx, req.(*QuerySponsorshipPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsoredFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsoredFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsoredFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/SponsoredFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsoredFees(ctx, req.(*QuerySponsoredFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -625,6 +1281,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseDenom",
 			Handler:    _Query_BaseDenom_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CurrentBaseFee",
+			Handler:    _Query_CurrentBaseFee_Handler,
+		},
+		{
+			MethodName: "SponsorshipPolicy",
+			Handler:    _Query_SponsorshipPolicy_Handler,
+		},
+		{
+			MethodName: "SponsorshipPolicies",
+			Handler:    _Query_SponsorshipPolicies_Handler,
+		},
+		{
+			MethodName: "SponsoredFees",
+			Handler:    _Query_SponsoredFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -869,122 +1545,1043 @@ func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCurrentBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCurrentBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
+func (m *QueryCurrentBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBaseDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCurrentBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *QueryBaseDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorshipPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorshipPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySponsorshipPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySponsoredFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsoredFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,10 +2604,10 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1034,7 +2631,139 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,15 +2786,15 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1092,8 +2821,7 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeTokens = append(m.FeeTokens, FeeToken{})
-			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1118,7 +2846,7 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,10 +2869,10 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1200,7 +2928,7 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +2951,17 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,14 +2971,27 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,7 +3019,7 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1303,7 +3044,7 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,15 +3067,15 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1362,7 +3103,7 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1385,7 +3126,7 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1408,17 +3149,17 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			m.PoolID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1428,11 +3169,25 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1454,7 +3209,7 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1477,10 +3232,10 @@ func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1504,7 +3259,7 @@ func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1527,15 +3282,99 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, SponsorshipPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsoredFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsoredFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsoredFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1563,7 +3402,123 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsoredFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsoredFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsoredFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
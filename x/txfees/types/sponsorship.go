@@ -0,0 +1,128 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// NewSponsorshipPolicy returns a sponsorship policy with an empty budget.
+func NewSponsorshipPolicy(sponsor string, allowedMessages, allowedContracts []string, userQuota sdk.Coins) SponsorshipPolicy {
+	return SponsorshipPolicy{
+		Sponsor:          sponsor,
+		AllowedMessages:  allowedMessages,
+		AllowedContracts: allowedContracts,
+		UserQuota:        userQuota,
+		Budget:           sdk.NewCoins(),
+	}
+}
+
+// Validate performs stateless validation of a sponsorship policy. It checks:
+// - The sponsor and the allowed contracts are valid addresses
+// - There is at least one allowed message type, and the allowed message types are type URLs
+// - There are no duplicate allowed message types or contracts
+// - The user quota is valid and not empty, and the budget is valid.
+func (p SponsorshipPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sponsor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid sponsor address (%s)", err)
+	}
+
+	if len(p.AllowedMessages) == 0 {
+		return errorsmod.Wrap(ErrInvalidSponsorshipPolicy, "at least one message type must be allowed")
+	}
+	for _, msgTypeURL := range p.AllowedMessages {
+		if !strings.HasPrefix(msgTypeURL, "/") {
+			return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "allowed message %s is not a type URL", msgTypeURL)
+		}
+	}
+	if osmoutils.ContainsDuplicate(p.AllowedMessages) {
+		return errorsmod.Wrap(ErrInvalidSponsorshipPolicy, "duplicate allowed messages")
+	}
+
+	for _, contract := range p.AllowedContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid allowed contract address (%s)", err)
+		}
+	}
+	if osmoutils.ContainsDuplicate(p.AllowedContracts) {
+		return errorsmod.Wrap(ErrInvalidSponsorshipPolicy, "duplicate allowed contracts")
+	}
+
+	if p.UserQuota.Empty() || !p.UserQuota.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid user quota (%s)", p.UserQuota)
+	}
+
+	if !p.Budget.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid budget (%s)", p.Budget)
+	}
+
+	return nil
+}
+
+// wasmMsgTypeURLPrefix is the type URL prefix of the x/wasm messages.
+const wasmMsgTypeURLPrefix = "/cosmwasm.wasm."
+
+// AllowsMsgs returns an error if any of the given messages cannot be sponsored under the policy, either because
+// its type is not allowed or because it acts on a contract that is not allowed. When the policy allows only some
+// contracts, wasm messages that do not act on an existing contract, such as instantiating or storing code, are not
+// sponsored either.
+func (p SponsorshipPolicy) AllowsMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if !osmoutils.Contains(p.AllowedMessages, msgTypeURL) {
+			return errorsmod.Wrapf(ErrSponsorshipNotAllowed, "message %s is not allowed", msgTypeURL)
+		}
+
+		if len(p.AllowedContracts) == 0 || !strings.HasPrefix(msgTypeURL, wasmMsgTypeURLPrefix) {
+			continue
+		}
+		contract, ok := getWasmMsgContract(msg)
+		if !ok {
+			return errorsmod.Wrapf(ErrSponsorshipNotAllowed, "message %s does not act on an allowed contract", msgTypeURL)
+		}
+		if !osmoutils.Contains(p.AllowedContracts, contract) {
+			return errorsmod.Wrapf(ErrSponsorshipNotAllowed, "contract %s is not allowed", contract)
+		}
+	}
+
+	return nil
+}
+
+// getWasmMsgContract returns the contract that a wasm message acts on, and false if the message does not name one.
+func getWasmMsgContract(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *wasmtypes.MsgExecuteContract:
+		return msg.Contract, true
+	case *wasmtypes.MsgMigrateContract:
+		return msg.Contract, true
+	case *wasmtypes.MsgUpdateAdmin:
+		return msg.Contract, true
+	case *wasmtypes.MsgClearAdmin:
+		return msg.Contract, true
+	default:
+		return "", false
+	}
+}
+
+// Validate performs stateless validation of the fees paid by a sponsor for a user.
+func (f SponsoredFees) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Sponsor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid sponsor address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(f.User); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid user address (%s)", err)
+	}
+	if !f.Fees.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "invalid sponsored fees (%s)", f.Fees)
+	}
+	if f.EpochNumber < 0 {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipPolicy, "negative sponsored fees epoch number (%d)", f.EpochNumber)
+	}
+
+	return nil
+}
@@ -0,0 +1,1004 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/sponsorship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SponsorshipPolicy is the policy under which a sponsor, an account or a
+// contract, pays the fees of the txs of other accounts. A tx is sponsored when
+// it names the sponsor as its fee granter. The fees are paid out of the
+// sponsor's budget, which is held by the txfees module account.
+type SponsorshipPolicy struct {
+	// sponsor is the address of the account or contract that pays the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	// allowed_messages are the type URLs of the messages that can be sponsored.
+	// Every message of a sponsored tx must be of one of these types.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty" yaml:"allowed_messages"`
+	// allowed_contracts are the contracts that the wasm messages of sponsored txs
+	// can act on. If it is empty, sponsored txs can act on any contract. If it is
+	// set, wasm messages that do not act on an existing contract, such as
+	// instantiating a contract, are not sponsored.
+	AllowedContracts []string `protobuf:"bytes,3,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty" yaml:"allowed_contracts"`
+	// user_quota is the total amount of fees that the sponsor pays for the txs of
+	// each user in every day epoch.
+	UserQuota github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=user_quota,json=userQuota,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_quota" yaml:"user_quota"`
+	// budget is the amount of fees that the sponsor has left to pay.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget" yaml:"budget"`
+}
+
+func (m *SponsorshipPolicy) Reset()         { *m = SponsorshipPolicy{} }
+func (m *SponsorshipPolicy) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipPolicy) ProtoMessage()    {}
+func (*SponsorshipPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0dcad2987de163d, []int{0}
+}
+func (m *SponsorshipPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipPolicy.Merge(m, src)
+}
+func (m *SponsorshipPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipPolicy proto.InternalMessageInfo
+
+func (m *SponsorshipPolicy) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsorshipPolicy) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *SponsorshipPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *SponsorshipPolicy) GetUserQuota() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserQuota
+	}
+	return nil
+}
+
+func (m *SponsorshipPolicy) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+// SponsoredFees are the fees that a sponsor has paid for the txs of a user in
+// the current day epoch.
+type SponsoredFees struct {
+	Sponsor string                                   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	User    string                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	Fees    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees" yaml:"fees"`
+	// epoch_number is the number of the day epoch in which the fees were paid.
+	// The fees no longer count towards the user quota once the epoch has ended.
+	EpochNumber int64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+}
+
+func (m *SponsoredFees) Reset()         { *m = SponsoredFees{} }
+func (m *SponsoredFees) String() string { return proto.CompactTextString(m) }
+func (*SponsoredFees) ProtoMessage()    {}
+func (*SponsoredFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0dcad2987de163d, []int{1}
+}
+func (m *SponsoredFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredFees.Merge(m, src)
+}
+func (m *SponsoredFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredFees proto.InternalMessageInfo
+
+func (m *SponsoredFees) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsoredFees) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SponsoredFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *SponsoredFees) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SponsorshipPolicy)(nil), "osmosis.txfees.v1beta1.SponsorshipPolicy")
+	proto.RegisterType((*SponsoredFees)(nil), "osmosis.txfees.v1beta1.SponsoredFees")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/sponsorship.proto", fileDescriptor_a0dcad2987de163d)
+}
+
+var fileDescriptor_a0dcad2987de163d = []byte{
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0xe3, 0x50, 0x94, 0x0b, 0xa5, 0xc4, 0x20, 0x6a, 0x0a, 0xb2, 0x23, 0xb3, 0x78, 0xa0,
+	0x3e, 0xa5, 0x0c, 0x48, 0x59, 0x10, 0xae, 0xa8, 0x84, 0x04, 0x08, 0xcc, 0xc6, 0x12, 0xd9, 0xce,
+	0xe1, 0x58, 0xd8, 0x3e, 0xe3, 0x77, 0x2e, 0xcd, 0xc4, 0x57, 0x60, 0xe0, 0x03, 0x30, 0xf3, 0x49,
+	0x3a, 0x96, 0x8d, 0xc9, 0xa0, 0x64, 0x61, 0xf6, 0x27, 0x40, 0xbe, 0x3b, 0x37, 0xa5, 0x4b, 0x95,
+	0x29, 0xb9, 0xf7, 0x7e, 0x7f, 0xee, 0xfd, 0xce, 0x0f, 0xd9, 0x14, 0x52, 0x0a, 0x31, 0x60, 0x76,
+	0xf2, 0x81, 0x10, 0xc0, 0xc7, 0xe3, 0x80, 0x30, 0x7f, 0x8c, 0x21, 0xa7, 0x19, 0xd0, 0x02, 0xe6,
+	0x71, 0xee, 0xe4, 0x05, 0x65, 0x54, 0xbb, 0x2b, 0x91, 0x8e, 0x40, 0x3a, 0x12, 0xb9, 0x77, 0x27,
+	0xa2, 0x11, 0xe5, 0x10, 0xdc, 0xfc, 0x13, 0xe8, 0x3d, 0x23, 0xe4, 0x70, 0x1c, 0xf8, 0x40, 0xce,
+	0x45, 0x43, 0x1a, 0x67, 0xa2, 0x6f, 0xfd, 0x54, 0xd1, 0xf0, 0xdd, 0xda, 0xe3, 0x0d, 0x4d, 0xe2,
+	0x70, 0xa1, 0x3d, 0x42, 0xd7, 0xa5, 0xb1, 0xae, 0x8c, 0x14, 0xbb, 0xef, 0x6a, 0x75, 0x65, 0xde,
+	0x5c, 0xf8, 0x69, 0x32, 0xb1, 0x64, 0xc3, 0xf2, 0x5a, 0x88, 0x76, 0x84, 0x6e, 0xf9, 0x49, 0x42,
+	0x3f, 0x93, 0xd9, 0x34, 0x25, 0x00, 0x7e, 0x44, 0x40, 0xef, 0x8e, 0x54, 0xbb, 0xef, 0xde, 0xaf,
+	0x2b, 0x73, 0x57, 0xd0, 0x2e, 0x23, 0x2c, 0x6f, 0x47, 0x96, 0x5e, 0xc9, 0x8a, 0xf6, 0x02, 0x0d,
+	0x5b, 0x54, 0x48, 0x33, 0x56, 0xf8, 0x21, 0x03, 0x5d, 0xe5, 0x42, 0x0f, 0xea, 0xca, 0xd4, 0xff,
+	0x17, 0x3a, 0x87, 0x58, 0x5e, 0x6b, 0x7f, 0xd8, 0x96, 0xb4, 0x2f, 0x08, 0x95, 0x40, 0x8a, 0xe9,
+	0xa7, 0x92, 0x32, 0x5f, 0xef, 0x8d, 0x54, 0x7b, 0x70, 0x70, 0xcf, 0x11, 0x59, 0x38, 0x4d, 0x16,
+	0x6d, 0x6c, 0xce, 0x21, 0x8d, 0x33, 0xf7, 0xf9, 0x69, 0x65, 0x76, 0xea, 0xca, 0x1c, 0x0a, 0x8b,
+	0x35, 0xd5, 0xfa, 0xf1, 0xdb, 0xb4, 0xa3, 0x98, 0xcd, 0xcb, 0xc0, 0x09, 0x69, 0x8a, 0x65, 0x9a,
+	0xe2, 0x67, 0x1f, 0x66, 0x1f, 0x31, 0x5b, 0xe4, 0x04, 0xb8, 0x0a, 0x78, 0xfd, 0x86, 0xf8, 0xb6,
+	0xe1, 0x69, 0x0c, 0x6d, 0x05, 0xe5, 0x2c, 0x22, 0x4c, 0xbf, 0x76, 0x95, 0xf9, 0x33, 0x69, 0xbe,
+	0x2d, 0xcc, 0x05, 0x6d, 0x33, 0x63, 0xe9, 0x35, 0xe9, 0xfd, 0xfd, 0x6e, 0x2a, 0xd6, 0xb7, 0x2e,
+	0xda, 0x96, 0x6f, 0x4a, 0x66, 0x47, 0x84, 0xc0, 0x86, 0xef, 0xf9, 0x10, 0xf5, 0x9a, 0x41, 0xf4,
+	0x2e, 0x87, 0xee, 0xd4, 0x95, 0x39, 0x58, 0xe7, 0x62, 0x79, 0xbc, 0xa9, 0x65, 0xa8, 0xd7, 0x7c,
+	0x7e, 0xba, 0x7a, 0xd5, 0x78, 0x4f, 0xe5, 0x78, 0x52, 0xa3, 0x21, 0x6d, 0x36, 0x1c, 0xf7, 0xd1,
+	0x26, 0xe8, 0x06, 0xc9, 0x69, 0x38, 0x9f, 0x66, 0x65, 0x1a, 0x90, 0x42, 0xef, 0x8d, 0x14, 0x5b,
+	0x75, 0x77, 0xeb, 0xca, 0xbc, 0x2d, 0x84, 0x2f, 0x76, 0x2d, 0x6f, 0xc0, 0x8f, 0xaf, 0xf9, 0x49,
+	0xc4, 0xe2, 0xbe, 0x3c, 0x5d, 0x1a, 0xca, 0xd9, 0xd2, 0x50, 0xfe, 0x2c, 0x0d, 0xe5, 0xeb, 0xca,
+	0xe8, 0x9c, 0xad, 0x8c, 0xce, 0xaf, 0x95, 0xd1, 0x79, 0x7f, 0x70, 0xe1, 0x2e, 0x72, 0xbb, 0xf6,
+	0x13, 0x3f, 0x80, 0xf6, 0x80, 0x8f, 0xc7, 0x4f, 0xf0, 0x49, 0xbb, 0x9a, 0xfc, 0x6e, 0xc1, 0x16,
+	0xdf, 0x9f, 0xc7, 0xff, 0x06, 0x00, 0x07, 0x4f, 0xf4, 0x2f, 0xb9, 0x03, 0x00, 0x00,
+}
+
+func (this *SponsorshipPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SponsorshipPolicy)
+	if !ok {
+		that2, ok := that.(SponsorshipPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
+	if len(this.AllowedMessages) != len(that1.AllowedMessages) {
+		return false
+	}
+	for i := range this.AllowedMessages {
+		if this.AllowedMessages[i] != that1.AllowedMessages[i] {
+			return false
+		}
+	}
+	if len(this.AllowedContracts) != len(that1.AllowedContracts) {
+		return false
+	}
+	for i := range this.AllowedContracts {
+		if this.AllowedContracts[i] != that1.AllowedContracts[i] {
+			return false
+		}
+	}
+	if len(this.UserQuota) != len(that1.UserQuota) {
+		return false
+	}
+	for i := range this.UserQuota {
+		if !this.UserQuota[i].Equal(&that1.UserQuota[i]) {
+			return false
+		}
+	}
+	if len(this.Budget) != len(that1.Budget) {
+		return false
+	}
+	for i := range this.Budget {
+		if !this.Budget[i].Equal(&that1.Budget[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SponsoredFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SponsoredFees)
+	if !ok {
+		that2, ok := that.(SponsoredFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if len(this.Fees) != len(that1.Fees) {
+		return false
+	}
+	for i := range this.Fees {
+		if !this.Fees[i].Equal(&that1.Fees[i]) {
+			return false
+		}
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	return true
+}
+func (m *SponsorshipPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UserQuota) > 0 {
+		for iNdEx := len(m.UserQuota) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserQuota[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintSponsorship(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintSponsorship(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsorship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsorship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SponsorshipPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if len(m.UserQuota) > 0 {
+		for _, e := range m.UserQuota {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SponsoredFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovSponsorship(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func sovSponsorship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsorship(x uint64) (n int) {
+	return sovSponsorship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SponsorshipPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserQuota = append(m.UserQuota, types.Coin{})
+			if err := m.UserQuota[len(m.UserQuota)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsoredFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsoredFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsoredFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsorship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsorship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsorship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsorship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsorship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsorship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsorship = fmt.Errorf("proto: unexpected end of group")
+)
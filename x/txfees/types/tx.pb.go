@@ -0,0 +1,1560 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetSponsorshipPolicy is the sdk.Msg type for registering the sender as a
+// sponsor of the fees of other accounts, or updating its sponsorship policy.
+// Updating the policy keeps the budget and the fees already paid for each user.
+type MsgSetSponsorshipPolicy struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// allowed_messages are the type URLs of the messages that can be sponsored.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty" yaml:"allowed_messages"`
+	// allowed_contracts are the contracts that sponsored txs can execute. If it
+	// is empty, sponsored txs can execute any contract.
+	AllowedContracts []string `protobuf:"bytes,3,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty" yaml:"allowed_contracts"`
+	// user_quota is the total amount of fees that the sponsor pays for the txs of
+	// each user.
+	UserQuota github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=user_quota,json=userQuota,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_quota" yaml:"user_quota"`
+}
+
+func (m *MsgSetSponsorshipPolicy) Reset()         { *m = MsgSetSponsorshipPolicy{} }
+func (m *MsgSetSponsorshipPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorshipPolicy) ProtoMessage()    {}
+func (*MsgSetSponsorshipPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{0}
+}
+func (m *MsgSetSponsorshipPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorshipPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorshipPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorshipPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorshipPolicy.Merge(m, src)
+}
+func (m *MsgSetSponsorshipPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorshipPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorshipPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorshipPolicy proto.InternalMessageInfo
+
+func (m *MsgSetSponsorshipPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorshipPolicy) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorshipPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorshipPolicy) GetUserQuota() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserQuota
+	}
+	return nil
+}
+
+type MsgSetSponsorshipPolicyResponse struct {
+}
+
+func (m *MsgSetSponsorshipPolicyResponse) Reset()         { *m = MsgSetSponsorshipPolicyResponse{} }
+func (m *MsgSetSponsorshipPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorshipPolicyResponse) ProtoMessage()    {}
+func (*MsgSetSponsorshipPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{1}
+}
+func (m *MsgSetSponsorshipPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorshipPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorshipPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorshipPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorshipPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetSponsorshipPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorshipPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorshipPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorshipPolicyResponse proto.InternalMessageInfo
+
+// MsgFundSponsorship is the sdk.Msg type for adding to the budget of a sponsor.
+// Any account can fund a sponsor.
+type MsgFundSponsorship struct {
+	Sender  string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Sponsor string                                   `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgFundSponsorship) Reset()         { *m = MsgFundSponsorship{} }
+func (m *MsgFundSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorship) ProtoMessage()    {}
+func (*MsgFundSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{2}
+}
+func (m *MsgFundSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorship.Merge(m, src)
+}
+func (m *MsgFundSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorship proto.InternalMessageInfo
+
+func (m *MsgFundSponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFundSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgFundSponsorship) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgFundSponsorshipResponse struct {
+}
+
+func (m *MsgFundSponsorshipResponse) Reset()         { *m = MsgFundSponsorshipResponse{} }
+func (m *MsgFundSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorshipResponse) ProtoMessage()    {}
+func (*MsgFundSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{3}
+}
+func (m *MsgFundSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgFundSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRemoveSponsorship is the sdk.Msg type for removing the sponsorship policy
+// of the sender. The remaining budget is refunded to the sender.
+type MsgRemoveSponsorship struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+}
+
+func (m *MsgRemoveSponsorship) Reset()         { *m = MsgRemoveSponsorship{} }
+func (m *MsgRemoveSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorship) ProtoMessage()    {}
+func (*MsgRemoveSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{4}
+}
+func (m *MsgRemoveSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorship.Merge(m, src)
+}
+func (m *MsgRemoveSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorship proto.InternalMessageInfo
+
+func (m *MsgRemoveSponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgRemoveSponsorshipResponse struct {
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded" yaml:"refunded"`
+}
+
+func (m *MsgRemoveSponsorshipResponse) Reset()         { *m = MsgRemoveSponsorshipResponse{} }
+func (m *MsgRemoveSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRemoveSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{5}
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorshipResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveSponsorshipResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetSponsorshipPolicy)(nil), "osmosis.txfees.v1beta1.MsgSetSponsorshipPolicy")
+	proto.RegisterType((*MsgSetSponsorshipPolicyResponse)(nil), "osmosis.txfees.v1beta1.MsgSetSponsorshipPolicyResponse")
+	proto.RegisterType((*MsgFundSponsorship)(nil), "osmosis.txfees.v1beta1.MsgFundSponsorship")
+	proto.RegisterType((*MsgFundSponsorshipResponse)(nil), "osmosis.txfees.v1beta1.MsgFundSponsorshipResponse")
+	proto.RegisterType((*MsgRemoveSponsorship)(nil), "osmosis.txfees.v1beta1.MsgRemoveSponsorship")
+	proto.RegisterType((*MsgRemoveSponsorshipResponse)(nil), "osmosis.txfees.v1beta1.MsgRemoveSponsorshipResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/txfees/v1beta1/tx.proto", fileDescriptor_3d23e2aa9435ce2a) }
+
+var fileDescriptor_3d23e2aa9435ce2a = []byte{
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x80, 0xe3, 0x18, 0x15, 0x72, 0x08, 0x42, 0xac, 0x88, 0x1a, 0x13, 0xd9, 0xa9, 0x05, 0x28,
+	0x44, 0x8d, 0xad, 0x04, 0xa4, 0x4a, 0xd9, 0x48, 0x44, 0x25, 0x24, 0x22, 0x81, 0xbb, 0xb1, 0x54,
+	0x8e, 0x7d, 0x75, 0x2d, 0x62, 0x5f, 0xea, 0x3b, 0xa7, 0x09, 0x0b, 0x0c, 0x2c, 0x30, 0x31, 0xf3,
+	0x13, 0x98, 0xf8, 0x19, 0x1d, 0x3b, 0x32, 0x19, 0x94, 0x0c, 0xec, 0x11, 0x3f, 0x00, 0xd9, 0x3e,
+	0xbb, 0xa9, 0x93, 0xb6, 0x84, 0x25, 0x1f, 0xaf, 0x9f, 0xf7, 0x43, 0xcf, 0x7b, 0x3e, 0x20, 0x21,
+	0xec, 0x20, 0x6c, 0x63, 0x95, 0x8c, 0x0f, 0x20, 0xc4, 0xea, 0xa8, 0xd9, 0x87, 0x44, 0x6f, 0xaa,
+	0x64, 0xac, 0x0c, 0x3d, 0x44, 0x10, 0x77, 0x97, 0x02, 0x4a, 0x0c, 0x28, 0x14, 0x10, 0xca, 0x16,
+	0xb2, 0x50, 0x84, 0xa8, 0xe1, 0xaf, 0x98, 0x16, 0x4a, 0xba, 0x63, 0xbb, 0x48, 0x8d, 0x3e, 0x69,
+	0x48, 0x34, 0xa2, 0x0a, 0x6a, 0x5f, 0xc7, 0x30, 0x2d, 0x6f, 0x20, 0xdb, 0x8d, 0x9f, 0xcb, 0x1f,
+	0x59, 0xb0, 0xd9, 0xc3, 0xd6, 0x1e, 0x24, 0x7b, 0x43, 0xe4, 0x62, 0xe4, 0xe1, 0x43, 0x7b, 0xf8,
+	0x0a, 0x0d, 0x6c, 0x63, 0xc2, 0x3d, 0x06, 0x1b, 0x18, 0xba, 0x26, 0xf4, 0x78, 0xa6, 0xca, 0xd4,
+	0x0a, 0x9d, 0xd2, 0x3c, 0x90, 0x6e, 0x4d, 0x74, 0x67, 0xd0, 0x96, 0xe3, 0xb8, 0xac, 0x51, 0x80,
+	0xdb, 0x05, 0x77, 0xf4, 0xc1, 0x00, 0x1d, 0x43, 0x73, 0xdf, 0x81, 0x18, 0xeb, 0x16, 0xc4, 0x7c,
+	0xbe, 0xca, 0xd6, 0x0a, 0x9d, 0xfb, 0xf3, 0x40, 0xda, 0x8c, 0x93, 0xb2, 0x84, 0xac, 0x15, 0x69,
+	0xa8, 0x47, 0x23, 0xdc, 0x0b, 0x50, 0x4a, 0x28, 0x03, 0xb9, 0xc4, 0xd3, 0x0d, 0x82, 0x79, 0x36,
+	0x2a, 0x54, 0x99, 0x07, 0x12, 0x7f, 0xbe, 0x50, 0x8a, 0xc8, 0x5a, 0xd2, 0xbe, 0x9b, 0x84, 0xb8,
+	0xf7, 0x00, 0xf8, 0x18, 0x7a, 0xfb, 0x47, 0x3e, 0x22, 0x3a, 0x7f, 0xad, 0xca, 0xd6, 0x6e, 0xb6,
+	0xee, 0x29, 0xb1, 0x0e, 0x25, 0xd4, 0x91, 0xc8, 0x54, 0xba, 0xc8, 0x76, 0x3b, 0xcf, 0x4f, 0x02,
+	0x29, 0x37, 0x0f, 0xa4, 0x52, 0xdc, 0xe2, 0x2c, 0x55, 0xfe, 0xf6, 0x53, 0xaa, 0x59, 0x36, 0x39,
+	0xf4, 0xfb, 0x8a, 0x81, 0x1c, 0x95, 0x0a, 0x8d, 0xbf, 0x1a, 0xd8, 0x7c, 0xab, 0x92, 0xc9, 0x10,
+	0xe2, 0xa8, 0x0a, 0xd6, 0x0a, 0x61, 0xe2, 0xeb, 0x30, 0xaf, 0x5d, 0xff, 0xfc, 0xfb, 0x7b, 0xfd,
+	0x61, 0x66, 0xc3, 0x18, 0x92, 0x06, 0x3e, 0x33, 0xdd, 0x18, 0x46, 0xaa, 0xe5, 0x2d, 0x20, 0x5d,
+	0xb0, 0x05, 0x0d, 0x46, 0x38, 0x94, 0x3f, 0xe5, 0x01, 0xd7, 0xc3, 0xd6, 0xae, 0xef, 0x9a, 0x0b,
+	0xd0, 0x3a, 0x4b, 0xda, 0x06, 0xd7, 0x69, 0x6b, 0x3e, 0x1f, 0xb1, 0xdc, 0x3c, 0x90, 0x6e, 0x53,
+	0x36, 0x7e, 0x20, 0x6b, 0x09, 0xc2, 0x11, 0xb0, 0xa1, 0x3b, 0xc8, 0x77, 0x09, 0xcf, 0x5e, 0xe5,
+	0xee, 0x19, 0x75, 0x47, 0xfb, 0xc6, 0x69, 0xeb, 0x79, 0xa3, 0xbd, 0xda, 0x0f, 0x42, 0x69, 0xd9,
+	0xd7, 0xe2, 0xc0, 0x77, 0xcd, 0x45, 0x6b, 0x72, 0x05, 0x08, 0xcb, 0x2a, 0x52, 0x53, 0x36, 0x28,
+	0xf7, 0xb0, 0xa5, 0x41, 0x07, 0x8d, 0xe0, 0xff, 0xa9, 0x6a, 0x3f, 0x0a, 0xc7, 0xd8, 0xca, 0x8c,
+	0xe1, 0x45, 0x15, 0xcf, 0x0d, 0xf2, 0x95, 0x01, 0x95, 0x55, 0xbd, 0x92, 0x59, 0xb8, 0x77, 0xe0,
+	0x86, 0x07, 0xc3, 0xf9, 0xa1, 0xc9, 0x33, 0x57, 0x79, 0xec, 0x52, 0x8f, 0xc5, 0x78, 0xa8, 0x24,
+	0x71, 0x3d, 0x93, 0x69, 0xbf, 0xd6, 0x9f, 0x3c, 0x60, 0x7b, 0xd8, 0xe2, 0x3e, 0x30, 0xa0, 0xbc,
+	0xf2, 0x05, 0x57, 0x95, 0xd5, 0xd7, 0x8b, 0x72, 0xc1, 0x59, 0x14, 0x76, 0xd6, 0x4c, 0x48, 0x35,
+	0x1c, 0x81, 0x62, 0xf6, 0xe0, 0xd6, 0x2f, 0xa9, 0x95, 0x61, 0x85, 0xd6, 0xbf, 0xb3, 0x69, 0xcb,
+	0x63, 0x50, 0x5a, 0x3e, 0x02, 0xdb, 0x97, 0x14, 0x5a, 0xa2, 0x85, 0xa7, 0xeb, 0xd0, 0x49, 0xe3,
+	0xce, 0xcb, 0x93, 0xa9, 0xc8, 0x9c, 0x4e, 0x45, 0xe6, 0xd7, 0x54, 0x64, 0xbe, 0xcc, 0xc4, 0xdc,
+	0xe9, 0x4c, 0xcc, 0xfd, 0x98, 0x89, 0xb9, 0x37, 0xad, 0x85, 0x25, 0xd2, 0xca, 0x8d, 0x81, 0xde,
+	0xc7, 0xc9, 0x1f, 0x75, 0xd4, 0xdc, 0x51, 0xc7, 0xc9, 0x71, 0x8b, 0x96, 0xda, 0xdf, 0x88, 0xee,
+	0xe9, 0x27, 0x7f, 0x07, 0x00, 0x91, 0x76, 0x91, 0xf1, 0x2b, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetSponsorshipPolicy(ctx context.Context, in *MsgSetSponsorshipPolicy, opts ...grpc.CallOption) (*MsgSetSponsorshipPolicyResponse, error)
+	FundSponsorship(ctx context.Context, in *MsgFundSponsorship, opts ...grpc.CallOption) (*MsgFundSponsorshipResponse, error)
+	RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetSponsorshipPolicy(ctx context.Context, in *MsgSetSponsorshipPolicy, opts ...grpc.CallOption) (*MsgSetSponsorshipPolicyResponse, error) {
+	out := new(MsgSetSponsorshipPolicyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Msg/SetSponsorshipPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSponsorship(ctx context.Context, in *MsgFundSponsorship, opts ...grpc.CallOption) (*MsgFundSponsorshipResponse, error) {
+	out := new(MsgFundSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Msg/FundSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error) {
+	out := new(MsgRemoveSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Msg/RemoveSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetSponsorshipPolicy(context.Context, *MsgSetSponsorshipPolicy) (*MsgSetSponsorshipPolicyResponse, error)
+	FundSponsorship(context.Context, *MsgFundSponsorship) (*MsgFundSponsorshipResponse, error)
+	RemoveSponsorship(context.Context, *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetSponsorshipPolicy(ctx context.Context, req *MsgSetSponsorshipPolicy) (*MsgSetSponsorshipPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorshipPolicy not implemented")
+}
+func (*UnimplementedMsgServer) FundSponsorship(ctx context.Context, req *MsgFundSponsorship) (*MsgFundSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSponsorship(ctx context.Context, req *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSponsorship not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetSponsorshipPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorshipPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorshipPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Msg/SetSponsorshipPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorshipPolicy(ctx, req.(*MsgSetSponsorshipPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Msg/FundSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSponsorship(ctx, req.(*MsgFundSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Msg/RemoveSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSponsorship(ctx, req.(*MsgRemoveSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.txfees.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSponsorshipPolicy",
+			Handler:    _Msg_SetSponsorshipPolicy_Handler,
+		},
+		{
+			MethodName: "FundSponsorship",
+			Handler:    _Msg_FundSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveSponsorship",
+			Handler:    _Msg_RemoveSponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/txfees/v1beta1/tx.proto",
+}
+
+func (m *MsgSetSponsorshipPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorshipPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorshipPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserQuota) > 0 {
+		for iNdEx := len(m.UserQuota) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserQuota[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorshipPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorshipPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorshipPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetSponsorshipPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UserQuota) > 0 {
+		for _, e := range m.UserQuota {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSponsorshipPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetSponsorshipPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorshipPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorshipPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserQuota = append(m.UserQuota, types.Coin{})
+			if err := m.UserQuota[len(m.UserQuota)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsorshipPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorshipPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorshipPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)